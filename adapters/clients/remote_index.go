@@ -30,6 +30,7 @@ import (
 	"github.com/weaviate/weaviate/adapters/handlers/rest/clusterapi"
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/aggregation"
+	"github.com/weaviate/weaviate/entities/facets"
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/search"
 	"github.com/weaviate/weaviate/entities/searchparams"
@@ -408,10 +409,13 @@ func (c *RemoteIndex) SearchShard(ctx context.Context, host, index, shard string
 	additional additional.Properties,
 	targetCombination *dto.TargetCombination,
 	properties []string,
+	matryoshkaDims int,
 ) ([]*storobj.Object, []float32, error) {
 	// new request
+	nprobe, _ := searchparams.NProbeFromContext(ctx)
+	rangeCursor, _ := searchparams.RangeCursorFromContext(ctx)
 	body, err := clusterapi.IndicesPayloads.SearchParams.
-		Marshal(vector, targetVector, limit, filters, keywordRanking, sort, cursor, groupBy, additional, targetCombination, properties)
+		Marshal(vector, targetVector, limit, filters, keywordRanking, sort, cursor, groupBy, additional, targetCombination, properties, matryoshkaDims, nprobe, rangeCursor)
 	if err != nil {
 		return nil, nil, fmt.Errorf("marshal request payload: %w", err)
 	}
@@ -442,6 +446,49 @@ func (r *searchShardResp) decode(data []byte) (err error) {
 	return
 }
 
+func (c *RemoteIndex) SearchShardBatch(ctx context.Context, host, index, shard string,
+	searchVectors [][]float32,
+	targetVector string,
+	distance float32,
+	limit int,
+	filters *filters.LocalFilter,
+	additional additional.Properties,
+	properties []string,
+) ([][]*storobj.Object, [][]float32, error) {
+	// new request
+	nprobe, _ := searchparams.NProbeFromContext(ctx)
+	body, err := clusterapi.IndicesPayloads.SearchBatchParams.
+		Marshal(searchVectors, targetVector, distance, limit, filters, additional, properties, nprobe)
+	if err != nil {
+		return nil, nil, fmt.Errorf("marshal request payload: %w", err)
+	}
+	url := url.URL{
+		Scheme: "http",
+		Host:   host,
+		Path:   fmt.Sprintf("/indices/%s/shards/%s/objects/_searchbatch", index, shard),
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, nil, fmt.Errorf("create http request: %w", err)
+	}
+	clusterapi.IndicesPayloads.SearchBatchParams.SetContentTypeHeaderReq(req)
+
+	// send request
+	resp := &searchShardBatchResp{}
+	err = c.doWithCustomMarshaller(c.timeoutUnit*20, req, body, resp.decode, successCode, 9)
+	return resp.Objects, resp.Distances, err
+}
+
+type searchShardBatchResp struct {
+	Objects   [][]*storobj.Object
+	Distances [][]float32
+}
+
+func (r *searchShardBatchResp) decode(data []byte) (err error) {
+	r.Objects, r.Distances, err = clusterapi.IndicesPayloads.SearchBatchResults.Unmarshal(data)
+	return
+}
+
 type aggregateResp struct {
 	Result *aggregation.Result
 }
@@ -477,6 +524,39 @@ func (c *RemoteIndex) Aggregate(ctx context.Context, hostName, index,
 	return resp.Result, err
 }
 
+type facetsResp struct {
+	Results []facets.Result
+}
+
+func (r *facetsResp) decode(data []byte) (err error) {
+	r.Results, err = clusterapi.IndicesPayloads.FacetsResults.Unmarshal(data)
+	return
+}
+
+func (c *RemoteIndex) Facets(ctx context.Context, hostName, index,
+	shard string, params facets.Params,
+) ([]facets.Result, error) {
+	body, err := clusterapi.IndicesPayloads.FacetsParams.Marshal(params)
+	if err != nil {
+		return nil, fmt.Errorf("marshal request payload: %w", err)
+	}
+
+	url := &url.URL{
+		Scheme: "http",
+		Host:   hostName,
+		Path:   fmt.Sprintf("/indices/%s/shards/%s/objects/_facets", index, shard),
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("create http request: %w", err)
+	}
+	clusterapi.IndicesPayloads.FacetsParams.SetContentTypeHeaderReq(req)
+
+	resp := &facetsResp{}
+	err = c.doWithCustomMarshaller(c.timeoutUnit*20, req, body, resp.decode, successCode, 9)
+	return resp.Results, err
+}
+
 func (c *RemoteIndex) FindUUIDs(ctx context.Context, hostName, indexName,
 	shardName string, filters *filters.LocalFilter,
 ) ([]strfmt.UUID, error) {
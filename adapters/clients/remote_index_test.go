@@ -34,6 +34,10 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/clusterapi"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	"github.com/weaviate/weaviate/entities/storobj"
 )
 
 func TestRemoteIndexIncreaseRF(t *testing.T) {
@@ -248,6 +252,81 @@ func TestRemoteIndexPutFile(t *testing.T) {
 	})
 }
 
+func TestRemoteIndexSearchShardBatch(t *testing.T) {
+	t.Parallel()
+	var (
+		ctx     = searchparams.ContextWithNProbe(context.Background(), 8)
+		path    = "/indices/C1/shards/S1/objects/_searchbatch"
+		fs      = newFakeRemoteIndexServer(t, http.MethodPost, path)
+		vectors = [][]float32{{1, 2}, {3, 4}}
+		obj     = storobj.FromObject(&models.Object{
+			ID:    "c6f85bf5-c3b7-4c1d-bd51-e899f9605336",
+			Class: "C1",
+		}, nil, nil)
+	)
+	ts := fs.server(t)
+	defer ts.Close()
+	client := newRemoteIndex(ts.Client())
+
+	fs.doAfter = func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		gotVectors, target, _, limit, _, _, _, nprobe, err := clusterapi.IndicesPayloads.SearchBatchParams.Unmarshal(body)
+		if err != nil || target != "vec" || limit != 5 || nprobe != 8 || len(gotVectors) != len(vectors) {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		clusterapi.IndicesPayloads.SearchBatchResults.SetContentTypeHeader(w)
+		bytes, _ := clusterapi.IndicesPayloads.SearchBatchResults.Marshal(
+			[][]*storobj.Object{{obj}, {}}, [][]float32{{0.5}, {}})
+		w.Write(bytes)
+	}
+
+	objs, dists, err := client.SearchShardBatch(ctx, fs.host, "C1", "S1",
+		vectors, "vec", 0, 5, nil, additional.Properties{}, nil)
+	assert.Nil(t, err)
+	assert.Len(t, objs, 2)
+	assert.Len(t, objs[0], 1)
+	assert.Equal(t, obj.ID(), objs[0][0].ID())
+	assert.Empty(t, objs[1])
+	assert.Equal(t, [][]float32{{0.5}, {}}, dists)
+}
+
+func TestRemoteIndexSearchShardRangeCursor(t *testing.T) {
+	t.Parallel()
+	var (
+		cursor = &searchparams.RangeCursor{Distance: 0.25, ID: "c6f85bf5-c3b7-4c1d-bd51-e899f9605336"}
+		ctx    = searchparams.ContextWithRangeCursor(context.Background(), cursor)
+		path   = "/indices/C1/shards/S1/objects/_search"
+		fs     = newFakeRemoteIndexServer(t, http.MethodPost, path)
+		obj    = storobj.FromObject(&models.Object{
+			ID:    "f2a8ab4a-9ab3-4b5a-9b8f-4d0e1bd5b0f4",
+			Class: "C1",
+		}, nil, nil)
+	)
+	ts := fs.server(t)
+	defer ts.Close()
+	client := newRemoteIndex(ts.Client())
+
+	fs.doAfter = func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		_, _, _, limit, _, _, _, _, _, _, _, _, _, _, gotCursor, err := clusterapi.IndicesPayloads.SearchParams.Unmarshal(body)
+		if err != nil || limit != -1 || gotCursor == nil || *gotCursor != *cursor {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		clusterapi.IndicesPayloads.SearchResults.SetContentTypeHeader(w)
+		bytes, _ := clusterapi.IndicesPayloads.SearchResults.Marshal([]*storobj.Object{obj}, []float32{0.3})
+		w.Write(bytes)
+	}
+
+	objs, dists, err := client.SearchShard(ctx, fs.host, "C1", "S1", [][]float32{{1, 2}}, []string{""},
+		-1, nil, nil, nil, nil, nil, additional.Properties{}, nil, nil, 0)
+	assert.Nil(t, err)
+	assert.Len(t, objs, 1)
+	assert.Equal(t, obj.ID(), objs[0].ID())
+	assert.Equal(t, []float32{0.3}, dists)
+}
+
 func newRemoteIndex(httpClient *http.Client) *RemoteIndex {
 	ri := NewRemoteIndex(httpClient)
 	ri.minBackOff = time.Millisecond * 1
@@ -20,6 +20,17 @@ const (
 
 const GroupBy = "Specify which properties to group by"
 
+const (
+	GroupByKeys            = "Specify the keys of nested groups, from the outermost to the innermost group. Takes precedence over groupBy"
+	GroupByKeyPath         = "The path of the property to group by"
+	GroupByKeyRanges       = "Group numbers, or the distances of geo coordinates to the geoOrigin in meters, into these ranges"
+	GroupByKeyRangeFrom    = "The lower bound of the range, included in the range. Unbounded if not set"
+	GroupByKeyRangeTo      = "The upper bound of the range, excluded from the range. Unbounded if not set"
+	GroupByKeyGeoOrigin    = "Group geo coordinates into ranges of their distance to this origin"
+	GroupByKeyDateInterval = "Group dates by the calendar interval they fall into (minute, hour, day, week, month, quarter or year). Intervals are in UTC, weeks start on Monday"
+	AggregateGroups        = "The nested groups of the next key in groupByKeys"
+)
+
 const (
 	AggregatePropertyObject = "An object containing Aggregation information about this property"
 )
@@ -46,6 +57,8 @@ const AggregateGroupedByObj = "An object containing the path and value of the gr
 const (
 	AggregateGroupedByGroupedByPath  = "The path of the grouped property"
 	AggregateGroupedByGroupedByValue = "The value of the grouped property"
+	AggregateGroupedByGroupedByFrom  = "The lower bound of a range group"
+	AggregateGroupedByGroupedByTo    = "The upper bound of a range group"
 )
 
 // NETWORK
@@ -151,4 +151,9 @@ const (
 	GroupByPath            = "Specify the path from the objects fields to the property name (e.g. ['Things', 'City', 'population'] leads to the 'population' property of a 'City' object)"
 	GroupByGroups          = "Specify the number of groups to be created"
 	GroupByObjectsPerGroup = "Specify the number of max objects in group"
+	GroupByStrategy        = "Specify how the objects of a group are selected, either the objects closest to the search (closest) which is default or the objects most distant to each other (diverse)"
+	GroupBySort            = "Specify the order of the groups, by default the distance of their closest object"
+	GroupBySortBy          = "Order the groups by the distance of their closest object (distance), the aggregated score of their objects (score), the value of the grouped by property (property) or their number of objects (size)"
+	GroupBySortAggregation = "Specify how the scores of the objects are aggregated when ordering by score, either max which is default, avg or sum"
+	GroupBySortDescending  = "Reverse the order when ordering by property"
 )
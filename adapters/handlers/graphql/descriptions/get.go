@@ -48,3 +48,15 @@ const ConsistencyLevel = "Determines how many replicas must acknowledge a reques
 	"before it is considered successful. Can be 'ONE', 'QUORUM', or 'ALL'"
 
 const Tenant = "The value by which a tenant is identified, specified in the class schema"
+
+const (
+	Facets         = "Count the objects per value of properties among all objects matching the search, not just the returned ones, even if there are none. Not supported on vector searches with a distance or certainty limit"
+	FacetsProperty = "The name of the property to count the values of. Text properties require field tokenization"
+	FacetsLimit    = "The maximum number of values, ordered by count. Ignored for ranges. Values which are not among the top values of every shard may be undercounted"
+	FacetsRanges   = "Count the objects per range of a numerical property instead of per value"
+	FacetsFrom     = "The lower bound of the range, included in the range. Unbounded if not set"
+	FacetsTo       = "The upper bound of the range, excluded from the range. Unbounded if not set"
+)
+
+const RangeCursor = "Continue a search by distance without limit behind the last result of the previous page, " +
+	"given by its distance and id. Pages hold up to the maximum number of query results, the search ends with an empty page"
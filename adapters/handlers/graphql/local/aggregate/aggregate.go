@@ -85,6 +85,7 @@ func classField(class *models.Class, description string,
 ) (*graphql.Field, error) {
 	metaClassName := fmt.Sprintf("Aggregate%s", class.Class)
 
+	var fieldsObject *graphql.Object
 	fields := graphql.ObjectConfig{
 		Name: metaClassName,
 		Fields: (graphql.FieldsThunk)(func() graphql.Fields {
@@ -94,12 +95,26 @@ func classField(class *models.Class, description string,
 				panic(fmt.Sprintf("Failed to assemble single Local Aggregate Class field: %s", err))
 			}
 
+			// nested groups have the same fields as their parent group
+			fields[GroupsFieldName] = &graphql.Field{
+				Description: descriptions.AggregateGroups,
+				Type:        graphql.NewList(fieldsObject),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					group, ok := p.Source.(aggregation.Group)
+					if !ok {
+						return nil, fmt.Errorf("groups: expected aggregation.Group, got %T", p.Source)
+					}
+
+					return group.Groups, nil
+				},
+			}
+
 			return fields
 		}),
 		Description: description,
 	}
 
-	fieldsObject := graphql.NewObject(fields)
+	fieldsObject = graphql.NewObject(fields)
 	fieldsField := &graphql.Field{
 		Type:        graphql.NewList(fieldsObject),
 		Description: description,
@@ -122,8 +137,9 @@ func classField(class *models.Class, description string,
 				Description: descriptions.GroupBy,
 				Type:        graphql.NewList(graphql.String),
 			},
-			"nearVector": nearVectorArgument(class.Class),
-			"nearObject": nearObjectArgument(class.Class),
+			"groupByKeys": groupByKeysArgument(class.Class),
+			"nearVector":  nearVectorArgument(class.Class),
+			"nearObject":  nearObjectArgument(class.Class),
 			"objectLimit": &graphql.ArgumentConfig{
 				Description: descriptions.First,
 				Type:        graphql.Int,
@@ -0,0 +1,135 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package aggregate
+
+import (
+	"fmt"
+
+	"github.com/tailor-inc/graphql"
+	"github.com/weaviate/weaviate/adapters/handlers/graphql/descriptions"
+	"github.com/weaviate/weaviate/entities/aggregation"
+	"github.com/weaviate/weaviate/entities/filters"
+)
+
+func groupByKeysArgument(className string) *graphql.ArgumentConfig {
+	prefix := fmt.Sprintf("AggregateObjects%s", className)
+	return &graphql.ArgumentConfig{
+		Description: descriptions.GroupByKeys,
+		Type: graphql.NewList(graphql.NewInputObject(graphql.InputObjectConfig{
+			Name: fmt.Sprintf("%sGroupByKeyInpObj", prefix),
+			Fields: graphql.InputObjectConfigFieldMap{
+				"path": &graphql.InputObjectFieldConfig{
+					Description: descriptions.GroupByKeyPath,
+					Type:        graphql.NewNonNull(graphql.NewList(graphql.String)),
+				},
+				"ranges": &graphql.InputObjectFieldConfig{
+					Description: descriptions.GroupByKeyRanges,
+					Type: graphql.NewList(graphql.NewInputObject(graphql.InputObjectConfig{
+						Name: fmt.Sprintf("%sGroupByKeyRangeInpObj", prefix),
+						Fields: graphql.InputObjectConfigFieldMap{
+							"from": &graphql.InputObjectFieldConfig{
+								Description: descriptions.GroupByKeyRangeFrom,
+								Type:        graphql.Float,
+							},
+							"to": &graphql.InputObjectFieldConfig{
+								Description: descriptions.GroupByKeyRangeTo,
+								Type:        graphql.Float,
+							},
+						},
+					})),
+				},
+				"geoOrigin": &graphql.InputObjectFieldConfig{
+					Description: descriptions.GroupByKeyGeoOrigin,
+					Type: graphql.NewInputObject(graphql.InputObjectConfig{
+						Name: fmt.Sprintf("%sGroupByKeyGeoOriginInpObj", prefix),
+						Fields: graphql.InputObjectConfigFieldMap{
+							"latitude": &graphql.InputObjectFieldConfig{
+								Type: graphql.NewNonNull(graphql.Float),
+							},
+							"longitude": &graphql.InputObjectFieldConfig{
+								Type: graphql.NewNonNull(graphql.Float),
+							},
+						},
+					}),
+				},
+				"dateInterval": &graphql.InputObjectFieldConfig{
+					Description: descriptions.GroupByKeyDateInterval,
+					Type:        graphql.String,
+				},
+			},
+		})),
+	}
+}
+
+func extractGroupByKeys(args map[string]interface{}, rootClass string) ([]aggregation.GroupByKey, error) {
+	groupByKeys, ok := args["groupByKeys"]
+	if !ok {
+		return nil, nil
+	}
+
+	list, ok := groupByKeys.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("groupByKeys must be a list, instead got: %#v", groupByKeys)
+	}
+
+	keys := make([]aggregation.GroupByKey, len(list))
+	for i, elem := range list {
+		arg, ok := elem.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("groupByKeys[%d] must be an object, instead got: %#v", i, elem)
+		}
+
+		pathSegments, ok := arg["path"].([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("groupByKeys[%d].path must be a list, instead got: %#v", i, arg["path"])
+		}
+		path, err := filters.ParsePath(pathSegments, rootClass)
+		if err != nil {
+			return nil, fmt.Errorf("groupByKeys[%d].path: %w", i, err)
+		}
+		keys[i].Path = path
+
+		if ranges, ok := arg["ranges"].([]interface{}); ok {
+			for _, r := range ranges {
+				bounds, _ := r.(map[string]interface{})
+				keys[i].Ranges = append(keys[i].Ranges, aggregation.GroupByRange{
+					From: optionalFloat64(bounds["from"]),
+					To:   optionalFloat64(bounds["to"]),
+				})
+			}
+		}
+
+		if origin, ok := arg["geoOrigin"].(map[string]interface{}); ok {
+			lat, _ := origin["latitude"].(float64)
+			lon, _ := origin["longitude"].(float64)
+			keys[i].GeoOrigin = &aggregation.GeoOrigin{Latitude: float32(lat), Longitude: float32(lon)}
+		}
+
+		if interval, ok := arg["dateInterval"].(string); ok {
+			keys[i].DateInterval = aggregation.DateInterval(interval)
+		}
+
+		if err := keys[i].Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	return keys, nil
+}
+
+func optionalFloat64(value interface{}) *float64 {
+	f, ok := value.(float64)
+	if !ok {
+		return nil
+	}
+	return &f
+}
@@ -404,6 +404,26 @@ func groupedByProperty(class *models.Class) *graphql.Object {
 			Type:        graphql.String,
 			Resolve:     groupedByResolver(func(g *aggregation.GroupedBy) interface{} { return g.Value }),
 		},
+		"from": &graphql.Field{
+			Description: descriptions.AggregateGroupedByGroupedByFrom,
+			Type:        graphql.Float,
+			Resolve: groupedByResolver(func(g *aggregation.GroupedBy) interface{} {
+				if g.Range == nil {
+					return nil
+				}
+				return g.Range.From
+			}),
+		},
+		"to": &graphql.Field{
+			Description: descriptions.AggregateGroupedByGroupedByTo,
+			Type:        graphql.Float,
+			Resolve: groupedByResolver(func(g *aggregation.GroupedBy) interface{} {
+				if g.Range == nil {
+					return nil
+				}
+				return g.Range.To
+			}),
+		},
 	}
 
 	classPropertiesObj := graphql.NewObject(graphql.ObjectConfig{
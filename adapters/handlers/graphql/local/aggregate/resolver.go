@@ -33,7 +33,10 @@ import (
 // GroupedByFieldName is a special graphQL field that appears alongside the
 // to-be-aggregated props, but doesn't require any processing by the connectors
 // itself, as it just displays meta info about the overall aggregation.
-const GroupedByFieldName = "groupedBy"
+const (
+	GroupedByFieldName = "groupedBy"
+	GroupsFieldName    = "groups"
+)
 
 // Resolver is a local interface that can be composed with other interfaces to
 // form the overall GraphQL API main interface. All data-base connectors that
@@ -97,6 +100,11 @@ func resolveAggregate(p graphql.ResolveParams, authorizer authorization.Authoriz
 		return nil, fmt.Errorf("could not extract groupBy path: %w", err)
 	}
 
+	groupByKeys, err := extractGroupByKeys(p.Args, p.Info.FieldName)
+	if err != nil {
+		return nil, fmt.Errorf("could not extract groupByKeys: %w", err)
+	}
+
 	limit, err := extractLimit(p.Args)
 	if err != nil {
 		return nil, fmt.Errorf("could not extract limit: %w", err)
@@ -163,6 +171,7 @@ func resolveAggregate(p graphql.ResolveParams, authorizer authorization.Authoriz
 		ClassName:        className,
 		Properties:       properties,
 		GroupBy:          groupBy,
+		GroupByKeys:      groupByKeys,
 		IncludeMetaCount: includeMeta,
 		Limit:            limit,
 		ObjectLimit:      objectLimit,
@@ -214,6 +223,18 @@ func extractProperties(selections *ast.SelectionSet) ([]aggregation.ParamPropert
 			continue
 		}
 
+		if name == GroupsFieldName {
+			// nested groups aggregate the same properties as their parent
+			// groups, so their selections are merged into the parent's
+			nested, nestedMeta, err := extractProperties(field.SelectionSet)
+			if err != nil {
+				return nil, false, err
+			}
+			properties = mergeProperties(properties, nested)
+			includeMeta = includeMeta || nestedMeta
+			continue
+		}
+
 		if name == "__typename" {
 			continue
 		}
@@ -232,6 +253,34 @@ func extractProperties(selections *ast.SelectionSet) ([]aggregation.ParamPropert
 	return properties, includeMeta, nil
 }
 
+func mergeProperties(properties, other []aggregation.ParamProperty) []aggregation.ParamProperty {
+outer:
+	for _, prop := range other {
+		for i := range properties {
+			if properties[i].Name != prop.Name {
+				continue
+			}
+			for _, agg := range prop.Aggregators {
+				if !containsAggregator(properties[i].Aggregators, agg) {
+					properties[i].Aggregators = append(properties[i].Aggregators, agg)
+				}
+			}
+			continue outer
+		}
+		properties = append(properties, prop)
+	}
+	return properties
+}
+
+func containsAggregator(aggregators []aggregation.Aggregator, agg aggregation.Aggregator) bool {
+	for _, a := range aggregators {
+		if a.String() == agg.String() {
+			return true
+		}
+	}
+	return false
+}
+
 func extractAggregators(selections *ast.SelectionSet) ([]aggregation.Aggregator, error) {
 	if selections == nil {
 		return nil, nil
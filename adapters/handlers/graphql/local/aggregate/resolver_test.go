@@ -29,6 +29,7 @@ type testCase struct {
 	resolverReturn           interface{}
 	expectedResults          []result
 	expectedGroupBy          *filters.Path
+	expectedGroupByKeys      []aggregation.GroupByKey
 	expectedWhereFilter      *filters.LocalFilter
 	expectedNearObjectFilter *searchparams.NearObject
 	expectedNearVectorFilter *searchparams.NearVector
@@ -464,6 +465,82 @@ func Test_Resolve(t *testing.T) {
 				},
 			}},
 		},
+		testCase{
+			name: "nested groups by ranges and values",
+			query: `{ Aggregate { Car(groupByKeys:[
+					{path:["horsepower"], ranges:[{to:100}, {from:100}]},
+					{path:["modelName"]}
+				]) {
+					groupedBy { value from to }
+					meta { count }
+					groups { groupedBy { value } horsepower { mean } }
+				} } }`,
+			expectedProps: []aggregation.ParamProperty{
+				{
+					Name:        "horsepower",
+					Aggregators: []aggregation.Aggregator{aggregation.MeanAggregator},
+				},
+			},
+			expectedIncludeMetaCount: true,
+			expectedGroupByKeys: []aggregation.GroupByKey{
+				{
+					Path: &filters.Path{Class: "Car", Property: "horsepower"},
+					Ranges: []aggregation.GroupByRange{
+						{To: ptFloat64(100)},
+						{From: ptFloat64(100)},
+					},
+				},
+				{
+					Path: &filters.Path{Class: "Car", Property: "modelName"},
+				},
+			},
+			resolverReturn: []aggregation.Group{
+				{
+					GroupedBy: &aggregation.GroupedBy{
+						Path:  []string{"horsepower"},
+						Value: "100-*",
+						Range: &aggregation.GroupByRange{From: ptFloat64(100)},
+					},
+					Count: 2,
+					Groups: []aggregation.Group{
+						{
+							GroupedBy: &aggregation.GroupedBy{
+								Path:  []string{"modelName"},
+								Value: "Fast",
+							},
+							Count: 2,
+							Properties: map[string]aggregation.Property{
+								"horsepower": {
+									Type: aggregation.PropertyTypeNumerical,
+									NumericalAggregations: map[string]interface{}{
+										"mean": 250.0,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedResults: []result{{
+				pathToField: []string{"Aggregate", "Car"},
+				expectedValue: []interface{}{
+					map[string]interface{}{
+						"groupedBy": map[string]interface{}{
+							"value": "100-*",
+							"from":  100.0,
+							"to":    nil,
+						},
+						"meta": map[string]interface{}{"count": 2},
+						"groups": []interface{}{
+							map[string]interface{}{
+								"groupedBy":  map[string]interface{}{"value": "Fast"},
+								"horsepower": map[string]interface{}{"mean": 250.0},
+							},
+						},
+					},
+				},
+			}},
+		},
 		testCase{
 			name: "hybrid vector distance",
 			query: `{
@@ -991,6 +1068,7 @@ func (tests testCases) AssertExtraction(t *testing.T, className string) {
 				ClassName:        schema.ClassName(className),
 				Properties:       testCase.expectedProps,
 				GroupBy:          testCase.expectedGroupBy,
+				GroupByKeys:      testCase.expectedGroupByKeys,
 				Filters:          testCase.expectedWhereFilter,
 				NearObject:       testCase.expectedNearObjectFilter,
 				NearVector:       testCase.expectedNearVectorFilter,
@@ -1017,3 +1095,7 @@ func (tests testCases) AssertExtraction(t *testing.T, className string) {
 func ptInt(in int) *int {
 	return &in
 }
+
+func ptFloat64(in float64) *float64 {
+	return &in
+}
@@ -25,5 +25,9 @@ func bm25Fields(prefix string) graphql.InputObjectConfigFieldMap {
 			Description: "The properties to search in",
 			Type:        graphql.NewList(graphql.String),
 		},
+		"slop": &graphql.InputObjectFieldConfig{
+			Description: "The number of other words allowed between the words of quoted phrases in the query",
+			Type:        graphql.Int,
+		},
 	}
 }
@@ -31,6 +31,11 @@ func ExtractBM25(source map[string]interface{}, explainScore bool) searchparams.
 		args.Query = query.(string)
 	}
 
+	slop, ok := source["slop"]
+	if ok {
+		args.Slop = slop.(int)
+	}
+
 	args.AdditionalExplanations = explainScore
 	args.Type = "bm25"
 
@@ -40,6 +40,8 @@ func BuildNew(path string) graphql.InputObjectConfigFieldMap {
 					"IsNull":           &graphql.EnumValueConfig{},
 					"ContainsAny":      &graphql.EnumValueConfig{},
 					"ContainsAll":      &graphql.EnumValueConfig{},
+					"Prefix":           &graphql.EnumValueConfig{},
+					"Regex":            &graphql.EnumValueConfig{},
 				},
 				Description: descriptions.WhereOperatorEnum,
 			}),
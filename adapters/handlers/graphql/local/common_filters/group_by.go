@@ -35,5 +35,16 @@ func ExtractGroupBy(source map[string]interface{}) searchparams.GroupBy {
 		args.ObjectsPerGroup = int(objectsPerGroup.(int))
 	}
 
+	if strategy, ok := source["strategy"].(string); ok {
+		args.Strategy = strategy
+	}
+
+	if sort, ok := source["sort"].(map[string]interface{}); ok {
+		args.Sort = &searchparams.GroupBySort{}
+		args.Sort.By, _ = sort["by"].(string)
+		args.Sort.Aggregation, _ = sort["aggregation"].(string)
+		args.Sort.Descending, _ = sort["descending"].(bool)
+	}
+
 	return args
 }
@@ -56,24 +56,61 @@ func NearVectorFields(prefix string, addTarget bool) graphql.InputObjectConfigFi
 			Description: "Target vectors",
 			Type:        graphql.NewList(graphql.String),
 		},
+		"matryoshkaDimensions": &graphql.InputObjectFieldConfig{
+			Description: "Number of leading dimensions the results are re-ranked with, if the index holds truncated vectors",
+			Type:        graphql.Int,
+		},
+		"nprobe": &graphql.InputObjectFieldConfig{
+			Description: "Number of centroids whose posting lists are scanned, if the vector index is an ivf index",
+			Type:        graphql.Int,
+		},
 	}
 	fieldMap = AddTargetArgument(fieldMap, prefix+"nearVector", addTarget)
 	return fieldMap
 }
 
+func NearVectorBatchArgument(argumentPrefix, className string) *graphql.ArgumentConfig {
+	prefix := fmt.Sprintf("%s%s", argumentPrefix, className)
+	return &graphql.ArgumentConfig{
+		Type: graphql.NewInputObject(
+			graphql.InputObjectConfig{
+				Name: fmt.Sprintf("%sNearVectorBatchInpObj", prefix),
+				Fields: graphql.InputObjectConfigFieldMap{
+					"vectors": &graphql.InputObjectFieldConfig{
+						Description: "Query vectors, each one is searched separately",
+						Type:        graphql.NewNonNull(graphql.NewList(graphql.NewList(graphql.Float))),
+					},
+					"certainty": &graphql.InputObjectFieldConfig{
+						Description: descriptions.Certainty,
+						Type:        graphql.Float,
+					},
+					"distance": &graphql.InputObjectFieldConfig{
+						Description: descriptions.Distance,
+						Type:        graphql.Float,
+					},
+					"targetVector": &graphql.InputObjectFieldConfig{
+						Description: "Target vector",
+						Type:        graphql.String,
+					},
+				},
+			},
+		),
+	}
+}
+
 func NearObjectArgument(argumentPrefix, className string, addTarget bool) *graphql.ArgumentConfig {
 	prefix := fmt.Sprintf("%s%s", argumentPrefix, className)
 	return &graphql.ArgumentConfig{
 		Type: graphql.NewInputObject(
 			graphql.InputObjectConfig{
 				Name:   fmt.Sprintf("%sNearObjectInpObj", prefix),
-				Fields: nearObjectFields(prefix, addTarget),
+				Fields: NearObjectFields(prefix, addTarget),
 			},
 		),
 	}
 }
 
-func nearObjectFields(prefix string, addTarget bool) graphql.InputObjectConfigFieldMap {
+func NearObjectFields(prefix string, addTarget bool) graphql.InputObjectConfigFieldMap {
 	fieldMap := graphql.InputObjectConfigFieldMap{
 		"id": &graphql.InputObjectFieldConfig{
 			Description: descriptions.ID,
@@ -100,6 +137,29 @@ func nearObjectFields(prefix string, addTarget bool) graphql.InputObjectConfigFi
 	return fieldMap
 }
 
+// RangeCursorField is the position after which a search by distance
+// continues, the distance and id of the last result of the previous page
+func RangeCursorField(prefix string) *graphql.InputObjectFieldConfig {
+	return &graphql.InputObjectFieldConfig{
+		Description: descriptions.RangeCursor,
+		Type: graphql.NewInputObject(
+			graphql.InputObjectConfig{
+				Name: fmt.Sprintf("%sAfterInpObj", prefix),
+				Fields: graphql.InputObjectConfigFieldMap{
+					"distance": &graphql.InputObjectFieldConfig{
+						Description: "Distance of the last result of the previous page",
+						Type:        graphql.NewNonNull(graphql.Float),
+					},
+					"id": &graphql.InputObjectFieldConfig{
+						Description: "Id of the last result of the previous page",
+						Type:        graphql.NewNonNull(graphql.String),
+					},
+				},
+			},
+		),
+	}
+}
+
 var vectorPerTarget = graphql.NewScalar(graphql.ScalarConfig{
 	Name:        "VectorPerTarget",
 	Description: "A custom scalar type for a map with strings as keys and list of floats or list of lists of floats as values",
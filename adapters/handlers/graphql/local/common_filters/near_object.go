@@ -49,6 +49,18 @@ func ExtractNearObject(source map[string]interface{}) (searchparams.NearObject,
 			fmt.Errorf("cannot provide distance and certainty")
 	}
 
+	if after, ok := source["after"].(map[string]interface{}); ok {
+		if !certaintyOK && !distanceOK {
+			return searchparams.NearObject{}, nil,
+				fmt.Errorf("after requires a distance or certainty")
+		}
+		cursor, err := extractRangeCursor(after)
+		if err != nil {
+			return searchparams.NearObject{}, nil, err
+		}
+		args.After = cursor
+	}
+
 	targetVectors, combination, err := ExtractTargets(source)
 	if err != nil {
 		return searchparams.NearObject{}, nil, err
@@ -14,6 +14,8 @@ package common_filters
 import (
 	"fmt"
 
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
 	"github.com/weaviate/weaviate/entities/dto"
 	"github.com/weaviate/weaviate/entities/searchparams"
 )
@@ -45,6 +47,34 @@ func ExtractNearVector(source map[string]interface{}, targetVectorsFromOtherLeve
 			fmt.Errorf("cannot provide distance and certainty")
 	}
 
+	if matryoshkaDims, ok := source["matryoshkaDimensions"]; ok {
+		args.MatryoshkaDimensions = matryoshkaDims.(int)
+		if args.MatryoshkaDimensions < 0 {
+			return searchparams.NearVector{}, nil,
+				fmt.Errorf("matryoshkaDimensions must not be negative")
+		}
+	}
+
+	if nprobe, ok := source["nprobe"]; ok {
+		args.NProbe = nprobe.(int)
+		if args.NProbe < 0 {
+			return searchparams.NearVector{}, nil,
+				fmt.Errorf("nprobe must not be negative")
+		}
+	}
+
+	if after, ok := source["after"].(map[string]interface{}); ok {
+		if !certaintyOK && !distanceOK {
+			return searchparams.NearVector{}, nil,
+				fmt.Errorf("after requires a distance or certainty")
+		}
+		cursor, err := extractRangeCursor(after)
+		if err != nil {
+			return searchparams.NearVector{}, nil, err
+		}
+		args.After = cursor
+	}
+
 	var targetVectors []string
 	var combination *dto.TargetCombination
 	if targetVectorsFromOtherLevel == nil {
@@ -132,3 +162,59 @@ func ExtractNearVector(source map[string]interface{}, targetVectorsFromOtherLeve
 
 	return args, combination, nil
 }
+
+// ExtractNearVectorBatch arguments, such as "vectors" and "distance"
+func ExtractNearVectorBatch(source map[string]interface{}) (searchparams.NearVectorBatch, error) {
+	var args searchparams.NearVectorBatch
+
+	vectorsGQL, ok := source["vectors"].([]interface{})
+	if !ok || len(vectorsGQL) == 0 {
+		return searchparams.NearVectorBatch{}, fmt.Errorf("vectors is a required field")
+	}
+
+	certainty, certaintyOK := source["certainty"]
+	if certaintyOK {
+		args.Certainty = certainty.(float64)
+	}
+
+	distance, distanceOK := source["distance"]
+	if distanceOK {
+		args.Distance = distance.(float64)
+		args.WithDistance = true
+	}
+
+	if certaintyOK && distanceOK {
+		return searchparams.NearVectorBatch{}, fmt.Errorf("cannot provide distance and certainty")
+	}
+
+	if targetVector, ok := source["targetVector"]; ok {
+		args.TargetVector = targetVector.(string)
+	}
+
+	args.Vectors = make([][]float32, len(vectorsGQL))
+	for i, vectorGQL := range vectorsGQL {
+		values, ok := vectorGQL.([]interface{})
+		if !ok || len(values) == 0 {
+			return searchparams.NearVectorBatch{}, fmt.Errorf("vector %d of the batch is empty", i)
+		}
+		vector := make([]float32, len(values))
+		for j, value := range values {
+			vector[j] = float32(value.(float64))
+		}
+		args.Vectors[i] = vector
+	}
+
+	return args, nil
+}
+
+// extractRangeCursor parses the "after" argument of a search by distance
+func extractRangeCursor(source map[string]interface{}) (*searchparams.RangeCursor, error) {
+	id, err := uuid.Parse(source["id"].(string))
+	if err != nil {
+		return nil, fmt.Errorf("after: invalid id: %w", err)
+	}
+	return &searchparams.RangeCursor{
+		Distance: float32(source["distance"].(float64)),
+		ID:       strfmt.UUID(id.String()),
+	}, nil
+}
@@ -83,12 +83,27 @@ func (b *classBuilder) kinds(kindSchema *models.Schema) (*graphql.Object, error)
 	})
 
 	classFields := graphql.Fields{}
+	facetsFields := graphql.Fields{}
 	for _, class := range kindSchema.Classes {
 		classField, err := b.classField(class, fusionAlgoEnum)
 		if err != nil {
 			return nil, fmt.Errorf("could not build class for %s", class.Class)
 		}
 		classFields[class.Class] = classField
+		facetsFields[class.Class] = buildGetFacetsField(class, classField, b.authorizer, b.modulesProvider)
+	}
+
+	// class names start with an upper case letter, so they can't clash
+	classFields["_facets"] = &graphql.Field{
+		Description: descriptions.Facets,
+		Type: graphql.NewObject(graphql.ObjectConfig{
+			Name:   "GetObjectsFacetsObj",
+			Fields: facetsFields,
+		}),
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			// Does nothing; pass through the root
+			return p.Source, nil
+		},
 	}
 
 	classes := graphql.NewObject(graphql.ObjectConfig{
@@ -180,6 +195,9 @@ func (b *classBuilder) additionalFields(classProperties graphql.Fields, class *m
 	additionalProperties["score"] = b.additionalScoreField()
 	additionalProperties["explainScore"] = b.additionalExplainScoreField()
 	additionalProperties["group"] = b.additionalGroupField(classProperties, class)
+	additionalProperties["highlights"] = b.additionalHighlightsField(class)
+	additionalProperties["queryIndex"] = b.additionalQueryIndexField()
+	additionalProperties["queryPlan"] = b.additionalQueryPlanField(class)
 	if replicationEnabled(class) {
 		additionalProperties["isConsistent"] = b.isConsistentField()
 	}
@@ -282,12 +300,47 @@ func (b *classBuilder) additionalLastUpdateTimeUnix() *graphql.Field {
 	}
 }
 
+func (b *classBuilder) additionalQueryIndexField() *graphql.Field {
+	return &graphql.Field{
+		Type: graphql.Int,
+	}
+}
+
+func (b *classBuilder) additionalQueryPlanField(class *models.Class) *graphql.Field {
+	return &graphql.Field{
+		Type: graphql.NewList(graphql.NewObject(graphql.ObjectConfig{
+			Name: fmt.Sprintf("%sAdditionalQueryPlan", class.Class),
+			Fields: graphql.Fields{
+				"shard":         &graphql.Field{Type: graphql.String},
+				"targetVector":  &graphql.Field{Type: graphql.String},
+				"strategy":      &graphql.Field{Type: graphql.String},
+				"selectivity":   &graphql.Field{Type: graphql.Float},
+				"allowListSize": &graphql.Field{Type: graphql.Int},
+				"indexSize":     &graphql.Field{Type: graphql.Int},
+				"estimatedCost": &graphql.Field{Type: graphql.Float},
+			},
+		})),
+	}
+}
+
 func (b *classBuilder) isConsistentField() *graphql.Field {
 	return &graphql.Field{
 		Type: graphql.Boolean,
 	}
 }
 
+func (b *classBuilder) additionalHighlightsField(class *models.Class) *graphql.Field {
+	return &graphql.Field{
+		Type: graphql.NewList(graphql.NewObject(graphql.ObjectConfig{
+			Name: fmt.Sprintf("%sAdditionalHighlights", class.Class),
+			Fields: graphql.Fields{
+				"property":  &graphql.Field{Type: graphql.String},
+				"fragments": &graphql.Field{Type: graphql.NewList(graphql.String)},
+			},
+		})),
+	}
+}
+
 func (b *classBuilder) additionalGroupField(classProperties graphql.Fields, class *models.Class) *graphql.Field {
 	hitsFields := graphql.Fields{
 		"_additional": &graphql.Field{
@@ -298,6 +351,7 @@ func (b *classBuilder) additionalGroupField(classProperties graphql.Fields, clas
 						"id":       &graphql.Field{Type: graphql.String},
 						"vector":   &graphql.Field{Type: graphql.NewList(graphql.Float)},
 						"distance": &graphql.Field{Type: graphql.Float},
+						"score":    &graphql.Field{Type: graphql.Float},
 					},
 				},
 			),
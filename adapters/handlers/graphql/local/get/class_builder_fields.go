@@ -228,12 +228,13 @@ func buildGetClassField(classObject *graphql.Object,
 				Type:        graphql.Int,
 			},
 
-			"sort":       sortArgument(class.Class),
-			"nearVector": nearVectorArgument(class.Class),
-			"nearObject": nearObjectArgument(class.Class),
-			"where":      whereArgument(class.Class),
-			"group":      groupArgument(class.Class),
-			"groupBy":    groupByArgument(class.Class),
+			"sort":            sortArgument(class.Class),
+			"nearVector":      nearVectorArgument(class.Class),
+			"nearVectorBatch": nearVectorBatchArgument(class.Class),
+			"nearObject":      nearObjectArgument(class.Class),
+			"where":           whereArgument(class.Class),
+			"group":           groupArgument(class.Class),
+			"groupBy":         groupByArgument(class.Class),
 		},
 		Resolve: newResolver(authorizer, modulesProvider).makeResolveGetClass(class.Class),
 	}
@@ -342,41 +343,106 @@ func (r *resolver) resolveGet(p graphql.ResolveParams, className string) (interf
 		return nil, fmt.Errorf("expected source map to have a usable Resolver, but got %#v", source["Resolver"])
 	}
 
+	params, err := r.extractGetParams(p, className, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return func() (interface{}, error) {
+		result, err := resolver.GetClass(p.Context, principal, params)
+		if err != nil {
+			return result, enterrors.NewErrGraphQLUser(err, "Get", params.ClassName)
+		}
+		return result, nil
+	}, nil
+}
+
+func (r *resolver) makeResolveGetFacets(className string) graphql.FieldResolveFn {
+	return func(p graphql.ResolveParams) (interface{}, error) {
+		result, err := r.resolveGetFacets(p, className)
+		if err != nil {
+			return result, enterrors.NewErrGraphQLUser(err, "Get", className)
+		}
+		return result, nil
+	}
+}
+
+// resolveGetFacets counts the facets of a search, the selection set is the
+// one of the facets rather than the one of the class
+func (r *resolver) resolveGetFacets(p graphql.ResolveParams, className string) (interface{}, error) {
+	principal := principalFromContext(p.Context)
+
+	source, ok := p.Source.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("expected graphql root to be a map, but was %T", p.Source)
+	}
+
+	resolver, ok := source["Resolver"].(FacetsResolver)
+	if !ok {
+		return nil, fmt.Errorf("expected source map to have a usable FacetsResolver, but got %#v", source["Resolver"])
+	}
+
+	params, err := r.extractGetParams(p, className, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return func() (interface{}, error) {
+		result, err := resolver.GetFacets(p.Context, principal, params)
+		if err != nil {
+			return result, enterrors.NewErrGraphQLUser(err, "Get", params.ClassName)
+		}
+		return result, nil
+	}, nil
+}
+
+// extractGetParams extracts the params of a Get query from the arguments.
+// The properties and additional properties are selected from the selection
+// set, if withProperties is set.
+func (r *resolver) extractGetParams(p graphql.ResolveParams, className string,
+	withProperties bool,
+) (dto.GetParams, error) {
+	principal := principalFromContext(p.Context)
+
 	var tenant string
 	if tk, ok := p.Args["tenant"]; ok {
 		tenant = tk.(string)
 	}
 
 	if err := r.authorizer.Authorize(principal, authorization.READ, authorization.ShardsData(className, tenant)...); err != nil {
-		return nil, err
+		return dto.GetParams{}, err
 	}
 
 	pagination, err := filters.ExtractPaginationFromArgs(p.Args)
 	if err != nil {
-		return nil, err
+		return dto.GetParams{}, err
 	}
 
 	cursor, err := filters.ExtractCursorFromArgs(p.Args)
 	if err != nil {
-		return nil, err
+		return dto.GetParams{}, err
 	}
 
-	// There can only be exactly one ast.Field; it is the class name.
-	if len(p.Info.FieldASTs) != 1 {
-		panic("Only one Field expected here")
-	}
+	var properties, groupByProperties search.SelectProperties
+	var addlProps additional.Properties
+	if withProperties {
+		// There can only be exactly one ast.Field; it is the class name.
+		if len(p.Info.FieldASTs) != 1 {
+			panic("Only one Field expected here")
+		}
 
-	selectionsOfClass := p.Info.FieldASTs[0].SelectionSet
+		selectionsOfClass := p.Info.FieldASTs[0].SelectionSet
 
-	properties, addlProps, groupByProperties, err := extractProperties(
-		className, selectionsOfClass, p.Info.Fragments, r.modulesProvider)
-	if err != nil {
-		return nil, err
-	}
-	allPropsToAuthorize := append(properties, groupByProperties...)
-	for _, property := range allPropsToAuthorize {
-		if err := common_filters.AuthorizeProperty(r.authorizer, &property, principal); err != nil {
-			return nil, err
+		properties, addlProps, groupByProperties, err = extractProperties(
+			className, selectionsOfClass, p.Info.Fragments, r.modulesProvider)
+		if err != nil {
+			return dto.GetParams{}, err
+		}
+		allPropsToAuthorize := append(properties, groupByProperties...)
+		for _, property := range allPropsToAuthorize {
+			if err := common_filters.AuthorizeProperty(r.authorizer, &property, principal); err != nil {
+				return dto.GetParams{}, err
+			}
 		}
 	}
 
@@ -387,11 +453,11 @@ func (r *resolver) resolveGet(p graphql.ResolveParams, className string) (interf
 
 	filters, err := common_filters.ExtractFilters(p.Args, p.Info.FieldName)
 	if err != nil {
-		return nil, fmt.Errorf("could not extract filters: %s", err)
+		return dto.GetParams{}, fmt.Errorf("could not extract filters: %s", err)
 	}
 	if filters != nil {
 		if err = common_filters.AuthorizeFilters(r.authorizer, filters.Root, principal); err != nil {
-			return nil, err
+			return dto.GetParams{}, err
 		}
 	}
 
@@ -400,17 +466,26 @@ func (r *resolver) resolveGet(p graphql.ResolveParams, className string) (interf
 	if nearVector, ok := p.Args["nearVector"]; ok {
 		p, targetCombination, err := common_filters.ExtractNearVector(nearVector.(map[string]interface{}), nil)
 		if err != nil {
-			return nil, fmt.Errorf("failed to extract nearVector params: %s", err)
+			return dto.GetParams{}, fmt.Errorf("failed to extract nearVector params: %s", err)
 		}
 		nearVectorParams = &p
 		targetVectorCombination = targetCombination
 	}
 
+	var nearVectorBatchParams *searchparams.NearVectorBatch
+	if nearVectorBatch, ok := p.Args["nearVectorBatch"]; ok {
+		p, err := common_filters.ExtractNearVectorBatch(nearVectorBatch.(map[string]interface{}))
+		if err != nil {
+			return dto.GetParams{}, fmt.Errorf("failed to extract nearVectorBatch params: %s", err)
+		}
+		nearVectorBatchParams = &p
+	}
+
 	var nearObjectParams *searchparams.NearObject
 	if nearObject, ok := p.Args["nearObject"]; ok {
 		p, targetCombination, err := common_filters.ExtractNearObject(nearObject.(map[string]interface{}))
 		if err != nil {
-			return nil, fmt.Errorf("failed to extract nearObject params: %s", err)
+			return dto.GetParams{}, fmt.Errorf("failed to extract nearObject params: %s", err)
 		}
 		nearObjectParams = &p
 		targetVectorCombination = targetCombination
@@ -433,7 +508,7 @@ func (r *resolver) resolveGet(p graphql.ResolveParams, className string) (interf
 	var keywordRankingParams *searchparams.KeywordRanking
 	if bm25, ok := p.Args["bm25"]; ok {
 		if len(sort) > 0 {
-			return nil, fmt.Errorf("bm25 search is not compatible with sort")
+			return dto.GetParams{}, fmt.Errorf("bm25 search is not compatible with sort")
 		}
 		p := common_filters.ExtractBM25(bm25.(map[string]interface{}), addlProps.ExplainScore)
 		keywordRankingParams = &p
@@ -445,11 +520,11 @@ func (r *resolver) resolveGet(p graphql.ResolveParams, className string) (interf
 	var hybridParams *searchparams.HybridSearch
 	if hybrid, ok := p.Args["hybrid"]; ok {
 		if len(sort) > 0 {
-			return nil, fmt.Errorf("hybrid search is not compatible with sort")
+			return dto.GetParams{}, fmt.Errorf("hybrid search is not compatible with sort")
 		}
 		p, targetCombination, err := common_filters.ExtractHybridSearch(hybrid.(map[string]interface{}), addlProps.ExplainScore)
 		if err != nil {
-			return nil, fmt.Errorf("failed to extract hybrid params: %w", err)
+			return dto.GetParams{}, fmt.Errorf("failed to extract hybrid params: %w", err)
 		}
 		hybridParams = p
 		targetVectorCombination = targetCombination
@@ -471,6 +546,11 @@ func (r *resolver) resolveGet(p graphql.ResolveParams, className string) (interf
 		groupByParams = &p
 	}
 
+	facets, err := extractFacets(p.Args)
+	if err != nil {
+		return dto.GetParams{}, err
+	}
+
 	params := dto.GetParams{
 		Filters:                 filters,
 		ClassName:               className,
@@ -479,6 +559,7 @@ func (r *resolver) resolveGet(p graphql.ResolveParams, className string) (interf
 		Properties:              properties,
 		Sort:                    sort,
 		NearVector:              nearVectorParams,
+		NearVectorBatch:         nearVectorBatchParams,
 		NearObject:              nearObjectParams,
 		Group:                   group,
 		ModuleParams:            moduleParams,
@@ -487,6 +568,7 @@ func (r *resolver) resolveGet(p graphql.ResolveParams, className string) (interf
 		HybridSearch:            hybridParams,
 		ReplicationProperties:   replProps,
 		GroupBy:                 groupByParams,
+		Facets:                  facets,
 		Tenant:                  tenant,
 		TargetVectorCombination: targetVectorCombination,
 	}
@@ -495,13 +577,7 @@ func (r *resolver) resolveGet(p graphql.ResolveParams, className string) (interf
 	// under certain conditions
 	setLimitBasedOnVectorSearchParams(&params)
 
-	return func() (interface{}, error) {
-		result, err := resolver.GetClass(p.Context, principal, params)
-		if err != nil {
-			return result, enterrors.NewErrGraphQLUser(err, "Get", params.ClassName)
-		}
-		return result, nil
-	}, nil
+	return params, nil
 }
 
 // the limit needs to be set according to the vector search parameters.
@@ -530,6 +606,12 @@ func setLimitBasedOnVectorSearchParams(params *dto.GetParams) {
 		return
 	}
 
+	if params.NearVectorBatch != nil &&
+		(params.NearVectorBatch.Certainty != 0 || params.NearVectorBatch.WithDistance) {
+		setLimit(params)
+		return
+	}
+
 	if params.NearObject != nil &&
 		(params.NearObject.Certainty != 0 || params.NearObject.WithDistance) {
 		setLimit(params)
@@ -598,7 +680,8 @@ func (ac *additionalCheck) isAdditional(parentName, name string) bool {
 			name == "distance" || name == "id" || name == "vector" || name == "vectors" ||
 			name == "creationTimeUnix" || name == "lastUpdateTimeUnix" ||
 			name == "score" || name == "explainScore" || name == "isConsistent" ||
-			name == "group" {
+			name == "group" || name == "highlights" || name == "queryIndex" ||
+			name == "queryPlan" {
 			return true
 		}
 		if ac.isModuleAdditional(name) {
@@ -713,6 +796,18 @@ func extractProperties(className string, selections *ast.SelectionSet,
 							additionalProps.IsConsistent = true
 							continue
 						}
+						if additionalProperty == "highlights" {
+							additionalProps.Highlights = true
+							continue
+						}
+						if additionalProperty == "queryIndex" {
+							additionalProps.QueryIndex = true
+							continue
+						}
+						if additionalProperty == "queryPlan" {
+							additionalProps.QueryPlan = true
+							continue
+						}
 						if additionalProperty == "group" {
 							additionalProps.Group = true
 							var err error
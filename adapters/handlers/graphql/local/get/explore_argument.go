@@ -21,11 +21,35 @@ import (
 )
 
 func nearVectorArgument(className string) *graphql.ArgumentConfig {
-	return common_filters.NearVectorArgument("GetObjects", className, true)
+	prefix := fmt.Sprintf("GetObjects%s", className)
+	fields := common_filters.NearVectorFields(prefix, true)
+	fields["after"] = common_filters.RangeCursorField(prefix + "NearVector")
+	return &graphql.ArgumentConfig{
+		Type: graphql.NewInputObject(
+			graphql.InputObjectConfig{
+				Name:   fmt.Sprintf("%sNearVectorInpObj", prefix),
+				Fields: fields,
+			},
+		),
+	}
+}
+
+func nearVectorBatchArgument(className string) *graphql.ArgumentConfig {
+	return common_filters.NearVectorBatchArgument("GetObjects", className)
 }
 
 func nearObjectArgument(className string) *graphql.ArgumentConfig {
-	return common_filters.NearObjectArgument("GetObjects", className, true)
+	prefix := fmt.Sprintf("GetObjects%s", className)
+	fields := common_filters.NearObjectFields(prefix, true)
+	fields["after"] = common_filters.RangeCursorField(prefix + "NearObject")
+	return &graphql.ArgumentConfig{
+		Type: graphql.NewInputObject(
+			graphql.InputObjectConfig{
+				Name:   fmt.Sprintf("%sNearObjectInpObj", prefix),
+				Fields: fields,
+			},
+		),
+	}
 }
 
 func nearTextFields(prefix string) graphql.InputObjectConfigFieldMap {
@@ -0,0 +1,146 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package get
+
+import (
+	"fmt"
+
+	"github.com/tailor-inc/graphql"
+	"github.com/weaviate/weaviate/adapters/handlers/graphql/descriptions"
+	"github.com/weaviate/weaviate/entities/aggregation"
+	"github.com/weaviate/weaviate/entities/facets"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+)
+
+// buildGetFacetsField builds the field of a class in Get { _facets }. It
+// takes the arguments of the class field which select the objects of a
+// search, the facets are counted among these objects. The facets don't
+// depend on the results, so they are returned even if there are none.
+func buildGetFacetsField(class *models.Class, classField *graphql.Field,
+	authorizer authorization.Authorizer, modulesProvider ModulesProvider,
+) *graphql.Field {
+	args := graphql.FieldConfigArgument{
+		"facets": facetsArgument(class.Class),
+	}
+	for name, arg := range classField.Args {
+		switch name {
+		case "after", "limit", "offset", "autocut", "sort", "group", "groupBy",
+			"nearVectorBatch", "consistencyLevel":
+			// don't change the objects the facets are counted among
+		default:
+			args[name] = arg
+		}
+	}
+
+	prefix := fmt.Sprintf("GetObjects%s", class.Class)
+	return &graphql.Field{
+		Description: descriptions.Facets,
+		Type: graphql.NewList(graphql.NewObject(graphql.ObjectConfig{
+			Name: fmt.Sprintf("%sFacets", prefix),
+			Fields: graphql.Fields{
+				"property": &graphql.Field{Type: graphql.String},
+				"values": &graphql.Field{Type: graphql.NewList(graphql.NewObject(graphql.ObjectConfig{
+					Name: fmt.Sprintf("%sFacetsValues", prefix),
+					Fields: graphql.Fields{
+						"value": &graphql.Field{Type: graphql.String},
+						"from":  &graphql.Field{Type: graphql.Float},
+						"to":    &graphql.Field{Type: graphql.Float},
+						"count": &graphql.Field{Type: graphql.Int},
+					},
+				}))},
+			},
+		})),
+		Args:    args,
+		Resolve: newResolver(authorizer, modulesProvider).makeResolveGetFacets(class.Class),
+	}
+}
+
+func facetsArgument(className string) *graphql.ArgumentConfig {
+	prefix := fmt.Sprintf("GetObjects%s", className)
+	return &graphql.ArgumentConfig{
+		Description: descriptions.Facets,
+		Type: graphql.NewNonNull(graphql.NewList(graphql.NewInputObject(graphql.InputObjectConfig{
+			Name: fmt.Sprintf("%sFacetInpObj", prefix),
+			Fields: graphql.InputObjectConfigFieldMap{
+				"property": &graphql.InputObjectFieldConfig{
+					Description: descriptions.FacetsProperty,
+					Type:        graphql.NewNonNull(graphql.String),
+				},
+				"limit": &graphql.InputObjectFieldConfig{
+					Description: descriptions.FacetsLimit,
+					Type:        graphql.Int,
+				},
+				"ranges": &graphql.InputObjectFieldConfig{
+					Description: descriptions.FacetsRanges,
+					Type: graphql.NewList(graphql.NewInputObject(graphql.InputObjectConfig{
+						Name: fmt.Sprintf("%sFacetRangeInpObj", prefix),
+						Fields: graphql.InputObjectConfigFieldMap{
+							"from": &graphql.InputObjectFieldConfig{
+								Description: descriptions.FacetsFrom,
+								Type:        graphql.Float,
+							},
+							"to": &graphql.InputObjectFieldConfig{
+								Description: descriptions.FacetsTo,
+								Type:        graphql.Float,
+							},
+						},
+					})),
+				},
+			},
+		}))),
+	}
+}
+
+func extractFacets(args map[string]interface{}) ([]facets.Facet, error) {
+	facetsArg, ok := args["facets"]
+	if !ok {
+		return nil, nil
+	}
+
+	list, ok := facetsArg.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("facets must be a list, instead got: %#v", facetsArg)
+	}
+
+	out := make([]facets.Facet, len(list))
+	for i, elem := range list {
+		arg, ok := elem.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("facets[%d] must be an object, instead got: %#v", i, elem)
+		}
+
+		out[i].Property, _ = arg["property"].(string)
+		if limit, ok := arg["limit"].(int); ok {
+			out[i].Limit = limit
+		}
+		if ranges, ok := arg["ranges"].([]interface{}); ok {
+			for _, r := range ranges {
+				bounds, _ := r.(map[string]interface{})
+				out[i].Ranges = append(out[i].Ranges, aggregation.GroupByRange{
+					From: optionalFloat64(bounds["from"]),
+					To:   optionalFloat64(bounds["to"]),
+				})
+			}
+		}
+	}
+
+	return out, nil
+}
+
+func optionalFloat64(value interface{}) *float64 {
+	f, ok := value.(float64)
+	if !ok {
+		return nil
+	}
+	return &f
+}
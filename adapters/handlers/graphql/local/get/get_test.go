@@ -25,7 +25,9 @@ import (
 	"github.com/tailor-inc/graphql/language/ast"
 	test_helper "github.com/weaviate/weaviate/adapters/handlers/graphql/test/helper"
 	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/aggregation"
 	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/facets"
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/search"
@@ -477,6 +479,71 @@ func TestExtractAdditionalFields(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:  "with _additional highlights",
+			query: "{ Get { SomeAction { _additional { highlights { property fragments } } } } }",
+			expectedParams: dto.GetParams{
+				ClassName: "SomeAction",
+				AdditionalProperties: additional.Properties{
+					Highlights: true,
+				},
+			},
+			resolverReturn: []interface{}{
+				map[string]interface{}{
+					"_additional": map[string]interface{}{
+						"highlights": []*additional.Highlight{
+							{Property: "description", Fragments: []string{"a <em>match</em>"}},
+						},
+					},
+				},
+			},
+			expectedResult: map[string]interface{}{
+				"_additional": map[string]interface{}{
+					"highlights": []interface{}{
+						map[string]interface{}{
+							"property":  "description",
+							"fragments": []interface{}{"a <em>match</em>"},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:  "with _additional queryPlan",
+			query: "{ Get { SomeAction { _additional { queryPlan { shard strategy selectivity allowListSize indexSize estimatedCost } } } } }",
+			expectedParams: dto.GetParams{
+				ClassName: "SomeAction",
+				AdditionalProperties: additional.Properties{
+					QueryPlan: true,
+				},
+			},
+			resolverReturn: []interface{}{
+				map[string]interface{}{
+					"_additional": map[string]interface{}{
+						"queryPlan": []*additional.QueryPlan{
+							{
+								Shard: "shard1", Strategy: "acorn", Selectivity: 0.25,
+								AllowListSize: 250, IndexSize: 1000, EstimatedCost: 1200,
+							},
+						},
+					},
+				},
+			},
+			expectedResult: map[string]interface{}{
+				"_additional": map[string]interface{}{
+					"queryPlan": []interface{}{
+						map[string]interface{}{
+							"shard":         "shard1",
+							"strategy":      "acorn",
+							"selectivity":   0.25,
+							"allowListSize": 250,
+							"indexSize":     1000,
+							"estimatedCost": float64(1200),
+						},
+					},
+				},
+			},
+		},
 		{
 			name:  "with _additional classification",
 			query: "{ Get { SomeAction { _additional { classification { id completed classifiedFields scope basedOn }  } } } }",
@@ -1521,6 +1588,151 @@ func TestNearVectorRanker(t *testing.T) {
 	})
 }
 
+func TestNearVectorBatch(t *testing.T) {
+	t.Parallel()
+
+	resolver := newMockResolver()
+
+	t.Run("with query index", func(t *testing.T) {
+		query := `{ Get { SomeThing(nearVectorBatch: {
+								vectors: [[0.123, 0.984], [0.5, 0.1]]
+								targetVector: "custom"
+							}) { intField _additional { queryIndex } } } }`
+
+		expectedParams := dto.GetParams{
+			ClassName:  "SomeThing",
+			Properties: []search.SelectProperty{{Name: "intField", IsPrimitive: true}},
+			NearVectorBatch: &searchparams.NearVectorBatch{
+				Vectors:      [][]float32{{0.123, 0.984}, {0.5, 0.1}},
+				TargetVector: "custom",
+			},
+			AdditionalProperties: additional.Properties{QueryIndex: true},
+		}
+
+		resolver.On("GetClass", expectedParams).
+			Return([]interface{}{}, nil).Once()
+
+		resolver.AssertResolve(t, query)
+	})
+
+	t.Run("with distance", func(t *testing.T) {
+		query := `{ Get { SomeThing(nearVectorBatch: {
+								vectors: [[0.123, 0.984]]
+								distance: 0.4
+							}) { intField } } }`
+
+		expectedParams := dto.GetParams{
+			ClassName:  "SomeThing",
+			Properties: []search.SelectProperty{{Name: "intField", IsPrimitive: true}},
+			Pagination: &filters.Pagination{Limit: filters.LimitFlagSearchByDist},
+			NearVectorBatch: &searchparams.NearVectorBatch{
+				Vectors:      [][]float32{{0.123, 0.984}},
+				Distance:     0.4,
+				WithDistance: true,
+			},
+		}
+
+		resolver.On("GetClass", expectedParams).
+			Return([]interface{}{}, nil).Once()
+
+		resolver.AssertResolve(t, query)
+	})
+
+	t.Run("with distance and certainty", func(t *testing.T) {
+		query := `{ Get { SomeThing(nearVectorBatch: {
+								vectors: [[0.123, 0.984]]
+								distance: 0.4
+								certainty: 0.6
+							}) { intField } } }`
+
+		resolver.AssertFailToResolve(t, query)
+	})
+}
+
+func TestGetFacets(t *testing.T) {
+	t.Parallel()
+
+	t.Run("facets are counted among the objects of the search", func(t *testing.T) {
+		resolver := newMockResolver()
+		expectedParams := dto.GetParams{
+			ClassName: "SomeAction",
+			Filters:   nil,
+			KeywordRanking: &searchparams.KeywordRanking{
+				Type: "bm25", Query: "apple",
+			},
+			Facets: []facets.Facet{
+				{Property: "textField", Limit: 2},
+				{Property: "intField", Ranges: []aggregation.GroupByRange{{To: ptFloat64(10)}, {From: ptFloat64(10)}}},
+			},
+		}
+		resolver.On("GetFacets", expectedParams).Return([]facets.Result{
+			{Property: "textField", Values: []facets.Value{{Value: "foo", Count: 3}, {Value: "bar", Count: 1}}},
+			{Property: "intField", Values: []facets.Value{{To: ptFloat64(10), Count: 2}, {From: ptFloat64(10), Count: 2}}},
+		}, nil).Once()
+
+		query := `{ Get { _facets { SomeAction(bm25: {query: "apple"},
+			facets: [{property: "textField", limit: 2}, {property: "intField", ranges: [{to: 10}, {from: 10}]}])
+			{ property values { value from to count } } } } }`
+		result := resolver.AssertResolve(t, query)
+
+		assert.Equal(t, []interface{}{
+			map[string]interface{}{
+				"property": "textField",
+				"values": []interface{}{
+					map[string]interface{}{"value": "foo", "from": nil, "to": nil, "count": 3},
+					map[string]interface{}{"value": "bar", "from": nil, "to": nil, "count": 1},
+				},
+			},
+			map[string]interface{}{
+				"property": "intField",
+				"values": []interface{}{
+					map[string]interface{}{"value": nil, "from": nil, "to": float64(10), "count": 2},
+					map[string]interface{}{"value": nil, "from": float64(10), "to": nil, "count": 2},
+				},
+			},
+		}, result.Get("Get", "_facets", "SomeAction").Result)
+	})
+
+	t.Run("facets are returned next to an empty page of results", func(t *testing.T) {
+		resolver := newMockResolver()
+		resolver.On("GetClass", dto.GetParams{
+			ClassName:  "SomeAction",
+			Properties: []search.SelectProperty{{Name: "intField", IsPrimitive: true}},
+			Pagination: &filters.Pagination{Offset: 20, Limit: 10},
+		}).Return(test_helper.EmptyList(), nil).Once()
+		resolver.On("GetFacets", dto.GetParams{
+			ClassName: "SomeAction",
+			Facets:    []facets.Facet{{Property: "textField"}},
+		}).Return([]facets.Result{
+			{Property: "textField", Values: []facets.Value{{Value: "foo", Count: 3}}},
+		}, nil).Once()
+
+		query := `{ Get {
+			SomeAction(offset: 20, limit: 10) { intField }
+			_facets { SomeAction(facets: [{property: "textField"}]) { property values { value count } } } } }`
+		result := resolver.AssertResolve(t, query)
+
+		assert.Empty(t, result.Get("Get", "SomeAction").Result)
+		assert.Equal(t, []interface{}{
+			map[string]interface{}{
+				"property": "textField",
+				"values":   []interface{}{map[string]interface{}{"value": "foo", "count": 3}},
+			},
+		}, result.Get("Get", "_facets", "SomeAction").Result)
+	})
+
+	t.Run("facets are required", func(t *testing.T) {
+		resolver := newMockResolver()
+		resolver.AssertFailToResolve(t, `{ Get { _facets { SomeAction { property } } } }`)
+	})
+
+	t.Run("pagination doesn't apply to facets", func(t *testing.T) {
+		resolver := newMockResolver()
+		resolver.AssertFailToResolve(t,
+			`{ Get { _facets { SomeAction(limit: 1, facets: [{property: "textField"}]) { property } } } }`)
+	})
+}
+
 func TestExtractPagination(t *testing.T) {
 	t.Parallel()
 
@@ -2435,6 +2647,33 @@ func TestNearObjectNoModules(t *testing.T) {
 
 		resolver.AssertResolve(t, query)
 	})
+
+	t.Run("for things with distance and after set", func(t *testing.T) {
+		query := `{ Get { SomeThing(nearObject: {
+								id: "123"
+								distance: 0.4
+								after: {distance: 0.25, id: "c6f85bf5-c3b7-4c1d-bd51-e899f9605336"}
+							}) { intField } } }`
+
+		expectedParams := dto.GetParams{
+			ClassName:  "SomeThing",
+			Properties: []search.SelectProperty{{Name: "intField", IsPrimitive: true}},
+			Pagination: &filters.Pagination{Limit: filters.LimitFlagSearchByDist},
+			NearObject: &searchparams.NearObject{
+				ID:           "123",
+				Distance:     0.4,
+				WithDistance: true,
+				After: &searchparams.RangeCursor{
+					Distance: 0.25,
+					ID:       "c6f85bf5-c3b7-4c1d-bd51-e899f9605336",
+				},
+			},
+		}
+		resolver.On("GetClass", expectedParams).
+			Return([]interface{}{}, nil).Once()
+
+		resolver.AssertResolve(t, query)
+	})
 }
 
 func TestNearVectorNoModules(t *testing.T) {
@@ -2483,6 +2722,50 @@ func TestNearVectorNoModules(t *testing.T) {
 		resolver.AssertResolve(t, query)
 	})
 
+	t.Run("for things with distance and after set", func(t *testing.T) {
+		query := `{ Get { SomeThing(nearVector: {
+								vector: [0.123, 0.984]
+								distance: 0.4
+								after: {distance: 0.25, id: "C6F85BF5-C3B7-4C1D-BD51-E899F9605336"}
+							}) { intField } } }`
+
+		expectedParams := dto.GetParams{
+			ClassName:  "SomeThing",
+			Properties: []search.SelectProperty{{Name: "intField", IsPrimitive: true}},
+			Pagination: &filters.Pagination{Limit: filters.LimitFlagSearchByDist},
+			NearVector: &searchparams.NearVector{
+				Vectors:      [][]float32{{0.123, 0.984}},
+				Distance:     0.4,
+				WithDistance: true,
+				After: &searchparams.RangeCursor{
+					Distance: 0.25,
+					ID:       "c6f85bf5-c3b7-4c1d-bd51-e899f9605336",
+				},
+			},
+		}
+		resolver.On("GetClass", expectedParams).
+			Return([]interface{}{}, nil).Once()
+
+		resolver.AssertResolve(t, query)
+	})
+
+	t.Run("after without distance or certainty", func(t *testing.T) {
+		query := `{ Get { SomeThing(nearVector: {
+								vector: [0.123, 0.984]
+								after: {distance: 0.25, id: "c6f85bf5-c3b7-4c1d-bd51-e899f9605336"}
+							}) { intField } } }`
+		resolver.AssertFailToResolve(t, query)
+	})
+
+	t.Run("after with an invalid id", func(t *testing.T) {
+		query := `{ Get { SomeThing(nearVector: {
+								vector: [0.123, 0.984]
+								distance: 0.4
+								after: {distance: 0.25, id: "not-a-uuid"}
+							}) { intField } } }`
+		resolver.AssertFailToResolve(t, query)
+	})
+
 	t.Run("for things with optional certainty set", func(t *testing.T) {
 		query := `{ Get { SomeThing(nearVector: {
 								vector: [0.123, 0.984]
@@ -2696,6 +2979,54 @@ func TestSort(t *testing.T) {
 
 				tt.resolver.AssertResolve(t, query)
 			})
+
+			t.Run("groupBy with strategy and sort", func(t *testing.T) {
+				query := `{ Get {
+					SomeAction(
+						groupBy:{path: ["path"] groups: 2 objectsPerGroup:3 strategy: diverse sort: {by: score aggregation: avg}}
+					) {
+						_additional{group{count groupedBy {value path} hits {_additional{distance score}}}
+						}
+					} } }`
+
+				expectedParams := dto.GetParams{
+					ClassName: "SomeAction",
+					GroupBy: &searchparams.GroupBy{
+						Property: "path", Groups: 2, ObjectsPerGroup: 3, Properties: search.SelectProperties{},
+						Strategy: searchparams.GroupByStrategyDiverse,
+						Sort:     &searchparams.GroupBySort{By: searchparams.GroupBySortScore, Aggregation: searchparams.GroupByScoreAvg},
+					},
+					AdditionalProperties: additional.Properties{Group: true},
+				}
+
+				tt.resolver.On("GetClass", expectedParams).
+					Return([]interface{}{}, nil).Once()
+
+				tt.resolver.AssertResolve(t, query)
+			})
+
+			t.Run("groupBy sorted by property", func(t *testing.T) {
+				query := `{ Get {
+					SomeAction(
+						groupBy:{path: ["path"] groups: 2 objectsPerGroup:3 sort: {by: property descending: true}}
+					) {
+						_additional{group{count groupedBy {value path}}}
+					} } }`
+
+				expectedParams := dto.GetParams{
+					ClassName: "SomeAction",
+					GroupBy: &searchparams.GroupBy{
+						Property: "path", Groups: 2, ObjectsPerGroup: 3, Properties: search.SelectProperties{},
+						Sort: &searchparams.GroupBySort{By: searchparams.GroupBySortProperty, Descending: true},
+					},
+					AdditionalProperties: additional.Properties{Group: true},
+				}
+
+				tt.resolver.On("GetClass", expectedParams).
+					Return([]interface{}{}, nil).Once()
+
+				tt.resolver.AssertResolve(t, query)
+			})
 		})
 	}
 }
@@ -2746,6 +3077,10 @@ func ptFloat32(in float32) *float32 {
 	return &in
 }
 
+func ptFloat64(in float64) *float64 {
+	return &in
+}
+
 func timeMust(t strfmt.DateTime, err error) strfmt.DateTime {
 	if err != nil {
 		panic(err)
@@ -16,6 +16,7 @@ import (
 
 	"github.com/tailor-inc/graphql"
 	"github.com/weaviate/weaviate/adapters/handlers/graphql/descriptions"
+	"github.com/weaviate/weaviate/entities/searchparams"
 )
 
 func groupByArgument(className string) *graphql.ArgumentConfig {
@@ -45,5 +46,50 @@ func groupByFields(prefix string) graphql.InputObjectConfigFieldMap {
 			Description: descriptions.GroupByObjectsPerGroup,
 			Type:        graphql.NewNonNull(graphql.Int),
 		},
+		"strategy": &graphql.InputObjectFieldConfig{
+			Description: descriptions.GroupByStrategy,
+			Type: graphql.NewEnum(graphql.EnumConfig{
+				Name: fmt.Sprintf("%sGroupByInpObjStrategyEnum", prefix),
+				Values: graphql.EnumValueConfigMap{
+					searchparams.GroupByStrategyClosest: &graphql.EnumValueConfig{},
+					searchparams.GroupByStrategyDiverse: &graphql.EnumValueConfig{},
+				},
+			}),
+		},
+		"sort": &graphql.InputObjectFieldConfig{
+			Description: descriptions.GroupBySort,
+			Type: graphql.NewInputObject(graphql.InputObjectConfig{
+				Name: fmt.Sprintf("%sGroupBySortInpObj", prefix),
+				Fields: graphql.InputObjectConfigFieldMap{
+					"by": &graphql.InputObjectFieldConfig{
+						Description: descriptions.GroupBySortBy,
+						Type: graphql.NewNonNull(graphql.NewEnum(graphql.EnumConfig{
+							Name: fmt.Sprintf("%sGroupBySortInpObjByEnum", prefix),
+							Values: graphql.EnumValueConfigMap{
+								searchparams.GroupBySortDistance: &graphql.EnumValueConfig{},
+								searchparams.GroupBySortScore:    &graphql.EnumValueConfig{},
+								searchparams.GroupBySortProperty: &graphql.EnumValueConfig{},
+								searchparams.GroupBySortSize:     &graphql.EnumValueConfig{},
+							},
+						})),
+					},
+					"aggregation": &graphql.InputObjectFieldConfig{
+						Description: descriptions.GroupBySortAggregation,
+						Type: graphql.NewEnum(graphql.EnumConfig{
+							Name: fmt.Sprintf("%sGroupBySortInpObjAggregationEnum", prefix),
+							Values: graphql.EnumValueConfigMap{
+								searchparams.GroupByScoreMax: &graphql.EnumValueConfig{},
+								searchparams.GroupByScoreAvg: &graphql.EnumValueConfig{},
+								searchparams.GroupByScoreSum: &graphql.EnumValueConfig{},
+							},
+						}),
+					},
+					"descending": &graphql.InputObjectFieldConfig{
+						Description: descriptions.GroupBySortDescending,
+						Type:        graphql.Boolean,
+					},
+				},
+			}),
+		},
 	}
 }
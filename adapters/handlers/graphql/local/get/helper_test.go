@@ -23,6 +23,7 @@ import (
 	"github.com/weaviate/weaviate/adapters/handlers/graphql/descriptions"
 	test_helper "github.com/weaviate/weaviate/adapters/handlers/graphql/test/helper"
 	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/facets"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/modulecapabilities"
 	"github.com/weaviate/weaviate/entities/moduletools"
@@ -686,6 +687,13 @@ func (m *mockResolver) GetClass(ctx context.Context, principal *models.Principal
 	return args.Get(0).([]interface{}), args.Error(1)
 }
 
+func (m *mockResolver) GetFacets(ctx context.Context, principal *models.Principal,
+	params dto.GetParams,
+) ([]facets.Result, error) {
+	args := m.Called(params)
+	return args.Get(0).([]facets.Result), args.Error(1)
+}
+
 type targetsAndVectors struct {
 	targets []string
 	vectors [][]float32
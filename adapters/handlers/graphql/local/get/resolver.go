@@ -15,6 +15,7 @@ import (
 	"context"
 
 	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/facets"
 	"github.com/weaviate/weaviate/entities/models"
 )
 
@@ -23,6 +24,11 @@ type Resolver interface {
 	GetClass(ctx context.Context, principal *models.Principal, info dto.GetParams) ([]interface{}, error)
 }
 
+// FacetsResolver counts the facets of a search, independently of its results
+type FacetsResolver interface {
+	GetFacets(ctx context.Context, principal *models.Principal, info dto.GetParams) ([]facets.Result, error)
+}
+
 // RequestsLog is a local abstraction on the RequestsLog that needs to be
 // provided to the graphQL API in order to log Local.Get queries.
 type RequestsLog interface {
@@ -0,0 +1,610 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package v1
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/weaviate/weaviate/entities/aggregation"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+)
+
+func (s *Service) Aggregate(ctx context.Context, req *pb.AggregateRequest) (*pb.AggregateReply, error) {
+	var result *pb.AggregateReply
+	var errInner error
+
+	if err := enterrors.GoWrapperWithBlock(func() {
+		result, errInner = s.aggregate(ctx, req)
+	}, s.logger); err != nil {
+		return nil, err
+	}
+
+	return result, errInner
+}
+
+func (s *Service) aggregate(ctx context.Context, req *pb.AggregateRequest) (*pb.AggregateReply, error) {
+	before := time.Now()
+
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("extract auth: %w", err)
+	}
+
+	if err := s.authorizer.Authorize(principal, authorization.READ, authorization.ShardsData(req.Collection, req.GetTenant())...); err != nil {
+		return nil, err
+	}
+
+	params, err := aggregateParamsFromProto(req, s.classGetterWithAuthzFunc(principal))
+	if err != nil {
+		return nil, fmt.Errorf("aggregate params: %w", err)
+	}
+
+	res, err := s.traverser.Aggregate(ctx, principal, params)
+	if err != nil {
+		return nil, err
+	}
+
+	typed, ok := res.(*aggregation.Result)
+	if !ok {
+		return nil, fmt.Errorf("unexpected aggregate result %T", res)
+	}
+	return aggregateReplyFromResult(typed, params, before)
+}
+
+func aggregateParamsFromProto(req *pb.AggregateRequest, authorizedGetClass func(string) (*models.Class, error)) (*aggregation.Params, error) {
+	class, err := authorizedGetClass(req.Collection)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &aggregation.Params{
+		ClassName:        schema.ClassName(req.Collection),
+		Tenant:           req.GetTenant(),
+		IncludeMetaCount: req.ObjectsCount,
+	}
+
+	for _, propIn := range req.Properties {
+		prop := aggregation.ParamProperty{Name: schema.PropertyName(schema.LowercaseFirstLetter(propIn.Name))}
+		for _, aggIn := range propIn.Aggregators {
+			agg, err := extractAggregator(aggIn)
+			if err != nil {
+				return nil, fmt.Errorf("property %s: %w", propIn.Name, err)
+			}
+			prop.Aggregators = append(prop.Aggregators, agg)
+		}
+		params.Properties = append(params.Properties, prop)
+	}
+
+	params.GroupByKeys, params.Limit, err = extractAggregateGroupBy(req.GroupBy, req.Collection)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Filters != nil {
+		clause, err := ExtractFilters(req.Filters, authorizedGetClass, req.Collection)
+		if err != nil {
+			return nil, err
+		}
+		filter := &filters.LocalFilter{Root: &clause}
+		if err := filters.ValidateFilters(authorizedGetClass, filter); err != nil {
+			return nil, err
+		}
+		params.Filters = filter
+	}
+
+	if err := extractAggregateSearch(req, class, params); err != nil {
+		return nil, err
+	}
+
+	if req.ObjectLimit != nil {
+		if *req.ObjectLimit == 0 {
+			return nil, fmt.Errorf("object_limit must be a positive integer")
+		}
+		if params.NearVector == nil && params.NearObject == nil && params.Hybrid == nil && len(params.ModuleParams) == 0 {
+			return nil, fmt.Errorf("object_limit can only be used with a near<Media> or hybrid search")
+		}
+		objectLimit := int(*req.ObjectLimit)
+		params.ObjectLimit = &objectLimit
+	}
+
+	return params, nil
+}
+
+// extractAggregateSearch sets the search restricting the aggregated objects.
+// Unlike a search, an aggregation is limited to a single target vector.
+func extractAggregateSearch(req *pb.AggregateRequest, class *models.Class, params *aggregation.Params) error {
+	targetVectors, _, _, err := extractTargetVectors(&pb.SearchRequest{
+		HybridSearch: req.HybridSearch,
+		NearVector:   req.NearVector,
+		NearObject:   req.NearObject,
+		NearText:     req.NearText,
+		NearImage:    req.NearImage,
+		NearAudio:    req.NearAudio,
+		NearVideo:    req.NearVideo,
+		NearDepth:    req.NearDepth,
+		NearThermal:  req.NearThermal,
+		NearImu:      req.NearImu,
+	}, class)
+	if err != nil {
+		return fmt.Errorf("extract target vectors: %w", err)
+	}
+	if len(targetVectors) > 1 {
+		return fmt.Errorf("aggregations support a single target vector, got %v", targetVectors)
+	}
+
+	limit := int(req.GetObjectLimit())
+	if nv := req.NearVector; nv != nil {
+		if params.NearVector, err = extractNearVector(nv, targetVectors); err != nil {
+			return err
+		}
+	}
+	if no := req.NearObject; no != nil {
+		if params.NearObject, err = extractNearObject(no, targetVectors); err != nil {
+			return err
+		}
+	}
+	if hs := req.HybridSearch; hs != nil {
+		if params.Hybrid, err = extractHybridSearch(hs, req.Collection, limit, targetVectors); err != nil {
+			return err
+		}
+	}
+
+	moduleParams := map[string]interface{}{}
+	if nt := req.NearText; nt != nil {
+		if moduleParams["nearText"], err = extractNearText(req.Collection, limit, nt, targetVectors); err != nil {
+			return err
+		}
+	}
+	if ni := req.NearImage; ni != nil {
+		if moduleParams["nearImage"], err = parseNearImage(ni, targetVectors); err != nil {
+			return err
+		}
+	}
+	if na := req.NearAudio; na != nil {
+		if moduleParams["nearAudio"], err = parseNearAudio(na, targetVectors); err != nil {
+			return err
+		}
+	}
+	if nv := req.NearVideo; nv != nil {
+		if moduleParams["nearVideo"], err = parseNearVideo(nv, targetVectors); err != nil {
+			return err
+		}
+	}
+	if nd := req.NearDepth; nd != nil {
+		if moduleParams["nearDepth"], err = parseNearDepth(nd, targetVectors); err != nil {
+			return err
+		}
+	}
+	if nt := req.NearThermal; nt != nil {
+		if moduleParams["nearThermal"], err = parseNearThermal(nt, targetVectors); err != nil {
+			return err
+		}
+	}
+	if ni := req.NearImu; ni != nil {
+		if moduleParams["nearIMU"], err = parseNearIMU(ni, targetVectors); err != nil {
+			return err
+		}
+	}
+	if len(moduleParams) > 0 {
+		params.ModuleParams = moduleParams
+	}
+
+	searches := len(moduleParams)
+	for _, set := range []bool{params.NearVector != nil, params.NearObject != nil, params.Hybrid != nil} {
+		if set {
+			searches++
+		}
+	}
+	if searches > 1 {
+		return fmt.Errorf("only one search can be set")
+	}
+	return nil
+}
+
+var aggregatorTypes = map[pb.Aggregator_Type]aggregation.Aggregator{
+	pb.Aggregator_TYPE_COUNT:            aggregation.CountAggregator,
+	pb.Aggregator_TYPE_TYPE:             aggregation.TypeAggregator,
+	pb.Aggregator_TYPE_MEAN:             aggregation.MeanAggregator,
+	pb.Aggregator_TYPE_MEDIAN:           aggregation.MedianAggregator,
+	pb.Aggregator_TYPE_MODE:             aggregation.ModeAggregator,
+	pb.Aggregator_TYPE_MAXIMUM:          aggregation.MaximumAggregator,
+	pb.Aggregator_TYPE_MINIMUM:          aggregation.MinimumAggregator,
+	pb.Aggregator_TYPE_SUM:              aggregation.SumAggregator,
+	pb.Aggregator_TYPE_TOTAL_TRUE:       aggregation.TotalTrueAggregator,
+	pb.Aggregator_TYPE_TOTAL_FALSE:      aggregation.TotalFalseAggregator,
+	pb.Aggregator_TYPE_PERCENTAGE_TRUE:  aggregation.PercentageTrueAggregator,
+	pb.Aggregator_TYPE_PERCENTAGE_FALSE: aggregation.PercentageFalseAggregator,
+	pb.Aggregator_TYPE_POINTING_TO:      aggregation.PointingToAggregator,
+	pb.Aggregator_TYPE_CARDINALITY:      aggregation.CardinalityAggregator,
+}
+
+func extractAggregator(in *pb.Aggregator) (aggregation.Aggregator, error) {
+	switch in.Type {
+	case pb.Aggregator_TYPE_TOP_OCCURRENCES:
+		limit := 5
+		if in.Limit != nil {
+			limit = int(*in.Limit)
+		}
+		return aggregation.NewTopOccurrencesAggregator(&limit), nil
+	case pb.Aggregator_TYPE_PERCENTILE:
+		return aggregation.NewPercentileAggregator(in.Quantiles)
+	case pb.Aggregator_TYPE_HISTOGRAM:
+		if in.Histogram == nil {
+			return aggregation.Aggregator{}, fmt.Errorf("histogram: an interval or bucket edges are required")
+		}
+		return aggregation.NewHistogramAggregator(in.Histogram.Interval, in.Histogram.Edges)
+	}
+
+	agg, ok := aggregatorTypes[in.Type]
+	if !ok {
+		return aggregation.Aggregator{}, fmt.Errorf("unknown aggregator %v", in.Type)
+	}
+	return agg, nil
+}
+
+func aggregateReplyFromResult(res *aggregation.Result, params *aggregation.Params, before time.Time) (*pb.AggregateReply, error) {
+	reply := &pb.AggregateReply{}
+
+	if len(params.GroupByLevels()) > 0 {
+		groups, err := aggregateGroupsToProto(res.Groups)
+		if err != nil {
+			return nil, err
+		}
+		reply.Result = &pb.AggregateReply_GroupedResults{
+			GroupedResults: &pb.AggregateReply_Grouped{Groups: groups},
+		}
+	} else {
+		single := &pb.AggregateReply_Single{}
+		if len(res.Groups) > 0 {
+			group := res.Groups[0]
+			if params.IncludeMetaCount {
+				count := int64(group.Count)
+				single.ObjectsCount = &count
+			}
+			props, err := aggregateResultsToProto(group.Properties)
+			if err != nil {
+				return nil, err
+			}
+			single.Properties = props
+		}
+		reply.Result = &pb.AggregateReply_SingleResult{SingleResult: single}
+	}
+
+	reply.Took = float32(time.Since(before).Seconds())
+	return reply, nil
+}
+
+var dateIntervals = map[pb.AggregateGroupBy_DateInterval]aggregation.DateInterval{
+	pb.AggregateGroupBy_DATE_INTERVAL_MINUTE:  aggregation.DateIntervalMinute,
+	pb.AggregateGroupBy_DATE_INTERVAL_HOUR:    aggregation.DateIntervalHour,
+	pb.AggregateGroupBy_DATE_INTERVAL_DAY:     aggregation.DateIntervalDay,
+	pb.AggregateGroupBy_DATE_INTERVAL_WEEK:    aggregation.DateIntervalWeek,
+	pb.AggregateGroupBy_DATE_INTERVAL_MONTH:   aggregation.DateIntervalMonth,
+	pb.AggregateGroupBy_DATE_INTERVAL_QUARTER: aggregation.DateIntervalQuarter,
+	pb.AggregateGroupBy_DATE_INTERVAL_YEAR:    aggregation.DateIntervalYear,
+}
+
+// extractAggregateGroupBy returns the group by keys and the maximum number of
+// groups on every level
+func extractAggregateGroupBy(groupBy *pb.AggregateGroupBy, className string) ([]aggregation.GroupByKey, *int, error) {
+	if groupBy == nil {
+		return nil, nil, nil
+	}
+
+	keys := make([]aggregation.GroupByKey, len(groupBy.Keys))
+	for i, keyIn := range groupBy.Keys {
+		segments := make([]interface{}, len(keyIn.Path))
+		for j := range keyIn.Path {
+			segments[j] = keyIn.Path[j]
+		}
+		path, err := filters.ParsePath(segments, className)
+		if err != nil {
+			return nil, nil, fmt.Errorf("group by key %d: %w", i, err)
+		}
+		keys[i].Path = path
+
+		switch derivation := keyIn.Derivation.(type) {
+		case *pb.AggregateGroupBy_Key_Ranges:
+			keys[i].Ranges = extractGroupByRanges(derivation.Ranges.GetRanges())
+		case *pb.AggregateGroupBy_Key_DateInterval:
+			interval, ok := dateIntervals[derivation.DateInterval]
+			if !ok {
+				return nil, nil, fmt.Errorf("group by key %d: unknown date interval %v", i, derivation.DateInterval)
+			}
+			keys[i].DateInterval = interval
+		case *pb.AggregateGroupBy_Key_GeoRings:
+			keys[i].GeoOrigin = &aggregation.GeoOrigin{
+				Latitude:  derivation.GeoRings.Latitude,
+				Longitude: derivation.GeoRings.Longitude,
+			}
+			keys[i].Ranges = extractGroupByRanges(derivation.GeoRings.Ranges)
+		case nil:
+		default:
+			return nil, nil, fmt.Errorf("group by key %d: unknown derivation %T", i, derivation)
+		}
+
+		if err := keys[i].Validate(); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	var limit *int
+	if groupBy.Limit != nil {
+		l := int(*groupBy.Limit)
+		limit = &l
+	}
+	return keys, limit, nil
+}
+
+func extractGroupByRanges(ranges []*pb.AggregateGroupBy_Range) []aggregation.GroupByRange {
+	out := make([]aggregation.GroupByRange, len(ranges))
+	for i, r := range ranges {
+		out[i] = aggregation.GroupByRange{From: r.From, To: r.To}
+	}
+	return out
+}
+
+func aggregateGroupsToProto(groups []aggregation.Group) ([]*pb.AggregateGroup, error) {
+	if len(groups) == 0 {
+		return nil, nil
+	}
+
+	out := make([]*pb.AggregateGroup, len(groups))
+	for i, group := range groups {
+		props, err := aggregateResultsToProto(group.Properties)
+		if err != nil {
+			return nil, err
+		}
+		nested, err := aggregateGroupsToProto(group.Groups)
+		if err != nil {
+			return nil, err
+		}
+		out[i] = &pb.AggregateGroup{
+			GroupedBy:  groupedByToProto(group.GroupedBy),
+			Count:      int64(group.Count),
+			Groups:     nested,
+			Properties: props,
+		}
+	}
+	return out, nil
+}
+
+func groupedByToProto(groupedBy *aggregation.GroupedBy) *pb.AggregateGroup_GroupedBy {
+	if groupedBy == nil {
+		return nil
+	}
+
+	out := &pb.AggregateGroup_GroupedBy{Path: groupedBy.Path}
+	switch val := groupedBy.Value.(type) {
+	case string:
+		out.Value = &pb.AggregateGroup_GroupedBy_Text{Text: val}
+	case float64:
+		out.Value = &pb.AggregateGroup_GroupedBy_Number{Number: val}
+	case int64:
+		out.Value = &pb.AggregateGroup_GroupedBy_Number{Number: float64(val)}
+	case bool:
+		out.Value = &pb.AggregateGroup_GroupedBy_Boolean{Boolean: val}
+	case nil:
+	default:
+		out.Value = &pb.AggregateGroup_GroupedBy_Text{Text: fmt.Sprint(val)}
+	}
+	if groupedBy.Range != nil {
+		out.From = groupedBy.Range.From
+		out.To = groupedBy.Range.To
+	}
+	return out
+}
+
+// aggregateResultsToProto converts the aggregations of the properties, sorted
+// by property name
+func aggregateResultsToProto(props map[string]aggregation.Property) ([]*pb.AggregateResult, error) {
+	if len(props) == 0 {
+		return nil, nil
+	}
+
+	names := make([]string, 0, len(props))
+	for name := range props {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	out := make([]*pb.AggregateResult, len(names))
+	for i, name := range names {
+		prop := props[name]
+		res := &pb.AggregateResult{Property: name}
+		if prop.SchemaType != "" {
+			res.Type = &prop.SchemaType
+		}
+
+		switch prop.Type {
+		case aggregation.PropertyTypeNumerical:
+			numerical, err := numericalToProto(prop.NumericalAggregations)
+			if err != nil {
+				return nil, fmt.Errorf("property %s: %w", name, err)
+			}
+			res.Aggregation = &pb.AggregateResult_Numerical_{Numerical: numerical}
+		case aggregation.PropertyTypeDate:
+			date, err := dateToProto(prop.DateAggregations)
+			if err != nil {
+				return nil, fmt.Errorf("property %s: %w", name, err)
+			}
+			res.Aggregation = &pb.AggregateResult_Date_{Date: date}
+		case aggregation.PropertyTypeText:
+			res.Aggregation = &pb.AggregateResult_Text_{Text: textToProto(prop.TextAggregation)}
+		case aggregation.PropertyTypeBoolean:
+			b := prop.BooleanAggregation
+			res.Aggregation = &pb.AggregateResult_Boolean_{Boolean: &pb.AggregateResult_Boolean{
+				Count:           int64(b.Count),
+				TotalTrue:       int64(b.TotalTrue),
+				TotalFalse:      int64(b.TotalFalse),
+				PercentageTrue:  b.PercentageTrue,
+				PercentageFalse: b.PercentageFalse,
+			}}
+		case aggregation.PropertyTypeReference:
+			res.Aggregation = &pb.AggregateResult_Reference_{Reference: &pb.AggregateResult_Reference{
+				PointingTo: prop.ReferenceAggregation.PointingTo,
+			}}
+		case "":
+			// only the type was requested
+		default:
+			return nil, fmt.Errorf("property %s: unknown aggregation type %s", name, prop.Type)
+		}
+		out[i] = res
+	}
+	return out, nil
+}
+
+func numericalToProto(aggs map[string]interface{}) (*pb.AggregateResult_Numerical, error) {
+	out := &pb.AggregateResult_Numerical{}
+	for name, value := range aggs {
+		if value == nil {
+			continue
+		}
+		var err error
+		switch name {
+		case aggregation.CountAggregator.Type:
+			out.Count, err = optionalInt64(value)
+		case aggregation.MeanAggregator.Type:
+			out.Mean, err = optionalFloat64(value)
+		case aggregation.MedianAggregator.Type:
+			out.Median, err = optionalFloat64(value)
+		case aggregation.ModeAggregator.Type:
+			out.Mode, err = optionalFloat64(value)
+		case aggregation.MaximumAggregator.Type:
+			out.Maximum, err = optionalFloat64(value)
+		case aggregation.MinimumAggregator.Type:
+			out.Minimum, err = optionalFloat64(value)
+		case aggregation.SumAggregator.Type:
+			out.Sum, err = optionalFloat64(value)
+		case aggregation.CardinalityType:
+			out.Cardinality, err = optionalInt64(value)
+		case aggregation.PercentileType:
+			percentiles, ok := value.([]aggregation.Percentile)
+			if !ok {
+				return nil, fmt.Errorf("percentile: unexpected type %T", value)
+			}
+			for _, p := range percentiles {
+				out.Percentiles = append(out.Percentiles, &pb.AggregateResult_Percentile{Quantile: p.Quantile, Value: p.Value})
+			}
+		case aggregation.HistogramType:
+			buckets, ok := value.([]aggregation.HistogramBucket)
+			if !ok {
+				return nil, fmt.Errorf("histogram: unexpected type %T", value)
+			}
+			for _, b := range buckets {
+				out.Histogram = append(out.Histogram, &pb.AggregateResult_HistogramBucket{From: b.From, To: b.To, Count: b.Count})
+			}
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+	}
+	return out, nil
+}
+
+func dateToProto(aggs map[string]interface{}) (*pb.AggregateResult_Date, error) {
+	out := &pb.AggregateResult_Date{}
+	for name, value := range aggs {
+		if value == nil {
+			continue
+		}
+		var err error
+		switch name {
+		case aggregation.CountAggregator.Type:
+			out.Count, err = optionalInt64(value)
+		case aggregation.MedianAggregator.Type:
+			out.Median, err = optionalString(value)
+		case aggregation.ModeAggregator.Type:
+			out.Mode, err = optionalString(value)
+		case aggregation.MaximumAggregator.Type:
+			out.Maximum, err = optionalString(value)
+		case aggregation.MinimumAggregator.Type:
+			out.Minimum, err = optionalString(value)
+		case aggregation.CardinalityType:
+			out.Cardinality, err = optionalInt64(value)
+		case aggregation.HistogramType:
+			buckets, ok := value.([]aggregation.DateHistogramBucket)
+			if !ok {
+				return nil, fmt.Errorf("histogram: unexpected type %T", value)
+			}
+			for _, b := range buckets {
+				out.Histogram = append(out.Histogram, &pb.AggregateResult_DateHistogramBucket{From: b.From, To: b.To, Count: b.Count})
+			}
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+	}
+	return out, nil
+}
+
+func textToProto(text aggregation.Text) *pb.AggregateResult_Text {
+	out := &pb.AggregateResult_Text{
+		Count:       int64(text.Count),
+		Cardinality: text.Cardinality,
+	}
+	for _, item := range text.Items {
+		out.TopOccurrences = append(out.TopOccurrences, &pb.AggregateResult_Text_TopOccurrence{
+			Value:  item.Value,
+			Occurs: int64(item.Occurs),
+		})
+	}
+	return out
+}
+
+// the numbers of an aggregation have different types depending on whether
+// they were computed on a single shard or combined from several shards
+func optionalInt64(value interface{}) (*int64, error) {
+	var out int64
+	switch v := value.(type) {
+	case int:
+		out = int64(v)
+	case int64:
+		out = v
+	case float64:
+		out = int64(v)
+	default:
+		return nil, fmt.Errorf("unexpected type %T", value)
+	}
+	return &out, nil
+}
+
+func optionalFloat64(value interface{}) (*float64, error) {
+	var out float64
+	switch v := value.(type) {
+	case float64:
+		out = v
+	case int:
+		out = float64(v)
+	case int64:
+		out = float64(v)
+	default:
+		return nil, fmt.Errorf("unexpected type %T", value)
+	}
+	return &out, nil
+}
+
+func optionalString(value interface{}) (*string, error) {
+	v, ok := value.(string)
+	if !ok {
+		return nil, fmt.Errorf("unexpected type %T", value)
+	}
+	return &v, nil
+}
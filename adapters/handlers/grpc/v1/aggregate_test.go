@@ -0,0 +1,416 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package v1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/aggregation"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+)
+
+func ptFloat64(in float64) *float64 {
+	return &in
+}
+
+func ptInt(in int) *int {
+	return &in
+}
+
+func TestExtractAggregateGroupBy(t *testing.T) {
+	limit := uint32(5)
+	tests := []struct {
+		name          string
+		in            *pb.AggregateGroupBy
+		expectedKeys  []aggregation.GroupByKey
+		expectedLimit *int
+		expectedErr   bool
+	}{
+		{
+			name: "nil",
+		},
+		{
+			name: "nested keys",
+			in: &pb.AggregateGroupBy{
+				Keys: []*pb.AggregateGroupBy_Key{
+					{Path: []string{"country"}},
+					{
+						Path: []string{"price"},
+						Derivation: &pb.AggregateGroupBy_Key_Ranges{Ranges: &pb.AggregateGroupBy_NumericRanges{
+							Ranges: []*pb.AggregateGroupBy_Range{{To: ptFloat64(10)}, {From: ptFloat64(10)}},
+						}},
+					},
+					{
+						Path:       []string{"published"},
+						Derivation: &pb.AggregateGroupBy_Key_DateInterval{DateInterval: pb.AggregateGroupBy_DATE_INTERVAL_WEEK},
+					},
+					{
+						Path: []string{"location"},
+						Derivation: &pb.AggregateGroupBy_Key_GeoRings{GeoRings: &pb.AggregateGroupBy_GeoRings{
+							Latitude: 52.37, Longitude: 4.9,
+							Ranges: []*pb.AggregateGroupBy_Range{{To: ptFloat64(1000)}},
+						}},
+					},
+				},
+				Limit: &limit,
+			},
+			expectedKeys: []aggregation.GroupByKey{
+				{Path: &filters.Path{Class: "Shop", Property: "country"}},
+				{
+					Path:   &filters.Path{Class: "Shop", Property: "price"},
+					Ranges: []aggregation.GroupByRange{{To: ptFloat64(10)}, {From: ptFloat64(10)}},
+				},
+				{
+					Path:         &filters.Path{Class: "Shop", Property: "published"},
+					DateInterval: aggregation.DateIntervalWeek,
+				},
+				{
+					Path:      &filters.Path{Class: "Shop", Property: "location"},
+					GeoOrigin: &aggregation.GeoOrigin{Latitude: 52.37, Longitude: 4.9},
+					Ranges:    []aggregation.GroupByRange{{To: ptFloat64(1000)}},
+				},
+			},
+			expectedLimit: ptInt(5),
+		},
+		{
+			name: "unspecified date interval",
+			in: &pb.AggregateGroupBy{Keys: []*pb.AggregateGroupBy_Key{{
+				Path:       []string{"published"},
+				Derivation: &pb.AggregateGroupBy_Key_DateInterval{},
+			}}},
+			expectedErr: true,
+		},
+		{
+			name: "empty range",
+			in: &pb.AggregateGroupBy{Keys: []*pb.AggregateGroupBy_Key{{
+				Path: []string{"price"},
+				Derivation: &pb.AggregateGroupBy_Key_Ranges{Ranges: &pb.AggregateGroupBy_NumericRanges{
+					Ranges: []*pb.AggregateGroupBy_Range{{}},
+				}},
+			}}},
+			expectedErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			keys, limit, err := extractAggregateGroupBy(tt.in, "Shop")
+			if tt.expectedErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedKeys, keys)
+			require.Equal(t, tt.expectedLimit, limit)
+		})
+	}
+}
+
+func TestAggregateGroupsToProto(t *testing.T) {
+	groups := []aggregation.Group{
+		{
+			GroupedBy: &aggregation.GroupedBy{
+				Path:  []string{"price"},
+				Value: "10-*",
+				Range: &aggregation.GroupByRange{From: ptFloat64(10)},
+			},
+			Count: 3,
+			Groups: []aggregation.Group{
+				{GroupedBy: &aggregation.GroupedBy{Path: []string{"inStock"}, Value: true}, Count: 2},
+				{GroupedBy: &aggregation.GroupedBy{Path: []string{"rating"}, Value: 4.5}, Count: 1},
+			},
+		},
+	}
+
+	out, err := aggregateGroupsToProto(groups)
+	require.Nil(t, err)
+	require.Len(t, out, 1)
+	require.Equal(t, "10-*", out[0].GroupedBy.GetText())
+	require.Equal(t, float64(10), out[0].GroupedBy.GetFrom())
+	require.Nil(t, out[0].GroupedBy.To)
+	require.Equal(t, int64(3), out[0].Count)
+	require.Len(t, out[0].Groups, 2)
+	require.True(t, out[0].Groups[0].GroupedBy.GetBoolean())
+	require.Equal(t, 4.5, out[0].Groups[1].GroupedBy.GetNumber())
+	require.Nil(t, out[0].Groups[1].Groups)
+}
+
+func TestAggregateParamsFromProto(t *testing.T) {
+	scheme := schema.Schema{
+		Objects: &models.Schema{
+			Classes: []*models.Class{
+				{
+					Class: "Shop",
+					Properties: []*models.Property{
+						{Name: "name", DataType: schema.DataTypeText.PropString()},
+						{Name: "price", DataType: schema.DataTypeNumber.PropString()},
+					},
+				},
+				{
+					Class: "MultiVecShop",
+					Properties: []*models.Property{
+						{Name: "name", DataType: schema.DataTypeText.PropString()},
+					},
+					VectorConfig: map[string]models.VectorConfig{
+						"first":  {VectorIndexType: "hnsw"},
+						"second": {VectorIndexType: "hnsw"},
+					},
+				},
+			},
+		},
+	}
+	getClass := func(name string) (*models.Class, error) {
+		return scheme.GetClass(name), nil
+	}
+
+	tenant := "tenant1"
+	objectLimit := uint32(10)
+	topLimit := uint32(3)
+	certainty := 0.7
+	nameFilter := &pb.Filters{
+		Operator:  pb.Filters_OPERATOR_EQUAL,
+		TestValue: &pb.Filters_ValueText{ValueText: "acme"},
+		Target:    &pb.FilterTarget{Target: &pb.FilterTarget_Property{Property: "name"}},
+	}
+
+	t.Run("valid request", func(t *testing.T) {
+		params, err := aggregateParamsFromProto(&pb.AggregateRequest{
+			Collection:   "Shop",
+			Tenant:       &tenant,
+			ObjectsCount: true,
+			Properties: []*pb.AggregateProperty{
+				{
+					Name: "price",
+					Aggregators: []*pb.Aggregator{
+						{Type: pb.Aggregator_TYPE_MEAN},
+						{Type: pb.Aggregator_TYPE_PERCENTILE, Quantiles: []float64{0.9}},
+						{Type: pb.Aggregator_TYPE_HISTOGRAM, Histogram: &pb.Aggregator_Histogram{Interval: 10}},
+					},
+				},
+				{
+					Name: "name",
+					Aggregators: []*pb.Aggregator{
+						{Type: pb.Aggregator_TYPE_TOP_OCCURRENCES, Limit: &topLimit},
+						{Type: pb.Aggregator_TYPE_CARDINALITY},
+					},
+				},
+			},
+			GroupBy: &pb.AggregateGroupBy{
+				Keys: []*pb.AggregateGroupBy_Key{{Path: []string{"name"}}},
+			},
+			Filters:     nameFilter,
+			NearVector:  &pb.NearVector{Vector: []float32{1, 2, 3}, Certainty: &certainty},
+			ObjectLimit: &objectLimit,
+		}, getClass)
+		require.Nil(t, err)
+
+		percentile, err := aggregation.NewPercentileAggregator([]float64{0.9})
+		require.Nil(t, err)
+		histogram, err := aggregation.NewHistogramAggregator(10, nil)
+		require.Nil(t, err)
+
+		require.Equal(t, &aggregation.Params{
+			ClassName:        "Shop",
+			Tenant:           tenant,
+			IncludeMetaCount: true,
+			Properties: []aggregation.ParamProperty{
+				{
+					Name:        "price",
+					Aggregators: []aggregation.Aggregator{aggregation.MeanAggregator, percentile, histogram},
+				},
+				{
+					Name: "name",
+					Aggregators: []aggregation.Aggregator{
+						aggregation.NewTopOccurrencesAggregator(ptInt(3)), aggregation.CardinalityAggregator,
+					},
+				},
+			},
+			GroupByKeys: []aggregation.GroupByKey{
+				{Path: &filters.Path{Class: "Shop", Property: "name"}},
+			},
+			Filters: &filters.LocalFilter{Root: &filters.Clause{
+				Operator: filters.OperatorEqual,
+				On:       &filters.Path{Class: "Shop", Property: "name"},
+				Value:    &filters.Value{Value: "acme", Type: schema.DataTypeText},
+			}},
+			NearVector: &searchparams.NearVector{
+				Vectors:   [][]float32{{1, 2, 3}},
+				Certainty: certainty,
+			},
+			ObjectLimit: ptInt(10),
+		}, params)
+	})
+
+	t.Run("hybrid with a single target vector", func(t *testing.T) {
+		params, err := aggregateParamsFromProto(&pb.AggregateRequest{
+			Collection:   "MultiVecShop",
+			HybridSearch: &pb.Hybrid{Query: "shoes", Alpha: 0.5, TargetVectors: []string{"second"}},
+			ObjectLimit:  &objectLimit,
+		}, getClass)
+		require.Nil(t, err)
+		require.NotNil(t, params.Hybrid)
+		require.Equal(t, "shoes", params.Hybrid.Query)
+		require.Equal(t, []string{"second"}, params.Hybrid.TargetVectors)
+	})
+
+	invalid := []struct {
+		name string
+		req  *pb.AggregateRequest
+	}{
+		{
+			name: "unknown aggregator",
+			req: &pb.AggregateRequest{Collection: "Shop", Properties: []*pb.AggregateProperty{
+				{Name: "price", Aggregators: []*pb.Aggregator{{}}},
+			}},
+		},
+		{
+			name: "histogram without buckets",
+			req: &pb.AggregateRequest{Collection: "Shop", Properties: []*pb.AggregateProperty{
+				{Name: "price", Aggregators: []*pb.Aggregator{{Type: pb.Aggregator_TYPE_HISTOGRAM}}},
+			}},
+		},
+		{
+			name: "object limit without search",
+			req:  &pb.AggregateRequest{Collection: "Shop", ObjectLimit: &objectLimit},
+		},
+		{
+			name: "several searches",
+			req: &pb.AggregateRequest{
+				Collection:  "Shop",
+				NearVector:  &pb.NearVector{Vector: []float32{1, 2, 3}},
+				NearObject:  &pb.NearObject{Id: "4bd2ef51-3eb3-4b1c-9c71-5e5f2b7d4b1f"},
+				ObjectLimit: &objectLimit,
+			},
+		},
+		{
+			name: "several target vectors",
+			req: &pb.AggregateRequest{
+				Collection: "MultiVecShop",
+				NearObject: &pb.NearObject{
+					Id:      "4bd2ef51-3eb3-4b1c-9c71-5e5f2b7d4b1f",
+					Targets: &pb.Targets{TargetVectors: []string{"first", "second"}},
+				},
+				ObjectLimit: &objectLimit,
+			},
+		},
+	}
+	for _, tt := range invalid {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := aggregateParamsFromProto(tt.req, getClass)
+			require.NotNil(t, err)
+		})
+	}
+}
+
+func TestAggregateReplyFromResult(t *testing.T) {
+	cardinality := int64(2)
+	properties := map[string]aggregation.Property{
+		"price": {
+			Type: aggregation.PropertyTypeNumerical,
+			NumericalAggregations: map[string]interface{}{
+				"count":       2,
+				"mean":        15.5,
+				"percentile":  []aggregation.Percentile{{Quantile: 0.5, Value: 11}},
+				"histogram":   []aggregation.HistogramBucket{{From: 10, To: 20, Count: 2}},
+				"cardinality": int64(2),
+			},
+		},
+		"published": {
+			Type: aggregation.PropertyTypeDate,
+			DateAggregations: map[string]interface{}{
+				"count":   int64(2),
+				"minimum": "2024-01-01T00:00:00Z",
+			},
+		},
+		"name": {
+			Type:       aggregation.PropertyTypeText,
+			SchemaType: "text",
+			TextAggregation: aggregation.Text{
+				Count:       2,
+				Items:       []aggregation.TextOccurrence{{Value: "acme", Occurs: 2}},
+				Cardinality: &cardinality,
+			},
+		},
+		"inStock": {
+			Type:               aggregation.PropertyTypeBoolean,
+			BooleanAggregation: aggregation.Boolean{Count: 2, TotalTrue: 1, TotalFalse: 1, PercentageTrue: 0.5, PercentageFalse: 0.5},
+		},
+	}
+
+	t.Run("single result", func(t *testing.T) {
+		reply, err := aggregateReplyFromResult(&aggregation.Result{
+			Groups: []aggregation.Group{{Count: 2, Properties: properties}},
+		}, &aggregation.Params{IncludeMetaCount: true}, time.Now())
+		require.Nil(t, err)
+
+		single := reply.GetSingleResult()
+		require.NotNil(t, single)
+		require.Equal(t, int64(2), single.GetObjectsCount())
+		require.Len(t, single.Properties, 4)
+
+		// sorted by property name
+		inStock, name, price, published := single.Properties[0], single.Properties[1], single.Properties[2], single.Properties[3]
+		require.Equal(t, "inStock", inStock.Property)
+		require.Equal(t, int64(1), inStock.GetBoolean().TotalTrue)
+
+		require.Equal(t, "text", name.GetType())
+		require.Equal(t, int64(2), name.GetText().Count)
+		require.Equal(t, int64(2), name.GetText().GetCardinality())
+		require.Equal(t, "acme", name.GetText().TopOccurrences[0].Value)
+
+		require.Nil(t, price.Type)
+		numerical := price.GetNumerical()
+		require.Equal(t, int64(2), numerical.GetCount())
+		require.Equal(t, 15.5, numerical.GetMean())
+		require.Nil(t, numerical.Median)
+		require.Equal(t, float64(11), numerical.Percentiles[0].Value)
+		require.Equal(t, int64(2), numerical.Histogram[0].Count)
+		require.Equal(t, int64(2), numerical.GetCardinality())
+
+		require.Equal(t, "2024-01-01T00:00:00Z", published.GetDate().GetMinimum())
+		require.Nil(t, published.GetDate().Maximum)
+	})
+
+	t.Run("grouped results", func(t *testing.T) {
+		reply, err := aggregateReplyFromResult(&aggregation.Result{
+			Groups: []aggregation.Group{{
+				Count:      2,
+				GroupedBy:  &aggregation.GroupedBy{Path: []string{"name"}, Value: "acme"},
+				Properties: properties,
+			}},
+		}, &aggregation.Params{GroupBy: &filters.Path{Class: "Shop", Property: "name"}}, time.Now())
+		require.Nil(t, err)
+
+		groups := reply.GetGroupedResults().GetGroups()
+		require.Len(t, groups, 1)
+		require.Equal(t, "acme", groups[0].GroupedBy.GetText())
+		require.Equal(t, int64(2), groups[0].Count)
+		require.Len(t, groups[0].Properties, 4)
+	})
+
+	t.Run("unexpected value type", func(t *testing.T) {
+		_, err := aggregateReplyFromResult(&aggregation.Result{
+			Groups: []aggregation.Group{{Properties: map[string]aggregation.Property{
+				"price": {
+					Type:                  aggregation.PropertyTypeNumerical,
+					NumericalAggregations: map[string]interface{}{"mean": "15.5"},
+				},
+			}}},
+		}, &aggregation.Params{}, time.Now())
+		require.NotNil(t, err)
+	})
+}
@@ -93,14 +93,23 @@ func BatchFromProto(req *pb.BatchObjectsRequest, authorizedGetClass func(string,
 			}
 		}
 
+		var sparseVectors models.SparseVectors = nil
+		if len(obj.SparseVectors) > 0 {
+			sparseVectors = make(models.SparseVectors, len(obj.SparseVectors))
+			for _, vec := range obj.SparseVectors {
+				sparseVectors[vec.Name] = models.SparseVector{Indices: vec.Indices, Values: vec.Values}
+			}
+		}
+
 		objOriginalIndex[insertCounter] = i
 		objs = append(objs, &models.Object{
-			Class:      obj.Collection,
-			Tenant:     obj.Tenant,
-			Vector:     vector,
-			Properties: props,
-			ID:         strfmt.UUID(obj.Uuid),
-			Vectors:    vectors,
+			Class:         obj.Collection,
+			Tenant:        obj.Tenant,
+			Vector:        vector,
+			Properties:    props,
+			ID:            strfmt.UUID(obj.Uuid),
+			Vectors:       vectors,
+			SparseVectors: sparseVectors,
 		})
 		insertCounter += 1
 	}
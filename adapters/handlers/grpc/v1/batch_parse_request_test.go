@@ -137,6 +137,22 @@ func TestGRPCBatchRequest(t *testing.T) {
 				},
 			}},
 		},
+		{
+			name: "Sparse Vecs",
+			req: []*pb.BatchObject{{Collection: collection, Uuid: UUID4, SparseVectors: []*pb.SparseVector{
+				{
+					Name:    "sparse",
+					Indices: []uint32{3, 17, 2048},
+					Values:  []float32{0.4, 1.2, 0.05},
+				},
+			}}},
+			out: []*models.Object{{
+				Class: collection, ID: UUID4, Properties: nilMap,
+				SparseVectors: models.SparseVectors{
+					"sparse": {Indices: []uint32{3, 17, 2048}, Values: []float32{0.4, 1.2, 0.05}},
+				},
+			}},
+		},
 		{
 			name: "only mult ref",
 			req: []*pb.BatchObject{{Collection: collection, Uuid: UUID4, Properties: &pb.BatchObject_Properties{
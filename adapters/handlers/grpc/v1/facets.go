@@ -0,0 +1,63 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package v1
+
+import (
+	"fmt"
+
+	"github.com/weaviate/weaviate/entities/aggregation"
+	"github.com/weaviate/weaviate/entities/facets"
+	"github.com/weaviate/weaviate/entities/schema"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+)
+
+func extractFacets(in []*pb.Facet) []facets.Facet {
+	out := make([]facets.Facet, len(in))
+	for i, facet := range in {
+		out[i] = facets.Facet{Property: schema.LowercaseFirstLetter(facet.Property)}
+		if facet.Limit != nil {
+			out[i].Limit = int(*facet.Limit)
+		}
+		for _, r := range facet.Ranges {
+			out[i].Ranges = append(out[i].Ranges, aggregation.GroupByRange{From: r.From, To: r.To})
+		}
+	}
+	return out
+}
+
+func facetResultsToProto(results []facets.Result) []*pb.FacetResult {
+	out := make([]*pb.FacetResult, len(results))
+	for i, result := range results {
+		values := make([]*pb.FacetResult_Value, len(result.Values))
+		for j, value := range result.Values {
+			values[j] = facetValueToProto(value)
+		}
+		out[i] = &pb.FacetResult{Property: result.Property, Values: values}
+	}
+	return out
+}
+
+func facetValueToProto(value facets.Value) *pb.FacetResult_Value {
+	out := &pb.FacetResult_Value{From: value.From, To: value.To, Count: value.Count}
+	switch val := value.Value.(type) {
+	case string:
+		out.Value = &pb.FacetResult_Value_Text{Text: val}
+	case float64:
+		out.Value = &pb.FacetResult_Value_Number{Number: val}
+	case bool:
+		out.Value = &pb.FacetResult_Value_Boolean{Boolean: val}
+	case nil:
+	default:
+		out.Value = &pb.FacetResult_Value_Text{Text: fmt.Sprint(val)}
+	}
+	return out
+}
@@ -0,0 +1,43 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/facets"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+)
+
+func TestFacetResultsToProto(t *testing.T) {
+	limit := 10.0
+
+	out := facetResultsToProto([]facets.Result{
+		{Property: "word", Values: []facets.Value{{Value: "other", Count: 3}, {Value: "word", Count: 1}}},
+		{Property: "price", Values: []facets.Value{{To: &limit, Count: 2}, {From: &limit, Count: 0}}},
+		{Property: "inStock", Values: []facets.Value{{Value: true, Count: 4}}},
+	})
+
+	expected := []*pb.FacetResult{
+		{Property: "word", Values: []*pb.FacetResult_Value{
+			{Value: &pb.FacetResult_Value_Text{Text: "other"}, Count: 3},
+			{Value: &pb.FacetResult_Value_Text{Text: "word"}, Count: 1},
+		}},
+		{Property: "price", Values: []*pb.FacetResult_Value{{To: &limit, Count: 2}, {From: &limit, Count: 0}}},
+		{Property: "inStock", Values: []*pb.FacetResult_Value{{Value: &pb.FacetResult_Value_Boolean{Boolean: true}, Count: 4}}},
+	}
+	require.Len(t, out, len(expected))
+	for i := range expected {
+		require.Equal(t, expected[i].String(), out[i].String())
+	}
+}
@@ -70,6 +70,10 @@ func ExtractFilters(filterIn *pb.Filters, authorizedGetClass func(string) (*mode
 			returnFilter.Operator = filters.ContainsAny
 		case pb.Filters_OPERATOR_CONTAINS_ALL:
 			returnFilter.Operator = filters.ContainsAll
+		case pb.Filters_OPERATOR_PREFIX:
+			returnFilter.Operator = filters.OperatorPrefix
+		case pb.Filters_OPERATOR_REGEX:
+			returnFilter.Operator = filters.OperatorRegex
 		default:
 			return filters.Clause{}, fmt.Errorf("unknown filter operator %v", filterIn.Operator)
 		}
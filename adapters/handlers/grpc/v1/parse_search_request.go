@@ -97,55 +97,34 @@ func (p *Parser) Search(req *pb.SearchRequest, config *config.Config) (dto.GetPa
 	}
 
 	if bm25 := req.Bm25Search; bm25 != nil {
-		out.KeywordRanking = &searchparams.KeywordRanking{Query: bm25.Query, Properties: schema.LowercaseFirstLetterOfStrings(bm25.Properties), Type: "bm25", AdditionalExplanations: out.AdditionalProperties.ExplainScore}
+		out.KeywordRanking = &searchparams.KeywordRanking{Query: bm25.Query, Properties: schema.LowercaseFirstLetterOfStrings(bm25.Properties), Type: "bm25", AdditionalExplanations: out.AdditionalProperties.ExplainScore, Slop: int(bm25.GetSlop())}
 	}
 
-	if nv := req.NearVector; nv != nil {
-		out.NearVector, err = parseNearVec(nv, targetVectors)
-		if err != nil {
-			return dto.GetParams{}, err
+	if ns := req.NearSparseVector; ns != nil {
+		if req.Bm25Search != nil {
+			return dto.GetParams{}, fmt.Errorf("near_sparse_vector: cannot be combined with bm25")
 		}
-
-		// The following business logic should not sit in the API. However, it is
-		// also part of the GraphQL API, so we need to duplicate it in order to get
-		// the same behavior
-		if nv.Distance != nil && nv.Certainty != nil {
-			return out, fmt.Errorf("near_vector: cannot provide distance and certainty")
+		if ns.TargetVector == "" {
+			return dto.GetParams{}, fmt.Errorf("near_sparse_vector: target_vector is required")
 		}
-
-		if nv.Certainty != nil {
-			out.NearVector.Certainty = *nv.Certainty
+		out.KeywordRanking = &searchparams.KeywordRanking{
+			Type:         "sparseVector",
+			TargetVector: ns.TargetVector,
+			SparseVector: models.SparseVector{Indices: ns.Indices, Values: ns.Values},
 		}
+	}
 
-		if nv.Distance != nil {
-			out.NearVector.Distance = *nv.Distance
-			out.NearVector.WithDistance = true
+	if nv := req.NearVector; nv != nil {
+		out.NearVector, err = extractNearVector(nv, targetVectors)
+		if err != nil {
+			return out, err
 		}
 	}
 
 	if no := req.NearObject; no != nil {
-		if no.Id == "" {
-			return dto.GetParams{}, fmt.Errorf("near_object: id is required")
-		}
-		out.NearObject = &searchparams.NearObject{
-			ID:            no.Id,
-			TargetVectors: targetVectors,
-		}
-
-		// The following business logic should not sit in the API. However, it is
-		// also part of the GraphQL API, so we need to duplicate it in order to get
-		// the same behavior
-		if no.Distance != nil && no.Certainty != nil {
-			return out, fmt.Errorf("near_object: cannot provide distance and certainty")
-		}
-
-		if no.Certainty != nil {
-			out.NearObject.Certainty = *no.Certainty
-		}
-
-		if no.Distance != nil {
-			out.NearObject.Distance = *no.Distance
-			out.NearObject.WithDistance = true
+		out.NearObject, err = extractNearObject(no, targetVectors)
+		if err != nil {
+			return out, err
 		}
 	}
 
@@ -229,74 +208,10 @@ func (p *Parser) Search(req *pb.SearchRequest, config *config.Config) (dto.GetPa
 
 	// Hybrid search now has the ability to run subsearches using the real nearvector and neartext searches.  So we need to extract those settings the same way we prepare for the real searches.
 	if hs := req.HybridSearch; hs != nil {
-		fusionType := common_filters.HybridFusionDefault
-		if hs.FusionType == pb.Hybrid_FUSION_TYPE_RANKED {
-			fusionType = common_filters.HybridRankedFusion
-		} else if hs.FusionType == pb.Hybrid_FUSION_TYPE_RELATIVE_SCORE {
-			fusionType = common_filters.HybridRelativeScoreFusion
-		}
-
-		var vector []float32
-		// bytes vector has precedent for being more efficient
-		if len(hs.VectorBytes) > 0 {
-			vector = byteops.Float32FromByteVector(hs.VectorBytes)
-		} else if len(hs.Vector) > 0 {
-			vector = hs.Vector
-		}
-
-		var distance float32
-		withDistance := false
-		if hs.Threshold != nil {
-			withDistance = true
-			switch hs.Threshold.(type) {
-			case *pb.Hybrid_VectorDistance:
-				distance = hs.Threshold.(*pb.Hybrid_VectorDistance).VectorDistance
-			default:
-				return dto.GetParams{}, fmt.Errorf("unknown value type %v", hs.Threshold)
-			}
-		}
-
-		nearTxt, err := extractNearText(out.ClassName, out.Pagination.Limit, req.HybridSearch.NearText, targetVectors)
+		out.HybridSearch, err = extractHybridSearch(hs, out.ClassName, out.Pagination.Limit, targetVectors)
 		if err != nil {
 			return dto.GetParams{}, err
 		}
-		nearVec := req.HybridSearch.NearVector
-
-		out.HybridSearch = &searchparams.HybridSearch{
-			Query:           hs.Query,
-			Properties:      schema.LowercaseFirstLetterOfStrings(hs.Properties),
-			Vector:          vector,
-			Alpha:           float64(hs.Alpha),
-			FusionAlgorithm: fusionType,
-			TargetVectors:   targetVectors,
-			Distance:        distance,
-			WithDistance:    withDistance,
-		}
-
-		if nearVec != nil {
-			out.HybridSearch.NearVectorParams, err = parseNearVec(nearVec, targetVectors)
-			if err != nil {
-				return dto.GetParams{}, err
-			}
-
-			if nearVec.Distance != nil {
-				out.HybridSearch.NearVectorParams.Distance = *nearVec.Distance
-				out.HybridSearch.NearVectorParams.WithDistance = true
-			}
-			if nearVec.Certainty != nil {
-				out.HybridSearch.NearVectorParams.Certainty = *nearVec.Certainty
-			}
-		}
-
-		if nearTxt != nil {
-			out.HybridSearch.NearTextParams = &searchparams.NearTextParams{
-				Values:        nearTxt.Values,
-				Limit:         nearTxt.Limit,
-				MoveAwayFrom:  searchparams.ExploreMove{Force: nearTxt.MoveAwayFrom.Force, Values: nearTxt.MoveAwayFrom.Values},
-				MoveTo:        searchparams.ExploreMove{Force: nearTxt.MoveTo.Force, Values: nearTxt.MoveTo.Values},
-				TargetVectors: targetVectors,
-			}
-		}
 	}
 
 	var nearText *nearText2.NearTextParams
@@ -342,7 +257,7 @@ func (p *Parser) Search(req *pb.SearchRequest, config *config.Config) (dto.GetPa
 	}
 
 	if len(req.SortBy) > 0 {
-		if req.NearText != nil || req.NearVideo != nil || req.NearAudio != nil || req.NearImage != nil || req.NearObject != nil || req.NearVector != nil || req.HybridSearch != nil || req.Bm25Search != nil || req.Generative != nil {
+		if req.NearText != nil || req.NearVideo != nil || req.NearAudio != nil || req.NearImage != nil || req.NearObject != nil || req.NearVector != nil || req.HybridSearch != nil || req.Bm25Search != nil || req.NearSparseVector != nil || req.Generative != nil {
 			return dto.GetParams{}, errors.New("sorting cannot be combined with search")
 		}
 		out.Sort = extractSorting(req.SortBy)
@@ -358,6 +273,10 @@ func (p *Parser) Search(req *pb.SearchRequest, config *config.Config) (dto.GetPa
 		out.GroupBy = groupBy
 	}
 
+	if len(req.Facets) > 0 {
+		out.Facets = extractFacets(req.Facets)
+	}
+
 	if out.HybridSearch != nil && out.HybridSearch.NearTextParams != nil && out.HybridSearch.NearVectorParams != nil {
 		return dto.GetParams{}, errors.New("cannot combine nearText and nearVector in hybrid search")
 	}
@@ -371,6 +290,141 @@ func (p *Parser) Search(req *pb.SearchRequest, config *config.Config) (dto.GetPa
 	return out, nil
 }
 
+func extractHybridSearch(hs *pb.Hybrid, className string, limit int, targetVectors []string) (*searchparams.HybridSearch, error) {
+	fusionType := common_filters.HybridFusionDefault
+	if hs.FusionType == pb.Hybrid_FUSION_TYPE_RANKED {
+		fusionType = common_filters.HybridRankedFusion
+	} else if hs.FusionType == pb.Hybrid_FUSION_TYPE_RELATIVE_SCORE {
+		fusionType = common_filters.HybridRelativeScoreFusion
+	}
+
+	var vector []float32
+	// bytes vector has precedent for being more efficient
+	if len(hs.VectorBytes) > 0 {
+		vector = byteops.Float32FromByteVector(hs.VectorBytes)
+	} else if len(hs.Vector) > 0 {
+		vector = hs.Vector
+	}
+
+	var distance float32
+	withDistance := false
+	if hs.Threshold != nil {
+		withDistance = true
+		switch hs.Threshold.(type) {
+		case *pb.Hybrid_VectorDistance:
+			distance = hs.Threshold.(*pb.Hybrid_VectorDistance).VectorDistance
+		default:
+			return nil, fmt.Errorf("unknown value type %v", hs.Threshold)
+		}
+	}
+
+	nearTxt, err := extractNearText(className, limit, hs.NearText, targetVectors)
+	if err != nil {
+		return nil, err
+	}
+	nearVec := hs.NearVector
+
+	hybrid := &searchparams.HybridSearch{
+		Query:           hs.Query,
+		Properties:      schema.LowercaseFirstLetterOfStrings(hs.Properties),
+		Vector:          vector,
+		Alpha:           float64(hs.Alpha),
+		FusionAlgorithm: fusionType,
+		TargetVectors:   targetVectors,
+		Distance:        distance,
+		WithDistance:    withDistance,
+	}
+
+	if nearVec != nil {
+		hybrid.NearVectorParams, err = parseNearVec(nearVec, targetVectors)
+		if err != nil {
+			return nil, err
+		}
+
+		if nearVec.Distance != nil {
+			hybrid.NearVectorParams.Distance = *nearVec.Distance
+			hybrid.NearVectorParams.WithDistance = true
+		}
+		if nearVec.Certainty != nil {
+			hybrid.NearVectorParams.Certainty = *nearVec.Certainty
+		}
+	}
+
+	if sv := hs.SparseVector; sv != nil {
+		if sv.TargetVector == "" {
+			return nil, fmt.Errorf("hybrid: sparse_vector: target_vector is required")
+		}
+		hybrid.SparseVectorParams = &searchparams.NearSparseVector{
+			TargetVector: sv.TargetVector,
+			Vector:       models.SparseVector{Indices: sv.Indices, Values: sv.Values},
+			Weight:       float64(hs.SparseVectorWeight),
+		}
+	}
+
+	if nearTxt != nil {
+		hybrid.NearTextParams = &searchparams.NearTextParams{
+			Values:        nearTxt.Values,
+			Limit:         nearTxt.Limit,
+			MoveAwayFrom:  searchparams.ExploreMove{Force: nearTxt.MoveAwayFrom.Force, Values: nearTxt.MoveAwayFrom.Values},
+			MoveTo:        searchparams.ExploreMove{Force: nearTxt.MoveTo.Force, Values: nearTxt.MoveTo.Values},
+			TargetVectors: targetVectors,
+		}
+	}
+
+	return hybrid, nil
+}
+
+func extractNearVector(nv *pb.NearVector, targetVectors []string) (*searchparams.NearVector, error) {
+	nearVector, err := parseNearVec(nv, targetVectors)
+	if err != nil {
+		return nil, err
+	}
+
+	// The following business logic should not sit in the API. However, it is
+	// also part of the GraphQL API, so we need to duplicate it in order to get
+	// the same behavior
+	if nv.Distance != nil && nv.Certainty != nil {
+		return nil, fmt.Errorf("near_vector: cannot provide distance and certainty")
+	}
+
+	if nv.Certainty != nil {
+		nearVector.Certainty = *nv.Certainty
+	}
+
+	if nv.Distance != nil {
+		nearVector.Distance = *nv.Distance
+		nearVector.WithDistance = true
+	}
+	return nearVector, nil
+}
+
+func extractNearObject(no *pb.NearObject, targetVectors []string) (*searchparams.NearObject, error) {
+	if no.Id == "" {
+		return nil, fmt.Errorf("near_object: id is required")
+	}
+	nearObject := &searchparams.NearObject{
+		ID:            no.Id,
+		TargetVectors: targetVectors,
+	}
+
+	// The following business logic should not sit in the API. However, it is
+	// also part of the GraphQL API, so we need to duplicate it in order to get
+	// the same behavior
+	if no.Distance != nil && no.Certainty != nil {
+		return nil, fmt.Errorf("near_object: cannot provide distance and certainty")
+	}
+
+	if no.Certainty != nil {
+		nearObject.Certainty = *no.Certainty
+	}
+
+	if no.Distance != nil {
+		nearObject.Distance = *no.Distance
+		nearObject.WithDistance = true
+	}
+	return nearObject, nil
+}
+
 func extractGroupBy(groupIn *pb.GroupBy, out *dto.GetParams, class *models.Class) (*searchparams.GroupBy, error) {
 	if len(groupIn.Path) != 1 {
 		return nil, fmt.Errorf("groupby path can only have one entry, received %v", groupIn.Path)
@@ -407,11 +461,58 @@ func extractGroupBy(groupIn *pb.GroupBy, out *dto.GetParams, class *models.Class
 		Properties:      additionalGroupProperties,
 	}
 
+	switch groupIn.Strategy {
+	case pb.GroupBy_STRATEGY_UNSPECIFIED:
+	case pb.GroupBy_STRATEGY_CLOSEST:
+		groupOut.Strategy = searchparams.GroupByStrategyClosest
+	case pb.GroupBy_STRATEGY_DIVERSE:
+		groupOut.Strategy = searchparams.GroupByStrategyDiverse
+	default:
+		return nil, fmt.Errorf("unknown groupby strategy %v", groupIn.Strategy)
+	}
+
+	if groupIn.Sort != nil {
+		sort, err := extractGroupBySort(groupIn.Sort)
+		if err != nil {
+			return nil, err
+		}
+		groupOut.Sort = sort
+	}
+
 	out.AdditionalProperties.NoProps = false
 
 	return groupOut, nil
 }
 
+func extractGroupBySort(sortIn *pb.GroupBy_Sort) (*searchparams.GroupBySort, error) {
+	sortOut := &searchparams.GroupBySort{Descending: sortIn.Descending}
+	switch sortIn.By {
+	case pb.GroupBy_Sort_BY_UNSPECIFIED, pb.GroupBy_Sort_BY_DISTANCE:
+		sortOut.By = searchparams.GroupBySortDistance
+	case pb.GroupBy_Sort_BY_SCORE:
+		sortOut.By = searchparams.GroupBySortScore
+	case pb.GroupBy_Sort_BY_PROPERTY:
+		sortOut.By = searchparams.GroupBySortProperty
+	case pb.GroupBy_Sort_BY_SIZE:
+		sortOut.By = searchparams.GroupBySortSize
+	default:
+		return nil, fmt.Errorf("unknown groupby sort %v", sortIn.By)
+	}
+
+	switch sortIn.ScoreAggregation {
+	case pb.GroupBy_Sort_SCORE_AGGREGATION_UNSPECIFIED:
+	case pb.GroupBy_Sort_SCORE_AGGREGATION_MAX:
+		sortOut.Aggregation = searchparams.GroupByScoreMax
+	case pb.GroupBy_Sort_SCORE_AGGREGATION_AVG:
+		sortOut.Aggregation = searchparams.GroupByScoreAvg
+	case pb.GroupBy_Sort_SCORE_AGGREGATION_SUM:
+		sortOut.Aggregation = searchparams.GroupByScoreSum
+	default:
+		return nil, fmt.Errorf("unknown groupby score aggregation %v", sortIn.ScoreAggregation)
+	}
+	return sortOut, nil
+}
+
 func extractTargetVectors(req *pb.SearchRequest, class *models.Class) ([]string, *dto.TargetCombination, bool, error) {
 	var targetVectors []string
 	var targets *pb.Targets
@@ -877,6 +978,7 @@ func extractAdditionalPropsFromMetadata(class *models.Class, prop *pb.MetadataRe
 		ExplainScore:       prop.ExplainScore,
 		IsConsistent:       prop.IsConsistent,
 		Vectors:            prop.Vectors,
+		Highlights:         prop.Highlights,
 	}
 
 	if vectorSearch && configvalidation.CheckCertaintyCompatibility(class, targetVectors) != nil {
@@ -908,7 +1010,8 @@ func isIdOnlyRequest(metadata *pb.MetadataRequest) bool {
 		!metadata.Certainty &&
 		!metadata.Score &&
 		!metadata.ExplainScore &&
-		!metadata.IsConsistent)
+		!metadata.IsConsistent &&
+		!metadata.Highlights)
 }
 
 func getAllNonRefNonBlobProperties(authorizedGetClass func(string) (*models.Class, error), className string) ([]search.SelectProperty, error) {
@@ -1194,8 +1297,10 @@ func parseNearVec(nv *pb.NearVector, targetVectors []string) (*searchparams.Near
 	}
 
 	return &searchparams.NearVector{
-		Vectors:       vectors,
-		TargetVectors: targetVectors,
+		Vectors:              vectors,
+		TargetVectors:        targetVectors,
+		MatryoshkaDimensions: int(nv.GetMatryoshkaDimensions()),
+		NProbe:               int(nv.GetNprobe()),
 	}, nil
 }
 
@@ -22,7 +22,9 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/weaviate/weaviate/adapters/handlers/graphql/local/common_filters"
 	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/aggregation"
 	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/facets"
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
@@ -174,6 +176,9 @@ func TestGRPCRequest(t *testing.T) {
 	quorum := pb.ConsistencyLevel_CONSISTENCY_LEVEL_QUORUM
 	someString1 := "a word"
 	someString2 := "other"
+	slop := int32(2)
+	limit2 := uint32(2)
+	float10 := float64(10)
 
 	tests := []struct {
 		name  string
@@ -279,6 +284,21 @@ func TestGRPCRequest(t *testing.T) {
 			},
 			error: false,
 		},
+		{
+			name: "Facets",
+			req: &pb.SearchRequest{Collection: classname, Facets: []*pb.Facet{
+				{Property: "Name", Limit: &limit2},
+				{Property: "floats", Ranges: []*pb.Facet_Range{{To: &float10}, {From: &float10}}},
+			}},
+			out: dto.GetParams{
+				ClassName: classname, Pagination: defaultPagination, Properties: defaultTestClassProps,
+				Facets: []facets.Facet{
+					{Property: "name", Limit: 2},
+					{Property: "floats", Ranges: []aggregation.GroupByRange{{To: &float10}, {From: &float10}}},
+				},
+			},
+			error: false,
+		},
 		{
 			name: "Empty return properties given",
 			req:  &pb.SearchRequest{Collection: classname, Properties: &pb.PropertiesRequest{}},
@@ -576,6 +596,30 @@ func TestGRPCRequest(t *testing.T) {
 			},
 			error: false,
 		},
+		{
+			name: "hybrid with sparse vector",
+			req: &pb.SearchRequest{
+				Collection: classname, Metadata: &pb.MetadataRequest{Vector: true, Certainty: false},
+				HybridSearch: &pb.Hybrid{
+					Query: "query", Alpha: 0.75, Properties: []string{"name"},
+					SparseVector:       &pb.NearSparseVector{TargetVector: "sparse", Indices: []uint32{1, 5}, Values: []float32{0.5, 2}},
+					SparseVectorWeight: 0.5,
+				},
+			},
+			out: dto.GetParams{
+				ClassName: classname, Pagination: defaultPagination, HybridSearch: &searchparams.HybridSearch{
+					Query: "query", FusionAlgorithm: common_filters.HybridRelativeScoreFusion, Alpha: 0.75, Properties: []string{"name"},
+					SparseVectorParams: &searchparams.NearSparseVector{
+						TargetVector: "sparse",
+						Vector:       models.SparseVector{Indices: []uint32{1, 5}, Values: []float32{0.5, 2}},
+						Weight:       0.5,
+					},
+				},
+				Properties:           defaultTestClassProps,
+				AdditionalProperties: additional.Properties{Vector: true, NoProps: false},
+			},
+			error: false,
+		},
 		{
 			name: "hybrid ranked groupby",
 			req: &pb.SearchRequest{
@@ -645,6 +689,70 @@ func TestGRPCRequest(t *testing.T) {
 			},
 			error: false,
 		},
+		{
+			name: "near sparse vector",
+			req: &pb.SearchRequest{
+				Collection: classname, Metadata: &pb.MetadataRequest{Vector: true},
+				NearSparseVector: &pb.NearSparseVector{TargetVector: "sparse", Indices: []uint32{3, 9}, Values: []float32{1.5, 0.25}},
+			},
+			out: dto.GetParams{
+				ClassName: classname, Pagination: defaultPagination,
+				KeywordRanking: &searchparams.KeywordRanking{
+					Type: "sparseVector", TargetVector: "sparse",
+					SparseVector: models.SparseVector{Indices: []uint32{3, 9}, Values: []float32{1.5, 0.25}},
+				},
+				Properties:           defaultTestClassProps,
+				AdditionalProperties: additional.Properties{Vector: true, NoProps: false},
+			},
+			error: false,
+		},
+		{
+			name: "near sparse vector without target vector",
+			req: &pb.SearchRequest{
+				Collection: classname, Metadata: &pb.MetadataRequest{Vector: true},
+				NearSparseVector: &pb.NearSparseVector{Indices: []uint32{3}, Values: []float32{1.5}},
+			},
+			out:   dto.GetParams{},
+			error: true,
+		},
+		{
+			name: "near sparse vector with bm25",
+			req: &pb.SearchRequest{
+				Collection: classname, Metadata: &pb.MetadataRequest{Vector: true},
+				Bm25Search:       &pb.BM25{Query: "query"},
+				NearSparseVector: &pb.NearSparseVector{TargetVector: "sparse", Indices: []uint32{3}, Values: []float32{1.5}},
+			},
+			out:   dto.GetParams{},
+			error: true,
+		},
+		{
+			name: "bm25 with slop",
+			req: &pb.SearchRequest{
+				Collection: classname, Metadata: &pb.MetadataRequest{Vector: true},
+				Bm25Search: &pb.BM25{Query: `"quick fox"`, Properties: []string{"name"}, Slop: &slop},
+			},
+			out: dto.GetParams{
+				ClassName: classname, Pagination: defaultPagination,
+				KeywordRanking:       &searchparams.KeywordRanking{Query: `"quick fox"`, Properties: []string{"name"}, Type: "bm25", Slop: 2},
+				Properties:           defaultTestClassProps,
+				AdditionalProperties: additional.Properties{Vector: true, NoProps: false},
+			},
+			error: false,
+		},
+		{
+			name: "bm25 with highlights",
+			req: &pb.SearchRequest{
+				Collection: classname, Metadata: &pb.MetadataRequest{Highlights: true},
+				Bm25Search: &pb.BM25{Query: "quick fox", Properties: []string{"name"}},
+			},
+			out: dto.GetParams{
+				ClassName: classname, Pagination: defaultPagination,
+				KeywordRanking:       &searchparams.KeywordRanking{Query: "quick fox", Properties: []string{"name"}, Type: "bm25"},
+				Properties:           defaultTestClassProps,
+				AdditionalProperties: additional.Properties{Highlights: true, NoProps: false},
+			},
+			error: false,
+		},
 		{
 			name: "bm25 groupby",
 			req: &pb.SearchRequest{
@@ -1409,6 +1517,64 @@ func TestGRPCRequest(t *testing.T) {
 			},
 			error: false,
 		},
+		{
+			name: "group by with strategy and sort",
+			req: &pb.SearchRequest{
+				Collection: classname, Metadata: &pb.MetadataRequest{Vector: true},
+				GroupBy: &pb.GroupBy{
+					Path: []string{"name"}, NumberOfGroups: 2, ObjectsPerGroup: 3,
+					Strategy: pb.GroupBy_STRATEGY_DIVERSE,
+					Sort:     &pb.GroupBy_Sort{By: pb.GroupBy_Sort_BY_SCORE, ScoreAggregation: pb.GroupBy_Sort_SCORE_AGGREGATION_SUM},
+				},
+				NearVector: &pb.NearVector{Vector: []float32{1, 2, 3}},
+				Properties: &pb.PropertiesRequest{},
+			},
+			out: dto.GetParams{
+				ClassName: classname, Pagination: defaultPagination,
+				Properties: search.SelectProperties{{Name: "name", IsPrimitive: true, IsObject: false}},
+				AdditionalProperties: additional.Properties{
+					Vector:  true,
+					NoProps: false,
+					Group:   true,
+				},
+				NearVector: &searchparams.NearVector{Vectors: [][]float32{{1, 2, 3}}},
+				GroupBy: &searchparams.GroupBy{
+					Groups: 2, ObjectsPerGroup: 3, Property: "name",
+					Properties: search.SelectProperties{{Name: "name", IsPrimitive: true, IsObject: false}},
+					Strategy:   searchparams.GroupByStrategyDiverse,
+					Sort:       &searchparams.GroupBySort{By: searchparams.GroupBySortScore, Aggregation: searchparams.GroupByScoreSum},
+				},
+			},
+			error: false,
+		},
+		{
+			name: "group by sorted by property",
+			req: &pb.SearchRequest{
+				Collection: classname, Metadata: &pb.MetadataRequest{Vector: true},
+				GroupBy: &pb.GroupBy{
+					Path: []string{"name"}, NumberOfGroups: 2, ObjectsPerGroup: 3,
+					Sort: &pb.GroupBy_Sort{By: pb.GroupBy_Sort_BY_PROPERTY, Descending: true},
+				},
+				NearVector: &pb.NearVector{Vector: []float32{1, 2, 3}},
+				Properties: &pb.PropertiesRequest{},
+			},
+			out: dto.GetParams{
+				ClassName: classname, Pagination: defaultPagination,
+				Properties: search.SelectProperties{{Name: "name", IsPrimitive: true, IsObject: false}},
+				AdditionalProperties: additional.Properties{
+					Vector:  true,
+					NoProps: false,
+					Group:   true,
+				},
+				NearVector: &searchparams.NearVector{Vectors: [][]float32{{1, 2, 3}}},
+				GroupBy: &searchparams.GroupBy{
+					Groups: 2, ObjectsPerGroup: 3, Property: "name",
+					Properties: search.SelectProperties{{Name: "name", IsPrimitive: true, IsObject: false}},
+					Sort:       &searchparams.GroupBySort{By: searchparams.GroupBySortProperty, Descending: true},
+				},
+			},
+			error: false,
+		},
 		{
 			name: "group by with too long path",
 			req: &pb.SearchRequest{
@@ -184,10 +184,11 @@ func (r *Replier) extractAdditionalProps(asMap map[string]any, additionalPropsPa
 		additionalPropertiesMap = asMap["_additional"].(map[string]interface{})
 	} else {
 		addPropertiesGroup := asMap["_additional"].(*additional.GroupHitAdditional)
-		additionalPropertiesMap = make(map[string]interface{}, 3)
+		additionalPropertiesMap = make(map[string]interface{}, 4)
 		additionalPropertiesMap["id"] = addPropertiesGroup.ID
 		additionalPropertiesMap["vector"] = addPropertiesGroup.Vector
 		additionalPropertiesMap["distance"] = addPropertiesGroup.Distance
+		additionalPropertiesMap["score"] = addPropertiesGroup.Score
 	}
 	generativeGroupResults := ""
 	// id is part of the _additional map in case of generative search, group, & rerank - don't aks me why
@@ -257,6 +258,17 @@ func (r *Replier) extractAdditionalProps(asMap map[string]any, additionalPropsPa
 			}
 
 		}
+
+		if sparseVectors, ok := additionalPropertiesMap["sparseVectors"].(models.SparseVectors); ok {
+			metadata.SparseVectors = make([]*pb.SparseVector, 0, len(sparseVectors))
+			for name, vector := range sparseVectors {
+				metadata.SparseVectors = append(metadata.SparseVectors, &pb.SparseVector{
+					Name:    name,
+					Indices: vector.Indices,
+					Values:  vector.Values,
+				})
+			}
+		}
 	}
 
 	if additionalPropsParams.Certainty {
@@ -342,6 +354,19 @@ func (r *Replier) extractAdditionalProps(asMap map[string]any, additionalPropsPa
 		}
 	}
 
+	if additionalPropsParams.Highlights {
+		highlights, ok := additionalPropertiesMap["highlights"]
+		if ok {
+			highlightsfmt, ok2 := highlights.([]*additional.Highlight)
+			if ok2 {
+				metadata.Highlights = make([]*pb.Highlight, len(highlightsfmt))
+				for i, highlight := range highlightsfmt {
+					metadata.Highlights[i] = &pb.Highlight{Property: highlight.Property, Fragments: highlight.Fragments}
+				}
+			}
+		}
+	}
+
 	return metadata, generativeGroupResults, generativeResult, nil
 }
 
@@ -229,6 +229,27 @@ func TestGRPCReply(t *testing.T) {
 			},
 			usesWeaviateStruct: true,
 		},
+		{
+			name: "highlights only",
+			res: []interface{}{
+				map[string]interface{}{
+					"_additional": map[string]interface{}{"highlights": []*additional.Highlight{
+						{Property: "word", Fragments: []string{"<em>word</em>"}},
+					}},
+				},
+				map[string]interface{}{
+					"_additional": map[string]interface{}{"highlights": []*additional.Highlight{}},
+				},
+			},
+			searchParams: dto.GetParams{AdditionalProperties: additional.Properties{Highlights: true}},
+			outSearch: []*pb.SearchResult{
+				{Metadata: &pb.MetadataResult{Highlights: []*pb.Highlight{
+					{Property: "word", Fragments: []string{"<em>word</em>"}},
+				}}, Properties: &pb.PropertiesResult{}},
+				{Metadata: &pb.MetadataResult{Highlights: []*pb.Highlight{}}, Properties: &pb.PropertiesResult{}},
+			},
+			usesWeaviateStruct: true,
+		},
 		{
 			name: "all additional",
 			res: []interface{}{
@@ -0,0 +1,131 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package v1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/weaviate/weaviate/entities/dto"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	"github.com/weaviate/weaviate/usecases/byteops"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+func (s *Service) SearchBatch(ctx context.Context, req *pb.SearchBatchRequest) (*pb.SearchBatchReply, error) {
+	var result *pb.SearchBatchReply
+	var errInner error
+
+	if err := enterrors.GoWrapperWithBlock(func() {
+		result, errInner = s.searchBatch(ctx, req)
+	}, s.logger); err != nil {
+		return nil, err
+	}
+
+	return result, errInner
+}
+
+func (s *Service) searchBatch(ctx context.Context, req *pb.SearchBatchRequest) (*pb.SearchBatchReply, error) {
+	before := time.Now()
+
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("extract auth: %w", err)
+	}
+
+	parser := NewParser(true, s.classGetterWithAuthzFunc(principal))
+	replier := NewReplier(true, true, true, parser.generative, s.logger)
+
+	searchParams, err := searchBatchParamsFromProto(parser, req, s.config)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.validateClassAndProperty(searchParams); err != nil {
+		return nil, err
+	}
+
+	res, err := s.traverser.GetClassBatch(ctx, principal, searchParams)
+	if err != nil {
+		return nil, err
+	}
+
+	scheme := s.schemaManager.GetSchemaSkipAuth()
+	out := &pb.SearchBatchReply{Results: make([]*pb.SearchBatchResult, len(res))}
+	for i := range res {
+		reply, err := replier.Search(res[i], before, searchParams, scheme)
+		if err != nil {
+			return nil, fmt.Errorf("query %d: %w", i, err)
+		}
+		out.Results[i] = &pb.SearchBatchResult{Results: reply.Results}
+	}
+	out.Took = float32(time.Since(before).Seconds())
+	return out, nil
+}
+
+// searchBatchParamsFromProto parses the request as a near vector search with
+// the first query vector, so that properties, metadata and filters are
+// extracted the same way as for a single search, and then replaces the near
+// vector with all vectors of the batch.
+func searchBatchParamsFromProto(parser *Parser, req *pb.SearchBatchRequest, config *config.Config) (dto.GetParams, error) {
+	if len(req.Vectors) == 0 {
+		return dto.GetParams{}, fmt.Errorf("search batch: at least one vector is required")
+	}
+
+	nearVector := &pb.NearVector{
+		VectorBytes: req.Vectors[0],
+		Certainty:   req.Certainty,
+		Distance:    req.Distance,
+	}
+	if req.TargetVector != nil {
+		nearVector.Targets = &pb.Targets{TargetVectors: []string{req.GetTargetVector()}}
+	}
+
+	params, err := parser.Search(&pb.SearchRequest{
+		Collection:       req.Collection,
+		Tenant:           req.Tenant,
+		ConsistencyLevel: req.ConsistencyLevel,
+		Properties:       req.Properties,
+		Metadata:         req.Metadata,
+		Limit:            req.Limit,
+		Offset:           req.Offset,
+		Autocut:          req.Autocut,
+		Filters:          req.Filters,
+		NearVector:       nearVector,
+		Uses_127Api:      true,
+	}, config)
+	if err != nil {
+		return dto.GetParams{}, err
+	}
+
+	batch := &searchparams.NearVectorBatch{
+		Certainty:    params.NearVector.Certainty,
+		Distance:     params.NearVector.Distance,
+		WithDistance: params.NearVector.WithDistance,
+		TargetVector: req.GetTargetVector(),
+		Vectors:      make([][]float32, len(req.Vectors)),
+	}
+	for i, vector := range req.Vectors {
+		if len(vector) == 0 || len(vector)%4 != 0 {
+			return dto.GetParams{}, fmt.Errorf("search batch: vector %d is not a list of float32 values", i)
+		}
+		batch.Vectors[i] = byteops.Float32FromByteVector(vector)
+	}
+
+	params.NearVector = nil
+	params.TargetVectorCombination = nil
+	params.NearVectorBatch = batch
+	return params, nil
+}
@@ -0,0 +1,126 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package v1
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	"github.com/weaviate/weaviate/usecases/byteops"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+func TestSearchBatchRequest(t *testing.T) {
+	scheme := schema.Schema{
+		Objects: &models.Schema{
+			Classes: []*models.Class{
+				{
+					Class: "Product",
+					Properties: []*models.Property{
+						{Name: "name", DataType: schema.DataTypeText.PropString()},
+					},
+					VectorConfig: map[string]models.VectorConfig{
+						"image": {VectorIndexType: "flat"},
+					},
+				},
+			},
+		},
+	}
+	getClass := func(name string) (*models.Class, error) {
+		class := scheme.GetClass(name)
+		if class == nil {
+			return nil, fmt.Errorf("class %s not found", name)
+		}
+		return class, nil
+	}
+	cfg := &config.Config{QueryDefaults: config.QueryDefaults{Limit: 10}}
+
+	vectors := [][]byte{
+		byteops.Float32ToByteVector([]float32{1, 2, 3}),
+		byteops.Float32ToByteVector([]float32{4, 5, 6}),
+	}
+	target := "image"
+	distance := 0.5
+
+	t.Run("valid request", func(t *testing.T) {
+		params, err := searchBatchParamsFromProto(NewParser(true, getClass), &pb.SearchBatchRequest{
+			Collection:   "Product",
+			Tenant:       "tenant1",
+			Limit:        5,
+			Offset:       1,
+			Vectors:      vectors,
+			TargetVector: &target,
+			Distance:     &distance,
+			Metadata:     &pb.MetadataRequest{Distance: true},
+			Filters: &pb.Filters{
+				Operator:  pb.Filters_OPERATOR_EQUAL,
+				TestValue: &pb.Filters_ValueText{ValueText: "lamp"},
+				Target:    &pb.FilterTarget{Target: &pb.FilterTarget_Property{Property: "name"}},
+			},
+		}, cfg)
+		require.Nil(t, err)
+
+		assert.Equal(t, "Product", params.ClassName)
+		assert.Equal(t, "tenant1", params.Tenant)
+		assert.Nil(t, params.NearVector)
+		assert.Equal(t, &searchparams.NearVectorBatch{
+			Distance:     0.5,
+			WithDistance: true,
+			TargetVector: "image",
+			Vectors:      [][]float32{{1, 2, 3}, {4, 5, 6}},
+		}, params.NearVectorBatch)
+		assert.Equal(t, &filters.Pagination{Limit: 5, Offset: 1}, params.Pagination)
+		assert.True(t, params.AdditionalProperties.Distance)
+		require.NotNil(t, params.Filters)
+		assert.Equal(t, filters.OperatorEqual, params.Filters.Root.Operator)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		certainty := 0.8
+		tests := []struct {
+			name string
+			req  *pb.SearchBatchRequest
+		}{
+			{
+				name: "no vectors",
+				req:  &pb.SearchBatchRequest{Collection: "Product"},
+			},
+			{
+				name: "malformed vector",
+				req:  &pb.SearchBatchRequest{Collection: "Product", Vectors: [][]byte{vectors[0], {1, 2, 3}}},
+			},
+			{
+				name: "distance and certainty",
+				req: &pb.SearchBatchRequest{
+					Collection: "Product", Vectors: vectors, Distance: &distance, Certainty: &certainty,
+				},
+			},
+			{
+				name: "unknown collection",
+				req:  &pb.SearchBatchRequest{Collection: "Unknown", Vectors: vectors},
+			},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				_, err := searchBatchParamsFromProto(NewParser(true, getClass), tt.req, cfg)
+				require.NotNil(t, err)
+			})
+		}
+	})
+}
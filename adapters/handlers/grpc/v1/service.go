@@ -258,13 +258,21 @@ func (s *Service) search(ctx context.Context, req *pb.SearchRequest) (*pb.Search
 		return nil, err
 	}
 
-	res, err := s.traverser.GetClass(ctx, principal, searchParams)
+	// facets are returned next to the results, even if there are none
+	res, facetResults, err := s.traverser.GetClassWithFacets(ctx, principal, searchParams)
 	if err != nil {
 		return nil, err
 	}
 
 	scheme := s.schemaManager.GetSchemaSkipAuth()
-	return replier.Search(res, before, searchParams, scheme)
+	reply, err := replier.Search(res, before, searchParams, scheme)
+	if err != nil {
+		return nil, err
+	}
+	if len(searchParams.Facets) > 0 {
+		reply.Facets = facetResultsToProto(facetResults)
+	}
+	return reply, nil
 }
 
 func (s *Service) validateClassAndProperty(searchParams dto.GetParams) error {
@@ -0,0 +1,159 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/weaviate/weaviate/entities/dto"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+)
+
+func (s *Service) Traverse(ctx context.Context, req *pb.TraverseRequest) (*pb.TraverseReply, error) {
+	var result *pb.TraverseReply
+	var errInner error
+
+	if err := enterrors.GoWrapperWithBlock(func() {
+		result, errInner = s.traverse(ctx, req)
+	}, s.logger); err != nil {
+		return nil, err
+	}
+
+	return result, errInner
+}
+
+func (s *Service) traverse(ctx context.Context, req *pb.TraverseRequest) (*pb.TraverseReply, error) {
+	before := time.Now()
+
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("extract auth: %w", err)
+	}
+
+	params, err := traverseParamsFromProto(req, s.classGetterWithAuthzFunc(principal))
+	if err != nil {
+		return nil, fmt.Errorf("traverse params: %w", err)
+	}
+
+	res, err := s.traverser.Traverse(ctx, principal, params)
+	if err != nil {
+		return nil, err
+	}
+
+	return traverseReplyFromResult(res, before)
+}
+
+func traverseParamsFromProto(req *pb.TraverseRequest, authorizedGetClass func(string) (*models.Class, error)) (dto.TraverseParams, error) {
+	params := dto.TraverseParams{
+		ClassName: req.Collection,
+		Tenant:    req.Tenant,
+		MaxDepth:  int(req.MaxDepth),
+		MaxFanOut: int(req.MaxFanOut),
+		MaxNodes:  int(req.MaxNodes),
+	}
+
+	for _, id := range req.StartUuids {
+		if !strfmt.IsUUID(id) {
+			return dto.TraverseParams{}, fmt.Errorf("invalid start uuid %q", id)
+		}
+		params.StartIDs = append(params.StartIDs, strfmt.UUID(id))
+	}
+	if req.TargetUuid != nil {
+		if !strfmt.IsUUID(*req.TargetUuid) {
+			return dto.TraverseParams{}, fmt.Errorf("invalid target uuid %q", *req.TargetUuid)
+		}
+		params.TargetID = strfmt.UUID(*req.TargetUuid)
+	}
+
+	for i, hopIn := range req.Hops {
+		hop := dto.TraverseHop{
+			Properties: hopIn.Properties,
+			ClassName:  hopIn.GetCollection(),
+		}
+		if hopIn.Filters != nil {
+			if hop.ClassName == "" {
+				return dto.TraverseParams{}, fmt.Errorf("hop %d: filters require a collection", i)
+			}
+			clause, err := ExtractFilters(hopIn.Filters, authorizedGetClass, hop.ClassName)
+			if err != nil {
+				return dto.TraverseParams{}, fmt.Errorf("hop %d: %w", i, err)
+			}
+			hop.Filters = &filters.LocalFilter{Root: &clause}
+		}
+		params.Hops = append(params.Hops, hop)
+	}
+
+	return params, nil
+}
+
+func traverseReplyFromResult(res *dto.TraverseResult, before time.Time) (*pb.TraverseReply, error) {
+	reply := &pb.TraverseReply{
+		Nodes:     make([]*pb.TraverseNode, len(res.Nodes)),
+		Edges:     make([]*pb.TraverseEdge, len(res.Edges)),
+		Path:      make([]string, len(res.Path)),
+		Truncated: res.Truncated,
+	}
+
+	for i, node := range res.Nodes {
+		props, err := propertiesToStruct(node.Properties)
+		if err != nil {
+			return nil, fmt.Errorf("node %s: %w", node.ID, err)
+		}
+		reply.Nodes[i] = &pb.TraverseNode{
+			Collection: node.ClassName,
+			Uuid:       node.ID.String(),
+			Depth:      uint32(node.Depth),
+			Properties: props,
+		}
+	}
+	for i, edge := range res.Edges {
+		reply.Edges[i] = &pb.TraverseEdge{
+			FromCollection: edge.FromClass,
+			FromUuid:       edge.From.String(),
+			Property:       edge.Property,
+			ToCollection:   edge.ToClass,
+			ToUuid:         edge.To.String(),
+		}
+	}
+	for i, id := range res.Path {
+		reply.Path[i] = id.String()
+	}
+
+	reply.Took = float32(time.Since(before).Seconds())
+	return reply, nil
+}
+
+// propertiesToStruct converts properties via their JSON representation, so
+// references, dates and geo coordinates look the same as in REST responses
+func propertiesToStruct(props map[string]interface{}) (*structpb.Struct, error) {
+	if props == nil {
+		return nil, nil
+	}
+	b, err := json.Marshal(props)
+	if err != nil {
+		return nil, err
+	}
+	out := &structpb.Struct{}
+	if err := out.UnmarshalJSON(b); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
@@ -0,0 +1,147 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package v1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+)
+
+func TestTraverseRequest(t *testing.T) {
+	scheme := schema.Schema{
+		Objects: &models.Schema{
+			Classes: []*models.Class{
+				{
+					Class: "Person",
+					Properties: []*models.Property{
+						{Name: "worksAt", DataType: []string{"Company"}},
+					},
+				},
+				{
+					Class: "Company",
+					Properties: []*models.Property{
+						{Name: "name", DataType: schema.DataTypeText.PropString()},
+					},
+				},
+			},
+		},
+	}
+	getClass := func(name string) (*models.Class, error) {
+		return scheme.GetClass(name), nil
+	}
+
+	startID := "4bd2ef51-3eb3-4b1c-9c71-5e5f2b7d4b1f"
+	targetID := "a7d1a6e4-5f0c-4b2e-9a5e-3c1f7b2d9e01"
+	nameFilter := &pb.Filters{
+		Operator:  pb.Filters_OPERATOR_EQUAL,
+		TestValue: &pb.Filters_ValueText{ValueText: "acme"},
+		Target:    &pb.FilterTarget{Target: &pb.FilterTarget_Property{Property: "name"}},
+	}
+	company := "Company"
+
+	t.Run("valid request", func(t *testing.T) {
+		params, err := traverseParamsFromProto(&pb.TraverseRequest{
+			Collection: "Person",
+			StartUuids: []string{startID},
+			Tenant:     "tenant1",
+			Hops: []*pb.TraverseHop{
+				{Properties: []string{"worksAt"}, Collection: &company, Filters: nameFilter},
+				{},
+			},
+			MaxDepth:   2,
+			MaxFanOut:  10,
+			MaxNodes:   100,
+			TargetUuid: &targetID,
+		}, getClass)
+		require.Nil(t, err)
+		assert.Equal(t, dto.TraverseParams{
+			ClassName: "Person",
+			StartIDs:  []strfmt.UUID{strfmt.UUID(startID)},
+			Tenant:    "tenant1",
+			Hops: []dto.TraverseHop{
+				{
+					Properties: []string{"worksAt"},
+					ClassName:  "Company",
+					Filters: &filters.LocalFilter{Root: &filters.Clause{
+						Operator: filters.OperatorEqual,
+						On:       &filters.Path{Class: "Company", Property: "name"},
+						Value:    &filters.Value{Value: "acme", Type: schema.DataTypeText},
+					}},
+				},
+				{},
+			},
+			MaxDepth:  2,
+			MaxFanOut: 10,
+			MaxNodes:  100,
+			TargetID:  strfmt.UUID(targetID),
+		}, params)
+	})
+
+	for name, req := range map[string]*pb.TraverseRequest{
+		"invalid start uuid":  {Collection: "Person", StartUuids: []string{"foo"}},
+		"invalid target uuid": {Collection: "Person", StartUuids: []string{startID}, TargetUuid: &company},
+		"filter without collection": {
+			Collection: "Person", StartUuids: []string{startID},
+			Hops: []*pb.TraverseHop{{Filters: nameFilter}},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := traverseParamsFromProto(req, getClass)
+			assert.NotNil(t, err)
+		})
+	}
+}
+
+func TestTraverseReply(t *testing.T) {
+	res := &dto.TraverseResult{
+		Nodes: []dto.TraverseNode{
+			{ClassName: "Person", ID: "4bd2ef51-3eb3-4b1c-9c71-5e5f2b7d4b1f", Properties: map[string]interface{}{
+				"name": "alice",
+				"worksAt": models.MultipleRef{
+					{Beacon: "weaviate://localhost/Company/a7d1a6e4-5f0c-4b2e-9a5e-3c1f7b2d9e01"},
+				},
+			}},
+			{ClassName: "Company", ID: "a7d1a6e4-5f0c-4b2e-9a5e-3c1f7b2d9e01", Depth: 1},
+		},
+		Edges: []dto.TraverseEdge{{
+			FromClass: "Person", From: "4bd2ef51-3eb3-4b1c-9c71-5e5f2b7d4b1f", Property: "worksAt",
+			ToClass: "Company", To: "a7d1a6e4-5f0c-4b2e-9a5e-3c1f7b2d9e01",
+		}},
+		Path:      []strfmt.UUID{"4bd2ef51-3eb3-4b1c-9c71-5e5f2b7d4b1f", "a7d1a6e4-5f0c-4b2e-9a5e-3c1f7b2d9e01"},
+		Truncated: true,
+	}
+
+	reply, err := traverseReplyFromResult(res, time.Now())
+	require.Nil(t, err)
+	require.Len(t, reply.Nodes, 2)
+	assert.Equal(t, "Person", reply.Nodes[0].Collection)
+	assert.Equal(t, "alice", reply.Nodes[0].Properties.Fields["name"].GetStringValue())
+	beacon := reply.Nodes[0].Properties.Fields["worksAt"].GetListValue().Values[0].GetStructValue().Fields["beacon"]
+	assert.Equal(t, "weaviate://localhost/Company/a7d1a6e4-5f0c-4b2e-9a5e-3c1f7b2d9e01", beacon.GetStringValue())
+	assert.Nil(t, reply.Nodes[1].Properties)
+	assert.Equal(t, uint32(1), reply.Nodes[1].Depth)
+	assert.Equal(t, &pb.TraverseEdge{
+		FromCollection: "Person", FromUuid: "4bd2ef51-3eb3-4b1c-9c71-5e5f2b7d4b1f", Property: "worksAt",
+		ToCollection: "Company", ToUuid: "a7d1a6e4-5f0c-4b2e-9a5e-3c1f7b2d9e01",
+	}, reply.Edges[0])
+	assert.Equal(t, []string{"4bd2ef51-3eb3-4b1c-9c71-5e5f2b7d4b1f", "a7d1a6e4-5f0c-4b2e-9a5e-3c1f7b2d9e01"}, reply.Path)
+	assert.True(t, reply.Truncated)
+}
@@ -31,6 +31,7 @@ import (
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/aggregation"
 	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/facets"
 	"github.com/weaviate/weaviate/entities/filters"
 	entschema "github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/search"
@@ -54,9 +55,11 @@ type indices struct {
 	regexObjectsDigestsInTokenRange *regexp.Regexp
 	regexObjectsHashTreeLevel       *regexp.Regexp
 	regexpObjectsSearch             *regexp.Regexp
+	regexpObjectsSearchBatch        *regexp.Regexp
 	regexpObjectsFind               *regexp.Regexp
 
 	regexpObjectsAggregations *regexp.Regexp
+	regexpObjectsFacets       *regexp.Regexp
 	regexpObject              *regexp.Regexp
 	regexpReferences          *regexp.Regexp
 	regexpShardsQueueSize     *regexp.Regexp
@@ -86,10 +89,14 @@ const (
 		`\/shards\/(` + sh + `)\/objects\/hashtree\/(` + l + `)`
 	urlPatternObjectsSearch = `\/indices\/(` + cl + `)` +
 		`\/shards\/(` + sh + `)\/objects\/_search`
+	urlPatternObjectsSearchBatch = `\/indices\/(` + cl + `)` +
+		`\/shards\/(` + sh + `)\/objects\/_searchbatch`
 	urlPatternObjectsFind = `\/indices\/(` + cl + `)` +
 		`\/shards\/(` + sh + `)\/objects\/_find`
 	urlPatternObjectsAggregations = `\/indices\/(` + cl + `)` +
 		`\/shards\/(` + sh + `)\/objects\/_aggregations`
+	urlPatternObjectsFacets = `\/indices\/(` + cl + `)` +
+		`\/shards\/(` + sh + `)\/objects\/_facets`
 	urlPatternObject = `\/indices\/(` + cl + `)` +
 		`\/shards\/(` + sh + `)\/objects\/(` + ob + `)`
 	urlPatternReferences = `\/indices\/(` + cl + `)` +
@@ -129,9 +136,16 @@ type shards interface {
 		filters *filters.LocalFilter, keywordRanking *searchparams.KeywordRanking,
 		sort []filters.Sort, cursor *filters.Cursor, groupBy *searchparams.GroupBy,
 		additional additional.Properties, targetCombination *dto.TargetCombination, properties []string,
+		matryoshkaDims int,
 	) ([]*storobj.Object, []float32, error)
+	SearchBatch(ctx context.Context, indexName, shardName string,
+		vectors [][]float32, targetVector string, distance float32, limit int,
+		filters *filters.LocalFilter, additional additional.Properties, properties []string,
+	) ([][]*storobj.Object, [][]float32, error)
 	Aggregate(ctx context.Context, indexName, shardName string,
 		params aggregation.Params) (*aggregation.Result, error)
+	Facets(ctx context.Context, indexName, shardName string,
+		params facets.Params) ([]facets.Result, error)
 	FindUUIDs(ctx context.Context, indexName, shardName string,
 		filters *filters.LocalFilter) ([]strfmt.UUID, error)
 	DeleteObjectBatch(ctx context.Context, indexName, shardName string,
@@ -170,9 +184,11 @@ func NewIndices(shards shards, db db, auth auth, maintenanceModeEnabled func() b
 		regexObjectsDigestsInTokenRange: regexp.MustCompile(urlPatternObjectsDigestsInTokenRange),
 		regexObjectsHashTreeLevel:       regexp.MustCompile(urlPatternHashTreeLevel),
 		regexpObjectsSearch:             regexp.MustCompile(urlPatternObjectsSearch),
+		regexpObjectsSearchBatch:        regexp.MustCompile(urlPatternObjectsSearchBatch),
 		regexpObjectsFind:               regexp.MustCompile(urlPatternObjectsFind),
 
 		regexpObjectsAggregations: regexp.MustCompile(urlPatternObjectsAggregations),
+		regexpObjectsFacets:       regexp.MustCompile(urlPatternObjectsFacets),
 		regexpObject:              regexp.MustCompile(urlPatternObject),
 		regexpReferences:          regexp.MustCompile(urlPatternReferences),
 		regexpShardsQueueSize:     regexp.MustCompile(urlPatternShardsQueueSize),
@@ -202,6 +218,16 @@ func (i *indices) indicesHandler() http.HandlerFunc {
 		// NOTE if you update any of these handler methods/paths, also update the indices_test.go
 		// TestMaintenanceModeIndices test to include the new methods/paths.
 		switch {
+		// the batch search is matched first, as its path extends the one of
+		// the single search
+		case i.regexpObjectsSearchBatch.MatchString(path):
+			if r.Method != http.MethodPost {
+				http.Error(w, "405 Method not Allowed", http.StatusMethodNotAllowed)
+				return
+			}
+
+			i.postSearchObjectsBatch().ServeHTTP(w, r)
+			return
 		case i.regexpObjectsSearch.MatchString(path):
 			if r.Method != http.MethodPost {
 				http.Error(w, "405 Method not Allowed", http.StatusMethodNotAllowed)
@@ -226,6 +252,14 @@ func (i *indices) indicesHandler() http.HandlerFunc {
 
 			i.postAggregateObjects().ServeHTTP(w, r)
 			return
+		case i.regexpObjectsFacets.MatchString(path):
+			if r.Method != http.MethodPost {
+				http.Error(w, "405 Method not Allowed", http.StatusMethodNotAllowed)
+				return
+			}
+
+			i.postFacets().ServeHTTP(w, r)
+			return
 		case i.regexpObjectsOverwrite.MatchString(path):
 			if r.Method != http.MethodPut {
 				http.Error(w, "405 Method not Allowed", http.StatusMethodNotAllowed)
@@ -714,7 +748,7 @@ func (i *indices) postSearchObjects() http.Handler {
 			return
 		}
 
-		vector, targetVector, certainty, limit, filters, keywordRanking, sort, cursor, groupBy, additional, targetCombination, props, err := IndicesPayloads.SearchParams.
+		vector, targetVector, certainty, limit, filters, keywordRanking, sort, cursor, groupBy, additional, targetCombination, props, matryoshkaDims, nprobe, rangeCursor, err := IndicesPayloads.SearchParams.
 			Unmarshal(reqPayload)
 		if err != nil {
 			http.Error(w, "unmarshal search params from json: "+err.Error(),
@@ -727,8 +761,15 @@ func (i *indices) postSearchObjects() http.Handler {
 			"action": "Search",
 		}).Debug("searching ...")
 
-		results, dists, err := i.shards.Search(r.Context(), index, shard,
-			vector, targetVector, certainty, limit, filters, keywordRanking, sort, cursor, groupBy, additional, targetCombination, props)
+		ctx := r.Context()
+		if nprobe > 0 {
+			ctx = searchparams.ContextWithNProbe(ctx, nprobe)
+		}
+		if rangeCursor != nil {
+			ctx = searchparams.ContextWithRangeCursor(ctx, rangeCursor)
+		}
+		results, dists, err := i.shards.Search(ctx, index, shard,
+			vector, targetVector, certainty, limit, filters, keywordRanking, sort, cursor, groupBy, additional, targetCombination, props, matryoshkaDims)
 		if err != nil && errors.As(err, &enterrors.ErrUnprocessable{}) {
 			http.Error(w, err.Error(), http.StatusUnprocessableEntity)
 			return
@@ -749,6 +790,69 @@ func (i *indices) postSearchObjects() http.Handler {
 	})
 }
 
+func (i *indices) postSearchObjectsBatch() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		args := i.regexpObjectsSearchBatch.FindStringSubmatch(r.URL.Path)
+		if len(args) != 3 {
+			http.Error(w, "invalid URI", http.StatusBadRequest)
+			return
+		}
+
+		index, shard := args[1], args[2]
+
+		defer r.Body.Close()
+		reqPayload, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, "read request body: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		ct, ok := IndicesPayloads.SearchBatchParams.CheckContentTypeHeaderReq(r)
+		if !ok {
+			http.Error(w, errors.Errorf("unexpected content type: %s", ct).Error(),
+				http.StatusUnsupportedMediaType)
+			return
+		}
+
+		vectors, targetVector, distance, limit, filters, additional, props, nprobe, err := IndicesPayloads.SearchBatchParams.
+			Unmarshal(reqPayload)
+		if err != nil {
+			http.Error(w, "unmarshal search batch params from json: "+err.Error(),
+				http.StatusBadRequest)
+			return
+		}
+
+		i.logger.WithFields(logrus.Fields{
+			"shard":  shard,
+			"action": "SearchBatch",
+		}).Debug("searching ...")
+
+		ctx := r.Context()
+		if nprobe > 0 {
+			ctx = searchparams.ContextWithNProbe(ctx, nprobe)
+		}
+		results, dists, err := i.shards.SearchBatch(ctx, index, shard,
+			vectors, targetVector, distance, limit, filters, additional, props)
+		if err != nil && errors.As(err, &enterrors.ErrUnprocessable{}) {
+			http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+			return
+		}
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		resBytes, err := IndicesPayloads.SearchBatchResults.Marshal(results, dists)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		IndicesPayloads.SearchBatchResults.SetContentTypeHeader(w)
+		w.Write(resBytes)
+	})
+}
+
 func (i *indices) postReferences() http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		args := i.regexpReferences.FindStringSubmatch(r.URL.Path)
@@ -799,6 +903,65 @@ func (i *indices) postReferences() http.Handler {
 	})
 }
 
+func (i *indices) postFacets() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		args := i.regexpObjectsFacets.FindStringSubmatch(r.URL.Path)
+		if len(args) != 3 {
+			http.Error(w, "invalid URI", http.StatusBadRequest)
+			return
+		}
+
+		index, shard := args[1], args[2]
+
+		defer r.Body.Close()
+		reqPayload, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, "read request body: "+err.Error(),
+				http.StatusInternalServerError)
+			return
+		}
+
+		ct, ok := IndicesPayloads.FacetsParams.CheckContentTypeHeaderReq(r)
+		if !ok {
+			http.Error(w, errors.Errorf("unexpected content type: %s", ct).Error(),
+				http.StatusUnsupportedMediaType)
+			return
+		}
+
+		params, err := IndicesPayloads.FacetsParams.Unmarshal(reqPayload)
+		if err != nil {
+			http.Error(w, "read request body: "+err.Error(),
+				http.StatusInternalServerError)
+			return
+		}
+
+		i.logger.WithFields(logrus.Fields{
+			"shard":  shard,
+			"action": "Facets",
+		}).Debug("facets ...")
+
+		results, err := i.shards.Facets(r.Context(), index, shard, params)
+
+		if err != nil && errors.As(err, &enterrors.ErrUnprocessable{}) {
+			http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+			return
+		}
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		resBytes, err := IndicesPayloads.FacetsResults.Marshal(results)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		IndicesPayloads.FacetsResults.SetContentTypeHeader(w)
+		w.Write(resBytes)
+	})
+}
+
 func (i *indices) postAggregateObjects() http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		args := i.regexpObjectsAggregations.FindStringSubmatch(r.URL.Path)
@@ -29,6 +29,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/aggregation"
+	"github.com/weaviate/weaviate/entities/facets"
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/searchparams"
 	"github.com/weaviate/weaviate/entities/storobj"
@@ -46,11 +47,15 @@ type indicesPayloads struct {
 	VersionedObjectList       versionedObjectListPayload
 	SearchResults             searchResultsPayload
 	SearchParams              searchParamsPayload
+	SearchBatchParams         searchBatchParamsPayload
+	SearchBatchResults        searchBatchResultsPayload
 	VectorDistanceParams      vectorDistanceParamsPayload
 	VectorDistanceResults     vectorDistanceResultsPayload
 	ReferenceList             referenceListPayload
 	AggregationParams         aggregationParamsPayload
 	AggregationResult         aggregationResultPayload
+	FacetsParams              facetsParamsPayload
+	FacetsResults             facetsResultsPayload
 	FindUUIDsParams           findUUIDsParamsPayload
 	FindUUIDsResults          findUUIDsResultsPayload
 	BatchDeleteParams         batchDeleteParamsPayload
@@ -438,6 +443,7 @@ func (p searchParamsPayload) Marshal(vectors [][]float32, targetVectors []string
 	filter *filters.LocalFilter, keywordRanking *searchparams.KeywordRanking,
 	sort []filters.Sort, cursor *filters.Cursor, groupBy *searchparams.GroupBy,
 	addP additional.Properties, targetCombination *dto.TargetCombination, properties []string,
+	matryoshkaDims, nprobe int, rangeCursor *searchparams.RangeCursor,
 ) ([]byte, error) {
 	type params struct {
 		SearchVector      []float32                    `json:"searchVector"`
@@ -453,6 +459,14 @@ func (p searchParamsPayload) Marshal(vectors [][]float32, targetVectors []string
 		TargetVectors     []string                     `json:"targetVectors"`
 		TargetCombination *dto.TargetCombination       `json:"targetCombination"`
 		Properties        []string                     `json:"properties"`
+		// MatryoshkaDimensions is the number of dimensions candidates are
+		// re-ranked with if the index holds truncated vectors
+		MatryoshkaDimensions int `json:"matryoshkaDimensions"`
+		// NProbe is the number of posting lists an ivf index scans
+		NProbe int `json:"nprobe"`
+		// RangeCursor continues a search by distance behind the last result
+		// of the previous page
+		RangeCursor *searchparams.RangeCursor `json:"rangeCursor"`
 	}
 	var vector []float32
 	var targetVector string
@@ -462,13 +476,14 @@ func (p searchParamsPayload) Marshal(vectors [][]float32, targetVectors []string
 		targetVector = targetVectors[0]
 	}
 
-	par := params{vector, targetVector, limit, filter, keywordRanking, sort, cursor, groupBy, addP, vectors, targetVectors, targetCombination, properties}
+	par := params{vector, targetVector, limit, filter, keywordRanking, sort, cursor, groupBy, addP, vectors, targetVectors, targetCombination, properties, matryoshkaDims, nprobe, rangeCursor}
 	return json.Marshal(par)
 }
 
 func (p searchParamsPayload) Unmarshal(in []byte) ([][]float32, []string, float32, int,
 	*filters.LocalFilter, *searchparams.KeywordRanking, []filters.Sort,
-	*filters.Cursor, *searchparams.GroupBy, additional.Properties, *dto.TargetCombination, []string, error,
+	*filters.Cursor, *searchparams.GroupBy, additional.Properties, *dto.TargetCombination, []string, int, int,
+	*searchparams.RangeCursor, error,
 ) {
 	type searchParametersPayload struct {
 		SearchVector      []float32                    `json:"searchVector"`
@@ -485,6 +500,14 @@ func (p searchParamsPayload) Unmarshal(in []byte) ([][]float32, []string, float3
 		TargetVectors     []string                     `json:"targetVectors"`
 		TargetCombination *dto.TargetCombination       `json:"targetCombination"`
 		Properties        []string                     `json:"properties"`
+		// MatryoshkaDimensions is the number of dimensions candidates are
+		// re-ranked with if the index holds truncated vectors
+		MatryoshkaDimensions int `json:"matryoshkaDimensions"`
+		// NProbe is the number of posting lists an ivf index scans
+		NProbe int `json:"nprobe"`
+		// RangeCursor continues a search by distance behind the last result
+		// of the previous page
+		RangeCursor *searchparams.RangeCursor `json:"rangeCursor"`
 	}
 	var par searchParametersPayload
 	err := json.Unmarshal(in, &par)
@@ -494,7 +517,8 @@ func (p searchParamsPayload) Unmarshal(in []byte) ([][]float32, []string, float3
 	}
 
 	return par.SearchVectors, par.TargetVectors, par.Distance, par.Limit,
-		par.Filters, par.KeywordRanking, par.Sort, par.Cursor, par.GroupBy, par.Additional, par.TargetCombination, par.Properties, err
+		par.Filters, par.KeywordRanking, par.Sort, par.Cursor, par.GroupBy, par.Additional, par.TargetCombination, par.Properties, par.MatryoshkaDimensions, par.NProbe,
+		par.RangeCursor, err
 }
 
 func (p searchParamsPayload) MIME() string {
@@ -583,6 +607,137 @@ func (p searchResultsPayload) CheckContentTypeHeader(r *http.Response) (string,
 	return ct, ct == p.MIME()
 }
 
+type searchBatchParamsPayload struct{}
+
+func (p searchBatchParamsPayload) Marshal(vectors [][]float32, targetVector string,
+	distance float32, limit int, filter *filters.LocalFilter, addP additional.Properties,
+	properties []string, nprobe int,
+) ([]byte, error) {
+	type params struct {
+		SearchVectors [][]float32           `json:"searchVectors"`
+		TargetVector  string                `json:"targetVector"`
+		Distance      float32               `json:"distance"`
+		Limit         int                   `json:"limit"`
+		Filters       *filters.LocalFilter  `json:"filters"`
+		Additional    additional.Properties `json:"additional"`
+		Properties    []string              `json:"properties"`
+		NProbe        int                   `json:"nprobe"`
+	}
+	par := params{vectors, targetVector, distance, limit, filter, addP, properties, nprobe}
+	return json.Marshal(par)
+}
+
+func (p searchBatchParamsPayload) Unmarshal(in []byte) ([][]float32, string,
+	float32, int, *filters.LocalFilter, additional.Properties, []string, int, error,
+) {
+	type searchBatchParametersPayload struct {
+		SearchVectors [][]float32           `json:"searchVectors"`
+		TargetVector  string                `json:"targetVector"`
+		Distance      float32               `json:"distance"`
+		Limit         int                   `json:"limit"`
+		Filters       *filters.LocalFilter  `json:"filters"`
+		Additional    additional.Properties `json:"additional"`
+		Properties    []string              `json:"properties"`
+		NProbe        int                   `json:"nprobe"`
+	}
+	var par searchBatchParametersPayload
+	err := json.Unmarshal(in, &par)
+	return par.SearchVectors, par.TargetVector, par.Distance, par.Limit,
+		par.Filters, par.Additional, par.Properties, par.NProbe, err
+}
+
+func (p searchBatchParamsPayload) MIME() string {
+	return "vnd.weaviate.searchbatchparams+json"
+}
+
+func (p searchBatchParamsPayload) CheckContentTypeHeaderReq(r *http.Request) (string, bool) {
+	ct := r.Header.Get("content-type")
+	return ct, ct == p.MIME()
+}
+
+func (p searchBatchParamsPayload) SetContentTypeHeaderReq(r *http.Request) {
+	r.Header.Set("content-type", p.MIME())
+}
+
+// searchBatchResultsPayload holds the search results of every query vector,
+// each encoded like the results of a single search and prefixed with its
+// length
+type searchBatchResultsPayload struct{}
+
+func (p searchBatchResultsPayload) Unmarshal(in []byte) ([][]*storobj.Object, [][]float32, error) {
+	read := uint64(0)
+	if len(in) < 8 {
+		return nil, nil, errors.Errorf("corrupt read: %d bytes", len(in))
+	}
+
+	queries := binary.LittleEndian.Uint64(in[read : read+8])
+	read += 8
+
+	objs := make([][]*storobj.Object, queries)
+	dists := make([][]float32, queries)
+	for q := range objs {
+		if read+8 > uint64(len(in)) {
+			return nil, nil, errors.Errorf("corrupt read: %d > %d", read+8, len(in))
+		}
+		length := binary.LittleEndian.Uint64(in[read : read+8])
+		read += 8
+		if read+length > uint64(len(in)) {
+			return nil, nil, errors.Errorf("corrupt read: %d > %d", read+length, len(in))
+		}
+
+		var err error
+		objs[q], dists[q], err = IndicesPayloads.SearchResults.Unmarshal(in[read : read+length])
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "query %d", q)
+		}
+		read += length
+	}
+
+	if read != uint64(len(in)) {
+		return nil, nil, errors.Errorf("corrupt read: %d != %d", read, len(in))
+	}
+
+	return objs, dists, nil
+}
+
+func (p searchBatchResultsPayload) Marshal(objs [][]*storobj.Object,
+	dists [][]float32,
+) ([]byte, error) {
+	if len(objs) != len(dists) {
+		return nil, errors.Errorf("%d object lists but %d distance lists", len(objs), len(dists))
+	}
+
+	reusableLengthBuf := make([]byte, 8)
+	binary.LittleEndian.PutUint64(reusableLengthBuf, uint64(len(objs)))
+	out := append([]byte{}, reusableLengthBuf...)
+
+	for q := range objs {
+		queryBytes, err := IndicesPayloads.SearchResults.Marshal(objs[q], dists[q])
+		if err != nil {
+			return nil, errors.Wrapf(err, "query %d", q)
+		}
+
+		binary.LittleEndian.PutUint64(reusableLengthBuf, uint64(len(queryBytes)))
+		out = append(out, reusableLengthBuf...)
+		out = append(out, queryBytes...)
+	}
+
+	return out, nil
+}
+
+func (p searchBatchResultsPayload) MIME() string {
+	return "application/vnd.weaviate.shardsearchbatchresults+octet-stream"
+}
+
+func (p searchBatchResultsPayload) SetContentTypeHeader(w http.ResponseWriter) {
+	w.Header().Set("content-type", p.MIME())
+}
+
+func (p searchBatchResultsPayload) CheckContentTypeHeader(r *http.Response) (string, bool) {
+	ct := r.Header.Get("content-type")
+	return ct, ct == p.MIME()
+}
+
 type referenceListPayload struct{}
 
 func (p referenceListPayload) MIME() string {
@@ -667,6 +822,56 @@ func (p aggregationResultPayload) Unmarshal(in []byte) (*aggregation.Result, err
 	return &out, err
 }
 
+type facetsParamsPayload struct{}
+
+func (p facetsParamsPayload) Marshal(params facets.Params) ([]byte, error) {
+	return json.Marshal(params)
+}
+
+func (p facetsParamsPayload) Unmarshal(in []byte) (facets.Params, error) {
+	var out facets.Params
+	err := json.Unmarshal(in, &out)
+	return out, err
+}
+
+func (p facetsParamsPayload) MIME() string {
+	return "application/vnd.weaviate.facets.params+json"
+}
+
+func (p facetsParamsPayload) SetContentTypeHeaderReq(r *http.Request) {
+	r.Header.Set("content-type", p.MIME())
+}
+
+func (p facetsParamsPayload) CheckContentTypeHeaderReq(r *http.Request) (string, bool) {
+	ct := r.Header.Get("content-type")
+	return ct, ct == p.MIME()
+}
+
+type facetsResultsPayload struct{}
+
+func (p facetsResultsPayload) MIME() string {
+	return "application/vnd.weaviate.facets.results+json"
+}
+
+func (p facetsResultsPayload) CheckContentTypeHeader(res *http.Response) (string, bool) {
+	ct := res.Header.Get("content-type")
+	return ct, ct == p.MIME()
+}
+
+func (p facetsResultsPayload) SetContentTypeHeader(w http.ResponseWriter) {
+	w.Header().Set("content-type", p.MIME())
+}
+
+func (p facetsResultsPayload) Marshal(in []facets.Result) ([]byte, error) {
+	return json.Marshal(in)
+}
+
+func (p facetsResultsPayload) Unmarshal(in []byte) ([]facets.Result, error) {
+	var out []facets.Result
+	err := json.Unmarshal(in, &out)
+	return out, err
+}
+
 type findUUIDsParamsPayload struct{}
 
 func (p findUUIDsParamsPayload) Marshal(filter *filters.LocalFilter) ([]byte, error) {
@@ -167,13 +167,15 @@ func TestBackwardCompatibilitySearch(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run("test", func(t *testing.T) {
-			b126, err := payload.Marshal(tt.SearchVectors, tt.Targets, 10, nil, nil, nil, nil, nil, additional.Properties{}, nil, nil)
+			rangeCursor := &searchparams.RangeCursor{Distance: 0.5, ID: "c6f85bf5-c3b7-4c1d-bd51-e899f9605336"}
+			b126, err := payload.Marshal(tt.SearchVectors, tt.Targets, 10, nil, nil, nil, nil, nil, additional.Properties{}, nil, nil, 0, 0, rangeCursor)
 			require.Nil(t, err)
 
-			vecs, targets, _, _, _, _, _, _, _, _, _, _, err := payload.Unmarshal(b126)
+			vecs, targets, _, _, _, _, _, _, _, _, _, _, _, _, gotRangeCursor, err := payload.Unmarshal(b126)
 			require.Nil(t, err)
 			assert.Equal(t, tt.SearchVectors, vecs)
 			assert.Equal(t, tt.Targets, targets)
+			assert.Equal(t, rangeCursor, gotRangeCursor)
 
 			if tt.compatible {
 				payloadOld := searchParamsPayloadOld{}
@@ -184,7 +186,7 @@ func TestBackwardCompatibilitySearch(t *testing.T) {
 				assert.Equal(t, tt.SearchVectors[0], vecsOld)
 				assert.Equal(t, tt.Targets[0], targetsOld)
 
-				vecs, targets, _, _, _, _, _, _, _, _, _, _, err := payload.Unmarshal(b125)
+				vecs, targets, _, _, _, _, _, _, _, _, _, _, _, _, _, err := payload.Unmarshal(b125)
 				require.Nil(t, err)
 				assert.Equal(t, tt.SearchVectors, vecs)
 				assert.Equal(t, tt.Targets, targets)
@@ -193,3 +195,54 @@ func TestBackwardCompatibilitySearch(t *testing.T) {
 		})
 	}
 }
+
+func TestSearchBatchPayloads(t *testing.T) {
+	t.Run("params", func(t *testing.T) {
+		payload := searchBatchParamsPayload{}
+		vectors := [][]float32{{1, 2, 3}, {4, 5, 6}}
+
+		b, err := payload.Marshal(vectors, "target", 0.5, 10, nil,
+			additional.Properties{Distance: true}, []string{"name"}, 32)
+		require.Nil(t, err)
+
+		vecs, target, distance, limit, filter, addP, props, nprobe, err := payload.Unmarshal(b)
+		require.Nil(t, err)
+		assert.Equal(t, vectors, vecs)
+		assert.Equal(t, "target", target)
+		assert.Equal(t, float32(0.5), distance)
+		assert.Equal(t, 10, limit)
+		assert.Nil(t, filter)
+		assert.True(t, addP.Distance)
+		assert.Equal(t, []string{"name"}, props)
+		assert.Equal(t, 32, nprobe)
+	})
+
+	t.Run("results", func(t *testing.T) {
+		payload := searchBatchResultsPayload{}
+		objs := [][]*storobj.Object{
+			{
+				storobj.FromObject(&models.Object{
+					ID:    "c6f85bf5-c3b7-4c1d-bd51-e899f9605336",
+					Class: "SomeClass",
+				}, nil, nil),
+			},
+			{},
+		}
+		dists := [][]float32{{0.25}, {}}
+
+		b, err := payload.Marshal(objs, dists)
+		require.Nil(t, err)
+
+		receivedObjs, receivedDists, err := payload.Unmarshal(b)
+		require.Nil(t, err)
+		require.Len(t, receivedObjs, 2)
+		require.Len(t, receivedObjs[0], 1)
+		assert.Equal(t, objs[0][0].ID(), receivedObjs[0][0].ID())
+		assert.Empty(t, receivedObjs[1])
+		assert.Equal(t, []float32{0.25}, receivedDists[0])
+		assert.Empty(t, receivedDists[1])
+
+		_, _, err = payload.Unmarshal(b[:len(b)-1])
+		assert.NotNil(t, err)
+	})
+}
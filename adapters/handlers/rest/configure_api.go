@@ -325,6 +325,7 @@ func MakeAppState(ctx context.Context, options *swag.CommandLineOptionsGroup) *s
 		SeparateObjectsCompactions:     appState.ServerConfig.Config.Persistence.LSMSeparateObjectsCompactions,
 		MaxSegmentSize:                 appState.ServerConfig.Config.Persistence.LSMMaxSegmentSize,
 		HNSWMaxLogSize:                 appState.ServerConfig.Config.Persistence.HNSWMaxLogSize,
+		LogArchiving:                   appState.ServerConfig.Config.Persistence.LogArchivingEnabled,
 		HNSWWaitForCachePrefill:        appState.ServerConfig.Config.HNSWStartupWaitForVectorCache,
 		HNSWFlatSearchConcurrency:      appState.ServerConfig.Config.HNSWFlatSearchConcurrency,
 		VisitedListPoolMaxSize:         appState.ServerConfig.Config.HNSWVisitedListPoolMaxSize,
@@ -481,6 +482,10 @@ func MakeAppState(ctx context.Context, options *swag.CommandLineOptionsGroup) *s
 
 	backupManager := backup.NewHandler(appState.Logger, appState.Authorizer,
 		schemaManager, repo, appState.Modules)
+	if persistence := appState.ServerConfig.Config.Persistence; persistence.LogArchivingEnabled {
+		backupManager.StartLogShipping(persistence.DataPath,
+			time.Duration(persistence.LogShippingIntervalSeconds)*time.Second, repo)
+	}
 	appState.BackupManager = backupManager
 
 	enterrors.GoWrapper(func() { clusterapi.Serve(appState) }, appState.Logger)
@@ -665,6 +670,7 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 	setupObjectBatchHandlers(api, appState.BatchManager, appState.Metrics, appState.Logger)
 	setupGraphQLHandlers(api, appState, appState.SchemaManager, appState.ServerConfig.Config.DisableGraphQL,
 		appState.Metrics, appState.Logger)
+	setupGraphHandlers(api, appState.Traverser, appState.Metrics, appState.Logger)
 	setupMiscHandlers(api, appState.ServerConfig, appState.Modules,
 		appState.Metrics, appState.Logger)
 	setupClassificationHandlers(api, classifier, appState.Metrics, appState.Logger)
@@ -735,7 +741,9 @@ func startBackupScheduler(appState *state.State) *backup.Scheduler {
 		appState.DB, appState.Modules,
 		membership{appState.Cluster, appState.ClusterService},
 		appState.SchemaManager,
+		appState.ClusterService,
 		appState.Logger)
+	appState.ClusterService.StartBackupSchedules(backupScheduler)
 	return backupScheduler
 }
 
@@ -834,6 +834,137 @@ func init() {
         ]
       }
     },
+    "/backup-schedules": {
+      "get": {
+        "description": "List all backup schedules.",
+        "tags": [
+          "backups"
+        ],
+        "summary": "List backup schedules",
+        "operationId": "backups.schedules.list",
+        "responses": {
+          "200": {
+            "description": "Existing backup schedules",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/BackupSchedule"
+              }
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.backup"
+        ]
+      },
+      "post": {
+        "description": "Create or replace a schedule which periodically backs up a set of collections. The schedule is triggered by the cluster leader according to its cron expression. Backups created by a schedule are named ` + "`" + `\u003cschedule\u003e-\u003cyyyyMMddHHmmss\u003e` + "`" + ` and are pruned from the backend according to the retention policy.",
+        "tags": [
+          "backups"
+        ],
+        "summary": "Create a backup schedule",
+        "operationId": "backups.schedules.create",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/BackupSchedule"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Backup schedule successfully created."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid backup schedule.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.backup"
+        ]
+      }
+    },
+    "/backup-schedules/{name}": {
+      "delete": {
+        "description": "Delete the backup schedule with the specified name. Backups already created by the schedule are kept.",
+        "tags": [
+          "backups"
+        ],
+        "summary": "Delete a backup schedule",
+        "operationId": "backups.schedules.delete",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The name of the backup schedule.",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Successfully deleted."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Backup schedule not found."
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.backup"
+        ]
+      }
+    },
     "/backups/{backend}": {
       "get": {
         "description": "[Coming soon] List all backups in progress not implemented yet.",
@@ -1592,6 +1723,60 @@ func init() {
         ]
       }
     },
+    "/graph/traverse": {
+      "post": {
+        "description": "Expands the cross-references of a set of objects breadth-first and returns the visited objects and references. Supports per-hop filters, depth and fan-out limits and shortest path searches.",
+        "tags": [
+          "graph"
+        ],
+        "summary": "Traverse the cross-references of objects",
+        "operationId": "graph.traverse",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/TraverseRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successful traversal.",
+            "schema": {
+              "$ref": "#/definitions/TraverseResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.query"
+        ]
+      }
+    },
     "/graphql": {
       "post": {
         "description": "Get a response based on a GraphQL query",
@@ -4011,6 +4196,17 @@ func init() {
           "minimum": 2,
           "x-nullable": false
         },
+        "CompressionCodec": {
+          "description": "compression algorithm used for backup files",
+          "type": "string",
+          "default": "gzip",
+          "enum": [
+            "gzip",
+            "zstd",
+            "none"
+          ],
+          "x-nullable": false
+        },
         "CompressionLevel": {
           "description": "compression level used by compression algorithm",
           "type": "string",
@@ -4022,6 +4218,12 @@ func init() {
           ],
           "x-nullable": false
         },
+        "Encrypt": {
+          "description": "Encrypt backup files client-side with AES-GCM. Requires the key encryption key to be configured with BACKUP_ENCRYPTION_KEY or BACKUP_ENCRYPTION_KEY_FILE. Restores decrypt automatically.",
+          "type": "boolean",
+          "default": false,
+          "x-nullable": false
+        },
         "Endpoint": {
           "description": "name of the endpoint, e.g. s3.amazonaws.com",
           "type": "string"
@@ -4057,6 +4259,10 @@ func init() {
           "items": {
             "type": "string"
           }
+        },
+        "incremental_base_backup_id": {
+          "description": "The ID of a previous successful backup on the same backend. If set, the backup is incremental: only files which changed since the base backup are uploaded. Restoring it requires all backups of the chain to be present.",
+          "type": "string"
         }
       }
     },
@@ -4203,6 +4409,11 @@ func init() {
           "additionalProperties": {
             "type": "string"
           }
+        },
+        "point_in_time": {
+          "description": "Restore the classes to their state at this time, which must be after the backup completed. Requires commit log archiving to be enabled: all commit logs shipped alongside the backup and completed until then are replayed on top of it.",
+          "type": "string",
+          "format": "date-time"
         }
       }
     },
@@ -4281,6 +4492,72 @@ func init() {
         }
       }
     },
+    "BackupRetention": {
+      "description": "Retention policy of scheduled backups. A backup is kept if it satisfies any of the set constraints. All backups are kept if none is set.",
+      "type": "object",
+      "properties": {
+        "keepDays": {
+          "description": "Number of days to keep backups for",
+          "type": "integer"
+        },
+        "keepLast": {
+          "description": "Number of most recent successful backups to keep",
+          "type": "integer"
+        }
+      }
+    },
+    "BackupSchedule": {
+      "description": "A recurring backup of a set of collections",
+      "type": "object",
+      "required": [
+        "name",
+        "cron",
+        "backend"
+      ],
+      "properties": {
+        "backend": {
+          "description": "Backup backend name e.g. ` + "`" + `filesystem` + "`" + `, ` + "`" + `gcs` + "`" + `, ` + "`" + `s3` + "`" + `, ` + "`" + `azure` + "`" + `.",
+          "type": "string"
+        },
+        "createdAt": {
+          "description": "Time the schedule has been created",
+          "type": "string",
+          "format": "date-time",
+          "readOnly": true
+        },
+        "cron": {
+          "description": "Five field cron expression (minute hour day-of-month month day-of-week) in UTC, e.g. ` + "`" + `0 3 * * *` + "`" + `. The macros ` + "`" + `@hourly` + "`" + `, ` + "`" + `@daily` + "`" + `, ` + "`" + `@weekly` + "`" + `, ` + "`" + `@monthly` + "`" + ` and ` + "`" + `@yearly` + "`" + ` are supported as well.",
+          "type": "string"
+        },
+        "exclude": {
+          "description": "List of collections to exclude from the backups. Cannot be used together with 'include'.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "include": {
+          "description": "List of collections to include in the backups. Cannot be used together with 'exclude'.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "lastRun": {
+          "description": "Time the schedule has been triggered last",
+          "type": "string",
+          "format": "date-time",
+          "readOnly": true
+        },
+        "name": {
+          "description": "The name of the schedule. Only lowercase, numbers, underscore, minus characters allowed.",
+          "type": "string"
+        },
+        "retention": {
+          "$ref": "#/definitions/BackupRetention"
+        }
+      }
+    },
     "BatchDelete": {
       "type": "object",
       "properties": {
@@ -4981,6 +5258,14 @@ func init() {
       "description": "Configure the inverted index built into Weaviate (default: 60).",
       "type": "object",
       "properties": {
+        "analyzers": {
+          "description": "Custom analyzers which text properties can reference by name. Analyzers can be added to an existing class, but not changed or removed.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/TextAnalyzerConfig"
+          },
+          "x-omitempty": true
+        },
         "bm25": {
           "$ref": "#/definitions/BM25Config"
         },
@@ -4993,6 +5278,14 @@ func init() {
           "description": "Index each object with the null state (default: 'false').",
           "type": "boolean"
         },
+        "indexPositions": {
+          "description": "Names of searchable text properties which additionally store the positions of their tokens. Required for phrase and proximity queries in bm25. Cannot be changed after the class was created.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true
+        },
         "indexPropertyLength": {
           "description": "Index length of properties (default: 'false').",
           "type": "boolean"
@@ -5003,6 +5296,9 @@ func init() {
         },
         "stopwords": {
           "$ref": "#/definitions/StopwordConfig"
+        },
+        "synonyms": {
+          "$ref": "#/definitions/SynonymConfig"
         }
       }
     },
@@ -5131,6 +5427,30 @@ func init() {
         }
       }
     },
+    "NodeShardRequantizationStatus": {
+      "description": "The progress of re-encoding the vectors of a shard with a new quantizer",
+      "properties": {
+        "compression": {
+          "description": "The compression the vectors are switched to.",
+          "type": "string"
+        },
+        "progress": {
+          "description": "The share of the vectors that are already encoded, between 0 and 1.",
+          "type": "number",
+          "format": "float"
+        },
+        "vectorsProcessed": {
+          "description": "The number of vectors that are already encoded with the new quantizer.",
+          "type": "number",
+          "format": "int64"
+        },
+        "vectorsTotal": {
+          "description": "The number of vectors to encode.",
+          "type": "number",
+          "format": "int64"
+        }
+      }
+    },
     "NodeShardStatus": {
       "description": "The definition of a node shard status response body",
       "properties": {
@@ -5160,6 +5480,10 @@ func init() {
           "format": "int64",
           "x-omitempty": false
         },
+        "requantization": {
+          "description": "The progress of switching the compressed vectors to a new quantizer. Only present while a requantization is running.",
+          "$ref": "#/definitions/NodeShardRequantizationStatus"
+        },
         "vectorIndexingStatus": {
           "description": "The status of the vector indexing process.",
           "format": "string",
@@ -5275,6 +5599,10 @@ func init() {
         "properties": {
           "$ref": "#/definitions/PropertySchema"
         },
+        "sparseVectors": {
+          "description": "This field returns sparse vectors associated with the Object.",
+          "$ref": "#/definitions/SparseVectors"
+        },
         "tenant": {
           "description": "Name of the Objects tenant.",
           "type": "string"
@@ -5618,6 +5946,10 @@ func init() {
     "Property": {
       "type": "object",
       "properties": {
+        "analyzer": {
+          "description": "Name of a custom analyzer defined in the class' invertedIndexConfig.analyzers. Optional. Applies to text and text[] data types. The analyzer is used to index the property and to tokenize filters and bm25 queries on it. The tokenization of the property has to match the tokenizer of the analyzer and defaults to it.",
+          "type": "string"
+        },
         "dataType": {
           "description": "Data type of the property (required). If it starts with a capital (for example Person), may be a reference to another type.",
           "type": "array",
@@ -6000,16 +6332,45 @@ func init() {
         }
       }
     },
-    "Statistics": {
-      "description": "The definition of node statistics.",
+    "SparseVector": {
+      "description": "A sparse vector representation of the object, e.g. a learned sparse embedding such as SPLADE. Only the dimensions with a non-zero weight are listed.",
+      "type": "object",
       "properties": {
-        "bootstrapped": {
-          "type": "boolean"
-        },
-        "candidates": {
-          "type": "object"
+        "indices": {
+          "description": "The dimensions with a non-zero weight in ascending order.",
+          "type": "array",
+          "items": {
+            "type": "integer",
+            "format": "uint32"
+          }
         },
-        "dbLoaded": {
+        "values": {
+          "description": "The weights of the dimensions listed in indices.",
+          "type": "array",
+          "items": {
+            "type": "number",
+            "format": "float"
+          }
+        }
+      }
+    },
+    "SparseVectors": {
+      "description": "A map of named sparse vectors.",
+      "type": "object",
+      "additionalProperties": {
+        "$ref": "#/definitions/SparseVector"
+      }
+    },
+    "Statistics": {
+      "description": "The definition of node statistics.",
+      "properties": {
+        "bootstrapped": {
+          "type": "boolean"
+        },
+        "candidates": {
+          "type": "object"
+        },
+        "dbLoaded": {
           "type": "boolean"
         },
         "initialLastAppliedIndex": {
@@ -6080,6 +6441,24 @@ func init() {
         }
       }
     },
+    "SynonymConfig": {
+      "description": "Synonyms which the terms of BM25 and hybrid queries are expanded by.",
+      "type": "object",
+      "properties": {
+        "boost": {
+          "description": "Weight of the expanded terms relative to the terms of the query (default: 1).",
+          "type": "number",
+          "format": "float"
+        },
+        "groups": {
+          "description": "Groups of equivalent terms separated by commas, e.g. 'laptop, notebook'. A query term matching a term of a group is expanded by the other terms of the group.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
     "Tenant": {
       "description": "attributes representing a single tenant within weaviate",
       "type": "object",
@@ -6126,6 +6505,266 @@ func init() {
         }
       ]
     },
+    "TextAnalyzerCharFilter": {
+      "description": "Rewrites the text before it is tokenized.",
+      "type": "object",
+      "properties": {
+        "mappings": {
+          "description": "Strings to replace (mapping only).",
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "pattern": {
+          "description": "RE2 regular expression (patternReplace only).",
+          "type": "string"
+        },
+        "replacement": {
+          "description": "Replacement for matches of pattern, may refer to submatches as $1 (patternReplace only).",
+          "type": "string"
+        },
+        "type": {
+          "description": "` + "`" + `mapping` + "`" + ` replaces each key of mappings with its value, ` + "`" + `patternReplace` + "`" + ` replaces all matches of the regular expression pattern with replacement.",
+          "type": "string",
+          "enum": [
+            "mapping",
+            "patternReplace"
+          ]
+        }
+      }
+    },
+    "TextAnalyzerConfig": {
+      "description": "A custom text analysis pipeline: the char filters are applied to the text, which is then split by the tokenizer, and the resulting tokens run through the token filters in order.",
+      "type": "object",
+      "properties": {
+        "charFilters": {
+          "description": "Filters applied to the text before tokenization.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/TextAnalyzerCharFilter"
+          }
+        },
+        "name": {
+          "description": "Name of the analyzer, referenced by the analyzer setting of a property.",
+          "type": "string"
+        },
+        "tokenFilters": {
+          "description": "Filters applied to the tokens in order.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/TextAnalyzerTokenFilter"
+          }
+        },
+        "tokenizer": {
+          "description": "Tokenization used to split the text, see the tokenization of a property for the allowed values.",
+          "type": "string",
+          "enum": [
+            "word",
+            "lowercase",
+            "whitespace",
+            "field",
+            "trigram",
+            "gse",
+            "kagome_kr",
+            "kagome_ja"
+          ]
+        }
+      }
+    },
+    "TextAnalyzerTokenFilter": {
+      "description": "Transforms, removes or adds tokens.",
+      "type": "object",
+      "properties": {
+        "language": {
+          "description": "Language of the stemmer (stemmer only, default: 'english').",
+          "type": "string"
+        },
+        "maxGram": {
+          "description": "Maximum length of n-grams (edge_ngram and ngram only, default: 2).",
+          "type": "integer",
+          "format": "int64"
+        },
+        "minGram": {
+          "description": "Minimum length of n-grams (edge_ngram and ngram only, default: 1).",
+          "type": "integer",
+          "format": "int64"
+        },
+        "stopwords": {
+          "description": "Stopwords to remove (stop only). Defaults to the stopwords of the inverted index config.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "synonyms": {
+          "description": "Groups of equivalent tokens, each given as a comma separated list such as 'laptop, notebook' (synonym only).",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "type": {
+          "description": "` + "`" + `lowercase` + "`" + ` lowercases tokens, ` + "`" + `asciifold` + "`" + ` removes diacritics, ` + "`" + `stemmer` + "`" + ` reduces tokens to their snowball stem, ` + "`" + `stop` + "`" + ` removes stopwords, ` + "`" + `synonym` + "`" + ` adds the synonyms of tokens, ` + "`" + `edge_ngram` + "`" + ` replaces tokens with their prefixes and ` + "`" + `ngram` + "`" + ` with their substrings of minGram to maxGram characters.",
+          "type": "string",
+          "enum": [
+            "lowercase",
+            "asciifold",
+            "stemmer",
+            "stop",
+            "synonym",
+            "edge_ngram",
+            "ngram"
+          ]
+        }
+      }
+    },
+    "TraverseEdge": {
+      "description": "A followed reference between two objects of a traversal.",
+      "type": "object",
+      "properties": {
+        "from": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "fromClass": {
+          "type": "string"
+        },
+        "property": {
+          "type": "string"
+        },
+        "to": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "toClass": {
+          "type": "string"
+        }
+      }
+    },
+    "TraverseHop": {
+      "description": "Configures a single expansion step of a traversal.",
+      "type": "object",
+      "properties": {
+        "class": {
+          "description": "Restricts the objects reached in this hop to a class. Required if 'where' is set.",
+          "type": "string"
+        },
+        "properties": {
+          "description": "Reference properties to follow. All reference properties are followed if empty.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "where": {
+          "description": "Filter the objects reached in this hop must match.",
+          "type": "object",
+          "$ref": "#/definitions/WhereFilter"
+        }
+      }
+    },
+    "TraverseNode": {
+      "description": "An object reached by a traversal.",
+      "type": "object",
+      "properties": {
+        "class": {
+          "type": "string"
+        },
+        "depth": {
+          "description": "Number of hops from the closest start object.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "properties": {
+          "$ref": "#/definitions/PropertySchema"
+        }
+      }
+    },
+    "TraverseRequest": {
+      "description": "Request to expand the cross-references of a set of objects breadth-first.",
+      "type": "object",
+      "properties": {
+        "class": {
+          "description": "Class of the start objects.",
+          "type": "string",
+          "example": "City"
+        },
+        "hops": {
+          "description": "Hop i configures the expansion of the objects at depth i. The last hop is reused for all further depths. Without hops all reference properties are followed.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/TraverseHop"
+          }
+        },
+        "maxDepth": {
+          "description": "Number of hops to expand. Defaults to 1 and is limited by QUERY_CROSS_REFERENCE_DEPTH_LIMIT.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "maxFanOut": {
+          "description": "Maximum number of references followed per object and property, 0 means no limit.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "maxNodes": {
+          "description": "Maximum number of objects returned. Limited by QUERY_MAXIMUM_RESULTS.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "startIds": {
+          "description": "IDs of the objects the traversal starts at.",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "format": "uuid"
+          }
+        },
+        "targetId": {
+          "description": "If set, the traversal stops once the object is reached and returns the shortest path to it.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "tenant": {
+          "description": "Tenant used for all multi-tenant classes reached by the traversal.",
+          "type": "string"
+        }
+      }
+    },
+    "TraverseResponse": {
+      "description": "The subgraph visited by a traversal.",
+      "type": "object",
+      "properties": {
+        "edges": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/TraverseEdge"
+          }
+        },
+        "nodes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/TraverseNode"
+          }
+        },
+        "path": {
+          "description": "IDs of the objects on the shortest path to 'targetId'. Empty if the target was not reached.",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "format": "uuid"
+          }
+        },
+        "truncated": {
+          "description": "True if objects or references have been skipped because of 'maxFanOut' or 'maxNodes'.",
+          "type": "boolean"
+        }
+      }
+    },
     "Vector": {
       "description": "A vector representation of the object. If provided at object creation, this wil take precedence over any vectorizer setting.",
       "type": "array",
@@ -6189,7 +6828,9 @@ func init() {
             "WithinGeoRange",
             "IsNull",
             "ContainsAny",
-            "ContainsAll"
+            "ContainsAll",
+            "Prefix",
+            "Regex"
           ],
           "example": "GreaterThanEqual"
         },
@@ -6439,6 +7080,10 @@ func init() {
     {
       "name": "graphql"
     },
+    {
+      "description": "Traversal of the graph formed by cross-references.",
+      "name": "graph"
+    },
     {
       "name": "meta"
     },
@@ -7264,6 +7909,137 @@ func init() {
         ]
       }
     },
+    "/backup-schedules": {
+      "get": {
+        "description": "List all backup schedules.",
+        "tags": [
+          "backups"
+        ],
+        "summary": "List backup schedules",
+        "operationId": "backups.schedules.list",
+        "responses": {
+          "200": {
+            "description": "Existing backup schedules",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/BackupSchedule"
+              }
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.backup"
+        ]
+      },
+      "post": {
+        "description": "Create or replace a schedule which periodically backs up a set of collections. The schedule is triggered by the cluster leader according to its cron expression. Backups created by a schedule are named ` + "`" + `\u003cschedule\u003e-\u003cyyyyMMddHHmmss\u003e` + "`" + ` and are pruned from the backend according to the retention policy.",
+        "tags": [
+          "backups"
+        ],
+        "summary": "Create a backup schedule",
+        "operationId": "backups.schedules.create",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/BackupSchedule"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Backup schedule successfully created."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid backup schedule.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.backup"
+        ]
+      }
+    },
+    "/backup-schedules/{name}": {
+      "delete": {
+        "description": "Delete the backup schedule with the specified name. Backups already created by the schedule are kept.",
+        "tags": [
+          "backups"
+        ],
+        "summary": "Delete a backup schedule",
+        "operationId": "backups.schedules.delete",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The name of the backup schedule.",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Successfully deleted."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Backup schedule not found."
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.backup"
+        ]
+      }
+    },
     "/backups/{backend}": {
       "get": {
         "description": "[Coming soon] List all backups in progress not implemented yet.",
@@ -8034,6 +8810,60 @@ func init() {
         ]
       }
     },
+    "/graph/traverse": {
+      "post": {
+        "description": "Expands the cross-references of a set of objects breadth-first and returns the visited objects and references. Supports per-hop filters, depth and fan-out limits and shortest path searches.",
+        "tags": [
+          "graph"
+        ],
+        "summary": "Traverse the cross-references of objects",
+        "operationId": "graph.traverse",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/TraverseRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successful traversal.",
+            "schema": {
+              "$ref": "#/definitions/TraverseResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
+        "x-serviceIds": [
+          "weaviate.local.query"
+        ]
+      }
+    },
     "/graphql": {
       "post": {
         "description": "Get a response based on a GraphQL query",
@@ -10563,6 +11393,17 @@ func init() {
           "minimum": 2,
           "x-nullable": false
         },
+        "CompressionCodec": {
+          "description": "compression algorithm used for backup files",
+          "type": "string",
+          "default": "gzip",
+          "enum": [
+            "gzip",
+            "zstd",
+            "none"
+          ],
+          "x-nullable": false
+        },
         "CompressionLevel": {
           "description": "compression level used by compression algorithm",
           "type": "string",
@@ -10574,6 +11415,12 @@ func init() {
           ],
           "x-nullable": false
         },
+        "Encrypt": {
+          "description": "Encrypt backup files client-side with AES-GCM. Requires the key encryption key to be configured with BACKUP_ENCRYPTION_KEY or BACKUP_ENCRYPTION_KEY_FILE. Restores decrypt automatically.",
+          "type": "boolean",
+          "default": false,
+          "x-nullable": false
+        },
         "Endpoint": {
           "description": "name of the endpoint, e.g. s3.amazonaws.com",
           "type": "string"
@@ -10609,6 +11456,10 @@ func init() {
           "items": {
             "type": "string"
           }
+        },
+        "incremental_base_backup_id": {
+          "description": "The ID of a previous successful backup on the same backend. If set, the backup is incremental: only files which changed since the base backup are uploaded. Restoring it requires all backups of the chain to be present.",
+          "type": "string"
         }
       }
     },
@@ -10758,6 +11609,11 @@ func init() {
           "additionalProperties": {
             "type": "string"
           }
+        },
+        "point_in_time": {
+          "description": "Restore the classes to their state at this time, which must be after the backup completed. Requires commit log archiving to be enabled: all commit logs shipped alongside the backup and completed until then are replayed on top of it.",
+          "type": "string",
+          "format": "date-time"
         }
       }
     },
@@ -10821,18 +11677,84 @@ func init() {
           "description": "destination path of backup files proper to selected backup backend, contains bucket and path",
           "type": "string"
         },
-        "status": {
-          "description": "phase of backup restoration process",
-          "type": "string",
-          "default": "STARTED",
-          "enum": [
-            "STARTED",
-            "TRANSFERRING",
-            "TRANSFERRED",
-            "SUCCESS",
-            "FAILED",
-            "CANCELED"
-          ]
+        "status": {
+          "description": "phase of backup restoration process",
+          "type": "string",
+          "default": "STARTED",
+          "enum": [
+            "STARTED",
+            "TRANSFERRING",
+            "TRANSFERRED",
+            "SUCCESS",
+            "FAILED",
+            "CANCELED"
+          ]
+        }
+      }
+    },
+    "BackupRetention": {
+      "description": "Retention policy of scheduled backups. A backup is kept if it satisfies any of the set constraints. All backups are kept if none is set.",
+      "type": "object",
+      "properties": {
+        "keepDays": {
+          "description": "Number of days to keep backups for",
+          "type": "integer"
+        },
+        "keepLast": {
+          "description": "Number of most recent successful backups to keep",
+          "type": "integer"
+        }
+      }
+    },
+    "BackupSchedule": {
+      "description": "A recurring backup of a set of collections",
+      "type": "object",
+      "required": [
+        "name",
+        "cron",
+        "backend"
+      ],
+      "properties": {
+        "backend": {
+          "description": "Backup backend name e.g. ` + "`" + `filesystem` + "`" + `, ` + "`" + `gcs` + "`" + `, ` + "`" + `s3` + "`" + `, ` + "`" + `azure` + "`" + `.",
+          "type": "string"
+        },
+        "createdAt": {
+          "description": "Time the schedule has been created",
+          "type": "string",
+          "format": "date-time",
+          "readOnly": true
+        },
+        "cron": {
+          "description": "Five field cron expression (minute hour day-of-month month day-of-week) in UTC, e.g. ` + "`" + `0 3 * * *` + "`" + `. The macros ` + "`" + `@hourly` + "`" + `, ` + "`" + `@daily` + "`" + `, ` + "`" + `@weekly` + "`" + `, ` + "`" + `@monthly` + "`" + ` and ` + "`" + `@yearly` + "`" + ` are supported as well.",
+          "type": "string"
+        },
+        "exclude": {
+          "description": "List of collections to exclude from the backups. Cannot be used together with 'include'.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "include": {
+          "description": "List of collections to include in the backups. Cannot be used together with 'exclude'.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "lastRun": {
+          "description": "Time the schedule has been triggered last",
+          "type": "string",
+          "format": "date-time",
+          "readOnly": true
+        },
+        "name": {
+          "description": "The name of the schedule. Only lowercase, numbers, underscore, minus characters allowed.",
+          "type": "string"
+        },
+        "retention": {
+          "$ref": "#/definitions/BackupRetention"
         }
       }
     },
@@ -11694,6 +12616,14 @@ func init() {
       "description": "Configure the inverted index built into Weaviate (default: 60).",
       "type": "object",
       "properties": {
+        "analyzers": {
+          "description": "Custom analyzers which text properties can reference by name. Analyzers can be added to an existing class, but not changed or removed.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/TextAnalyzerConfig"
+          },
+          "x-omitempty": true
+        },
         "bm25": {
           "$ref": "#/definitions/BM25Config"
         },
@@ -11706,6 +12636,14 @@ func init() {
           "description": "Index each object with the null state (default: 'false').",
           "type": "boolean"
         },
+        "indexPositions": {
+          "description": "Names of searchable text properties which additionally store the positions of their tokens. Required for phrase and proximity queries in bm25. Cannot be changed after the class was created.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true
+        },
         "indexPropertyLength": {
           "description": "Index length of properties (default: 'false').",
           "type": "boolean"
@@ -11716,6 +12654,9 @@ func init() {
         },
         "stopwords": {
           "$ref": "#/definitions/StopwordConfig"
+        },
+        "synonyms": {
+          "$ref": "#/definitions/SynonymConfig"
         }
       }
     },
@@ -11844,6 +12785,30 @@ func init() {
         }
       }
     },
+    "NodeShardRequantizationStatus": {
+      "description": "The progress of re-encoding the vectors of a shard with a new quantizer",
+      "properties": {
+        "compression": {
+          "description": "The compression the vectors are switched to.",
+          "type": "string"
+        },
+        "progress": {
+          "description": "The share of the vectors that are already encoded, between 0 and 1.",
+          "type": "number",
+          "format": "float"
+        },
+        "vectorsProcessed": {
+          "description": "The number of vectors that are already encoded with the new quantizer.",
+          "type": "number",
+          "format": "int64"
+        },
+        "vectorsTotal": {
+          "description": "The number of vectors to encode.",
+          "type": "number",
+          "format": "int64"
+        }
+      }
+    },
     "NodeShardStatus": {
       "description": "The definition of a node shard status response body",
       "properties": {
@@ -11873,6 +12838,10 @@ func init() {
           "format": "int64",
           "x-omitempty": false
         },
+        "requantization": {
+          "description": "The progress of switching the compressed vectors to a new quantizer. Only present while a requantization is running.",
+          "$ref": "#/definitions/NodeShardRequantizationStatus"
+        },
         "vectorIndexingStatus": {
           "description": "The status of the vector indexing process.",
           "format": "string",
@@ -11988,6 +12957,10 @@ func init() {
         "properties": {
           "$ref": "#/definitions/PropertySchema"
         },
+        "sparseVectors": {
+          "description": "This field returns sparse vectors associated with the Object.",
+          "$ref": "#/definitions/SparseVectors"
+        },
         "tenant": {
           "description": "Name of the Objects tenant.",
           "type": "string"
@@ -12427,6 +13400,10 @@ func init() {
     "Property": {
       "type": "object",
       "properties": {
+        "analyzer": {
+          "description": "Name of a custom analyzer defined in the class' invertedIndexConfig.analyzers. Optional. Applies to text and text[] data types. The analyzer is used to index the property and to tokenize filters and bm25 queries on it. The tokenization of the property has to match the tokenizer of the analyzer and defaults to it.",
+          "type": "string"
+        },
         "dataType": {
           "description": "Data type of the property (required). If it starts with a capital (for example Person), may be a reference to another type.",
           "type": "array",
@@ -12809,6 +13786,35 @@ func init() {
         }
       }
     },
+    "SparseVector": {
+      "description": "A sparse vector representation of the object, e.g. a learned sparse embedding such as SPLADE. Only the dimensions with a non-zero weight are listed.",
+      "type": "object",
+      "properties": {
+        "indices": {
+          "description": "The dimensions with a non-zero weight in ascending order.",
+          "type": "array",
+          "items": {
+            "type": "integer",
+            "format": "uint32"
+          }
+        },
+        "values": {
+          "description": "The weights of the dimensions listed in indices.",
+          "type": "array",
+          "items": {
+            "type": "number",
+            "format": "float"
+          }
+        }
+      }
+    },
+    "SparseVectors": {
+      "description": "A map of named sparse vectors.",
+      "type": "object",
+      "additionalProperties": {
+        "$ref": "#/definitions/SparseVector"
+      }
+    },
     "Statistics": {
       "description": "The definition of node statistics.",
       "properties": {
@@ -12889,6 +13895,24 @@ func init() {
         }
       }
     },
+    "SynonymConfig": {
+      "description": "Synonyms which the terms of BM25 and hybrid queries are expanded by.",
+      "type": "object",
+      "properties": {
+        "boost": {
+          "description": "Weight of the expanded terms relative to the terms of the query (default: 1).",
+          "type": "number",
+          "format": "float"
+        },
+        "groups": {
+          "description": "Groups of equivalent terms separated by commas, e.g. 'laptop, notebook'. A query term matching a term of a group is expanded by the other terms of the group.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
     "Tenant": {
       "description": "attributes representing a single tenant within weaviate",
       "type": "object",
@@ -12935,6 +13959,266 @@ func init() {
         }
       ]
     },
+    "TextAnalyzerCharFilter": {
+      "description": "Rewrites the text before it is tokenized.",
+      "type": "object",
+      "properties": {
+        "mappings": {
+          "description": "Strings to replace (mapping only).",
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "pattern": {
+          "description": "RE2 regular expression (patternReplace only).",
+          "type": "string"
+        },
+        "replacement": {
+          "description": "Replacement for matches of pattern, may refer to submatches as $1 (patternReplace only).",
+          "type": "string"
+        },
+        "type": {
+          "description": "` + "`" + `mapping` + "`" + ` replaces each key of mappings with its value, ` + "`" + `patternReplace` + "`" + ` replaces all matches of the regular expression pattern with replacement.",
+          "type": "string",
+          "enum": [
+            "mapping",
+            "patternReplace"
+          ]
+        }
+      }
+    },
+    "TextAnalyzerConfig": {
+      "description": "A custom text analysis pipeline: the char filters are applied to the text, which is then split by the tokenizer, and the resulting tokens run through the token filters in order.",
+      "type": "object",
+      "properties": {
+        "charFilters": {
+          "description": "Filters applied to the text before tokenization.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/TextAnalyzerCharFilter"
+          }
+        },
+        "name": {
+          "description": "Name of the analyzer, referenced by the analyzer setting of a property.",
+          "type": "string"
+        },
+        "tokenFilters": {
+          "description": "Filters applied to the tokens in order.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/TextAnalyzerTokenFilter"
+          }
+        },
+        "tokenizer": {
+          "description": "Tokenization used to split the text, see the tokenization of a property for the allowed values.",
+          "type": "string",
+          "enum": [
+            "word",
+            "lowercase",
+            "whitespace",
+            "field",
+            "trigram",
+            "gse",
+            "kagome_kr",
+            "kagome_ja"
+          ]
+        }
+      }
+    },
+    "TextAnalyzerTokenFilter": {
+      "description": "Transforms, removes or adds tokens.",
+      "type": "object",
+      "properties": {
+        "language": {
+          "description": "Language of the stemmer (stemmer only, default: 'english').",
+          "type": "string"
+        },
+        "maxGram": {
+          "description": "Maximum length of n-grams (edge_ngram and ngram only, default: 2).",
+          "type": "integer",
+          "format": "int64"
+        },
+        "minGram": {
+          "description": "Minimum length of n-grams (edge_ngram and ngram only, default: 1).",
+          "type": "integer",
+          "format": "int64"
+        },
+        "stopwords": {
+          "description": "Stopwords to remove (stop only). Defaults to the stopwords of the inverted index config.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "synonyms": {
+          "description": "Groups of equivalent tokens, each given as a comma separated list such as 'laptop, notebook' (synonym only).",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "type": {
+          "description": "` + "`" + `lowercase` + "`" + ` lowercases tokens, ` + "`" + `asciifold` + "`" + ` removes diacritics, ` + "`" + `stemmer` + "`" + ` reduces tokens to their snowball stem, ` + "`" + `stop` + "`" + ` removes stopwords, ` + "`" + `synonym` + "`" + ` adds the synonyms of tokens, ` + "`" + `edge_ngram` + "`" + ` replaces tokens with their prefixes and ` + "`" + `ngram` + "`" + ` with their substrings of minGram to maxGram characters.",
+          "type": "string",
+          "enum": [
+            "lowercase",
+            "asciifold",
+            "stemmer",
+            "stop",
+            "synonym",
+            "edge_ngram",
+            "ngram"
+          ]
+        }
+      }
+    },
+    "TraverseEdge": {
+      "description": "A followed reference between two objects of a traversal.",
+      "type": "object",
+      "properties": {
+        "from": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "fromClass": {
+          "type": "string"
+        },
+        "property": {
+          "type": "string"
+        },
+        "to": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "toClass": {
+          "type": "string"
+        }
+      }
+    },
+    "TraverseHop": {
+      "description": "Configures a single expansion step of a traversal.",
+      "type": "object",
+      "properties": {
+        "class": {
+          "description": "Restricts the objects reached in this hop to a class. Required if 'where' is set.",
+          "type": "string"
+        },
+        "properties": {
+          "description": "Reference properties to follow. All reference properties are followed if empty.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "where": {
+          "description": "Filter the objects reached in this hop must match.",
+          "type": "object",
+          "$ref": "#/definitions/WhereFilter"
+        }
+      }
+    },
+    "TraverseNode": {
+      "description": "An object reached by a traversal.",
+      "type": "object",
+      "properties": {
+        "class": {
+          "type": "string"
+        },
+        "depth": {
+          "description": "Number of hops from the closest start object.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "properties": {
+          "$ref": "#/definitions/PropertySchema"
+        }
+      }
+    },
+    "TraverseRequest": {
+      "description": "Request to expand the cross-references of a set of objects breadth-first.",
+      "type": "object",
+      "properties": {
+        "class": {
+          "description": "Class of the start objects.",
+          "type": "string",
+          "example": "City"
+        },
+        "hops": {
+          "description": "Hop i configures the expansion of the objects at depth i. The last hop is reused for all further depths. Without hops all reference properties are followed.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/TraverseHop"
+          }
+        },
+        "maxDepth": {
+          "description": "Number of hops to expand. Defaults to 1 and is limited by QUERY_CROSS_REFERENCE_DEPTH_LIMIT.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "maxFanOut": {
+          "description": "Maximum number of references followed per object and property, 0 means no limit.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "maxNodes": {
+          "description": "Maximum number of objects returned. Limited by QUERY_MAXIMUM_RESULTS.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "startIds": {
+          "description": "IDs of the objects the traversal starts at.",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "format": "uuid"
+          }
+        },
+        "targetId": {
+          "description": "If set, the traversal stops once the object is reached and returns the shortest path to it.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "tenant": {
+          "description": "Tenant used for all multi-tenant classes reached by the traversal.",
+          "type": "string"
+        }
+      }
+    },
+    "TraverseResponse": {
+      "description": "The subgraph visited by a traversal.",
+      "type": "object",
+      "properties": {
+        "edges": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/TraverseEdge"
+          }
+        },
+        "nodes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/TraverseNode"
+          }
+        },
+        "path": {
+          "description": "IDs of the objects on the shortest path to 'targetId'. Empty if the target was not reached.",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "format": "uuid"
+          }
+        },
+        "truncated": {
+          "description": "True if objects or references have been skipped because of 'maxFanOut' or 'maxNodes'.",
+          "type": "boolean"
+        }
+      }
+    },
     "Vector": {
       "description": "A vector representation of the object. If provided at object creation, this wil take precedence over any vectorizer setting.",
       "type": "array",
@@ -12998,7 +14282,9 @@ func init() {
             "WithinGeoRange",
             "IsNull",
             "ContainsAny",
-            "ContainsAll"
+            "ContainsAll",
+            "Prefix",
+            "Regex"
           ],
           "example": "GreaterThanEqual"
         },
@@ -13257,6 +14543,10 @@ func init() {
     {
       "name": "graphql"
     },
+    {
+      "description": "Traversal of the graph formed by cross-references.",
+      "name": "graph"
+    },
     {
       "name": "meta"
     },
@@ -145,6 +145,10 @@ func parseOperator(in string) (filters.Operator, error) {
 		return filters.ContainsAny, nil
 	case models.WhereFilterOperatorContainsAll:
 		return filters.ContainsAll, nil
+	case models.WhereFilterOperatorPrefix:
+		return filters.OperatorPrefix, nil
+	case models.WhereFilterOperatorRegex:
+		return filters.OperatorRegex, nil
 	default:
 		return -1, fmt.Errorf("unrecognized operator: %s", in)
 	}
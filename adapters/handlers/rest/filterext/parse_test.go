@@ -305,6 +305,16 @@ func Test_ExtractFlatFilters(t *testing.T) {
 				input:          inputIntFilterWithOp("Like"),
 				expectedFilter: intFilterWithOp(filters.OperatorLike),
 			},
+			{
+				name:           "prefix",
+				input:          inputIntFilterWithOp("Prefix"),
+				expectedFilter: intFilterWithOp(filters.OperatorPrefix),
+			},
+			{
+				name:           "regex",
+				input:          inputIntFilterWithOp("Regex"),
+				expectedFilter: intFilterWithOp(filters.OperatorRegex),
+			},
 			{
 				name:           "not equal",
 				input:          inputIntFilterWithOp("NotEqual"),
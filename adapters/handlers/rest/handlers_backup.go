@@ -12,7 +12,10 @@
 package rest
 
 import (
+	"time"
+
 	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/backups"
@@ -43,15 +46,21 @@ func compressionFromBCfg(cfg *models.BackupConfig) ubak.Compression {
 			cfg.CompressionLevel = models.BackupConfigCompressionLevelDefaultCompression
 		}
 
+		if cfg.CompressionCodec == "" {
+			cfg.CompressionCodec = models.BackupConfigCompressionCodecGzip
+		}
+
 		return ubak.Compression{
 			CPUPercentage: int(cfg.CPUPercentage),
 			ChunkSize:     int(cfg.ChunkSize),
 			Level:         parseCompressionLevel(cfg.CompressionLevel),
+			Codec:         ubak.CompressionCodec(cfg.CompressionCodec),
 		}
 	}
 
 	return ubak.Compression{
 		Level:         ubak.DefaultCompression,
+		Codec:         ubak.CodecGzip,
 		CPUPercentage: ubak.DefaultCPUPercentage,
 		ChunkSize:     ubak.DefaultChunkSize,
 	}
@@ -93,9 +102,11 @@ func (s *backupHandlers) createBackup(params backups.BackupsCreateParams,
 ) middleware.Responder {
 	overrideBucket := ""
 	overridePath := ""
+	encrypt := false
 	if params.Body.Config != nil {
 		overrideBucket = params.Body.Config.Bucket
 		overridePath = params.Body.Config.Path
+		encrypt = params.Body.Config.Encrypt
 	}
 	meta, err := s.manager.Backup(params.HTTPRequest.Context(), principal, &ubak.BackupRequest{
 		ID:          params.Body.ID,
@@ -105,6 +116,9 @@ func (s *backupHandlers) createBackup(params backups.BackupsCreateParams,
 		Include:     params.Body.Include,
 		Exclude:     params.Body.Exclude,
 		Compression: compressionFromBCfg(params.Body.Config),
+		Encrypt:     encrypt,
+
+		IncrementalBaseBackupID: params.Body.IncrementalBaseBackupID,
 	})
 	if err != nil {
 		s.metricRequestsTotal.logError("", err)
@@ -185,6 +199,7 @@ func (s *backupHandlers) restoreBackup(params backups.BackupsRestoreParams,
 		Compression: compressionFromRCfg(params.Body.Config),
 		Bucket:      bucket,
 		Path:        path,
+		PointInTime: time.Time(params.Body.PointInTime),
 	})
 	if err != nil {
 		s.metricRequestsTotal.logError("", err)
@@ -306,6 +321,117 @@ func (s *backupHandlers) list(params backups.BackupsListParams,
 	return backups.NewBackupsListOK().WithPayload(*payload)
 }
 
+func (s *backupHandlers) createSchedule(params backups.BackupsSchedulesCreateParams,
+	principal *models.Principal,
+) middleware.Responder {
+	err := s.manager.CreateSchedule(params.HTTPRequest.Context(), principal, scheduleFromModel(params.Body))
+	if err != nil {
+		s.metricRequestsTotal.logError("", err)
+		switch err.(type) {
+		case errors.Forbidden:
+			return backups.NewBackupsSchedulesCreateForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case backup.ErrUnprocessable:
+			return backups.NewBackupsSchedulesCreateUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return backups.NewBackupsSchedulesCreateInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	s.metricRequestsTotal.logOk("")
+	return backups.NewBackupsSchedulesCreateOK()
+}
+
+func (s *backupHandlers) listSchedules(params backups.BackupsSchedulesListParams,
+	principal *models.Principal,
+) middleware.Responder {
+	schedules, err := s.manager.Schedules(params.HTTPRequest.Context(), principal)
+	if err != nil {
+		s.metricRequestsTotal.logError("", err)
+		switch err.(type) {
+		case errors.Forbidden:
+			return backups.NewBackupsSchedulesListForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return backups.NewBackupsSchedulesListInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	payload := make([]*models.BackupSchedule, len(schedules))
+	for i := range schedules {
+		payload[i] = scheduleToModel(schedules[i])
+	}
+	s.metricRequestsTotal.logOk("")
+	return backups.NewBackupsSchedulesListOK().WithPayload(payload)
+}
+
+func (s *backupHandlers) deleteSchedule(params backups.BackupsSchedulesDeleteParams,
+	principal *models.Principal,
+) middleware.Responder {
+	err := s.manager.DeleteSchedule(params.HTTPRequest.Context(), principal, params.Name)
+	if err != nil {
+		s.metricRequestsTotal.logError("", err)
+		switch err.(type) {
+		case errors.Forbidden:
+			return backups.NewBackupsSchedulesDeleteForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case backup.ErrNotFound:
+			return backups.NewBackupsSchedulesDeleteNotFound()
+		default:
+			return backups.NewBackupsSchedulesDeleteInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	s.metricRequestsTotal.logOk("")
+	return backups.NewBackupsSchedulesDeleteNoContent()
+}
+
+func scheduleFromModel(m *models.BackupSchedule) backup.Schedule {
+	s := backup.Schedule{
+		Include: m.Include,
+		Exclude: m.Exclude,
+	}
+	if m.Name != nil {
+		s.Name = *m.Name
+	}
+	if m.Cron != nil {
+		s.Cron = *m.Cron
+	}
+	if m.Backend != nil {
+		s.Backend = *m.Backend
+	}
+	if m.Retention != nil {
+		s.Retention = backup.Retention{
+			KeepLast: int(m.Retention.KeepLast),
+			KeepDays: int(m.Retention.KeepDays),
+		}
+	}
+	return s
+}
+
+func scheduleToModel(s backup.Schedule) *models.BackupSchedule {
+	m := &models.BackupSchedule{
+		Name:    &s.Name,
+		Cron:    &s.Cron,
+		Backend: &s.Backend,
+		Include: s.Include,
+		Exclude: s.Exclude,
+		Retention: &models.BackupRetention{
+			KeepLast: int64(s.Retention.KeepLast),
+			KeepDays: int64(s.Retention.KeepDays),
+		},
+		CreatedAt: strfmt.DateTime(s.CreatedAt),
+	}
+	if !s.LastRun.IsZero() {
+		m.LastRun = strfmt.DateTime(s.LastRun)
+	}
+	return m
+}
+
 func setupBackupHandlers(api *operations.WeaviateAPI,
 	scheduler *ubak.Scheduler, metrics *monitoring.PrometheusMetrics, logger logrus.FieldLogger,
 ) {
@@ -320,6 +446,12 @@ func setupBackupHandlers(api *operations.WeaviateAPI,
 		BackupsRestoreStatusHandlerFunc(h.restoreBackupStatus)
 	api.BackupsBackupsCancelHandler = backups.BackupsCancelHandlerFunc(h.cancel)
 	api.BackupsBackupsListHandler = backups.BackupsListHandlerFunc(h.list)
+	api.BackupsBackupsSchedulesCreateHandler = backups.
+		BackupsSchedulesCreateHandlerFunc(h.createSchedule)
+	api.BackupsBackupsSchedulesListHandler = backups.
+		BackupsSchedulesListHandlerFunc(h.listSchedules)
+	api.BackupsBackupsSchedulesDeleteHandler = backups.
+		BackupsSchedulesDeleteHandlerFunc(h.deleteSchedule)
 }
 
 type backupRequestsTotal struct {
@@ -23,6 +23,7 @@ func TestCompressionBackupCfg(t *testing.T) {
 	tcs := map[string]struct {
 		cfg                 *models.BackupConfig
 		expectedCompression ubak.CompressionLevel
+		expectedCodec       ubak.CompressionCodec
 		expectedCPU         int
 		expectedChunkSize   int
 		expectedBucket      string
@@ -31,6 +32,7 @@ func TestCompressionBackupCfg(t *testing.T) {
 		"without config": {
 			cfg:                 nil,
 			expectedCompression: ubak.DefaultCompression,
+			expectedCodec:       ubak.CodecGzip,
 			expectedCPU:         ubak.DefaultCPUPercentage,
 			expectedChunkSize:   ubak.DefaultChunkSize,
 		},
@@ -41,6 +43,7 @@ func TestCompressionBackupCfg(t *testing.T) {
 				CompressionLevel: models.BackupConfigCompressionLevelBestSpeed,
 			},
 			expectedCompression: ubak.BestSpeed,
+			expectedCodec:       ubak.CodecGzip,
 			expectedCPU:         25,
 			expectedChunkSize:   512,
 		},
@@ -49,6 +52,7 @@ func TestCompressionBackupCfg(t *testing.T) {
 				CPUPercentage: 25,
 			},
 			expectedCompression: ubak.DefaultCompression,
+			expectedCodec:       ubak.CodecGzip,
 			expectedCPU:         25,
 			expectedChunkSize:   ubak.DefaultChunkSize,
 		},
@@ -57,6 +61,7 @@ func TestCompressionBackupCfg(t *testing.T) {
 				ChunkSize: 125,
 			},
 			expectedCompression: ubak.DefaultCompression,
+			expectedCodec:       ubak.CodecGzip,
 			expectedCPU:         ubak.DefaultCPUPercentage,
 			expectedChunkSize:   125,
 		},
@@ -65,6 +70,16 @@ func TestCompressionBackupCfg(t *testing.T) {
 				CompressionLevel: models.BackupConfigCompressionLevelBestSpeed,
 			},
 			expectedCompression: ubak.BestSpeed,
+			expectedCodec:       ubak.CodecGzip,
+			expectedCPU:         ubak.DefaultCPUPercentage,
+			expectedChunkSize:   ubak.DefaultChunkSize,
+		},
+		"with partial config [CompressionCodec]": {
+			cfg: &models.BackupConfig{
+				CompressionCodec: models.BackupConfigCompressionCodecZstd,
+			},
+			expectedCompression: ubak.DefaultCompression,
+			expectedCodec:       ubak.CodecZstd,
 			expectedCPU:         ubak.DefaultCPUPercentage,
 			expectedChunkSize:   ubak.DefaultChunkSize,
 		},
@@ -73,6 +88,7 @@ func TestCompressionBackupCfg(t *testing.T) {
 				Bucket: "a bucket name",
 			},
 			expectedCompression: ubak.DefaultCompression,
+			expectedCodec:       ubak.CodecGzip,
 			expectedCPU:         ubak.DefaultCPUPercentage,
 			expectedChunkSize:   ubak.DefaultChunkSize,
 			expectedBucket:      "a bucket name",
@@ -82,6 +98,7 @@ func TestCompressionBackupCfg(t *testing.T) {
 				Path: "a path",
 			},
 			expectedCompression: ubak.DefaultCompression,
+			expectedCodec:       ubak.CodecGzip,
 			expectedCPU:         ubak.DefaultCPUPercentage,
 			expectedChunkSize:   ubak.DefaultChunkSize,
 			expectedPath:        "a path",
@@ -92,6 +109,7 @@ func TestCompressionBackupCfg(t *testing.T) {
 		t.Run(n, func(t *testing.T) {
 			ccfg := compressionFromBCfg(tc.cfg)
 			assert.Equal(t, tc.expectedCompression, ccfg.Level)
+			assert.Equal(t, tc.expectedCodec, ccfg.Codec)
 			assert.Equal(t, tc.expectedCPU, ccfg.CPUPercentage)
 			assert.Equal(t, tc.expectedChunkSize, ccfg.ChunkSize)
 		})
@@ -0,0 +1,149 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"errors"
+	"fmt"
+
+	middleware "github.com/go-openapi/runtime/middleware"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/filterext"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/graph"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/models"
+	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	"github.com/weaviate/weaviate/usecases/monitoring"
+	uco "github.com/weaviate/weaviate/usecases/objects"
+	"github.com/weaviate/weaviate/usecases/traverser"
+)
+
+type graphHandlers struct {
+	traverser           *traverser.Traverser
+	metricRequestsTotal restApiRequestsTotal
+}
+
+func setupGraphHandlers(api *operations.WeaviateAPI, traverser *traverser.Traverser,
+	metrics *monitoring.PrometheusMetrics, logger logrus.FieldLogger,
+) {
+	h := &graphHandlers{traverser, newGraphRequestsTotal(metrics, logger)}
+	api.GraphGraphTraverseHandler = graph.GraphTraverseHandlerFunc(h.traverse)
+}
+
+func (h *graphHandlers) traverse(params graph.GraphTraverseParams,
+	principal *models.Principal,
+) middleware.Responder {
+	req, err := traverseParamsFromModel(params.Body)
+	if err != nil {
+		h.metricRequestsTotal.logUserError(params.Body.Class)
+		return graph.NewGraphTraverseUnprocessableEntity().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	res, err := h.traverser.Traverse(params.HTTPRequest.Context(), principal, req)
+	if err != nil {
+		h.metricRequestsTotal.logError(req.ClassName, err)
+		switch {
+		case errors.As(err, &autherrs.Forbidden{}):
+			return graph.NewGraphTraverseForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &uco.ErrInvalidUserInput{}), errors.As(err, &uco.ErrMultiTenancy{}):
+			return graph.NewGraphTraverseUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return graph.NewGraphTraverseInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	h.metricRequestsTotal.logOk(req.ClassName)
+	return graph.NewGraphTraverseOK().WithPayload(traverseResultToModel(res))
+}
+
+func traverseParamsFromModel(body *models.TraverseRequest) (dto.TraverseParams, error) {
+	params := dto.TraverseParams{
+		ClassName: body.Class,
+		StartIDs:  body.StartIds,
+		Tenant:    body.Tenant,
+		MaxDepth:  int(body.MaxDepth),
+		MaxFanOut: int(body.MaxFanOut),
+		MaxNodes:  int(body.MaxNodes),
+		TargetID:  body.TargetID,
+	}
+	for i, hopIn := range body.Hops {
+		if hopIn == nil {
+			continue
+		}
+		hop := dto.TraverseHop{
+			Properties: hopIn.Properties,
+			ClassName:  hopIn.Class,
+		}
+		if hopIn.Where != nil {
+			filter, err := filterext.Parse(hopIn.Where, hopIn.Class)
+			if err != nil {
+				return dto.TraverseParams{}, fmt.Errorf("hop %d: %w", i, err)
+			}
+			hop.Filters = filter
+		}
+		params.Hops = append(params.Hops, hop)
+	}
+	return params, nil
+}
+
+func traverseResultToModel(res *dto.TraverseResult) *models.TraverseResponse {
+	out := &models.TraverseResponse{
+		Nodes:     make([]*models.TraverseNode, len(res.Nodes)),
+		Edges:     make([]*models.TraverseEdge, len(res.Edges)),
+		Path:      res.Path,
+		Truncated: res.Truncated,
+	}
+	for i, node := range res.Nodes {
+		out.Nodes[i] = &models.TraverseNode{
+			Class:      node.ClassName,
+			ID:         node.ID,
+			Depth:      int64(node.Depth),
+			Properties: node.Properties,
+		}
+	}
+	for i, edge := range res.Edges {
+		out.Edges[i] = &models.TraverseEdge{
+			FromClass: edge.FromClass,
+			From:      edge.From,
+			Property:  edge.Property,
+			ToClass:   edge.ToClass,
+			To:        edge.To,
+		}
+	}
+	return out
+}
+
+type graphRequestsTotal struct {
+	*restApiRequestsTotalImpl
+}
+
+func newGraphRequestsTotal(metrics *monitoring.PrometheusMetrics, logger logrus.FieldLogger) restApiRequestsTotal {
+	return &graphRequestsTotal{
+		restApiRequestsTotalImpl: &restApiRequestsTotalImpl{newRequestsTotalMetric(metrics, "rest"), "rest", "graph", logger},
+	}
+}
+
+func (e *graphRequestsTotal) logError(className string, err error) {
+	switch {
+	case errors.As(err, &autherrs.Forbidden{}),
+		errors.As(err, &uco.ErrInvalidUserInput{}),
+		errors.As(err, &uco.ErrMultiTenancy{}):
+		e.logUserError(className)
+	default:
+		e.logServerError(className, err)
+	}
+}
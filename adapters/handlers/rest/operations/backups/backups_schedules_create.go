@@ -0,0 +1,84 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package backups
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// BackupsSchedulesCreateHandlerFunc turns a function with the right signature into a backups schedules create handler
+type BackupsSchedulesCreateHandlerFunc func(BackupsSchedulesCreateParams, *models.Principal) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn BackupsSchedulesCreateHandlerFunc) Handle(params BackupsSchedulesCreateParams, principal *models.Principal) middleware.Responder {
+	return fn(params, principal)
+}
+
+// BackupsSchedulesCreateHandler interface for that can handle valid backups schedules create params
+type BackupsSchedulesCreateHandler interface {
+	Handle(BackupsSchedulesCreateParams, *models.Principal) middleware.Responder
+}
+
+// NewBackupsSchedulesCreate creates a new http.Handler for the backups schedules create operation
+func NewBackupsSchedulesCreate(ctx *middleware.Context, handler BackupsSchedulesCreateHandler) *BackupsSchedulesCreate {
+	return &BackupsSchedulesCreate{Context: ctx, Handler: handler}
+}
+
+/*
+	BackupsSchedulesCreate swagger:route POST /backup-schedules backups backupsSchedulesCreate
+
+# Create a backup schedule
+
+Create or replace a schedule which periodically backs up a set of collections. The schedule is triggered by the cluster leader according to its cron expression. Backups created by a schedule are named `<schedule>-<yyyyMMddHHmmss>` and are pruned from the backend according to the retention policy.
+*/
+type BackupsSchedulesCreate struct {
+	Context *middleware.Context
+	Handler BackupsSchedulesCreateHandler
+}
+
+func (o *BackupsSchedulesCreate) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewBackupsSchedulesCreateParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.Principal
+	if uprinc != nil {
+		principal = uprinc.(*models.Principal) // this is really a models.Principal, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,210 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package backups
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// BackupsSchedulesCreateOKCode is the HTTP code returned for type BackupsSchedulesCreateOK
+const BackupsSchedulesCreateOKCode int = 200
+
+/*
+BackupsSchedulesCreateOK Backup schedule successfully created.
+
+swagger:response backupsSchedulesCreateOK
+*/
+type BackupsSchedulesCreateOK struct {
+}
+
+// NewBackupsSchedulesCreateOK creates BackupsSchedulesCreateOK with default headers values
+func NewBackupsSchedulesCreateOK() *BackupsSchedulesCreateOK {
+
+	return &BackupsSchedulesCreateOK{}
+}
+
+// WriteResponse to the client
+func (o *BackupsSchedulesCreateOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(200)
+}
+
+// BackupsSchedulesCreateUnauthorizedCode is the HTTP code returned for type BackupsSchedulesCreateUnauthorized
+const BackupsSchedulesCreateUnauthorizedCode int = 401
+
+/*
+BackupsSchedulesCreateUnauthorized Unauthorized or invalid credentials.
+
+swagger:response backupsSchedulesCreateUnauthorized
+*/
+type BackupsSchedulesCreateUnauthorized struct {
+}
+
+// NewBackupsSchedulesCreateUnauthorized creates BackupsSchedulesCreateUnauthorized with default headers values
+func NewBackupsSchedulesCreateUnauthorized() *BackupsSchedulesCreateUnauthorized {
+
+	return &BackupsSchedulesCreateUnauthorized{}
+}
+
+// WriteResponse to the client
+func (o *BackupsSchedulesCreateUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(401)
+}
+
+// BackupsSchedulesCreateForbiddenCode is the HTTP code returned for type BackupsSchedulesCreateForbidden
+const BackupsSchedulesCreateForbiddenCode int = 403
+
+/*
+BackupsSchedulesCreateForbidden Forbidden
+
+swagger:response backupsSchedulesCreateForbidden
+*/
+type BackupsSchedulesCreateForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewBackupsSchedulesCreateForbidden creates BackupsSchedulesCreateForbidden with default headers values
+func NewBackupsSchedulesCreateForbidden() *BackupsSchedulesCreateForbidden {
+
+	return &BackupsSchedulesCreateForbidden{}
+}
+
+// WithPayload adds the payload to the backups schedules create forbidden response
+func (o *BackupsSchedulesCreateForbidden) WithPayload(payload *models.ErrorResponse) *BackupsSchedulesCreateForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the backups schedules create forbidden response
+func (o *BackupsSchedulesCreateForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *BackupsSchedulesCreateForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// BackupsSchedulesCreateUnprocessableEntityCode is the HTTP code returned for type BackupsSchedulesCreateUnprocessableEntity
+const BackupsSchedulesCreateUnprocessableEntityCode int = 422
+
+/*
+BackupsSchedulesCreateUnprocessableEntity Invalid backup schedule.
+
+swagger:response backupsSchedulesCreateUnprocessableEntity
+*/
+type BackupsSchedulesCreateUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewBackupsSchedulesCreateUnprocessableEntity creates BackupsSchedulesCreateUnprocessableEntity with default headers values
+func NewBackupsSchedulesCreateUnprocessableEntity() *BackupsSchedulesCreateUnprocessableEntity {
+
+	return &BackupsSchedulesCreateUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the backups schedules create unprocessable entity response
+func (o *BackupsSchedulesCreateUnprocessableEntity) WithPayload(payload *models.ErrorResponse) *BackupsSchedulesCreateUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the backups schedules create unprocessable entity response
+func (o *BackupsSchedulesCreateUnprocessableEntity) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *BackupsSchedulesCreateUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// BackupsSchedulesCreateInternalServerErrorCode is the HTTP code returned for type BackupsSchedulesCreateInternalServerError
+const BackupsSchedulesCreateInternalServerErrorCode int = 500
+
+/*
+BackupsSchedulesCreateInternalServerError An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+
+swagger:response backupsSchedulesCreateInternalServerError
+*/
+type BackupsSchedulesCreateInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewBackupsSchedulesCreateInternalServerError creates BackupsSchedulesCreateInternalServerError with default headers values
+func NewBackupsSchedulesCreateInternalServerError() *BackupsSchedulesCreateInternalServerError {
+
+	return &BackupsSchedulesCreateInternalServerError{}
+}
+
+// WithPayload adds the payload to the backups schedules create internal server error response
+func (o *BackupsSchedulesCreateInternalServerError) WithPayload(payload *models.ErrorResponse) *BackupsSchedulesCreateInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the backups schedules create internal server error response
+func (o *BackupsSchedulesCreateInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *BackupsSchedulesCreateInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,84 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package backups
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// BackupsSchedulesDeleteHandlerFunc turns a function with the right signature into a backups schedules delete handler
+type BackupsSchedulesDeleteHandlerFunc func(BackupsSchedulesDeleteParams, *models.Principal) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn BackupsSchedulesDeleteHandlerFunc) Handle(params BackupsSchedulesDeleteParams, principal *models.Principal) middleware.Responder {
+	return fn(params, principal)
+}
+
+// BackupsSchedulesDeleteHandler interface for that can handle valid backups schedules delete params
+type BackupsSchedulesDeleteHandler interface {
+	Handle(BackupsSchedulesDeleteParams, *models.Principal) middleware.Responder
+}
+
+// NewBackupsSchedulesDelete creates a new http.Handler for the backups schedules delete operation
+func NewBackupsSchedulesDelete(ctx *middleware.Context, handler BackupsSchedulesDeleteHandler) *BackupsSchedulesDelete {
+	return &BackupsSchedulesDelete{Context: ctx, Handler: handler}
+}
+
+/*
+	BackupsSchedulesDelete swagger:route DELETE /backup-schedules/{name} backups backupsSchedulesDelete
+
+# Delete a backup schedule
+
+Delete the backup schedule with the specified name. Backups already created by the schedule are kept.
+*/
+type BackupsSchedulesDelete struct {
+	Context *middleware.Context
+	Handler BackupsSchedulesDeleteHandler
+}
+
+func (o *BackupsSchedulesDelete) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewBackupsSchedulesDeleteParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.Principal
+	if uprinc != nil {
+		principal = uprinc.(*models.Principal) // this is really a models.Principal, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,190 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package backups
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// BackupsSchedulesDeleteNoContentCode is the HTTP code returned for type BackupsSchedulesDeleteNoContent
+const BackupsSchedulesDeleteNoContentCode int = 204
+
+/*
+BackupsSchedulesDeleteNoContent Successfully deleted.
+
+swagger:response backupsSchedulesDeleteNoContent
+*/
+type BackupsSchedulesDeleteNoContent struct {
+}
+
+// NewBackupsSchedulesDeleteNoContent creates BackupsSchedulesDeleteNoContent with default headers values
+func NewBackupsSchedulesDeleteNoContent() *BackupsSchedulesDeleteNoContent {
+
+	return &BackupsSchedulesDeleteNoContent{}
+}
+
+// WriteResponse to the client
+func (o *BackupsSchedulesDeleteNoContent) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(204)
+}
+
+// BackupsSchedulesDeleteUnauthorizedCode is the HTTP code returned for type BackupsSchedulesDeleteUnauthorized
+const BackupsSchedulesDeleteUnauthorizedCode int = 401
+
+/*
+BackupsSchedulesDeleteUnauthorized Unauthorized or invalid credentials.
+
+swagger:response backupsSchedulesDeleteUnauthorized
+*/
+type BackupsSchedulesDeleteUnauthorized struct {
+}
+
+// NewBackupsSchedulesDeleteUnauthorized creates BackupsSchedulesDeleteUnauthorized with default headers values
+func NewBackupsSchedulesDeleteUnauthorized() *BackupsSchedulesDeleteUnauthorized {
+
+	return &BackupsSchedulesDeleteUnauthorized{}
+}
+
+// WriteResponse to the client
+func (o *BackupsSchedulesDeleteUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(401)
+}
+
+// BackupsSchedulesDeleteForbiddenCode is the HTTP code returned for type BackupsSchedulesDeleteForbidden
+const BackupsSchedulesDeleteForbiddenCode int = 403
+
+/*
+BackupsSchedulesDeleteForbidden Forbidden
+
+swagger:response backupsSchedulesDeleteForbidden
+*/
+type BackupsSchedulesDeleteForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewBackupsSchedulesDeleteForbidden creates BackupsSchedulesDeleteForbidden with default headers values
+func NewBackupsSchedulesDeleteForbidden() *BackupsSchedulesDeleteForbidden {
+
+	return &BackupsSchedulesDeleteForbidden{}
+}
+
+// WithPayload adds the payload to the backups schedules delete forbidden response
+func (o *BackupsSchedulesDeleteForbidden) WithPayload(payload *models.ErrorResponse) *BackupsSchedulesDeleteForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the backups schedules delete forbidden response
+func (o *BackupsSchedulesDeleteForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *BackupsSchedulesDeleteForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// BackupsSchedulesDeleteNotFoundCode is the HTTP code returned for type BackupsSchedulesDeleteNotFound
+const BackupsSchedulesDeleteNotFoundCode int = 404
+
+/*
+BackupsSchedulesDeleteNotFound Backup schedule not found.
+
+swagger:response backupsSchedulesDeleteNotFound
+*/
+type BackupsSchedulesDeleteNotFound struct {
+}
+
+// NewBackupsSchedulesDeleteNotFound creates BackupsSchedulesDeleteNotFound with default headers values
+func NewBackupsSchedulesDeleteNotFound() *BackupsSchedulesDeleteNotFound {
+
+	return &BackupsSchedulesDeleteNotFound{}
+}
+
+// WriteResponse to the client
+func (o *BackupsSchedulesDeleteNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(404)
+}
+
+// BackupsSchedulesDeleteInternalServerErrorCode is the HTTP code returned for type BackupsSchedulesDeleteInternalServerError
+const BackupsSchedulesDeleteInternalServerErrorCode int = 500
+
+/*
+BackupsSchedulesDeleteInternalServerError An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+
+swagger:response backupsSchedulesDeleteInternalServerError
+*/
+type BackupsSchedulesDeleteInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewBackupsSchedulesDeleteInternalServerError creates BackupsSchedulesDeleteInternalServerError with default headers values
+func NewBackupsSchedulesDeleteInternalServerError() *BackupsSchedulesDeleteInternalServerError {
+
+	return &BackupsSchedulesDeleteInternalServerError{}
+}
+
+// WithPayload adds the payload to the backups schedules delete internal server error response
+func (o *BackupsSchedulesDeleteInternalServerError) WithPayload(payload *models.ErrorResponse) *BackupsSchedulesDeleteInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the backups schedules delete internal server error response
+func (o *BackupsSchedulesDeleteInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *BackupsSchedulesDeleteInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,188 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package backups
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// BackupsSchedulesListOKCode is the HTTP code returned for type BackupsSchedulesListOK
+const BackupsSchedulesListOKCode int = 200
+
+/*
+BackupsSchedulesListOK Existing backup schedules
+
+swagger:response backupsSchedulesListOK
+*/
+type BackupsSchedulesListOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload []*models.BackupSchedule `json:"body,omitempty"`
+}
+
+// NewBackupsSchedulesListOK creates BackupsSchedulesListOK with default headers values
+func NewBackupsSchedulesListOK() *BackupsSchedulesListOK {
+
+	return &BackupsSchedulesListOK{}
+}
+
+// WithPayload adds the payload to the backups schedules list o k response
+func (o *BackupsSchedulesListOK) WithPayload(payload []*models.BackupSchedule) *BackupsSchedulesListOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the backups schedules list o k response
+func (o *BackupsSchedulesListOK) SetPayload(payload []*models.BackupSchedule) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *BackupsSchedulesListOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		// return empty array
+		payload = make([]*models.BackupSchedule, 0, 50)
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+// BackupsSchedulesListUnauthorizedCode is the HTTP code returned for type BackupsSchedulesListUnauthorized
+const BackupsSchedulesListUnauthorizedCode int = 401
+
+/*
+BackupsSchedulesListUnauthorized Unauthorized or invalid credentials.
+
+swagger:response backupsSchedulesListUnauthorized
+*/
+type BackupsSchedulesListUnauthorized struct {
+}
+
+// NewBackupsSchedulesListUnauthorized creates BackupsSchedulesListUnauthorized with default headers values
+func NewBackupsSchedulesListUnauthorized() *BackupsSchedulesListUnauthorized {
+
+	return &BackupsSchedulesListUnauthorized{}
+}
+
+// WriteResponse to the client
+func (o *BackupsSchedulesListUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(401)
+}
+
+// BackupsSchedulesListForbiddenCode is the HTTP code returned for type BackupsSchedulesListForbidden
+const BackupsSchedulesListForbiddenCode int = 403
+
+/*
+BackupsSchedulesListForbidden Forbidden
+
+swagger:response backupsSchedulesListForbidden
+*/
+type BackupsSchedulesListForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewBackupsSchedulesListForbidden creates BackupsSchedulesListForbidden with default headers values
+func NewBackupsSchedulesListForbidden() *BackupsSchedulesListForbidden {
+
+	return &BackupsSchedulesListForbidden{}
+}
+
+// WithPayload adds the payload to the backups schedules list forbidden response
+func (o *BackupsSchedulesListForbidden) WithPayload(payload *models.ErrorResponse) *BackupsSchedulesListForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the backups schedules list forbidden response
+func (o *BackupsSchedulesListForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *BackupsSchedulesListForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// BackupsSchedulesListInternalServerErrorCode is the HTTP code returned for type BackupsSchedulesListInternalServerError
+const BackupsSchedulesListInternalServerErrorCode int = 500
+
+/*
+BackupsSchedulesListInternalServerError An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+
+swagger:response backupsSchedulesListInternalServerError
+*/
+type BackupsSchedulesListInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewBackupsSchedulesListInternalServerError creates BackupsSchedulesListInternalServerError with default headers values
+func NewBackupsSchedulesListInternalServerError() *BackupsSchedulesListInternalServerError {
+
+	return &BackupsSchedulesListInternalServerError{}
+}
+
+// WithPayload adds the payload to the backups schedules list internal server error response
+func (o *BackupsSchedulesListInternalServerError) WithPayload(payload *models.ErrorResponse) *BackupsSchedulesListInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the backups schedules list internal server error response
+func (o *BackupsSchedulesListInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *BackupsSchedulesListInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
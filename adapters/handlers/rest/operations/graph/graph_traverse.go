@@ -0,0 +1,84 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package graph
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// GraphTraverseHandlerFunc turns a function with the right signature into a graph traverse handler
+type GraphTraverseHandlerFunc func(GraphTraverseParams, *models.Principal) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn GraphTraverseHandlerFunc) Handle(params GraphTraverseParams, principal *models.Principal) middleware.Responder {
+	return fn(params, principal)
+}
+
+// GraphTraverseHandler interface for that can handle valid graph traverse params
+type GraphTraverseHandler interface {
+	Handle(GraphTraverseParams, *models.Principal) middleware.Responder
+}
+
+// NewGraphTraverse creates a new http.Handler for the graph traverse operation
+func NewGraphTraverse(ctx *middleware.Context, handler GraphTraverseHandler) *GraphTraverse {
+	return &GraphTraverse{Context: ctx, Handler: handler}
+}
+
+/*
+	GraphTraverse swagger:route POST /graph/traverse graph graphTraverse
+
+# Traverse the cross-references of objects
+
+Expands the cross-references of a set of objects breadth-first and returns the visited objects and references. Supports per-hop filters, depth and fan-out limits and shortest path searches.
+*/
+type GraphTraverse struct {
+	Context *middleware.Context
+	Handler GraphTraverseHandler
+}
+
+func (o *GraphTraverse) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewGraphTraverseParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.Principal
+	if uprinc != nil {
+		principal = uprinc.(*models.Principal) // this is really a models.Principal, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,230 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package graph
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// GraphTraverseOKCode is the HTTP code returned for type GraphTraverseOK
+const GraphTraverseOKCode int = 200
+
+/*
+GraphTraverseOK Successful traversal.
+
+swagger:response graphTraverseOK
+*/
+type GraphTraverseOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.TraverseResponse `json:"body,omitempty"`
+}
+
+// NewGraphTraverseOK creates GraphTraverseOK with default headers values
+func NewGraphTraverseOK() *GraphTraverseOK {
+
+	return &GraphTraverseOK{}
+}
+
+// WithPayload adds the payload to the graph traverse o k response
+func (o *GraphTraverseOK) WithPayload(payload *models.TraverseResponse) *GraphTraverseOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the graph traverse o k response
+func (o *GraphTraverseOK) SetPayload(payload *models.TraverseResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GraphTraverseOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// GraphTraverseUnauthorizedCode is the HTTP code returned for type GraphTraverseUnauthorized
+const GraphTraverseUnauthorizedCode int = 401
+
+/*
+GraphTraverseUnauthorized Unauthorized or invalid credentials.
+
+swagger:response graphTraverseUnauthorized
+*/
+type GraphTraverseUnauthorized struct {
+}
+
+// NewGraphTraverseUnauthorized creates GraphTraverseUnauthorized with default headers values
+func NewGraphTraverseUnauthorized() *GraphTraverseUnauthorized {
+
+	return &GraphTraverseUnauthorized{}
+}
+
+// WriteResponse to the client
+func (o *GraphTraverseUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(401)
+}
+
+// GraphTraverseForbiddenCode is the HTTP code returned for type GraphTraverseForbidden
+const GraphTraverseForbiddenCode int = 403
+
+/*
+GraphTraverseForbidden Forbidden
+
+swagger:response graphTraverseForbidden
+*/
+type GraphTraverseForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewGraphTraverseForbidden creates GraphTraverseForbidden with default headers values
+func NewGraphTraverseForbidden() *GraphTraverseForbidden {
+
+	return &GraphTraverseForbidden{}
+}
+
+// WithPayload adds the payload to the graph traverse forbidden response
+func (o *GraphTraverseForbidden) WithPayload(payload *models.ErrorResponse) *GraphTraverseForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the graph traverse forbidden response
+func (o *GraphTraverseForbidden) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GraphTraverseForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// GraphTraverseUnprocessableEntityCode is the HTTP code returned for type GraphTraverseUnprocessableEntity
+const GraphTraverseUnprocessableEntityCode int = 422
+
+/*
+GraphTraverseUnprocessableEntity Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?
+
+swagger:response graphTraverseUnprocessableEntity
+*/
+type GraphTraverseUnprocessableEntity struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewGraphTraverseUnprocessableEntity creates GraphTraverseUnprocessableEntity with default headers values
+func NewGraphTraverseUnprocessableEntity() *GraphTraverseUnprocessableEntity {
+
+	return &GraphTraverseUnprocessableEntity{}
+}
+
+// WithPayload adds the payload to the graph traverse unprocessable entity response
+func (o *GraphTraverseUnprocessableEntity) WithPayload(payload *models.ErrorResponse) *GraphTraverseUnprocessableEntity {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the graph traverse unprocessable entity response
+func (o *GraphTraverseUnprocessableEntity) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GraphTraverseUnprocessableEntity) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(422)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// GraphTraverseInternalServerErrorCode is the HTTP code returned for type GraphTraverseInternalServerError
+const GraphTraverseInternalServerErrorCode int = 500
+
+/*
+GraphTraverseInternalServerError An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+
+swagger:response graphTraverseInternalServerError
+*/
+type GraphTraverseInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ErrorResponse `json:"body,omitempty"`
+}
+
+// NewGraphTraverseInternalServerError creates GraphTraverseInternalServerError with default headers values
+func NewGraphTraverseInternalServerError() *GraphTraverseInternalServerError {
+
+	return &GraphTraverseInternalServerError{}
+}
+
+// WithPayload adds the payload to the graph traverse internal server error response
+func (o *GraphTraverseInternalServerError) WithPayload(payload *models.ErrorResponse) *GraphTraverseInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the graph traverse internal server error response
+func (o *GraphTraverseInternalServerError) SetPayload(payload *models.ErrorResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GraphTraverseInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -36,6 +36,7 @@ import (
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/batch"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/classifications"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/cluster"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/graph"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/graphql"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/meta"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/nodes"
@@ -95,6 +96,15 @@ func NewWeaviateAPI(spec *loads.Document) *WeaviateAPI {
 		BackupsBackupsRestoreStatusHandler: backups.BackupsRestoreStatusHandlerFunc(func(params backups.BackupsRestoreStatusParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation backups.BackupsRestoreStatus has not yet been implemented")
 		}),
+		BackupsBackupsSchedulesCreateHandler: backups.BackupsSchedulesCreateHandlerFunc(func(params backups.BackupsSchedulesCreateParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation backups.BackupsSchedulesCreate has not yet been implemented")
+		}),
+		BackupsBackupsSchedulesDeleteHandler: backups.BackupsSchedulesDeleteHandlerFunc(func(params backups.BackupsSchedulesDeleteParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation backups.BackupsSchedulesDelete has not yet been implemented")
+		}),
+		BackupsBackupsSchedulesListHandler: backups.BackupsSchedulesListHandlerFunc(func(params backups.BackupsSchedulesListParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation backups.BackupsSchedulesList has not yet been implemented")
+		}),
 		BatchBatchObjectsCreateHandler: batch.BatchObjectsCreateHandlerFunc(func(params batch.BatchObjectsCreateParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation batch.BatchObjectsCreate has not yet been implemented")
 		}),
@@ -134,6 +144,9 @@ func NewWeaviateAPI(spec *loads.Document) *WeaviateAPI {
 		AuthzGetUsersForRoleHandler: authz.GetUsersForRoleHandlerFunc(func(params authz.GetUsersForRoleParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation authz.GetUsersForRole has not yet been implemented")
 		}),
+		GraphGraphTraverseHandler: graph.GraphTraverseHandlerFunc(func(params graph.GraphTraverseParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation graph.GraphTraverse has not yet been implemented")
+		}),
 		GraphqlGraphqlBatchHandler: graphql.GraphqlBatchHandlerFunc(func(params graphql.GraphqlBatchParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation graphql.GraphqlBatch has not yet been implemented")
 		}),
@@ -351,6 +364,12 @@ type WeaviateAPI struct {
 	BackupsBackupsRestoreHandler backups.BackupsRestoreHandler
 	// BackupsBackupsRestoreStatusHandler sets the operation handler for the backups restore status operation
 	BackupsBackupsRestoreStatusHandler backups.BackupsRestoreStatusHandler
+	// BackupsBackupsSchedulesCreateHandler sets the operation handler for the backups schedules create operation
+	BackupsBackupsSchedulesCreateHandler backups.BackupsSchedulesCreateHandler
+	// BackupsBackupsSchedulesDeleteHandler sets the operation handler for the backups schedules delete operation
+	BackupsBackupsSchedulesDeleteHandler backups.BackupsSchedulesDeleteHandler
+	// BackupsBackupsSchedulesListHandler sets the operation handler for the backups schedules list operation
+	BackupsBackupsSchedulesListHandler backups.BackupsSchedulesListHandler
 	// BatchBatchObjectsCreateHandler sets the operation handler for the batch objects create operation
 	BatchBatchObjectsCreateHandler batch.BatchObjectsCreateHandler
 	// BatchBatchObjectsDeleteHandler sets the operation handler for the batch objects delete operation
@@ -377,6 +396,8 @@ type WeaviateAPI struct {
 	AuthzGetRolesForUserHandler authz.GetRolesForUserHandler
 	// AuthzGetUsersForRoleHandler sets the operation handler for the get users for role operation
 	AuthzGetUsersForRoleHandler authz.GetUsersForRoleHandler
+	// GraphGraphTraverseHandler sets the operation handler for the graph traverse operation
+	GraphGraphTraverseHandler graph.GraphTraverseHandler
 	// GraphqlGraphqlBatchHandler sets the operation handler for the graphql batch operation
 	GraphqlGraphqlBatchHandler graphql.GraphqlBatchHandler
 	// GraphqlGraphqlPostHandler sets the operation handler for the graphql post operation
@@ -576,6 +597,15 @@ func (o *WeaviateAPI) Validate() error {
 	if o.BackupsBackupsRestoreStatusHandler == nil {
 		unregistered = append(unregistered, "backups.BackupsRestoreStatusHandler")
 	}
+	if o.BackupsBackupsSchedulesCreateHandler == nil {
+		unregistered = append(unregistered, "backups.BackupsSchedulesCreateHandler")
+	}
+	if o.BackupsBackupsSchedulesDeleteHandler == nil {
+		unregistered = append(unregistered, "backups.BackupsSchedulesDeleteHandler")
+	}
+	if o.BackupsBackupsSchedulesListHandler == nil {
+		unregistered = append(unregistered, "backups.BackupsSchedulesListHandler")
+	}
 	if o.BatchBatchObjectsCreateHandler == nil {
 		unregistered = append(unregistered, "batch.BatchObjectsCreateHandler")
 	}
@@ -615,6 +645,9 @@ func (o *WeaviateAPI) Validate() error {
 	if o.AuthzGetUsersForRoleHandler == nil {
 		unregistered = append(unregistered, "authz.GetUsersForRoleHandler")
 	}
+	if o.GraphGraphTraverseHandler == nil {
+		unregistered = append(unregistered, "graph.GraphTraverseHandler")
+	}
 	if o.GraphqlGraphqlBatchHandler == nil {
 		unregistered = append(unregistered, "graphql.GraphqlBatchHandler")
 	}
@@ -886,6 +919,18 @@ func (o *WeaviateAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/backup-schedules"] = backups.NewBackupsSchedulesCreate(o.context, o.BackupsBackupsSchedulesCreateHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/backup-schedules/{name}"] = backups.NewBackupsSchedulesDelete(o.context, o.BackupsBackupsSchedulesDeleteHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/backup-schedules"] = backups.NewBackupsSchedulesList(o.context, o.BackupsBackupsSchedulesListHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/batch/objects"] = batch.NewBatchObjectsCreate(o.context, o.BatchBatchObjectsCreateHandler)
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
@@ -938,6 +983,10 @@ func (o *WeaviateAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/graph/traverse"] = graph.NewGraphTraverse(o.context, o.GraphGraphTraverseHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/graphql/batch"] = graphql.NewGraphqlBatch(o.context, o.GraphqlGraphqlBatchHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
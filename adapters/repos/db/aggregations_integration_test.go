@@ -72,6 +72,9 @@ func Test_Aggregations(t *testing.T) {
 	t.Run("date aggregations with filters",
 		testDateAggregationsWithFilters(repo))
 
+	t.Run("aggregations with nested and derived groups",
+		testAggregationsWithGroupByKeys(repo))
+
 	t.Run("clean up",
 		cleanupCompanyTestSchemaAndData(repo, migrator))
 }
@@ -117,6 +120,9 @@ func Test_Aggregations_MultiShard(t *testing.T) {
 	t.Run("date aggregations with filters",
 		testDateAggregationsWithFilters(repo))
 
+	t.Run("aggregations with nested and derived groups",
+		testAggregationsWithGroupByKeys(repo))
+
 	t.Run("clean up",
 		cleanupCompanyTestSchemaAndData(repo, migrator))
 }
@@ -2254,6 +2260,123 @@ func testDateAggregationsWithoutGrouping(repo *DB, exact bool) func(t *testing.T
 	}
 }
 
+func testAggregationsWithGroupByKeys(repo *DB) func(t *testing.T) {
+	byValue := func(groups []aggregation.Group) map[interface{}]aggregation.Group {
+		out := map[interface{}]aggregation.Group{}
+		for _, group := range groups {
+			out[group.GroupedBy.Value] = group
+		}
+		return out
+	}
+	counts := func(groups []aggregation.Group) map[interface{}]int {
+		out := map[interface{}]int{}
+		for _, group := range groups {
+			out[group.GroupedBy.Value] = group.Count
+		}
+		return out
+	}
+
+	return func(t *testing.T) {
+		t.Run("group by sector and location", func(t *testing.T) {
+			params := aggregation.Params{
+				ClassName:        schema.ClassName(companyClass.Class),
+				IncludeMetaCount: true,
+				GroupByKeys: []aggregation.GroupByKey{
+					{Path: &filters.Path{Class: schema.ClassName(companyClass.Class), Property: "sector"}},
+					{Path: &filters.Path{Class: schema.ClassName(companyClass.Class), Property: "location"}},
+				},
+			}
+
+			res, err := repo.Aggregate(context.Background(), params, nil)
+			require.Nil(t, err)
+			require.NotNil(t, res)
+
+			assert.Equal(t, map[interface{}]int{"Food": 60, "Financials": 30}, counts(res.Groups))
+			sectors := byValue(res.Groups)
+			assert.Equal(t, map[interface{}]int{
+				"Atlanta": 20, "Los Angeles": 10, "Detroit": 10, "San Francisco": 10, "New York": 10,
+			}, counts(sectors["Food"].Groups))
+			assert.Equal(t, map[interface{}]int{
+				"New York": 20, "San Francisco": 10,
+			}, counts(sectors["Financials"].Groups))
+			assert.Equal(t, []string{"location"}, sectors["Food"].Groups[0].GroupedBy.Path)
+		})
+
+		t.Run("group by price ranges and sector", func(t *testing.T) {
+			params := aggregation.Params{
+				ClassName:        schema.ClassName(companyClass.Class),
+				IncludeMetaCount: true,
+				GroupByKeys: []aggregation.GroupByKey{
+					{
+						Path: &filters.Path{Class: schema.ClassName(companyClass.Class), Property: "price"},
+						Ranges: []aggregation.GroupByRange{
+							{To: ptFloat64(100)},
+							{From: ptFloat64(100)},
+						},
+					},
+					{Path: &filters.Path{Class: schema.ClassName(companyClass.Class), Property: "sector"}},
+				},
+				Properties: []aggregation.ParamProperty{
+					{
+						Name:        "dividendYield",
+						Aggregators: []aggregation.Aggregator{aggregation.MeanAggregator},
+					},
+				},
+			}
+
+			res, err := repo.Aggregate(context.Background(), params, nil)
+			require.Nil(t, err)
+			require.NotNil(t, res)
+
+			assert.Equal(t, map[interface{}]int{"*-100": 40, "100-*": 50}, counts(res.Groups))
+			ranges := byValue(res.Groups)
+			assert.Equal(t, &aggregation.GroupByRange{To: ptFloat64(100)}, ranges["*-100"].GroupedBy.Range)
+			assert.Equal(t, map[interface{}]int{"Food": 30, "Financials": 10}, counts(ranges["*-100"].Groups))
+			assert.Equal(t, map[interface{}]int{"Food": 30, "Financials": 20}, counts(ranges["100-*"].Groups))
+
+			cheapFood := byValue(ranges["*-100"].Groups)["Food"]
+			assert.InDelta(t, 3.7,
+				cheapFood.Properties["dividendYield"].NumericalAggregations["mean"], 0.001)
+		})
+
+		t.Run("group by date interval", func(t *testing.T) {
+			params := aggregation.Params{
+				ClassName:        schema.ClassName(customerClass.Class),
+				IncludeMetaCount: true,
+				GroupByKeys: []aggregation.GroupByKey{
+					{
+						Path:         &filters.Path{Class: schema.ClassName(customerClass.Class), Property: "timeArrived"},
+						DateInterval: aggregation.DateIntervalHour,
+					},
+					{Path: &filters.Path{Class: schema.ClassName(customerClass.Class), Property: "isNewCustomer"}},
+				},
+			}
+
+			res, err := repo.Aggregate(context.Background(), params, nil)
+			require.Nil(t, err)
+			require.NotNil(t, res)
+
+			assert.Equal(t, map[interface{}]int{"2022-06-16T17:00:00Z": 10}, counts(res.Groups))
+			assert.Equal(t, map[interface{}]int{false: 6, true: 4}, counts(res.Groups[0].Groups))
+		})
+
+		t.Run("ranges on a text property", func(t *testing.T) {
+			params := aggregation.Params{
+				ClassName: schema.ClassName(companyClass.Class),
+				GroupByKeys: []aggregation.GroupByKey{
+					{
+						Path:   &filters.Path{Class: schema.ClassName(companyClass.Class), Property: "sector"},
+						Ranges: []aggregation.GroupByRange{{To: ptFloat64(100)}},
+					},
+				},
+			}
+
+			_, err := repo.Aggregate(context.Background(), params, nil)
+			require.NotNil(t, err)
+		})
+	}
+}
+
 func ptInt(in int) *int {
 	return &in
 }
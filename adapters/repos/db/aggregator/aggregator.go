@@ -34,6 +34,14 @@ type vectorIndex interface {
 	SearchByVector(ctx context.Context, vector []float32, k int, allowList helpers.AllowList) ([]uint64, []float32, error)
 }
 
+// rangeVectorIndex is implemented by vector indexes which can stream every
+// vector within a distance of the query
+type rangeVectorIndex interface {
+	SearchByVectorRange(ctx context.Context, vector []float32, targetDistance float32,
+		allowList helpers.AllowList, fn func(id uint64, dist float32) bool) error
+	Multivector() bool
+}
+
 type Aggregator struct {
 	logger                 logrus.FieldLogger
 	store                  *lsmkv.Store
@@ -42,6 +50,7 @@ type Aggregator struct {
 	classSearcher          inverted.ClassSearcher // to support ref-filters
 	vectorIndex            vectorIndex
 	stopwords              stopwords.StopwordDetector
+	textAnalyzers          *inverted.TextAnalyzers
 	shardVersion           uint16
 	propLenTracker         *inverted.JsonShardMetaData
 	isFallbackToSearchable inverted.IsFallbackToSearchable
@@ -53,7 +62,8 @@ type Aggregator struct {
 
 func New(store *lsmkv.Store, params aggregation.Params,
 	getSchema schemaUC.SchemaGetter, classSearcher inverted.ClassSearcher,
-	stopwords stopwords.StopwordDetector, shardVersion uint16,
+	stopwords stopwords.StopwordDetector, textAnalyzers *inverted.TextAnalyzers,
+	shardVersion uint16,
 	vectorIndex vectorIndex, logger logrus.FieldLogger,
 	propLenTracker *inverted.JsonShardMetaData,
 	isFallbackToSearchable inverted.IsFallbackToSearchable,
@@ -68,6 +78,7 @@ func New(store *lsmkv.Store, params aggregation.Params,
 		getSchema:              getSchema,
 		classSearcher:          classSearcher,
 		stopwords:              stopwords,
+		textAnalyzers:          textAnalyzers,
 		shardVersion:           shardVersion,
 		vectorIndex:            vectorIndex,
 		propLenTracker:         propLenTracker,
@@ -84,7 +95,7 @@ func (a *Aggregator) GetPropertyLengthTracker() *inverted.JsonShardMetaData {
 }
 
 func (a *Aggregator) Do(ctx context.Context) (*aggregation.Result, error) {
-	if a.params.GroupBy != nil {
+	if len(a.params.GroupByLevels()) > 0 {
 		return newGroupedAggregator(a).Do(ctx)
 	}
 
@@ -0,0 +1,90 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package aggregator
+
+import (
+	"encoding/binary"
+	"math"
+	"math/bits"
+
+	"github.com/spaolacci/murmur3"
+)
+
+// cardinalityPrecision is the number of hash bits which select a register,
+// 2^14 registers estimate the cardinality with a standard error of ~0.8%
+const cardinalityPrecision = 14
+
+// cardinalityAggregator approximates the number of distinct values with
+// HyperLogLog. The registers of several shards are merged by taking their
+// maximum, so the same value is counted once no matter how many shards
+// contain it.
+type cardinalityAggregator struct {
+	Registers []uint8 `json:"registers"`
+}
+
+func newCardinalityAggregator() *cardinalityAggregator {
+	return &cardinalityAggregator{Registers: make([]uint8, 1<<cardinalityPrecision)}
+}
+
+func (a *cardinalityAggregator) AddFloat64(value float64) {
+	var buf [8]byte
+	binary.LittleEndian.PutUint64(buf[:], math.Float64bits(value))
+	a.addHash(murmur3.Sum64(buf[:]))
+}
+
+func (a *cardinalityAggregator) AddInt64(value int64) {
+	var buf [8]byte
+	binary.LittleEndian.PutUint64(buf[:], uint64(value))
+	a.addHash(murmur3.Sum64(buf[:]))
+}
+
+func (a *cardinalityAggregator) AddText(value string) {
+	a.addHash(murmur3.Sum64([]byte(value)))
+}
+
+func (a *cardinalityAggregator) addHash(hash uint64) {
+	register := hash >> (64 - cardinalityPrecision)
+	// the remaining bits, with a guard bit to limit the rank
+	rest := hash<<cardinalityPrecision | 1<<(cardinalityPrecision-1)
+	rank := uint8(bits.LeadingZeros64(rest) + 1)
+	if rank > a.Registers[register] {
+		a.Registers[register] = rank
+	}
+}
+
+func (a *cardinalityAggregator) merge(other *cardinalityAggregator) {
+	for i, rank := range other.Registers {
+		if i < len(a.Registers) && rank > a.Registers[i] {
+			a.Registers[i] = rank
+		}
+	}
+}
+
+func (a *cardinalityAggregator) Cardinality() int64 {
+	m := float64(len(a.Registers))
+	sum := 0.0
+	zeros := 0
+	for _, rank := range a.Registers {
+		sum += math.Ldexp(1, -int(rank))
+		if rank == 0 {
+			zeros++
+		}
+	}
+
+	estimate := 0.7213 / (1 + 1.079/m) * m * m / sum
+	if estimate <= 2.5*m && zeros > 0 {
+		// small cardinalities are estimated more precisely from the number of
+		// empty registers
+		estimate = m * math.Log(m/float64(zeros))
+	}
+	return int64(math.Round(estimate))
+}
@@ -0,0 +1,51 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package aggregator
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCardinalityAggregator(t *testing.T) {
+	for _, distinct := range []int{0, 1, 10, 1000, 100000} {
+		t.Run(fmt.Sprintf("%d distinct values", distinct), func(t *testing.T) {
+			// the shards overlap, so every value is added to two of them
+			shards := []*cardinalityAggregator{
+				newCardinalityAggregator(),
+				newCardinalityAggregator(),
+				newCardinalityAggregator(),
+			}
+			for i := 0; i < distinct; i++ {
+				shards[i%3].AddText(fmt.Sprintf("value-%d", i))
+				shards[(i+1)%3].AddText(fmt.Sprintf("value-%d", i))
+			}
+
+			combined := shards[0]
+			for _, shard := range shards[1:] {
+				combined.merge(shard)
+			}
+			assert.InDelta(t, distinct, combined.Cardinality(), float64(distinct)*0.03)
+		})
+	}
+
+	t.Run("numbers and dates", func(t *testing.T) {
+		agg := newCardinalityAggregator()
+		for i := 0; i < 500; i++ {
+			agg.AddFloat64(float64(i % 100))
+			agg.AddInt64(int64(i))
+		}
+		assert.InDelta(t, 600, agg.Cardinality(), 600*0.03)
+	})
+}
@@ -51,6 +51,28 @@ func addDateAggregations(prop *aggregation.Property,
 		}
 	}
 
+	// the distributions are only computed once the results of all shards are
+	// combined, each shard adds the partial results to merge
+	for _, aProp := range aggs {
+		switch aProp.Type {
+		case aggregation.HistogramType:
+			if aProp.Histogram == nil {
+				continue
+			}
+			histogram := newDateHistogramAggregator(aProp.Histogram)
+			for _, pair := range agg.pairs {
+				histogram.add(pair.value.epochNano, pair.count)
+			}
+			prop.DateAggregations["_histogramAggregator"] = histogram
+		case aggregation.CardinalityType:
+			cardinality := newCardinalityAggregator()
+			for _, pair := range agg.pairs {
+				cardinality.AddInt64(pair.value.epochNano)
+			}
+			prop.DateAggregations["_cardinalityAggregator"] = cardinality
+		}
+	}
+
 	for _, aProp := range aggs {
 		switch aProp {
 		case aggregation.MinimumAggregator:
@@ -130,7 +130,7 @@ func (fa *filteredAggregator) bm25Objects(ctx context.Context, kw *searchparams.
 	kw.ChooseSearchableProperties(class)
 
 	objs, scores, err := inverted.NewBM25Searcher(cfg.BM25, fa.store, fa.getSchema.ReadOnlyClass,
-		propertyspecific.Indices{}, fa.classSearcher,
+		propertyspecific.Indices{}, fa.classSearcher, fa.textAnalyzers,
 		fa.GetPropertyLengthTracker(), fa.logger, fa.shardVersion,
 	).BM25F(ctx, nil, fa.params.ClassName, *fa.params.ObjectLimit, *kw, additional.Properties{})
 	if err != nil {
@@ -415,7 +415,7 @@ func (pa propAggs) results() (map[string]aggregation.Property, error) {
 			aggProp.BooleanAggregation = prop.boolAgg.Res()
 			out[prop.name.String()] = aggProp
 		case aggregation.PropertyTypeText:
-			aggProp.TextAggregation = prop.textAgg.Res()
+			addTextAggregations(&aggProp, prop.specifiedAggregators, prop.textAgg)
 			out[prop.name.String()] = aggProp
 		case aggregation.PropertyTypeNumerical:
 			addNumericalAggregations(&aggProp, prop.specifiedAggregators,
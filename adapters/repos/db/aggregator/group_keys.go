@@ -0,0 +1,227 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package aggregator
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/entities/aggregation"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// groupKey derives the keys of the groups an object belongs to on one level
+// of nested groups
+type groupKey struct {
+	aggregation.GroupByKey
+	geo distancer.Provider
+}
+
+func (a *Aggregator) newGroupKeys(levels []aggregation.GroupByKey) ([]groupKey, error) {
+	keys := make([]groupKey, len(levels))
+	for i, level := range levels {
+		if len(level.Path.Slice()) > 1 {
+			return nil, fmt.Errorf("grouping by cross-refs not supported")
+		}
+
+		keys[i] = groupKey{GroupByKey: level}
+		if len(level.Ranges) == 0 && level.DateInterval == "" {
+			// every value is a group, regardless of the property's type
+			continue
+		}
+
+		dt, err := a.groupKeyDataType(level)
+		if err != nil {
+			return nil, err
+		}
+
+		switch {
+		case level.GeoOrigin != nil:
+			if dt != schema.DataTypeGeoCoordinates {
+				return nil, fmt.Errorf("group by %s: geo rings require a geoCoordinates property", level.Path.Property)
+			}
+		case len(level.Ranges) > 0:
+			if !isNumericalDataType(dt) {
+				return nil, fmt.Errorf("group by %s: ranges require a number property", level.Path.Property)
+			}
+		case level.DateInterval != "":
+			if dt != schema.DataTypeDate && dt != schema.DataTypeDateArray {
+				return nil, fmt.Errorf("group by %s: date intervals require a date property", level.Path.Property)
+			}
+		}
+
+		if level.GeoOrigin != nil {
+			keys[i].geo = distancer.NewGeoProvider()
+		}
+	}
+	return keys, nil
+}
+
+func (a *Aggregator) groupKeyDataType(level aggregation.GroupByKey) (schema.DataType, error) {
+	class := a.getSchema.ReadOnlyClass(a.params.ClassName.String())
+	if class == nil {
+		return "", fmt.Errorf("could not find class %s in schema", a.params.ClassName)
+	}
+
+	prop, err := schema.GetPropertyByName(class, level.Path.Property.String())
+	if err != nil {
+		return "", err
+	}
+	return schema.DataType(prop.DataType[0]), nil
+}
+
+func isNumericalDataType(dt schema.DataType) bool {
+	switch dt {
+	case schema.DataTypeInt, schema.DataTypeNumber, schema.DataTypeIntArray,
+		schema.DataTypeNumberArray:
+		return true
+	default:
+		return false
+	}
+}
+
+// values are the group keys of a property value, arrays belong to the groups
+// of all their elements
+func (k groupKey) values(item interface{}) []interface{} {
+	var elems []interface{}
+	switch val := item.(type) {
+	case []string:
+		for i := range val {
+			elems = append(elems, val[i])
+		}
+	case []float64:
+		for i := range val {
+			elems = append(elems, val[i])
+		}
+	case []bool:
+		for i := range val {
+			elems = append(elems, val[i])
+		}
+	case []time.Time:
+		for i := range val {
+			elems = append(elems, val[i])
+		}
+	case []interface{}:
+		elems = val
+	case models.MultipleRef:
+		for i := range val {
+			elems = append(elems, val[i].Beacon)
+		}
+	default:
+		elems = []interface{}{val}
+	}
+
+	if len(k.Ranges) == 0 && k.DateInterval == "" {
+		return elems
+	}
+
+	var out []interface{}
+	for _, elem := range elems {
+		out = append(out, k.derive(elem)...)
+	}
+	return out
+}
+
+func (k groupKey) derive(elem interface{}) []interface{} {
+	if k.DateInterval != "" {
+		t, ok := asTime(elem)
+		if !ok {
+			return nil
+		}
+		return []interface{}{k.DateInterval.Start(t).Format(time.RFC3339)}
+	}
+
+	var value float64
+	if k.GeoOrigin != nil {
+		lat, lon, ok := asGeoCoordinates(elem)
+		if !ok {
+			return nil
+		}
+		dist, err := k.geo.SingleDist(
+			[]float32{k.GeoOrigin.Latitude, k.GeoOrigin.Longitude}, []float32{lat, lon})
+		if err != nil {
+			return nil
+		}
+		value = float64(dist)
+	} else {
+		number, ok := asFloat64(elem)
+		if !ok {
+			return nil
+		}
+		value = number
+	}
+
+	var out []interface{}
+	for _, r := range k.Ranges {
+		if r.Contains(value) {
+			out = append(out, r.String())
+		}
+	}
+	return out
+}
+
+// rangeOf returns the range of a range group
+func (k groupKey) rangeOf(value interface{}) *aggregation.GroupByRange {
+	label, ok := value.(string)
+	if !ok || len(k.Ranges) == 0 {
+		return nil
+	}
+	for i := range k.Ranges {
+		if k.Ranges[i].String() == label {
+			return &k.Ranges[i]
+		}
+	}
+	return nil
+}
+
+func asFloat64(elem interface{}) (float64, bool) {
+	switch val := elem.(type) {
+	case float64:
+		return val, true
+	case int64:
+		return float64(val), true
+	case int:
+		return float64(val), true
+	default:
+		return 0, false
+	}
+}
+
+func asTime(elem interface{}) (time.Time, bool) {
+	switch val := elem.(type) {
+	case time.Time:
+		return val, true
+	case string:
+		t, err := time.Parse(time.RFC3339Nano, val)
+		return t, err == nil
+	default:
+		return time.Time{}, false
+	}
+}
+
+func asGeoCoordinates(elem interface{}) (float32, float32, bool) {
+	switch val := elem.(type) {
+	case *models.GeoCoordinates:
+		if val == nil || val.Latitude == nil || val.Longitude == nil {
+			return 0, 0, false
+		}
+		return *val.Latitude, *val.Longitude, true
+	case map[string]interface{}:
+		lat, okLat := asFloat64(val["latitude"])
+		lon, okLon := asFloat64(val["longitude"])
+		return float32(lat), float32(lon), okLat && okLon
+	default:
+		return 0, 0, false
+	}
+}
@@ -0,0 +1,87 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package aggregator
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/entities/aggregation"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestGroupKeyValues(t *testing.T) {
+	ptFloat64 := func(in float64) *float64 { return &in }
+	ptFloat32 := func(in float32) *float32 { return &in }
+
+	t.Run("plain values", func(t *testing.T) {
+		key := groupKey{}
+		assert.Equal(t, []interface{}{"a"}, key.values("a"))
+		assert.Equal(t, []interface{}{"a", "b"}, key.values([]string{"a", "b"}))
+	})
+
+	t.Run("overlapping ranges", func(t *testing.T) {
+		key := groupKey{GroupByKey: aggregation.GroupByKey{Ranges: []aggregation.GroupByRange{
+			{To: ptFloat64(10)},
+			{From: ptFloat64(5), To: ptFloat64(20)},
+			{From: ptFloat64(20)},
+		}}}
+		assert.Equal(t, []interface{}{"*-10", "5-20"}, key.values(float64(7)))
+		assert.Equal(t, []interface{}{"20-*"}, key.values(int64(20)))
+		assert.Equal(t, []interface{}{"*-10", "20-*"}, key.values([]float64{-1, 30}))
+		assert.Equal(t, &key.Ranges[1], key.rangeOf("5-20"))
+		assert.Nil(t, key.rangeOf("unknown"))
+	})
+
+	t.Run("date intervals", func(t *testing.T) {
+		// a Wednesday
+		date := time.Date(2024, 5, 15, 13, 45, 12, 0, time.UTC)
+		for interval, expected := range map[aggregation.DateInterval]string{
+			aggregation.DateIntervalMinute:  "2024-05-15T13:45:00Z",
+			aggregation.DateIntervalHour:    "2024-05-15T13:00:00Z",
+			aggregation.DateIntervalDay:     "2024-05-15T00:00:00Z",
+			aggregation.DateIntervalWeek:    "2024-05-13T00:00:00Z",
+			aggregation.DateIntervalMonth:   "2024-05-01T00:00:00Z",
+			aggregation.DateIntervalQuarter: "2024-04-01T00:00:00Z",
+			aggregation.DateIntervalYear:    "2024-01-01T00:00:00Z",
+		} {
+			key := groupKey{GroupByKey: aggregation.GroupByKey{DateInterval: interval}}
+			assert.Equal(t, []interface{}{expected}, key.values(date), interval)
+		}
+	})
+
+	t.Run("geo rings", func(t *testing.T) {
+		key := groupKey{
+			GroupByKey: aggregation.GroupByKey{
+				GeoOrigin: &aggregation.GeoOrigin{Latitude: 52.3676, Longitude: 4.9041},
+				Ranges: []aggregation.GroupByRange{
+					{To: ptFloat64(10_000)},
+					{From: ptFloat64(10_000), To: ptFloat64(100_000)},
+					{From: ptFloat64(100_000)},
+				},
+			},
+			geo: distancer.NewGeoProvider(),
+		}
+		// Amsterdam city center, Utrecht and Berlin
+		assert.Equal(t, []interface{}{"*-10000"}, key.values(&models.GeoCoordinates{
+			Latitude: ptFloat32(52.3731), Longitude: ptFloat32(4.8922),
+		}))
+		assert.Equal(t, []interface{}{"10000-100000"}, key.values(&models.GeoCoordinates{
+			Latitude: ptFloat32(52.0907), Longitude: ptFloat32(5.1214),
+		}))
+		assert.Equal(t, []interface{}{"100000-*"}, key.values(map[string]interface{}{
+			"latitude": 52.52, "longitude": 13.405,
+		}))
+	})
+}
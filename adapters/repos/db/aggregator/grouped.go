@@ -38,17 +38,35 @@ func (ga *groupedAggregator) Do(ctx context.Context) (*aggregation.Result, error
 		return nil, errors.Wrap(err, "identify groups")
 	}
 
-	out.Groups = make([]aggregation.Group, len(groups))
+	out.Groups, err = ga.aggregateGroups(ctx, groups)
+	if err != nil {
+		return nil, err
+	}
+
+	return &out, nil
+}
+
+func (ga *groupedAggregator) aggregateGroups(ctx context.Context,
+	groups []group,
+) ([]aggregation.Group, error) {
+	if len(groups) == 0 {
+		return nil, nil
+	}
+
+	out := make([]aggregation.Group, len(groups))
 	for i, g := range groups {
 		res, err := ga.aggregateGroup(ctx, g.res, g.docIDs)
 		if err != nil {
 			return nil, errors.Wrapf(err, "aggregate group %d (%v)", i,
 				g.res.GroupedBy.Value)
 		}
-		out.Groups[i] = res
+		res.Groups, err = ga.aggregateGroups(ctx, g.groups)
+		if err != nil {
+			return nil, err
+		}
+		out[i] = res
 	}
-
-	return &out, nil
+	return out, nil
 }
 
 // group is a helper construct that contains the final aggregation.Group which
@@ -58,6 +76,7 @@ func (ga *groupedAggregator) Do(ctx context.Context) (*aggregation.Result, error
 type group struct {
 	res    aggregation.Group
 	docIDs []uint64
+	groups []group // nested groups of the next group by key
 }
 
 func (ga *groupedAggregator) identifyGroups(ctx context.Context) ([]group, error) {
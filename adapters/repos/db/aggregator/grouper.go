@@ -33,23 +33,25 @@ import (
 // additionally performs an aggregation for each group.
 type grouper struct {
 	*Aggregator
-	values    map[interface{}]map[uint64]struct{} // map[value][docID]struct, to keep docIds unique
-	topGroups []group
-	limit     int
+	keys    []groupKey
+	docKeys map[uint64][][]interface{} // map[docID][level]keys
+	limit   int
 }
 
 func newGrouper(a *Aggregator, limit int) *grouper {
 	return &grouper{
 		Aggregator: a,
-		values:     map[interface{}]map[uint64]struct{}{},
+		docKeys:    map[uint64][][]interface{}{},
 		limit:      limit,
 	}
 }
 
 func (g *grouper) Do(ctx context.Context) ([]group, error) {
-	if len(g.params.GroupBy.Slice()) > 1 {
-		return nil, fmt.Errorf("grouping by cross-refs not supported")
+	keys, err := g.newGroupKeys(g.params.GroupByLevels())
+	if err != nil {
+		return nil, err
 	}
+	g.keys = keys
 
 	if g.params.Filters == nil && len(g.params.SearchVector) == 0 && g.params.Hybrid == nil {
 		return g.groupAll(ctx)
@@ -66,7 +68,7 @@ func (g *grouper) groupAll(ctx context.Context) ([]group, error) {
 		return nil, errors.Wrap(err, "group all (unfiltered)")
 	}
 
-	return g.aggregateAndSelect()
+	return g.selectGroups(nil, 0), nil
 }
 
 func (g *grouper) groupFiltered(ctx context.Context) ([]group, error) {
@@ -75,14 +77,18 @@ func (g *grouper) groupFiltered(ctx context.Context) ([]group, error) {
 		return nil, err
 	}
 
+	props := make([]string, len(g.keys))
+	for i, key := range g.keys {
+		props[i] = key.Path.Property.String()
+	}
 	if err := docid.ScanObjectsLSM(g.store, ids,
 		func(prop *models.PropertySchema, docID uint64) (bool, error) {
 			return true, g.addElementById(prop, docID)
-		}, []string{g.params.GroupBy.Property.String()}); err != nil {
+		}, props); err != nil {
 		return nil, err
 	}
 
-	return g.aggregateAndSelect()
+	return g.selectGroups(nil, 0), nil
 }
 
 func (g *grouper) fetchDocIDs(ctx context.Context) (ids []uint64, err error) {
@@ -159,50 +165,57 @@ func (g *grouper) addElementById(s *models.PropertySchema, docID uint64) error {
 		return nil
 	}
 
-	item, ok := (*s).(map[string]interface{})[g.params.GroupBy.Property.String()]
-	if !ok {
-		return nil
-	}
-
-	switch val := item.(type) {
-	case []string:
-		for i := range val {
-			g.addItem(val[i], docID)
-		}
-	case []float64:
-		for i := range val {
-			g.addItem(val[i], docID)
-		}
-	case []bool:
-		for i := range val {
-			g.addItem(val[i], docID)
+	props := (*s).(map[string]interface{})
+	var keys [][]interface{}
+	for level, key := range g.keys {
+		item, ok := props[key.Path.Property.String()]
+		if !ok {
+			continue
 		}
-	case []interface{}:
-		for i := range val {
-			g.addItem(val[i], docID)
+		values := key.values(item)
+		if len(values) == 0 {
+			continue
 		}
-	case models.MultipleRef:
-		for i := range val {
-			g.addItem(val[i].Beacon, docID)
+		if keys == nil {
+			keys = make([][]interface{}, len(g.keys))
 		}
-	default:
-		g.addItem(val, docID)
+		keys[level] = values
 	}
 
+	if keys != nil {
+		g.docKeys[docID] = keys
+	}
 	return nil
 }
 
-func (g *grouper) addItem(item interface{}, docID uint64) {
-	idsMap, ok := g.values[item]
-	if !ok {
-		idsMap = map[uint64]struct{}{}
+// selectGroups groups the given objects, or all of them if docIDs is nil, by
+// the key of the level and nests the groups of the next level into the top
+// groups
+func (g *grouper) selectGroups(docIDs []uint64, level int) []group {
+	values := map[interface{}]map[uint64]struct{}{} // map[value][docID]struct, to keep docIds unique
+	addItem := func(docID uint64) {
+		for _, item := range g.docKeys[docID][level] {
+			idsMap, ok := values[item]
+			if !ok {
+				idsMap = map[uint64]struct{}{}
+				values[item] = idsMap
+			}
+			idsMap[docID] = struct{}{}
+		}
+	}
+	if docIDs == nil {
+		for docID := range g.docKeys {
+			addItem(docID)
+		}
+	} else {
+		for _, docID := range docIDs {
+			addItem(docID)
+		}
 	}
-	idsMap[docID] = struct{}{}
-	g.values[item] = idsMap
-}
 
-func (g *grouper) aggregateAndSelect() ([]group, error) {
-	for value, idsMap := range g.values {
+	var topGroups []group
+	key := g.keys[level]
+	for value, idsMap := range values {
 		count := len(idsMap)
 		ids := make([]uint64, count)
 
@@ -212,11 +225,12 @@ func (g *grouper) aggregateAndSelect() ([]group, error) {
 			i++
 		}
 
-		g.insertOrdered(group{
+		topGroups = g.insertOrdered(topGroups, group{
 			res: aggregation.Group{
 				GroupedBy: &aggregation.GroupedBy{
-					Path:  g.params.GroupBy.Slice(),
+					Path:  key.Path.Slice(),
 					Value: value,
+					Range: key.rangeOf(value),
 				},
 				Count: count,
 			},
@@ -224,26 +238,30 @@ func (g *grouper) aggregateAndSelect() ([]group, error) {
 		})
 	}
 
-	return g.topGroups, nil
+	if level+1 < len(g.keys) {
+		for i := range topGroups {
+			topGroups[i].groups = g.selectGroups(topGroups[i].docIDs, level+1)
+		}
+	}
+	return topGroups
 }
 
-func (g *grouper) insertOrdered(elem group) {
-	if len(g.topGroups) == 0 {
-		g.topGroups = []group{elem}
-		return
+func (g *grouper) insertOrdered(topGroups []group, elem group) []group {
+	if len(topGroups) == 0 {
+		return []group{elem}
 	}
 
 	added := false
-	for i, existing := range g.topGroups {
+	for i, existing := range topGroups {
 		if existing.res.Count > elem.res.Count {
 			continue
 		}
 
 		// we have found the first one that's smaller so we must insert before i
-		g.topGroups = append(
-			g.topGroups[:i], append(
+		topGroups = append(
+			topGroups[:i], append(
 				[]group{elem},
-				g.topGroups[i:]...,
+				topGroups[i:]...,
 			)...,
 		)
 
@@ -251,17 +269,16 @@ func (g *grouper) insertOrdered(elem group) {
 		break
 	}
 
-	if len(g.topGroups) > g.limit {
-		g.topGroups = g.topGroups[:len(g.topGroups)-1]
+	if len(topGroups) > g.limit {
+		topGroups = topGroups[:len(topGroups)-1]
 	}
 
-	if !added && len(g.topGroups) < g.limit {
-		g.topGroups = append(g.topGroups, elem)
+	if !added && len(topGroups) < g.limit {
+		topGroups = append(topGroups, elem)
 	}
+	return topGroups
 }
 
-// ScanAll iterates over every row in the object buckets
-// TODO: where should this live?
 func ScanAll(tx *bolt.Tx, scan docid.ObjectScanFn) error {
 	b := tx.Bucket(helpers.ObjectsBucket)
 	if b == nil {
@@ -0,0 +1,141 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package aggregator
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"github.com/weaviate/weaviate/entities/aggregation"
+)
+
+// histogramAggregator counts values per bucket. The buckets are either
+// Interval wide and aligned to zero, or lie between the Edges. As every shard
+// uses the same buckets, their counts are merged by adding them up. Numbers
+// are counted as float64, dates as int64 nanoseconds since the epoch.
+type histogramAggregator[T float64 | int64] struct {
+	Interval T                `json:"interval,omitempty"`
+	Edges    []T              `json:"edges,omitempty"`
+	Counts   map[int64]uint64 `json:"counts"`
+}
+
+func newNumericalHistogramAggregator(params *aggregation.HistogramParams) *histogramAggregator[float64] {
+	return &histogramAggregator[float64]{
+		Interval: params.Interval,
+		Edges:    params.Edges,
+		Counts:   map[int64]uint64{},
+	}
+}
+
+func newDateHistogramAggregator(params *aggregation.HistogramParams) *histogramAggregator[int64] {
+	edges := make([]int64, len(params.Edges))
+	for i, edge := range params.Edges {
+		edges[i] = secondsToNanos(edge)
+	}
+	return &histogramAggregator[int64]{
+		Interval: secondsToNanos(params.Interval),
+		Edges:    edges,
+		Counts:   map[int64]uint64{},
+	}
+}
+
+func secondsToNanos(seconds float64) int64 {
+	return int64(math.Round(seconds * float64(time.Second)))
+}
+
+func (a *histogramAggregator[T]) add(value T, count uint64) {
+	if bucket, ok := a.bucket(value); ok && count > 0 {
+		a.Counts[bucket] += count
+	}
+}
+
+func (a *histogramAggregator[T]) bucket(value T) (int64, bool) {
+	if len(a.Edges) > 0 {
+		if value < a.Edges[0] || value >= a.Edges[len(a.Edges)-1] {
+			return 0, false
+		}
+		return int64(sort.Search(len(a.Edges), func(i int) bool {
+			return a.Edges[i] > value
+		}) - 1), true
+	}
+
+	switch v := any(value).(type) {
+	case float64:
+		return int64(math.Floor(v / float64(a.Interval))), true
+	case int64:
+		interval := int64(a.Interval)
+		bucket := v / interval
+		if v%interval < 0 {
+			bucket--
+		}
+		return bucket, true
+	default:
+		return 0, false
+	}
+}
+
+func (a *histogramAggregator[T]) bounds(bucket int64) (T, T) {
+	if len(a.Edges) > 0 {
+		return a.Edges[bucket], a.Edges[bucket+1]
+	}
+	return T(bucket) * a.Interval, T(bucket+1) * a.Interval
+}
+
+func (a *histogramAggregator[T]) merge(other *histogramAggregator[T]) {
+	for bucket, count := range other.Counts {
+		a.Counts[bucket] += count
+	}
+}
+
+// buckets are all buckets between the edges, but only the buckets holding
+// values of an interval histogram, as their number is unbounded otherwise
+func (a *histogramAggregator[T]) buckets() []int64 {
+	if len(a.Edges) > 0 {
+		buckets := make([]int64, len(a.Edges)-1)
+		for i := range buckets {
+			buckets[i] = int64(i)
+		}
+		return buckets
+	}
+
+	buckets := make([]int64, 0, len(a.Counts))
+	for bucket := range a.Counts {
+		buckets = append(buckets, bucket)
+	}
+	sort.Slice(buckets, func(x, y int) bool { return buckets[x] < buckets[y] })
+	return buckets
+}
+
+func numericalHistogramRes(a *histogramAggregator[float64]) []aggregation.HistogramBucket {
+	buckets := a.buckets()
+	out := make([]aggregation.HistogramBucket, len(buckets))
+	for i, bucket := range buckets {
+		from, to := a.bounds(bucket)
+		out[i] = aggregation.HistogramBucket{From: from, To: to, Count: int64(a.Counts[bucket])}
+	}
+	return out
+}
+
+func dateHistogramRes(a *histogramAggregator[int64]) []aggregation.DateHistogramBucket {
+	buckets := a.buckets()
+	out := make([]aggregation.DateHistogramBucket, len(buckets))
+	for i, bucket := range buckets {
+		from, to := a.bounds(bucket)
+		out[i] = aggregation.DateHistogramBucket{
+			From:  newTimestamp(from).rfc3339,
+			To:    newTimestamp(to).rfc3339,
+			Count: int64(a.Counts[bucket]),
+		}
+	}
+	return out
+}
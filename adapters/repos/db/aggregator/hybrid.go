@@ -53,7 +53,7 @@ func (a *Aggregator) bm25Objects(ctx context.Context, kw *searchparams.KeywordRa
 	kw.ChooseSearchableProperties(class)
 
 	objs, dists, err := inverted.NewBM25Searcher(cfg.BM25, a.store, a.getSchema.ReadOnlyClass,
-		propertyspecific.Indices{}, a.classSearcher,
+		propertyspecific.Indices{}, a.classSearcher, a.textAnalyzers,
 		a.GetPropertyLengthTracker(), a.logger, a.shardVersion,
 	).BM25F(ctx, nil, a.params.ClassName, *a.params.ObjectLimit, *kw, additional.Properties{})
 	if err != nil {
@@ -51,6 +51,38 @@ loop:
 		}
 	}
 
+	// the distributions are only computed once the results of all shards are
+	// combined, each shard adds the partial results to merge
+	for _, aProp := range aggs {
+		switch aProp.Type {
+		case aggregation.PercentileType:
+			quantiles := aggregation.DefaultPercentileQuantiles
+			if aProp.Percentile != nil && len(aProp.Percentile.Quantiles) > 0 {
+				quantiles = aProp.Percentile.Quantiles
+			}
+			percentiles := newPercentileAggregator(quantiles)
+			for _, pair := range agg.pairs {
+				percentiles.add(pair.value, pair.count)
+			}
+			prop.NumericalAggregations["_percentileAggregator"] = percentiles
+		case aggregation.HistogramType:
+			if aProp.Histogram == nil {
+				continue
+			}
+			histogram := newNumericalHistogramAggregator(aProp.Histogram)
+			for _, pair := range agg.pairs {
+				histogram.add(pair.value, pair.count)
+			}
+			prop.NumericalAggregations["_histogramAggregator"] = histogram
+		case aggregation.CardinalityType:
+			cardinality := newCardinalityAggregator()
+			for _, pair := range agg.pairs {
+				cardinality.AddFloat64(pair.value)
+			}
+			prop.NumericalAggregations["_cardinalityAggregator"] = cardinality
+		}
+	}
+
 	for _, aProp := range aggs {
 		switch aProp {
 		case aggregation.MeanAggregator:
@@ -0,0 +1,144 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package aggregator
+
+import (
+	"math"
+	"sort"
+
+	"github.com/weaviate/weaviate/entities/aggregation"
+)
+
+// percentileRelativeAccuracy is the maximum relative error of an estimated
+// percentile
+const percentileRelativeAccuracy = 0.01
+
+// percentileAggregator estimates quantiles with a bounded relative error,
+// following DDSketch (https://arxiv.org/abs/1908.10693). Values are counted
+// in buckets of logarithmically growing width, so the sketches of several
+// shards are merged by adding up their bucket counts. The fields are exported
+// as the sketch is sent to the node combining the shard results.
+type percentileAggregator struct {
+	Quantiles []float64        `json:"quantiles"`
+	Gamma     float64          `json:"gamma"`
+	Positive  map[int32]uint64 `json:"positive"`
+	Negative  map[int32]uint64 `json:"negative"`
+	Zero      uint64           `json:"zero"`
+	Count     uint64           `json:"count"`
+	Min       float64          `json:"min"`
+	Max       float64          `json:"max"`
+}
+
+func newPercentileAggregator(quantiles []float64) *percentileAggregator {
+	return &percentileAggregator{
+		Quantiles: quantiles,
+		Gamma:     (1 + percentileRelativeAccuracy) / (1 - percentileRelativeAccuracy),
+		Positive:  map[int32]uint64{},
+		Negative:  map[int32]uint64{},
+		Min:       math.MaxFloat64,
+		Max:       -math.MaxFloat64,
+	}
+}
+
+func (a *percentileAggregator) key(value float64) int32 {
+	return int32(math.Ceil(math.Log(value) / math.Log(a.Gamma)))
+}
+
+// value is the estimate for all values counted in the bucket of key
+func (a *percentileAggregator) value(key int32) float64 {
+	return 2 * math.Pow(a.Gamma, float64(key)) / (a.Gamma + 1)
+}
+
+func (a *percentileAggregator) add(value float64, count uint64) {
+	if count == 0 || math.IsNaN(value) {
+		return
+	}
+
+	a.Count += count
+	a.Min = math.Min(a.Min, value)
+	a.Max = math.Max(a.Max, value)
+
+	switch {
+	case value > 0:
+		a.Positive[a.key(value)] += count
+	case value < 0:
+		a.Negative[a.key(-value)] += count
+	default:
+		a.Zero += count
+	}
+}
+
+func (a *percentileAggregator) merge(other *percentileAggregator) {
+	if other.Count == 0 {
+		return
+	}
+
+	a.Count += other.Count
+	a.Zero += other.Zero
+	a.Min = math.Min(a.Min, other.Min)
+	a.Max = math.Max(a.Max, other.Max)
+	for key, count := range other.Positive {
+		a.Positive[key] += count
+	}
+	for key, count := range other.Negative {
+		a.Negative[key] += count
+	}
+}
+
+// quantile estimates the value at quantile q. The estimate is never outside
+// of the values added, so the quantiles 0 and 1 are exact.
+func (a *percentileAggregator) quantile(q float64) float64 {
+	if a.Count == 0 {
+		return 0
+	}
+
+	rank := uint64(q * float64(a.Count-1))
+	return math.Max(a.Min, math.Min(a.Max, a.estimate(rank)))
+}
+
+// estimate walks the buckets in ascending order of their values, negative
+// values are counted with the key of their magnitude
+func (a *percentileAggregator) estimate(rank uint64) float64 {
+	var seen uint64
+	negative := sortedKeys(a.Negative)
+	for i := len(negative) - 1; i >= 0; i-- {
+		if seen += a.Negative[negative[i]]; seen > rank {
+			return -a.value(negative[i])
+		}
+	}
+	if seen += a.Zero; seen > rank {
+		return 0
+	}
+	for _, key := range sortedKeys(a.Positive) {
+		if seen += a.Positive[key]; seen > rank {
+			return a.value(key)
+		}
+	}
+	return a.Max
+}
+
+func (a *percentileAggregator) Res() []aggregation.Percentile {
+	out := make([]aggregation.Percentile, len(a.Quantiles))
+	for i, q := range a.Quantiles {
+		out[i] = aggregation.Percentile{Quantile: q, Value: a.quantile(q)}
+	}
+	return out
+}
+
+func sortedKeys(buckets map[int32]uint64) []int32 {
+	keys := make([]int32, 0, len(buckets))
+	for key := range buckets {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(a, b int) bool { return keys[a] < keys[b] })
+	return keys
+}
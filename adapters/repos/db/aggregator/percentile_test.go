@@ -0,0 +1,73 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package aggregator
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPercentileAggregator(t *testing.T) {
+	r := rand.New(rand.NewSource(7))
+	quantiles := []float64{0, 0.5, 0.9, 0.95, 0.99, 1}
+
+	tests := []struct {
+		name   string
+		sample func() float64
+	}{
+		{name: "exponential latencies", sample: func() float64 { return r.ExpFloat64() * 120 }},
+		{name: "normal around zero", sample: func() float64 { return r.NormFloat64() * 50 }},
+		{name: "few distinct values", sample: func() float64 { return float64(r.Intn(5)) }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			values := make([]float64, 10000)
+			shards := []*percentileAggregator{
+				newPercentileAggregator(quantiles),
+				newPercentileAggregator(quantiles),
+				newPercentileAggregator(quantiles),
+			}
+			for i := range values {
+				values[i] = tt.sample()
+				shards[i%len(shards)].add(values[i], 1)
+			}
+			sort.Float64s(values)
+
+			combined := shards[0]
+			for _, shard := range shards[1:] {
+				combined.merge(shard)
+			}
+
+			for _, res := range combined.Res() {
+				exact := values[int(res.Quantile*float64(len(values)-1))]
+				assert.InDelta(t, exact, res.Value, math.Abs(exact)*percentileRelativeAccuracy+1e-9,
+					"quantile %v", res.Quantile)
+			}
+		})
+	}
+
+	t.Run("counted rows", func(t *testing.T) {
+		agg := newPercentileAggregator([]float64{0.5})
+		agg.add(10, 3)
+		agg.add(1000, 1)
+		assert.InDelta(t, 10, agg.Res()[0].Value, 10*percentileRelativeAccuracy)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		agg := newPercentileAggregator([]float64{0.5})
+		assert.Equal(t, float64(0), agg.Res()[0].Value)
+	})
+}
@@ -12,6 +12,8 @@
 package aggregator
 
 import (
+	"encoding/json"
+	"fmt"
 	"sort"
 	"time"
 
@@ -24,7 +26,9 @@ func NewShardCombiner() *ShardCombiner {
 	return &ShardCombiner{}
 }
 
-func (sc *ShardCombiner) Do(results []*aggregation.Result) *aggregation.Result {
+// Do combines the results of the shards. It fails if the partial results of
+// a shard, which remote shards send as json, can't be decoded.
+func (sc *ShardCombiner) Do(results []*aggregation.Result) (*aggregation.Result, error) {
 	allResultsAreNil := true
 	firstNonNilRes := 0
 	for i, res := range results {
@@ -36,7 +40,7 @@ func (sc *ShardCombiner) Do(results []*aggregation.Result) *aggregation.Result {
 	}
 
 	if allResultsAreNil {
-		return &aggregation.Result{}
+		return &aggregation.Result{}, nil
 	}
 
 	if results[firstNonNilRes].Groups[0].GroupedBy == nil {
@@ -46,7 +50,7 @@ func (sc *ShardCombiner) Do(results []*aggregation.Result) *aggregation.Result {
 	return sc.combineGrouped(results)
 }
 
-func (sc *ShardCombiner) combineUngrouped(results []*aggregation.Result) *aggregation.Result {
+func (sc *ShardCombiner) combineUngrouped(results []*aggregation.Result) (*aggregation.Result, error) {
 	combined := aggregation.Result{
 		Groups: make([]aggregation.Group, 1),
 	}
@@ -55,40 +59,75 @@ func (sc *ShardCombiner) combineUngrouped(results []*aggregation.Result) *aggreg
 		if len(shard.Groups) == 0 { // not every shard has results
 			continue
 		}
-		sc.mergeIntoCombinedGroupAtPos(combined.Groups, 0, shard.Groups[0])
+		if err := sc.mergeIntoCombinedGroupAtPos(combined.Groups, 0, shard.Groups[0]); err != nil {
+			return nil, err
+		}
 	}
 
-	sc.finalizeGroup(&combined.Groups[0])
-	return &combined
+	if err := sc.finalizeGroup(&combined.Groups[0]); err != nil {
+		return nil, err
+	}
+	return &combined, nil
 }
 
-func (sc *ShardCombiner) combineGrouped(results []*aggregation.Result) *aggregation.Result {
+func (sc *ShardCombiner) combineGrouped(results []*aggregation.Result) (*aggregation.Result, error) {
 	combined := aggregation.Result{}
 
 	for _, shard := range results {
-		for _, shardGroup := range shard.Groups {
-			pos := getPosOfGroup(combined.Groups, shardGroup.GroupedBy.Value)
-			if pos < 0 {
-				combined.Groups = append(combined.Groups, shardGroup)
-			} else {
-				sc.mergeIntoCombinedGroupAtPos(combined.Groups, pos, shardGroup)
-			}
+		groups, err := sc.mergeGroups(combined.Groups, shard.Groups)
+		if err != nil {
+			return nil, err
+		}
+		combined.Groups = groups
+	}
+
+	if err := sc.finalizeGroups(combined.Groups); err != nil {
+		return nil, err
+	}
+	return &combined, nil
+}
+
+// mergeGroups merges the groups of a shard into the combined groups of the
+// same level, nested groups are merged with the nested groups of their parent
+func (sc *ShardCombiner) mergeGroups(combined, shardGroups []aggregation.Group) ([]aggregation.Group, error) {
+	for _, shardGroup := range shardGroups {
+		pos := getPosOfGroup(combined, shardGroup.GroupedBy.Value)
+		if pos < 0 {
+			combined = append(combined, shardGroup)
+			continue
 		}
+
+		if err := sc.mergeIntoCombinedGroupAtPos(combined, pos, shardGroup); err != nil {
+			return nil, err
+		}
+		groups, err := sc.mergeGroups(combined[pos].Groups, shardGroup.Groups)
+		if err != nil {
+			return nil, err
+		}
+		combined[pos].Groups = groups
 	}
+	return combined, nil
+}
 
-	for i := range combined.Groups {
-		sc.finalizeGroup(&combined.Groups[i])
+func (sc *ShardCombiner) finalizeGroups(groups []aggregation.Group) error {
+	for i := range groups {
+		if err := sc.finalizeGroup(&groups[i]); err != nil {
+			return err
+		}
+		if err := sc.finalizeGroups(groups[i].Groups); err != nil {
+			return err
+		}
 	}
 
-	sort.Slice(combined.Groups, func(a, b int) bool {
-		return combined.Groups[a].Count > combined.Groups[b].Count
+	sort.Slice(groups, func(a, b int) bool {
+		return groups[a].Count > groups[b].Count
 	})
-	return &combined
+	return nil
 }
 
 func (sc *ShardCombiner) mergeIntoCombinedGroupAtPos(combinedGroups []aggregation.Group,
 	pos int, shardGroup aggregation.Group,
-) {
+) error {
 	combinedGroups[pos].Count += shardGroup.Count
 
 	for propName, prop := range shardGroup.Properties {
@@ -105,14 +144,18 @@ func (sc *ShardCombiner) mergeIntoCombinedGroupAtPos(combinedGroups []aggregatio
 			if combinedProp.NumericalAggregations == nil {
 				combinedProp.NumericalAggregations = map[string]interface{}{}
 			}
-			sc.mergeNumericalProp(
-				combinedProp.NumericalAggregations, prop.NumericalAggregations)
+			if err := sc.mergeNumericalProp(
+				combinedProp.NumericalAggregations, prop.NumericalAggregations); err != nil {
+				return fmt.Errorf("property %q: %w", propName, err)
+			}
 		case aggregation.PropertyTypeDate:
 			if combinedProp.DateAggregations == nil {
 				combinedProp.DateAggregations = map[string]interface{}{}
 			}
-			sc.mergeDateProp(
-				combinedProp.DateAggregations, prop.DateAggregations)
+			if err := sc.mergeDateProp(
+				combinedProp.DateAggregations, prop.DateAggregations); err != nil {
+				return fmt.Errorf("property %q: %w", propName, err)
+			}
 		case aggregation.PropertyTypeBoolean:
 			sc.mergeBooleanProp(
 				&combinedProp.BooleanAggregation, &prop.BooleanAggregation)
@@ -128,11 +171,12 @@ func (sc *ShardCombiner) mergeIntoCombinedGroupAtPos(combinedGroups []aggregatio
 		combinedGroups[pos].Properties[propName] = combinedProp
 
 	}
+	return nil
 }
 
-func (sc *ShardCombiner) mergeDateProp(first, second map[string]interface{}) {
+func (sc *ShardCombiner) mergeDateProp(first, second map[string]interface{}) error {
 	if len(second) == 0 {
-		return
+		return nil
 	}
 
 	// add all values from the second map to the first one. This is needed to compute median and mode correctly
@@ -153,17 +197,25 @@ func (sc *ShardCombiner) mergeDateProp(first, second map[string]interface{}) {
 			} else {
 				first[propType] = second[propType]
 			}
+		case "_histogramAggregator":
+			if err := mergePartial(first, propType, second[propType], (*histogramAggregator[int64]).merge); err != nil {
+				return err
+			}
+		case "_cardinalityAggregator":
+			if err := mergePartial(first, propType, second[propType], (*cardinalityAggregator).merge); err != nil {
+				return err
+			}
 		}
 	}
 
 	for propType, value := range second {
 		switch propType {
 		case "count":
+			count := dateCount(value)
 			if val, ok := first[propType]; ok {
-				first[propType] = val.(int64) + value.(int64)
-			} else {
-				first[propType] = value
+				count += dateCount(val)
 			}
+			first[propType] = count
 		case "mode":
 			dateAggCombined := first["_dateAggregator"].(*dateAggregator)
 			first[propType] = dateAggCombined.Mode()
@@ -192,17 +244,27 @@ func (sc *ShardCombiner) mergeDateProp(first, second map[string]interface{}) {
 					first["maximum"] = value
 				}
 			}
-		case "_dateAggregator":
+		case "_dateAggregator", "_histogramAggregator", "_cardinalityAggregator":
 			continue
 		default:
 			panic("unknown map entry: " + propType)
 		}
 	}
+	return nil
+}
+
+// dateCount returns the count of a date property, which is decoded as a
+// float64 from the json results of remote shards
+func dateCount(count interface{}) int64 {
+	if f, ok := count.(float64); ok {
+		return int64(f)
+	}
+	return count.(int64)
 }
 
-func (sc *ShardCombiner) mergeNumericalProp(first, second map[string]interface{}) {
+func (sc *ShardCombiner) mergeNumericalProp(first, second map[string]interface{}) error {
 	if len(second) == 0 {
-		return
+		return nil
 	}
 
 	// add all values from the second map to the first one. This is needed to compute median, mean and mode correctly
@@ -222,6 +284,18 @@ func (sc *ShardCombiner) mergeNumericalProp(first, second map[string]interface{}
 			} else {
 				first[propType] = second[propType]
 			}
+		case "_percentileAggregator":
+			if err := mergePartial(first, propType, second[propType], (*percentileAggregator).merge); err != nil {
+				return err
+			}
+		case "_histogramAggregator":
+			if err := mergePartial(first, propType, second[propType], (*histogramAggregator[float64]).merge); err != nil {
+				return err
+			}
+		case "_cardinalityAggregator":
+			if err := mergePartial(first, propType, second[propType], (*cardinalityAggregator).merge); err != nil {
+				return err
+			}
 		}
 	}
 
@@ -250,20 +324,105 @@ func (sc *ShardCombiner) mergeNumericalProp(first, second map[string]interface{}
 			if _, ok := first["maximum"]; !ok || value.(float64) > first["maximum"].(float64) {
 				first["maximum"] = value
 			}
-		case "_numericalAggregator":
+		case "_numericalAggregator", "_percentileAggregator", "_histogramAggregator",
+			"_cardinalityAggregator":
 			continue
 		default:
 			panic("unknown map entry: " + propType)
 		}
 	}
+	return nil
 }
 
-func (sc *ShardCombiner) finalizeDateProp(combined map[string]interface{}) {
+// mergePartial merges the partial result of a shard into the combined one
+func mergePartial[T any](combined map[string]interface{}, key string,
+	partial interface{}, merge func(combined, source *T),
+) error {
+	source, err := decodePartial[T](partial)
+	if err != nil {
+		return err
+	}
+	if existing, ok := combined[key]; ok {
+		target, err := decodePartial[T](existing)
+		if err != nil {
+			return err
+		}
+		merge(target, source)
+		combined[key] = target
+	} else {
+		combined[key] = source
+	}
+	return nil
+}
+
+// decodePartial returns the typed partial result of a shard. The results of
+// remote shards are decoded from json, so their partials arrive as plain maps.
+func decodePartial[T any](partial interface{}) (*T, error) {
+	if typed, ok := partial.(*T); ok {
+		return typed, nil
+	}
+
+	var out T
+	b, err := json.Marshal(partial)
+	if err == nil {
+		err = json.Unmarshal(b, &out)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("decode partial aggregation %T: %w", out, err)
+	}
+	return &out, nil
+}
+
+func (sc *ShardCombiner) finalizeDateProp(combined map[string]interface{}) error {
+	if partial, ok := combined["_histogramAggregator"]; ok {
+		histogram, err := decodePartial[histogramAggregator[int64]](partial)
+		if err != nil {
+			return err
+		}
+		combined[aggregation.HistogramType] = dateHistogramRes(histogram)
+	}
+	if partial, ok := combined["_cardinalityAggregator"]; ok {
+		cardinality, err := decodePartial[cardinalityAggregator](partial)
+		if err != nil {
+			return err
+		}
+		combined[aggregation.CardinalityType] = cardinality.Cardinality()
+	}
+
 	delete(combined, "_dateAggregator")
+	delete(combined, "_histogramAggregator")
+	delete(combined, "_cardinalityAggregator")
+	return nil
 }
 
-func (sc *ShardCombiner) finalizeNumerical(combined map[string]interface{}) {
+func (sc *ShardCombiner) finalizeNumerical(combined map[string]interface{}) error {
+	if partial, ok := combined["_percentileAggregator"]; ok {
+		percentile, err := decodePartial[percentileAggregator](partial)
+		if err != nil {
+			return err
+		}
+		combined[aggregation.PercentileType] = percentile.Res()
+	}
+	if partial, ok := combined["_histogramAggregator"]; ok {
+		histogram, err := decodePartial[histogramAggregator[float64]](partial)
+		if err != nil {
+			return err
+		}
+		combined[aggregation.HistogramType] = numericalHistogramRes(histogram)
+	}
+	if partial, ok := combined["_cardinalityAggregator"]; ok {
+		cardinality, err := decodePartial[cardinalityAggregator](partial)
+		if err != nil {
+			return err
+		}
+		combined[aggregation.CardinalityType] = cardinality.Cardinality()
+	}
+
 	delete(combined, "_numericalAggregator")
+	delete(combined, "_percentileAggregator")
+	delete(combined, "_histogramAggregator")
+	delete(combined, "_cardinalityAggregator")
+	return nil
 }
 
 func (sc *ShardCombiner) mergeBooleanProp(combined, source *aggregation.Boolean) {
@@ -280,6 +439,15 @@ func (sc *ShardCombiner) finalizeBoolean(combined *aggregation.Boolean) {
 func (sc *ShardCombiner) mergeTextProp(first, second *aggregation.Text) {
 	first.Count += second.Count
 
+	if len(second.CardinalitySketch) > 0 {
+		if len(first.CardinalitySketch) == 0 {
+			first.CardinalitySketch = append([]byte(nil), second.CardinalitySketch...)
+		} else {
+			combined := cardinalityAggregator{Registers: first.CardinalitySketch}
+			combined.merge(&cardinalityAggregator{Registers: second.CardinalitySketch})
+		}
+	}
+
 	for _, textOcc := range second.Items {
 		pos := getPosOfTextOcc(first.Items, textOcc.Value)
 		if pos < 0 {
@@ -295,6 +463,12 @@ func (sc *ShardCombiner) mergeRefProp(first, second *aggregation.Reference) {
 }
 
 func (sc *ShardCombiner) finalizeText(combined *aggregation.Text) {
+	if len(combined.CardinalitySketch) > 0 {
+		cardinality := (&cardinalityAggregator{Registers: combined.CardinalitySketch}).Cardinality()
+		combined.Cardinality = &cardinality
+		combined.CardinalitySketch = nil
+	}
+
 	sort.Slice(combined.Items, func(a, b int) bool {
 		return combined.Items[a].Occurs > combined.Items[b].Occurs
 	})
@@ -310,17 +484,21 @@ func getPosOfTextOcc(haystack []aggregation.TextOccurrence, needle string) int {
 	return -1
 }
 
-func (sc *ShardCombiner) finalizeGroup(group *aggregation.Group) {
+func (sc *ShardCombiner) finalizeGroup(group *aggregation.Group) error {
 	for propName, prop := range group.Properties {
 		switch prop.Type {
 		case aggregation.PropertyTypeNumerical:
-			sc.finalizeNumerical(prop.NumericalAggregations)
+			if err := sc.finalizeNumerical(prop.NumericalAggregations); err != nil {
+				return fmt.Errorf("property %q: %w", propName, err)
+			}
 		case aggregation.PropertyTypeBoolean:
 			sc.finalizeBoolean(&prop.BooleanAggregation)
 		case aggregation.PropertyTypeText:
 			sc.finalizeText(&prop.TextAggregation)
 		case aggregation.PropertyTypeDate:
-			sc.finalizeDateProp(prop.DateAggregations)
+			if err := sc.finalizeDateProp(prop.DateAggregations); err != nil {
+				return fmt.Errorf("property %q: %w", propName, err)
+			}
 		case aggregation.PropertyTypeReference:
 			continue
 		default:
@@ -328,6 +506,7 @@ func (sc *ShardCombiner) finalizeGroup(group *aggregation.Group) {
 		}
 		group.Properties[propName] = prop
 	}
+	return nil
 }
 
 func getPosOfGroup(haystack []aggregation.Group, needle interface{}) int {
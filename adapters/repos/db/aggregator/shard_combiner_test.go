@@ -12,10 +12,14 @@
 package aggregator
 
 import (
+	"encoding/json"
+	"math"
 	"math/rand"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/weaviate/weaviate/entities/aggregation"
 )
 
@@ -68,8 +72,8 @@ func testDates(t *testing.T, dates1, dates2 []string, tt TestStructDates) {
 	dateMap1 := createDateAgg(dates1)
 	dateMap2 := createDateAgg(dates2)
 
-	sc.mergeDateProp(dateMap1, dateMap2)
-	sc.finalizeDateProp(dateMap1)
+	require.Nil(t, sc.mergeDateProp(dateMap1, dateMap2))
+	require.Nil(t, sc.finalizeDateProp(dateMap1))
 	assert.Equal(t, YearMonthDayHourMinute+tt.expectedMinimum+NanoSecondsTimeZone, dateMap1["minimum"])
 	assert.Equal(t, YearMonthDayHourMinute+tt.expectedMaximum+NanoSecondsTimeZone, dateMap1["maximum"])
 	assert.Equal(t, YearMonthDayHourMinute+tt.expectedMedian+NanoSecondsTimeZone, dateMap1["median"])
@@ -202,7 +206,8 @@ func TestShardCombinerMergeNil(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			combinedResults := NewShardCombiner().Do(tt.results)
+			combinedResults, err := NewShardCombiner().Do(tt.results)
+			require.Nil(t, err)
 			assert.Equal(t, len(combinedResults.Groups), tt.totalResults)
 		})
 	}
@@ -215,8 +220,8 @@ func testNumbers(t *testing.T, numbers1, numbers2 []float64, testMode bool) {
 
 	combinedMap := createNumericalAgg(append(numbers1, numbers2...))
 
-	sc.mergeNumericalProp(numberMap1, numberMap2)
-	sc.finalizeNumerical(numberMap1)
+	require.Nil(t, sc.mergeNumericalProp(numberMap1, numberMap2))
+	require.Nil(t, sc.finalizeNumerical(numberMap1))
 
 	assert.Equal(t, len(numbers1)+len(numbers2), int(numberMap1["count"].(float64)))
 	assert.InDelta(t, combinedMap["mean"], numberMap1["mean"], 0.0001)
@@ -247,3 +252,196 @@ func createRandomSlice() []float64 {
 	}
 	return array
 }
+
+func TestShardCombinerDistributions(t *testing.T) {
+	percentile, err := aggregation.NewPercentileAggregator([]float64{0.5, 1})
+	require.Nil(t, err)
+	numberHistogram, err := aggregation.NewHistogramAggregator(10, nil)
+	require.Nil(t, err)
+	dateHistogram, err := aggregation.NewHistogramAggregator(0, []float64{0, 3600, 7200})
+	require.Nil(t, err)
+
+	shardResult := func(groupedBy *aggregation.GroupedBy, numbers []float64, dates []int64, texts []string) *aggregation.Result {
+		numAgg := newNumericalAggregator()
+		for _, number := range numbers {
+			numAgg.AddFloat64(number)
+		}
+		dateAgg := newDateAggregator()
+		for _, date := range dates {
+			dateAgg.addRow(newTimestamp(date*int64(time.Second)), 1)
+		}
+		textAgg := newTextAggregator(5)
+		for _, text := range texts {
+			textAgg.AddText(text)
+		}
+
+		numberProp := aggregation.Property{Type: aggregation.PropertyTypeNumerical}
+		addNumericalAggregations(&numberProp, []aggregation.Aggregator{
+			aggregation.CountAggregator, percentile, numberHistogram, aggregation.CardinalityAggregator,
+		}, numAgg)
+		dateProp := aggregation.Property{Type: aggregation.PropertyTypeDate}
+		addDateAggregations(&dateProp, []aggregation.Aggregator{
+			aggregation.CountAggregator, dateHistogram, aggregation.CardinalityAggregator,
+		}, dateAgg)
+		textProp := aggregation.Property{Type: aggregation.PropertyTypeText}
+		addTextAggregations(&textProp, []aggregation.Aggregator{aggregation.CardinalityAggregator}, textAgg)
+
+		return &aggregation.Result{Groups: []aggregation.Group{{
+			Count:     len(numbers),
+			GroupedBy: groupedBy,
+			Properties: map[string]aggregation.Property{
+				"number": numberProp, "date": dateProp, "text": textProp,
+			},
+		}}}
+	}
+
+	// results of remote shards are sent as json
+	remote := func(res *aggregation.Result) *aggregation.Result {
+		b, err := json.Marshal(res)
+		require.Nil(t, err)
+		var out aggregation.Result
+		require.Nil(t, json.Unmarshal(b, &out))
+		return &out
+	}
+
+	assertCombined := func(t *testing.T, group aggregation.Group) {
+		number := group.Properties["number"].NumericalAggregations
+		assert.Equal(t, []aggregation.Percentile{
+			{Quantile: 0.5, Value: 12},
+			{Quantile: 1, Value: 35},
+		}, roundPercentiles(number["percentile"].([]aggregation.Percentile)))
+		assert.Equal(t, []aggregation.HistogramBucket{
+			{From: 0, To: 10, Count: 2},
+			{From: 10, To: 20, Count: 3},
+			{From: 30, To: 40, Count: 1},
+		}, number["histogram"])
+		assert.Equal(t, int64(4), number["cardinality"])
+		assert.NotContains(t, number, "_percentileAggregator")
+		assert.NotContains(t, number, "_histogramAggregator")
+		assert.NotContains(t, number, "_cardinalityAggregator")
+
+		date := group.Properties["date"].DateAggregations
+		assert.Equal(t, []aggregation.DateHistogramBucket{
+			{From: "1970-01-01T00:00:00Z", To: "1970-01-01T01:00:00Z", Count: 3},
+			{From: "1970-01-01T01:00:00Z", To: "1970-01-01T02:00:00Z", Count: 1},
+		}, date["histogram"])
+		assert.Equal(t, int64(5), date["cardinality"])
+		assert.NotContains(t, date, "_histogramAggregator")
+
+		text := group.Properties["text"].TextAggregation
+		require.NotNil(t, text.Cardinality)
+		assert.Equal(t, int64(3), *text.Cardinality)
+		assert.Nil(t, text.CardinalitySketch)
+	}
+
+	t.Run("ungrouped", func(t *testing.T) {
+		res, err := NewShardCombiner().Do([]*aggregation.Result{
+			shardResult(nil, []float64{1, 5, 12}, []int64{10, 20}, []string{"a", "b"}),
+			remote(shardResult(nil, []float64{12, 12, 35}, []int64{30, 4000, 8000}, []string{"b", "c"})),
+		})
+		require.Nil(t, err)
+		require.Len(t, res.Groups, 1)
+		assertCombined(t, res.Groups[0])
+	})
+
+	t.Run("grouped by a single remote shard", func(t *testing.T) {
+		res, err := NewShardCombiner().Do([]*aggregation.Result{
+			remote(shardResult(&aggregation.GroupedBy{Value: "group", Path: []string{"prop"}},
+				[]float64{1, 5, 12, 12, 12, 35}, []int64{10, 20, 30, 4000, 8000}, []string{"a", "b", "c"})),
+		})
+		require.Nil(t, err)
+		require.Len(t, res.Groups, 1)
+		assertCombined(t, res.Groups[0])
+	})
+
+	t.Run("malformed remote partials fail the aggregation", func(t *testing.T) {
+		for _, tc := range []struct {
+			prop, partial string
+			grouped       bool
+		}{
+			{prop: "number", partial: "_percentileAggregator"},
+			{prop: "number", partial: "_histogramAggregator", grouped: true},
+			{prop: "number", partial: "_cardinalityAggregator"},
+			{prop: "date", partial: "_histogramAggregator"},
+			{prop: "date", partial: "_cardinalityAggregator", grouped: true},
+		} {
+			t.Run(tc.prop+tc.partial, func(t *testing.T) {
+				var groupedBy *aggregation.GroupedBy
+				if tc.grouped {
+					groupedBy = &aggregation.GroupedBy{Value: "group", Path: []string{"prop"}}
+				}
+				local := shardResult(groupedBy, []float64{1, 5, 12}, []int64{10, 20}, []string{"a"})
+				malformed := remote(shardResult(groupedBy, []float64{12, 35}, []int64{30}, []string{"b"}))
+				aggregations := malformed.Groups[0].Properties[tc.prop].NumericalAggregations
+				if tc.prop == "date" {
+					aggregations = malformed.Groups[0].Properties[tc.prop].DateAggregations
+				}
+				aggregations[tc.partial] = "not a partial aggregation"
+
+				_, err := NewShardCombiner().Do([]*aggregation.Result{local, malformed})
+				require.NotNil(t, err)
+				assert.ErrorContains(t, err, "decode partial aggregation")
+				assert.ErrorContains(t, err, tc.prop)
+
+				// a single malformed shard fails when it is finalized
+				_, err = NewShardCombiner().Do([]*aggregation.Result{malformed})
+				assert.ErrorContains(t, err, "decode partial aggregation")
+			})
+		}
+	})
+}
+
+func roundPercentiles(percentiles []aggregation.Percentile) []aggregation.Percentile {
+	for i := range percentiles {
+		percentiles[i].Value = math.Round(percentiles[i].Value)
+	}
+	return percentiles
+}
+
+func TestShardCombinerNestedGroups(t *testing.T) {
+	group := func(prop string, value interface{}, count int, groups ...aggregation.Group) aggregation.Group {
+		return aggregation.Group{
+			GroupedBy: &aggregation.GroupedBy{Path: []string{prop}, Value: value},
+			Count:     count,
+			Groups:    groups,
+		}
+	}
+
+	shard1 := &aggregation.Result{Groups: []aggregation.Group{
+		group("country", "NL", 3,
+			group("category", "books", 3),
+		),
+		group("country", "DE", 1,
+			group("category", "books", 1),
+		),
+	}}
+	shard2 := &aggregation.Result{Groups: []aggregation.Group{
+		group("country", "DE", 4,
+			group("category", "music", 3),
+			group("category", "books", 1),
+		),
+		group("country", "NL", 1,
+			group("category", "music", 1),
+		),
+		group("country", "FR", 1,
+			group("category", "film", 1),
+		),
+	}}
+
+	res, err := NewShardCombiner().Do([]*aggregation.Result{shard1, shard2})
+	require.Nil(t, err)
+
+	assert.Equal(t, []aggregation.Group{
+		group("country", "DE", 5,
+			group("category", "music", 3),
+			group("category", "books", 2),
+		),
+		group("country", "NL", 4,
+			group("category", "books", 3),
+			group("category", "music", 1),
+		),
+		group("country", "FR", 1,
+			group("category", "film", 1),
+		),
+	}, res.Groups)
+}
@@ -31,6 +31,25 @@ func extractLimitFromTopOccs(aggs []aggregation.Aggregator) int {
 	return 5
 }
 
+func addTextAggregations(prop *aggregation.Property,
+	aggs []aggregation.Aggregator, agg *textAggregator,
+) {
+	prop.TextAggregation = agg.Res()
+
+	for _, aProp := range aggs {
+		if aProp.Type != aggregation.CardinalityType || agg.count == 0 {
+			continue
+		}
+		// like the other distributions, the cardinality is only estimated once
+		// the results of all shards are combined
+		cardinality := newCardinalityAggregator()
+		for value := range agg.itemCounter {
+			cardinality.AddText(value)
+		}
+		prop.TextAggregation.CardinalitySketch = cardinality.Registers
+	}
+}
+
 func newTextAggregator(limit int) *textAggregator {
 	return &textAggregator{itemCounter: map[string]int{}, max: limit}
 }
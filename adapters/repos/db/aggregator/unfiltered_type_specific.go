@@ -468,7 +468,7 @@ func (ua unfilteredAggregator) textProperty(ctx context.Context,
 		}
 	}
 
-	out.TextAggregation = agg.Res()
+	addTextAggregations(&out, prop.Aggregators, agg)
 
 	return &out, nil
 }
@@ -17,6 +17,7 @@ import (
 
 	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/storobj"
 )
@@ -58,6 +59,17 @@ func (a *Aggregator) searchByVectorDistance(ctx context.Context, searchVector []
 	}
 
 	targetDist := float32(1-a.params.Certainty) * 2
+	if rs, ok := a.vectorIndex.(rangeVectorIndex); ok && !rs.Multivector() {
+		// all matches are aggregated, range search finds them in a single pass
+		idsFound, dists, _, err := common.RangeSearch(func(fn func(id uint64, dist float32) bool) error {
+			return rs.SearchByVectorRange(ctx, searchVector, targetDist, ids, fn)
+		}, 0, nil)
+		if err != nil {
+			return nil, nil, fmt.Errorf("aggregate range search by vector: %w", err)
+		}
+		return idsFound, dists, nil
+	}
+
 	idsFound, dists, err := a.vectorIndex.SearchByVectorDistance(ctx, searchVector, targetDist, -1, ids)
 	if err != nil {
 		return nil, nil, fmt.Errorf("aggregate search by vector: %w", err)
@@ -90,7 +102,7 @@ func (a *Aggregator) buildAllowList(ctx context.Context) (helpers.AllowList, err
 
 	if a.params.Filters != nil {
 		allow, err = inverted.NewSearcher(a.logger, a.store, a.getSchema.ReadOnlyClass, nil,
-			a.classSearcher, a.stopwords, a.shardVersion, a.isFallbackToSearchable,
+			a.classSearcher, a.stopwords, a.textAnalyzers, a.shardVersion, a.isFallbackToSearchable,
 			a.tenant, a.nestedCrossRefLimit, a.bitmapFactory).
 			DocIDs(ctx, a.params.Filters, additional.Properties{},
 				a.params.ClassName)
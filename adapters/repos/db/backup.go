@@ -22,6 +22,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/entities/backup"
+	"github.com/weaviate/weaviate/entities/errorcompounder"
 	"github.com/weaviate/weaviate/entities/schema"
 )
 
@@ -115,11 +116,12 @@ func (db *DB) ShardsBackup(
 	idx.shardTransferMutex.Lock()
 	defer idx.shardTransferMutex.Unlock()
 	for shardName, shard := range sm {
+		haltedAt := time.Now()
 		if err := shard.HaltForTransfer(ctx); err != nil {
 			return cd, fmt.Errorf("class %q: shard %q: begin backup: %w", class, shardName, err)
 		}
 
-		sd := backup.ShardDescriptor{Name: shardName}
+		sd := backup.ShardDescriptor{Name: shardName, HaltedAt: haltedAt}
 		if err := shard.ListBackupFiles(ctx, &sd); err != nil {
 			return cd, fmt.Errorf("class %q: shard %q: list backup files: %w", class, shardName, err)
 		}
@@ -203,6 +205,37 @@ func (db *DB) ListClasses(ctx context.Context) []string {
 	return classNames
 }
 
+// CheckpointLogs hands over the commit logs of the classes which are still
+// being written to the log archive, so that all entries written until now
+// are archived
+func (db *DB) CheckpointLogs(ctx context.Context, classes []string) error {
+	ec := errorcompounder.New()
+	for _, class := range classes {
+		db.indexLock.RLock()
+		idx := db.indices[indexID(schema.ClassName(class))]
+		db.indexLock.RUnlock()
+		if idx != nil {
+			ec.AddWrap(idx.checkpointLogs(ctx), class)
+		}
+	}
+	return ec.ToError()
+}
+
+// checkpointLogs hands over the commit logs of all loaded shards. Shards
+// which are not loaded have no logs being written.
+func (i *Index) checkpointLogs(ctx context.Context) error {
+	// don't interfere with the halting of shards for a backup
+	i.shardTransferMutex.Lock()
+	defer i.shardTransferMutex.Unlock()
+
+	return i.ForEachLoadedShard(func(name string, s ShardLike) error {
+		if err := s.checkpointLogs(ctx); err != nil {
+			return fmt.Errorf("shard %s: %w", name, err)
+		}
+		return nil
+	})
+}
+
 // descriptor record everything needed to restore a class
 func (i *Index) descriptor(ctx context.Context, backupID string, desc *backup.ClassDescriptor) (err error) {
 	if err := i.initBackup(backupID); err != nil {
@@ -218,10 +251,11 @@ func (i *Index) descriptor(ctx context.Context, backupID string, desc *backup.Cl
 	defer i.shardTransferMutex.Unlock()
 
 	if err = i.ForEachShard(func(name string, s ShardLike) error {
+		haltedAt := time.Now()
 		if err = s.HaltForTransfer(ctx); err != nil {
 			return fmt.Errorf("pause compaction and flush: %w", err)
 		}
-		var sd backup.ShardDescriptor
+		sd := backup.ShardDescriptor{HaltedAt: haltedAt}
 		if err := s.ListBackupFiles(ctx, &sd); err != nil {
 			return fmt.Errorf("list shard %v files: %w", s.Name(), err)
 		}
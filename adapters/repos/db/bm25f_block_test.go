@@ -850,3 +850,24 @@ func TestBM25F_SortMultiPropBlock(t *testing.T) {
 
 	}
 }
+
+func TestBM25FWithTextAnalyzerBlock(t *testing.T) {
+	t.Setenv("USE_INVERTED_SEARCHABLE", "true")
+	t.Setenv("USE_BLOCKMAX_WAND", "true")
+	t.Setenv("COMPUTE_PROPLENGTH_WITH_DUPS", "true")
+	testBM25FWithTextAnalyzer(t)
+}
+
+func TestBM25FWithSynonymsBlock(t *testing.T) {
+	t.Setenv("USE_INVERTED_SEARCHABLE", "true")
+	t.Setenv("USE_BLOCKMAX_WAND", "true")
+	t.Setenv("COMPUTE_PROPLENGTH_WITH_DUPS", "true")
+	testBM25FWithSynonyms(t)
+}
+
+func TestBM25FWithPhrasesBlock(t *testing.T) {
+	t.Setenv("USE_INVERTED_SEARCHABLE", "true")
+	t.Setenv("USE_BLOCKMAX_WAND", "true")
+	t.Setenv("COMPUTE_PROPLENGTH_WITH_DUPS", "true")
+	testBM25FWithPhrases(t)
+}
@@ -16,8 +16,10 @@ package db
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/google/uuid"
@@ -30,6 +32,7 @@ import (
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/searchparams"
+	"github.com/weaviate/weaviate/entities/storobj"
 	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 	"github.com/weaviate/weaviate/usecases/memwatch"
 )
@@ -1090,3 +1093,346 @@ func TestBM25F_SortMultiProp(t *testing.T) {
 		require.True(t, strings.Contains(explanationString, "BM25F_banana_propLength:1"))
 	})
 }
+
+func TestBM25FWithTextAnalyzer(t *testing.T) {
+	testBM25FWithTextAnalyzer(t)
+}
+
+func testBM25FWithTextAnalyzer(t *testing.T) {
+	dirName := t.TempDir()
+
+	logger := logrus.New()
+	schemaGetter := &fakeSchemaGetter{
+		schema:     schema.Schema{Objects: &models.Schema{Classes: nil}},
+		shardState: singleShardState(),
+	}
+	repo, err := New(logger, Config{
+		MemtablesFlushDirtyAfter:  60,
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, nil, nil, memwatch.NewDummyMonitor())
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(context.TODO()))
+	defer repo.Shutdown(context.Background())
+
+	vFalse := false
+	vTrue := true
+	invertedConfig := BM25FinvertedConfig(1.2, 0.75, "en")
+	invertedConfig.Analyzers = []*models.TextAnalyzerConfig{{
+		Name:      "english",
+		Tokenizer: models.PropertyTokenizationWord,
+		TokenFilters: []*models.TextAnalyzerTokenFilter{
+			{Type: models.TextAnalyzerTokenFilterTypeAsciifold},
+			{Type: models.TextAnalyzerTokenFilterTypeStop},
+			{Type: models.TextAnalyzerTokenFilterTypeStemmer},
+			{Type: models.TextAnalyzerTokenFilterTypeSynonym, Synonyms: []string{"laptop, notebook"}},
+		},
+	}}
+	class := &models.Class{
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig,
+		Class:               "Analyzed",
+		Properties: []*models.Property{
+			{
+				Name:            "analyzed",
+				DataType:        schema.DataTypeText.PropString(),
+				Tokenization:    models.PropertyTokenizationWord,
+				Analyzer:        "english",
+				IndexFilterable: &vTrue,
+				IndexSearchable: &vTrue,
+			},
+			{
+				Name:            "plain",
+				DataType:        schema.DataTypeText.PropString(),
+				Tokenization:    models.PropertyTokenizationWord,
+				IndexFilterable: &vFalse,
+				IndexSearchable: &vTrue,
+			},
+		},
+	}
+	schemaGetter.schema = schema.Schema{Objects: &models.Schema{Classes: []*models.Class{class}}}
+	migrator := NewMigrator(repo, logger)
+	require.Nil(t, migrator.AddClass(context.Background(), class, schemaGetter.shardState))
+
+	testData := []map[string]interface{}{
+		{"analyzed": "The runners are running", "plain": "The runners are running"},
+		{"analyzed": "A cheap laptop", "plain": "A cheap laptop"},
+		{"analyzed": "Café culture", "plain": "Café culture"},
+	}
+	for i, data := range testData {
+		id := strfmt.UUID(uuid.MustParse(fmt.Sprintf("%032d", i)).String())
+		obj := &models.Object{Class: class.Class, ID: id, Properties: data}
+		require.Nil(t, repo.PutObject(context.Background(), obj, []float32{1, 2, 3}, nil, nil, 0))
+	}
+
+	idx := repo.GetIndex(schema.ClassName(class.Class))
+	require.NotNil(t, idx)
+	props := []string{"analyzed", "plain"}
+
+	docIDs := func(res []*storobj.Object) []uint64 {
+		ids := make([]uint64, len(res))
+		for i, r := range res {
+			ids[i] = r.DocID
+		}
+		return ids
+	}
+
+	for _, query := range []struct {
+		query      string
+		properties []string
+		expected   []uint64
+	}{
+		{query: "run", properties: []string{"analyzed"}, expected: []uint64{0}},
+		{query: "run", properties: []string{"plain"}, expected: []uint64{}},
+		{query: "notebooks", properties: []string{"analyzed"}, expected: []uint64{1}},
+		{query: "cafe", properties: []string{"analyzed", "plain"}, expected: []uint64{2}},
+	} {
+		t.Run(fmt.Sprintf("bm25 %q on %v", query.query, query.properties), func(t *testing.T) {
+			kwr := &searchparams.KeywordRanking{Type: "bm25", Properties: query.properties, Query: query.query}
+			res, _, err := idx.objectSearch(context.TODO(), 10, nil, kwr, nil, nil, additional.Properties{}, nil, "", 0, props)
+			require.Nil(t, err)
+			assert.ElementsMatch(t, query.expected, docIDs(res))
+		})
+	}
+
+	t.Run("filter is analyzed", func(t *testing.T) {
+		filter := &filters.LocalFilter{Root: &filters.Clause{
+			Operator: filters.OperatorEqual,
+			On:       &filters.Path{Class: schema.ClassName(class.Class), Property: "analyzed"},
+			Value:    &filters.Value{Value: "Running", Type: schema.DataTypeText},
+		}}
+		res, _, err := idx.objectSearch(context.TODO(), 10, filter, nil, nil, nil, additional.Properties{}, nil, "", 0, props)
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{0}, docIDs(res))
+	})
+
+	t.Run("analyzers are replaced with the config", func(t *testing.T) {
+		updated := *invertedConfig
+		updated.Analyzers = append(updated.Analyzers, &models.TextAnalyzerConfig{
+			Name:      "folded",
+			Tokenizer: models.PropertyTokenizationWhitespace,
+		})
+		require.Nil(t, migrator.UpdateInvertedIndexConfig(context.Background(), class.Class, &updated))
+
+		analyzer, err := idx.getTextAnalyzers().ForProperty(&models.Property{Name: "other", Analyzer: "folded"})
+		require.Nil(t, err)
+		assert.Equal(t, []string{"Café", "culture"}, analyzer.Analyze("Café culture"))
+	})
+}
+
+func TestBM25FWithSynonyms(t *testing.T) {
+	testBM25FWithSynonyms(t)
+}
+
+func testBM25FWithSynonyms(t *testing.T) {
+	dirName := t.TempDir()
+
+	logger := logrus.New()
+	schemaGetter := &fakeSchemaGetter{
+		schema:     schema.Schema{Objects: &models.Schema{Classes: nil}},
+		shardState: singleShardState(),
+	}
+	repo, err := New(logger, Config{
+		MemtablesFlushDirtyAfter:  60,
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, nil, nil, memwatch.NewDummyMonitor())
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(context.TODO()))
+	defer repo.Shutdown(context.Background())
+
+	vFalse := false
+	vTrue := true
+	invertedConfig := BM25FinvertedConfig(1.2, 0.75, "en")
+	invertedConfig.Synonyms = &models.SynonymConfig{Groups: []string{"laptop, notebook"}, Boost: 0.5}
+	class := &models.Class{
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig,
+		Class:               "Synonyms",
+		Properties: []*models.Property{{
+			Name:            "description",
+			DataType:        schema.DataTypeText.PropString(),
+			Tokenization:    models.PropertyTokenizationWord,
+			IndexFilterable: &vFalse,
+			IndexSearchable: &vTrue,
+		}},
+	}
+	schemaGetter.schema = schema.Schema{Objects: &models.Schema{Classes: []*models.Class{class}}}
+	migrator := NewMigrator(repo, logger)
+	require.Nil(t, migrator.AddClass(context.Background(), class, schemaGetter.shardState))
+
+	testData := []string{"a cheap laptop", "a cheap notebook", "a cheap phone"}
+	for i, description := range testData {
+		id := strfmt.UUID(uuid.MustParse(fmt.Sprintf("%032d", i)).String())
+		obj := &models.Object{Class: class.Class, ID: id, Properties: map[string]interface{}{"description": description}}
+		require.Nil(t, repo.PutObject(context.Background(), obj, []float32{1, 2, 3}, nil, nil, 0))
+	}
+
+	idx := repo.GetIndex(schema.ClassName(class.Class))
+	require.NotNil(t, idx)
+
+	search := func(t *testing.T, query string) ([]uint64, []float32) {
+		kwr := &searchparams.KeywordRanking{Type: "bm25", Properties: []string{"description"}, Query: query}
+		res, scores, err := idx.objectSearch(context.TODO(), 10, nil, kwr, nil, nil, additional.Properties{}, nil, "", 0, []string{"description"})
+		require.Nil(t, err)
+		ids := make([]uint64, len(res))
+		for i, r := range res {
+			ids[i] = r.DocID
+		}
+		return ids, scores
+	}
+
+	for _, location := range []string{"memory", "disk"} {
+		t.Run("expanded terms are boosted down "+location, func(t *testing.T) {
+			ids, scores := search(t, "laptop")
+			require.Equal(t, []uint64{0, 1}, ids)
+			EqualFloats(t, scores[0]*0.5, scores[1], 5)
+
+			ids, _ = search(t, "notebook")
+			require.Equal(t, []uint64{1, 0}, ids)
+		})
+
+		t.Run("unrelated terms are not expanded "+location, func(t *testing.T) {
+			ids, _ := search(t, "phone")
+			assert.Equal(t, []uint64{2}, ids)
+		})
+
+		require.Nil(t, idx.ForEachShard(func(name string, shard ShardLike) error {
+			return shard.Store().FlushMemtables(context.Background())
+		}))
+	}
+}
+
+func TestBM25FWithPhrases(t *testing.T) {
+	testBM25FWithPhrases(t)
+}
+
+func testBM25FWithPhrases(t *testing.T) {
+	dirName := t.TempDir()
+
+	logger := logrus.New()
+	schemaGetter := &fakeSchemaGetter{
+		schema:     schema.Schema{Objects: &models.Schema{Classes: nil}},
+		shardState: singleShardState(),
+	}
+	repo, err := New(logger, Config{
+		MemtablesFlushDirtyAfter:  60,
+		RootPath:                  dirName,
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, nil, nil, memwatch.NewDummyMonitor())
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(context.TODO()))
+	defer repo.Shutdown(context.Background())
+
+	invertedConfig := BM25FinvertedConfig(1.2, 0.75, "none")
+	invertedConfig.IndexPositions = []string{"title", "tags"}
+	class := &models.Class{
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig,
+		Class:               "Phrases",
+		Properties: []*models.Property{
+			{Name: "title", DataType: schema.DataTypeText.PropString(), Tokenization: models.PropertyTokenizationWord},
+			{Name: "body", DataType: schema.DataTypeText.PropString(), Tokenization: models.PropertyTokenizationWord},
+			{Name: "tags", DataType: schema.DataTypeTextArray.PropString(), Tokenization: models.PropertyTokenizationWord},
+		},
+	}
+	schemaGetter.schema = schema.Schema{Objects: &models.Schema{Classes: []*models.Class{class}}}
+	migrator := NewMigrator(repo, logger)
+	require.Nil(t, migrator.AddClass(context.Background(), class, schemaGetter.shardState))
+
+	ids := make([]strfmt.UUID, 5)
+	put := func(t *testing.T, i int, title string, tags ...string) {
+		props := map[string]interface{}{"title": title, "body": title}
+		if len(tags) > 0 {
+			props["tags"] = tags
+		}
+		obj := &models.Object{Class: class.Class, ID: ids[i], Properties: props}
+		require.Nil(t, repo.PutObject(context.Background(), obj, []float32{1, 2, 3}, nil, nil, 0))
+	}
+	for i := range ids {
+		ids[i] = strfmt.UUID(uuid.MustParse(fmt.Sprintf("%032d", i)).String())
+	}
+	put(t, 0, "the quick brown fox")
+	put(t, 1, "brown quick fox")
+	put(t, 2, "the quick red brown fox")
+	put(t, 3, "quick fox and brown dog")
+	put(t, 4, "cat", "quick", "brown")
+
+	idx := repo.GetIndex(schema.ClassName(class.Class))
+	require.NotNil(t, idx)
+
+	search := func(t *testing.T, query string, slop int, props ...string) ([]int, error) {
+		kwr := &searchparams.KeywordRanking{Type: "bm25", Properties: props, Query: query, Slop: slop}
+		res, _, err := idx.objectSearch(context.TODO(), 10, nil, kwr, nil, nil, additional.Properties{}, nil, "", 0, props)
+		if err != nil {
+			return nil, err
+		}
+		found := make([]int, len(res))
+		for i, r := range res {
+			found[i] = slices.Index(ids, r.ID())
+		}
+		return found, nil
+	}
+
+	for _, location := range []string{"memory", "disk"} {
+		t.Run("exact phrase "+location, func(t *testing.T) {
+			found, err := search(t, `"quick brown"`, 0, "title")
+			require.Nil(t, err)
+			assert.Equal(t, []int{0}, found)
+		})
+
+		t.Run("phrase with slop "+location, func(t *testing.T) {
+			found, err := search(t, `"quick brown"`, 1, "title")
+			require.Nil(t, err)
+			assert.Equal(t, []int{0, 2}, found)
+
+			found, err = search(t, `"quick brown"`, 2, "title")
+			require.Nil(t, err)
+			assert.ElementsMatch(t, []int{0, 2, 3}, found)
+		})
+
+		t.Run("phrase combined with terms "+location, func(t *testing.T) {
+			found, err := search(t, `"quick brown" dog`, 0, "title")
+			require.Nil(t, err)
+			assert.ElementsMatch(t, []int{0, 3}, found)
+		})
+
+		t.Run("phrases do not match across array elements "+location, func(t *testing.T) {
+			found, err := search(t, `"quick brown"`, 10, "tags")
+			require.Nil(t, err)
+			assert.Empty(t, found)
+		})
+
+		t.Run("phrases require positions "+location, func(t *testing.T) {
+			_, err := search(t, `"quick brown"`, 0, "title", "body")
+			require.ErrorContains(t, err, "phrase queries require property 'body' to be listed in invertedIndexConfig.indexPositions")
+
+			found, err := search(t, `"dog"`, 0, "body")
+			require.Nil(t, err)
+			assert.Equal(t, []int{3}, found)
+		})
+
+		require.Nil(t, idx.ForEachShard(func(name string, shard ShardLike) error {
+			return shard.Store().FlushMemtables(context.Background())
+		}))
+	}
+
+	t.Run("positions follow updates and deletes", func(t *testing.T) {
+		put(t, 1, "the quick brown cat")
+		found, err := search(t, `"quick brown"`, 0, "title")
+		require.Nil(t, err)
+		assert.ElementsMatch(t, []int{0, 1}, found)
+
+		put(t, 0, "brown fox")
+		require.Nil(t, repo.DeleteObject(context.Background(), class.Class, ids[1], time.Now(), nil, "", 0))
+		found, err = search(t, `"quick brown"`, 0, "title")
+		require.Nil(t, err)
+		assert.Empty(t, found)
+	})
+}
@@ -105,7 +105,7 @@ func (n *node) init(dirName string, shardStateRaw []byte,
 
 	backupClient := clients.NewClusterBackups(&http.Client{})
 	n.scheduler = ubak.NewScheduler(
-		&fakeAuthorizer{}, backupClient, n.repo, backendProvider, nodeResolver, n.schemaManager, logger)
+		&fakeAuthorizer{}, backupClient, n.repo, backendProvider, nodeResolver, n.schemaManager, nil, logger)
 
 	n.migrator = db.NewMigrator(n.repo, logger)
 
@@ -315,6 +315,10 @@ func (f *fakeBackupBackend) AllBackups(context.Context) ([]*backup.DistributedBa
 	return nil, fmt.Errorf("not implemented")
 }
 
+func (f *fakeBackupBackend) Delete(ctx context.Context, backupID, overrideBucket, overridePath string) error {
+	return nil
+}
+
 func (f *fakeBackupBackend) GetObject(ctx context.Context, backupID, key, overrideBucket, overridePath string) ([]byte, error) {
 	f.Lock()
 	defer f.Unlock()
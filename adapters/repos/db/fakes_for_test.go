@@ -26,6 +26,7 @@ import (
 	"github.com/go-openapi/strfmt"
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/aggregation"
+	"github.com/weaviate/weaviate/entities/facets"
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
@@ -212,17 +213,30 @@ func (f *fakeRemoteClient) SearchShard(ctx context.Context, hostName, indexName,
 	shardName string, vector [][]float32, targetVector []string, limit int,
 	filters *filters.LocalFilter, _ *searchparams.KeywordRanking, sort []filters.Sort,
 	cursor *filters.Cursor, groupBy *searchparams.GroupBy, additional additional.Properties, targetCombination *dto.TargetCombination,
-	properties []string,
+	properties []string, matryoshkaDims int,
 ) ([]*storobj.Object, []float32, error) {
 	return nil, nil, nil
 }
 
+func (f *fakeRemoteClient) SearchShardBatch(ctx context.Context, hostName, indexName,
+	shardName string, searchVectors [][]float32, targetVector string, distance float32,
+	limit int, filters *filters.LocalFilter, additional additional.Properties, properties []string,
+) ([][]*storobj.Object, [][]float32, error) {
+	return nil, nil, nil
+}
+
 func (f *fakeRemoteClient) Aggregate(ctx context.Context, hostName, indexName,
 	shardName string, params aggregation.Params,
 ) (*aggregation.Result, error) {
 	return nil, nil
 }
 
+func (f *fakeRemoteClient) Facets(ctx context.Context, hostName, indexName,
+	shardName string, params facets.Params,
+) ([]facets.Result, error) {
+	return nil, nil
+}
+
 func (f *fakeRemoteClient) BatchAddReferences(ctx context.Context, hostName,
 	indexName, shardName string, refs objects.BatchReferences, schemaVersion uint64,
 ) []error {
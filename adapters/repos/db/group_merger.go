@@ -18,6 +18,7 @@ import (
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/searchparams"
 	"github.com/weaviate/weaviate/entities/storobj"
+	traversergrouper "github.com/weaviate/weaviate/usecases/traverser/grouper"
 )
 
 type groupMerger struct {
@@ -75,15 +76,8 @@ func (gm *groupMerger) Do() ([]*storobj.Object, []float32, error) {
 		return groupDistances[i].distance < groupDistances[j].distance
 	})
 
-	desiredLength := len(groups)
-	if desiredLength > gm.groupBy.Groups {
-		desiredLength = gm.groupBy.Groups
-	}
-
-	objs := make([]*storobj.Object, desiredLength)
-	dists := make([]float32, desiredLength)
-	for i, groupDistance := range groupDistances[:desiredLength] {
-		val := groupDistance.value
+	merged := make([]*additional.Group, len(groupDistances))
+	for i, groupDistance := range groupDistances {
 		group := groups[groupDistance.value]
 		count := 0
 		hits := []map[string]interface{}{}
@@ -97,24 +91,31 @@ func (gm *groupMerger) Do() ([]*storobj.Object, []float32, error) {
 				hits[j]["_additional"].(*additional.GroupHitAdditional).Distance
 		})
 
-		if len(hits) > gm.groupBy.ObjectsPerGroup {
-			hits = hits[:gm.groupBy.ObjectsPerGroup]
-			count = len(hits)
-		}
-
-		indx := objects[val][0]
-		obj, dist := gm.objects[indx], gm.dists[indx]
-		obj.AdditionalProperties()["group"] = &additional.Group{
-			ID: i,
+		merged[i] = &additional.Group{
 			GroupedBy: &additional.GroupedBy{
-				Value: val,
+				Value: groupDistance.value,
 				Path:  []string{gm.groupBy.Property},
 			},
-			Count:       count,
-			Hits:        hits,
-			MaxDistance: hits[0]["_additional"].(*additional.GroupHitAdditional).Distance,
-			MinDistance: hits[len(hits)-1]["_additional"].(*additional.GroupHitAdditional).Distance,
+			Count: count,
+			Hits:  hits,
 		}
+	}
+
+	merged, err := traversergrouper.ArrangeGroups(merged, gm.groupBy)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	objs := make([]*storobj.Object, len(merged))
+	dists := make([]float32, len(merged))
+	for i, group := range merged {
+		hits := group.Hits
+		group.MaxDistance = hits[0]["_additional"].(*additional.GroupHitAdditional).Distance
+		group.MinDistance = hits[len(hits)-1]["_additional"].(*additional.GroupHitAdditional).Distance
+
+		indx := objects[group.GroupedBy.Value][0]
+		obj, dist := gm.objects[indx], gm.dists[indx]
+		obj.AdditionalProperties()["group"] = group
 		objs[i], dists[i] = obj, dist
 	}
 
@@ -51,6 +51,15 @@ func NewAllowListFromBitmapDeepCopy(bm *sroar.Bitmap) AllowList {
 	return NewAllowListFromBitmap(bm.Clone())
 }
 
+// AllowListBitmap returns the ids of the allow list as a bitmap. The bitmap
+// may be shared with the allow list, so it must not be modified.
+func AllowListBitmap(al AllowList) *sroar.Bitmap {
+	if bal, ok := al.(*bitmapAllowList); ok {
+		return bal.bm
+	}
+	return roaringset.NewBitmap(al.Slice()...)
+}
+
 type bitmapAllowList struct {
 	bm *sroar.Bitmap
 }
@@ -22,6 +22,9 @@ var (
 	ObjectsBucketLSM           = "objects"
 	VectorsCompressedBucketLSM = "vectors_compressed"
 	VectorsBucketLSM           = "vectors"
+	VectorsPostingsBucketLSM   = "vectors_postings"
+	VectorsCentroidsBucketLSM  = "vectors_centroids"
+	VectorsSparseBucketLSM     = "vectors_sparse"
 	DimensionsBucketLSM        = "dimensions"
 )
 
@@ -71,6 +74,10 @@ func BucketSearchableFromPropNameLSM(propName string) string {
 	return BucketFromPropNameLSM(propName + "_searchable")
 }
 
+func BucketPositionsFromPropNameLSM(propName string) string {
+	return BucketFromPropNameLSM(propName + "_positions")
+}
+
 func BucketRangeableFromPropNameLSM(propName string) string {
 	return BucketFromPropNameLSM(propName + "_rangeable")
 }
@@ -316,8 +316,14 @@ func lowercase(terms []string) []string {
 }
 
 func TokenizeAndCountDuplicates(tokenization string, in string) ([]string, []int) {
+	return CountDuplicates(Tokenize(tokenization, in))
+}
+
+// CountDuplicates returns the unique terms along with the number of times
+// each of them occurs
+func CountDuplicates(terms []string) ([]string, []int) {
 	counts := map[string]int{}
-	for _, term := range Tokenize(tokenization, in) {
+	for _, term := range terms {
 		counts[term]++
 	}
 
@@ -26,8 +26,10 @@ import (
 	"github.com/sirupsen/logrus"
 	"github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/facets"
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/modulecapabilities"
@@ -931,6 +933,18 @@ func (f *fakeObjectSearcher) VectorSearch(context.Context, dto.GetParams, []stri
 	return nil, nil
 }
 
+func (f *fakeObjectSearcher) VectorSearchBatch(context.Context, dto.GetParams, string, [][]float32) ([][]search.Result, error) {
+	return nil, nil
+}
+
+func (f *fakeObjectSearcher) Facets(context.Context, facets.Params) ([]facets.Result, error) {
+	return nil, nil
+}
+
+func (f *fakeObjectSearcher) TextAnalyzers(string) (*inverted.TextAnalyzers, error) {
+	return nil, nil
+}
+
 func (f *fakeObjectSearcher) CrossClassVectorSearch(context.Context, []float32, string, int, int, *filters.LocalFilter) ([]search.Result, error) {
 	return nil, nil
 }
@@ -41,12 +41,14 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted/stopwords"
 	"github.com/weaviate/weaviate/adapters/repos/db/sorter"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw"
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/aggregation"
 	"github.com/weaviate/weaviate/entities/autocut"
 	"github.com/weaviate/weaviate/entities/errorcompounder"
 	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/facets"
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/multi"
@@ -174,6 +176,7 @@ type Index struct {
 	partitioningEnabled bool
 
 	invertedIndexConfig     schema.InvertedIndexConfig
+	textAnalyzers           *inverted.TextAnalyzers // built from invertedIndexConfig
 	invertedIndexConfigLock sync.Mutex
 
 	// This lock should be used together with the db indexLock.
@@ -248,6 +251,10 @@ func NewIndex(ctx context.Context, cfg IndexConfig,
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to create new index")
 	}
+	textAnalyzers, err := inverted.NewTextAnalyzers(invertedIndexConfig.Analyzers, &invertedIndexConfig.Stopwords)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create new index")
+	}
 
 	repl := replica.NewReplicator(cfg.ClassName.String(),
 		sg, nodeResolver, string(cfg.DeletionStrategy), replicaClient, logger)
@@ -263,6 +270,7 @@ func NewIndex(ctx context.Context, cfg IndexConfig,
 		classSearcher:          cs,
 		vectorIndexUserConfig:  vectorIndexUserConfig,
 		invertedIndexConfig:    invertedIndexConfig,
+		textAnalyzers:          textAnalyzers,
 		vectorIndexUserConfigs: vectorIndexUserConfigs,
 		stopwords:              sd,
 		replicator:             repl,
@@ -551,13 +559,28 @@ func (i *Index) getInvertedIndexConfig() schema.InvertedIndexConfig {
 	return i.invertedIndexConfig
 }
 
+// getTextAnalyzers returns the custom analyzers of the current inverted index
+// config, which are shared by all writes and queries of the index
+func (i *Index) getTextAnalyzers() *inverted.TextAnalyzers {
+	i.invertedIndexConfigLock.Lock()
+	defer i.invertedIndexConfigLock.Unlock()
+
+	return i.textAnalyzers
+}
+
 func (i *Index) updateInvertedIndexConfig(ctx context.Context,
 	updated schema.InvertedIndexConfig,
 ) error {
+	textAnalyzers, err := inverted.NewTextAnalyzers(updated.Analyzers, &updated.Stopwords)
+	if err != nil {
+		return errors.Wrap(err, "update inverted index config")
+	}
+
 	i.invertedIndexConfigLock.Lock()
 	defer i.invertedIndexConfigLock.Unlock()
 
 	i.invertedIndexConfig = updated
+	i.textAnalyzers = textAnalyzers
 
 	return nil
 }
@@ -596,6 +619,7 @@ type IndexConfig struct {
 	SeparateObjectsCompactions     bool
 	MaxSegmentSize                 int64
 	HNSWMaxLogSize                 int64
+	LogArchiving                   bool
 	HNSWWaitForCachePrefill        bool
 	HNSWFlatSearchConcurrency      int
 	VisitedListPoolMaxSize         int
@@ -1277,27 +1301,8 @@ func (i *Index) objectSearch(ctx context.Context, limit int, filters *filters.Lo
 		return nil, nil, err
 	}
 
-	// If the request is a BM25F with no properties selected, use all possible properties
-	if keywordRanking != nil && keywordRanking.Type == "bm25" && len(keywordRanking.Properties) == 0 {
-
-		cl := i.getSchema.ReadOnlyClass(i.Config.ClassName.String())
-		if cl == nil {
-			return nil, nil, fmt.Errorf("class %s not found in schema", i.Config.ClassName)
-		}
-
-		propHash := cl.Properties
-		// Get keys of hash
-		for _, v := range propHash {
-			if inverted.PropertyHasSearchableIndex(i.getSchema.ReadOnlyClass(i.Config.ClassName.String()), v.Name) {
-				keywordRanking.Properties = append(keywordRanking.Properties, v.Name)
-			}
-		}
-
-		// WEAVIATE-471 - error if we can't find a property to search
-		if len(keywordRanking.Properties) == 0 {
-			return nil, []float32{}, errors.New(
-				"No properties provided, and no indexed properties found in class")
-		}
+	if err := i.defaultKeywordRankingProperties(keywordRanking); err != nil {
+		return nil, []float32{}, err
 	}
 
 	outObjects, outScores, err := i.objectSearchByShard(ctx, limit,
@@ -1386,6 +1391,32 @@ func (i *Index) objectSearch(ctx context.Context, limit int, filters *filters.Lo
 	return outObjects, outScores, nil
 }
 
+// defaultKeywordRankingProperties selects all properties with a searchable
+// index if a BM25F search has no properties selected
+func (i *Index) defaultKeywordRankingProperties(keywordRanking *searchparams.KeywordRanking) error {
+	if keywordRanking == nil || keywordRanking.Type != "bm25" || len(keywordRanking.Properties) > 0 {
+		return nil
+	}
+
+	cl := i.getSchema.ReadOnlyClass(i.Config.ClassName.String())
+	if cl == nil {
+		return fmt.Errorf("class %s not found in schema", i.Config.ClassName)
+	}
+
+	for _, v := range cl.Properties {
+		if inverted.PropertyHasSearchableIndex(cl, v.Name) {
+			keywordRanking.Properties = append(keywordRanking.Properties, v.Name)
+		}
+	}
+
+	// WEAVIATE-471 - error if we can't find a property to search
+	if len(keywordRanking.Properties) == 0 {
+		return errors.New(
+			"No properties provided, and no indexed properties found in class")
+	}
+	return nil
+}
+
 func (i *Index) objectSearchByShard(ctx context.Context, limit int, filters *filters.LocalFilter,
 	keywordRanking *searchparams.KeywordRanking, sort []filters.Sort, cursor *filters.Cursor,
 	addlProps additional.Properties, shards []string, properties []string,
@@ -1428,7 +1459,7 @@ func (i *Index) objectSearchByShard(ctx context.Context, limit int, filters *fil
 
 				objs, scores, nodeName, err = i.remote.SearchShard(
 					ctx, shardName, nil, nil, limit, filters, keywordRanking,
-					sort, cursor, nil, addlProps, i.replicationEnabled(), nil, properties)
+					sort, cursor, nil, addlProps, i.replicationEnabled(), nil, properties, 0)
 				if err != nil {
 					return fmt.Errorf(
 						"remote shard object search %s: %w", shardName, err)
@@ -1518,7 +1549,7 @@ func (i *Index) mergeGroups(objects []*storobj.Object, dists []float32,
 func (i *Index) singleLocalShardObjectVectorSearch(ctx context.Context, searchVectors [][]float32,
 	targetVectors []string, dist float32, limit int, filters *filters.LocalFilter,
 	sort []filters.Sort, groupBy *searchparams.GroupBy, additional additional.Properties,
-	shard ShardLike, targetCombination *dto.TargetCombination, properties []string,
+	shard ShardLike, targetCombination *dto.TargetCombination, properties []string, matryoshkaDims int,
 ) ([]*storobj.Object, []float32, error) {
 	ctx = helpers.InitSlowQueryDetails(ctx)
 	helpers.AnnotateSlowQueryLog(ctx, "is_coordinator", true)
@@ -1526,10 +1557,13 @@ func (i *Index) singleLocalShardObjectVectorSearch(ctx context.Context, searchVe
 		return nil, nil, enterrors.NewErrUnprocessable(fmt.Errorf("local %s shard is not ready", shard.Name()))
 	}
 	res, resDists, err := shard.ObjectVectorSearch(
-		ctx, searchVectors, targetVectors, dist, limit, filters, sort, groupBy, additional, targetCombination, properties)
+		ctx, searchVectors, targetVectors, dist, limit, filters, sort, groupBy, additional, targetCombination, properties, matryoshkaDims)
 	if err != nil {
 		return nil, nil, errors.Wrapf(err, "shard %s", shard.ID())
 	}
+	if groupBy != nil && groupBy.Exhaustive() {
+		return i.mergeGroups(res, resDists, groupBy, limit, 1)
+	}
 	return res, resDists, nil
 }
 
@@ -1563,6 +1597,7 @@ func (i *Index) objectVectorSearch(ctx context.Context, searchVectors [][]float3
 	targetVectors []string, dist float32, limit int, filters *filters.LocalFilter, sort []filters.Sort,
 	groupBy *searchparams.GroupBy, additional additional.Properties,
 	replProps *additional.ReplicationProperties, tenant string, targetCombination *dto.TargetCombination, properties []string,
+	matryoshkaDims int,
 ) ([]*storobj.Object, []float32, error) {
 	if err := i.validateMultiTenancy(tenant); err != nil {
 		return nil, nil, err
@@ -1581,7 +1616,7 @@ func (i *Index) objectVectorSearch(ctx context.Context, searchVectors [][]float3
 		if shard != nil {
 			defer release()
 			return i.singleLocalShardObjectVectorSearch(ctx, searchVectors, targetVectors, dist, limit, filters,
-				sort, groupBy, additional, shard, targetCombination, properties)
+				sort, groupBy, additional, shard, targetCombination, properties, matryoshkaDims)
 		}
 	}
 
@@ -1614,7 +1649,7 @@ func (i *Index) objectVectorSearch(ctx context.Context, searchVectors [][]float3
 				localCtx := helpers.InitSlowQueryDetails(ctx)
 				helpers.AnnotateSlowQueryLog(localCtx, "is_coordinator", true)
 				localShardResult, localShardScores, err := shard.ObjectVectorSearch(
-					localCtx, searchVectors, targetVectors, dist, limit, filters, sort, groupBy, additional, targetCombination, properties)
+					localCtx, searchVectors, targetVectors, dist, limit, filters, sort, groupBy, additional, targetCombination, properties, matryoshkaDims)
 				if err != nil {
 					return errors.Wrapf(err, "shard %s", shard.ID())
 				}
@@ -1634,7 +1669,7 @@ func (i *Index) objectVectorSearch(ctx context.Context, searchVectors [][]float3
 					// Force a search on all the replicas for the shard
 					remoteSearchResults, err := i.remote.SearchAllReplicas(ctx,
 						i.logger, shardName, searchVectors, targetVectors, limit, filters,
-						nil, sort, nil, groupBy, additional, i.replicationEnabled(), i.getSchema.NodeName(), targetCombination, properties)
+						nil, sort, nil, groupBy, additional, i.replicationEnabled(), i.getSchema.NodeName(), targetCombination, properties, matryoshkaDims)
 					// Only return an error if we failed to query remote shards AND we had no local shard to query
 					if err != nil && shard == nil {
 						return errors.Wrapf(err, "remote shard %s", shardName)
@@ -1653,7 +1688,7 @@ func (i *Index) objectVectorSearch(ctx context.Context, searchVectors [][]float3
 					// Search only what is necessary
 					remoteResult, remoteDists, nodeName, err := i.remote.SearchShard(ctx,
 						shardName, searchVectors, targetVectors, limit, filters,
-						nil, sort, nil, groupBy, additional, i.replicationEnabled(), targetCombination, properties)
+						nil, sort, nil, groupBy, additional, i.replicationEnabled(), targetCombination, properties, matryoshkaDims)
 					if err != nil {
 						return errors.Wrapf(err, "remote shard %s", shardName)
 					}
@@ -1684,11 +1719,11 @@ func (i *Index) objectVectorSearch(ctx context.Context, searchVectors [][]float3
 		}
 	}
 
-	if len(shardNames) == 1 {
+	if len(shardNames) == 1 && (groupBy == nil || !groupBy.Exhaustive()) {
 		return out, dists, nil
 	}
 
-	if len(shardNames) > 1 && groupBy != nil {
+	if groupBy != nil {
 		return i.mergeGroups(out, dists, groupBy, limit, len(shardNames))
 	}
 
@@ -1697,6 +1732,11 @@ func (i *Index) objectVectorSearch(ctx context.Context, searchVectors [][]float3
 	}
 
 	out, dists = newDistancesSorter().sort(out, dists)
+	if limit < 0 {
+		// every shard returns at most the first page of a search by distance,
+		// results behind it can be missing from the other shards
+		limit = i.searchByDistanceLimit()
+	}
 	if limit > 0 && len(out) > limit {
 		out = out[:limit]
 		dists = dists[:limit]
@@ -1717,11 +1757,23 @@ func (i *Index) objectVectorSearch(ctx context.Context, searchVectors [][]float3
 	return out, dists, nil
 }
 
+// searchByDistanceLimit is the maximum number of results of a search by
+// distance, or zero if there is none. Like the iterative search by distance,
+// the first batch of results is never cut short by the maximum and a negative
+// maximum means no limit.
+func (i *Index) searchByDistanceLimit() int {
+	if i.Config.QueryMaximumResults < 0 {
+		return 0
+	}
+	return max(int(i.Config.QueryMaximumResults), common.DefaultSearchByDistInitialLimit)
+}
+
 func (i *Index) IncomingSearch(ctx context.Context, shardName string,
 	searchVectors [][]float32, targetVectors []string, distance float32, limit int,
 	filters *filters.LocalFilter, keywordRanking *searchparams.KeywordRanking,
 	sort []filters.Sort, cursor *filters.Cursor, groupBy *searchparams.GroupBy,
 	additional additional.Properties, targetCombination *dto.TargetCombination, properties []string,
+	matryoshkaDims int,
 ) ([]*storobj.Object, []float32, error) {
 	shard, release, err := i.getOrInitShard(ctx, shardName)
 	if err != nil {
@@ -1758,7 +1810,7 @@ func (i *Index) IncomingSearch(ctx context.Context, shardName string,
 	}
 
 	res, resDists, err := shard.ObjectVectorSearch(
-		ctx, searchVectors, targetVectors, distance, limit, filters, sort, groupBy, additional, targetCombination, properties)
+		ctx, searchVectors, targetVectors, distance, limit, filters, sort, groupBy, additional, targetCombination, properties, matryoshkaDims)
 	if err != nil {
 		return nil, nil, errors.Wrapf(err, "shard %s", shard.ID())
 	}
@@ -2036,7 +2088,7 @@ func (i *Index) aggregate(ctx context.Context,
 		results[j] = res
 	}
 
-	return aggregator.NewShardCombiner().Do(results), nil
+	return aggregator.NewShardCombiner().Do(results)
 }
 
 func (i *Index) IncomingAggregate(ctx context.Context, shardName string,
@@ -2055,6 +2107,76 @@ func (i *Index) IncomingAggregate(ctx context.Context, shardName string,
 	return shard.Aggregate(ctx, params, mods.(*modules.Provider))
 }
 
+// facets counts the facets of the params on every shard and merges the
+// counts. Each shard is counted on a single replica.
+func (i *Index) facets(ctx context.Context, params facets.Params) ([]facets.Result, error) {
+	if err := i.validateMultiTenancy(params.Tenant); err != nil {
+		return nil, err
+	}
+
+	if params.KeywordRanking != nil {
+		keywordRanking := *params.KeywordRanking
+		keywordRanking.Properties = append([]string(nil), keywordRanking.Properties...)
+		if err := i.defaultKeywordRankingProperties(&keywordRanking); err != nil {
+			return nil, err
+		}
+		params.KeywordRanking = &keywordRanking
+	}
+
+	shardNames, err := i.targetShardNames(ctx, params.Tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([][]facets.Result, len(shardNames))
+	eg := enterrors.NewErrorGroupWrapper(i.logger, "tenant:", params.Tenant)
+	eg.SetLimit(_NUMCPU * 2)
+	for j, shardName := range shardNames {
+		j, shardName := j, shardName
+		eg.Go(func() error {
+			shard, release, err := i.GetShard(ctx, shardName)
+			if err != nil {
+				return errors.Wrapf(err, "shard %s", shardName)
+			}
+
+			var res []facets.Result
+			if shard != nil {
+				defer release()
+				res, err = shard.Facets(ctx, params)
+			} else {
+				res, err = i.remote.Facets(ctx, shardName, params)
+			}
+			if err != nil {
+				return errors.Wrapf(err, "shard %s", shardName)
+			}
+
+			results[j] = res
+			return nil
+		}, shardName)
+	}
+	if err := eg.Wait(); err != nil {
+		return nil, err
+	}
+
+	return facets.Merge(params.Facets, results), nil
+}
+
+func (i *Index) IncomingFacets(ctx context.Context, shardName string,
+	params facets.Params,
+) ([]facets.Result, error) {
+	shard, release, err := i.getOrInitShard(ctx, shardName)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	if shard.GetStatus() == storagestate.StatusLoading {
+		return nil, enterrors.NewErrUnprocessable(fmt.Errorf("local %s shard is not ready", shardName))
+	}
+
+	return shard.Facets(ctx, params)
+}
+
 func (i *Index) drop() error {
 	i.shardTransferMutex.RLock()
 	defer i.shardTransferMutex.RUnlock()
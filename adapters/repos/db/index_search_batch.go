@@ -0,0 +1,183 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/entities/additional"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/storagestate"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/usecases/replica"
+)
+
+// objectVectorSearchBatch answers several near vector queries against the
+// same target vector. Every shard, local or remote, is asked once for all
+// queries. The result holds one list of objects and distances per query
+// vector, merged across shards and cut to limit.
+func (i *Index) objectVectorSearchBatch(ctx context.Context, searchVectors [][]float32,
+	targetVector string, dist float32, limit int, filters *filters.LocalFilter,
+	additional additional.Properties, replProps *additional.ReplicationProperties,
+	tenant string, properties []string,
+) ([][]*storobj.Object, [][]float32, error) {
+	if err := i.validateMultiTenancy(tenant); err != nil {
+		return nil, nil, err
+	}
+	shardNames, err := i.targetShardNames(ctx, tenant)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	out := make([][]*storobj.Object, len(searchVectors))
+	dists := make([][]float32, len(searchVectors))
+	if len(shardNames) == 0 {
+		return out, dists, nil
+	}
+
+	if i.Config.ForceFullReplicasSearch {
+		// results from all replicas need to be deduplicated per query, which
+		// the single query path already takes care of
+		for q, vector := range searchVectors {
+			out[q], dists[q], err = i.objectVectorSearch(ctx, [][]float32{vector}, []string{targetVector},
+				dist, limit, filters, nil, nil, additional, replProps, tenant, nil, properties, 0)
+			if err != nil {
+				return nil, nil, err
+			}
+		}
+		return out, dists, nil
+	}
+
+	eg := enterrors.NewErrorGroupWrapper(i.logger, "tenant:", tenant)
+	eg.SetLimit(_NUMCPU * 2)
+	m := &sync.Mutex{}
+
+	for _, shardName := range shardNames {
+		shardName := shardName
+		eg.Go(func() error {
+			shard, release, err := i.GetShard(ctx, shardName)
+			if err != nil {
+				return nil
+			}
+
+			if shard != nil {
+				defer release()
+
+				if shard.GetStatus() == storagestate.StatusLoading {
+					return enterrors.NewErrUnprocessable(fmt.Errorf("local %s shard is not ready", shard.Name()))
+				}
+
+				localCtx := helpers.InitSlowQueryDetails(ctx)
+				helpers.AnnotateSlowQueryLog(localCtx, "is_coordinator", true)
+				shardResults, shardDists, err := shard.ObjectVectorSearchBatch(localCtx,
+					searchVectors, targetVector, dist, limit, filters, additional, properties)
+				if err != nil {
+					return errors.Wrapf(err, "shard %s", shard.ID())
+				}
+
+				m.Lock()
+				defer m.Unlock()
+				for q := range shardResults {
+					if i.replicationEnabled() {
+						storobj.AddOwnership(shardResults[q], i.getSchema.NodeName(), shardName)
+					}
+					out[q] = append(out[q], shardResults[q]...)
+					dists[q] = append(dists[q], shardDists[q]...)
+				}
+				return nil
+			}
+
+			remoteResults, remoteDists, nodeName, err := i.remote.SearchShardBatch(ctx,
+				shardName, searchVectors, targetVector, dist, limit, filters, additional, properties)
+			if err != nil {
+				return errors.Wrapf(err, "remote shard %s", shardName)
+			}
+			if len(remoteResults) != len(searchVectors) || len(remoteDists) != len(searchVectors) {
+				return fmt.Errorf("remote shard %s: got results for %d of %d queries",
+					shardName, len(remoteResults), len(searchVectors))
+			}
+
+			m.Lock()
+			defer m.Unlock()
+			for q := range remoteResults {
+				if i.replicationEnabled() {
+					storobj.AddOwnership(remoteResults[q], nodeName, shardName)
+				}
+				out[q] = append(out[q], remoteResults[q]...)
+				dists[q] = append(dists[q], remoteDists[q]...)
+			}
+			return nil
+		}, shardName)
+	}
+
+	if err := eg.Wait(); err != nil {
+		return nil, nil, err
+	}
+
+	if len(shardNames) == 1 {
+		return out, dists, nil
+	}
+
+	for q := range searchVectors {
+		out[q], dists[q] = newDistancesSorter().sort(out[q], dists[q])
+		if limit > 0 && len(out[q]) > limit {
+			out[q] = out[q][:limit]
+			dists[q] = dists[q][:limit]
+		}
+
+		if i.replicationEnabled() {
+			if replProps == nil {
+				replProps = defaultConsistency(replica.One)
+			}
+			l := replica.ConsistencyLevel(replProps.ConsistencyLevel)
+			if err := i.replicator.CheckConsistency(ctx, l, out[q]); err != nil {
+				i.logger.WithField("action", "object_vector_search_batch").
+					Errorf("failed to check consistency of search results: %v", err)
+			}
+		}
+	}
+
+	return out, dists, nil
+}
+
+func (i *Index) IncomingSearchBatch(ctx context.Context, shardName string,
+	searchVectors [][]float32, targetVector string, distance float32, limit int,
+	filters *filters.LocalFilter, additional additional.Properties, properties []string,
+) ([][]*storobj.Object, [][]float32, error) {
+	shard, release, err := i.getOrInitShard(ctx, shardName)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer release()
+
+	ctx = helpers.InitSlowQueryDetails(ctx)
+	helpers.AnnotateSlowQueryLog(ctx, "is_coordinator", false)
+
+	if i.replicationEnabled() && shard.GetStatusNoLoad() == storagestate.StatusLoading {
+		return nil, nil, enterrors.NewErrUnprocessable(fmt.Errorf("local %s shard is not ready", shardName))
+	} else if shard.GetStatus() == storagestate.StatusLoading {
+		return nil, nil, enterrors.NewErrUnprocessable(fmt.Errorf("local %s shard is not ready", shardName))
+	}
+
+	res, resDists, err := shard.ObjectVectorSearchBatch(ctx, searchVectors, targetVector,
+		distance, limit, filters, additional, properties)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "shard %s", shard.ID())
+	}
+
+	return res, resDists, nil
+}
@@ -94,6 +94,7 @@ func (db *DB) init(ctx context.Context) error {
 				SeparateObjectsCompactions:     db.config.SeparateObjectsCompactions,
 				MaxSegmentSize:                 db.config.MaxSegmentSize,
 				HNSWMaxLogSize:                 db.config.HNSWMaxLogSize,
+				LogArchiving:                   db.config.LogArchiving,
 				HNSWWaitForCachePrefill:        db.config.HNSWWaitForCachePrefill,
 				HNSWFlatSearchConcurrency:      db.config.HNSWFlatSearchConcurrency,
 				VisitedListPoolMaxSize:         db.config.VisitedListPoolMaxSize,
@@ -14,6 +14,7 @@ package inverted
 import (
 	"bytes"
 	"encoding/binary"
+	"slices"
 
 	"github.com/google/uuid"
 	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
@@ -25,6 +26,7 @@ type IsFallbackToSearchable func() bool
 type Countable struct {
 	Data          []byte
 	TermFrequency float32
+	Positions     []uint32 // only set for properties which index positions
 }
 
 type Property struct {
@@ -63,6 +65,8 @@ func DedupItems(props []Property) []Property {
 
 type Analyzer struct {
 	isFallbackToSearchable IsFallbackToSearchable
+	textAnalyzers          *TextAnalyzers
+	indexPositions         []string
 }
 
 // Text tokenizes given input according to selected tokenization,
@@ -78,7 +82,30 @@ func (a *Analyzer) TextArray(tokenization string, inArr []string) []Countable {
 	for _, in := range inArr {
 		terms = append(terms, helpers.Tokenize(tokenization, in)...)
 	}
+	return countTerms(terms)
+}
 
+// textArray analyzes input with the custom analyzer of the property if it has
+// one, or with its tokenization otherwise
+func (a *Analyzer) textArray(prop *models.Property, inArr []string) ([]Countable, error) {
+	textAnalyzer, err := a.textAnalyzers.ForProperty(prop)
+	if err != nil {
+		return nil, err
+	}
+	if slices.Contains(a.indexPositions, prop.Name) {
+		analyze := func(in string) []string { return helpers.Tokenize(prop.Tokenization, in) }
+		if textAnalyzer != nil {
+			analyze = textAnalyzer.Analyze
+		}
+		return countTermsWithPositions(inArr, analyze), nil
+	}
+	if textAnalyzer == nil {
+		return a.TextArray(prop.Tokenization, inArr), nil
+	}
+	return countTerms(textAnalyzer.AnalyzeArray(inArr)), nil
+}
+
+func countTerms(terms []string) []Countable {
 	counts := map[string]uint64{}
 	for _, term := range terms {
 		counts[term]++
@@ -245,3 +272,16 @@ func NewAnalyzer(isFallbackToSearchable IsFallbackToSearchable) *Analyzer {
 	}
 	return &Analyzer{isFallbackToSearchable: isFallbackToSearchable}
 }
+
+// WithTextAnalyzers sets the custom analyzers text properties can refer to
+func (a *Analyzer) WithTextAnalyzers(textAnalyzers *TextAnalyzers) *Analyzer {
+	a.textAnalyzers = textAnalyzers
+	return a
+}
+
+// WithIndexPositions sets the text properties whose terms are analyzed
+// together with their positions
+func (a *Analyzer) WithIndexPositions(propNames []string) *Analyzer {
+	a.indexPositions = propNames
+	return a
+}
@@ -17,6 +17,8 @@ import (
 	"math"
 	"os"
 	"runtime/debug"
+	"slices"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -45,6 +47,7 @@ type BM25Searcher struct {
 	getClass       func(string) *models.Class
 	classSearcher  ClassSearcher // to allow recursive searches on ref-props
 	propIndices    propertyspecific.Indices
+	textAnalyzers  *TextAnalyzers
 	propLenTracker propLengthRetriever
 	logger         logrus.FieldLogger
 	shardVersion   uint16
@@ -55,17 +58,18 @@ type propLengthRetriever interface {
 }
 
 type termListRequest struct {
-	term               string
-	termId             int
-	duplicateTextBoost int
-	propertyNames      []string
-	propertyBoosts     map[string]float32
+	term           string
+	termId         int
+	queryTermBoost float64
+	propertyNames  []string
+	propertyBoosts map[string]float32
+	phrase         []string // tokens of a quoted phrase, empty for single terms
 }
 
 func NewBM25Searcher(config schema.BM25Config, store *lsmkv.Store,
 	getClass func(string) *models.Class, propIndices propertyspecific.Indices,
-	classSearcher ClassSearcher, propLenTracker propLengthRetriever,
-	logger logrus.FieldLogger, shardVersion uint16,
+	classSearcher ClassSearcher, textAnalyzers *TextAnalyzers,
+	propLenTracker propLengthRetriever, logger logrus.FieldLogger, shardVersion uint16,
 ) *BM25Searcher {
 	return &BM25Searcher{
 		config:         config,
@@ -73,6 +77,7 @@ func NewBM25Searcher(config schema.BM25Config, store *lsmkv.Store,
 		getClass:       getClass,
 		propIndices:    propIndices,
 		classSearcher:  classSearcher,
+		textAnalyzers:  textAnalyzers,
 		propLenTracker: propLenTracker,
 		logger:         logger.WithField("action", "bm25_search"),
 		shardVersion:   shardVersion,
@@ -98,7 +103,9 @@ func (b *BM25Searcher) BM25F(ctx context.Context, filterDocIds helpers.AllowList
 	var scores []float32
 	var err error
 
-	if os.Getenv("USE_BLOCKMAX_WAND") == "true" {
+	// phrases are matched on the positions of all segments combined, which
+	// the block-max search does not support
+	if _, phrases := splitPhrases(keywordRanking.Query); os.Getenv("USE_BLOCKMAX_WAND") == "true" && len(phrases) == 0 {
 		objs, scores, err = b.wandBlock(ctx, filterDocIds, class, keywordRanking, limit, additional)
 	} else {
 		objs, scores, err = b.wand(ctx, filterDocIds, class, keywordRanking, limit, additional)
@@ -114,7 +121,7 @@ func (b *BM25Searcher) GetPropertyLengthTracker() *JsonShardMetaData {
 	return b.propLenTracker.(*JsonShardMetaData)
 }
 
-func (b *BM25Searcher) generateQueryTermsAndStats(class *models.Class, params searchparams.KeywordRanking) (float64, map[string][]string, map[string][]string, map[string][]int, map[string]float32, float64, error) {
+func (b *BM25Searcher) generateQueryTermsAndStats(class *models.Class, params searchparams.KeywordRanking) (float64, map[string][]string, map[string][]string, map[string][]float64, map[string]float32, float64, error) {
 	N := float64(b.store.Bucket(helpers.ObjectsBucketLSM).Count())
 
 	var stopWordDetector *stopwords.Detector
@@ -131,21 +138,32 @@ func (b *BM25Searcher) generateQueryTermsAndStats(class *models.Class, params se
 	// Query is tokenized and respective properties are then searched for the search terms,
 	// results at the end are combined using WAND
 	queryTermsByTokenization := map[string][]string{}
-	duplicateBoostsByTokenization := map[string][]int{}
+	queryTermBoostsByTokenization := map[string][]float64{}
 	propNamesByTokenization := map[string][]string{}
 	propertyBoosts := make(map[string]float32, len(params.Properties))
 
+	var synonyms *querySynonyms
+	if class.InvertedIndexConfig != nil {
+		var err error
+		synonyms, err = newQuerySynonyms(class.InvertedIndexConfig.Synonyms)
+		if err != nil {
+			return 0, nil, nil, nil, nil, 0, err
+		}
+	}
+
 	for _, tokenization := range helpers.Tokenizations {
-		queryTerms, dupBoosts := helpers.TokenizeAndCountDuplicates(tokenization, params.Query)
+		tokenize := func(in string) []string { return helpers.Tokenize(tokenization, in) }
+		queryTerms, queryTermBoosts := countQueryTerms(tokenize(params.Query))
+		queryTerms, queryTermBoosts = synonyms.expand(queryTerms, queryTermBoosts, tokenize)
 		queryTermsByTokenization[tokenization] = queryTerms
-		duplicateBoostsByTokenization[tokenization] = dupBoosts
+		queryTermBoostsByTokenization[tokenization] = queryTermBoosts
 
 		// stopword filtering for word tokenization
 		if tokenization == models.PropertyTokenizationWord {
-			queryTerms, dupBoosts = b.removeStopwordsFromQueryTerms(queryTermsByTokenization[tokenization],
-				duplicateBoostsByTokenization[tokenization], stopWordDetector)
+			queryTerms, queryTermBoosts = b.removeStopwordsFromQueryTerms(queryTermsByTokenization[tokenization],
+				queryTermBoostsByTokenization[tokenization], stopWordDetector)
 			queryTermsByTokenization[tokenization] = queryTerms
-			duplicateBoostsByTokenization[tokenization] = dupBoosts
+			queryTermBoostsByTokenization[tokenization] = queryTermBoosts
 		}
 
 		propNamesByTokenization[tokenization] = make([]string, 0)
@@ -184,6 +202,23 @@ func (b *BM25Searcher) generateQueryTermsAndStats(class *models.Class, params se
 
 		switch dt, _ := schema.AsPrimitive(prop.DataType); dt {
 		case schema.DataTypeText, schema.DataTypeTextArray:
+			if prop.Analyzer != "" {
+				// properties with a custom analyzer are searched for the terms
+				// the query is analyzed into by the same analyzer
+				key := analyzerQueryKey(prop.Analyzer)
+				if _, exists := queryTermsByTokenization[key]; !exists {
+					textAnalyzer, err := b.textAnalyzers.ForProperty(prop)
+					if err != nil {
+						return 0, nil, nil, nil, nil, 0, err
+					}
+					queryTerms, queryTermBoosts := countQueryTerms(textAnalyzer.Analyze(params.Query))
+					queryTerms, queryTermBoosts = synonyms.expand(queryTerms, queryTermBoosts, textAnalyzer.Analyze)
+					queryTermsByTokenization[key] = queryTerms
+					queryTermBoostsByTokenization[key] = queryTermBoosts
+				}
+				propNamesByTokenization[key] = append(propNamesByTokenization[key], property)
+				continue
+			}
 			if _, exists := propNamesByTokenization[prop.Tokenization]; !exists {
 				return 0, nil, nil, nil, nil, 0, fmt.Errorf("cannot handle tokenization '%v' of property '%s'",
 					prop.Tokenization, prop.Name)
@@ -203,34 +238,54 @@ func (b *BM25Searcher) generateQueryTermsAndStats(class *models.Class, params se
 	if math.IsNaN(averagePropLength) || averagePropLength == 0 {
 		averagePropLength = 40.0
 	}
-	return N, propNamesByTokenization, queryTermsByTokenization, duplicateBoostsByTokenization, propertyBoosts, averagePropLength, nil
+	return N, propNamesByTokenization, queryTermsByTokenization, queryTermBoostsByTokenization, propertyBoosts, averagePropLength, nil
 }
 
 func (b *BM25Searcher) wand(
 	ctx context.Context, filterDocIds helpers.AllowList, class *models.Class, params searchparams.KeywordRanking, limit int, additional additional.Properties,
 ) ([]*storobj.Object, []float32, error) {
-	N, propNamesByTokenization, queryTermsByTokenization, duplicateBoostsByTokenization, propertyBoosts, averagePropLength, err := b.generateQueryTermsAndStats(class, params)
+	query, phrases := splitPhrases(params.Query)
+	params.Query = query
+	N, propNamesByTokenization, queryTermsByTokenization, queryTermBoostsByTokenization, propertyBoosts, averagePropLength, err := b.generateQueryTermsAndStats(class, params)
+	if err != nil {
+		return nil, nil, err
+	}
+	phrasesByTokenization, err := b.queryPhrases(class, phrases, propNamesByTokenization)
 	if err != nil {
 		return nil, nil, err
 	}
+	if params.Slop < 0 {
+		return nil, nil, fmt.Errorf("slop must not be negative, got %d", params.Slop)
+	}
 
 	allRequests := make([]termListRequest, 0, 1000)
 	allQueryTerms := make([]string, 0, 1000)
 
-	for _, tokenization := range helpers.Tokenizations {
+	for _, tokenization := range queryTermKeys(propNamesByTokenization) {
 		propNames := propNamesByTokenization[tokenization]
 		if len(propNames) > 0 {
-			queryTerms, duplicateBoosts := queryTermsByTokenization[tokenization], duplicateBoostsByTokenization[tokenization]
+			queryTerms, queryTermBoosts := queryTermsByTokenization[tokenization], queryTermBoostsByTokenization[tokenization]
 			for queryTermIndex, queryTerm := range queryTerms {
 				allRequests = append(allRequests, termListRequest{
-					term:               queryTerm,
-					termId:             len(allRequests),
-					duplicateTextBoost: duplicateBoosts[queryTermIndex],
-					propertyNames:      propNames,
-					propertyBoosts:     propertyBoosts,
+					term:           queryTerm,
+					termId:         len(allRequests),
+					queryTermBoost: queryTermBoosts[queryTermIndex],
+					propertyNames:  propNames,
+					propertyBoosts: propertyBoosts,
 				})
 				allQueryTerms = append(allQueryTerms, queryTerm)
 			}
+			for _, phrase := range phrasesByTokenization[tokenization] {
+				allRequests = append(allRequests, termListRequest{
+					term:           strings.Join(phrase, " "),
+					termId:         len(allRequests),
+					queryTermBoost: 1,
+					propertyNames:  propNames,
+					propertyBoosts: propertyBoosts,
+					phrase:         phrase,
+				})
+				allQueryTerms = append(allQueryTerms, strings.Join(phrase, " "))
+			}
 		}
 	}
 
@@ -243,7 +298,8 @@ func (b *BM25Searcher) wand(
 		term := request.term
 		termId := request.termId
 		propNames := request.propertyNames
-		duplicateBoost := request.duplicateTextBoost
+		queryTermBoost := request.queryTermBoost
+		phrase := request.phrase
 
 		eg.Go(func() (err error) {
 			defer func() {
@@ -259,7 +315,13 @@ func (b *BM25Searcher) wand(
 				}
 			}()
 
-			termResult, termErr := b.createTerm(N, filterDocIds, term, termId, propNames, propertyBoosts, duplicateBoost, ctx)
+			var termResult *terms.Term
+			var termErr error
+			if len(phrase) > 1 {
+				termResult, termErr = b.createPhraseTerm(N, filterDocIds, phrase, params.Slop, termId, propNames, propertyBoosts, queryTermBoost, ctx)
+			} else {
+				termResult, termErr = b.createTerm(N, filterDocIds, term, termId, propNames, propertyBoosts, queryTermBoost, ctx)
+			}
 			if termErr != nil {
 				err = termErr
 				return
@@ -298,25 +360,57 @@ func (b *BM25Searcher) wand(
 	return b.getTopKObjects(topKHeap, params.AdditionalExplanations, allQueryTerms, additional)
 }
 
+// countQueryTerms returns the unique query terms, each boosted by the number
+// of times it occurs in the query
+func countQueryTerms(terms []string) ([]string, []float64) {
+	unique, duplicates := helpers.CountDuplicates(terms)
+	boosts := make([]float64, len(duplicates))
+	for i, count := range duplicates {
+		boosts[i] = float64(count)
+	}
+	return unique, boosts
+}
+
+// analyzerQueryKey is the key the query terms of properties with a custom
+// analyzer are grouped by. It cannot clash with the name of a tokenization.
+func analyzerQueryKey(analyzer string) string {
+	return "analyzer:" + analyzer
+}
+
+// queryTermKeys returns the tokenizations followed by the custom analyzers the
+// searched properties are grouped by, in a stable order
+func queryTermKeys(propNamesByTokenization map[string][]string) []string {
+	keys := make([]string, 0, len(propNamesByTokenization))
+	keys = append(keys, helpers.Tokenizations...)
+	var analyzerKeys []string
+	for key := range propNamesByTokenization {
+		if !slices.Contains(helpers.Tokenizations, key) {
+			analyzerKeys = append(analyzerKeys, key)
+		}
+	}
+	sort.Strings(analyzerKeys)
+	return append(keys, analyzerKeys...)
+}
+
 func (b *BM25Searcher) removeStopwordsFromQueryTerms(queryTerms []string,
-	duplicateBoost []int, detector *stopwords.Detector,
-) ([]string, []int) {
+	queryTermBoosts []float64, detector *stopwords.Detector,
+) ([]string, []float64) {
 	if detector == nil || len(queryTerms) == 0 {
-		return queryTerms, duplicateBoost
+		return queryTerms, queryTermBoosts
 	}
 
 	i := 0
 WordLoop:
 	for {
 		if i == len(queryTerms) {
-			return queryTerms, duplicateBoost
+			return queryTerms, queryTermBoosts
 		}
 		queryTerm := queryTerms[i]
 		if detector.IsStopword(queryTerm) {
 			queryTerms[i] = queryTerms[len(queryTerms)-1]
 			queryTerms = queryTerms[:len(queryTerms)-1]
-			duplicateBoost[i] = duplicateBoost[len(duplicateBoost)-1]
-			duplicateBoost = duplicateBoost[:len(duplicateBoost)-1]
+			queryTermBoosts[i] = queryTermBoosts[len(queryTermBoosts)-1]
+			queryTermBoosts = queryTermBoosts[:len(queryTermBoosts)-1]
 
 			continue WordLoop
 		}
@@ -382,7 +476,22 @@ func (b *BM25Searcher) getTopKObjects(topKHeap *priorityqueue.Queue[[]*terms.Doc
 	return objs, scores, nil
 }
 
-func (b *BM25Searcher) createTerm(N float64, filterDocIds helpers.AllowList, query string, queryTermIndex int, propertyNames []string, propertyBoosts map[string]float32, duplicateTextBoost int, ctx context.Context) (*terms.Term, error) {
+func (b *BM25Searcher) createTerm(N float64, filterDocIds helpers.AllowList, query string, queryTermIndex int, propertyNames []string, propertyBoosts map[string]float32, queryTermBoost float64, ctx context.Context) (*terms.Term, error) {
+	return b.createTermFromPostings(N, filterDocIds, query, queryTermIndex, propertyNames, queryTermBoost,
+		func(propName string) ([]terms.DocPointerWithScore, error) {
+			bucket := b.store.Bucket(helpers.BucketSearchableFromPropNameLSM(propName))
+			if bucket == nil {
+				return nil, fmt.Errorf("could not find bucket for property %v", propName)
+			}
+			return bucket.DocPointerWithScoreList(ctx, []byte(query), propertyBoosts[propName])
+		})
+}
+
+// createTermFromPostings combines the postings of a term, as returned by
+// postings for each of the searched properties, into a single term
+func (b *BM25Searcher) createTermFromPostings(N float64, filterDocIds helpers.AllowList, query string, queryTermIndex int,
+	propertyNames []string, queryTermBoost float64, postings func(propName string) ([]terms.DocPointerWithScore, error),
+) (*terms.Term, error) {
 	termResult := terms.NewTerm(query, queryTermIndex, float32(1.0), b.config)
 
 	var filteredDocIDs *sroar.Bitmap
@@ -402,11 +511,7 @@ func (b *BM25Searcher) createTerm(N float64, filterDocIds helpers.AllowList, que
 
 		eg.Go(
 			func() error {
-				bucket := b.store.Bucket(helpers.BucketSearchableFromPropNameLSM(propName))
-				if bucket == nil {
-					return fmt.Errorf("could not find bucket for property %v", propName)
-				}
-				preM, err := bucket.DocPointerWithScoreList(ctx, []byte(query), propertyBoosts[propName])
+				preM, err := postings(propName)
 				if err != nil {
 					return err
 				}
@@ -469,7 +574,7 @@ func (b *BM25Searcher) createTerm(N float64, filterDocIds helpers.AllowList, que
 		if filterDocIds != nil {
 			n += float64(filteredDocIDs.GetCardinality())
 		}
-		termResult.SetIdf(math.Log(float64(1)+(N-float64(n)+0.5)/(float64(n)+0.5)) * float64(duplicateTextBoost))
+		termResult.SetIdf(math.Log(float64(1)+(N-float64(n)+0.5)/(float64(n)+0.5)) * queryTermBoost)
 		termResult.SetPosPointer(0)
 		termResult.SetIdPointer(termResult.Data[0].Id)
 		return termResult, nil
@@ -544,7 +649,7 @@ func (b *BM25Searcher) createTerm(N float64, filterDocIds helpers.AllowList, que
 	if filterDocIds != nil {
 		n += float64(filteredDocIDs.GetCardinality())
 	}
-	termResult.SetIdf(math.Log(float64(1)+(N-n+0.5)/(n+0.5)) * float64(duplicateTextBoost))
+	termResult.SetIdf(math.Log(float64(1)+(N-n+0.5)/(n+0.5)) * queryTermBoost)
 
 	// catch special case where there are no results and would panic termResult.data[0].id
 	// related to #4125
@@ -32,17 +32,17 @@ import (
 
 // var metrics = lsmkv.BlockMetrics{}
 
-func (b *BM25Searcher) createBlockTerm(N float64, filterDocIds helpers.AllowList, query []string, propName string, propertyBoost float32, duplicateTextBoosts []int, averagePropLength float64, config schema.BM25Config, ctx context.Context) ([][]terms.TermInterface, *sync.RWMutex, error) {
+func (b *BM25Searcher) createBlockTerm(N float64, filterDocIds helpers.AllowList, query []string, propName string, propertyBoost float32, queryTermBoosts []float64, averagePropLength float64, config schema.BM25Config, ctx context.Context) ([][]terms.TermInterface, *sync.RWMutex, error) {
 	bucket := b.store.Bucket(helpers.BucketSearchableFromPropNameLSM(propName))
 	desiredStrategy := bucket.GetDesiredStrategy()
 	if desiredStrategy == lsmkv.StrategyInverted {
-		return bucket.CreateDiskTerm(N, filterDocIds, query, propName, propertyBoost, duplicateTextBoosts, averagePropLength, config, ctx)
+		return bucket.CreateDiskTerm(N, filterDocIds, query, propName, propertyBoost, queryTermBoosts, averagePropLength, config, ctx)
 	} else if desiredStrategy == lsmkv.StrategyMapCollection {
 		term := make([]terms.TermInterface, 0, len(query))
 		for i, queryTerm := range query {
 			propertyBoosts := make(map[string]float32)
 			propertyBoosts[propName] = propertyBoost
-			t, err := b.createTerm(N, filterDocIds, queryTerm, i, []string{propName}, propertyBoosts, duplicateTextBoosts[i], ctx)
+			t, err := b.createTerm(N, filterDocIds, queryTerm, i, []string{propName}, propertyBoosts, queryTermBoosts[i], ctx)
 			if err != nil {
 				return nil, nil, err
 			}
@@ -59,7 +59,7 @@ func (b *BM25Searcher) createBlockTerm(N float64, filterDocIds helpers.AllowList
 func (b *BM25Searcher) wandBlock(
 	ctx context.Context, filterDocIds helpers.AllowList, class *models.Class, params searchparams.KeywordRanking, limit int, additional additional.Properties,
 ) ([]*storobj.Object, []float32, error) {
-	N, propNamesByTokenization, queryTermsByTokenization, duplicateBoostsByTokenization, propertyBoosts, averagePropLength, err := b.generateQueryTermsAndStats(class, params)
+	N, propNamesByTokenization, queryTermsByTokenization, queryTermBoostsByTokenization, propertyBoosts, averagePropLength, err := b.generateQueryTermsAndStats(class, params)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -82,12 +82,12 @@ func (b *BM25Searcher) wandBlock(
 		}
 	}()
 
-	for _, tokenization := range helpers.Tokenizations {
+	for _, tokenization := range queryTermKeys(propNamesByTokenization) {
 		propNames := propNamesByTokenization[tokenization]
 		if len(propNames) > 0 {
-			queryTerms, duplicateBoosts := queryTermsByTokenization[tokenization], duplicateBoostsByTokenization[tokenization]
+			queryTerms, queryTermBoosts := queryTermsByTokenization[tokenization], queryTermBoostsByTokenization[tokenization]
 			for _, propName := range propNames {
-				results, lock, err := b.createBlockTerm(N, filterDocIds, queryTerms, propName, propertyBoosts[propName], duplicateBoosts, averagePropLength, b.config, ctx)
+				results, lock, err := b.createBlockTerm(N, filterDocIds, queryTerms, propName, propertyBoosts[propName], queryTermBoosts, averagePropLength, b.config, ctx)
 				if err != nil {
 					if lock != nil {
 						lock.RUnlock()
@@ -0,0 +1,69 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/weaviate/sroar"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/entities/inverted"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/searchparams"
+)
+
+// DocIDs returns every object BM25F would score, i.e. every object which
+// contains at least one of the query terms in one of the searched properties.
+// The terms of quoted phrases are matched on their own, so objects containing
+// them in a different order are included as well.
+func (b *BM25Searcher) DocIDs(ctx context.Context, filterDocIds helpers.AllowList,
+	className schema.ClassName, keywordRanking searchparams.KeywordRanking,
+) (*sroar.Bitmap, error) {
+	class := b.getClass(className.String())
+	if class == nil {
+		return nil, fmt.Errorf("could not find class %s in schema", className)
+	}
+	for _, property := range keywordRanking.Properties {
+		if !PropertyHasSearchableIndex(class, property) {
+			return nil, inverted.NewMissingSearchableIndexError(property)
+		}
+	}
+
+	_, propNamesByTokenization, queryTermsByTokenization, _, _, _, err := b.generateQueryTermsAndStats(class, keywordRanking)
+	if err != nil {
+		return nil, err
+	}
+
+	docIDs := sroar.NewBitmap()
+	for key, propNames := range propNamesByTokenization {
+		for _, propName := range propNames {
+			bucket := b.store.Bucket(helpers.BucketSearchableFromPropNameLSM(propName))
+			if bucket == nil {
+				return nil, fmt.Errorf("could not find bucket for property %v", propName)
+			}
+			for _, term := range queryTermsByTokenization[key] {
+				postings, err := bucket.DocPointerWithScoreList(ctx, []byte(term), 1)
+				if err != nil {
+					return nil, fmt.Errorf("postings of term %q in property %s: %w", term, propName, err)
+				}
+				for _, posting := range postings {
+					if filterDocIds == nil || filterDocIds.Contains(posting.Id) {
+						docIDs.Set(posting.Id)
+					}
+				}
+			}
+		}
+	}
+
+	return docIDs, nil
+}
@@ -0,0 +1,140 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/terms"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// queryPhrases tokenizes the phrases of a query for each of the tokenizations
+// and analyzers the searched properties are grouped by. Phrases of more than
+// one token can only be searched in properties which index positions.
+func (b *BM25Searcher) queryPhrases(class *models.Class, phrases []string,
+	propNamesByTokenization map[string][]string,
+) (map[string][][]string, error) {
+	if len(phrases) == 0 {
+		return nil, nil
+	}
+
+	var indexPositions []string
+	if class.InvertedIndexConfig != nil {
+		indexPositions = class.InvertedIndexConfig.IndexPositions
+	}
+
+	phrasesByTokenization := map[string][][]string{}
+	for key, propNames := range propNamesByTokenization {
+		if len(propNames) == 0 {
+			continue
+		}
+
+		// all properties of a group share their tokenization or analyzer
+		prop, err := schema.GetPropertyByName(class, propNames[0])
+		if err != nil {
+			return nil, err
+		}
+		textAnalyzer, err := b.textAnalyzers.ForProperty(prop)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, phrase := range phrases {
+			var tokens []string
+			if textAnalyzer != nil {
+				tokens = textAnalyzer.Analyze(phrase)
+			} else {
+				tokens = helpers.Tokenize(prop.Tokenization, phrase)
+			}
+			if len(tokens) == 0 {
+				continue
+			}
+			if len(tokens) > 1 {
+				for _, propName := range propNames {
+					if !slices.Contains(indexPositions, propName) {
+						return nil, fmt.Errorf("phrase queries require property '%s' to be listed in "+
+							"invertedIndexConfig.indexPositions", propName)
+					}
+				}
+			}
+			phrasesByTokenization[key] = append(phrasesByTokenization[key], tokens)
+		}
+	}
+	return phrasesByTokenization, nil
+}
+
+// createPhraseTerm creates a term matching the documents which contain the
+// tokens of phrase in order, with at most slop other tokens in between. The
+// number of matches is used as the frequency of the term.
+func (b *BM25Searcher) createPhraseTerm(N float64, filterDocIds helpers.AllowList, phrase []string, slop int,
+	queryTermIndex int, propertyNames []string, propertyBoosts map[string]float32, queryTermBoost float64,
+	ctx context.Context,
+) (*terms.Term, error) {
+	return b.createTermFromPostings(N, filterDocIds, strings.Join(phrase, " "), queryTermIndex, propertyNames, queryTermBoost,
+		func(propName string) ([]terms.DocPointerWithScore, error) {
+			bucket := b.store.Bucket(helpers.BucketPositionsFromPropNameLSM(propName))
+			if bucket == nil {
+				return nil, fmt.Errorf("could not find positions bucket for property %v", propName)
+			}
+
+			var docs map[uint64][]docPositions
+			for i, token := range phrase {
+				pairs, err := bucket.MapList(ctx, []byte(token))
+				if err != nil {
+					return nil, err
+				}
+
+				next := make(map[uint64][]docPositions, len(pairs))
+				for _, pair := range pairs {
+					docID, positions, err := parsePositionsPair(pair)
+					if err != nil {
+						return nil, err
+					}
+					if i == 0 {
+						next[docID] = []docPositions{positions}
+					} else if prev, ok := docs[docID]; ok {
+						next[docID] = append(prev, positions)
+					}
+				}
+				docs = next
+				if len(docs) == 0 {
+					return nil, nil
+				}
+			}
+
+			postings := make([]terms.DocPointerWithScore, 0, len(docs))
+			tokenPositions := make([][]uint32, len(phrase))
+			for docID, positions := range docs {
+				for i := range positions {
+					tokenPositions[i] = positions[i].positions
+				}
+				freq := phraseFrequency(tokenPositions, slop)
+				if freq == 0 {
+					continue
+				}
+				postings = append(postings, terms.DocPointerWithScore{
+					Id:         docID,
+					Frequency:  float32(freq) * propertyBoosts[propName],
+					PropLength: positions[0].propLen,
+				})
+			}
+			sort.Slice(postings, func(i, j int) bool { return postings[i].Id < postings[j].Id })
+			return postings, nil
+		})
+}
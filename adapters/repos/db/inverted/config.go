@@ -39,6 +39,16 @@ func ValidateConfig(conf *models.InvertedIndexConfig) error {
 		return err
 	}
 
+	err = ValidateTextAnalyzers(conf)
+	if err != nil {
+		return err
+	}
+
+	err = ValidateSynonyms(conf.Synonyms)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -48,6 +58,9 @@ func ConfigFromModel(iicm *models.InvertedIndexConfig) schema.InvertedIndexConfi
 	conf.IndexTimestamps = iicm.IndexTimestamps
 	conf.IndexNullState = iicm.IndexNullState
 	conf.IndexPropertyLength = iicm.IndexPropertyLength
+	conf.Analyzers = iicm.Analyzers
+	conf.Synonyms = iicm.Synonyms
+	conf.IndexPositions = iicm.IndexPositions
 
 	if iicm.Bm25 == nil {
 		conf.BM25.K1 = float64(config.DefaultBM25k1)
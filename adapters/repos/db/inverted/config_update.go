@@ -12,6 +12,9 @@
 package inverted
 
 import (
+	"reflect"
+	"slices"
+
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/entities/models"
 )
@@ -36,6 +39,19 @@ func ValidateUserConfigUpdate(initial, updated *models.InvertedIndexConfig) erro
 		return err
 	}
 
+	err = validateTextAnalyzersUpdate(initial, updated)
+	if err != nil {
+		return err
+	}
+	if updated.Analyzers == nil {
+		updated.Analyzers = slices.Clone(initial.Analyzers)
+	}
+
+	err = validateSynonymsUpdate(initial, updated)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -65,6 +81,12 @@ func validateInvertedIndexConfigUpdate(initial, updated *models.InvertedIndexCon
 		return errors.New("IndexNullState cannot be changed when updating a schema")
 	}
 
+	if updated.IndexPositions == nil {
+		updated.IndexPositions = initial.IndexPositions
+	} else if !slices.Equal(updated.IndexPositions, initial.IndexPositions) {
+		return errors.New("IndexPositions cannot be changed when updating a schema")
+	}
+
 	return nil
 }
 
@@ -85,3 +107,39 @@ func validateStopwordsConfigUpdate(initial, updated *models.InvertedIndexConfig)
 
 	return nil
 }
+
+// validateSynonymsUpdate allows any change of the synonyms, as they are
+// only applied at query time
+func validateSynonymsUpdate(initial, updated *models.InvertedIndexConfig) error {
+	if updated.Synonyms == nil {
+		updated.Synonyms = initial.Synonyms
+		return nil
+	}
+
+	return ValidateSynonyms(updated.Synonyms)
+}
+
+// validateTextAnalyzersUpdate only allows adding analyzers, as existing ones
+// were used to build the index of the properties referring to them. Missing
+// analyzers are inherited, which is validated on a copy of updated.
+func validateTextAnalyzersUpdate(initial, updated *models.InvertedIndexConfig) error {
+	conf := *updated
+	if conf.Analyzers == nil {
+		conf.Analyzers = initial.Analyzers
+	}
+
+	for _, analyzer := range initial.Analyzers {
+		if analyzer == nil {
+			continue
+		}
+		updatedAnalyzer := findTextAnalyzerConfig(&conf, analyzer.Name)
+		if updatedAnalyzer == nil {
+			return errors.Errorf("analyzer %q cannot be removed when updating a schema", analyzer.Name)
+		}
+		if !reflect.DeepEqual(analyzer, updatedAnalyzer) {
+			return errors.Errorf("analyzer %q cannot be changed when updating a schema", analyzer.Name)
+		}
+	}
+
+	return ValidateTextAnalyzers(&conf)
+}
@@ -138,4 +138,139 @@ func TestValidateUserConfigUpdate(t *testing.T) {
 		err := ValidateUserConfigUpdate(validInitial, updated)
 		require.EqualError(t, err, "IndexPropertyLength cannot be changed when updating a schema")
 	})
+
+	t.Run("with analyzers", func(t *testing.T) {
+		stemmed := &models.TextAnalyzerConfig{
+			Name:      "stemmed",
+			Tokenizer: models.PropertyTokenizationWord,
+			TokenFilters: []*models.TextAnalyzerTokenFilter{
+				{Type: models.TextAnalyzerTokenFilterTypeStemmer},
+			},
+		}
+		folded := &models.TextAnalyzerConfig{
+			Name:      "folded",
+			Tokenizer: models.PropertyTokenizationWhitespace,
+			TokenFilters: []*models.TextAnalyzerTokenFilter{
+				{Type: models.TextAnalyzerTokenFilterTypeAsciifold},
+			},
+		}
+		initial := &models.InvertedIndexConfig{
+			CleanupIntervalSeconds: 1,
+			Bm25:                   validInitial.Bm25,
+			Stopwords:              validInitial.Stopwords,
+			Analyzers:              []*models.TextAnalyzerConfig{stemmed},
+		}
+
+		t.Run("are inherited if missing", func(t *testing.T) {
+			updated := &models.InvertedIndexConfig{CleanupIntervalSeconds: 1}
+
+			require.Nil(t, ValidateUserConfigUpdate(initial, updated))
+			assert.Equal(t, initial.Analyzers, updated.Analyzers)
+		})
+
+		t.Run("can be added", func(t *testing.T) {
+			updated := &models.InvertedIndexConfig{
+				CleanupIntervalSeconds: 1,
+				Analyzers:              []*models.TextAnalyzerConfig{stemmed, folded},
+			}
+
+			require.Nil(t, ValidateUserConfigUpdate(initial, updated))
+		})
+
+		t.Run("cannot be removed", func(t *testing.T) {
+			updated := &models.InvertedIndexConfig{
+				CleanupIntervalSeconds: 1,
+				Analyzers:              []*models.TextAnalyzerConfig{folded},
+			}
+
+			err := ValidateUserConfigUpdate(initial, updated)
+			require.EqualError(t, err, `analyzer "stemmed" cannot be removed when updating a schema`)
+		})
+
+		t.Run("cannot be changed", func(t *testing.T) {
+			changed := *stemmed
+			changed.Tokenizer = models.PropertyTokenizationLowercase
+			updated := &models.InvertedIndexConfig{
+				CleanupIntervalSeconds: 1,
+				Analyzers:              []*models.TextAnalyzerConfig{&changed},
+			}
+
+			err := ValidateUserConfigUpdate(initial, updated)
+			require.EqualError(t, err, `analyzer "stemmed" cannot be changed when updating a schema`)
+		})
+
+		t.Run("must be valid", func(t *testing.T) {
+			updated := &models.InvertedIndexConfig{
+				CleanupIntervalSeconds: 1,
+				Analyzers: []*models.TextAnalyzerConfig{stemmed, {
+					Name:      "invalid",
+					Tokenizer: "unknown",
+				}},
+			}
+
+			err := ValidateUserConfigUpdate(initial, updated)
+			require.EqualError(t, err, `analyzer "invalid": tokenizer "unknown" is not supported`)
+		})
+	})
+
+	t.Run("with synonyms", func(t *testing.T) {
+		initial := &models.InvertedIndexConfig{
+			CleanupIntervalSeconds: 1,
+			Bm25:                   validInitial.Bm25,
+			Stopwords:              validInitial.Stopwords,
+			Synonyms:               &models.SynonymConfig{Groups: []string{"laptop, notebook"}},
+		}
+
+		t.Run("are inherited if missing", func(t *testing.T) {
+			updated := &models.InvertedIndexConfig{CleanupIntervalSeconds: 1}
+
+			require.Nil(t, ValidateUserConfigUpdate(initial, updated))
+			assert.Equal(t, initial.Synonyms, updated.Synonyms)
+		})
+
+		t.Run("can be changed", func(t *testing.T) {
+			updated := &models.InvertedIndexConfig{
+				CleanupIntervalSeconds: 1,
+				Synonyms:               &models.SynonymConfig{Groups: []string{"tv, television"}, Boost: 0.5},
+			}
+
+			require.Nil(t, ValidateUserConfigUpdate(initial, updated))
+		})
+
+		t.Run("must be valid", func(t *testing.T) {
+			updated := &models.InvertedIndexConfig{
+				CleanupIntervalSeconds: 1,
+				Synonyms:               &models.SynonymConfig{Groups: []string{"tv"}},
+			}
+
+			err := ValidateUserConfigUpdate(initial, updated)
+			require.EqualError(t, err, `synonym group "tv" requires at least two tokens`)
+		})
+	})
+
+	t.Run("with index positions", func(t *testing.T) {
+		initial := &models.InvertedIndexConfig{
+			CleanupIntervalSeconds: 1,
+			Bm25:                   validInitial.Bm25,
+			Stopwords:              validInitial.Stopwords,
+			IndexPositions:         []string{"title"},
+		}
+
+		t.Run("are inherited if missing", func(t *testing.T) {
+			updated := &models.InvertedIndexConfig{CleanupIntervalSeconds: 1}
+
+			require.Nil(t, ValidateUserConfigUpdate(initial, updated))
+			assert.Equal(t, initial.IndexPositions, updated.IndexPositions)
+		})
+
+		t.Run("cannot be changed", func(t *testing.T) {
+			updated := &models.InvertedIndexConfig{
+				CleanupIntervalSeconds: 1,
+				IndexPositions:         []string{"title", "body"},
+			}
+
+			err := ValidateUserConfigUpdate(initial, updated)
+			require.EqualError(t, err, "IndexPositions cannot be changed when updating a schema")
+		})
+	})
 }
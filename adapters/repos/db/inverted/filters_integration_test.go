@@ -86,7 +86,7 @@ func Test_Filters_String(t *testing.T) {
 	bitmapFactory := roaringset.NewBitmapFactory(newFakeMaxIDGetter(200), logger)
 
 	searcher := NewSearcher(logger, store, createSchema().GetClass, nil, nil,
-		fakeStopwordDetector{}, 2, func() bool { return false }, "",
+		fakeStopwordDetector{}, nil, 2, func() bool { return false }, "",
 		config.DefaultQueryNestedCrossReferenceLimit, bitmapFactory)
 
 	type test struct {
@@ -133,6 +133,42 @@ func Test_Filters_String(t *testing.T) {
 			expectedListBeforeUpdate: helpers.NewAllowList(10, 11, 12, 13, 14, 15, 16),
 			expectedListAfterUpdate:  helpers.NewAllowList(10, 11, 12, 13, 14, 15, 16, 17),
 		},
+		{
+			name: "prefix operator",
+			filter: &filters.LocalFilter{
+				Root: &filters.Clause{
+					Operator: filters.OperatorPrefix,
+					On: &filters.Path{
+						Class:    "foo",
+						Property: schema.PropertyName(propName),
+					},
+					Value: &filters.Value{
+						Value: "modulo-1",
+						Type:  schema.DataTypeText,
+					},
+				},
+			},
+			expectedListBeforeUpdate: helpers.NewAllowList(10, 11, 12, 13, 14, 15, 16),
+			expectedListAfterUpdate:  helpers.NewAllowList(10, 11, 12, 13, 14, 15, 16, 17),
+		},
+		{
+			name: "regex operator",
+			filter: &filters.LocalFilter{
+				Root: &filters.Clause{
+					Operator: filters.OperatorRegex,
+					On: &filters.Path{
+						Class:    "foo",
+						Property: schema.PropertyName(propName),
+					},
+					Value: &filters.Value{
+						Value: "modulo-1[3-7]",
+						Type:  schema.DataTypeText,
+					},
+				},
+			},
+			expectedListBeforeUpdate: helpers.NewAllowList(13, 14, 15, 16),
+			expectedListAfterUpdate:  helpers.NewAllowList(13, 14, 15, 16, 17),
+		},
 		{
 			name: "exact match - or filter",
 			filter: &filters.LocalFilter{
@@ -341,7 +377,7 @@ func Test_Filters_Int(t *testing.T) {
 
 	bitmapFactory := roaringset.NewBitmapFactory(newFakeMaxIDGetter(maxDocID), logger)
 	searcher := NewSearcher(logger, store, createSchema().GetClass, nil, nil,
-		fakeStopwordDetector{}, 2, func() bool { return false }, "",
+		fakeStopwordDetector{}, nil, 2, func() bool { return false }, "",
 		config.DefaultQueryNestedCrossReferenceLimit, bitmapFactory)
 
 	type test struct {
@@ -1058,7 +1094,7 @@ func Test_Filters_String_DuplicateEntriesInAnd(t *testing.T) {
 	bitmapFactory := roaringset.NewBitmapFactory(newFakeMaxIDGetter(200), logger)
 
 	searcher := NewSearcher(logger, store, createSchema().GetClass, nil, nil,
-		fakeStopwordDetector{}, 2, func() bool { return false }, "",
+		fakeStopwordDetector{}, nil, 2, func() bool { return false }, "",
 		config.DefaultQueryNestedCrossReferenceLimit, bitmapFactory)
 
 	type test struct {
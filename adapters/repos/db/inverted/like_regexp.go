@@ -14,16 +14,34 @@ package inverted
 import (
 	"bytes"
 	"regexp"
+	"regexp/syntax"
 
 	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/filters"
 )
 
+// likeRegexp matches the keys of a bucket. If it is optimizable, all matching
+// keys start with min, so a cursor can seek to min and stop as soon as the
+// keys no longer start with it. A nil regexp matches every key in that range.
 type likeRegexp struct {
 	optimizable bool
 	min         []byte
 	regexp      *regexp.Regexp
 }
 
+// parseOperatorRegexp parses the value of any operator which is served by
+// scanning the ordered keys of a bucket, i.e. Like, Prefix and Regex
+func parseOperatorRegexp(operator filters.Operator, in []byte) (*likeRegexp, error) {
+	switch operator {
+	case filters.OperatorPrefix:
+		return parsePrefixRegexp(in), nil
+	case filters.OperatorRegex:
+		return parseRegexRegexp(in)
+	default:
+		return parseLikeRegexp(in)
+	}
+}
+
 func parseLikeRegexp(in []byte) (*likeRegexp, error) {
 	r, err := regexp.Compile(transformLikeStringToRegexp(in))
 	if err != nil {
@@ -60,3 +78,64 @@ func optimizable(in []byte) ([]byte, bool) {
 func isWildcardCharacter(in byte) bool {
 	return in == '?' || in == '*'
 }
+
+func parsePrefixRegexp(in []byte) *likeRegexp {
+	return &likeRegexp{
+		min:         in,
+		optimizable: len(in) > 0,
+	}
+}
+
+// parseRegexRegexp parses a RE2 pattern which has to match an entire key.
+// The literal characters the pattern starts with bound the keys to scan.
+func parseRegexRegexp(in []byte) (*likeRegexp, error) {
+	parsed, err := syntax.Parse(string(in), syntax.Perl)
+	if err != nil {
+		return nil, errors.Wrap(err, "parse regex")
+	}
+
+	// anchor the re-serialized pattern, so that alternations in the original
+	// pattern can't escape the anchors
+	r, err := regexp.Compile("^(?:" + parsed.String() + ")$")
+	if err != nil {
+		return nil, errors.Wrap(err, "compile regex")
+	}
+
+	min, _ := literalPrefix(parsed)
+	return &likeRegexp{
+		regexp:      r,
+		min:         min,
+		optimizable: len(min) > 0,
+	}, nil
+}
+
+// literalPrefix returns the case-sensitive literal every match of re starts
+// with and whether re consists of nothing but that literal
+func literalPrefix(re *syntax.Regexp) ([]byte, bool) {
+	switch re.Op {
+	case syntax.OpLiteral:
+		if re.Flags&syntax.FoldCase != 0 {
+			return nil, false
+		}
+		return []byte(string(re.Rune)), true
+	case syntax.OpBeginText, syntax.OpEmptyMatch:
+		return nil, true
+	case syntax.OpCapture:
+		return literalPrefix(re.Sub[0])
+	case syntax.OpPlus:
+		prefix, _ := literalPrefix(re.Sub[0])
+		return prefix, false
+	case syntax.OpConcat:
+		var prefix []byte
+		for _, sub := range re.Sub {
+			subPrefix, complete := literalPrefix(sub)
+			prefix = append(prefix, subPrefix...)
+			if !complete {
+				return prefix, false
+			}
+		}
+		return prefix, true
+	default:
+		return nil, false
+	}
+}
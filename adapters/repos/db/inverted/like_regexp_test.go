@@ -12,6 +12,7 @@
 package inverted
 
 import (
+	"bytes"
 	"fmt"
 	"testing"
 
@@ -162,3 +163,48 @@ func TestLikeRegexp_ForOptimizability(t *testing.T) {
 
 	run(t, tests)
 }
+
+func TestRegexRegexp(t *testing.T) {
+	type test struct {
+		input       string
+		expectedMin []byte
+		matches     []string
+		mismatches  []string
+	}
+
+	tests := []test{
+		{input: "car", expectedMin: []byte("car"), matches: []string{"car"}, mismatches: []string{"care", "supercar"}},
+		{input: "car.*", expectedMin: []byte("car"), matches: []string{"car", "caretaker"}, mismatches: []string{"scar"}},
+		{input: "^car[st]?$", expectedMin: []byte("car"), matches: []string{"cars", "cart"}, mismatches: []string{"carx"}},
+		{input: "(car)s+", expectedMin: []byte("cars"), matches: []string{"cars", "carss"}, mismatches: []string{"car"}},
+		{input: "cars?", expectedMin: []byte("car"), matches: []string{"car", "cars"}},
+		{input: "car|cat", expectedMin: []byte("ca"), matches: []string{"car", "cat"}, mismatches: []string{"cab"}},
+		{input: "car|bus", expectedMin: nil, matches: []string{"car", "bus"}, mismatches: []string{"carbus"}},
+		{input: "(?i)car", expectedMin: nil, matches: []string{"car", "CAR"}},
+		{input: ".*car", expectedMin: nil, matches: []string{"car", "supercar"}, mismatches: []string{"cars"}},
+		{input: "Ü\\d+", expectedMin: []byte("Ü"), matches: []string{"Ü12"}, mismatches: []string{"ü12"}},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("for input %q", test.input), func(t *testing.T) {
+			res, err := parseRegexRegexp([]byte(test.input))
+			require.Nil(t, err)
+			assert.Equal(t, test.expectedMin, res.min)
+			assert.Equal(t, len(test.expectedMin) > 0, res.optimizable)
+			for _, subject := range test.matches {
+				assert.True(t, res.regexp.MatchString(subject), subject)
+				if res.optimizable {
+					assert.True(t, bytes.HasPrefix([]byte(subject), res.min), subject)
+				}
+			}
+			for _, subject := range test.mismatches {
+				assert.False(t, res.regexp.MatchString(subject), subject)
+			}
+		})
+	}
+
+	t.Run("invalid pattern", func(t *testing.T) {
+		_, err := parseRegexRegexp([]byte("car("))
+		assert.NotNil(t, err)
+	})
+}
@@ -0,0 +1,151 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"encoding/binary"
+	"math"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+)
+
+// positionGap separates the positions of consecutive elements of text
+// arrays, so that phrases do not match across elements
+const positionGap = 100
+
+// PairPositions builds the entry of the positions bucket for a term of a
+// property. The key is the doc id, the value holds the length of the property
+// followed by the positions of the term.
+//
+// Key (term) | Value (doc id -> prop length, positions)
+// quick      | 1 -> 4.0, [1], 7 -> 9.0, [0, 5]
+func PairPositions(docID uint64, propLen float32, positions []uint32) lsmkv.MapPair {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, docID)
+
+	value := make([]byte, 4+4*len(positions))
+	binary.LittleEndian.PutUint32(value[0:4], math.Float32bits(propLen))
+	for i, pos := range positions {
+		binary.LittleEndian.PutUint32(value[4+4*i:], pos)
+	}
+
+	return lsmkv.MapPair{Key: key, Value: value}
+}
+
+type docPositions struct {
+	propLen   float32
+	positions []uint32
+}
+
+func parsePositionsPair(pair lsmkv.MapPair) (uint64, docPositions, error) {
+	if len(pair.Key) != 8 || len(pair.Value) < 4 || len(pair.Value)%4 != 0 {
+		return 0, docPositions{}, errors.Errorf("invalid positions entry: key length %d, value length %d",
+			len(pair.Key), len(pair.Value))
+	}
+
+	positions := make([]uint32, (len(pair.Value)-4)/4)
+	for i := range positions {
+		positions[i] = binary.LittleEndian.Uint32(pair.Value[4+4*i:])
+	}
+	return binary.BigEndian.Uint64(pair.Key), docPositions{
+		propLen:   math.Float32frombits(binary.LittleEndian.Uint32(pair.Value[0:4])),
+		positions: positions,
+	}, nil
+}
+
+// countTermsWithPositions works like countTerms, but also records where the
+// terms occur in the token stream of the analyzed values
+func countTermsWithPositions(inArr []string, analyze func(string) []string) []Countable {
+	var terms []string
+	positions := map[string][]uint32{}
+	pos := uint32(0)
+	for i, in := range inArr {
+		if i > 0 {
+			pos += positionGap
+		}
+		for _, term := range analyze(in) {
+			if _, ok := positions[term]; !ok {
+				terms = append(terms, term)
+			}
+			positions[term] = append(positions[term], pos)
+			pos++
+		}
+	}
+
+	countable := make([]Countable, len(terms))
+	for i, term := range terms {
+		countable[i] = Countable{
+			Data:          []byte(term),
+			TermFrequency: float32(len(positions[term])),
+			Positions:     positions[term],
+		}
+	}
+	return countable
+}
+
+// splitPhrases extracts the double quoted phrases of a keyword query and
+// returns them along with the remaining query. An unterminated quote extends
+// to the end of the query.
+func splitPhrases(query string) (string, []string) {
+	if !strings.Contains(query, `"`) {
+		return query, nil
+	}
+
+	var rest strings.Builder
+	var phrases []string
+	for i, part := range strings.Split(query, `"`) {
+		if i%2 == 0 {
+			rest.WriteString(part)
+			rest.WriteString(" ")
+		} else if strings.TrimSpace(part) != "" {
+			phrases = append(phrases, part)
+		}
+	}
+	return strings.TrimSpace(rest.String()), phrases
+}
+
+// phraseFrequency counts how often the tokens of a phrase occur in order with
+// at most slop other tokens in between. positions holds the sorted positions
+// of each token of the phrase. Every occurrence of the first token starts at
+// most one match, which extends to the earliest following position of each
+// subsequent token.
+func phraseFrequency(positions [][]uint32, slop int) int {
+	if len(positions) == 0 {
+		return 0
+	}
+
+	freq := 0
+	next := make([]int, len(positions))
+	for _, start := range positions[0] {
+		prev, matched := start, true
+		for i := 1; i < len(positions); i++ {
+			for next[i] < len(positions[i]) && positions[i][next[i]] <= prev {
+				next[i]++
+			}
+			if next[i] == len(positions[i]) {
+				matched = false
+				break
+			}
+			prev = positions[i][next[i]]
+		}
+		if !matched {
+			// later starts cannot find the missing token either
+			break
+		}
+		if int(prev-start)-(len(positions)-1) <= slop {
+			freq++
+		}
+	}
+	return freq
+}
@@ -0,0 +1,94 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestSplitPhrases(t *testing.T) {
+	tests := []struct {
+		query   string
+		rest    string
+		phrases []string
+	}{
+		{query: "quick brown fox", rest: "quick brown fox"},
+		{query: `"quick brown" fox`, rest: "fox", phrases: []string{"quick brown"}},
+		{query: `the "quick brown" fox "lazy dog"`, rest: "the   fox", phrases: []string{"quick brown", "lazy dog"}},
+		{query: `fox "" dog`, rest: "fox   dog"},
+		{query: `fox "lazy dog`, rest: "fox", phrases: []string{"lazy dog"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.query, func(t *testing.T) {
+			rest, phrases := splitPhrases(test.query)
+			assert.Equal(t, test.rest, rest)
+			assert.Equal(t, test.phrases, phrases)
+		})
+	}
+}
+
+func TestPhraseFrequency(t *testing.T) {
+	tests := []struct {
+		name      string
+		positions [][]uint32
+		slop      int
+		expected  int
+	}{
+		{name: "exact", positions: [][]uint32{{0}, {1}}, expected: 1},
+		{name: "reversed", positions: [][]uint32{{1}, {0}}, slop: 5, expected: 0},
+		{name: "gap without slop", positions: [][]uint32{{0}, {2}}, expected: 0},
+		{name: "gap within slop", positions: [][]uint32{{0}, {2}}, slop: 1, expected: 1},
+		{name: "multiple matches", positions: [][]uint32{{0, 5, 9}, {1, 6}}, expected: 2},
+		{name: "three tokens", positions: [][]uint32{{0, 10}, {3, 11}, {4, 12}}, slop: 2, expected: 2},
+		{name: "three tokens exceeding slop", positions: [][]uint32{{0}, {3}, {5}}, slop: 2, expected: 0},
+		{name: "repeated token", positions: [][]uint32{{0, 1, 2}, {0, 1, 2}}, expected: 2},
+		{name: "missing token", positions: [][]uint32{{0}, {}}, expected: 0},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, phraseFrequency(test.positions, test.slop))
+		})
+	}
+}
+
+func TestCountTermsWithPositions(t *testing.T) {
+	analyze := func(in string) []string {
+		return helpers.Tokenize(models.PropertyTokenizationWord, in)
+	}
+
+	countable := countTermsWithPositions([]string{"the quick fox", "the fox"}, analyze)
+	assert.Equal(t, []Countable{
+		{Data: []byte("the"), TermFrequency: 2, Positions: []uint32{0, 103}},
+		{Data: []byte("quick"), TermFrequency: 1, Positions: []uint32{1}},
+		{Data: []byte("fox"), TermFrequency: 2, Positions: []uint32{2, 104}},
+	}, countable)
+}
+
+func TestPairPositions(t *testing.T) {
+	pair := PairPositions(17, 4, []uint32{1, 3})
+
+	docID, positions, err := parsePositionsPair(pair)
+	require.Nil(t, err)
+	assert.Equal(t, uint64(17), docID)
+	assert.Equal(t, docPositions{propLen: 4, positions: []uint32{1, 3}}, positions)
+
+	pair.Value = pair.Value[:6]
+	_, _, err = parsePositionsPair(pair)
+	assert.NotNil(t, err)
+}
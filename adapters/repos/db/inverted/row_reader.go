@@ -16,6 +16,7 @@ import (
 	"context"
 	"encoding/binary"
 	"fmt"
+	"strings"
 
 	"github.com/weaviate/sroar"
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
@@ -64,7 +65,7 @@ func (rr *RowReader) Read(ctx context.Context, readFn ReadFn) error {
 		return rr.lessThan(ctx, readFn, false)
 	case filters.OperatorLessThanEqual:
 		return rr.lessThan(ctx, readFn, true)
-	case filters.OperatorLike:
+	case filters.OperatorLike, filters.OperatorPrefix, filters.OperatorRegex:
 		return rr.like(ctx, readFn)
 	case filters.OperatorIsNull: // we need to fetch a row with a given value (there is only nil and !nil) and can reuse equal to get the correct row
 		return rr.equal(ctx, readFn)
@@ -160,9 +161,9 @@ func (rr *RowReader) lessThan(ctx context.Context, readFn ReadFn,
 }
 
 func (rr *RowReader) like(ctx context.Context, readFn ReadFn) error {
-	like, err := parseLikeRegexp(rr.value)
+	like, err := parseOperatorRegexp(rr.operator, rr.value)
 	if err != nil {
-		return fmt.Errorf("parse like value: %w", err)
+		return fmt.Errorf("parse %s value: %w", strings.ToLower(rr.operator.Name()), err)
 	}
 
 	c := rr.newCursor()
@@ -197,7 +198,7 @@ func (rr *RowReader) like(ctx context.Context, readFn ReadFn) error {
 			}
 		}
 
-		if !like.regexp.Match(k) {
+		if like.regexp != nil && !like.regexp.Match(k) {
 			continue
 		}
 
@@ -16,6 +16,7 @@ import (
 	"context"
 	"encoding/binary"
 	"fmt"
+	"strings"
 
 	"github.com/weaviate/sroar"
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
@@ -61,7 +62,7 @@ func (rr *RowReaderFrequency) Read(ctx context.Context, readFn ReadFn) error {
 		return rr.lessThan(ctx, readFn, false)
 	case filters.OperatorLessThanEqual:
 		return rr.lessThan(ctx, readFn, true)
-	case filters.OperatorLike:
+	case filters.OperatorLike, filters.OperatorPrefix, filters.OperatorRegex:
 		return rr.like(ctx, readFn)
 	default:
 		return fmt.Errorf("operator %v supported", rr.operator)
@@ -155,9 +156,9 @@ func (rr *RowReaderFrequency) lessThan(ctx context.Context, readFn ReadFn,
 }
 
 func (rr *RowReaderFrequency) like(ctx context.Context, readFn ReadFn) error {
-	like, err := parseLikeRegexp(rr.value)
+	like, err := parseOperatorRegexp(rr.operator, rr.value)
 	if err != nil {
-		return fmt.Errorf("parse like value: %w", err)
+		return fmt.Errorf("parse %s value: %w", strings.ToLower(rr.operator.Name()), err)
 	}
 
 	// TODO: don't we need to check here if this is a doc id vs a object search?
@@ -194,7 +195,7 @@ func (rr *RowReaderFrequency) like(ctx context.Context, readFn ReadFn) error {
 			}
 		}
 
-		if !like.regexp.Match(k) {
+		if like.regexp != nil && !like.regexp.Match(k) {
 			continue
 		}
 
@@ -15,6 +15,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/weaviate/sroar"
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
@@ -85,7 +86,7 @@ func (rr *RowReaderRoaringSet) Read(ctx context.Context, readFn ReadFn) error {
 		return rr.lessThan(ctx, readFn, false)
 	case filters.OperatorLessThanEqual:
 		return rr.lessThan(ctx, readFn, true)
-	case filters.OperatorLike:
+	case filters.OperatorLike, filters.OperatorPrefix, filters.OperatorRegex:
 		return rr.like(ctx, readFn)
 	default:
 		return fmt.Errorf("operator %v not supported", rr.operator)
@@ -178,9 +179,9 @@ func (rr *RowReaderRoaringSet) lessThan(ctx context.Context,
 func (rr *RowReaderRoaringSet) like(ctx context.Context,
 	readFn ReadFn,
 ) error {
-	like, err := parseLikeRegexp(rr.value)
+	like, err := parseOperatorRegexp(rr.operator, rr.value)
 	if err != nil {
-		return fmt.Errorf("parse like value: %w", err)
+		return fmt.Errorf("parse %s value: %w", strings.ToLower(rr.operator.Name()), err)
 	}
 
 	c := rr.newCursor()
@@ -216,7 +217,7 @@ func (rr *RowReaderRoaringSet) like(ctx context.Context,
 			}
 		}
 
-		if !like.regexp.Match(k) {
+		if like.regexp != nil && !like.regexp.Match(k) {
 			continue
 		}
 
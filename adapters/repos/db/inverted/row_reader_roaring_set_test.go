@@ -180,6 +180,37 @@ func TestRowReaderRoaringSet(t *testing.T) {
 				{"hhh", []uint64{11111111, 2222222, 33333333}},
 			},
 		},
+		{
+			name:     "prefix 'gg' value",
+			value:    "gg",
+			operator: filters.OperatorPrefix,
+			expected: []kvData{
+				{"ggg", []uint64{1111111, 2222222, 3333333}},
+			},
+		},
+		{
+			name:     "prefix non-matching value",
+			value:    "gh",
+			operator: filters.OperatorPrefix,
+			expected: []kvData{},
+		},
+		{
+			name:     "regex 'c.c|ddd' value",
+			value:    "c.c|ddd",
+			operator: filters.OperatorRegex,
+			expected: []kvData{
+				{"ccc", []uint64{111, 222, 333}},
+				{"ddd", []uint64{1111, 2222, 3333}},
+			},
+		},
+		{
+			name:     "regex 'e+' value",
+			value:    "e+",
+			operator: filters.OperatorRegex,
+			expected: []kvData{
+				{"eee", []uint64{11111, 22222, 33333}},
+			},
+		},
 	}
 
 	for _, tc := range testcases {
@@ -15,8 +15,10 @@ import (
 	"context"
 	"encoding/binary"
 	"fmt"
+	"regexp/syntax"
 	"strconv"
 	"time"
+	"unicode"
 
 	enterrors "github.com/weaviate/weaviate/entities/errors"
 
@@ -45,6 +47,7 @@ type Searcher struct {
 	classSearcher          ClassSearcher // to allow recursive searches on ref-props
 	propIndices            propertyspecific.Indices
 	stopwords              stopwords.StopwordDetector
+	textAnalyzers          *TextAnalyzers
 	shardVersion           uint16
 	isFallbackToSearchable IsFallbackToSearchable
 	tenant                 string
@@ -56,7 +59,7 @@ type Searcher struct {
 func NewSearcher(logger logrus.FieldLogger, store *lsmkv.Store,
 	getClass func(string) *models.Class, propIndices propertyspecific.Indices,
 	classSearcher ClassSearcher, stopwords stopwords.StopwordDetector,
-	shardVersion uint16, isFallbackToSearchable IsFallbackToSearchable,
+	textAnalyzers *TextAnalyzers, shardVersion uint16, isFallbackToSearchable IsFallbackToSearchable,
 	tenant string, nestedCrossRefLimit int64, bitmapFactory *roaringset.BitmapFactory,
 ) *Searcher {
 	return &Searcher{
@@ -66,6 +69,7 @@ func NewSearcher(logger logrus.FieldLogger, store *lsmkv.Store,
 		propIndices:            propIndices,
 		classSearcher:          classSearcher,
 		stopwords:              stopwords,
+		textAnalyzers:          textAnalyzers,
 		shardVersion:           shardVersion,
 		isFallbackToSearchable: isFallbackToSearchable,
 		tenant:                 tenant,
@@ -564,10 +568,31 @@ func (s *Searcher) extractTokenizableProp(prop *models.Property, propType schema
 	case schema.DataTypeText:
 		// if the operator is like, we cannot apply the regular text-splitting
 		// logic as it would remove all wildcard symbols
-		if operator == filters.OperatorLike {
+		switch operator {
+		case filters.OperatorLike:
 			terms = helpers.TokenizeWithWildcards(prop.Tokenization, valueString)
-		} else {
+		case filters.OperatorPrefix:
+			if _, err := lowercasesTerms(operator, prop.Tokenization); err != nil {
+				return nil, err
+			}
 			terms = helpers.Tokenize(prop.Tokenization, valueString)
+		case filters.OperatorRegex:
+			// a pattern is matched against individual terms as a whole
+			pattern, err := regexForTokenization(prop.Tokenization, valueString)
+			if err != nil {
+				return nil, err
+			}
+			terms = []string{pattern}
+		default:
+			textAnalyzer, err := s.textAnalyzers.ForProperty(prop)
+			if err != nil {
+				return nil, err
+			}
+			if textAnalyzer != nil {
+				terms = textAnalyzer.Analyze(valueString)
+			} else {
+				terms = helpers.Tokenize(prop.Tokenization, valueString)
+			}
 		}
 	default:
 		return nil, fmt.Errorf("expected value type to be text, got %v", propType)
@@ -583,7 +608,11 @@ func (s *Searcher) extractTokenizableProp(prop *models.Property, propType schema
 
 	propValuePairs := make([]*propValuePair, 0, len(terms))
 	for _, term := range terms {
-		if s.stopwords.IsStopword(term) {
+		// stopwords are only dropped for exact matches, a stopword may still be
+		// the beginning of other terms. Custom analyzers drop stopwords by
+		// their own stop filter.
+		if operator != filters.OperatorPrefix && operator != filters.OperatorRegex &&
+			prop.Analyzer == "" && s.stopwords.IsStopword(term) {
 			continue
 		}
 		propValuePairs = append(propValuePairs, &propValuePair{
@@ -607,6 +636,52 @@ func (s *Searcher) extractTokenizableProp(prop *models.Property, propType schema
 		"Stopwords can be configured in class.invertedIndexConfig.stopwords")
 }
 
+// regexForTokenization rewrites a regex pattern for tokenizations which
+// lowercase their terms. The pattern is matched case-insensitively, but all
+// literals are lowercased so that they can still bound the scanned terms.
+func regexForTokenization(tokenization, pattern string) (string, error) {
+	lowercased, err := lowercasesTerms(filters.OperatorRegex, tokenization)
+	if err != nil {
+		return "", err
+	}
+	if !lowercased {
+		return pattern, nil
+	}
+
+	re, err := syntax.Parse(pattern, syntax.Perl|syntax.FoldCase)
+	if err != nil {
+		return "", fmt.Errorf("parse regex: %w", err)
+	}
+	lowercaseLiterals(re)
+	return re.String(), nil
+}
+
+// lowercasesTerms reports whether a tokenization supported by the Prefix
+// and Regex operators lowercases its terms
+func lowercasesTerms(operator filters.Operator, tokenization string) (bool, error) {
+	switch tokenization {
+	case models.PropertyTokenizationWord, models.PropertyTokenizationLowercase:
+		return true, nil
+	case models.PropertyTokenizationWhitespace, models.PropertyTokenizationField:
+		return false, nil
+	default:
+		return false, fmt.Errorf("operator %s is not supported for tokenization %q",
+			operator.Name(), tokenization)
+	}
+}
+
+func lowercaseLiterals(re *syntax.Regexp) {
+	if re.Op == syntax.OpLiteral && re.Flags&syntax.FoldCase != 0 {
+		for i, r := range re.Rune {
+			re.Rune[i] = unicode.ToLower(r)
+		}
+		re.Flags &^= syntax.FoldCase
+	}
+	for _, sub := range re.Sub {
+		lowercaseLiterals(sub)
+	}
+}
+
 func (s *Searcher) extractPropertyLength(prop *models.Property, propType schema.DataType,
 	value interface{}, operator filters.Operator, class *models.Class,
 ) (*propValuePair, error) {
@@ -15,7 +15,9 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/weaviate/sroar"
+	"github.com/weaviate/weaviate/entities/models"
 )
 
 func TestDocBitmap(t *testing.T) {
@@ -131,3 +133,21 @@ func TestDocIDsIterator_Slice(t *testing.T) {
 		assert.Equal(t, []uint64{3, 1, 0, 2}, ids)
 	})
 }
+
+func TestRegexForTokenization(t *testing.T) {
+	pattern, err := regexForTokenization(models.PropertyTokenizationWhitespace, "Car[st]")
+	require.Nil(t, err)
+	assert.Equal(t, "Car[st]", pattern)
+
+	pattern, err = regexForTokenization(models.PropertyTokenizationWord, "Car[st]")
+	require.Nil(t, err)
+	res, err := parseRegexRegexp([]byte(pattern))
+	require.Nil(t, err)
+	assert.Equal(t, []byte("car"), res.min)
+	assert.True(t, res.regexp.MatchString("cars"))
+	assert.True(t, res.regexp.MatchString("cart"))
+	assert.False(t, res.regexp.MatchString("carx"))
+
+	_, err = regexForTokenization(models.PropertyTokenizationTrigram, "car")
+	assert.NotNil(t, err)
+}
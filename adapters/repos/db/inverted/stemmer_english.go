@@ -0,0 +1,326 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import "strings"
+
+// stemEnglish implements the english (porter2) snowball stemmer, see
+// https://snowballstem.org/algorithms/english/stemmer.html. It expects
+// lowercased input.
+func stemEnglish(word string) string {
+	if len(word) <= 2 {
+		return word
+	}
+	if stem, ok := englishExceptions1[word]; ok {
+		return stem
+	}
+
+	w := &englishWord{b: []rune(strings.TrimPrefix(word, "'"))}
+	w.markConsonantY()
+	w.markRegions()
+
+	w.step0()
+	w.step1a()
+	if _, ok := englishExceptions2[string(w.b)]; ok {
+		return string(w.b)
+	}
+	w.step1b()
+	w.step1c()
+	w.step2()
+	w.step3()
+	w.step4()
+	w.step5()
+
+	for i, r := range w.b {
+		if r == 'Y' {
+			w.b[i] = 'y'
+		}
+	}
+	return string(w.b)
+}
+
+var englishExceptions1 = map[string]string{
+	"skis": "ski", "skies": "sky", "dying": "die", "lying": "lie", "tying": "tie",
+	"idly": "idl", "gently": "gentl", "ugly": "ugli", "early": "earli", "only": "onli",
+	"singly": "singl", "sky": "sky", "news": "news", "howe": "howe", "atlas": "atlas",
+	"cosmos": "cosmos", "bias": "bias", "andes": "andes",
+}
+
+var englishExceptions2 = map[string]struct{}{
+	"inning": {}, "outing": {}, "canning": {}, "herring": {}, "earring": {},
+	"proceed": {}, "exceed": {}, "succeed": {},
+}
+
+type englishWord struct {
+	b      []rune
+	r1, r2 int
+}
+
+func isEnglishVowel(r rune) bool {
+	switch r {
+	case 'a', 'e', 'i', 'o', 'u', 'y':
+		return true
+	default:
+		return false
+	}
+}
+
+func (w *englishWord) isVowel(i int) bool {
+	return isEnglishVowel(w.b[i])
+}
+
+func (w *englishWord) markConsonantY() {
+	for i, r := range w.b {
+		if r == 'y' && (i == 0 || w.isVowel(i-1)) {
+			w.b[i] = 'Y'
+		}
+	}
+}
+
+func (w *englishWord) markRegions() {
+	w.r1 = len(w.b)
+	for _, prefix := range []string{"gener", "commun", "arsen"} {
+		if strings.HasPrefix(string(w.b), prefix) {
+			w.r1 = len([]rune(prefix))
+			break
+		}
+	}
+	if w.r1 == len(w.b) {
+		w.r1 = w.regionAfter(0)
+	}
+	w.r2 = w.regionAfter(w.r1)
+}
+
+// regionAfter returns the position after the first non-vowel following a
+// vowel, starting the search at start
+func (w *englishWord) regionAfter(start int) int {
+	for i := start + 1; i < len(w.b); i++ {
+		if !w.isVowel(i) && w.isVowel(i-1) {
+			return i + 1
+		}
+	}
+	return len(w.b)
+}
+
+func (w *englishWord) hasSuffix(suffix string) bool {
+	return strings.HasSuffix(string(w.b), suffix)
+}
+
+// longestSuffix returns the longest of the given suffixes the word ends with
+func (w *englishWord) longestSuffix(suffixes ...string) string {
+	longest := ""
+	for _, suffix := range suffixes {
+		if len(suffix) > len(longest) && w.hasSuffix(suffix) {
+			longest = suffix
+		}
+	}
+	return longest
+}
+
+// suffixStart returns the position at which the given suffix starts
+func (w *englishWord) suffixStart(suffix string) int {
+	return len(w.b) - len([]rune(suffix))
+}
+
+func (w *englishWord) replaceSuffix(suffix, replacement string) {
+	w.b = append(w.b[:w.suffixStart(suffix)], []rune(replacement)...)
+}
+
+func (w *englishWord) containsVowel(end int) bool {
+	for i := 0; i < end; i++ {
+		if w.isVowel(i) {
+			return true
+		}
+	}
+	return false
+}
+
+// endsWithShortSyllable reports whether the word ends with a vowel followed
+// by a non-vowel other than w, x or Y, which is preceded by a non-vowel or
+// the beginning of the word
+func (w *englishWord) endsWithShortSyllable() bool {
+	n := len(w.b)
+	if n == 2 {
+		return w.isVowel(0) && !w.isVowel(1)
+	}
+	if n < 3 {
+		return false
+	}
+	last := w.b[n-1]
+	return !w.isVowel(n-3) && w.isVowel(n-2) && !w.isVowel(n-1) &&
+		last != 'w' && last != 'x' && last != 'Y'
+}
+
+func (w *englishWord) isShort() bool {
+	return w.r1 >= len(w.b) && w.endsWithShortSyllable()
+}
+
+func (w *englishWord) step0() {
+	if suffix := w.longestSuffix("'s'", "'s", "'"); suffix != "" {
+		w.replaceSuffix(suffix, "")
+	}
+}
+
+func (w *englishWord) step1a() {
+	switch suffix := w.longestSuffix("sses", "ied", "ies", "us", "ss", "s"); suffix {
+	case "sses":
+		w.replaceSuffix(suffix, "ss")
+	case "ied", "ies":
+		if w.suffixStart(suffix) > 1 {
+			w.replaceSuffix(suffix, "i")
+		} else {
+			w.replaceSuffix(suffix, "ie")
+		}
+	case "s":
+		// delete if the preceding part contains a vowel which is not
+		// immediately before the s
+		if w.containsVowel(len(w.b) - 2) {
+			w.replaceSuffix(suffix, "")
+		}
+	}
+}
+
+func (w *englishWord) step1b() {
+	switch suffix := w.longestSuffix("eed", "eedly", "ed", "edly", "ing", "ingly"); suffix {
+	case "":
+	case "eed", "eedly":
+		if w.suffixStart(suffix) >= w.r1 {
+			w.replaceSuffix(suffix, "ee")
+		}
+	default:
+		if !w.containsVowel(w.suffixStart(suffix)) {
+			return
+		}
+		w.replaceSuffix(suffix, "")
+		switch {
+		case w.hasSuffix("at"), w.hasSuffix("bl"), w.hasSuffix("iz"):
+			w.b = append(w.b, 'e')
+		case w.endsWithDouble():
+			w.b = w.b[:len(w.b)-1]
+		case w.isShort():
+			w.b = append(w.b, 'e')
+		}
+	}
+}
+
+func (w *englishWord) endsWithDouble() bool {
+	for _, double := range []string{"bb", "dd", "ff", "gg", "mm", "nn", "pp", "rr", "tt"} {
+		if w.hasSuffix(double) {
+			return true
+		}
+	}
+	return false
+}
+
+func (w *englishWord) step1c() {
+	n := len(w.b)
+	if n > 2 && (w.b[n-1] == 'y' || w.b[n-1] == 'Y') && !w.isVowel(n-2) {
+		w.b[n-1] = 'i'
+	}
+}
+
+var englishStep2 = map[string]string{
+	"tional": "tion", "enci": "ence", "anci": "ance", "abli": "able", "entli": "ent",
+	"izer": "ize", "ization": "ize", "ational": "ate", "ation": "ate", "ator": "ate",
+	"alism": "al", "aliti": "al", "alli": "al", "fulness": "ful", "ousli": "ous",
+	"ousness": "ous", "iveness": "ive", "iviti": "ive", "biliti": "ble", "bli": "ble",
+	"fulli": "ful", "lessli": "less", "ogi": "og", "li": "",
+}
+
+func (w *englishWord) step2() {
+	suffix := w.longestSuffixOf(englishStep2)
+	if suffix == "" || w.suffixStart(suffix) < w.r1 {
+		return
+	}
+	start := w.suffixStart(suffix)
+	switch suffix {
+	case "ogi":
+		if start == 0 || w.b[start-1] != 'l' {
+			return
+		}
+	case "li":
+		if start == 0 || !strings.ContainsRune("cdeghkmnrt", w.b[start-1]) {
+			return
+		}
+	}
+	w.replaceSuffix(suffix, englishStep2[suffix])
+}
+
+var englishStep3 = map[string]string{
+	"tional": "tion", "ational": "ate", "alize": "al", "icate": "ic", "iciti": "ic",
+	"ical": "ic", "ful": "", "ness": "", "ative": "",
+}
+
+func (w *englishWord) step3() {
+	suffix := w.longestSuffixOf(englishStep3)
+	if suffix == "" || w.suffixStart(suffix) < w.r1 {
+		return
+	}
+	if suffix == "ative" && w.suffixStart(suffix) < w.r2 {
+		return
+	}
+	w.replaceSuffix(suffix, englishStep3[suffix])
+}
+
+var englishStep4 = map[string]string{
+	"al": "", "ance": "", "ence": "", "er": "", "ic": "", "able": "", "ible": "",
+	"ant": "", "ement": "", "ment": "", "ent": "", "ism": "", "ate": "", "iti": "",
+	"ous": "", "ive": "", "ize": "", "ion": "",
+}
+
+func (w *englishWord) step4() {
+	suffix := w.longestSuffixOf(englishStep4)
+	if suffix == "" || w.suffixStart(suffix) < w.r2 {
+		return
+	}
+	if suffix == "ion" {
+		start := w.suffixStart(suffix)
+		if start == 0 || (w.b[start-1] != 's' && w.b[start-1] != 't') {
+			return
+		}
+	}
+	w.replaceSuffix(suffix, "")
+}
+
+func (w *englishWord) step5() {
+	n := len(w.b)
+	if n == 0 {
+		return
+	}
+	switch w.b[n-1] {
+	case 'e':
+		if n-1 >= w.r2 {
+			w.b = w.b[:n-1]
+			return
+		}
+		if n-1 >= w.r1 {
+			w.b = w.b[:n-1]
+			if w.endsWithShortSyllable() {
+				w.b = append(w.b, 'e')
+			}
+		}
+	case 'l':
+		if n-1 >= w.r2 && n > 1 && w.b[n-2] == 'l' {
+			w.b = w.b[:n-1]
+		}
+	}
+}
+
+func (w *englishWord) longestSuffixOf(suffixes map[string]string) string {
+	longest := ""
+	for suffix := range suffixes {
+		if len(suffix) > len(longest) && w.hasSuffix(suffix) {
+			longest = suffix
+		}
+	}
+	return longest
+}
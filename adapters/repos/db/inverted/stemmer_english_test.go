@@ -0,0 +1,59 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStemEnglish(t *testing.T) {
+	// expected stems are taken from the snowball reference vocabulary
+	tests := map[string]string{
+		"caresses":       "caress",
+		"ponies":         "poni",
+		"ties":           "tie",
+		"cats":           "cat",
+		"gas":            "gas",
+		"feed":           "feed",
+		"agreed":         "agre",
+		"plastered":      "plaster",
+		"motoring":       "motor",
+		"sing":           "sing",
+		"hopping":        "hop",
+		"hoping":         "hope",
+		"filing":         "file",
+		"happy":          "happi",
+		"cry":            "cri",
+		"relational":     "relat",
+		"conditional":    "condit",
+		"generalization": "general",
+		"generously":     "generous",
+		"communication":  "communic",
+		"running":        "run",
+		"consigned":      "consign",
+		"consignment":    "consign",
+		"knightly":       "knight",
+		"abilities":      "abil",
+		"laptops":        "laptop",
+		"skies":          "sky",
+		"succeeding":     "succeed",
+		"yelling":        "yell",
+		"controlling":    "control",
+		"a":              "a",
+	}
+
+	for word, stem := range tests {
+		assert.Equal(t, stem, stemEnglish(word), word)
+	}
+}
@@ -0,0 +1,104 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"slices"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+const defaultSynonymBoost = 1.0
+
+// querySynonyms expands the terms of keyword queries by the synonym
+// dictionary of a class
+type querySynonyms struct {
+	groups [][]string
+	boost  float64
+}
+
+func newQuerySynonyms(conf *models.SynonymConfig) (*querySynonyms, error) {
+	if conf == nil || len(conf.Groups) == 0 {
+		return nil, nil
+	}
+
+	s := &querySynonyms{boost: defaultSynonymBoost}
+	if conf.Boost != 0 {
+		s.boost = float64(conf.Boost)
+	}
+	for _, group := range conf.Groups {
+		terms, err := splitSynonymGroup(group)
+		if err != nil {
+			return nil, err
+		}
+		s.groups = append(s.groups, terms)
+	}
+	return s, nil
+}
+
+// expand appends the synonyms of the query terms, using tokenize to split the
+// synonyms the same way the query was split. A group is only triggered by
+// synonyms which are a single term. Expanded terms are weighted by the
+// configured boost times the highest weight of the query terms they expand.
+func (s *querySynonyms) expand(queryTerms []string, boosts []float64,
+	tokenize func(string) []string,
+) ([]string, []float64) {
+	if s == nil || len(queryTerms) == 0 {
+		return queryTerms, boosts
+	}
+
+	queryTermCount := len(queryTerms)
+	for _, group := range s.groups {
+		tokenized := make([][]string, len(group))
+		weight := 0.0
+		for i, synonym := range group {
+			tokenized[i] = tokenize(synonym)
+			if len(tokenized[i]) != 1 {
+				continue
+			}
+			if pos := slices.Index(queryTerms[:queryTermCount], tokenized[i][0]); pos >= 0 {
+				weight = max(weight, boosts[pos])
+			}
+		}
+		if weight == 0 {
+			continue
+		}
+
+		for _, terms := range tokenized {
+			for _, term := range terms {
+				pos := slices.Index(queryTerms, term)
+				switch {
+				case pos < 0:
+					queryTerms = append(queryTerms, term)
+					boosts = append(boosts, weight*s.boost)
+				case pos >= queryTermCount:
+					// expanded by multiple groups
+					boosts[pos] = max(boosts[pos], weight*s.boost)
+				}
+			}
+		}
+	}
+	return queryTerms, boosts
+}
+
+// ValidateSynonyms checks that the synonym groups of conf can be parsed
+func ValidateSynonyms(conf *models.SynonymConfig) error {
+	if conf == nil {
+		return nil
+	}
+	if conf.Boost < 0 {
+		return errors.Errorf("synonyms.boost must be >= 0, got %v", conf.Boost)
+	}
+	_, err := newQuerySynonyms(conf)
+	return err
+}
@@ -0,0 +1,110 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestQuerySynonyms(t *testing.T) {
+	tokenize := func(in string) []string {
+		return helpers.Tokenize(models.PropertyTokenizationWord, in)
+	}
+
+	synonyms, err := newQuerySynonyms(&models.SynonymConfig{
+		Groups: []string{"Laptop, notebook", "tv, television, telly", "pc, personal computer", "notebook, notepad"},
+		Boost:  0.5,
+	})
+	require.Nil(t, err)
+
+	type testCase struct {
+		name           string
+		queryTerms     []string
+		boosts         []float64
+		expectedTerms  []string
+		expectedBoosts []float64
+	}
+
+	testCases := []testCase{
+		{
+			name:           "no synonyms",
+			queryTerms:     []string{"cheap", "phone"},
+			boosts:         []float64{1, 1},
+			expectedTerms:  []string{"cheap", "phone"},
+			expectedBoosts: []float64{1, 1},
+		},
+		{
+			name:           "synonyms are tokenized like the query",
+			queryTerms:     []string{"cheap", "laptop"},
+			boosts:         []float64{1, 2},
+			expectedTerms:  []string{"cheap", "laptop", "notebook"},
+			expectedBoosts: []float64{1, 2, 1},
+		},
+		{
+			name:           "multi term synonyms are added but do not trigger",
+			queryTerms:     []string{"pc"},
+			boosts:         []float64{1},
+			expectedTerms:  []string{"pc", "personal", "computer"},
+			expectedBoosts: []float64{1, 0.5, 0.5},
+		},
+		{
+			name:           "personal alone does not expand",
+			queryTerms:     []string{"personal"},
+			boosts:         []float64{1},
+			expectedTerms:  []string{"personal"},
+			expectedBoosts: []float64{1},
+		},
+		{
+			name:           "query terms are not boosted down",
+			queryTerms:     []string{"tv", "television"},
+			boosts:         []float64{1, 1},
+			expectedTerms:  []string{"tv", "television", "telly"},
+			expectedBoosts: []float64{1, 1, 0.5},
+		},
+		{
+			name:           "expansions are not expanded again",
+			queryTerms:     []string{"laptop"},
+			boosts:         []float64{1},
+			expectedTerms:  []string{"laptop", "notebook"},
+			expectedBoosts: []float64{1, 0.5},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			terms, boosts := synonyms.expand(tc.queryTerms, tc.boosts, tokenize)
+			assert.Equal(t, tc.expectedTerms, terms)
+			assert.Equal(t, tc.expectedBoosts, boosts)
+		})
+	}
+
+	t.Run("without synonyms", func(t *testing.T) {
+		var synonyms *querySynonyms
+		terms, boosts := synonyms.expand([]string{"laptop"}, []float64{1}, tokenize)
+		assert.Equal(t, []string{"laptop"}, terms)
+		assert.Equal(t, []float64{1}, boosts)
+	})
+}
+
+func TestValidateSynonyms(t *testing.T) {
+	assert.Nil(t, ValidateSynonyms(nil))
+	assert.Nil(t, ValidateSynonyms(&models.SynonymConfig{Groups: []string{"a, b"}}))
+	assert.EqualError(t, ValidateSynonyms(&models.SynonymConfig{Groups: []string{"a"}}),
+		`synonym group "a" requires at least two tokens`)
+	assert.EqualError(t, ValidateSynonyms(&models.SynonymConfig{Groups: []string{"a, b"}, Boost: -1}),
+		"synonyms.boost must be >= 0, got -1")
+}
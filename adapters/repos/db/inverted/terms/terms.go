@@ -210,6 +210,11 @@ type Term struct {
 	queryTermIndex int
 	propertyBoost  float64
 	config         schema.BM25Config
+
+	// dotProduct scores the frequencies as weights instead of with BM25, see
+	// NewDotProductTerm
+	dotProduct   bool
+	maxFrequency float64
 }
 
 func NewTerm(queryTerm string, queryTermIndex int, propertyBoost float32, config schema.BM25Config) *Term {
@@ -221,10 +226,27 @@ func NewTerm(queryTerm string, queryTermIndex int, propertyBoost float32, config
 	}
 }
 
+// NewDotProductTerm creates a term that scores a document with the product of
+// its frequency and the idf instead of BM25. It is used for sparse vectors,
+// which store their quantized weights as frequencies and pass the query
+// weight as idf.
+func NewDotProductTerm(queryTerm string, queryTermIndex int, weight float64) *Term {
+	return &Term{
+		idf:            weight,
+		queryTerm:      queryTerm,
+		queryTermIndex: queryTermIndex,
+		propertyBoost:  1,
+		dotProduct:     true,
+	}
+}
+
 func (t *Term) Score(averagePropLength float64, additionalExplanations bool) (uint64, float64, *DocPointerWithScore) {
 	pair := t.Data[t.posPointer]
 	freq := float64(pair.Frequency)
-	tf := freq / (freq + t.config.K1*(1-t.config.B+t.config.B*float64(pair.PropLength)/averagePropLength))
+	tf := freq
+	if !t.dotProduct {
+		tf = freq / (freq + t.config.K1*(1-t.config.B+t.config.B*float64(pair.PropLength)/averagePropLength))
+	}
 	if !additionalExplanations {
 		return t.idPointer, tf * t.idf * t.propertyBoost, nil
 	}
@@ -303,6 +325,14 @@ func (t *Term) SetIdPointer(idPointer uint64) {
 }
 
 func (t *Term) CurrentBlockImpact() float32 {
+	if t.dotProduct {
+		if t.maxFrequency == 0 {
+			for i := range t.Data {
+				t.maxFrequency = math.Max(t.maxFrequency, float64(t.Data[i].Frequency))
+			}
+		}
+		return float32(t.maxFrequency * t.idf * t.propertyBoost)
+	}
 	return float32(t.idf * t.propertyBoost)
 }
 
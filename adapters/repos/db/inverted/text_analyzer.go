@@ -0,0 +1,364 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/pkg/errors"
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/stopwords"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+const (
+	defaultMinGram = 1
+	defaultMaxGram = 2
+)
+
+type (
+	charFilter  func(in string) string
+	tokenFilter func(tokens []string) []string
+)
+
+// TextAnalyzer is a custom analysis pipeline as configured in the inverted
+// index config of a class. Char filters rewrite the text, which is then split
+// by the tokenizer. The tokens finally run through the token filters.
+type TextAnalyzer struct {
+	charFilters  []charFilter
+	tokenizer    string
+	tokenFilters []tokenFilter
+}
+
+// NewTextAnalyzer builds the pipeline of conf. Stop filters without their own
+// list of stopwords fall back to stopwordConf.
+func NewTextAnalyzer(conf *models.TextAnalyzerConfig,
+	stopwordConf *models.StopwordConfig,
+) (*TextAnalyzer, error) {
+	if !slices.Contains(helpers.Tokenizations, conf.Tokenizer) {
+		return nil, errors.Errorf("analyzer %q: tokenizer %q is not supported", conf.Name, conf.Tokenizer)
+	}
+
+	t := &TextAnalyzer{tokenizer: conf.Tokenizer}
+	for i, cf := range conf.CharFilters {
+		f, err := newCharFilter(cf)
+		if err != nil {
+			return nil, errors.Wrapf(err, "analyzer %q: char filter %d", conf.Name, i)
+		}
+		t.charFilters = append(t.charFilters, f)
+	}
+	for i, tf := range conf.TokenFilters {
+		f, err := newTokenFilter(tf, stopwordConf)
+		if err != nil {
+			return nil, errors.Wrapf(err, "analyzer %q: token filter %d", conf.Name, i)
+		}
+		t.tokenFilters = append(t.tokenFilters, f)
+	}
+	return t, nil
+}
+
+// Analyze returns the tokens of in, tokens may occur multiple times
+func (t *TextAnalyzer) Analyze(in string) []string {
+	for _, f := range t.charFilters {
+		in = f(in)
+	}
+	tokens := helpers.Tokenize(t.tokenizer, in)
+	for _, f := range t.tokenFilters {
+		tokens = f(tokens)
+	}
+	return slices.DeleteFunc(tokens, func(token string) bool { return token == "" })
+}
+
+func (t *TextAnalyzer) AnalyzeArray(in []string) []string {
+	var tokens []string
+	for _, s := range in {
+		tokens = append(tokens, t.Analyze(s)...)
+	}
+	return tokens
+}
+
+// TextAnalyzers resolves the custom analyzers properties refer to. All
+// analyzers are built upfront, so a TextAnalyzers can be shared by concurrent
+// writes and queries. It is replaced as a whole when the config changes.
+type TextAnalyzers struct {
+	analyzers map[string]*TextAnalyzer
+}
+
+func NewTextAnalyzers(analyzers []*models.TextAnalyzerConfig,
+	stopwordConf *models.StopwordConfig,
+) (*TextAnalyzers, error) {
+	t := &TextAnalyzers{analyzers: make(map[string]*TextAnalyzer, len(analyzers))}
+	for _, conf := range analyzers {
+		if conf == nil {
+			continue
+		}
+		analyzer, err := NewTextAnalyzer(conf, stopwordConf)
+		if err != nil {
+			return nil, err
+		}
+		t.analyzers[conf.Name] = analyzer
+	}
+	return t, nil
+}
+
+// ForProperty returns the analyzer of prop, or nil if prop is analyzed by its
+// tokenization only
+func (t *TextAnalyzers) ForProperty(prop *models.Property) (*TextAnalyzer, error) {
+	if prop.Analyzer == "" {
+		return nil, nil
+	}
+	if t != nil {
+		if analyzer, ok := t.analyzers[prop.Analyzer]; ok {
+			return analyzer, nil
+		}
+	}
+	return nil, errors.Errorf("property %q: analyzer %q does not exist", prop.Name, prop.Analyzer)
+}
+
+func findTextAnalyzerConfig(conf *models.InvertedIndexConfig, name string) *models.TextAnalyzerConfig {
+	if conf == nil {
+		return nil
+	}
+	for _, analyzer := range conf.Analyzers {
+		if analyzer != nil && analyzer.Name == name {
+			return analyzer
+		}
+	}
+	return nil
+}
+
+// ValidateTextAnalyzers checks that the analyzers of conf have unique names
+// and can be built
+func ValidateTextAnalyzers(conf *models.InvertedIndexConfig) error {
+	names := map[string]struct{}{}
+	for _, analyzer := range conf.Analyzers {
+		if analyzer == nil || analyzer.Name == "" {
+			return errors.New("analyzers must have a name")
+		}
+		if _, ok := names[analyzer.Name]; ok {
+			return errors.Errorf("analyzer %q is defined more than once", analyzer.Name)
+		}
+		names[analyzer.Name] = struct{}{}
+	}
+
+	_, err := NewTextAnalyzers(conf.Analyzers, conf.Stopwords)
+	return err
+}
+
+func newCharFilter(conf *models.TextAnalyzerCharFilter) (charFilter, error) {
+	switch conf.Type {
+	case models.TextAnalyzerCharFilterTypeMapping:
+		if len(conf.Mappings) == 0 {
+			return nil, errors.New("mapping requires mappings")
+		}
+		// prefer the longest match if keys overlap
+		keys := make([]string, 0, len(conf.Mappings))
+		for key := range conf.Mappings {
+			if key == "" {
+				return nil, errors.New("mappings cannot contain an empty key")
+			}
+			keys = append(keys, key)
+		}
+		sort.Slice(keys, func(i, j int) bool {
+			if len(keys[i]) != len(keys[j]) {
+				return len(keys[i]) > len(keys[j])
+			}
+			return keys[i] < keys[j]
+		})
+		pairs := make([]string, 0, 2*len(keys))
+		for _, key := range keys {
+			pairs = append(pairs, key, conf.Mappings[key])
+		}
+		return strings.NewReplacer(pairs...).Replace, nil
+
+	case models.TextAnalyzerCharFilterTypePatternReplace:
+		r, err := regexp.Compile(conf.Pattern)
+		if err != nil {
+			return nil, errors.Wrap(err, "compile pattern")
+		}
+		replacement := conf.Replacement
+		return func(in string) string {
+			return r.ReplaceAllString(in, replacement)
+		}, nil
+
+	default:
+		return nil, errors.Errorf("unknown char filter type %q", conf.Type)
+	}
+}
+
+func newTokenFilter(conf *models.TextAnalyzerTokenFilter,
+	stopwordConf *models.StopwordConfig,
+) (tokenFilter, error) {
+	switch conf.Type {
+	case models.TextAnalyzerTokenFilterTypeLowercase:
+		return mapTokens(strings.ToLower), nil
+
+	case models.TextAnalyzerTokenFilterTypeAsciifold:
+		return mapTokens(asciiFold), nil
+
+	case models.TextAnalyzerTokenFilterTypeStemmer:
+		switch conf.Language {
+		case "", "english":
+			return mapTokens(stemEnglish), nil
+		default:
+			return nil, errors.Errorf("stemmer language %q is not supported", conf.Language)
+		}
+
+	case models.TextAnalyzerTokenFilterTypeStop:
+		detector, err := newStopFilterDetector(conf.Stopwords, stopwordConf)
+		if err != nil {
+			return nil, err
+		}
+		return func(tokens []string) []string {
+			return slices.DeleteFunc(tokens, detector.IsStopword)
+		}, nil
+
+	case models.TextAnalyzerTokenFilterTypeSynonym:
+		synonyms, err := parseSynonymGroups(conf.Synonyms)
+		if err != nil {
+			return nil, err
+		}
+		return func(tokens []string) []string {
+			out := make([]string, 0, len(tokens))
+			for _, token := range tokens {
+				out = append(out, token)
+				out = append(out, synonyms[token]...)
+			}
+			return out
+		}, nil
+
+	case models.TextAnalyzerTokenFilterTypeEdgeNgram, models.TextAnalyzerTokenFilterTypeNgram:
+		minGram, maxGram := int(conf.MinGram), int(conf.MaxGram)
+		if minGram == 0 {
+			minGram = defaultMinGram
+		}
+		if maxGram == 0 {
+			maxGram = max(defaultMaxGram, minGram)
+		}
+		if minGram < 1 || maxGram < minGram {
+			return nil, errors.Errorf("%s requires 1 <= minGram <= maxGram, got %d and %d",
+				conf.Type, minGram, maxGram)
+		}
+		edge := conf.Type == models.TextAnalyzerTokenFilterTypeEdgeNgram
+		return func(tokens []string) []string {
+			var out []string
+			for _, token := range tokens {
+				out = append(out, ngrams(token, minGram, maxGram, edge)...)
+			}
+			return out
+		}, nil
+
+	default:
+		return nil, errors.Errorf("unknown token filter type %q", conf.Type)
+	}
+}
+
+func mapTokens(fn func(string) string) tokenFilter {
+	return func(tokens []string) []string {
+		for i := range tokens {
+			tokens[i] = fn(tokens[i])
+		}
+		return tokens
+	}
+}
+
+func newStopFilterDetector(words []string,
+	stopwordConf *models.StopwordConfig,
+) (*stopwords.Detector, error) {
+	if len(words) > 0 {
+		detector, err := stopwords.NewDetectorFromPreset(stopwords.NoPreset)
+		if err != nil {
+			return nil, err
+		}
+		detector.SetAdditions(words)
+		return detector, nil
+	}
+	if stopwordConf == nil {
+		return stopwords.NewDetectorFromPreset(stopwords.EnglishPreset)
+	}
+	return stopwords.NewDetectorFromConfig(*stopwordConf)
+}
+
+// parseSynonymGroups maps every token of a group of equivalent tokens to the
+// other tokens of the group
+func parseSynonymGroups(groups []string) (map[string][]string, error) {
+	if len(groups) == 0 {
+		return nil, errors.New("synonym requires synonyms")
+	}
+
+	synonyms := map[string][]string{}
+	for _, group := range groups {
+		tokens, err := splitSynonymGroup(group)
+		if err != nil {
+			return nil, err
+		}
+		for _, token := range tokens {
+			for _, synonym := range tokens {
+				if synonym != token && !slices.Contains(synonyms[token], synonym) {
+					synonyms[token] = append(synonyms[token], synonym)
+				}
+			}
+		}
+	}
+	return synonyms, nil
+}
+
+// splitSynonymGroup returns the comma separated terms of a synonym group
+func splitSynonymGroup(group string) ([]string, error) {
+	var terms []string
+	for _, term := range strings.Split(group, ",") {
+		if term = strings.TrimSpace(term); term != "" {
+			terms = append(terms, term)
+		}
+	}
+	if len(terms) < 2 {
+		return nil, errors.Errorf("synonym group %q requires at least two tokens", group)
+	}
+	return terms, nil
+}
+
+func ngrams(token string, minGram, maxGram int, edge bool) []string {
+	r := []rune(token)
+	var out []string
+	for start := 0; start < len(r); start++ {
+		for n := minGram; n <= maxGram && start+n <= len(r); n++ {
+			out = append(out, string(r[start:start+n]))
+		}
+		if edge {
+			break
+		}
+	}
+	return out
+}
+
+// asciiFoldings covers letters which have no decomposed form
+var asciiFoldings = strings.NewReplacer(
+	"ß", "ss", "æ", "ae", "Æ", "AE", "ø", "o", "Ø", "O", "œ", "oe", "Œ", "OE",
+	"đ", "d", "Đ", "D", "ł", "l", "Ł", "L", "þ", "th", "Þ", "TH", "ı", "i",
+)
+
+func asciiFold(in string) string {
+	t := transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC)
+	out, _, err := transform.String(t, in)
+	if err != nil {
+		out = in
+	}
+	return asciiFoldings.Replace(out)
+}
@@ -0,0 +1,251 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestTextAnalyzer(t *testing.T) {
+	type testCase struct {
+		name     string
+		conf     *models.TextAnalyzerConfig
+		input    string
+		expected []string
+	}
+
+	testCases := []testCase{
+		{
+			name:     "tokenizer only",
+			conf:     &models.TextAnalyzerConfig{Tokenizer: models.PropertyTokenizationWhitespace},
+			input:    "Hello World",
+			expected: []string{"Hello", "World"},
+		},
+		{
+			name: "mapping char filter prefers longest keys",
+			conf: &models.TextAnalyzerConfig{
+				Tokenizer: models.PropertyTokenizationWhitespace,
+				CharFilters: []*models.TextAnalyzerCharFilter{{
+					Type:     models.TextAnalyzerCharFilterTypeMapping,
+					Mappings: map[string]string{"&": " and ", "&&": " or "},
+				}},
+			},
+			input:    "salt&pepper&&vinegar",
+			expected: []string{"salt", "and", "pepper", "or", "vinegar"},
+		},
+		{
+			name: "pattern replace char filter",
+			conf: &models.TextAnalyzerConfig{
+				Tokenizer: models.PropertyTokenizationWord,
+				CharFilters: []*models.TextAnalyzerCharFilter{{
+					Type:        models.TextAnalyzerCharFilterTypePatternReplace,
+					Pattern:     `(\d)-(\d)`,
+					Replacement: "$1$2",
+				}},
+			},
+			input:    "call 555-1234",
+			expected: []string{"call", "5551234"},
+		},
+		{
+			name: "lowercase and asciifold",
+			conf: &models.TextAnalyzerConfig{
+				Tokenizer: models.PropertyTokenizationWhitespace,
+				TokenFilters: []*models.TextAnalyzerTokenFilter{
+					{Type: models.TextAnalyzerTokenFilterTypeLowercase},
+					{Type: models.TextAnalyzerTokenFilterTypeAsciifold},
+				},
+			},
+			input:    "Crème Brûlée Straße",
+			expected: []string{"creme", "brulee", "strasse"},
+		},
+		{
+			name: "stop and stemmer",
+			conf: &models.TextAnalyzerConfig{
+				Tokenizer: models.PropertyTokenizationWord,
+				TokenFilters: []*models.TextAnalyzerTokenFilter{
+					{Type: models.TextAnalyzerTokenFilterTypeStop},
+					{Type: models.TextAnalyzerTokenFilterTypeStemmer, Language: "english"},
+				},
+			},
+			input:    "The runners are running",
+			expected: []string{"runner", "run"},
+		},
+		{
+			name: "stop with own stopwords",
+			conf: &models.TextAnalyzerConfig{
+				Tokenizer: models.PropertyTokenizationWord,
+				TokenFilters: []*models.TextAnalyzerTokenFilter{
+					{Type: models.TextAnalyzerTokenFilterTypeStop, Stopwords: []string{"quick"}},
+				},
+			},
+			input:    "the quick fox",
+			expected: []string{"the", "fox"},
+		},
+		{
+			name: "synonym",
+			conf: &models.TextAnalyzerConfig{
+				Tokenizer: models.PropertyTokenizationWord,
+				TokenFilters: []*models.TextAnalyzerTokenFilter{{
+					Type:     models.TextAnalyzerTokenFilterTypeSynonym,
+					Synonyms: []string{"laptop, notebook", "tv, television"},
+				}},
+			},
+			input:    "cheap laptop",
+			expected: []string{"cheap", "laptop", "notebook"},
+		},
+		{
+			name: "edge ngram",
+			conf: &models.TextAnalyzerConfig{
+				Tokenizer: models.PropertyTokenizationWord,
+				TokenFilters: []*models.TextAnalyzerTokenFilter{{
+					Type:    models.TextAnalyzerTokenFilterTypeEdgeNgram,
+					MinGram: 2,
+					MaxGram: 4,
+				}},
+			},
+			input:    "search",
+			expected: []string{"se", "sea", "sear"},
+		},
+		{
+			name: "ngram",
+			conf: &models.TextAnalyzerConfig{
+				Tokenizer: models.PropertyTokenizationWord,
+				TokenFilters: []*models.TextAnalyzerTokenFilter{{
+					Type: models.TextAnalyzerTokenFilterTypeNgram,
+				}},
+			},
+			input:    "abc",
+			expected: []string{"a", "ab", "b", "bc", "c"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			analyzer, err := NewTextAnalyzer(tc.conf, nil)
+			require.Nil(t, err)
+			assert.Equal(t, tc.expected, analyzer.Analyze(tc.input))
+		})
+	}
+}
+
+func TestTextAnalyzersForProperty(t *testing.T) {
+	analyzers, err := NewTextAnalyzers([]*models.TextAnalyzerConfig{{
+		Name:      "stemmed",
+		Tokenizer: models.PropertyTokenizationWord,
+		TokenFilters: []*models.TextAnalyzerTokenFilter{
+			{Type: models.TextAnalyzerTokenFilterTypeStemmer},
+		},
+	}}, nil)
+	require.Nil(t, err)
+
+	t.Run("property without analyzer", func(t *testing.T) {
+		analyzer, err := analyzers.ForProperty(&models.Property{Name: "title"})
+		require.Nil(t, err)
+		assert.Nil(t, analyzer)
+	})
+
+	t.Run("property with analyzer", func(t *testing.T) {
+		prop := &models.Property{Name: "title", Analyzer: "stemmed"}
+		analyzer, err := analyzers.ForProperty(prop)
+		require.Nil(t, err)
+		assert.Equal(t, []string{"jump", "jump"}, analyzer.AnalyzeArray([]string{"jumping", "jumps"}))
+
+		again, err := analyzers.ForProperty(prop)
+		require.Nil(t, err)
+		assert.Same(t, analyzer, again)
+	})
+
+	t.Run("property with unknown analyzer", func(t *testing.T) {
+		_, err := analyzers.ForProperty(&models.Property{Name: "title", Analyzer: "unknown"})
+		assert.EqualError(t, err, `property "title": analyzer "unknown" does not exist`)
+	})
+}
+
+func TestValidateTextAnalyzers(t *testing.T) {
+	valid := func() *models.TextAnalyzerConfig {
+		return &models.TextAnalyzerConfig{Name: "valid", Tokenizer: models.PropertyTokenizationWord}
+	}
+
+	testCases := map[string]struct {
+		analyzers []*models.TextAnalyzerConfig
+		expected  string
+	}{
+		"missing name": {
+			analyzers: []*models.TextAnalyzerConfig{{Tokenizer: models.PropertyTokenizationWord}},
+			expected:  "analyzers must have a name",
+		},
+		"duplicate name": {
+			analyzers: []*models.TextAnalyzerConfig{valid(), valid()},
+			expected:  `analyzer "valid" is defined more than once`,
+		},
+		"unknown char filter": {
+			analyzers: []*models.TextAnalyzerConfig{{
+				Name: "a", Tokenizer: models.PropertyTokenizationWord,
+				CharFilters: []*models.TextAnalyzerCharFilter{{Type: "html"}},
+			}},
+			expected: `analyzer "a": char filter 0: unknown char filter type "html"`,
+		},
+		"invalid pattern": {
+			analyzers: []*models.TextAnalyzerConfig{{
+				Name: "a", Tokenizer: models.PropertyTokenizationWord,
+				CharFilters: []*models.TextAnalyzerCharFilter{{
+					Type: models.TextAnalyzerCharFilterTypePatternReplace, Pattern: "(",
+				}},
+			}},
+			expected: "analyzer \"a\": char filter 0: compile pattern: error parsing regexp: missing closing ): `(`",
+		},
+		"unsupported stemmer language": {
+			analyzers: []*models.TextAnalyzerConfig{{
+				Name: "a", Tokenizer: models.PropertyTokenizationWord,
+				TokenFilters: []*models.TextAnalyzerTokenFilter{{
+					Type: models.TextAnalyzerTokenFilterTypeStemmer, Language: "klingon",
+				}},
+			}},
+			expected: `analyzer "a": token filter 0: stemmer language "klingon" is not supported`,
+		},
+		"synonym group with single token": {
+			analyzers: []*models.TextAnalyzerConfig{{
+				Name: "a", Tokenizer: models.PropertyTokenizationWord,
+				TokenFilters: []*models.TextAnalyzerTokenFilter{{
+					Type: models.TextAnalyzerTokenFilterTypeSynonym, Synonyms: []string{"tv"},
+				}},
+			}},
+			expected: `analyzer "a": token filter 0: synonym group "tv" requires at least two tokens`,
+		},
+		"invalid ngram sizes": {
+			analyzers: []*models.TextAnalyzerConfig{{
+				Name: "a", Tokenizer: models.PropertyTokenizationWord,
+				TokenFilters: []*models.TextAnalyzerTokenFilter{{
+					Type: models.TextAnalyzerTokenFilterTypeNgram, MinGram: 3, MaxGram: 2,
+				}},
+			}},
+			expected: `analyzer "a": token filter 0: ngram requires 1 <= minGram <= maxGram, got 3 and 2`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := ValidateTextAnalyzers(&models.InvertedIndexConfig{Analyzers: tc.analyzers})
+			assert.EqualError(t, err, tc.expected)
+		})
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		assert.Nil(t, ValidateTextAnalyzers(&models.InvertedIndexConfig{
+			Analyzers: []*models.TextAnalyzerConfig{valid()},
+		}))
+	})
+}
@@ -134,6 +134,10 @@ type Bucket struct {
 	// redundant obsolete data, that was deleted or updated in newer segments
 	// (currently supported only in buckets of REPLACE strategy)
 	segmentsCleanupInterval time.Duration
+
+	// optional archiver taking over commit logs of flushed memtables, e.g. to
+	// ship them alongside backups
+	walArchiver WALArchiver
 }
 
 func NewBucketCreator() *Bucket { return &Bucket{} }
@@ -993,6 +997,9 @@ func (b *Bucket) setNewActiveMemtable() error {
 	if err != nil {
 		return err
 	}
+	if err := mt.setWALArchiver(b.walArchiver); err != nil {
+		return errors.Wrap(err, "archive commit log")
+	}
 
 	b.active = mt
 	return nil
@@ -1429,7 +1436,7 @@ func (b *Bucket) DocPointerWithScoreList(ctx context.Context, key []byte, propBo
 	return terms.NewSortedDocPointerWithScoreMerger().Do(ctx, segments)
 }
 
-func (b *Bucket) CreateDiskTerm(N float64, filterDocIds helpers.AllowList, query []string, propName string, propertyBoost float32, duplicateTextBoosts []int, averagePropLength float64, config schema.BM25Config, ctx context.Context) ([][]terms.TermInterface, *sync.RWMutex, error) {
+func (b *Bucket) CreateDiskTerm(N float64, filterDocIds helpers.AllowList, query []string, propName string, propertyBoost float32, queryTermBoosts []float64, averagePropLength float64, config schema.BM25Config, ctx context.Context) ([][]terms.TermInterface, *sync.RWMutex, error) {
 	b.flushLock.RLock()
 	defer b.flushLock.RUnlock()
 
@@ -1499,7 +1506,7 @@ func (b *Bucket) CreateDiskTerm(N float64, filterDocIds helpers.AllowList, query
 			}
 		}
 
-		idfs[i] = math.Log(float64(1)+(N-float64(n)+0.5)/(float64(n)+0.5)) * float64(duplicateTextBoosts[i])
+		idfs[i] = math.Log(float64(1)+(N-float64(n)+0.5)/(float64(n)+0.5)) * queryTermBoosts[i]
 		flushing.SetIdf(idfs[i])
 		active.SetIdf(idfs[i])
 
@@ -1543,6 +1550,97 @@ func (b *Bucket) CreateDiskTerm(N float64, filterDocIds helpers.AllowList, query
 	return output, lock, nil
 }
 
+// CreateSparseDiskTerm creates the terms for a dot product search over an
+// inverted bucket that stores weights as frequencies. Each key is scored with
+// the matching query weight. As with CreateDiskTerm, the returned lock is held
+// for reading and must be released by the caller once the search is done.
+//
+// Unlike CreateDiskTerm, the tombstones of newer segments and memtables are
+// applied to the older ones, so that deleted documents are not returned.
+func (b *Bucket) CreateSparseDiskTerm(filterDocIds helpers.AllowList, keys [][]byte, weights []float64) ([][]terms.TermInterface, *sync.RWMutex, error) {
+	b.flushLock.RLock()
+	defer b.flushLock.RUnlock()
+
+	lock := &b.disk.maintenanceLock
+	lock.RLock()
+
+	defer func() {
+		if r := recover(); r != nil {
+			b.logger.Errorf("Recovered from panic in CreateSparseDiskTerm: %v", r)
+			lock.RUnlock()
+		}
+	}()
+
+	segmentsDisk := b.disk.segments
+	output := make([][]terms.TermInterface, len(segmentsDisk)+2)
+
+	deleted := sroar.NewBitmap()
+	memtables := []*Memtable{b.active, b.flushing}
+	for i, mt := range memtables {
+		if mt == nil {
+			continue
+		}
+		pos := len(segmentsDisk) + 1 - i
+		output[pos] = make([]terms.TermInterface, 0, len(keys))
+		for j, key := range keys {
+			mapPairs, err := mt.getMap(key)
+			if err != nil && !errors.Is(err, lsmkv.NotFound) {
+				return nil, lock, err
+			}
+			term := terms.NewDotProductTerm(string(key), j, weights[j])
+			if _, err := addDataToTerm(withoutDeleted(mapPairs, deleted), filterDocIds, term); err != nil {
+				return nil, lock, err
+			}
+			if len(term.Data) > 0 {
+				output[pos] = append(output[pos], term)
+			}
+		}
+
+		tombstones, err := mt.GetTombstones()
+		if err != nil && !errors.Is(err, lsmkv.NotFound) {
+			return nil, lock, err
+		}
+		if tombstones != nil {
+			deleted = sroar.Or(deleted, tombstones)
+		}
+	}
+
+	for j := len(segmentsDisk) - 1; j >= 0; j-- {
+		segment := segmentsDisk[j]
+		tombstones, err := segment.GetTombstones()
+		if err != nil {
+			return nil, lock, err
+		}
+		if tombstones != nil {
+			deleted = sroar.Or(deleted, tombstones)
+		}
+
+		output[j] = make([]terms.TermInterface, 0, len(keys))
+		for i, key := range keys {
+			term := NewSegmentBlockMaxDotProduct(segment, key, i, weights[i], deleted, filterDocIds)
+			if term != nil {
+				output[j] = append(output[j], term)
+			}
+		}
+	}
+	return output, lock, nil
+}
+
+// withoutDeleted returns the pairs whose doc ids are not deleted
+func withoutDeleted(pairs []MapPair, deleted *sroar.Bitmap) []MapPair {
+	if deleted.IsEmpty() {
+		return pairs
+	}
+	out := make([]MapPair, 0, len(pairs))
+	for _, pair := range pairs {
+		if len(pair.Key) == 8 && deleted.Contains(binary.BigEndian.Uint64(pair.Key)) {
+			continue
+		}
+		out = append(out, pair)
+	}
+	return out
+}
+
 func addDataToTerm(mem []MapPair, filterDocIds helpers.AllowList, term *terms.Term) (uint64, error) {
 	n := uint64(0)
 	data := make([]terms.DocPointerWithScore, 0, len(mem))
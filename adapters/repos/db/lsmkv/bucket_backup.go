@@ -66,6 +66,24 @@ func (b *Bucket) FlushMemtable() error {
 	return nil
 }
 
+// ArchiveActiveWAL hands over the entries written to the commit log of the
+// active memtable so far to the WAL archiver. Unlike FlushMemtable, it does
+// not create a segment, the log is handed over in full once the memtable is
+// flushed.
+func (b *Bucket) ArchiveActiveWAL() error {
+	if b.walArchiver == nil {
+		return nil
+	}
+
+	// the active memtable can't be switched, and hand over its log, meanwhile
+	b.flushLock.RLock()
+	defer b.flushLock.RUnlock()
+	if b.active == nil {
+		return nil
+	}
+	return b.active.archiveWALPrefix()
+}
+
 // ListFiles lists all files that currently exist in the Bucket. The files are only
 // in a stable state if the memtable is empty, and if compactions are paused. If one
 // of those conditions is not given, it errors
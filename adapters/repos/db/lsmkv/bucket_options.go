@@ -159,6 +159,15 @@ func WithSegmentsCleanupInterval(interval time.Duration) BucketOption {
 	}
 }
 
+// WithWALArchiver hands over the commit logs of flushed memtables to the
+// archiver instead of deleting them.
+func WithWALArchiver(archiver WALArchiver) BucketOption {
+	return func(b *Bucket) error {
+		b.walArchiver = archiver
+		return nil
+	}
+}
+
 /*
 Background for this option:
 
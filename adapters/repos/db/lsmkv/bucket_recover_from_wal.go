@@ -84,6 +84,9 @@ func (b *Bucket) mayRecoverFromCommitLogs(ctx context.Context) error {
 		if err != nil {
 			return err
 		}
+		if err := mt.setWALArchiver(b.walArchiver); err != nil {
+			return errors.Wrap(err, "archive commit log")
+		}
 
 		logOnceWhenRecoveringFromWAL.Do(func() {
 			b.logger.WithField("action", "lsm_recover_from_active_wal").
@@ -13,13 +13,17 @@ package lsmkv
 
 import (
 	"context"
+	"io"
 	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/backup"
 	"github.com/weaviate/weaviate/entities/cyclemanager"
 )
 
@@ -70,10 +74,200 @@ func TestBucket(t *testing.T) {
 				WithSecondaryIndices(1),
 			},
 		},
+		{
+			name: "bucketArchivesWALs",
+			f:    bucketArchivesWALs,
+			opts: []BucketOption{
+				WithStrategy(StrategyReplace),
+			},
+		},
+		{
+			name: "bucketArchivesActiveWALWithoutFlushing",
+			f:    bucketArchivesActiveWALWithoutFlushing,
+			opts: []BucketOption{
+				WithStrategy(StrategyReplace),
+			},
+		},
+		{
+			name: "bucketCutsArchivedWALAtPointInTime",
+			f:    bucketCutsArchivedWALAtPointInTime,
+			opts: []BucketOption{
+				WithStrategy(StrategyReplace),
+			},
+		},
 	}
 	tests.run(ctx, t)
 }
 
+type moveWALArchiver struct {
+	dir      string
+	archived []string
+}
+
+func (a *moveWALArchiver) ArchiveWAL(path string) {
+	dst := filepath.Join(a.dir, filepath.Base(path))
+	if err := os.Rename(path, dst); err == nil {
+		a.archived = append(a.archived, dst)
+	}
+}
+
+func (a *moveWALArchiver) ArchiveWALPrefix(path string, size int64) error {
+	r, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+	dst := filepath.Join(a.dir, filepath.Base(path))
+	w, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+	defer w.Close()
+	if _, err := io.CopyN(w, r, size); err != nil {
+		return err
+	}
+	a.archived = append(a.archived, dst)
+	return nil
+}
+
+func (a *moveWALArchiver) LogTimes(path string) (*backup.LogTimes, error) {
+	return backup.OpenLogTimes(filepath.Join(a.dir, filepath.Base(path)+backup.LogTimesSuffix))
+}
+
+func bucketArchivesWALs(ctx context.Context, t *testing.T, opts []BucketOption) {
+	dirName := t.TempDir()
+	archiver := &moveWALArchiver{dir: t.TempDir()}
+	logger, _ := test.NewNullLogger()
+
+	b, err := NewBucketCreator().NewBucket(ctx, dirName, "", logger, nil,
+		cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+		append(opts, WithWALArchiver(archiver))...)
+	require.NoError(t, err)
+
+	require.NoError(t, b.Put([]byte("key"), []byte("value")))
+	require.NoError(t, b.FlushAndSwitch())
+	require.NoError(t, b.Shutdown(ctx))
+
+	t.Run("flushed commit log is handed over", func(t *testing.T) {
+		require.Len(t, archiver.archived, 1)
+		assert.Equal(t, ".wal", filepath.Ext(archiver.archived[0]))
+
+		segment := strings.TrimSuffix(filepath.Base(archiver.archived[0]), ".wal") + ".db"
+		_, err := os.Stat(filepath.Join(dirName, segment))
+		require.NoError(t, err)
+	})
+
+	t.Run("times of the entries are archived along", func(t *testing.T) {
+		info, err := os.Stat(archiver.archived[0] + backup.LogTimesSuffix)
+		require.NoError(t, err)
+		assert.Equal(t, int64(16), info.Size())
+	})
+
+	t.Run("archived commit log can be replayed", func(t *testing.T) {
+		replayDir := t.TempDir()
+		wal := archiver.archived[0]
+		require.NoError(t, os.Rename(wal, filepath.Join(replayDir, filepath.Base(wal))))
+
+		b, err := NewBucketCreator().NewBucket(ctx, replayDir, "", logger, nil,
+			cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(), opts...)
+		require.NoError(t, err)
+		defer b.Shutdown(ctx)
+
+		val, err := b.Get([]byte("key"))
+		require.NoError(t, err)
+		assert.Equal(t, []byte("value"), val)
+	})
+}
+
+func bucketArchivesActiveWALWithoutFlushing(ctx context.Context, t *testing.T, opts []BucketOption) {
+	dirName := t.TempDir()
+	archiver := &moveWALArchiver{dir: t.TempDir()}
+	logger, _ := test.NewNullLogger()
+
+	b, err := NewBucketCreator().NewBucket(ctx, dirName, "", logger, nil,
+		cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+		append(opts, WithWALArchiver(archiver))...)
+	require.NoError(t, err)
+	defer b.Shutdown(ctx)
+
+	t.Run("empty commit log is not handed over", func(t *testing.T) {
+		require.NoError(t, b.ArchiveActiveWAL())
+		assert.Empty(t, archiver.archived)
+	})
+
+	require.NoError(t, b.Put([]byte("key"), []byte("value")))
+	require.NoError(t, b.ArchiveActiveWAL())
+
+	t.Run("no segment is created", func(t *testing.T) {
+		assert.Equal(t, 0, b.disk.Len())
+		segments, err := filepath.Glob(filepath.Join(dirName, "*.db"))
+		require.NoError(t, err)
+		assert.Empty(t, segments)
+	})
+
+	t.Run("written entries are handed over", func(t *testing.T) {
+		require.Len(t, archiver.archived, 1)
+
+		replayDir := t.TempDir()
+		wal := archiver.archived[0]
+		require.NoError(t, os.Rename(wal, filepath.Join(replayDir, filepath.Base(wal))))
+
+		replayed, err := NewBucketCreator().NewBucket(ctx, replayDir, "", logger, nil,
+			cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(), opts...)
+		require.NoError(t, err)
+		defer replayed.Shutdown(ctx)
+
+		val, err := replayed.Get([]byte("key"))
+		require.NoError(t, err)
+		assert.Equal(t, []byte("value"), val)
+	})
+
+	t.Run("memtable keeps being written", func(t *testing.T) {
+		require.NoError(t, b.Put([]byte("other"), []byte("value")))
+		val, err := b.Get([]byte("key"))
+		require.NoError(t, err)
+		assert.Equal(t, []byte("value"), val)
+	})
+}
+
+func bucketCutsArchivedWALAtPointInTime(ctx context.Context, t *testing.T, opts []BucketOption) {
+	dirName := t.TempDir()
+	archiver := &moveWALArchiver{dir: t.TempDir()}
+	logger, _ := test.NewNullLogger()
+
+	b, err := NewBucketCreator().NewBucket(ctx, dirName, "", logger, nil,
+		cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+		append(opts, WithWALArchiver(archiver))...)
+	require.NoError(t, err)
+
+	// both writes end up in the same commit log
+	require.NoError(t, b.Put([]byte("before"), []byte("value")))
+	time.Sleep(10 * time.Millisecond)
+	pointInTime := time.Now()
+	time.Sleep(10 * time.Millisecond)
+	require.NoError(t, b.Put([]byte("after"), []byte("value")))
+	require.NoError(t, b.FlushAndSwitch())
+	require.NoError(t, b.Shutdown(ctx))
+	require.Len(t, archiver.archived, 1)
+
+	replayDir := t.TempDir()
+	wal := filepath.Join(replayDir, filepath.Base(archiver.archived[0]))
+	require.NoError(t, os.Rename(archiver.archived[0], wal))
+	require.NoError(t, backup.CutLog(wal, archiver.archived[0]+backup.LogTimesSuffix, pointInTime, time.Now()))
+
+	b, err = NewBucketCreator().NewBucket(ctx, replayDir, "", logger, nil,
+		cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(), opts...)
+	require.NoError(t, err)
+	defer b.Shutdown(ctx)
+
+	val, err := b.Get([]byte("before"))
+	require.NoError(t, err)
+	assert.Equal(t, []byte("value"), val)
+	val, err = b.Get([]byte("after"))
+	require.NoError(t, err)
+	assert.Nil(t, val, "write after the point in time must not be replayed")
+}
+
 func bucket_WasDeleted_KeepTombstones(ctx context.Context, t *testing.T, opts []BucketOption) {
 	tmpDir := t.TempDir()
 	logger, _ := test.NewNullLogger()
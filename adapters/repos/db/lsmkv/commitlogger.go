@@ -18,9 +18,11 @@ import (
 	"fmt"
 	"os"
 	"sync/atomic"
+	"time"
 
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/rwhasher"
 	"github.com/weaviate/weaviate/adapters/repos/db/roaringset"
+	"github.com/weaviate/weaviate/entities/backup"
 )
 
 type commitLogger struct {
@@ -36,6 +38,9 @@ type commitLogger struct {
 	// e.g. when recovering from an existing log, we do not want to write into a
 	// new log again
 	paused bool
+
+	// optional, records the time of every entry if the log is archived
+	times *backup.LogTimes
 }
 
 // commit log entry data format
@@ -137,8 +142,11 @@ func (cl *commitLogger) writeEntry(commitType CommitType, nodeBytes []byte) erro
 		return err
 	}
 
-	cl.n.Add(int64(1 + 1 + 4 + len(nodeBytes) + checksumSize))
+	end := cl.n.Add(int64(1 + 1 + 4 + len(nodeBytes) + checksumSize))
 
+	if cl.times != nil {
+		return cl.times.Record(end, time.Now())
+	}
 	return nil
 }
 
@@ -204,6 +212,13 @@ func (cl *commitLogger) Size() int64 {
 }
 
 func (cl *commitLogger) close() error {
+	if cl.times != nil {
+		if err := cl.times.Close(); err != nil {
+			return err
+		}
+		cl.times = nil
+	}
+
 	if !cl.paused {
 		if err := cl.writer.Flush(); err != nil {
 			return err
@@ -235,5 +250,11 @@ func (cl *commitLogger) flushBuffers() error {
 		return fmt.Errorf("flushing WAL %q: %w", cl.path, err)
 	}
 
+	if cl.times != nil {
+		if err := cl.times.Flush(); err != nil {
+			return fmt.Errorf("flushing times of WAL %q: %w", cl.path, err)
+		}
+	}
+
 	return nil
 }
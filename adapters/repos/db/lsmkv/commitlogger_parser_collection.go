@@ -83,7 +83,8 @@ func (p *commitloggerParser) parseCollectionNode(reader io.Reader) error {
 		return err
 	}
 
-	if p.strategy == StrategyMapCollection {
+	// inverted memtables hold their data as a map until they are flushed
+	if p.strategy == StrategyMapCollection || p.strategy == StrategyInverted {
 		return p.parseMapNode(n)
 	}
 
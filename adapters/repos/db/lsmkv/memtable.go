@@ -47,6 +47,8 @@ type Memtable struct {
 	metrics   *memtableMetrics
 
 	tombstones *sroar.Bitmap
+
+	walArchiver WALArchiver
 }
 
 func newMemtable(path string, strategy string, secondaryIndices uint16,
@@ -89,6 +91,42 @@ func newMemtable(path string, strategy string, secondaryIndices uint16,
 	return m, nil
 }
 
+// setWALArchiver makes the memtable hand over its commit log to the archiver
+// once it has been flushed. Until then, the times of the entries written to
+// the log are recorded.
+func (m *Memtable) setWALArchiver(archiver WALArchiver) error {
+	m.walArchiver = archiver
+	if archiver == nil || m.commitlog.paused {
+		return nil
+	}
+	times, err := archiver.LogTimes(m.commitlog.path)
+	if err != nil {
+		return err
+	}
+	m.commitlog.times = times
+	return nil
+}
+
+// archiveWALPrefix hands over the entries written to the commit log so far to
+// the archiver, without flushing the memtable
+func (m *Memtable) archiveWALPrefix() error {
+	if m.walArchiver == nil || m.commitlog.paused {
+		return nil
+	}
+
+	m.Lock()
+	err := m.commitlog.flushBuffers()
+	size := m.commitlog.Size()
+	m.Unlock()
+	if err != nil {
+		return err
+	}
+	if size == 0 {
+		return nil
+	}
+	return m.walArchiver.ArchiveWALPrefix(m.commitlog.path, size)
+}
+
 func (m *Memtable) get(key []byte) ([]byte, error) {
 	start := time.Now()
 	defer m.metrics.get(start.UnixNano())
@@ -113,6 +113,10 @@ func (m *Memtable) flush() error {
 	// only now that the file has been flushed is it safe to delete the commit log
 	// TODO: there might be an interest in keeping the commit logs around for
 	// longer as they might come in handy for replication
+	if m.walArchiver != nil {
+		m.walArchiver.ArchiveWAL(m.commitlog.path)
+		return nil
+	}
 	return m.commitlog.delete()
 }
 
@@ -17,6 +17,7 @@ package lsmkv
 import (
 	"bytes"
 	"context"
+	"encoding/binary"
 	"fmt"
 	"io"
 	"os"
@@ -26,6 +27,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/segmentindex"
 	"github.com/weaviate/weaviate/entities/cyclemanager"
 	"github.com/weaviate/weaviate/entities/filters"
 )
@@ -952,3 +954,97 @@ func TestMapStrategy_RecoverFromWAL(t *testing.T) {
 		})
 	})
 }
+
+func TestInvertedStrategy_RecoverFromWAL(t *testing.T) {
+	dirNameOriginal := t.TempDir()
+	dirNameRecovered := t.TempDir()
+
+	t.Run("without prior state", func(t *testing.T) {
+		b, err := NewBucketCreator().NewBucket(testCtx(), dirNameOriginal, "", nullLogger(), nil,
+			cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+			WithStrategy(StrategyInverted))
+		require.Nil(t, err)
+
+		// so big it effectively never triggers as part of this test
+		b.SetMemtableThreshold(1e9)
+
+		rowKey1 := []byte("term-1")
+		rowKey2 := []byte("term-2")
+
+		expected1 := []MapPair{
+			NewMapPairFromDocIdAndTf(0, 1, 10, false),
+			NewMapPairFromDocIdAndTf(2, 3, 12, false),
+		}
+		expected2 := []MapPair{
+			NewMapPairFromDocIdAndTf(1, 2, 8, false),
+		}
+
+		t.Run("set original values", func(t *testing.T) {
+			require.Nil(t, b.MapSet(rowKey1, NewMapPairFromDocIdAndTf(0, 1, 10, false)))
+			require.Nil(t, b.MapSet(rowKey1, NewMapPairFromDocIdAndTf(1, 1, 8, false)))
+			require.Nil(t, b.MapSet(rowKey1, NewMapPairFromDocIdAndTf(2, 3, 12, false)))
+			require.Nil(t, b.MapSet(rowKey2, NewMapPairFromDocIdAndTf(1, 2, 8, false)))
+		})
+
+		t.Run("delete one doc from the first term", func(t *testing.T) {
+			docId := make([]byte, 8)
+			binary.BigEndian.PutUint64(docId, 1)
+			require.Nil(t, b.MapDeleteKey(rowKey1, docId))
+		})
+
+		t.Run("validate the results prior to recovery", func(t *testing.T) {
+			res, err := b.MapList(testCtx(), rowKey1)
+			require.Nil(t, err)
+			assert.Equal(t, expected1, res)
+			res, err = b.MapList(testCtx(), rowKey2)
+			require.Nil(t, err)
+			assert.Equal(t, expected2, res)
+		})
+
+		t.Run("make sure the WAL is flushed", func(t *testing.T) {
+			require.Nil(t, b.WriteWAL())
+		})
+
+		t.Run("copy state into recovery folder and destroy original", func(t *testing.T) {
+			cmd := exec.Command("/bin/bash", "-c", fmt.Sprintf("cp -r %s/*.wal %s",
+				dirNameOriginal, dirNameRecovered))
+			var out bytes.Buffer
+			cmd.Stderr = &out
+			err := cmd.Run()
+			if err != nil {
+				fmt.Println(out.String())
+				t.Fatal(err)
+			}
+			b = nil
+			require.Nil(t, os.RemoveAll(dirNameOriginal))
+		})
+
+		var bRec *Bucket
+
+		t.Run("create new bucket from existing state", func(t *testing.T) {
+			b, err := NewBucketCreator().NewBucket(testCtx(), dirNameRecovered, "", nullLogger(), nil,
+				cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+				WithStrategy(StrategyInverted))
+			require.Nil(t, err)
+
+			// so big it effectively never triggers as part of this test
+			b.SetMemtableThreshold(1e9)
+
+			bRec = b
+		})
+
+		t.Run("validate the results after recovery", func(t *testing.T) {
+			res, err := bRec.MapList(testCtx(), rowKey1)
+			require.Nil(t, err)
+			assert.Equal(t, expected1, res)
+			res, err = bRec.MapList(testCtx(), rowKey2)
+			require.Nil(t, err)
+			assert.Equal(t, expected2, res)
+		})
+
+		t.Run("recovered WAL is flushed into an inverted segment", func(t *testing.T) {
+			require.Equal(t, 1, bRec.disk.Len())
+			assert.Equal(t, segmentindex.StrategyInverted, bRec.disk.segments[0].strategy)
+		})
+	})
+}
@@ -139,6 +139,9 @@ type SegmentBlockMax struct {
 	b                    float32
 	k1                   float32
 	propertyBoost        float32
+	// dotProduct scores the frequencies as weights instead of with BM25, see
+	// NewSegmentBlockMaxDotProduct
+	dotProduct bool
 
 	currentBlockImpact float32
 	tombstones         *sroar.Bitmap
@@ -189,6 +192,19 @@ func NewSegmentBlockMax(s *segment, key []byte, queryTermIndex int, idf float64,
 	return output
 }
 
+// NewSegmentBlockMaxDotProduct creates a term that scores a document with the
+// product of its frequency and the weight instead of BM25. It is used for
+// sparse vectors, which store their quantized weights as frequencies.
+func NewSegmentBlockMaxDotProduct(s *segment, key []byte, queryTermIndex int, weight float64, tombstones *sroar.Bitmap, filterDocIds helpers.AllowList) *SegmentBlockMax {
+	output := NewSegmentBlockMax(s, key, queryTermIndex, weight, 1, tombstones, filterDocIds, 1, schema.BM25Config{})
+	if output == nil {
+		return nil
+	}
+	output.dotProduct = true
+	output.currentBlockImpact = output.computeCurrentBlockImpact()
+	return output
+}
+
 func NewSegmentBlockMaxTest(docCount uint64, blockEntries []*terms.BlockEntry, blockDatas []*terms.BlockData, propLengths map[uint64]uint32, key []byte, queryTermIndex int, idf float64, propertyBoost float32, tombstones *sroar.Bitmap, filterDocIds helpers.AllowList, averagePropLength float64, config schema.BM25Config, codecs []varenc.VarEncDataType) *SegmentBlockMax {
 	decoders := make([]varenc.VarEncEncoder[uint64], len(codecs))
 
@@ -278,6 +294,9 @@ func (s *SegmentBlockMax) reset() error {
 	s.decoded = true
 
 	s.advanceOnTombstoneOrFilter()
+	if !s.exhausted {
+		s.idPointer = s.blockDataDecoded.DocIds[s.blockDataIdx]
+	}
 
 	return nil
 }
@@ -298,6 +317,9 @@ func (s *SegmentBlockMax) decodeBlock() error {
 		s.idPointer = s.blockDataDecoded.DocIds[s.blockDataIdx]
 		s.blockDataSize = int(s.docCount)
 		s.freqDecoded = true
+		if s.dotProduct {
+			s.currentBlockImpact = s.computeCurrentBlockImpact()
+		}
 		s.Metrics.BlockCountDecodedDocIds++
 		s.Metrics.DocCountDecodedDocIds += uint64(s.blockDataSize)
 		return nil
@@ -337,9 +359,11 @@ func (s *SegmentBlockMax) AdvanceAtLeast(docId uint64) {
 	}
 
 	if !s.decoded {
+		// a shallow advance moved to a new block without decoding it, the
+		// docs before docId and the ones filtered out still need to be
+		// skipped below
 		s.decodeBlock()
 		s.decoded = true
-		return
 	}
 
 	advanced := false
@@ -426,7 +450,10 @@ func (s *SegmentBlockMax) Score(averagePropLength float64, additionalExplanation
 
 	freq := float32(s.blockDataDecoded.Tfs[s.blockDataIdx])
 	propLength := s.propLengths[s.blockDataDecoded.DocIds[s.blockDataIdx]]
-	tf := freq / (freq + s.k1*(1-s.b+s.b*(float32(propLength)/s.averagePropLength)))
+	tf := freq
+	if !s.dotProduct {
+		tf = freq / (freq + s.k1*(1-s.b+s.b*(float32(propLength)/s.averagePropLength)))
+	}
 	s.Metrics.DocCountScored++
 	if s.blockEntryIdx != s.Metrics.LastAddedBlock {
 		s.Metrics.BlockCountDecodedFreqs++
@@ -475,6 +502,17 @@ func (s *SegmentBlockMax) Advance() {
 
 func (s *SegmentBlockMax) computeCurrentBlockImpact() float32 {
 	freq := float32(s.blockEntries[s.blockEntryIdx].MaxImpactTf)
+	if s.dotProduct {
+		if s.docCount <= uint64(terms.ENCODE_AS_FULL_BYTES) {
+			// the block entry of short lists only holds the first frequency
+			for _, tf := range s.blockDataDecoded.Tfs[:s.docCount] {
+				if float32(tf) > freq {
+					freq = float32(tf)
+				}
+			}
+		}
+		return float32(s.idf) * freq * s.propertyBoost
+	}
 	propLength := float32(s.blockEntries[s.blockEntryIdx].MaxImpactPropLength)
 	return float32(s.idf) * (freq / (freq + s.k1*(1-s.b+s.b*(propLength/float32(s.averagePropLength))))) * s.propertyBoost
 }
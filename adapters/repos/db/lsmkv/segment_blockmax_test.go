@@ -12,9 +12,20 @@
 package lsmkv
 
 import (
+	"context"
+	"encoding/binary"
 	"fmt"
+	"math"
+	"sort"
 	"testing"
 
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/sroar"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/terms"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
 	"github.com/weaviate/weaviate/entities/schema"
 )
 
@@ -37,3 +48,208 @@ func TestSerializeAndParseInvertedNodeTest(t *testing.T) {
 
 	fmt.Println(sbm)
 }
+
+// newBlockMaxTestSegment flushes the doc ids 0 to docs-1 of a single key into
+// an inverted segment, which spans several blocks
+func newBlockMaxTestSegment(t *testing.T, key []byte, docs int) *segment {
+	tfs := map[uint64]float32{}
+	for id := 0; id < docs; id++ {
+		tfs[uint64(id)] = float32(1 + id%3)
+	}
+	return newBlockMaxTestSegmentTerms(t, map[string]map[uint64]float32{string(key): tfs})
+}
+
+// newBlockMaxTestSegmentTerms flushes the term frequencies by doc id of every
+// key into an inverted segment. All docs have a property length of 10.
+func newBlockMaxTestSegmentTerms(t *testing.T, postings map[string]map[uint64]float32) *segment {
+	ctx := context.Background()
+	dirName := t.TempDir()
+	logger, _ := test.NewNullLogger()
+
+	b, err := NewBucketCreator().NewBucket(ctx, dirName, "", logger, nil,
+		cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+		WithStrategy(StrategyInverted))
+	require.NoError(t, err)
+	t.Cleanup(func() { b.Shutdown(ctx) })
+
+	for key, tfs := range postings {
+		for id, tf := range tfs {
+			docID := make([]byte, 8)
+			binary.BigEndian.PutUint64(docID, id)
+			value := make([]byte, 8)
+			binary.LittleEndian.PutUint32(value[0:4], math.Float32bits(tf))
+			binary.LittleEndian.PutUint32(value[4:8], math.Float32bits(10))
+			require.NoError(t, b.MapSet([]byte(key), MapPair{Key: docID, Value: value}))
+		}
+	}
+	require.NoError(t, b.FlushAndSwitch())
+	require.Len(t, b.disk.segments, 1)
+	return b.disk.segments[0]
+}
+
+func TestSegmentBlockMaxBM25(t *testing.T) {
+	key := []byte("term")
+	seg := newBlockMaxTestSegment(t, key, 300)
+	config := schema.BM25Config{K1: 1.2, B: 0.75}
+
+	t.Run("advance after a shallow advance skips to the doc id", func(t *testing.T) {
+		sbm := NewSegmentBlockMax(seg, key, 0, 1, 1, nil, nil, 10, config)
+		require.NotNil(t, sbm)
+		require.Equal(t, uint64(0), sbm.IdPointer())
+
+		// the block max WAND moves to the block of a pivot without decoding it
+		// to get its upper bound, and only then advances to the pivot
+		sbm.AdvanceAtLeastShallow(200)
+		sbm.AdvanceAtLeast(200)
+		assert.Equal(t, uint64(200), sbm.IdPointer())
+
+		id, _, _ := sbm.Score(10, false)
+		assert.Equal(t, uint64(200), id)
+	})
+
+	t.Run("advance after a shallow advance skips filtered docs", func(t *testing.T) {
+		allow := helpers.NewAllowList()
+		for id := uint64(0); id < 300; id++ {
+			if id < 128 || id > 250 {
+				allow.Insert(id)
+			}
+		}
+		sbm := NewSegmentBlockMax(seg, key, 0, 1, 1, nil, allow, 10, config)
+		require.NotNil(t, sbm)
+
+		sbm.AdvanceAtLeastShallow(130)
+		sbm.AdvanceAtLeast(130)
+		assert.Equal(t, uint64(251), sbm.IdPointer())
+	})
+
+	t.Run("deleted first doc is skipped", func(t *testing.T) {
+		tombstones := sroar.NewBitmap()
+		tombstones.Set(0)
+		tombstones.Set(1)
+		sbm := NewSegmentBlockMax(seg, key, 0, 1, 1, tombstones, nil, 10, config)
+		require.NotNil(t, sbm)
+
+		assert.Equal(t, uint64(2), sbm.IdPointer())
+		id, _, _ := sbm.Score(10, false)
+		assert.Equal(t, uint64(2), id)
+	})
+}
+
+func TestSegmentBlockMaxWandBM25(t *testing.T) {
+	config := schema.BM25Config{K1: 1.2, B: 0.75}
+	idfs := map[string]float64{"common": 0.5, "rare": 2}
+	limit := 10
+
+	postingsRange := func(tfs map[uint64]float32, from, to uint64, tf float32) {
+		for id := from; id < to; id++ {
+			tfs[id] = tf
+		}
+	}
+
+	type testCase struct {
+		name     string
+		postings map[string]map[uint64]float32
+		// skipped is the best scoring doc, which is deleted or filtered out
+		skipped uint64
+	}
+
+	firstDoc := testCase{
+		name:     "first doc",
+		postings: map[string]map[uint64]float32{"common": {}, "rare": {}},
+		skipped:  0,
+	}
+	postingsRange(firstDoc.postings["common"], 0, 200, 1)
+	postingsRange(firstDoc.postings["rare"], 0, 200, 1)
+	firstDoc.postings["common"][0] = 50
+	firstDoc.postings["rare"][0] = 50
+
+	// The first docs fill the heap. The pivot is then on "common" at 350, so
+	// the upper bound shallow advances "rare" into its second block, which
+	// starts with doc 300, and "rare" is advanced to the pivot.
+	shallowAdvance := testCase{
+		name:     "first doc of a block after a shallow advance",
+		postings: map[string]map[uint64]float32{"common": {}, "rare": {}},
+		skipped:  300,
+	}
+	postingsRange(shallowAdvance.postings["common"], 0, 10, 50)
+	postingsRange(shallowAdvance.postings["common"], 350, 600, 1)
+	postingsRange(shallowAdvance.postings["rare"], 0, 128, 1)
+	shallowAdvance.postings["rare"][64] = 2
+	shallowAdvance.postings["rare"][300] = 50
+	postingsRange(shallowAdvance.postings["rare"], 350, 477, 2)
+
+	for _, tc := range []testCase{firstDoc, shallowAdvance} {
+		seg := newBlockMaxTestSegmentTerms(t, tc.postings)
+
+		expectedScore := func(id uint64) float64 {
+			var score float64
+			for key, tfs := range tc.postings {
+				if tf, ok := tfs[id]; ok {
+					score += idfs[key] * float64(tf) / (float64(tf) + config.K1)
+				}
+			}
+			return score
+		}
+
+		search := func(tombstones *sroar.Bitmap, allow helpers.AllowList) map[uint64]float64 {
+			all := []terms.TermInterface{}
+			for i, key := range []string{"common", "rare"} {
+				sbm := NewSegmentBlockMax(seg, []byte(key), i, idfs[key], 1, tombstones, allow, 10, config)
+				require.NotNil(t, sbm)
+				all = append(all, sbm)
+			}
+			topK := terms.DoBlockMaxWand(limit, &terms.Terms{T: all, Count: len(all)}, 10, false)
+
+			results := map[uint64]float64{}
+			for topK.Len() > 0 {
+				item := topK.Pop()
+				results[item.ID] = float64(item.Dist)
+			}
+			return results
+		}
+
+		// the scores are compared instead of the ids, as docs can tie
+		assertResults := func(t *testing.T, results map[uint64]float64) {
+			var expected, actual []float64
+			for id := uint64(0); id < 600; id++ {
+				if _, ok := tc.postings["common"][id]; !ok {
+					if _, ok := tc.postings["rare"][id]; !ok {
+						continue
+					}
+				}
+				if id != tc.skipped {
+					expected = append(expected, expectedScore(id))
+				}
+			}
+			sort.Float64s(expected)
+			expected = expected[len(expected)-limit:]
+
+			require.Len(t, results, limit)
+			for id, score := range results {
+				assert.InDelta(t, expectedScore(id), score, 1e-4, "doc %d", id)
+				actual = append(actual, score)
+			}
+			sort.Float64s(actual)
+			assert.InDeltaSlice(t, expected, actual, 1e-4)
+			assert.NotContains(t, results, tc.skipped)
+		}
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Run("deleted", func(t *testing.T) {
+				tombstones := sroar.NewBitmap()
+				tombstones.Set(tc.skipped)
+				assertResults(t, search(tombstones, nil))
+			})
+
+			t.Run("filtered", func(t *testing.T) {
+				allow := helpers.NewAllowList()
+				for id := uint64(0); id < 600; id++ {
+					if id != tc.skipped {
+						allow.Insert(id)
+					}
+				}
+				assertResults(t, search(nil, allow))
+			})
+		})
+	}
+}
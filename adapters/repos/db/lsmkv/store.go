@@ -28,6 +28,7 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/backup"
 	"github.com/weaviate/weaviate/entities/cyclemanager"
 	"github.com/weaviate/weaviate/entities/errorcompounder"
 	"github.com/weaviate/weaviate/entities/storagestate"
@@ -58,6 +59,28 @@ type Store struct {
 
 	closeLock sync.RWMutex
 	closed    bool
+
+	walArchiver WALArchiver
+}
+
+// WALArchiver takes over the commit log of a memtable once the memtable has
+// been flushed to a segment. It must move the log away, as a log remaining
+// in the bucket's directory would be recovered again on the next startup.
+type WALArchiver interface {
+	ArchiveWAL(path string)
+	// ArchiveWALPrefix hands over the first size bytes of the commit log at
+	// path, which is still being written. The log is handed over in full by
+	// ArchiveWAL once its memtable has been flushed.
+	ArchiveWALPrefix(path string, size int64) error
+	// LogTimes opens the record of the times the entries of the commit log at
+	// path are written at, which is handed over along with the log
+	LogTimes(path string) (*backup.LogTimes, error)
+}
+
+// SetWALArchiver makes all buckets created afterwards hand over the commit
+// logs of flushed memtables to the archiver instead of deleting them.
+func (s *Store) SetWALArchiver(archiver WALArchiver) {
+	s.walArchiver = archiver
 }
 
 // New initializes a new [Store] based on the root dir. If state is present on
@@ -188,6 +211,10 @@ func (s *Store) CreateOrLoadBucket(ctx context.Context, bucketName string,
 		compactionCallbacks = s.cycleCallbacks.compactionAuxCallbacks
 	}
 
+	if s.walArchiver != nil {
+		opts = append(opts, WithWALArchiver(s.walArchiver))
+	}
+
 	// bucket can be concurrently loaded with another buckets but
 	// the same bucket will be loaded only once
 	b, err := s.bcreator.NewBucket(ctx, s.bucketDir(bucketName), s.rootDir, s.logger, s.metrics,
@@ -455,6 +482,9 @@ func (s *Store) ReplaceBuckets(ctx context.Context, bucketName, replacementBucke
 
 	s.updateBucketDir(bucket, currBucketDir, newBucketDir)
 	s.updateBucketDir(replacementBucket, currReplacementBucketDir, newReplacementBucketDir)
+	// the replacement bucket now lives in the dir of the original bucket, which
+	// stays registered. Its previous dir is free to be used by a new bucket.
+	GlobalBucketRegistry.Remove(currReplacementBucketDir)
 
 	if err := bucket.Shutdown(ctx); err != nil {
 		return errors.Wrapf(err, "failed shutting down bucket old '%s'", bucketName)
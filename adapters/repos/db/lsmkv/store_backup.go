@@ -87,3 +87,13 @@ func (s *Store) FlushMemtables(ctx context.Context) error {
 	_, err := s.runJobOnBuckets(ctx, flushMemtable, nil)
 	return err
 }
+
+// ArchiveActiveWALs hands over the entries written to the commit logs of the
+// active memtables of all buckets to the WAL archiver, without flushing them
+func (s *Store) ArchiveActiveWALs(ctx context.Context) error {
+	archiveWAL := func(ctx context.Context, b *Bucket) (interface{}, error) {
+		return nil, b.ArchiveActiveWAL()
+	}
+	_, err := s.runJobOnBuckets(ctx, archiveWAL, nil)
+	return err
+}
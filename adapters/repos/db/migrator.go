@@ -23,6 +23,9 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/dynamic"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/flat"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/ivf"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/sparse"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/vamana"
 	command "github.com/weaviate/weaviate/cluster/proto/api"
 	"github.com/weaviate/weaviate/cluster/types"
 	"github.com/weaviate/weaviate/entities/errorcompounder"
@@ -119,6 +122,7 @@ func (m *Migrator) AddClass(ctx context.Context, class *models.Class,
 			SeparateObjectsCompactions:     m.db.config.SeparateObjectsCompactions,
 			MaxSegmentSize:                 m.db.config.MaxSegmentSize,
 			HNSWMaxLogSize:                 m.db.config.HNSWMaxLogSize,
+			LogArchiving:                   m.db.config.LogArchiving,
 			HNSWWaitForCachePrefill:        m.db.config.HNSWWaitForCachePrefill,
 			HNSWFlatSearchConcurrency:      m.db.config.HNSWFlatSearchConcurrency,
 			VisitedListPoolMaxSize:         m.db.config.VisitedListPoolMaxSize,
@@ -611,6 +615,12 @@ func (m *Migrator) ValidateVectorIndexConfigUpdate(
 		return flat.ValidateUserConfigUpdate(old, updated)
 	case vectorindex.VectorIndexTypeDYNAMIC:
 		return dynamic.ValidateUserConfigUpdate(old, updated)
+	case vectorindex.VectorIndexTypeIVF:
+		return ivf.ValidateUserConfigUpdate(old, updated)
+	case vectorindex.VectorIndexTypeVAMANA:
+		return vamana.ValidateUserConfigUpdate(old, updated)
+	case vectorindex.VectorIndexTypeSPARSE:
+		return sparse.ValidateUserConfigUpdate(old, updated)
 	}
 	return fmt.Errorf("Invalid index type: %s", old.IndexType())
 }
@@ -918,3 +918,138 @@ func normalize(v []float32) []float32 {
 
 	return v
 }
+
+func Test_MultiShardJourneys_GroupBy(t *testing.T) {
+	repo, logger := setupMultiShardTest(t)
+	defer func() {
+		repo.Shutdown(context.Background())
+	}()
+
+	newClass := func(name string) *models.Class {
+		return &models.Class{
+			Class:               name,
+			VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+			InvertedIndexConfig: invertedConfig(),
+			Properties: []*models.Property{
+				{
+					Name:         "topic",
+					DataType:     schema.DataTypeText.PropString(),
+					Tokenization: models.PropertyTokenizationField,
+				},
+			},
+		}
+	}
+	sizeClass, diverseClass := newClass("GroupBySize"), newClass("GroupByDiverse")
+	t.Run("prepare", makeTestMultiShardSchema(repo, logger, true, sizeClass, diverseClass))
+
+	// the ids are picked so that the objects end up in the shards the test
+	// needs them in, the shard state is fixed
+	shardState := fixedMultiShardState()
+	shards := shardState.AllPhysicalShards()
+	sort.Strings(shards)
+	next := 0
+	idInShard := func(shard int) strfmt.UUID {
+		for ; ; next++ {
+			id := uuid.MustParse(fmt.Sprintf("%08x-0000-4000-8000-000000000000", next))
+			if shardState.Shard("", string(id[:])) == shards[shard] {
+				next++
+				return strfmt.UUID(id.String())
+			}
+		}
+	}
+
+	type object struct {
+		shard  int
+		topic  string
+		vector []float32
+	}
+	put := func(t *testing.T, class string, objs []object) map[strfmt.UUID]int {
+		ids := map[strfmt.UUID]int{}
+		for i, obj := range objs {
+			id := idInShard(obj.shard)
+			ids[id] = i
+			require.Nil(t, repo.PutObject(context.Background(), &models.Object{
+				ID:         id,
+				Class:      class,
+				Properties: map[string]interface{}{"topic": obj.topic},
+			}, obj.vector, nil, nil, 0))
+		}
+		return ids
+	}
+	groups := func(t *testing.T, res search.Results) []*additional.Group {
+		out := make([]*additional.Group, len(res))
+		for i := range res {
+			group, ok := res[i].AdditionalProperties["group"].(*additional.Group)
+			require.True(t, ok)
+			out[i] = group
+		}
+		return out
+	}
+
+	t.Run("sort by size counts the groups of all shards", func(t *testing.T) {
+		// topic b is the smallest group on every shard, but the largest overall
+		put(t, sizeClass.Class, []object{
+			{shard: 0, topic: "a", vector: []float32{1, 0.1}},
+			{shard: 0, topic: "a", vector: []float32{1, 0.2}},
+			{shard: 0, topic: "b", vector: []float32{1, 0.3}},
+			{shard: 1, topic: "c", vector: []float32{1, 0.1}},
+			{shard: 1, topic: "c", vector: []float32{1, 0.2}},
+			{shard: 1, topic: "b", vector: []float32{1, 0.3}},
+			{shard: 2, topic: "d", vector: []float32{1, 0.1}},
+			{shard: 2, topic: "d", vector: []float32{1, 0.2}},
+			{shard: 2, topic: "b", vector: []float32{1, 0.3}},
+		})
+
+		res, err := repo.VectorSearch(context.Background(), dto.GetParams{
+			ClassName:            sizeClass.Class,
+			Pagination:           &filters.Pagination{Limit: 100},
+			AdditionalProperties: additional.Properties{Group: true},
+			GroupBy: &searchparams.GroupBy{
+				Property:        "topic",
+				Groups:          1,
+				ObjectsPerGroup: 10,
+				Sort:            &searchparams.GroupBySort{By: searchparams.GroupBySortSize},
+			},
+		}, []string{""}, [][]float32{{1, 0}})
+		require.Nil(t, err)
+
+		arranged := groups(t, res)
+		require.Len(t, arranged, 1)
+		assert.Equal(t, "b", arranged[0].GroupedBy.Value)
+		assert.Equal(t, 3, arranged[0].Count)
+		assert.Len(t, arranged[0].Hits, 3)
+	})
+
+	t.Run("diverse strategy picks among the hits of all shards", func(t *testing.T) {
+		// the second shard alone would pick its closest object and the one at
+		// -80°, which is not the furthest from the closest object of all shards
+		ids := put(t, diverseClass.Class, []object{
+			{shard: 0, topic: "a", vector: []float32{1, 0}},
+			{shard: 1, topic: "a", vector: []float32{0.9848, 0.1736}},
+			{shard: 1, topic: "a", vector: []float32{0.1736, -0.9848}},
+			{shard: 1, topic: "a", vector: []float32{0, 1}},
+		})
+
+		res, err := repo.VectorSearch(context.Background(), dto.GetParams{
+			ClassName:            diverseClass.Class,
+			Pagination:           &filters.Pagination{Limit: 100},
+			AdditionalProperties: additional.Properties{Group: true, Vector: true},
+			GroupBy: &searchparams.GroupBy{
+				Property:        "topic",
+				Groups:          1,
+				ObjectsPerGroup: 2,
+				Strategy:        searchparams.GroupByStrategyDiverse,
+			},
+		}, []string{""}, [][]float32{{1, 0}})
+		require.Nil(t, err)
+
+		arranged := groups(t, res)
+		require.Len(t, arranged, 1)
+		assert.Equal(t, 4, arranged[0].Count)
+		picked := []int{}
+		for _, hit := range arranged[0].Hits {
+			picked = append(picked, ids[hit["_additional"].(*additional.GroupHitAdditional).ID])
+		}
+		assert.Equal(t, []int{0, 3}, picked)
+	})
+}
@@ -17,6 +17,7 @@ import (
 	"sort"
 
 	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
 	enterrors "github.com/weaviate/weaviate/entities/errors"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
@@ -190,6 +191,7 @@ func (i *Index) getShardsNodeStatus(ctx context.Context,
 		// FIXME stats of target vectors
 		var queueLen int64
 		var compressed bool
+		var requantization *models.NodeShardRequantizationStatus
 		if shard.hasTargetVectors() {
 			for _, queue := range shard.Queues() {
 				queueLen += queue.Size()
@@ -200,9 +202,15 @@ func (i *Index) getShardsNodeStatus(ctx context.Context,
 					break
 				}
 			}
+			for _, vectorIndex := range shard.VectorIndexes() {
+				if requantization = requantizationStatus(vectorIndex); requantization != nil {
+					break
+				}
+			}
 		} else {
 			queueLen = shard.Queue().Size()
 			compressed = shard.VectorIndex().Compressed()
+			requantization = requantizationStatus(shard.VectorIndex())
 		}
 
 		shardStatus := &models.NodeShardStatus{
@@ -213,6 +221,7 @@ func (i *Index) getShardsNodeStatus(ctx context.Context,
 			VectorQueueLength:    queueLen,
 			Compressed:           compressed,
 			Loaded:               true,
+			Requantization:       requantization,
 		}
 		*status = append(*status, shardStatus)
 		shardCount++
@@ -221,6 +230,29 @@ func (i *Index) getShardsNodeStatus(ctx context.Context,
 	return
 }
 
+type requantizableIndexer interface {
+	RequantizationStatus() (common.RequantizationStatus, bool)
+}
+
+// requantizationStatus returns the progress of the vector index switching to
+// a new quantizer, or nil if it is not doing so
+func requantizationStatus(vectorIndex VectorIndex) *models.NodeShardRequantizationStatus {
+	index, ok := vectorIndex.(requantizableIndexer)
+	if !ok {
+		return nil
+	}
+	status, running := index.RequantizationStatus()
+	if !running {
+		return nil
+	}
+	return &models.NodeShardRequantizationStatus{
+		Compression:      status.Compression,
+		VectorsProcessed: int64(status.Processed),
+		VectorsTotal:     int64(status.Total),
+		Progress:         float32(status.Progress()),
+	}
+}
+
 func (db *DB) GetNodeStatistics(ctx context.Context) ([]*models.Statistics, error) {
 	nodeStatistics := make([]*models.Statistics, len(db.schemaGetter.Nodes()))
 	eg := enterrors.NewErrorGroupWrapper(db.logger)
@@ -197,6 +197,7 @@ type Config struct {
 	SeparateObjectsCompactions     bool
 	MaxSegmentSize                 int64
 	HNSWMaxLogSize                 int64
+	LogArchiving                   bool
 	HNSWWaitForCachePrefill        bool
 	HNSWFlatSearchConcurrency      int
 	VisitedListPoolMaxSize         int
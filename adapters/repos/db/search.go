@@ -21,10 +21,12 @@ import (
 	enterrors "github.com/weaviate/weaviate/entities/errors"
 
 	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
 	"github.com/weaviate/weaviate/adapters/repos/db/refcache"
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/aggregation"
 	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/facets"
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/search"
@@ -46,6 +48,31 @@ func (db *DB) Aggregate(ctx context.Context,
 	return idx.aggregate(ctx, params, modules)
 }
 
+// Facets counts the objects per value of the facet properties among all
+// candidates of a search, merged across the shards of the class
+func (db *DB) Facets(ctx context.Context, params facets.Params) ([]facets.Result, error) {
+	idx := db.GetIndex(params.ClassName)
+	if idx == nil {
+		return nil, fmt.Errorf("tried to browse non-existing index for %s", params.ClassName)
+	}
+
+	res, err := idx.facets(ctx, params)
+	if err != nil {
+		return nil, errors.Wrapf(err, "facets at index %s", idx.ID())
+	}
+	return res, nil
+}
+
+// TextAnalyzers returns the custom analyzers of the class, as they are used
+// to index and search its properties
+func (db *DB) TextAnalyzers(className string) (*inverted.TextAnalyzers, error) {
+	idx := db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return nil, fmt.Errorf("tried to browse non-existing index for %s", className)
+	}
+	return idx.getTextAnalyzers(), nil
+}
+
 func (db *DB) GetQueryMaximumResults() int {
 	return int(db.config.QueryMaximumResults)
 }
@@ -122,9 +149,23 @@ func (db *DB) VectorSearch(ctx context.Context,
 	}
 
 	targetDist := extractDistanceFromParams(params)
+	matryoshkaDims := 0
+	if params.NearVector != nil {
+		matryoshkaDims = params.NearVector.MatryoshkaDimensions
+		if params.NearVector.NProbe > 0 {
+			ctx = searchparams.ContextWithNProbe(ctx, params.NearVector.NProbe)
+		}
+		if params.NearVector.After != nil {
+			ctx = searchparams.ContextWithRangeCursor(ctx, params.NearVector.After)
+		}
+	}
+	if params.NearObject != nil && params.NearObject.After != nil {
+		ctx = searchparams.ContextWithRangeCursor(ctx, params.NearObject.After)
+	}
 	res, dists, err := idx.objectVectorSearch(ctx, searchVectors, targetVectors,
 		targetDist, totalLimit, params.Filters, params.Sort, params.GroupBy,
-		params.AdditionalProperties, params.ReplicationProperties, params.Tenant, params.TargetVectorCombination, params.Properties.GetPropertyNames())
+		params.AdditionalProperties, params.ReplicationProperties, params.Tenant, params.TargetVectorCombination, params.Properties.GetPropertyNames(),
+		matryoshkaDims)
 	if err != nil {
 		return nil, errors.Wrapf(err, "object vector search at index %s", idx.ID())
 	}
@@ -139,6 +180,47 @@ func (db *DB) VectorSearch(ctx context.Context,
 		params.Properties, params.GroupBy, params.AdditionalProperties, params.Tenant)
 }
 
+// VectorSearchBatch runs one near vector search per vector of the batch
+// against the target vector. All other parameters, such as filters and
+// pagination, are shared by the queries. The result holds one list per vector.
+func (db *DB) VectorSearchBatch(ctx context.Context,
+	params dto.GetParams, targetVector string, searchVectors [][]float32,
+) ([][]search.Result, error) {
+	totalLimit, err := db.getTotalLimit(params.Pagination, params.AdditionalProperties)
+	if err != nil {
+		return nil, fmt.Errorf("invalid pagination params: %w", err)
+	}
+
+	idx := db.GetIndex(schema.ClassName(params.ClassName))
+	if idx == nil {
+		return nil, fmt.Errorf("tried to browse non-existing index for %s", params.ClassName)
+	}
+
+	targetDist := extractDistanceFromParams(params)
+	res, dists, err := idx.objectVectorSearchBatch(ctx, searchVectors, targetVector,
+		targetDist, totalLimit, params.Filters, params.AdditionalProperties,
+		params.ReplicationProperties, params.Tenant, params.Properties.GetPropertyNames())
+	if err != nil {
+		return nil, errors.Wrapf(err, "object vector search batch at index %s", idx.ID())
+	}
+
+	out := make([][]search.Result, len(res))
+	for i := range res {
+		pagination := params.Pagination
+		if totalLimit < 0 {
+			pagination = &filters.Pagination{Offset: params.Pagination.Offset, Limit: len(res[i])}
+		}
+		objs, objDists := db.getStoreObjectsWithScores(res[i], dists[i], pagination)
+		out[i], err = db.ResolveReferences(ctx,
+			storobj.SearchResultsWithDists(objs, params.AdditionalProperties, objDists),
+			params.Properties, nil, params.AdditionalProperties, params.Tenant)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
 func extractDistanceFromParams(params dto.GetParams) float32 {
 	certainty := traverser.ExtractCertaintyFromParams(params)
 	if certainty != 0 {
@@ -168,7 +250,7 @@ func (db *DB) CrossClassVectorSearch(ctx context.Context, vector []float32, targ
 
 			objs, dist, err := index.objectVectorSearch(ctx, [][]float32{vector}, []string{targetVector},
 				0, totalLimit, filters, nil, nil,
-				additional.Properties{}, nil, "", nil, nil)
+				additional.Properties{}, nil, "", nil, nil, 0)
 			if err != nil {
 				mutex.Lock()
 				searchErrors = append(searchErrors, errors.Wrapf(err, "search index %s", index.ID()))
@@ -32,7 +32,7 @@ func (s *Shard) Aggregate(ctx context.Context, params aggregation.Params, module
 	}
 
 	return aggregator.New(s.store, params, s.index.getSchema, s.index.classSearcher,
-		s.index.stopwords, s.versioner.Version(), vectorIndex, s.index.logger, s.GetPropertyLengthTracker(),
+		s.index.stopwords, s.index.getTextAnalyzers(), s.versioner.Version(), vectorIndex, s.index.logger, s.GetPropertyLengthTracker(),
 		s.isFallbackToSearchable, s.tenant(), s.index.Config.QueryNestedRefLimit, s.bitmapFactory, modules).
 		Do(ctx)
 }
@@ -17,6 +17,8 @@ import (
 	"os"
 	"path/filepath"
 
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw"
 	enterrors "github.com/weaviate/weaviate/entities/errors"
 
 	"github.com/weaviate/weaviate/entities/backup"
@@ -143,6 +145,106 @@ func (s *Shard) readBackupMetadata(d *backup.ShardDescriptor) (err error) {
 	return nil
 }
 
+// logArchiver hands over completed commit logs of a shard to the log archive
+// of the node, from where they are shipped alongside backups
+type logArchiver struct {
+	rootPath string
+	class    string
+	shard    string
+	logger   logrus.FieldLogger
+}
+
+func (s *Shard) logArchiver() *logArchiver {
+	return &logArchiver{
+		rootPath: s.index.Config.RootPath,
+		class:    s.index.Config.ClassName.String(),
+		shard:    s.name,
+		logger:   s.index.logger,
+	}
+}
+
+// ArchiveWAL moves the commit log of a flushed memtable into the archive.
+// The log is removed if this fails, as it must not be recovered again.
+func (a *logArchiver) ArchiveWAL(path string) {
+	err := a.archive(path, false)
+	if err == nil {
+		return
+	}
+	a.logger.WithField("action", "archive_wal").WithField("path", path).Error(err)
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		a.logger.WithField("action", "archive_wal").WithField("path", path).
+			Errorf("delete commit log: %v", err)
+	}
+}
+
+// ArchiveWALPrefix copies the entries written so far to the commit log of an
+// active memtable into the archive
+func (a *logArchiver) ArchiveWALPrefix(path string, size int64) error {
+	return backup.ArchiveLogPrefix(a.rootPath, a.class, a.shard, path, size)
+}
+
+// LogTimes opens the record of the times the entries of a commit log are
+// written at, which is archived along with the log
+func (a *logArchiver) LogTimes(path string) (*backup.LogTimes, error) {
+	timesPath, err := backup.LogTimesPath(a.rootPath, a.class, a.shard, path)
+	if err != nil {
+		return nil, err
+	}
+	return backup.OpenLogTimes(timesPath)
+}
+
+// ArchiveCommitLog adds a switched vector index commit log to the archive
+func (a *logArchiver) ArchiveCommitLog(path string) {
+	if err := a.archive(path, true); err != nil {
+		a.logger.WithField("action", "archive_commit_log").WithField("path", path).Error(err)
+	}
+}
+
+// checkpointLogs hands over the commit logs which are still being written to
+// the log archive, so that all entries written until now are archived
+func (s *Shard) checkpointLogs(ctx context.Context) error {
+	if !s.index.Config.LogArchiving {
+		return nil
+	}
+	if err := s.store.ArchiveActiveWALs(ctx); err != nil {
+		return fmt.Errorf("archive active commit logs: %w", err)
+	}
+	if s.hasTargetVectors() {
+		for targetVector, vectorIndex := range s.vectorIndexes {
+			if err := vectorIndex.SwitchCommitLogs(ctx); err != nil {
+				return fmt.Errorf("switch commit logs of vector %q: %w", targetVector, err)
+			}
+		}
+		return nil
+	}
+	if err := s.vectorIndex.SwitchCommitLogs(ctx); err != nil {
+		return fmt.Errorf("switch commit logs: %w", err)
+	}
+	return nil
+}
+
+// commitlogArchiverOption archives vector index commit logs if log archiving is enabled
+func (s *Shard) commitlogArchiverOption() hnsw.CommitlogOption {
+	if !s.index.Config.LogArchiving {
+		return hnsw.WithCommitlogArchiver(nil)
+	}
+	return hnsw.WithCommitlogArchiver(s.logArchiver())
+}
+
+func (a *logArchiver) archive(path string, keep bool) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+	if info.Size() == 0 { // nothing to replay
+		if keep {
+			return nil
+		}
+		return os.Remove(path)
+	}
+	return backup.ArchiveLog(a.rootPath, a.class, a.shard, path, keep)
+}
+
 func (s *Shard) nodeName() (string, error) {
 	node, err := s.index.getSchema.ShardOwner(
 		s.index.Config.ClassName.String(), s.name)
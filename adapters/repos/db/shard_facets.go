@@ -0,0 +1,233 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/sroar"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/facets"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// Facets counts the objects per value of the facet properties among the
+// candidates of the params. The filterable index of a property holds a
+// bitmap of objects per value, which is intersected with the candidates.
+// Only the values with the most objects are returned, see
+// facets.Facet.ShardLimit.
+func (s *Shard) Facets(ctx context.Context, params facets.Params) ([]facets.Result, error) {
+	s.activityTracker.Add(1)
+
+	class := s.index.getSchema.ReadOnlyClass(s.index.Config.ClassName.String())
+	if class == nil {
+		return nil, fmt.Errorf("could not find class %s in schema", s.index.Config.ClassName)
+	}
+
+	candidates, err := s.facetCandidates(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]facets.Result, len(params.Facets))
+	for i, facet := range params.Facets {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		prop, err := schema.GetPropertyByName(class, facet.Property)
+		if err != nil {
+			return nil, fmt.Errorf("facet %s: %w", facet.Property, err)
+		}
+
+		var values []facets.Value
+		if len(facet.Ranges) > 0 {
+			values, err = s.facetRangeValues(prop, facet, candidates)
+		} else {
+			values, err = s.facetValues(prop, facet, candidates)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("facet %s: %w", facet.Property, err)
+		}
+		out[i] = facets.Result{Property: facet.Property, Values: values}
+	}
+	return out, nil
+}
+
+// facetCandidates are the objects matching the filters and the keyword
+// search of the params. Nil means every object is a candidate.
+func (s *Shard) facetCandidates(ctx context.Context, params facets.Params) (*sroar.Bitmap, error) {
+	var allowList helpers.AllowList
+	if params.Filters != nil {
+		var err error
+		allowList, err = s.buildAllowList(ctx, params.Filters, additional.Properties{})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if params.KeywordRanking == nil {
+		if allowList == nil {
+			return nil, nil
+		}
+		return helpers.AllowListBitmap(allowList), nil
+	}
+
+	if v := s.versioner.Version(); v < 2 {
+		return nil, errors.Errorf(
+			"shard was built with an older version of " +
+				"Weaviate which does not yet support BM25 search")
+	}
+
+	logger := s.index.logger.WithFields(logrus.Fields{"class": s.index.Config.ClassName, "shard": s.name})
+	bm25searcher := inverted.NewBM25Searcher(s.index.getInvertedIndexConfig().BM25, s.store,
+		s.index.getSchema.ReadOnlyClass, s.propertyIndices, s.index.classSearcher,
+		s.index.getTextAnalyzers(), s.GetPropertyLengthTracker(), logger, s.versioner.Version())
+	docIDs, err := bm25searcher.DocIDs(ctx, allowList, s.index.Config.ClassName, *params.KeywordRanking)
+	if err != nil {
+		return nil, errors.Wrap(err, "keyword search candidates")
+	}
+	return docIDs, nil
+}
+
+func (s *Shard) facetValues(prop *models.Property, facet facets.Facet,
+	candidates *sroar.Bitmap,
+) ([]facets.Value, error) {
+	bucket, err := s.facetBucket(prop)
+	if err != nil {
+		return nil, err
+	}
+
+	c := bucket.CursorRoaringSet()
+	defer c.Close()
+
+	var values []facets.Value
+	for k, v := c.First(); k != nil; k, v = c.Next() {
+		count := countCandidates(v, candidates)
+		if count == 0 {
+			continue
+		}
+		value, err := parseFacetValue(prop, k)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, facets.Value{Value: value, Count: int64(count)})
+	}
+	return facets.Top(values, facet.ShardLimit()), nil
+}
+
+// facetRangeValues unites the bitmaps of the values within a range before
+// counting, so objects with several values in the same range, such as
+// number arrays, are counted once
+func (s *Shard) facetRangeValues(prop *models.Property, facet facets.Facet,
+	candidates *sroar.Bitmap,
+) ([]facets.Value, error) {
+	bucket, err := s.facetBucket(prop)
+	if err != nil {
+		return nil, err
+	}
+
+	inRange := make([]*sroar.Bitmap, len(facet.Ranges))
+	for i := range inRange {
+		inRange[i] = sroar.NewBitmap()
+	}
+
+	c := bucket.CursorRoaringSet()
+	defer c.Close()
+
+	for k, v := c.First(); k != nil; k, v = c.Next() {
+		value, err := parseFacetValue(prop, k)
+		if err != nil {
+			return nil, err
+		}
+		number, ok := value.(float64)
+		if !ok {
+			return nil, fmt.Errorf("ranges require a numerical property, got %v", prop.DataType)
+		}
+		for i, r := range facet.Ranges {
+			if r.Contains(number) {
+				inRange[i].Or(v)
+			}
+		}
+	}
+
+	values := make([]facets.Value, len(facet.Ranges))
+	for i, r := range facet.Ranges {
+		values[i] = facets.Value{From: r.From, To: r.To, Count: int64(countCandidates(inRange[i], candidates))}
+	}
+	return values, nil
+}
+
+func (s *Shard) facetBucket(prop *models.Property) (*lsmkv.Bucket, error) {
+	bucket := s.store.Bucket(helpers.BucketFromPropNameLSM(prop.Name))
+	if bucket == nil || bucket.Strategy() != lsmkv.StrategyRoaringSet {
+		return nil, fmt.Errorf("property %s has no filterable index", prop.Name)
+	}
+	return bucket, nil
+}
+
+func countCandidates(bm, candidates *sroar.Bitmap) int {
+	if candidates == nil {
+		return bm.GetCardinality()
+	}
+	return sroar.And(bm, candidates).GetCardinality()
+}
+
+// parseFacetValue parses a key of the filterable index of the property into
+// the value of a facet
+func parseFacetValue(prop *models.Property, key []byte) (interface{}, error) {
+	dt, ok := schema.AsPrimitive(prop.DataType)
+	if !ok {
+		return nil, fmt.Errorf("unsupported data type %v", prop.DataType)
+	}
+
+	switch dt {
+	case schema.DataTypeText, schema.DataTypeTextArray:
+		return string(key), nil
+	case schema.DataTypeInt, schema.DataTypeIntArray:
+		i, err := inverted.ParseLexicographicallySortableInt64(key)
+		if err != nil {
+			return nil, err
+		}
+		return float64(i), nil
+	case schema.DataTypeNumber, schema.DataTypeNumberArray:
+		return inverted.ParseLexicographicallySortableFloat64(key)
+	case schema.DataTypeBoolean, schema.DataTypeBooleanArray:
+		if len(key) != 1 {
+			return nil, fmt.Errorf("unexpected key length on inverted index, expected 1: got %d", len(key))
+		}
+		return key[0] != 0, nil
+	case schema.DataTypeDate, schema.DataTypeDateArray:
+		nsec, err := inverted.ParseLexicographicallySortableInt64(key)
+		if err != nil {
+			return nil, err
+		}
+		return time.Unix(0, nsec).UTC().Format(time.RFC3339Nano), nil
+	case schema.DataTypeUUID, schema.DataTypeUUIDArray:
+		id, err := uuid.FromBytes(key)
+		if err != nil {
+			return nil, err
+		}
+		return id.String(), nil
+	default:
+		return nil, fmt.Errorf("unsupported data type %v", dt)
+	}
+}
@@ -0,0 +1,179 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/aggregation"
+	"github.com/weaviate/weaviate/entities/facets"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	"github.com/weaviate/weaviate/entities/storobj"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestShard_Facets(t *testing.T) {
+	ctx := context.Background()
+	ptFloat64 := func(in float64) *float64 { return &in }
+
+	class := &models.Class{
+		Class:               "FacetProduct",
+		InvertedIndexConfig: &models.InvertedIndexConfig{},
+		Properties: []*models.Property{
+			{Name: "name", DataType: schema.DataTypeText.PropString(), Tokenization: models.PropertyTokenizationWord},
+			{Name: "brand", DataType: schema.DataTypeText.PropString(), Tokenization: models.PropertyTokenizationField},
+			{Name: "prices", DataType: schema.DataTypeNumberArray.PropString()},
+			{Name: "inStock", DataType: schema.DataTypeBoolean.PropString()},
+		},
+	}
+	shard, idx := testShardWithSettings(t, ctx, class, enthnsw.UserConfig{Skip: true}, false, false)
+	defer shard.Shutdown(ctx)
+
+	products := []map[string]interface{}{
+		{"name": "running shoe", "brand": "acme", "prices": []float64{40, 45}, "inStock": true},
+		{"name": "trail shoe", "brand": "acme", "prices": []float64{90}, "inStock": false},
+		{"name": "running shirt", "brand": "globex", "prices": []float64{20, 60}, "inStock": true},
+		{"name": "dress shoe", "brand": "initech", "prices": []float64{120}, "inStock": true},
+		{"name": "winter jacket", "brand": "globex", "prices": []float64{150}, "inStock": false},
+	}
+	for _, props := range products {
+		require.Nil(t, shard.PutObject(ctx, &storobj.Object{
+			MarshallerVersion: 1,
+			Object: models.Object{
+				ID:         strfmt.UUID(uuid.NewString()),
+				Class:      class.Class,
+				Properties: props,
+			},
+		}))
+	}
+
+	priceRanges := []aggregation.GroupByRange{{To: ptFloat64(50)}, {From: ptFloat64(50), To: ptFloat64(100)}, {From: ptFloat64(100)}}
+	allFacets := []facets.Facet{
+		{Property: "brand"},
+		{Property: "prices", Ranges: priceRanges},
+		{Property: "inStock"},
+	}
+
+	t.Run("all objects", func(t *testing.T) {
+		res, err := idx.facets(ctx, facets.Params{ClassName: schema.ClassName(class.Class), Facets: allFacets})
+		require.Nil(t, err)
+		assert.Equal(t, []facets.Result{
+			{Property: "brand", Values: []facets.Value{
+				{Value: "acme", Count: 2}, {Value: "globex", Count: 2}, {Value: "initech", Count: 1},
+			}},
+			// the shirt has a price in two ranges, the first shoe has two prices in the same range
+			{Property: "prices", Values: []facets.Value{
+				{To: ptFloat64(50), Count: 2},
+				{From: ptFloat64(50), To: ptFloat64(100), Count: 2},
+				{From: ptFloat64(100), Count: 2},
+			}},
+			{Property: "inStock", Values: []facets.Value{{Value: true, Count: 3}, {Value: false, Count: 2}}},
+		}, res)
+	})
+
+	t.Run("filtered objects", func(t *testing.T) {
+		res, err := idx.facets(ctx, facets.Params{
+			ClassName: schema.ClassName(class.Class),
+			Filters:   filterEqual[bool](true, schema.DataTypeBoolean, class.Class, "inStock"),
+			Facets:    []facets.Facet{{Property: "brand", Limit: 2}},
+		})
+		require.Nil(t, err)
+		assert.Equal(t, []facets.Result{
+			{Property: "brand", Values: []facets.Value{{Value: "acme", Count: 1}, {Value: "globex", Count: 1}}},
+		}, res)
+	})
+
+	t.Run("objects matching a keyword search", func(t *testing.T) {
+		res, err := idx.facets(ctx, facets.Params{
+			ClassName:      schema.ClassName(class.Class),
+			Filters:        filterEqual[bool](true, schema.DataTypeBoolean, class.Class, "inStock"),
+			KeywordRanking: &searchparams.KeywordRanking{Type: "bm25", Query: "shoe", Properties: []string{"name"}},
+			Facets:         allFacets,
+		})
+		require.Nil(t, err)
+		assert.Equal(t, []facets.Result{
+			{Property: "brand", Values: []facets.Value{{Value: "acme", Count: 1}, {Value: "initech", Count: 1}}},
+			{Property: "prices", Values: []facets.Value{
+				{To: ptFloat64(50), Count: 1},
+				{From: ptFloat64(50), To: ptFloat64(100), Count: 0},
+				{From: ptFloat64(100), Count: 1},
+			}},
+			{Property: "inStock", Values: []facets.Value{{Value: true, Count: 2}}},
+		}, res)
+	})
+
+	t.Run("keyword search on all searchable properties", func(t *testing.T) {
+		res, err := idx.facets(ctx, facets.Params{
+			ClassName:      schema.ClassName(class.Class),
+			KeywordRanking: &searchparams.KeywordRanking{Type: "bm25", Query: "globex"},
+			Facets:         []facets.Facet{{Property: "brand"}},
+		})
+		require.Nil(t, err)
+		assert.Equal(t, []facets.Result{
+			{Property: "brand", Values: []facets.Value{{Value: "globex", Count: 2}}},
+		}, res)
+	})
+
+	t.Run("ranges on a text property", func(t *testing.T) {
+		_, err := idx.facets(ctx, facets.Params{
+			ClassName: schema.ClassName(class.Class),
+			Facets:    []facets.Facet{{Property: "brand", Ranges: priceRanges}},
+		})
+		assert.ErrorContains(t, err, "ranges require a numerical property")
+	})
+}
+
+func TestShard_FacetsShardLimit(t *testing.T) {
+	ctx := context.Background()
+
+	class := &models.Class{
+		Class:               "FacetSku",
+		InvertedIndexConfig: &models.InvertedIndexConfig{},
+		Properties: []*models.Property{
+			{Name: "sku", DataType: schema.DataTypeInt.PropString()},
+		},
+	}
+	shard, _ := testShardWithSettings(t, ctx, class, enthnsw.UserConfig{Skip: true}, false, false)
+	defer shard.Shutdown(ctx)
+
+	// sku i is on i objects, so that the top values are the highest skus
+	for sku := 1; sku <= 20; sku++ {
+		for i := 0; i < sku; i++ {
+			require.Nil(t, shard.PutObject(ctx, &storobj.Object{
+				MarshallerVersion: 1,
+				Object: models.Object{
+					ID:         strfmt.UUID(uuid.NewString()),
+					Class:      class.Class,
+					Properties: map[string]interface{}{"sku": int64(sku)},
+				},
+			}))
+		}
+	}
+
+	facet := facets.Facet{Property: "sku", Limit: 1}
+	res, err := shard.Facets(ctx, facets.Params{ClassName: schema.ClassName(class.Class), Facets: []facets.Facet{facet}})
+	require.Nil(t, err)
+	require.Len(t, res, 1)
+	require.Len(t, res[0].Values, facet.ShardLimit())
+	for i, value := range res[0].Values {
+		assert.Equal(t, facets.Value{Value: float64(20 - i), Count: int64(20 - i)}, value)
+	}
+}
@@ -24,11 +24,12 @@ import (
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/searchparams"
 	"github.com/weaviate/weaviate/entities/storobj"
+	traversergrouper "github.com/weaviate/weaviate/usecases/traverser/grouper"
 )
 
 func (s *Shard) groupResults(ctx context.Context, ids []uint64,
 	dists []float32, groupBy *searchparams.GroupBy,
-	additional additional.Properties, properties []string,
+	additional additional.Properties, properties []string, targetVectors []string,
 ) ([]*storobj.Object, []float32, error) {
 	objsBucket := s.store.Bucket(helpers.ObjectsBucketLSM)
 	className := s.index.Config.ClassName
@@ -51,7 +52,7 @@ func (s *Shard) groupResults(ctx context.Context, ids []uint64,
 		props = append(props, propTmp.Name)
 	}
 
-	return newGrouper(ids, dists, groupBy, objsBucket, dt, additional, props).Do(ctx)
+	return newGrouper(ids, dists, groupBy, objsBucket, dt, additional, props, targetVectors).Do(ctx)
 }
 
 type grouper struct {
@@ -62,12 +63,13 @@ type grouper struct {
 	propertyDataType schema.PropertyDataType
 	objBucket        *lsmkv.Bucket
 	properties       []string
+	targetVectors    []string
 }
 
 func newGrouper(ids []uint64, dists []float32,
 	groupBy *searchparams.GroupBy, objBucket *lsmkv.Bucket,
 	propertyDataType schema.PropertyDataType,
-	additional additional.Properties, properties []string,
+	additional additional.Properties, properties []string, targetVectors []string,
 ) *grouper {
 	return &grouper{
 		ids:              ids,
@@ -77,6 +79,7 @@ func newGrouper(ids []uint64, dists []float32,
 		propertyDataType: propertyDataType,
 		additional:       additional,
 		properties:       properties,
+		targetVectors:    targetVectors,
 	}
 }
 
@@ -122,11 +125,11 @@ DOCS_LOOP:
 
 		for _, val := range values {
 			current, groupExists := groups[val]
-			if len(current) >= g.groupBy.ObjectsPerGroup {
+			if len(current) >= g.groupBy.ObjectsPerGroup && !g.groupBy.Exhaustive() {
 				continue
 			}
 
-			if !groupExists && len(groups) >= g.groupBy.Groups {
+			if !groupExists && len(groups) >= g.groupBy.Groups && !g.groupBy.Exhaustive() {
 				continue DOCS_LOOP
 			}
 
@@ -149,17 +152,9 @@ DOCS_LOOP:
 		}
 	}
 
-	objs := make([]*storobj.Object, len(groupsOrdered))
-	dists := make([]float32, len(groupsOrdered))
-	objIDs := []uint64{}
+	arranged := make([]*additional.Group, len(groupsOrdered))
 	for i, val := range groupsOrdered {
 		docIDs := groups[val]
-		unmarshalled, err := g.getUnmarshalled(docIDs[0], docIDObject, objIDs)
-		if err != nil {
-			return nil, nil, err
-		}
-		dist := docIDDistance[docIDs[0]]
-		objIDs = append(objIDs, docIDs[0])
 		hits := make([]map[string]interface{}, len(docIDs))
 		for j, docID := range docIDs {
 			props := map[string]interface{}{}
@@ -169,21 +164,45 @@ DOCS_LOOP:
 			props["_additional"] = &additional.GroupHitAdditional{
 				ID:       docIDObject[docID].ID(),
 				Distance: docIDDistance[docID],
-				Vector:   docIDObject[docID].Vector,
+				Vector:   g.hitVector(docIDObject[docID]),
+				Score:    1 - docIDDistance[docID],
 			}
 			hits[j] = props
 		}
-		group := &additional.Group{
+		arranged[i] = &additional.Group{
 			ID: i,
 			GroupedBy: &additional.GroupedBy{
 				Value: val,
 				Path:  []string{g.groupBy.Property},
 			},
-			Count:       len(hits),
-			Hits:        hits,
-			MinDistance: docIDDistance[docIDs[0]],
-			MaxDistance: docIDDistance[docIDs[len(docIDs)-1]],
+			Count: len(hits),
+			Hits:  hits,
 		}
+	}
+
+	if !g.groupBy.Exhaustive() {
+		// exhaustive groups can only be arranged once the groups of all shards
+		// are merged, until then all of their hits are kept
+		var err error
+		arranged, err = traversergrouper.ArrangeGroups(arranged, g.groupBy)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	objs := make([]*storobj.Object, len(arranged))
+	dists := make([]float32, len(arranged))
+	objIDs := []uint64{}
+	for i, group := range arranged {
+		docIDs := groups[group.GroupedBy.Value]
+		unmarshalled, err := g.getUnmarshalled(docIDs[0], docIDObject, objIDs)
+		if err != nil {
+			return nil, nil, err
+		}
+		dist := docIDDistance[docIDs[0]]
+		objIDs = append(objIDs, docIDs[0])
+		group.MinDistance = group.Hits[0]["_additional"].(*additional.GroupHitAdditional).Distance
+		group.MaxDistance = group.Hits[len(group.Hits)-1]["_additional"].(*additional.GroupHitAdditional).Distance
 
 		// add group
 		if unmarshalled.AdditionalProperties() == nil {
@@ -198,6 +217,18 @@ DOCS_LOOP:
 	return objs, dists, nil
 }
 
+// hitVector is the vector of the first target of the search, which the
+// diverse strategy compares the hits by
+func (g *grouper) hitVector(obj *storobj.Object) []float32 {
+	if g.groupBy.Strategy == searchparams.GroupByStrategyDiverse &&
+		len(g.targetVectors) > 0 && g.targetVectors[0] != "" {
+		if vector, ok := obj.Vectors[g.targetVectors[0]]; ok {
+			return vector
+		}
+	}
+	return obj.Vector
+}
+
 func (g *grouper) getUnmarshalled(docID uint64,
 	docIDObject map[uint64]*storobj.Object,
 	objIDs []uint64,
@@ -128,6 +128,10 @@ func (s *Shard) initLSMStore() error {
 		return fmt.Errorf("init lsmkv store at %s: %w", s.pathLSM(), err)
 	}
 
+	if s.index.Config.LogArchiving {
+		store.SetWALArchiver(s.logArchiver())
+	}
+
 	s.store = store
 
 	return nil
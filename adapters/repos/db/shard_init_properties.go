@@ -14,6 +14,7 @@ package db
 import (
 	"context"
 	"fmt"
+	"slices"
 
 	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
@@ -133,6 +134,15 @@ func (s *Shard) createPropertyValueIndex(ctx context.Context, prop *models.Prope
 		); err != nil {
 			return err
 		}
+
+		if slices.Contains(s.index.invertedIndexConfig.IndexPositions, prop.Name) {
+			if err := s.store.CreateOrLoadBucket(ctx,
+				helpers.BucketPositionsFromPropNameLSM(prop.Name),
+				append(bucketOpts, lsmkv.WithStrategy(lsmkv.StrategyMapCollection))...,
+			); err != nil {
+				return err
+			}
+		}
 	}
 
 	if inverted.HasRangeableIndex(prop) {
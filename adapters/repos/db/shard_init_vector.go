@@ -20,13 +20,19 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/flat"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/ivf"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/noop"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/sparse"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/vamana"
 	schemaConfig "github.com/weaviate/weaviate/entities/schema/config"
 	"github.com/weaviate/weaviate/entities/vectorindex"
 	"github.com/weaviate/weaviate/entities/vectorindex/common"
 	dynamicent "github.com/weaviate/weaviate/entities/vectorindex/dynamic"
 	flatent "github.com/weaviate/weaviate/entities/vectorindex/flat"
 	hnswent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	ivfent "github.com/weaviate/weaviate/entities/vectorindex/ivf"
+	sparseent "github.com/weaviate/weaviate/entities/vectorindex/sparse"
+	vamanaent "github.com/weaviate/weaviate/entities/vectorindex/vamana"
 )
 
 func (s *Shard) initVectorIndex(ctx context.Context,
@@ -94,6 +100,7 @@ func (s *Shard) initVectorIndex(ctx context.Context,
 						hnsw.WithCommitlogThresholdForCombining(s.index.Config.HNSWMaxLogSize),
 						// consistent with previous logic where the individual limit is 1/5 of the combined limit
 						hnsw.WithCommitlogThreshold(s.index.Config.HNSWMaxLogSize/5),
+						s.commitlogArchiverOption(),
 					)
 				},
 				AllocChecker:           s.index.allocChecker,
@@ -140,6 +147,8 @@ func (s *Shard) initVectorIndex(ctx context.Context,
 				vectorIndexUserConfig)
 		}
 		s.index.cycleCallbacks.vectorCommitLoggerCycle.Start()
+		// the upgraded index removes deleted nodes in the tombstone cleanup
+		s.index.cycleCallbacks.vectorTombstoneCleanupCycle.Start()
 
 		// a shard can actually have multiple vector indexes:
 		// - the main index, which is used for all normal object vectors
@@ -161,7 +170,8 @@ func (s *Shard) initVectorIndex(ctx context.Context,
 			TempVectorForIDThunk: hnsw.NewTempVectorForIDThunk(targetVector, s.readVectorByIndexIDIntoSlice),
 			MakeCommitLoggerThunk: func() (hnsw.CommitLogger, error) {
 				return hnsw.NewCommitLogger(s.path(), vecIdxID,
-					s.index.logger, s.cycleCallbacks.vectorCommitLoggerCallbacks)
+					s.index.logger, s.cycleCallbacks.vectorCommitLoggerCallbacks,
+					s.commitlogArchiverOption())
 			},
 			TombstoneCallbacks: s.cycleCallbacks.vectorTombstoneCleanupCallbacks,
 		}, dynamicUserConfig, s.store)
@@ -169,9 +179,49 @@ func (s *Shard) initVectorIndex(ctx context.Context,
 			return nil, errors.Wrapf(err, "init shard %q: dynamic index", s.ID())
 		}
 		vectorIndex = vi
+	case vectorindex.VectorIndexTypeIVF:
+		ivfUserConfig, ok := vectorIndexUserConfig.(ivfent.UserConfig)
+		if !ok {
+			return nil, errors.Errorf("ivf vector index: config is not ivf.UserConfig: %T",
+				vectorIndexUserConfig)
+		}
+
+		vi, err := ivf.New(ivf.Config{
+			ID:               s.vectorIndexID(targetVector),
+			TargetVector:     targetVector,
+			RootPath:         s.path(),
+			Logger:           s.index.logger,
+			DistanceProvider: distProv,
+		}, ivfUserConfig, s.store)
+		if err != nil {
+			return nil, errors.Wrapf(err, "init shard %q: ivf index", s.ID())
+		}
+		vectorIndex = vi
+	case vectorindex.VectorIndexTypeVAMANA:
+		vamanaUserConfig, ok := vectorIndexUserConfig.(vamanaent.UserConfig)
+		if !ok {
+			return nil, errors.Errorf("vamana vector index: config is not vamana.UserConfig: %T",
+				vectorIndexUserConfig)
+		}
+		// deleted nodes are removed from the graph by the tombstone cleanup
+		s.index.cycleCallbacks.vectorTombstoneCleanupCycle.Start()
+
+		vi, err := vamana.New(vamana.Config{
+			ID:                 s.vectorIndexID(targetVector),
+			TargetVector:       targetVector,
+			RootPath:           s.path(),
+			Logger:             s.index.logger,
+			DistanceProvider:   distProv,
+			TombstoneCallbacks: s.cycleCallbacks.vectorTombstoneCleanupCallbacks,
+		}, vamanaUserConfig)
+		if err != nil {
+			return nil, errors.Wrapf(err, "init shard %q: vamana index", s.ID())
+		}
+		vectorIndex = vi
 	default:
-		return nil, fmt.Errorf("Unknown vector index type: %q. Choose one from [\"%s\", \"%s\", \"%s\"]",
-			vectorIndexUserConfig.IndexType(), vectorindex.VectorIndexTypeHNSW, vectorindex.VectorIndexTypeFLAT, vectorindex.VectorIndexTypeDYNAMIC)
+		return nil, fmt.Errorf("Unknown vector index type: %q. Choose one from [\"%s\", \"%s\", \"%s\", \"%s\", \"%s\"]",
+			vectorIndexUserConfig.IndexType(), vectorindex.VectorIndexTypeHNSW, vectorindex.VectorIndexTypeFLAT,
+			vectorindex.VectorIndexTypeDYNAMIC, vectorindex.VectorIndexTypeIVF, vectorindex.VectorIndexTypeVAMANA)
 	}
 	defer vectorIndex.PostStartup()
 	return vectorIndex, nil
@@ -189,7 +239,23 @@ func hasTargetVectors(cfg schemaConfig.VectorIndexConfig, targetCfgs map[string]
 
 func (s *Shard) initTargetVectors(ctx context.Context) error {
 	s.vectorIndexes = make(map[string]VectorIndex)
+	s.sparseIndexes = make(map[string]*sparse.Index)
 	for targetVector, vectorIndexConfig := range s.index.vectorIndexUserConfigs {
+		// sparse vectors are kept in an inverted index of their own, which
+		// is written synchronously and therefore has no queue
+		if sparseUserConfig, ok := vectorIndexConfig.(sparseent.UserConfig); ok {
+			sparseIndex, err := sparse.New(sparse.Config{
+				ID:           s.vectorIndexID(targetVector),
+				TargetVector: targetVector,
+				Logger:       s.index.logger,
+			}, sparseUserConfig, s.store)
+			if err != nil {
+				return fmt.Errorf("cannot create sparse index for %q: %w", targetVector, err)
+			}
+			s.sparseIndexes[targetVector] = sparseIndex
+			continue
+		}
+
 		vectorIndex, err := s.initVectorIndex(ctx, targetVector, vectorIndexConfig)
 		if err != nil {
 			return fmt.Errorf("cannot create vector index for %q: %w", targetVector, err)
@@ -32,6 +32,7 @@ import (
 	"github.com/weaviate/weaviate/entities/aggregation"
 	"github.com/weaviate/weaviate/entities/backup"
 	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/facets"
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/multi"
@@ -248,11 +249,18 @@ func (l *LazyLoadShard) ObjectSearch(ctx context.Context, limit int, filters *fi
 	return l.shard.ObjectSearch(ctx, limit, filters, keywordRanking, sort, cursor, additional, properties)
 }
 
-func (l *LazyLoadShard) ObjectVectorSearch(ctx context.Context, searchVectors [][]float32, targetVectors []string, targetDist float32, limit int, filters *filters.LocalFilter, sort []filters.Sort, groupBy *searchparams.GroupBy, additional additional.Properties, targetCombination *dto.TargetCombination, properties []string) ([]*storobj.Object, []float32, error) {
+func (l *LazyLoadShard) ObjectVectorSearch(ctx context.Context, searchVectors [][]float32, targetVectors []string, targetDist float32, limit int, filters *filters.LocalFilter, sort []filters.Sort, groupBy *searchparams.GroupBy, additional additional.Properties, targetCombination *dto.TargetCombination, properties []string, matryoshkaDims int) ([]*storobj.Object, []float32, error) {
 	if err := l.Load(ctx); err != nil {
 		return nil, nil, err
 	}
-	return l.shard.ObjectVectorSearch(ctx, searchVectors, targetVectors, targetDist, limit, filters, sort, groupBy, additional, targetCombination, properties)
+	return l.shard.ObjectVectorSearch(ctx, searchVectors, targetVectors, targetDist, limit, filters, sort, groupBy, additional, targetCombination, properties, matryoshkaDims)
+}
+
+func (l *LazyLoadShard) ObjectVectorSearchBatch(ctx context.Context, searchVectors [][]float32, targetVector string, targetDist float32, limit int, filters *filters.LocalFilter, additional additional.Properties, properties []string) ([][]*storobj.Object, [][]float32, error) {
+	if err := l.Load(ctx); err != nil {
+		return nil, nil, err
+	}
+	return l.shard.ObjectVectorSearchBatch(ctx, searchVectors, targetVector, targetDist, limit, filters, additional, properties)
 }
 
 func (l *LazyLoadShard) UpdateVectorIndexConfig(ctx context.Context, updated schemaConfig.VectorIndexConfig) error {
@@ -389,6 +397,13 @@ func (l *LazyLoadShard) resumeMaintenanceCycles(ctx context.Context) error {
 	return l.shard.resumeMaintenanceCycles(ctx)
 }
 
+func (l *LazyLoadShard) checkpointLogs(ctx context.Context) error {
+	if err := l.Load(ctx); err != nil {
+		return err
+	}
+	return l.shard.checkpointLogs(ctx)
+}
+
 func (l *LazyLoadShard) SetPropertyLengths(props []inverted.Property) error {
 	l.mustLoad()
 	return l.shard.SetPropertyLengths(props)
@@ -421,6 +436,13 @@ func (l *LazyLoadShard) Aggregate(ctx context.Context, params aggregation.Params
 	return l.shard.Aggregate(ctx, params, modules)
 }
 
+func (l *LazyLoadShard) Facets(ctx context.Context, params facets.Params) ([]facets.Result, error) {
+	if err := l.Load(ctx); err != nil {
+		return nil, err
+	}
+	return l.shard.Facets(ctx, params)
+}
+
 func (l *LazyLoadShard) MergeObject(ctx context.Context, object objects.MergeDocument) error {
 	if err := l.Load(ctx); err != nil {
 		return err
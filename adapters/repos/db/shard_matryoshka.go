@@ -0,0 +1,135 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/entities/storobj"
+	flatent "github.com/weaviate/weaviate/entities/vectorindex/flat"
+	hnswent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+// matryoshkaConfig returns the matryoshka settings of the vector index for
+// the given target vector. Index types that don't support truncated vectors
+// return a disabled config.
+func (s *Shard) matryoshkaConfig(targetVector string) hnswent.MatryoshkaConfig {
+	s.index.vectorIndexUserConfigLock.Lock()
+	defer s.index.vectorIndexUserConfigLock.Unlock()
+
+	cfg := s.index.vectorIndexUserConfig
+	if targetVector != "" {
+		cfg = s.index.vectorIndexUserConfigs[targetVector]
+	}
+
+	switch c := cfg.(type) {
+	case hnswent.UserConfig:
+		return c.Matryoshka
+	case flatent.UserConfig:
+		return c.Matryoshka
+	default:
+		return hnswent.MatryoshkaConfig{}
+	}
+}
+
+// matryoshkaSearchLimit is the number of candidates to fetch from an index
+// that holds truncated vectors, so that enough of them are left to be
+// re-ranked with the full vectors
+func matryoshkaSearchLimit(cfg hnswent.MatryoshkaConfig, limit int) int {
+	if limit < 0 || limit >= cfg.RescoreLimit {
+		return limit
+	}
+	return cfg.RescoreLimit
+}
+
+// rescoreMatryoshka re-ranks the candidates found on the truncated vectors
+// with the leading rescoreDims dimensions of the full vectors read from the
+// objects bucket. A rescoreDims of 0 uses the full vectors. The results are
+// cut to limit or, for a search by distance (limit < 0), to the ones within
+// targetDist.
+func (s *Shard) rescoreMatryoshka(ctx context.Context, vidx VectorIndex,
+	targetVector string, searchVector []float32, ids []uint64,
+	cfg hnswent.MatryoshkaConfig, rescoreDims, limit int, targetDist float32,
+) ([]uint64, []float32, error) {
+	if rescoreDims == 0 {
+		rescoreDims = len(searchVector)
+	}
+	if rescoreDims < cfg.Dimensions {
+		return nil, nil, fmt.Errorf("matryoshka dimensions %d must not be lower than the %d dimensions held by the index",
+			rescoreDims, cfg.Dimensions)
+	}
+	query, err := common.TruncateVector(searchVector, rescoreDims)
+	if err != nil {
+		return nil, nil, fmt.Errorf("matryoshka query: %w", err)
+	}
+
+	provider := vidx.DistancerProvider()
+	// the truncated vectors are not normalized anymore, even if the full ones
+	// were
+	normalize := provider.Type() == "cosine-dot"
+	if normalize {
+		query = distancer.Normalize(query)
+	}
+
+	type candidate struct {
+		id   uint64
+		dist float32
+	}
+	candidates := make([]candidate, 0, len(ids))
+	container := &common.VectorSlice{Buff8: make([]byte, 8)}
+	for _, id := range ids {
+		vec, err := s.readVectorByIndexIDIntoSlice(ctx, id, container, targetVector)
+		if err != nil {
+			var e storobj.ErrNotFound
+			if errors.As(err, &e) {
+				// deleted in the meantime
+				continue
+			}
+			return nil, nil, fmt.Errorf("matryoshka rescore: %w", err)
+		}
+		vec, err = common.TruncateVector(vec, rescoreDims)
+		if err != nil {
+			return nil, nil, fmt.Errorf("matryoshka rescore: %w", err)
+		}
+		if normalize {
+			vec = distancer.Normalize(vec)
+		}
+		dist, err := provider.SingleDist(query, vec)
+		if err != nil {
+			return nil, nil, fmt.Errorf("matryoshka rescore: %w", err)
+		}
+		if limit < 0 && dist > targetDist {
+			continue
+		}
+		candidates = append(candidates, candidate{id: id, dist: dist})
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].dist < candidates[j].dist
+	})
+	if limit >= 0 && len(candidates) > limit {
+		candidates = candidates[:limit]
+	}
+
+	outIDs := make([]uint64, len(candidates))
+	outDists := make([]float32, len(candidates))
+	for i, c := range candidates {
+		outIDs[i] = c.id
+		outDists[i] = c.dist
+	}
+	return outIDs, outDists, nil
+}
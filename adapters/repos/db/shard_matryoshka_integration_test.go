@@ -0,0 +1,82 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/entities/vectorindex/common"
+	flatent "github.com/weaviate/weaviate/entities/vectorindex/flat"
+)
+
+func TestShard_MatryoshkaRescore(t *testing.T) {
+	ctx := context.Background()
+	className := "MatryoshkaClass"
+
+	vic := flatent.NewDefaultUserConfig()
+	vic.Distance = common.DistanceL2Squared
+	vic.Matryoshka.Dimensions = 2
+	vic.Matryoshka.RescoreLimit = 10
+
+	shard, _ := testShardWithSettings(t, ctx, &models.Class{Class: className}, vic, false, false)
+	defer shard.Shutdown(ctx)
+
+	closeOnTruncated := strfmt.UUID("00000000-0000-0000-0000-000000000001")
+	closeOnFull := strfmt.UUID("00000000-0000-0000-0000-000000000002")
+	for id, vec := range map[strfmt.UUID][]float32{
+		closeOnTruncated: {1, 0, 5, 5},
+		closeOnFull:      {0.5, 0, 0, 0},
+	} {
+		obj := &storobj.Object{
+			MarshallerVersion: 1,
+			Object:            models.Object{ID: id, Class: className},
+			Vector:            vec,
+		}
+		require.Nil(t, shard.PutObject(ctx, obj))
+	}
+
+	query := [][]float32{{1, 0, 0, 0}}
+
+	t.Run("re-ranked with the full vectors", func(t *testing.T) {
+		res, dists, err := shard.ObjectVectorSearch(ctx, query, []string{""}, 0, 1,
+			nil, nil, nil, additional.Properties{}, nil, nil, 0)
+		require.Nil(t, err)
+		require.Len(t, res, 1)
+		assert.Equal(t, closeOnFull, res[0].ID())
+		assert.InDelta(t, 0.25, dists[0], 1e-6)
+	})
+
+	t.Run("re-ranked with the truncated vectors", func(t *testing.T) {
+		res, dists, err := shard.ObjectVectorSearch(ctx, query, []string{""}, 0, 1,
+			nil, nil, nil, additional.Properties{}, nil, nil, 2)
+		require.Nil(t, err)
+		require.Len(t, res, 1)
+		assert.Equal(t, closeOnTruncated, res[0].ID())
+		assert.InDelta(t, 0, dists[0], 1e-6)
+	})
+
+	t.Run("re-ranking with less dimensions than the index holds", func(t *testing.T) {
+		_, _, err := shard.ObjectVectorSearch(ctx, query, []string{""}, 0, 1,
+			nil, nil, nil, additional.Properties{}, nil, nil, 1)
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "must not be lower than the 2 dimensions")
+	})
+}
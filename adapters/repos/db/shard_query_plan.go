@@ -0,0 +1,189 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"math"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/storobj"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+const (
+	queryPlanUnfiltered = "unfiltered"
+
+	// ACORN reads the neighbors of neighbors to reach allowed nodes. Reading a
+	// neighbor id is estimated at a hundredth of a distance calculation.
+	acornExpansionCost = 0.01
+	// a post-filtered search only pays off if most of the results it finds
+	// are allowed, below this selectivity it is not considered
+	postFilterMinSelectivity = 0.5
+)
+
+// candidates are compared in this order, so ties go to the first
+var plannedFilterStrategies = []common.FilterStrategy{
+	common.FilterStrategyFlat,
+	common.FilterStrategyAcorn,
+	common.FilterStrategySweeping,
+	common.FilterStrategyPostFilter,
+}
+
+// planVectorSearch estimates the selectivity of the allow list and picks the
+// way the filter is applied to a search with k results. A negative k stands
+// for a search by distance.
+//
+// Only indexes configured with the auto filter strategy follow the plan, the
+// returned context carries the picked strategy to them. For all other indexes
+// the plan reports the strategy the index picks by itself.
+func (s *Shard) planVectorSearch(ctx context.Context, vidx VectorIndex,
+	targetVector string, allowList helpers.AllowList, k int,
+) (context.Context, *additional.QueryPlan) {
+	if k < 0 {
+		k = common.DefaultSearchByDistInitialLimit
+	}
+
+	plan := &additional.QueryPlan{
+		Shard:        s.name,
+		TargetVector: targetVector,
+		Selectivity:  1,
+	}
+
+	var stats common.FilterSearchStats
+	planner, ok := vidx.(filterSearchPlanner)
+	if ok {
+		stats, ok = planner.FilterSearchStats(k)
+	}
+	if !ok {
+		plan.IndexSize = int(vidx.AlreadyIndexed())
+		plan.Strategy = string(common.FilterStrategyFlat)
+		plan.EstimatedCost = float64(plan.IndexSize)
+		if allowList != nil {
+			plan.AllowListSize = allowList.Len()
+			plan.Selectivity = allowListSelectivity(plan.AllowListSize, plan.IndexSize)
+			plan.EstimatedCost = float64(plan.AllowListSize)
+		}
+		return ctx, plan
+	}
+
+	plan.IndexSize = stats.IndexSize
+	if allowList == nil {
+		plan.Strategy = queryPlanUnfiltered
+		plan.EstimatedCost = hnswSearchCost(stats, stats.EF)
+		return ctx, plan
+	}
+	plan.AllowListSize = allowList.Len()
+	plan.Selectivity = allowListSelectivity(plan.AllowListSize, plan.IndexSize)
+
+	costs := filterStrategyCosts(stats, plan.AllowListSize, plan.Selectivity, k)
+	strategy := staticFilterStrategy(stats, plan.AllowListSize, plan.Selectivity)
+	if stats.Strategy == enthnsw.FilterStrategyAuto && !vidx.Multivector() {
+		best := math.Inf(1)
+		for _, candidate := range plannedFilterStrategies {
+			if cost, ok := costs[candidate]; ok && cost < best {
+				strategy, best = candidate, cost
+			}
+		}
+		ctx = common.ContextWithFilterStrategy(ctx, strategy)
+	}
+
+	plan.Strategy = string(strategy)
+	plan.EstimatedCost = costs[strategy]
+	helpers.AnnotateSlowQueryLog(ctx, "query_plan_strategy", plan.Strategy)
+	helpers.AnnotateSlowQueryLog(ctx, "query_plan_selectivity", plan.Selectivity)
+	return ctx, plan
+}
+
+// staticFilterStrategy is the strategy the index picks by itself based on its
+// configuration
+func staticFilterStrategy(stats common.FilterSearchStats, allowListSize int,
+	selectivity float64,
+) common.FilterStrategy {
+	if !stats.ForbidFlat && allowListSize < stats.FlatSearchCutoff {
+		return common.FilterStrategyFlat
+	}
+	if stats.Strategy == enthnsw.FilterStrategyAcorn && selectivity <= stats.AcornMaxSelectivity {
+		return common.FilterStrategyAcorn
+	}
+	return common.FilterStrategySweeping
+}
+
+// filterStrategyCosts estimates the number of distance calculations of every
+// strategy that is viable for the allow list
+func filterStrategyCosts(stats common.FilterSearchStats, allowListSize int,
+	selectivity float64, k int,
+) map[common.FilterStrategy]float64 {
+	costs := map[common.FilterStrategy]float64{}
+	if !stats.ForbidFlat {
+		costs[common.FilterStrategyFlat] = float64(allowListSize)
+	}
+	if selectivity == 0 {
+		// there is nothing to find, but a graph search only notices after
+		// visiting the whole graph
+		costs[common.FilterStrategySweeping] = float64(stats.IndexSize)
+		costs[common.FilterStrategyAcorn] = float64(stats.IndexSize)
+		return costs
+	}
+
+	// the graph search has to visit 1/selectivity nodes for every allowed one
+	base := hnswSearchCost(stats, stats.EF)
+	costs[common.FilterStrategySweeping] = base / selectivity
+
+	if selectivity <= stats.AcornMaxSelectivity {
+		// ACORN only calculates distances to allowed nodes, but expands the
+		// neighborhood by up to 8 to find them, see hnsw acornParams
+		expansion := math.Min(1/selectivity, 8)
+		costs[common.FilterStrategyAcorn] = base * (1 + acornExpansionCost*expansion*
+			float64(stats.MaxConnections))
+	}
+
+	if selectivity >= postFilterMinSelectivity {
+		limit := int(math.Ceil(float64(k) / selectivity * common.PostFilterOversampling))
+		costs[common.FilterStrategyPostFilter] = hnswSearchCost(stats, max(stats.EF, limit))
+	}
+	return costs
+}
+
+// hnswSearchCost estimates the distance calculations of an unfiltered graph
+// search: the search descends through log(n) layers and compares the query
+// to all neighbors of every node it visits on the base layer
+func hnswSearchCost(stats common.FilterSearchStats, ef int) float64 {
+	return (float64(ef) + math.Log2(float64(max(stats.IndexSize, 2)))) *
+		float64(stats.MaxConnections)
+}
+
+func allowListSelectivity(allowListSize, indexSize int) float64 {
+	if indexSize == 0 {
+		return 0
+	}
+	// the allow list may contain objects which are not indexed yet
+	return math.Min(float64(allowListSize)/float64(indexSize), 1)
+}
+
+func newQueryPlans(n int) []*additional.QueryPlan {
+	return make([]*additional.QueryPlan, n)
+}
+
+// addQueryPlans sets the plans of the vector searches of a query, one per
+// target vector, as additional property of its results
+func addQueryPlans(objs []*storobj.Object, plans []*additional.QueryPlan) {
+	for _, obj := range objs {
+		if obj.Object.Additional == nil {
+			obj.Object.Additional = models.AdditionalProperties{}
+		}
+		obj.Object.Additional["queryPlan"] = plans
+	}
+}
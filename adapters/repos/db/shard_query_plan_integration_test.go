@@ -0,0 +1,95 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	schemaConfig "github.com/weaviate/weaviate/entities/schema/config"
+	"github.com/weaviate/weaviate/entities/storobj"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestShard_ObjectVectorSearchQueryPlan(t *testing.T) {
+	ctx := context.Background()
+	r := rand.New(rand.NewSource(7))
+	objectCount := 600
+	dims := 8
+
+	randomVector := func() []float32 {
+		vec := make([]float32, dims)
+		for i := range vec {
+			vec[i] = r.Float32()
+		}
+		return vec
+	}
+
+	config := enthnsw.NewDefaultUserConfig()
+	config.FilterStrategy = enthnsw.FilterStrategyAuto
+	class := &models.Class{
+		Class:               "PlannedClass",
+		InvertedIndexConfig: &models.InvertedIndexConfig{},
+		Properties: []*models.Property{
+			{Name: "category", DataType: schema.DataTypeInt.PropString()},
+		},
+	}
+	shard, _ := testShardWithSettings(t, ctx, class, config, false, false, func(i *Index) {
+		i.vectorIndexUserConfigs = map[string]schemaConfig.VectorIndexConfig{
+			"embedding": config,
+		}
+	})
+	defer shard.Shutdown(ctx)
+
+	for i := 0; i < objectCount; i++ {
+		obj := &storobj.Object{
+			MarshallerVersion: 1,
+			Object: models.Object{
+				ID:         strfmt.UUID(uuid.NewString()),
+				Class:      class.Class,
+				Properties: map[string]interface{}{"category": float64(i % 3)},
+			},
+			Vectors: map[string][]float32{"embedding": randomVector()},
+		}
+		require.Nil(t, shard.PutObject(ctx, obj))
+	}
+
+	category := filterEqual[int](1, schema.DataTypeInt, class.Class, "category")
+	objs, _, err := shard.ObjectVectorSearch(ctx, [][]float32{randomVector()}, []string{"embedding"},
+		0, 10, category, nil, nil, additional.Properties{QueryPlan: true}, nil, nil, 0)
+	require.Nil(t, err)
+	require.Len(t, objs, 10)
+
+	for _, obj := range objs {
+		assert.Equal(t, float64(1), obj.Properties().(map[string]interface{})["category"])
+
+		res := obj.SearchResult(additional.Properties{QueryPlan: true}, "")
+		plans, ok := res.AdditionalProperties["queryPlan"].([]*additional.QueryPlan)
+		require.True(t, ok)
+		require.Len(t, plans, 1)
+		assert.Equal(t, "embedding", plans[0].TargetVector)
+		assert.Equal(t, "flat", plans[0].Strategy)
+		assert.Equal(t, objectCount/3, plans[0].AllowListSize)
+		assert.Equal(t, objectCount, plans[0].IndexSize)
+		assert.InDelta(t, 1.0/3, plans[0].Selectivity, 1e-6)
+	}
+}
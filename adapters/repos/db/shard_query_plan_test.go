@@ -0,0 +1,165 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+type fakePlannedIndex struct {
+	VectorIndex
+	stats       common.FilterSearchStats
+	multivector bool
+}
+
+func (f *fakePlannedIndex) FilterSearchStats(k int) (common.FilterSearchStats, bool) {
+	return f.stats, true
+}
+
+func (f *fakePlannedIndex) Multivector() bool {
+	return f.multivector
+}
+
+type fakeUnplannedIndex struct {
+	VectorIndex
+	size uint64
+}
+
+func (f *fakeUnplannedIndex) AlreadyIndexed() uint64 {
+	return f.size
+}
+
+func TestShard_PlanVectorSearch(t *testing.T) {
+	indexSize := 1_000_000
+	stats := func(strategy string, forbidFlat bool) common.FilterSearchStats {
+		return common.FilterSearchStats{
+			IndexSize:           indexSize,
+			EF:                  100,
+			MaxConnections:      64,
+			FlatSearchCutoff:    enthnsw.DefaultFlatSearchCutoff,
+			ForbidFlat:          forbidFlat,
+			AcornMaxSelectivity: 0.4,
+			Strategy:            strategy,
+		}
+	}
+	allowList := func(size int) helpers.AllowList {
+		list := helpers.NewAllowList()
+		for i := 0; i < size; i++ {
+			list.Insert(uint64(i))
+		}
+		return list
+	}
+
+	tests := []struct {
+		name             string
+		index            VectorIndex
+		allowList        helpers.AllowList
+		expectedStrategy string
+		// whether the strategy is passed on to the vector index
+		expectedOverride bool
+	}{
+		{
+			name:             "unfiltered",
+			index:            &fakePlannedIndex{stats: stats(enthnsw.FilterStrategyAuto, false)},
+			expectedStrategy: queryPlanUnfiltered,
+		},
+		{
+			name:             "flat index",
+			index:            &fakeUnplannedIndex{size: uint64(indexSize)},
+			allowList:        allowList(100),
+			expectedStrategy: "flat",
+		},
+		{
+			name:             "sweeping config below flat search cutoff",
+			index:            &fakePlannedIndex{stats: stats(enthnsw.FilterStrategySweeping, false)},
+			allowList:        allowList(10_000),
+			expectedStrategy: "flat",
+		},
+		{
+			name:             "acorn config above acorn selectivity",
+			index:            &fakePlannedIndex{stats: stats(enthnsw.FilterStrategyAcorn, false)},
+			allowList:        allowList(900_000),
+			expectedStrategy: "sweeping",
+		},
+		{
+			name:             "auto with selective filter",
+			index:            &fakePlannedIndex{stats: stats(enthnsw.FilterStrategyAuto, false)},
+			allowList:        allowList(10_000),
+			expectedStrategy: "flat",
+			expectedOverride: true,
+		},
+		{
+			name:             "auto with selective filter and flat search forbidden",
+			index:            &fakePlannedIndex{stats: stats(enthnsw.FilterStrategyAuto, true)},
+			allowList:        allowList(10_000),
+			expectedStrategy: "acorn",
+			expectedOverride: true,
+		},
+		{
+			name:             "auto with medium filter",
+			index:            &fakePlannedIndex{stats: stats(enthnsw.FilterStrategyAuto, false)},
+			allowList:        allowList(200_000),
+			expectedStrategy: "acorn",
+			expectedOverride: true,
+		},
+		{
+			name:             "auto with broad filter",
+			index:            &fakePlannedIndex{stats: stats(enthnsw.FilterStrategyAuto, false)},
+			allowList:        allowList(900_000),
+			expectedStrategy: "postFilter",
+			expectedOverride: true,
+		},
+		{
+			name: "auto with multi vector index",
+			index: &fakePlannedIndex{
+				stats:       stats(enthnsw.FilterStrategyAuto, false),
+				multivector: true,
+			},
+			allowList:        allowList(200_000),
+			expectedStrategy: "sweeping",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			shard := &Shard{name: "shard1"}
+			ctx, plan := shard.planVectorSearch(context.Background(), tt.index, "custom",
+				tt.allowList, 10)
+			require.NotNil(t, plan)
+
+			assert.Equal(t, "shard1", plan.Shard)
+			assert.Equal(t, "custom", plan.TargetVector)
+			assert.Equal(t, tt.expectedStrategy, plan.Strategy)
+			assert.Equal(t, indexSize, plan.IndexSize)
+			assert.Greater(t, plan.EstimatedCost, float64(0))
+			if tt.allowList != nil {
+				assert.Equal(t, tt.allowList.Len(), plan.AllowListSize)
+				assert.InDelta(t, float64(tt.allowList.Len())/float64(indexSize), plan.Selectivity, 1e-9)
+			} else {
+				assert.Equal(t, float64(1), plan.Selectivity)
+			}
+
+			strategy, ok := common.FilterStrategyFromContext(ctx)
+			assert.Equal(t, tt.expectedOverride, ok)
+			if tt.expectedOverride {
+				assert.Equal(t, tt.expectedStrategy, string(strategy))
+			}
+		})
+	}
+}
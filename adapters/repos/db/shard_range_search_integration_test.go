@@ -0,0 +1,273 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	schemaConfig "github.com/weaviate/weaviate/entities/schema/config"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	"github.com/weaviate/weaviate/entities/storobj"
+	flatent "github.com/weaviate/weaviate/entities/vectorindex/flat"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+func TestShard_ObjectVectorSearchByDistance(t *testing.T) {
+	ctx := context.Background()
+	r := rand.New(rand.NewSource(11))
+	// more matches than the initial limit of a search by distance
+	objectCount := 1000
+	dims := 4
+	targetDist := float32(0.2)
+
+	randomVector := func() []float32 {
+		vec := make([]float32, dims)
+		for i := range vec {
+			vec[i] = r.Float32()
+		}
+		return vec
+	}
+	query := randomVector()
+
+	vectors := make([][]float32, objectCount)
+	for i := range vectors {
+		vectors[i] = randomVector()
+	}
+	expected := 0
+	for _, vec := range vectors {
+		dist, err := distancer.NewCosineDistanceProvider().SingleDist(
+			distancer.Normalize(query), distancer.Normalize(vec))
+		require.Nil(t, err)
+		if dist <= targetDist {
+			expected++
+		}
+	}
+	require.Greater(t, expected, 100)
+
+	vectorIndexes := []struct {
+		name   string
+		config schemaConfig.VectorIndexConfig
+	}{
+		{name: "flat", config: flatent.NewDefaultUserConfig()},
+		{name: "hnsw", config: enthnsw.NewDefaultUserConfig()},
+	}
+	for _, vi := range vectorIndexes {
+		t.Run(vi.name, func(t *testing.T) {
+			class := &models.Class{
+				Class:               "RangeClass",
+				InvertedIndexConfig: &models.InvertedIndexConfig{},
+			}
+			shard, _ := testShardWithSettings(t, ctx, class, vi.config, false, false, func(i *Index) {
+				i.vectorIndexUserConfigs = map[string]schemaConfig.VectorIndexConfig{
+					"embedding": vi.config,
+				}
+			})
+			defer shard.Shutdown(ctx)
+
+			for _, vec := range vectors {
+				obj := &storobj.Object{
+					MarshallerVersion: 1,
+					Object: models.Object{
+						ID:    strfmt.UUID(uuid.NewString()),
+						Class: class.Class,
+					},
+					Vectors: map[string][]float32{"embedding": vec},
+				}
+				require.Nil(t, shard.PutObject(ctx, obj))
+			}
+
+			objs, dists, err := shard.ObjectVectorSearch(ctx, [][]float32{query}, []string{"embedding"},
+				targetDist, -1, nil, nil, nil, additional.Properties{}, nil, nil, 0)
+			require.Nil(t, err)
+			require.Len(t, dists, len(objs))
+
+			assert.GreaterOrEqual(t, float32(len(objs))/float32(expected), float32(0.95))
+			for i := range dists {
+				assert.LessOrEqual(t, dists[i], targetDist)
+				if i > 0 {
+					assert.LessOrEqual(t, dists[i-1], dists[i])
+				}
+			}
+		})
+	}
+}
+
+func TestIndex_ObjectVectorSearchByDistancePages(t *testing.T) {
+	r := rand.New(rand.NewSource(7))
+	dims := 4
+	targetDist := float32(0.2)
+	// far fewer than the matches, every page is cut short
+	queryMaximumResults := int64(20)
+
+	randomVector := func() []float32 {
+		vec := make([]float32, dims)
+		for i := range vec {
+			vec[i] = r.Float32()
+		}
+		return vec
+	}
+	query := randomVector()
+
+	vectors := make([][]float32, 0, 1700)
+	for i := 0; i < 1500; i++ {
+		vectors = append(vectors, randomVector())
+	}
+	// copies of vectors within range have the same distance on every shard,
+	// so the order of the results depends on their ids
+	for i := 0; i < 10; i++ {
+		vec := make([]float32, dims)
+		for j := range vec {
+			vec[j] = query[j] + (r.Float32()-0.5)/10
+		}
+		for j := 0; j < 20; j++ {
+			vectors = append(vectors, vec)
+		}
+	}
+
+	vectorIndexes := []struct {
+		name      string
+		config    schemaConfig.VectorIndexConfig
+		minRecall float32
+	}{
+		{name: "flat", config: flatent.NewDefaultUserConfig(), minRecall: 1},
+		{name: "hnsw", config: enthnsw.NewDefaultUserConfig(), minRecall: 0.95},
+	}
+	shardStates := []struct {
+		name  string
+		state func() *sharding.State
+	}{
+		{name: "single shard", state: singleShardState},
+		{name: "multiple shards", state: multiShardState},
+	}
+	for _, vi := range vectorIndexes {
+		for _, ss := range shardStates {
+			t.Run(vi.name+" "+ss.name, func(t *testing.T) {
+				testVectorSearchByDistancePages(t, vi.config, ss.state(), vi.minRecall,
+					query, vectors, targetDist, queryMaximumResults)
+			})
+		}
+	}
+}
+
+func testVectorSearchByDistancePages(t *testing.T, vectorIndexConfig schemaConfig.VectorIndexConfig,
+	shardState *sharding.State, minRecall float32, query []float32, vectors [][]float32,
+	targetDist float32, queryMaximumResults int64,
+) {
+	ctx := context.Background()
+	pageSize := common.DefaultSearchByDistInitialLimit
+
+	logger, _ := test.NewNullLogger()
+	repo, err := New(logger, Config{
+		MemtablesFlushDirtyAfter:  60,
+		RootPath:                  t.TempDir(),
+		QueryMaximumResults:       queryMaximumResults,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil, nil)
+	require.Nil(t, err)
+	defer repo.Shutdown(context.Background())
+
+	class := &models.Class{
+		Class:               "RangePages",
+		VectorIndexConfig:   vectorIndexConfig,
+		VectorIndexType:     vectorIndexConfig.IndexType(),
+		InvertedIndexConfig: invertedConfig(),
+	}
+	schemaGetter := &fakeSchemaGetter{
+		schema:     schema.Schema{Objects: &models.Schema{}},
+		shardState: shardState,
+	}
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(testCtx()))
+	require.Nil(t, NewMigrator(repo, logger).AddClass(ctx, class, shardState))
+	schemaGetter.schema.Objects.Classes = []*models.Class{class}
+
+	expected := map[strfmt.UUID]struct{}{}
+	for _, vec := range vectors {
+		id := strfmt.UUID(uuid.NewString())
+		obj := &models.Object{ID: id, Class: class.Class}
+		require.Nil(t, repo.PutObject(ctx, obj, vec, nil, nil, 0))
+
+		dist, err := distancer.NewCosineDistanceProvider().SingleDist(
+			distancer.Normalize(query), distancer.Normalize(vec))
+		require.Nil(t, err)
+		if dist <= targetDist {
+			expected[id] = struct{}{}
+		}
+	}
+	require.Greater(t, len(expected), 3*pageSize)
+
+	found := map[strfmt.UUID]struct{}{}
+	var after *searchparams.RangeCursor
+	pages := 0
+	for {
+		res, err := repo.VectorSearch(ctx, dto.GetParams{
+			ClassName: class.Class,
+			NearVector: &searchparams.NearVector{
+				Vectors:      [][]float32{query},
+				Distance:     float64(targetDist),
+				WithDistance: true,
+				After:        after,
+			},
+			Pagination:           &filters.Pagination{Limit: filters.LimitFlagSearchByDist},
+			AdditionalProperties: additional.Properties{Distance: true},
+		}, []string{""}, [][]float32{query})
+		require.Nil(t, err)
+		if len(res) == 0 {
+			break
+		}
+		require.LessOrEqual(t, len(res), pageSize)
+		pages++
+
+		for i, obj := range res {
+			prev := after
+			if i > 0 {
+				prev = &searchparams.RangeCursor{Distance: res[i-1].Dist, ID: res[i-1].ID}
+			}
+			if prev != nil {
+				// ordered by distance and then by id, across pages
+				require.True(t, prev.Distance < obj.Dist ||
+					prev.Distance == obj.Dist && prev.ID < obj.ID)
+			}
+			assert.LessOrEqual(t, obj.Dist, targetDist)
+			require.NotContains(t, found, obj.ID)
+			found[obj.ID] = struct{}{}
+		}
+		last := res[len(res)-1]
+		after = &searchparams.RangeCursor{Distance: last.Dist, ID: last.ID}
+	}
+
+	assert.Greater(t, pages, 3)
+	matched := 0
+	for id := range found {
+		if _, ok := expected[id]; ok {
+			matched++
+		}
+	}
+	assert.GreaterOrEqual(t, float32(matched)/float32(len(expected)), minRecall)
+}
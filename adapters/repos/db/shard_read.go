@@ -28,6 +28,7 @@ import (
 	"github.com/spaolacci/murmur3"
 	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
 	"github.com/weaviate/weaviate/adapters/repos/db/sorter"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
 	"github.com/weaviate/weaviate/entities/additional"
@@ -322,7 +323,7 @@ func (s *Shard) ObjectSearch(ctx context.Context, limit int, filters *filters.Lo
 		if filters != nil {
 			objs, err = inverted.NewSearcher(s.index.logger, s.store,
 				s.index.getSchema.ReadOnlyClass, s.propertyIndices,
-				s.index.classSearcher, s.index.stopwords, s.versioner.Version(),
+				s.index.classSearcher, s.index.stopwords, s.index.getTextAnalyzers(), s.versioner.Version(),
 				s.isFallbackToSearchable, s.tenant(), s.index.Config.QueryNestedRefLimit,
 				s.bitmapFactory).
 				DocIDs(ctx, filters, additional, s.index.Config.ClassName)
@@ -333,12 +334,16 @@ func (s *Shard) ObjectSearch(ctx context.Context, limit int, filters *filters.Lo
 			filterDocIds = objs
 		}
 
+		if keywordRanking.Type == "sparseVector" {
+			return s.sparseObjectSearch(ctx, limit, filterDocIds, *keywordRanking, additional, properties)
+		}
+
 		className := s.index.Config.ClassName
 		bm25Config := s.index.getInvertedIndexConfig().BM25
 		logger := s.index.logger.WithFields(logrus.Fields{"class": s.index.Config.ClassName, "shard": s.name})
 		bm25searcher := inverted.NewBM25Searcher(bm25Config, s.store,
 			s.index.getSchema.ReadOnlyClass, s.propertyIndices, s.index.classSearcher,
-			s.GetPropertyLengthTracker(), logger, s.versioner.Version())
+			s.index.getTextAnalyzers(), s.GetPropertyLengthTracker(), logger, s.versioner.Version())
 		bm25objs, bm25count, err = bm25searcher.BM25F(ctx, filterDocIds, className, limit, *keywordRanking, additional)
 		if err != nil {
 			return nil, nil, err
@@ -353,7 +358,7 @@ func (s *Shard) ObjectSearch(ctx context.Context, limit int, filters *filters.Lo
 		return objs, nil, err
 	}
 	objs, err := inverted.NewSearcher(s.index.logger, s.store, s.index.getSchema.ReadOnlyClass,
-		s.propertyIndices, s.index.classSearcher, s.index.stopwords, s.versioner.Version(),
+		s.propertyIndices, s.index.classSearcher, s.index.stopwords, s.index.getTextAnalyzers(), s.versioner.Version(),
 		s.isFallbackToSearchable, s.tenant(), s.index.Config.QueryNestedRefLimit, s.bitmapFactory).
 		Objects(ctx, limit, filters, sort, additional, s.index.Config.ClassName, properties)
 	return objs, nil, err
@@ -416,7 +421,7 @@ func (s *Shard) getIndexQueue(targetVector string) (*VectorIndexQueue, error) {
 	return s.queue, nil
 }
 
-func (s *Shard) ObjectVectorSearch(ctx context.Context, searchVectors [][]float32, targetVectors []string, targetDist float32, limit int, filters *filters.LocalFilter, sort []filters.Sort, groupBy *searchparams.GroupBy, additional additional.Properties, targetCombination *dto.TargetCombination, properties []string) ([]*storobj.Object, []float32, error) {
+func (s *Shard) ObjectVectorSearch(ctx context.Context, searchVectors [][]float32, targetVectors []string, targetDist float32, limit int, filters *filters.LocalFilter, sort []filters.Sort, groupBy *searchparams.GroupBy, additional additional.Properties, targetCombination *dto.TargetCombination, properties []string, matryoshkaDims int) ([]*storobj.Object, []float32, error) {
 	startTime := time.Now()
 
 	defer func() {
@@ -456,6 +461,7 @@ func (s *Shard) ObjectVectorSearch(ctx context.Context, searchVectors [][]float3
 	eg.SetLimit(_NUMCPU)
 	idss := make([][]uint64, len(targetVectors))
 	distss := make([][]float32, len(targetVectors))
+	plans := newQueryPlans(len(targetVectors))
 	beforeVector := time.Now()
 
 	for i, targetVector := range targetVectors {
@@ -471,32 +477,10 @@ func (s *Shard) ObjectVectorSearch(ctx context.Context, searchVectors [][]float3
 				return err
 			}
 
-			if limit < 0 {
-				ids, dists, err = vidx.SearchByVectorDistance(
-					ctx, searchVectors[i], targetDist, s.index.Config.QueryMaximumResults, allowList)
-				if err != nil {
-					// This should normally not fail. A failure here could indicate that more
-					// attention is required, for example because data is corrupted. That's
-					// why this error is explicitly pushed to sentry.
-					err = fmt.Errorf("vector search by distance: %w", err)
-					entsentry.CaptureException(err)
-					return err
-				}
-			} else {
-				ids, dists, err = vidx.SearchByVector(ctx, searchVectors[i], limit, allowList)
-				if err != nil {
-					// This should normally not fail. A failure here could indicate that more
-					// attention is required, for example because data is corrupted. That's
-					// why this error is explicitly pushed to sentry.
-					err = fmt.Errorf("vector search: %w", err)
-					// annotate for sentry so we know which collection/shard this happened on
-					entsentry.CaptureException(fmt.Errorf("collection %q shard %q: %w",
-						s.index.Config.ClassName, s.name, err))
-					return err
-				}
-			}
-			if len(ids) == 0 {
-				return nil
+			ids, dists, plans[i], err = s.searchVectorIndex(ctx, vidx, targetVector, searchVectors[i],
+				targetDist, limit, allowList, matryoshkaDims)
+			if err != nil {
+				return err
 			}
 
 			idss[i] = ids
@@ -520,10 +504,13 @@ func (s *Shard) ObjectVectorSearch(ctx context.Context, searchVectors [][]float3
 	helpers.AnnotateSlowQueryLog(ctx, "vector_search_took", time.Since(beforeVector))
 
 	if groupBy != nil {
-		objs, dists, err := s.groupResults(ctx, idsCombined, distCombined, groupBy, additional, properties)
+		objs, dists, err := s.groupResults(ctx, idsCombined, distCombined, groupBy, additional, properties, targetVectors)
 		if err != nil {
 			return nil, nil, err
 		}
+		if additional.QueryPlan {
+			addQueryPlans(objs, plans)
+		}
 		return objs, dists, nil
 	}
 
@@ -555,9 +542,150 @@ func (s *Shard) ObjectVectorSearch(ctx context.Context, searchVectors [][]float3
 	}
 
 	helpers.AnnotateSlowQueryLog(ctx, "objects_took", took)
+	if additional.QueryPlan {
+		addQueryPlans(objs, plans)
+	}
 	return objs, distCombined, nil
 }
 
+// searchVectorIndex runs a single query against the vector index of a target
+// vector. A negative limit searches by distance. If the index holds matryoshka
+// truncated vectors, the candidates are re-ranked with the full vectors.
+func (s *Shard) searchVectorIndex(ctx context.Context, vidx VectorIndex, targetVector string,
+	searchVector []float32, targetDist float32, limit int, allowList helpers.AllowList,
+	matryoshkaDims int,
+) ([]uint64, []float32, *additional.QueryPlan, error) {
+	matryoshka := s.matryoshkaConfig(targetVector)
+	if _, ok := searchparams.RangeCursorFromContext(ctx); ok && limit < 0 && matryoshka.Enabled() {
+		return nil, nil, nil, fmt.Errorf("paging a search by distance is not supported with matryoshka vectors")
+	}
+	searchLimit := limit
+	if matryoshka.Enabled() {
+		searchLimit = matryoshkaSearchLimit(matryoshka, limit)
+	}
+	ctx, plan := s.planVectorSearch(ctx, vidx, targetVector, allowList, searchLimit)
+
+	var (
+		ids   []uint64
+		dists []float32
+		err   error
+	)
+	if limit < 0 {
+		ids, dists, err = s.searchVectorIndexByDistance(ctx, vidx, searchVector, targetDist, allowList)
+		if err != nil {
+			// This should normally not fail. A failure here could indicate that more
+			// attention is required, for example because data is corrupted. That's
+			// why this error is explicitly pushed to sentry.
+			err = fmt.Errorf("vector search by distance: %w", err)
+			entsentry.CaptureException(err)
+			return nil, nil, nil, err
+		}
+	} else {
+		ids, dists, err = vidx.SearchByVector(ctx, searchVector, searchLimit, allowList)
+		if err != nil {
+			// This should normally not fail. A failure here could indicate that more
+			// attention is required, for example because data is corrupted. That's
+			// why this error is explicitly pushed to sentry.
+			err = fmt.Errorf("vector search: %w", err)
+			// annotate for sentry so we know which collection/shard this happened on
+			entsentry.CaptureException(fmt.Errorf("collection %q shard %q: %w",
+				s.index.Config.ClassName, s.name, err))
+			return nil, nil, nil, err
+		}
+	}
+	if len(ids) == 0 || !matryoshka.Enabled() {
+		return ids, dists, plan, nil
+	}
+
+	ids, dists, err = s.rescoreMatryoshka(ctx, vidx, targetVector, searchVector, ids,
+		matryoshka, matryoshkaDims, limit, targetDist)
+	return ids, dists, plan, err
+}
+
+// searchVectorIndexByDistance returns the vectors within targetDist of the
+// search vector, up to the maximum number of query results. Indexes which
+// support range search find them in a single pass, ordered by distance and
+// then by uuid, and continue behind the range cursor of the context, if any.
+// The others are searched with a growing limit until the distance is exceeded.
+func (s *Shard) searchVectorIndexByDistance(ctx context.Context, vidx VectorIndex,
+	searchVector []float32, targetDist float32, allowList helpers.AllowList,
+) ([]uint64, []float32, error) {
+	cursor, withCursor := searchparams.RangeCursorFromContext(ctx)
+	rs, ok := vidx.(rangeVectorSearcher)
+	if !ok || vidx.Multivector() {
+		if withCursor {
+			return nil, nil, fmt.Errorf("the vector index doesn't support paging a search by distance")
+		}
+		return vidx.SearchByVectorDistance(ctx, searchVector, targetDist,
+			s.index.Config.QueryMaximumResults, allowList)
+	}
+
+	uuids := newDocUUIDs(s.store.Bucket(helpers.ObjectsBucketLSM))
+	search := func(fn func(id uint64, dist float32) bool) error {
+		return rs.SearchByVectorRange(ctx, searchVector, targetDist, allowList, func(id uint64, dist float32) bool {
+			if withCursor && (dist < cursor.Distance ||
+				dist == cursor.Distance && uuids.get(id) <= cursor.ID) {
+				return true
+			}
+			return fn(id, dist)
+		})
+	}
+	limit := s.index.searchByDistanceLimit()
+	ids, dists, truncated, err := common.RangeSearch(search, limit, uuids.less)
+	if err == nil {
+		err = uuids.err
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("range search: %w", err)
+	}
+	if truncated {
+		s.index.logger.
+			WithField("action", "unlimited_vector_search").
+			Warnf("maximum search limit of %d results has been reached", limit)
+	}
+	return ids, dists, nil
+}
+
+// docUUIDs looks up the uuids of doc ids, so that results with the same
+// distance are ordered the same way on every shard
+type docUUIDs struct {
+	bucket *lsmkv.Bucket
+	uuids  map[uint64]strfmt.UUID
+	err    error
+}
+
+func newDocUUIDs(bucket *lsmkv.Bucket) *docUUIDs {
+	return &docUUIDs{bucket: bucket, uuids: map[uint64]strfmt.UUID{}}
+}
+
+// get returns the uuid of the doc id. Objects which no longer exist have an
+// empty uuid, they are dropped when the objects are loaded.
+func (d *docUUIDs) get(docID uint64) strfmt.UUID {
+	if id, ok := d.uuids[docID]; ok {
+		return id
+	}
+
+	var id strfmt.UUID
+	key := binary.LittleEndian.AppendUint64(nil, docID)
+	res, err := d.bucket.GetBySecondary(0, key)
+	if err == nil && res != nil {
+		var prop []string
+		prop, _, err = storobj.ParseAndExtractProperty(res, "id")
+		if err == nil {
+			id = strfmt.UUID(prop[0])
+		}
+	}
+	if err != nil && d.err == nil {
+		d.err = fmt.Errorf("uuid of doc id %d: %w", docID, err)
+	}
+	d.uuids[docID] = id
+	return id
+}
+
+func (d *docUUIDs) less(a, b uint64) bool {
+	return d.get(a) < d.get(b)
+}
+
 func (s *Shard) ObjectList(ctx context.Context, limit int, sort []filters.Sort, cursor *filters.Cursor, additional additional.Properties, className schema.ClassName) ([]*storobj.Object, error) {
 	s.activityTracker.Add(1)
 	if len(sort) > 0 {
@@ -639,7 +767,7 @@ func (s *Shard) sortDocIDsAndDists(ctx context.Context, limit int, sort []filter
 
 func (s *Shard) buildAllowList(ctx context.Context, filters *filters.LocalFilter, addl additional.Properties) (helpers.AllowList, error) {
 	list, err := inverted.NewSearcher(s.index.logger, s.store, s.index.getSchema.ReadOnlyClass,
-		s.propertyIndices, s.index.classSearcher, s.index.stopwords, s.versioner.Version(),
+		s.propertyIndices, s.index.classSearcher, s.index.stopwords, s.index.getTextAnalyzers(), s.versioner.Version(),
 		s.isFallbackToSearchable, s.tenant(), s.index.Config.QueryNestedRefLimit, s.bitmapFactory).
 		DocIDs(ctx, filters, addl, s.index.Config.ClassName)
 	if err != nil {
@@ -0,0 +1,170 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/entities/additional"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+// ObjectVectorSearchBatch runs several near vector queries against a single
+// target vector. The filter is resolved into an allow list once and shared by
+// all queries. If the vector index supports it, the queries are evaluated
+// together, otherwise they run concurrently. The result holds one list of
+// objects and distances per query vector, in the order of searchVectors.
+func (s *Shard) ObjectVectorSearchBatch(ctx context.Context, searchVectors [][]float32,
+	targetVector string, targetDist float32, limit int, filters *filters.LocalFilter,
+	additional additional.Properties, properties []string,
+) ([][]*storobj.Object, [][]float32, error) {
+	startTime := time.Now()
+
+	defer func() {
+		s.slowQueryReporter.LogIfSlow(ctx, startTime, map[string]any{
+			"collection": s.index.Config.ClassName,
+			"shard":      s.ID(),
+			"tenant":     s.tenant(),
+			"query":      "ObjectVectorSearchBatch",
+			"queries":    len(searchVectors),
+			"filters":    filters,
+			"limit":      limit,
+			"version":    s.versioner.Version(),
+			"additional": additional,
+		})
+	}()
+
+	s.activityTracker.Add(1)
+
+	var allowList helpers.AllowList
+	if filters != nil {
+		beforeFilter := time.Now()
+		list, err := s.buildAllowList(ctx, filters, additional)
+		if err != nil {
+			return nil, nil, err
+		}
+		allowList = list
+		took := time.Since(beforeFilter)
+		s.metrics.FilteredVectorFilter(took)
+		helpers.AnnotateSlowQueryLog(ctx, "filters_build_allow_list_took", took)
+		helpers.AnnotateSlowQueryLog(ctx, "filters_ids_matched", allowList.Len())
+	}
+
+	vidx, err := s.getVectorIndex(targetVector)
+	if err != nil {
+		return nil, nil, err
+	}
+	if vidx.Multivector() {
+		return nil, nil, fmt.Errorf("batch search is not supported for multi vector target vector %q",
+			targetVector)
+	}
+
+	beforeVector := time.Now()
+	idss, distss, err := s.searchVectorIndexBatch(ctx, vidx, targetVector, searchVectors,
+		targetDist, limit, allowList)
+	if err != nil {
+		return nil, nil, err
+	}
+	if filters != nil {
+		s.metrics.FilteredVectorVector(time.Since(beforeVector))
+	}
+	helpers.AnnotateSlowQueryLog(ctx, "vector_search_took", time.Since(beforeVector))
+
+	beforeObjects := time.Now()
+
+	// queries of a batch often share results, so every object is read only once
+	var docIDs []uint64
+	seen := map[uint64]struct{}{}
+	for _, ids := range idss {
+		for _, id := range ids {
+			if _, ok := seen[id]; !ok {
+				seen[id] = struct{}{}
+				docIDs = append(docIDs, id)
+			}
+		}
+	}
+
+	bucket := s.store.Bucket(helpers.ObjectsBucketLSM)
+	objs, err := storobj.ObjectsByDocID(bucket, docIDs, additional, properties, s.index.logger)
+	if err != nil {
+		return nil, nil, err
+	}
+	objsByDocID := make(map[uint64]*storobj.Object, len(objs))
+	for _, obj := range objs {
+		objsByDocID[obj.DocID] = obj
+	}
+
+	outObjs := make([][]*storobj.Object, len(searchVectors))
+	outDists := make([][]float32, len(searchVectors))
+	for i, ids := range idss {
+		outObjs[i] = make([]*storobj.Object, 0, len(ids))
+		outDists[i] = make([]float32, 0, len(ids))
+		for j, id := range ids {
+			// objects deleted in the meantime are skipped
+			if obj, ok := objsByDocID[id]; ok {
+				outObjs[i] = append(outObjs[i], obj)
+				outDists[i] = append(outDists[i], distss[i][j])
+			}
+		}
+	}
+
+	took := time.Since(beforeObjects)
+	if filters != nil {
+		s.metrics.FilteredVectorObjects(took)
+	}
+	helpers.AnnotateSlowQueryLog(ctx, "objects_took", took)
+
+	return outObjs, outDists, nil
+}
+
+func (s *Shard) searchVectorIndexBatch(ctx context.Context, vidx VectorIndex,
+	targetVector string, searchVectors [][]float32, targetDist float32, limit int,
+	allowList helpers.AllowList,
+) ([][]uint64, [][]float32, error) {
+	// matryoshka indexes need to re-rank each query on the full vectors and
+	// searches by distance have no fixed k, both run query by query
+	if bs, ok := vidx.(batchVectorSearcher); ok && limit >= 0 &&
+		!s.matryoshkaConfig(targetVector).Enabled() {
+		idss, distss, err := bs.SearchByVectorBatch(ctx, searchVectors, limit, allowList)
+		if err != nil {
+			return nil, nil, fmt.Errorf("vector search batch: %w", err)
+		}
+		return idss, distss, nil
+	}
+
+	eg := enterrors.NewErrorGroupWrapper(s.index.logger)
+	eg.SetLimit(_NUMCPU)
+	idss := make([][]uint64, len(searchVectors))
+	distss := make([][]float32, len(searchVectors))
+	for i := range searchVectors {
+		i := i
+		eg.Go(func() error {
+			ids, dists, _, err := s.searchVectorIndex(ctx, vidx, targetVector, searchVectors[i],
+				targetDist, limit, allowList, 0)
+			if err != nil {
+				return err
+			}
+			idss[i] = ids
+			distss[i] = dists
+			return nil
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return nil, nil, err
+	}
+	return idss, distss, nil
+}
@@ -0,0 +1,115 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	schemaConfig "github.com/weaviate/weaviate/entities/schema/config"
+	"github.com/weaviate/weaviate/entities/storobj"
+	flatent "github.com/weaviate/weaviate/entities/vectorindex/flat"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestShard_ObjectVectorSearchBatch(t *testing.T) {
+	ctx := context.Background()
+	r := rand.New(rand.NewSource(7))
+	objectCount := 600
+	dims := 8
+
+	randomVector := func() []float32 {
+		vec := make([]float32, dims)
+		for i := range vec {
+			vec[i] = r.Float32()
+		}
+		return vec
+	}
+
+	queries := make([][]float32, 5)
+	for i := range queries {
+		queries[i] = randomVector()
+	}
+
+	vectorIndexes := []struct {
+		name   string
+		config schemaConfig.VectorIndexConfig
+	}{
+		{name: "flat", config: flatent.NewDefaultUserConfig()},
+		{name: "hnsw", config: enthnsw.NewDefaultUserConfig()},
+	}
+	for _, vi := range vectorIndexes {
+		t.Run(vi.name, func(t *testing.T) {
+			class := &models.Class{
+				Class:               "BatchClass",
+				InvertedIndexConfig: &models.InvertedIndexConfig{},
+				Properties: []*models.Property{
+					{Name: "category", DataType: schema.DataTypeInt.PropString()},
+				},
+			}
+			shard, _ := testShardWithSettings(t, ctx, class, vi.config, false, false, func(i *Index) {
+				i.vectorIndexUserConfigs = map[string]schemaConfig.VectorIndexConfig{
+					"embedding": vi.config,
+				}
+			})
+			defer shard.Shutdown(ctx)
+
+			for i := 0; i < objectCount; i++ {
+				obj := &storobj.Object{
+					MarshallerVersion: 1,
+					Object: models.Object{
+						ID:         strfmt.UUID(uuid.NewString()),
+						Class:      class.Class,
+						Properties: map[string]interface{}{"category": float64(i % 3)},
+					},
+					Vectors: map[string][]float32{"embedding": randomVector()},
+				}
+				require.Nil(t, shard.PutObject(ctx, obj))
+			}
+
+			category := filterEqual[int](1, schema.DataTypeInt, class.Class, "category")
+			for _, filter := range []*filters.LocalFilter{nil, category} {
+				batchObjs, batchDists, err := shard.ObjectVectorSearchBatch(ctx, queries, "embedding",
+					0, 10, filter, additional.Properties{}, nil)
+				require.Nil(t, err)
+				require.Len(t, batchObjs, len(queries))
+				require.Len(t, batchDists, len(queries))
+
+				for i, query := range queries {
+					objs, dists, err := shard.ObjectVectorSearch(ctx, [][]float32{query}, []string{"embedding"},
+						0, 10, filter, nil, nil, additional.Properties{}, nil, nil, 0)
+					require.Nil(t, err)
+
+					require.Len(t, batchObjs[i], len(objs))
+					for j := range objs {
+						assert.Equal(t, objs[j].ID(), batchObjs[i][j].ID())
+						if filter != nil {
+							assert.Equal(t, float64(1), batchObjs[i][j].Properties().(map[string]interface{})["category"])
+						}
+					}
+					assert.InDeltaSlice(t, dists, batchDists[i], 1e-6)
+				}
+			}
+		})
+	}
+}
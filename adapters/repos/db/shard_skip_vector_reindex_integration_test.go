@@ -513,7 +513,7 @@ func TestShard_SkipVectorReindex(t *testing.T) {
 			t.Run("to be found", func(t *testing.T) {
 				require.EventuallyWithT(t, func(collect *assert.CollectT) {
 					found, _, err := shard.ObjectVectorSearch(ctx, [][]float32{vectorToBeFound}, []string{targetVector},
-						vectorSearchDist, vectorSearchLimit, nil, nil, nil, additional.Properties{}, nil, nil)
+						vectorSearchDist, vectorSearchLimit, nil, nil, nil, additional.Properties{}, nil, nil, 0)
 					if !assert.NoError(collect, err) {
 						return
 					}
@@ -526,7 +526,7 @@ func TestShard_SkipVectorReindex(t *testing.T) {
 
 			t.Run("not to be found", func(t *testing.T) {
 				found, _, err := shard.ObjectVectorSearch(ctx, [][]float32{vectorNotToBeFound}, []string{targetVector},
-					vectorSearchDist, vectorSearchLimit, nil, nil, nil, additional.Properties{}, nil, nil)
+					vectorSearchDist, vectorSearchLimit, nil, nil, nil, additional.Properties{}, nil, nil, 0)
 				require.NoError(t, err)
 				require.Len(t, found, 0)
 			})
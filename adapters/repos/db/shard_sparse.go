@@ -0,0 +1,63 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+// sparseObjectSearch returns the objects whose sparse vectors of the target
+// vector have the highest dot product with the query vector
+func (s *Shard) sparseObjectSearch(ctx context.Context, limit int, allow helpers.AllowList,
+	keywordRanking searchparams.KeywordRanking, additional additional.Properties,
+	properties []string,
+) ([]*storobj.Object, []float32, error) {
+	index, ok := s.sparseIndexes[keywordRanking.TargetVector]
+	if !ok {
+		return nil, nil, fmt.Errorf("sparse index for target vector %q not found",
+			keywordRanking.TargetVector)
+	}
+
+	ids, scores, err := index.Search(ctx, keywordRanking.SparseVector, limit, allow)
+	if err != nil {
+		return nil, nil, fmt.Errorf("sparse vector search: %w", err)
+	}
+	if len(ids) == 0 {
+		return []*storobj.Object{}, []float32{}, nil
+	}
+
+	bucket := s.store.Bucket(helpers.ObjectsBucketLSM)
+	objs, err := storobj.ObjectsByDocID(bucket, ids, additional, properties, s.index.logger)
+	if err != nil {
+		return nil, nil, fmt.Errorf("load objects: %w", err)
+	}
+
+	// objects may have been removed since the search, their scores are dropped
+	if len(objs) != len(scores) {
+		j := 0
+		for i := range ids {
+			if j < len(objs) && objs[j].DocID == ids[i] {
+				scores[j] = scores[i]
+				j++
+			}
+		}
+		scores = scores[:j]
+	}
+
+	return objs, scores, nil
+}
@@ -0,0 +1,124 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	schemaConfig "github.com/weaviate/weaviate/entities/schema/config"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	"github.com/weaviate/weaviate/entities/storobj"
+	flatent "github.com/weaviate/weaviate/entities/vectorindex/flat"
+	sparseent "github.com/weaviate/weaviate/entities/vectorindex/sparse"
+)
+
+func TestShard_SparseVectors(t *testing.T) {
+	ctx := context.Background()
+	className := "SparseClass"
+
+	shard, _ := testShardWithSettings(t, ctx, &models.Class{Class: className},
+		flatent.NewDefaultUserConfig(), false, false, func(i *Index) {
+			i.vectorIndexUserConfigs = map[string]schemaConfig.VectorIndexConfig{
+				"dense":  flatent.NewDefaultUserConfig(),
+				"sparse": sparseent.NewDefaultUserConfig(),
+			}
+		})
+	defer shard.Shutdown(ctx)
+
+	first := strfmt.UUID("00000000-0000-0000-0000-000000000001")
+	second := strfmt.UUID("00000000-0000-0000-0000-000000000002")
+	third := strfmt.UUID("00000000-0000-0000-0000-000000000003")
+
+	put := func(t *testing.T, id strfmt.UUID, indices []uint32, values []float32) {
+		obj := &storobj.Object{
+			MarshallerVersion: 1,
+			Object:            models.Object{ID: id, Class: className},
+			Vectors:           map[string][]float32{"dense": {1, 2, 3}},
+			SparseVectors: models.SparseVectors{
+				"sparse": {Indices: indices, Values: values},
+			},
+		}
+		require.Nil(t, shard.PutObject(ctx, obj))
+	}
+
+	search := func(t *testing.T, indices []uint32, values []float32) ([]strfmt.UUID, []float32) {
+		res, scores, err := shard.ObjectSearch(ctx, 10, nil, &searchparams.KeywordRanking{
+			Type:         "sparseVector",
+			TargetVector: "sparse",
+			SparseVector: models.SparseVector{Indices: indices, Values: values},
+		}, nil, nil, additional.Properties{}, nil)
+		require.Nil(t, err)
+		require.Len(t, scores, len(res))
+		ids := make([]strfmt.UUID, len(res))
+		for i := range res {
+			ids[i] = res[i].ID()
+		}
+		return ids, scores
+	}
+
+	put(t, first, []uint32{1, 2}, []float32{1, 0.5})
+	put(t, second, []uint32{2, 3}, []float32{2, 1})
+	put(t, third, []uint32{4}, []float32{3})
+
+	t.Run("ranked by dot product", func(t *testing.T) {
+		ids, scores := search(t, []uint32{1, 2}, []float32{1, 1})
+		assert.Equal(t, []strfmt.UUID{second, first}, ids)
+		assert.InDeltaSlice(t, []float32{2, 1.5}, scores, 1e-3)
+	})
+
+	t.Run("stored with the object", func(t *testing.T) {
+		obj, err := shard.ObjectByID(ctx, first, nil, additional.Properties{Vectors: []string{"sparse"}})
+		require.Nil(t, err)
+		assert.Equal(t, models.SparseVector{Indices: []uint32{1, 2}, Values: []float32{1, 0.5}},
+			obj.SparseVectors["sparse"])
+	})
+
+	t.Run("updated vector replaces the previous one", func(t *testing.T) {
+		put(t, first, []uint32{4}, []float32{1})
+
+		ids, _ := search(t, []uint32{1, 2}, []float32{1, 1})
+		assert.Equal(t, []strfmt.UUID{second}, ids)
+
+		ids, scores := search(t, []uint32{4}, []float32{1})
+		assert.Equal(t, []strfmt.UUID{third, first}, ids)
+		assert.InDeltaSlice(t, []float32{3, 1}, scores, 1e-3)
+	})
+
+	t.Run("deleted objects are not found", func(t *testing.T) {
+		require.Nil(t, shard.DeleteObject(ctx, third, time.Now()))
+
+		ids, _ := search(t, []uint32{4}, []float32{1})
+		assert.Equal(t, []strfmt.UUID{first}, ids)
+	})
+
+	t.Run("sparse vector for a dense target vector", func(t *testing.T) {
+		obj := &storobj.Object{
+			MarshallerVersion: 1,
+			Object:            models.Object{ID: third, Class: className},
+			SparseVectors: models.SparseVectors{
+				"dense": {Indices: []uint32{1}, Values: []float32{1}},
+			},
+		}
+		err := shard.PutObject(ctx, obj)
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "is not a sparse vector")
+	})
+}
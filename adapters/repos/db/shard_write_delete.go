@@ -208,6 +208,14 @@ func (s *Shard) cleanupInvertedIndexOnDelete(previous []byte, docID uint64) erro
 		}
 	}
 
+	for vecName, vec := range previousObject.SparseVectors {
+		if index, ok := s.sparseIndexes[vecName]; ok {
+			if err = index.Delete(docID, vec); err != nil {
+				return fmt.Errorf("delete sparse vector %q: %w", vecName, err)
+			}
+		}
+	}
+
 	return nil
 }
 
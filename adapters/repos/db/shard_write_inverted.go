@@ -79,6 +79,9 @@ func (s *Shard) AnalyzeObject(object *storobj.Object) ([]inverted.Property, []in
 		schemaMap[filters.InternalPropLastUpdateTimeUnix] = object.Object.LastUpdateTimeUnix
 	}
 
-	props, err := inverted.NewAnalyzer(s.isFallbackToSearchable).Object(schemaMap, c.Properties, object.ID())
+	props, err := inverted.NewAnalyzer(s.isFallbackToSearchable).
+		WithTextAnalyzers(s.index.getTextAnalyzers()).
+		WithIndexPositions(s.index.invertedIndexConfig.IndexPositions).
+		Object(schemaMap, c.Properties, object.ID())
 	return props, nilProps, err
 }
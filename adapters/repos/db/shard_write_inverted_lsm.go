@@ -16,6 +16,7 @@ import (
 	"fmt"
 	"math"
 	"os"
+	"slices"
 
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
@@ -107,6 +108,19 @@ func (s *Shard) addToPropertyValueIndex(docID uint64, property inverted.Property
 				return errors.Wrapf(err, "failed adding to prop '%s' value bucket", property.Name)
 			}
 		}
+
+		if slices.Contains(s.index.invertedIndexConfig.IndexPositions, property.Name) {
+			bucketPositions := s.store.Bucket(helpers.BucketPositionsFromPropNameLSM(property.Name))
+			if bucketPositions == nil {
+				return errors.Errorf("no bucket positions for prop '%s' found", property.Name)
+			}
+			for _, item := range property.Items {
+				pair := inverted.PairPositions(docID, propLen, item.Positions)
+				if err := s.addToPropertyMapBucket(bucketPositions, pair, item.Data); err != nil {
+					return errors.Wrapf(err, "failed adding to prop '%s' positions bucket", property.Name)
+				}
+			}
+		}
 	}
 
 	if property.HasRangeableIndex {
@@ -14,6 +14,7 @@ package db
 import (
 	"encoding/binary"
 	"fmt"
+	"slices"
 
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
@@ -52,6 +53,22 @@ func (s *Shard) deleteFromInvertedIndicesLSM(props []inverted.Property, nilProps
 						string(item.Data))
 				}
 			}
+
+			if slices.Contains(s.index.invertedIndexConfig.IndexPositions, prop.Name) {
+				bucketPositions := s.store.Bucket(helpers.BucketPositionsFromPropNameLSM(prop.Name))
+				if bucketPositions == nil {
+					return fmt.Errorf("no bucket positions for prop '%s' found", prop.Name)
+				}
+
+				docIDBytes := make([]byte, 8)
+				binary.BigEndian.PutUint64(docIDBytes, docID)
+				for _, item := range prop.Items {
+					if err := bucketPositions.MapDeleteKey(item.Data, docIDBytes); err != nil {
+						return errors.Wrapf(err, "delete item '%s' from positions index",
+							string(item.Data))
+					}
+				}
+			}
 		}
 
 		if prop.HasRangeableIndex {
@@ -298,6 +298,12 @@ func mergeProps(previous *storobj.Object,
 		next.Vectors = vectorsAsMap(merge.Vectors)
 	}
 
+	if len(merge.SparseVectors) == 0 {
+		next.SparseVectors = previous.SparseVectors
+	} else {
+		next.SparseVectors = merge.SparseVectors
+	}
+
 	next.Object.LastUpdateTimeUnix = merge.UpdateTime
 	next.SetProperties(properties)
 
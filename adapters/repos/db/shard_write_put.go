@@ -18,6 +18,7 @@ import (
 	"fmt"
 	"os"
 	"reflect"
+	"slices"
 	"time"
 
 	"github.com/google/uuid"
@@ -27,6 +28,7 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/sparse"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/storobj"
 	"github.com/weaviate/weaviate/entities/types"
@@ -257,6 +259,15 @@ func (s *Shard) putObjectLSM(obj *storobj.Object, idBytes []byte,
 		}
 	}
 
+	for targetVector, vector := range obj.SparseVectors {
+		if _, ok := s.sparseIndexes[targetVector]; !ok {
+			return status, fmt.Errorf("target vector %q of object %s is not a sparse vector", targetVector, obj.ID())
+		}
+		if err := sparse.Validate(vector); err != nil {
+			return status, errors.Wrapf(err, "Validate sparse vector %s for %s", targetVector, obj.ID())
+		}
+	}
+
 	bucket := s.store.Bucket(helpers.ObjectsBucketLSM)
 	var prevObj *storobj.Object
 
@@ -569,6 +580,41 @@ func (s *Shard) updateInvertedIndexLSM(object *storobj.Object,
 		}
 	}
 
+	if err := s.updateSparseIndexes(object, status, prevObject); err != nil {
+		return fmt.Errorf("update sparse indexes: %w", err)
+	}
+
+	return nil
+}
+
+// updateSparseIndexes moves the sparse vectors of an object to its new doc
+// id. An existing object whose doc id did not change is left untouched, as a
+// change of any sparse vector always results in a new doc id.
+func (s *Shard) updateSparseIndexes(object *storobj.Object,
+	status objectInsertStatus, prevObject *storobj.Object,
+) error {
+	if prevObject != nil {
+		if !status.docIDChanged {
+			return nil
+		}
+		for targetVector, vector := range prevObject.SparseVectors {
+			if index, ok := s.sparseIndexes[targetVector]; ok {
+				if err := index.Delete(status.oldDocID, vector); err != nil {
+					return fmt.Errorf("delete sparse vector %q: %w", targetVector, err)
+				}
+			}
+		}
+	}
+
+	for targetVector, vector := range object.SparseVectors {
+		index, ok := s.sparseIndexes[targetVector]
+		if !ok {
+			return fmt.Errorf("sparse index for target vector %q not found", targetVector)
+		}
+		if err := index.Add(status.docID, vector); err != nil {
+			return fmt.Errorf("add sparse vector %q: %w", targetVector, err)
+		}
+	}
 	return nil
 }
 
@@ -590,6 +636,9 @@ func compareObjsForInsertStatus(prevObj, nextObj *storobj.Object) (preserve, ski
 	if !targetVectorsEqual(prevObj.Vectors, nextObj.Vectors) {
 		return false, false
 	}
+	if !sparseVectorsEqual(prevObj.SparseVectors, nextObj.SparseVectors) {
+		return false, false
+	}
 	if !addPropsEqual(prevObj.Object.Additional, nextObj.Object.Additional) {
 		return true, false
 	}
@@ -675,6 +724,19 @@ func targetVectorsEqual(prevTargetVectors, nextTargetVectors map[string][]float3
 	return true
 }
 
+func sparseVectorsEqual(prevSparseVectors, nextSparseVectors models.SparseVectors) bool {
+	if len(prevSparseVectors) != len(nextSparseVectors) {
+		return false
+	}
+	for vecName, vec := range prevSparseVectors {
+		next, ok := nextSparseVectors[vecName]
+		if !ok || !slices.Equal(vec.Indices, next.Indices) || !slices.Equal(vec.Values, next.Values) {
+			return false
+		}
+	}
+	return true
+}
+
 func addPropsEqual(prevAddProps, nextAddProps models.AdditionalProperties) bool {
 	return reflect.DeepEqual(prevAddProps, nextAddProps)
 }
@@ -26,8 +26,13 @@ func (sbd *sortByDistances) Len() int {
 	return len(sbd.objects)
 }
 
+// Less orders objects with the same distance by id, so that the results of
+// several shards are merged into the same order as every shard returns them
 func (sbd *sortByDistances) Less(i, j int) bool {
-	return sbd.scores[i] < sbd.scores[j]
+	if sbd.scores[i] != sbd.scores[j] {
+		return sbd.scores[i] < sbd.scores[j]
+	}
+	return sbd.objects[i].ID() < sbd.objects[j].ID()
 }
 
 func (sbd *sortByDistances) Swap(i, j int) {
@@ -0,0 +1,386 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/adapters/repos/db/refcache"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/multi"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/schema/crossref"
+	"github.com/weaviate/weaviate/entities/search"
+)
+
+// Traverse runs a breadth-first expansion over the cross-references of the
+// start objects. Each level is resolved with a single refcache lookup, so
+// objects on other shards or nodes are fetched the same way as references
+// in a Get query. Objects reached a second time are linked by an edge, but
+// not expanded again, which makes the traversal safe against cycles.
+func (db *DB) Traverse(ctx context.Context, params dto.TraverseParams) (*dto.TraverseResult, error) {
+	router := &tenantRouter{db: db}
+	t := &traversal{
+		db:      db,
+		params:  params,
+		router:  router,
+		cacher:  refcache.NewCacher(router, db.logger, params.Tenant),
+		result:  &dto.TraverseResult{},
+		visited: map[multi.Identifier]struct{}{},
+		parents: map[multi.Identifier]multi.Identifier{},
+	}
+
+	frontier, err := t.start(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for depth := 0; depth < params.MaxDepth && len(frontier) > 0 && !t.done; depth++ {
+		frontier, err = t.expand(ctx, frontier, depth)
+		if err != nil {
+			return nil, fmt.Errorf("expand depth %d: %w", depth+1, err)
+		}
+	}
+	return t.result, nil
+}
+
+type traversal struct {
+	db      *DB
+	params  dto.TraverseParams
+	router  *tenantRouter
+	cacher  *refcache.Cacher
+	result  *dto.TraverseResult
+	visited map[multi.Identifier]struct{}
+	// parents maps each node to the node it has been reached from first
+	parents map[multi.Identifier]multi.Identifier
+	// done is set once the target of a shortest path search has been reached
+	done bool
+}
+
+// traverseEdge is a reference found on a frontier node
+type traverseEdge struct {
+	from search.Result
+	prop string
+	to   search.Result
+}
+
+func (t *traversal) start(ctx context.Context) ([]search.Result, error) {
+	query := make([]multi.Identifier, len(t.params.StartIDs))
+	for i, id := range t.params.StartIDs {
+		query[i] = multi.Identifier{ID: id.String(), ClassName: t.params.ClassName}
+	}
+	res, err := t.router.MultiGet(ctx, query, additional.Properties{}, t.params.Tenant)
+	if err != nil {
+		return nil, fmt.Errorf("get start objects: %w", err)
+	}
+
+	var frontier []search.Result
+	for _, obj := range res {
+		if obj.ID == "" {
+			continue
+		}
+		si := identifier(obj)
+		if _, ok := t.visited[si]; ok {
+			continue
+		}
+		t.addNode(obj, 0)
+		frontier = append(frontier, obj)
+		if t.isTarget(obj) {
+			t.result.Path = []strfmt.UUID{obj.ID}
+			t.done = true
+			break
+		}
+	}
+	return frontier, nil
+}
+
+// expand follows the references of the frontier nodes at the given depth and
+// returns the nodes reached for the first time
+func (t *traversal) expand(ctx context.Context, frontier []search.Result, depth int) ([]search.Result, error) {
+	hop := t.params.Hop(depth)
+	objects, props := t.refsToFollow(frontier, hop)
+	if len(props) == 0 {
+		return nil, nil
+	}
+	if err := t.cacher.Build(ctx, objects, props, additional.Properties{}, nil); err != nil {
+		return nil, err
+	}
+
+	edges, err := t.resolveEdges(objects, props, hop)
+	if err != nil {
+		return nil, err
+	}
+	if hop.Filters != nil {
+		if edges, err = t.filterEdges(ctx, edges, hop.Filters); err != nil {
+			return nil, err
+		}
+	}
+
+	var next []search.Result
+	for _, e := range edges {
+		si := identifier(e.to)
+		if _, ok := t.visited[si]; !ok {
+			if t.params.MaxNodes > 0 && len(t.result.Nodes) >= t.params.MaxNodes {
+				t.result.Truncated = true
+				continue
+			}
+			t.addNode(e.to, depth+1)
+			t.parents[si] = identifier(e.from)
+			next = append(next, e.to)
+		}
+		t.result.Edges = append(t.result.Edges, dto.TraverseEdge{
+			FromClass: e.from.ClassName,
+			From:      e.from.ID,
+			Property:  e.prop,
+			ToClass:   e.to.ClassName,
+			To:        e.to.ID,
+		})
+		if t.isTarget(e.to) {
+			t.result.Path = t.path(si)
+			t.done = true
+			return nil, nil
+		}
+	}
+	return next, nil
+}
+
+// refsToFollow reduces the frontier nodes to the reference properties of the
+// hop, applying the fan-out limit. It returns the reduced objects along with
+// the select properties the refcache needs to resolve them.
+func (t *traversal) refsToFollow(frontier []search.Result, hop dto.TraverseHop) ([]search.Result, search.SelectProperties) {
+	var (
+		objects []search.Result
+		props   search.SelectProperties
+		targets = map[string]map[string]struct{}{}
+	)
+	for _, obj := range frontier {
+		class := t.db.schemaGetter.ReadOnlyClass(obj.ClassName)
+		schemaMap, ok := obj.Schema.(map[string]interface{})
+		if class == nil || !ok {
+			continue
+		}
+
+		refs := map[string]interface{}{}
+		for _, prop := range class.Properties {
+			if !schema.IsRefDataType(prop.DataType) || !hop.Follows(prop.Name) {
+				continue
+			}
+			mref, ok := schemaMap[prop.Name].(models.MultipleRef)
+			if !ok || len(mref) == 0 {
+				continue
+			}
+			if t.params.MaxFanOut > 0 && len(mref) > t.params.MaxFanOut {
+				mref = mref[:t.params.MaxFanOut]
+				t.result.Truncated = true
+			}
+			refs[prop.Name] = mref
+
+			if targets[prop.Name] == nil {
+				targets[prop.Name] = map[string]struct{}{}
+				props = append(props, search.SelectProperty{Name: prop.Name})
+			}
+			for _, target := range prop.DataType {
+				if hop.Reaches(target) {
+					targets[prop.Name][target] = struct{}{}
+				}
+			}
+		}
+		if len(refs) > 0 {
+			objects = append(objects, search.Result{ID: obj.ID, ClassName: obj.ClassName, Schema: refs})
+		}
+	}
+
+	for i := range props {
+		for target := range targets[props[i].Name] {
+			props[i].Refs = append(props[i].Refs, search.SelectClass{ClassName: target})
+		}
+	}
+	return objects, props
+}
+
+// resolveEdges looks up the targets of all references of objects in the refcache.
+// Dangling references are skipped.
+func (t *traversal) resolveEdges(objects []search.Result, props search.SelectProperties,
+	hop dto.TraverseHop,
+) ([]traverseEdge, error) {
+	var edges []traverseEdge
+	for _, obj := range objects {
+		schemaMap := obj.Schema.(map[string]interface{})
+		for _, prop := range props {
+			mref, ok := schemaMap[prop.Name].(models.MultipleRef)
+			if !ok {
+				continue
+			}
+			for _, item := range mref {
+				ref, err := crossref.Parse(item.Beacon.String())
+				if err != nil {
+					return nil, err
+				}
+				if ref.Class != "" && !hop.Reaches(ref.Class) {
+					continue
+				}
+				if target, ok := t.lookup(ref, prop); ok {
+					edges = append(edges, traverseEdge{from: obj, prop: prop.Name, to: target})
+				}
+			}
+		}
+	}
+	return edges, nil
+}
+
+func (t *traversal) lookup(ref *crossref.Ref, prop search.SelectProperty) (search.Result, bool) {
+	if ref.Class != "" {
+		return t.cacher.Get(multi.Identifier{ID: ref.TargetID.String(), ClassName: ref.Class})
+	}
+	// legacy beacons without class, try all targets of the property
+	for _, target := range prop.Refs {
+		res, ok := t.cacher.Get(multi.Identifier{ID: ref.TargetID.String(), ClassName: target.ClassName})
+		if ok {
+			return res, true
+		}
+	}
+	return search.Result{}, false
+}
+
+// filterEdges removes all edges whose target does not match the hop filter
+func (t *traversal) filterEdges(ctx context.Context, edges []traverseEdge,
+	filter *filters.LocalFilter,
+) ([]traverseEdge, error) {
+	ids := map[string][]string{}
+	for _, e := range edges {
+		ids[e.to.ClassName] = append(ids[e.to.ClassName], e.to.ID.String())
+	}
+
+	matches := map[multi.Identifier]struct{}{}
+	for className, classIDs := range ids {
+		res, err := t.db.Search(ctx, dto.GetParams{
+			ClassName:            className,
+			Filters:              restrictToIDs(className, classIDs, filter),
+			Pagination:           &filters.Pagination{Limit: len(classIDs)},
+			AdditionalProperties: additional.Properties{NoProps: true, ReferenceQuery: true},
+			Tenant:               t.router.tenant(className, t.params.Tenant),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("filter %s: %w", className, err)
+		}
+		for _, obj := range res {
+			matches[identifier(obj)] = struct{}{}
+		}
+	}
+
+	out := edges[:0]
+	for _, e := range edges {
+		if _, ok := matches[identifier(e.to)]; ok {
+			out = append(out, e)
+		}
+	}
+	return out, nil
+}
+
+func (t *traversal) addNode(obj search.Result, depth int) {
+	t.visited[identifier(obj)] = struct{}{}
+	props, _ := obj.Schema.(map[string]interface{})
+	t.result.Nodes = append(t.result.Nodes, dto.TraverseNode{
+		ClassName:  obj.ClassName,
+		ID:         obj.ID,
+		Depth:      depth,
+		Properties: props,
+	})
+}
+
+func (t *traversal) isTarget(obj search.Result) bool {
+	return t.params.TargetID != "" && obj.ID == t.params.TargetID
+}
+
+// path walks up the parents of si to the start node it has been reached from
+func (t *traversal) path(si multi.Identifier) []strfmt.UUID {
+	var reversed []strfmt.UUID
+	for {
+		reversed = append(reversed, strfmt.UUID(si.ID))
+		parent, ok := t.parents[si]
+		if !ok {
+			break
+		}
+		si = parent
+	}
+	path := make([]strfmt.UUID, len(reversed))
+	for i, id := range reversed {
+		path[len(reversed)-1-i] = id
+	}
+	return path
+}
+
+func identifier(obj search.Result) multi.Identifier {
+	return multi.Identifier{ID: obj.ID.String(), ClassName: obj.ClassName}
+}
+
+// restrictToIDs combines filter with a clause matching only the given ids
+func restrictToIDs(className string, ids []string, filter *filters.LocalFilter) *filters.LocalFilter {
+	return &filters.LocalFilter{Root: &filters.Clause{
+		Operator: filters.OperatorAnd,
+		Operands: []filters.Clause{
+			{
+				Operator: filters.ContainsAny,
+				On: &filters.Path{
+					Class:    schema.ClassName(className),
+					Property: filters.InternalPropID,
+				},
+				Value: &filters.Value{Value: ids, Type: schema.DataTypeText},
+			},
+			*filter.Root,
+		},
+	}}
+}
+
+// tenantRouter passes the tenant of a traversal only to multi-tenant
+// classes, so references from multi-tenant to shared classes can be followed
+type tenantRouter struct {
+	db *DB
+}
+
+func (r *tenantRouter) tenant(className, tenant string) string {
+	class := r.db.schemaGetter.ReadOnlyClass(className)
+	if class != nil && schema.MultiTenancyEnabled(class) {
+		return tenant
+	}
+	return ""
+}
+
+func (r *tenantRouter) MultiGet(ctx context.Context, query []multi.Identifier,
+	addl additional.Properties, tenant string,
+) ([]search.Result, error) {
+	byTenant := map[string][]int{}
+	for i, q := range query {
+		t := r.tenant(q.ClassName, tenant)
+		byTenant[t] = append(byTenant[t], i)
+	}
+
+	out := make([]search.Result, len(query))
+	for t, positions := range byTenant {
+		sub := make([]multi.Identifier, len(positions))
+		for i, pos := range positions {
+			sub[i] = query[pos]
+		}
+		res, err := r.db.MultiGet(ctx, sub, addl, t)
+		if err != nil {
+			return nil, err
+		}
+		for i, pos := range positions {
+			out[pos] = res[i]
+		}
+	}
+	return out, nil
+}
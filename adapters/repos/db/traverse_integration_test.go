@@ -0,0 +1,215 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/memwatch"
+)
+
+func TestTraverse(t *testing.T) {
+	const (
+		alice = strfmt.UUID("00000000-0000-0000-0000-00000000000a")
+		bob   = strfmt.UUID("00000000-0000-0000-0000-00000000000b")
+		carol = strfmt.UUID("00000000-0000-0000-0000-00000000000c")
+		dave  = strfmt.UUID("00000000-0000-0000-0000-00000000000d")
+		acme  = strfmt.UUID("00000000-0000-0000-0000-0000000000a1")
+		corp  = strfmt.UUID("00000000-0000-0000-0000-0000000000a2")
+	)
+
+	classes := []*models.Class{
+		{
+			Class:               "Person",
+			VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+			InvertedIndexConfig: invertedConfig(),
+			Properties: []*models.Property{
+				{Name: "name", DataType: schema.DataTypeText.PropString(), Tokenization: models.PropertyTokenizationWhitespace},
+				{Name: "knows", DataType: []string{"Person"}},
+				{Name: "worksAt", DataType: []string{"Company"}},
+			},
+		},
+		{
+			Class:               "Company",
+			VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+			InvertedIndexConfig: invertedConfig(),
+			Properties: []*models.Property{
+				{Name: "name", DataType: schema.DataTypeText.PropString(), Tokenization: models.PropertyTokenizationWhitespace},
+			},
+		},
+	}
+
+	logger := logrus.New()
+	schemaGetter := &fakeSchemaGetter{
+		schema:     schema.Schema{Objects: &models.Schema{Classes: nil}},
+		shardState: multiShardState(),
+	}
+	repo, err := New(logger, Config{
+		MemtablesFlushDirtyAfter:  60,
+		RootPath:                  t.TempDir(),
+		QueryMaximumResults:       10000,
+		MaxImportGoroutinesFactor: 1,
+	}, &fakeRemoteClient{}, &fakeNodeResolver{}, &fakeRemoteNodeClient{}, &fakeReplicationClient{}, nil, memwatch.NewDummyMonitor())
+	require.Nil(t, err)
+	repo.SetSchemaGetter(schemaGetter)
+	require.Nil(t, repo.WaitForStartup(testCtx()))
+	defer repo.Shutdown(context.Background())
+	migrator := NewMigrator(repo, logger)
+
+	for _, class := range classes {
+		require.Nil(t, migrator.AddClass(context.Background(), class, schemaGetter.shardState))
+	}
+	schemaGetter.schema = schema.Schema{Objects: &models.Schema{Classes: classes}}
+
+	refs := func(class string, ids ...strfmt.UUID) models.MultipleRef {
+		out := make(models.MultipleRef, len(ids))
+		for i, id := range ids {
+			out[i] = &models.SingleRef{Beacon: strfmt.URI("weaviate://localhost/" + class + "/" + id.String())}
+		}
+		return out
+	}
+	objects := []*models.Object{
+		{Class: "Company", ID: acme, Properties: map[string]interface{}{"name": "acme"}},
+		{Class: "Company", ID: corp, Properties: map[string]interface{}{"name": "corp"}},
+		{Class: "Person", ID: alice, Properties: map[string]interface{}{
+			"name": "alice", "knows": refs("Person", bob, dave), "worksAt": refs("Company", acme),
+		}},
+		{Class: "Person", ID: bob, Properties: map[string]interface{}{
+			"name": "bob", "knows": refs("Person", carol), "worksAt": refs("Company", corp),
+		}},
+		// carol closes the cycle alice -> bob -> carol -> alice
+		{Class: "Person", ID: carol, Properties: map[string]interface{}{
+			"name": "carol", "knows": refs("Person", alice),
+		}},
+		// dave references an object which does not exist
+		{Class: "Person", ID: dave, Properties: map[string]interface{}{
+			"name": "dave", "knows": refs("Person", "00000000-0000-0000-0000-0000000000ff"),
+		}},
+	}
+	for _, obj := range objects {
+		require.Nil(t, repo.PutObject(context.Background(), obj, []float32{1, 2, 3}, nil, nil, 0))
+	}
+
+	nodeIDs := func(res *dto.TraverseResult) map[strfmt.UUID]int {
+		out := map[strfmt.UUID]int{}
+		for _, n := range res.Nodes {
+			out[n.ID] = n.Depth
+		}
+		return out
+	}
+	edges := func(res *dto.TraverseResult) []string {
+		var out []string
+		for _, e := range res.Edges {
+			out = append(out, e.From.String()[34:]+"-"+e.Property+"->"+e.To.String()[34:])
+		}
+		sort.Strings(out)
+		return out
+	}
+
+	t.Run("single hop follows all references", func(t *testing.T) {
+		res, err := repo.Traverse(context.Background(), dto.TraverseParams{
+			ClassName: "Person", StartIDs: []strfmt.UUID{alice}, MaxDepth: 1,
+		})
+		require.Nil(t, err)
+		assert.Equal(t, map[strfmt.UUID]int{alice: 0, bob: 1, dave: 1, acme: 1}, nodeIDs(res))
+		assert.Equal(t, []string{"0a-knows->0b", "0a-knows->0d", "0a-worksAt->a1"}, edges(res))
+		assert.False(t, res.Truncated)
+		for _, n := range res.Nodes {
+			if n.ID == acme {
+				assert.Equal(t, "Company", n.ClassName)
+				assert.Equal(t, "acme", n.Properties["name"])
+			}
+		}
+	})
+
+	t.Run("cycles are linked but not expanded", func(t *testing.T) {
+		res, err := repo.Traverse(context.Background(), dto.TraverseParams{
+			ClassName: "Person", StartIDs: []strfmt.UUID{alice}, MaxDepth: 5,
+			Hops: []dto.TraverseHop{{Properties: []string{"knows"}}},
+		})
+		require.Nil(t, err)
+		assert.Equal(t, map[strfmt.UUID]int{alice: 0, bob: 1, dave: 1, carol: 2}, nodeIDs(res))
+		assert.Equal(t, []string{"0a-knows->0b", "0a-knows->0d", "0b-knows->0c", "0c-knows->0a"}, edges(res))
+	})
+
+	t.Run("per hop properties and filters", func(t *testing.T) {
+		worksAtAcme := dto.TraverseHop{
+			Properties: []string{"worksAt"},
+			ClassName:  "Company",
+			Filters: &filters.LocalFilter{Root: &filters.Clause{
+				Operator: filters.OperatorEqual,
+				On:       &filters.Path{Class: "Company", Property: "name"},
+				Value:    &filters.Value{Value: "acme", Type: schema.DataTypeText},
+			}},
+		}
+
+		res, err := repo.Traverse(context.Background(), dto.TraverseParams{
+			ClassName: "Person", StartIDs: []strfmt.UUID{alice, bob}, MaxDepth: 1,
+			Hops: []dto.TraverseHop{worksAtAcme},
+		})
+		require.Nil(t, err)
+		// bob works at corp, which is filtered out
+		assert.Equal(t, map[strfmt.UUID]int{alice: 0, bob: 0, acme: 1}, nodeIDs(res))
+		assert.Equal(t, []string{"0a-worksAt->a1"}, edges(res))
+
+		res, err = repo.Traverse(context.Background(), dto.TraverseParams{
+			ClassName: "Person", StartIDs: []strfmt.UUID{carol}, MaxDepth: 3,
+			Hops: []dto.TraverseHop{{Properties: []string{"knows"}}, {Properties: []string{"knows"}}, worksAtAcme},
+		})
+		require.Nil(t, err)
+		assert.Equal(t, map[strfmt.UUID]int{carol: 0, alice: 1, bob: 2, dave: 2}, nodeIDs(res))
+	})
+
+	t.Run("fan-out and node limits", func(t *testing.T) {
+		res, err := repo.Traverse(context.Background(), dto.TraverseParams{
+			ClassName: "Person", StartIDs: []strfmt.UUID{alice}, MaxDepth: 1, MaxFanOut: 1,
+			Hops: []dto.TraverseHop{{Properties: []string{"knows"}}},
+		})
+		require.Nil(t, err)
+		assert.Equal(t, map[strfmt.UUID]int{alice: 0, bob: 1}, nodeIDs(res))
+		assert.True(t, res.Truncated)
+
+		res, err = repo.Traverse(context.Background(), dto.TraverseParams{
+			ClassName: "Person", StartIDs: []strfmt.UUID{alice}, MaxDepth: 3, MaxNodes: 2,
+		})
+		require.Nil(t, err)
+		assert.Len(t, res.Nodes, 2)
+		assert.True(t, res.Truncated)
+	})
+
+	t.Run("shortest path", func(t *testing.T) {
+		res, err := repo.Traverse(context.Background(), dto.TraverseParams{
+			ClassName: "Person", StartIDs: []strfmt.UUID{alice}, MaxDepth: 5, TargetID: carol,
+		})
+		require.Nil(t, err)
+		assert.Equal(t, []strfmt.UUID{alice, bob, carol}, res.Path)
+
+		res, err = repo.Traverse(context.Background(), dto.TraverseParams{
+			ClassName: "Person", StartIDs: []strfmt.UUID{alice}, MaxDepth: 1, TargetID: carol,
+		})
+		require.Nil(t, err)
+		assert.Empty(t, res.Path)
+	})
+}
@@ -0,0 +1,74 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package common
+
+import "context"
+
+// FilterStrategy is the way a vector index applies an allow list during a
+// search
+type FilterStrategy string
+
+const (
+	// FilterStrategyFlat compares the query against every allowed vector
+	FilterStrategyFlat FilterStrategy = "flat"
+	// FilterStrategySweeping traverses the graph as if unfiltered, but only
+	// keeps allowed nodes as results
+	FilterStrategySweeping FilterStrategy = "sweeping"
+	// FilterStrategyAcorn only evaluates allowed nodes and expands the
+	// neighborhood of the graph to reach them
+	FilterStrategyAcorn FilterStrategy = "acorn"
+	// FilterStrategyPostFilter runs an unfiltered, oversampled search and drops
+	// the results which are not allowed afterwards
+	FilterStrategyPostFilter FilterStrategy = "postFilter"
+)
+
+// PostFilterOversampling is the factor by which a post-filtered search asks
+// for more results than the filter is expected to let through, as filters
+// often correlate with the query
+const PostFilterOversampling = 1.5
+
+type filterStrategyKey struct{}
+
+// ContextWithFilterStrategy returns a context which asks the vector index to
+// apply the allow list of a search with the given strategy instead of the
+// one configured for the index
+func ContextWithFilterStrategy(ctx context.Context, strategy FilterStrategy) context.Context {
+	return context.WithValue(ctx, filterStrategyKey{}, strategy)
+}
+
+// FilterStrategyFromContext returns the strategy set with
+// ContextWithFilterStrategy, if any
+func FilterStrategyFromContext(ctx context.Context) (FilterStrategy, bool) {
+	strategy, ok := ctx.Value(filterStrategyKey{}).(FilterStrategy)
+	return strategy, ok
+}
+
+// FilterSearchStats describes a vector index to a query planner which picks
+// the filter strategy of a single search
+type FilterSearchStats struct {
+	// IndexSize is the number of vectors in the index
+	IndexSize int
+	// EF is the size of the search queue the index uses for the requested k
+	EF int
+	// MaxConnections is the maximum number of neighbors on the base layer
+	MaxConnections int
+	// FlatSearchCutoff is the allow list size below which the index would
+	// search flat by itself
+	FlatSearchCutoff int
+	// ForbidFlat is set if the index can not be searched flat
+	ForbidFlat bool
+	// AcornMaxSelectivity is the share of allowed vectors above which the
+	// index does not use ACORN
+	AcornMaxSelectivity float64
+	// Strategy is the filter strategy configured for the index
+	Strategy string
+}
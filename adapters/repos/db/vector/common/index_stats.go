@@ -18,6 +18,8 @@ const (
 	IndexTypeFlat    = "flat"
 	IndexTypeNoop    = "noop"
 	IndexTypeDynamic = "dynamic"
+	IndexTypeIVF     = "ivf"
+	IndexTypeVamana  = "vamana"
 )
 
 type IndexStats interface {
@@ -0,0 +1,83 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package common
+
+import (
+	"math"
+	"sort"
+)
+
+// SimilarityFunc scores two vectors, a higher score means more similar
+type SimilarityFunc func(x, y []float32) float32
+
+// MeanVector returns the mean of the given vectors, it is the single vector
+// representation of a multi vector document used for mean pooling
+func MeanVector(vectors [][]float32) []float32 {
+	if len(vectors) == 0 {
+		return nil
+	}
+
+	mean := make([]float32, len(vectors[0]))
+	for _, vec := range vectors {
+		for i := range mean {
+			mean[i] += vec[i]
+		}
+	}
+	for i := range mean {
+		mean[i] /= float32(len(vectors))
+	}
+	return mean
+}
+
+// MaxSims returns the highest similarity of each query vector to any of the
+// document vectors
+func MaxSims(similarity SimilarityFunc, query, doc [][]float32) []float32 {
+	maxSims := make([]float32, len(query))
+	for i, queryVec := range query {
+		maxSim := float32(-math.MaxFloat32)
+		for _, docVec := range doc {
+			if sim := similarity(queryVec, docVec); sim > maxSim {
+				maxSim = sim
+			}
+		}
+		maxSims[i] = maxSim
+	}
+	return maxSims
+}
+
+// MaxSimScore is the late interaction score of a document, the sum of the
+// highest similarity of each query vector to any of the document vectors
+func MaxSimScore(similarity SimilarityFunc, query, doc [][]float32) float32 {
+	var score float32
+	for _, maxSim := range MaxSims(similarity, query, doc) {
+		score += maxSim
+	}
+	return score
+}
+
+// TopKSum sums the k highest of the given similarities. The similarities are
+// sorted in place.
+func TopKSum(similarities []float32, k int) float32 {
+	sort.Slice(similarities, func(i, j int) bool { return similarities[i] > similarities[j] })
+
+	var sum float32
+	for i := 0; i < k && i < len(similarities); i++ {
+		sum += similarities[i]
+	}
+	return sum
+}
+
+// TopKSumScore only counts the k query vectors that match the document best,
+// so a document is not penalized for query vectors it has no match for
+func TopKSumScore(similarity SimilarityFunc, query, doc [][]float32, k int) float32 {
+	return TopKSum(MaxSims(similarity, query, doc), k)
+}
@@ -0,0 +1,51 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func dot(x, y []float32) float32 {
+	var sum float32
+	for i := range x {
+		sum += x[i] * y[i]
+	}
+	return sum
+}
+
+func TestMultivectorScores(t *testing.T) {
+	query := [][]float32{{1, 0}, {0, 1}, {1, 1}}
+	doc := [][]float32{{2, 0}, {0, 1}}
+
+	t.Run("mean vector", func(t *testing.T) {
+		assert.Equal(t, []float32{1, 0.5}, MeanVector(doc))
+		assert.Nil(t, MeanVector(nil))
+	})
+
+	t.Run("max sims", func(t *testing.T) {
+		assert.Equal(t, []float32{2, 1, 2}, MaxSims(dot, query, doc))
+	})
+
+	t.Run("max sim score", func(t *testing.T) {
+		assert.Equal(t, float32(5), MaxSimScore(dot, query, doc))
+	})
+
+	t.Run("top k sum score", func(t *testing.T) {
+		assert.Equal(t, float32(2), TopKSumScore(dot, query, doc, 1))
+		assert.Equal(t, float32(4), TopKSumScore(dot, query, doc, 2))
+		// k larger than the number of query vectors is the same as max sim
+		assert.Equal(t, float32(5), TopKSumScore(dot, query, doc, 10))
+	})
+}
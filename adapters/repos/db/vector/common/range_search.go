@@ -0,0 +1,103 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package common
+
+import (
+	"container/heap"
+	"sort"
+)
+
+// RangeSearchFunc streams every id within the distance threshold of a range
+// search to fn, in no particular order, until fn returns false
+type RangeSearchFunc func(fn func(id uint64, dist float32) bool) error
+
+// RangeTieBreak reports whether the result with id a is ordered before the
+// result with id b, if both have the same distance to the query
+type RangeTieBreak func(a, b uint64) bool
+
+// RangeSearch returns the up to limit results of a range search which are
+// closest to the query, ordered by distance and then by tieBreak. A nil
+// tieBreak orders results with the same distance by id. A limit of zero or
+// less returns all results. Only the returned results are held in memory, no
+// matter how many vectors are within range.
+//
+// truncated reports whether results beyond the limit have been dropped.
+func RangeSearch(search RangeSearchFunc, limit int, tieBreak RangeTieBreak,
+) (ids []uint64, dists []float32, truncated bool, err error) {
+	if tieBreak == nil {
+		tieBreak = func(a, b uint64) bool { return a < b }
+	}
+	page := &rangeHeap{tieBreak: tieBreak}
+	err = search(func(id uint64, dist float32) bool {
+		if limit <= 0 || page.Len() < limit {
+			heap.Push(page, rangeResult{id: id, dist: dist})
+			return true
+		}
+		truncated = true
+		if page.after(page.results[0], rangeResult{id: id, dist: dist}) {
+			page.results[0] = rangeResult{id: id, dist: dist}
+			heap.Fix(page, 0)
+		}
+		return true
+	})
+	if err != nil {
+		return nil, nil, false, err
+	}
+
+	sort.Slice(page.results, func(a, b int) bool {
+		return page.after(page.results[b], page.results[a])
+	})
+	ids = make([]uint64, len(page.results))
+	dists = make([]float32, len(page.results))
+	for i, res := range page.results {
+		ids[i] = res.id
+		dists[i] = res.dist
+	}
+	return ids, dists, truncated, nil
+}
+
+type rangeResult struct {
+	id   uint64
+	dist float32
+}
+
+// rangeHeap is a max heap, the result ordered last is on top
+type rangeHeap struct {
+	results  []rangeResult
+	tieBreak RangeTieBreak
+}
+
+// after reports whether result a is ordered behind result b
+func (h *rangeHeap) after(a, b rangeResult) bool {
+	if a.dist != b.dist {
+		return a.dist > b.dist
+	}
+	return a.id != b.id && h.tieBreak(b.id, a.id)
+}
+
+func (h *rangeHeap) Len() int { return len(h.results) }
+
+func (h *rangeHeap) Less(a, b int) bool {
+	return h.after(h.results[a], h.results[b])
+}
+
+func (h *rangeHeap) Swap(a, b int) {
+	h.results[a], h.results[b] = h.results[b], h.results[a]
+}
+
+func (h *rangeHeap) Push(x any) { h.results = append(h.results, x.(rangeResult)) }
+
+func (h *rangeHeap) Pop() any {
+	last := h.results[len(h.results)-1]
+	h.results = h.results[:len(h.results)-1]
+	return last
+}
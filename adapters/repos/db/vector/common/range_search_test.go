@@ -0,0 +1,115 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package common
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRangeSearch(t *testing.T) {
+	r := rand.New(rand.NewSource(3))
+	size := 1000
+	ids := r.Perm(size)
+	dists := make([]float32, size)
+	for i := range dists {
+		// few distinct distances, so many results share a distance
+		dists[i] = float32(r.Intn(50)) / 100
+	}
+
+	search := func(fn func(id uint64, dist float32) bool) error {
+		for i := range ids {
+			if !fn(uint64(ids[i]), dists[i]) {
+				return nil
+			}
+		}
+		return nil
+	}
+
+	type result struct {
+		id   uint64
+		dist float32
+	}
+	expected := make([]result, size)
+	for i := range ids {
+		expected[i] = result{id: uint64(ids[i]), dist: dists[i]}
+	}
+	sort.Slice(expected, func(a, b int) bool {
+		if expected[a].dist != expected[b].dist {
+			return expected[a].dist < expected[b].dist
+		}
+		return expected[a].id < expected[b].id
+	})
+
+	t.Run("all results", func(t *testing.T) {
+		gotIDs, gotDists, truncated, err := RangeSearch(search, 0, nil)
+		require.Nil(t, err)
+		assert.False(t, truncated)
+		require.Len(t, gotIDs, size)
+		for i := range expected {
+			assert.Equal(t, expected[i].id, gotIDs[i])
+			assert.Equal(t, expected[i].dist, gotDists[i])
+		}
+	})
+
+	t.Run("limited", func(t *testing.T) {
+		gotIDs, gotDists, truncated, err := RangeSearch(search, 7, nil)
+		require.Nil(t, err)
+		assert.True(t, truncated)
+		require.Len(t, gotIDs, 7)
+		for i := range gotIDs {
+			assert.Equal(t, expected[i].id, gotIDs[i])
+			assert.Equal(t, expected[i].dist, gotDists[i])
+		}
+	})
+
+	t.Run("limit matches the number of results", func(t *testing.T) {
+		gotIDs, _, truncated, err := RangeSearch(search, size, nil)
+		require.Nil(t, err)
+		assert.False(t, truncated)
+		assert.Len(t, gotIDs, size)
+	})
+
+	t.Run("limited with a tie break", func(t *testing.T) {
+		// reversed id order within the same distance
+		byIDDesc := func(a, b uint64) bool { return a > b }
+		gotIDs, gotDists, truncated, err := RangeSearch(search, 50, byIDDesc)
+		require.Nil(t, err)
+		assert.True(t, truncated)
+
+		reversed := make([]result, len(expected))
+		copy(reversed, expected)
+		sort.Slice(reversed, func(a, b int) bool {
+			if reversed[a].dist != reversed[b].dist {
+				return reversed[a].dist < reversed[b].dist
+			}
+			return reversed[a].id > reversed[b].id
+		})
+		require.Len(t, gotIDs, 50)
+		for i := range gotIDs {
+			assert.Equal(t, reversed[i].id, gotIDs[i])
+			assert.Equal(t, reversed[i].dist, gotDists[i])
+		}
+	})
+
+	t.Run("no results", func(t *testing.T) {
+		none := func(fn func(id uint64, dist float32) bool) error { return nil }
+		gotIDs, _, truncated, err := RangeSearch(none, 10, nil)
+		require.Nil(t, err)
+		assert.Empty(t, gotIDs)
+		assert.False(t, truncated)
+	})
+}
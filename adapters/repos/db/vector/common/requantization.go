@@ -0,0 +1,31 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package common
+
+// RequantizationStatus is the progress of an index switching its compressed
+// vectors to a new quantizer
+type RequantizationStatus struct {
+	// Compression is the compression the vectors are switched to
+	Compression string
+	// Processed is the number of vectors that are already encoded
+	Processed uint64
+	// Total is the number of vectors in the index when the switch started
+	Total uint64
+}
+
+// Progress returns the share of the vectors that are already encoded
+func (s RequantizationStatus) Progress() float64 {
+	if s.Total == 0 {
+		return 0
+	}
+	return min(float64(s.Processed)/float64(s.Total), 1)
+}
@@ -11,6 +11,8 @@
 
 package common
 
+import "fmt"
+
 func VectorsEqual(vecA, vecB []float32) bool {
 	if len(vecA) != len(vecB) {
 		return false
@@ -41,3 +43,17 @@ func CalculateOptimalSegments(dims int) int {
 	}
 	return dims
 }
+
+// TruncateVector returns the leading dims dimensions of the vector, which is
+// what an index with a matryoshka config holds. A dims of 0 returns the
+// vector as is.
+func TruncateVector(vector []float32, dims int) ([]float32, error) {
+	if dims <= 0 {
+		return vector, nil
+	}
+	if len(vector) < dims {
+		return nil, fmt.Errorf("vector has %d dimensions, but the index holds the first %d",
+			len(vector), dims)
+	}
+	return vector[:dims:dims], nil
+}
@@ -16,6 +16,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestVectorUtil_Equal(t *testing.T) {
@@ -132,3 +133,25 @@ func Test_CalculateOptimalSegments(t *testing.T) {
 		assert.Equal(t, tc.expectedSegments, segments)
 	}
 }
+
+func TestTruncateVector(t *testing.T) {
+	vector := []float32{1, 2, 3, 4}
+
+	t.Run("no truncation", func(t *testing.T) {
+		truncated, err := TruncateVector(vector, 0)
+		require.Nil(t, err)
+		assert.Equal(t, vector, truncated)
+	})
+
+	t.Run("leading dimensions", func(t *testing.T) {
+		truncated, err := TruncateVector(vector, 2)
+		require.Nil(t, err)
+		assert.Equal(t, []float32{1, 2}, truncated)
+		assert.Equal(t, 2, cap(truncated))
+	})
+
+	t.Run("vector too short", func(t *testing.T) {
+		_, err := TruncateVector(vector, 5)
+		assert.NotNil(t, err)
+	})
+}
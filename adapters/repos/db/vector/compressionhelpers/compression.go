@@ -15,6 +15,8 @@ import (
 	"context"
 	"encoding/binary"
 	"fmt"
+	"math"
+	"math/rand"
 	"runtime"
 	"time"
 
@@ -34,11 +36,20 @@ type CompressorDistancer interface {
 	DistanceToFloat(vec []float32) (float32, error)
 }
 
+// BoundedCompressorDistancer is implemented by compressor distancers which
+// also bound the error of their estimated distances. The true distance lies
+// within the bound with high probability.
+type BoundedCompressorDistancer interface {
+	DistanceToNodeWithBound(id uint64) (float32, float32, error)
+}
+
 type ReturnDistancerFn func()
 
 type CommitLogger interface {
 	AddPQCompression(PQData) error
 	AddSQCompression(SQData) error
+	AddRQCompression(RQData) error
+	AddBQCompression() error
 }
 
 type VectorCompressor interface {
@@ -61,11 +72,16 @@ type VectorCompressor interface {
 	NewBag() CompressionDistanceBag
 
 	PersistCompression(CommitLogger)
+
+	// UseBucket switches the bucket the compressed vectors are stored in, the
+	// bucket is created if it does not exist yet
+	UseBucket(name string) error
 }
 
 type quantizedVectorsCompressor[T byte | uint64] struct {
 	cache           cache.Cache[T]
 	compressedStore *lsmkv.Store
+	bucketName      string
 	quantizer       quantizer[T]
 	storeId         func([]byte, uint64)
 	loadId          func([]byte) uint64
@@ -101,7 +117,7 @@ func (compressor *quantizedVectorsCompressor[T]) Delete(ctx context.Context, id
 	compressor.cache.Delete(ctx, id)
 	idBytes := make([]byte, 8)
 	compressor.storeId(idBytes, id)
-	if err := compressor.compressedStore.Bucket(helpers.VectorsCompressedBucketLSM).Delete(idBytes); err != nil {
+	if err := compressor.compressedStore.Bucket(compressor.bucketName).Delete(idBytes); err != nil {
 		compressor.logger.WithFields(logrus.Fields{
 			"action": "compressor_delete",
 			"id":     id,
@@ -114,7 +130,7 @@ func (compressor *quantizedVectorsCompressor[T]) Preload(id uint64, vector []flo
 	compressedVector := compressor.quantizer.Encode(vector)
 	idBytes := make([]byte, 8)
 	compressor.storeId(idBytes, id)
-	compressor.compressedStore.Bucket(helpers.VectorsCompressedBucketLSM).Put(idBytes, compressor.quantizer.CompressedBytes(compressedVector))
+	compressor.compressedStore.Bucket(compressor.bucketName).Put(idBytes, compressor.quantizer.CompressedBytes(compressedVector))
 	compressor.cache.Grow(id)
 	compressor.cache.Preload(id, compressedVector)
 }
@@ -127,7 +143,7 @@ func (compressor *quantizedVectorsCompressor[T]) PreloadMulti(docID uint64, ids
 	for i, id := range ids {
 		idBytes := make([]byte, 8)
 		compressor.storeId(idBytes, id)
-		compressor.compressedStore.Bucket(helpers.VectorsCompressedBucketLSM).Put(idBytes, compressor.quantizer.CompressedBytes(compressedVectors[i]))
+		compressor.compressedStore.Bucket(compressor.bucketName).Put(idBytes, compressor.quantizer.CompressedBytes(compressedVectors[i]))
 		compressor.cache.Grow(id)
 	}
 	compressor.cache.PreloadMulti(docID, ids, compressedVectors)
@@ -174,7 +190,7 @@ func (compressor *quantizedVectorsCompressor[T]) DistanceBetweenCompressedVector
 func (compressor *quantizedVectorsCompressor[T]) getCompressedVectorForID(ctx context.Context, id uint64) ([]T, error) {
 	idBytes := make([]byte, 8)
 	compressor.storeId(idBytes, id)
-	compressedVector, err := compressor.compressedStore.Bucket(helpers.VectorsCompressedBucketLSM).Get(idBytes)
+	compressedVector, err := compressor.compressedStore.Bucket(compressor.bucketName).Get(idBytes)
 	if err != nil {
 		return nil, errors.Wrap(err, "Getting vector for id")
 	}
@@ -228,10 +244,15 @@ func (compressor *quantizedVectorsCompressor[T]) NewBag() CompressionDistanceBag
 }
 
 func (compressor *quantizedVectorsCompressor[T]) initCompressedStore() error {
-	err := compressor.compressedStore.CreateOrLoadBucket(context.Background(), helpers.VectorsCompressedBucketLSM)
+	return compressor.UseBucket(helpers.VectorsCompressedBucketLSM)
+}
+
+func (compressor *quantizedVectorsCompressor[T]) UseBucket(name string) error {
+	err := compressor.compressedStore.CreateOrLoadBucket(context.Background(), name)
 	if err != nil {
 		return errors.Wrapf(err, "Create or load bucket (compressed vectors store)")
 	}
+	compressor.bucketName = name
 	return nil
 }
 
@@ -250,7 +271,7 @@ func (compressor *quantizedVectorsCompressor[T]) PrefillCache() {
 	vecs := make([]VecAndID[T], 0, 10_000)
 
 	it := NewParallelIterator(
-		compressor.compressedStore.Bucket(helpers.VectorsCompressedBucketLSM),
+		compressor.compressedStore.Bucket(compressor.bucketName),
 		parallel, compressor.loadId, compressor.quantizer.FromCompressedBytesWithSubsliceBuffer,
 		compressor.logger)
 	channel := it.IterateAll()
@@ -443,6 +464,46 @@ func RestoreHNSWSQCompressor(
 	return sqVectorsCompressor, nil
 }
 
+func NewHNSWRQCompressor(
+	distance distancer.Provider,
+	dimensions int,
+	bits int,
+	vectorCacheMaxObjects int,
+	logger logrus.FieldLogger,
+	store *lsmkv.Store,
+	allocChecker memwatch.AllocChecker,
+) (VectorCompressor, error) {
+	return RestoreHNSWRQCompressor(distance, vectorCacheMaxObjects, logger,
+		RQData{Dimensions: uint16(dimensions), Bits: uint8(bits), Seed: rand.Uint64()},
+		store, allocChecker)
+}
+
+func RestoreHNSWRQCompressor(
+	distance distancer.Provider,
+	vectorCacheMaxObjects int,
+	logger logrus.FieldLogger,
+	data RQData,
+	store *lsmkv.Store,
+	allocChecker memwatch.AllocChecker,
+) (VectorCompressor, error) {
+	quantizer, err := RestoreRotationalQuantizer(data, distance)
+	if err != nil {
+		return nil, err
+	}
+	rqVectorsCompressor := &quantizedVectorsCompressor[byte]{
+		quantizer:       quantizer,
+		compressedStore: store,
+		storeId:         binary.BigEndian.PutUint64,
+		loadId:          binary.BigEndian.Uint64,
+		logger:          logger,
+	}
+	rqVectorsCompressor.initCompressedStore()
+	rqVectorsCompressor.cache = cache.NewShardedByteLockCache(
+		rqVectorsCompressor.getCompressedVectorForID, vectorCacheMaxObjects, 1, logger,
+		0, allocChecker)
+	return rqVectorsCompressor, nil
+}
+
 type quantizedCompressorDistancer[T byte | uint64] struct {
 	compressor *quantizedVectorsCompressor[T]
 	distancer  quantizerDistancer[T]
@@ -460,6 +521,29 @@ func (distancer *quantizedCompressorDistancer[T]) DistanceToNode(id uint64) (flo
 	return distancer.distancer.Distance(compressedVector)
 }
 
+// DistanceToNodeWithBound estimates the distance to the node along with a
+// bound of its error. The error is not bounded if the quantizer does not
+// bound it.
+func (distancer *quantizedCompressorDistancer[T]) DistanceToNodeWithBound(id uint64) (float32, float32, error) {
+	bounded, ok := distancer.distancer.(interface {
+		DistanceWithBound(x []T) (float32, float32, error)
+	})
+	if !ok {
+		dist, err := distancer.DistanceToNode(id)
+		return dist, math.MaxFloat32, err
+	}
+
+	compressedVector, err := distancer.compressor.cache.Get(context.Background(), id)
+	if err != nil {
+		return 0, 0, err
+	}
+	if len(compressedVector) == 0 {
+		return 0, 0, fmt.Errorf(
+			"got a nil or zero-length vector at docID %d", id)
+	}
+	return bounded.DistanceWithBound(compressedVector)
+}
+
 func (distancer *quantizedCompressorDistancer[T]) DistanceToFloat(vector []float32) (float32, error) {
 	return distancer.distancer.DistanceToFloat(vector)
 }
@@ -36,6 +36,7 @@ type quantizer[T byte | uint64] interface {
 }
 
 func (bq *BinaryQuantizer) PersistCompression(logger CommitLogger) {
+	logger.AddBQCompression()
 }
 
 func (pq *ProductQuantizer) NewQuantizerDistancer(vec []float32) quantizerDistancer[byte] {
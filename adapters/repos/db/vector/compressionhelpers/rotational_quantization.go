@@ -0,0 +1,434 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package compressionhelpers
+
+import (
+	"encoding/binary"
+	"math"
+	"math/rand"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+)
+
+const (
+	rqMinBits = 1
+	rqMaxBits = 4
+
+	// the rotation mixes blocks of this many dimensions at once, the rotated
+	// vectors are padded with zeros to a multiple of it
+	rqBlockSize = 64
+	rqRounds    = 3
+
+	// after the rotation the quantization error is spread evenly across all
+	// dimensions, so its inner product with a query is a sum of many small
+	// independent terms. The error bound is this many standard deviations of
+	// that sum.
+	rqErrorBoundDeviations = 3
+
+	// every code ends with lower, step, sum of codes, squared norm and
+	// residual norm of the vector
+	rqFooterSize = 5 * 4
+)
+
+// RotationalQuantizer applies a random orthogonal rotation to the vectors
+// before it encodes every dimension with 1 to 4 bits. The rotation makes the
+// dimensions roughly equally important, so a single range per vector is
+// enough and no training data is needed. Only the seed of the rotation has to
+// be persisted to restore the quantizer.
+type RotationalQuantizer struct {
+	distancer  distancer.Provider
+	dimensions int
+	bits       int
+	seed       uint64
+	rotation   *fastRotation
+}
+
+type RQData struct {
+	Dimensions uint16
+	Bits       uint8
+	Seed       uint64
+}
+
+func NewRotationalQuantizer(dimensions, bits int, seed uint64, distance distancer.Provider) (*RotationalQuantizer, error) {
+	if dimensions <= 0 {
+		return nil, errors.Errorf("dimensions must be positive, got %d", dimensions)
+	}
+	if bits < rqMinBits || bits > rqMaxBits {
+		return nil, errors.Errorf("bits must be between %d and %d, got %d", rqMinBits, rqMaxBits, bits)
+	}
+	switch distance.Type() {
+	case "l2-squared", "dot", "cosine-dot":
+	default:
+		return nil, errors.Errorf("distance %q is not supported by rotational quantization", distance.Type())
+	}
+
+	return &RotationalQuantizer{
+		distancer:  distance,
+		dimensions: dimensions,
+		bits:       bits,
+		seed:       seed,
+		rotation:   newFastRotation(dimensions, seed),
+	}, nil
+}
+
+func RestoreRotationalQuantizer(data RQData, distance distancer.Provider) (*RotationalQuantizer, error) {
+	return NewRotationalQuantizer(int(data.Dimensions), int(data.Bits), data.Seed, distance)
+}
+
+func (rq *RotationalQuantizer) Encode(vec []float32) []byte {
+	x := rq.rotation.rotate(vec)
+	n := len(x)
+	levels := (1 << rq.bits) - 1
+
+	lo, hi := x[0], x[0]
+	var sum float64
+	for _, v := range x {
+		lo = min(lo, v)
+		hi = max(hi, v)
+		sum += float64(v)
+	}
+
+	codes := make([]byte, n)
+	if rq.bits == 1 {
+		mean := float32(sum / float64(n))
+		for i, v := range x {
+			if v > mean {
+				codes[i] = 1
+			}
+		}
+	} else if hi > lo {
+		scale := float32(levels) / (hi - lo)
+		for i, v := range x {
+			codes[i] = byte(math.Round(float64((v - lo) * scale)))
+		}
+	}
+
+	// the reconstruction lower+step*code is fitted by least squares instead of
+	// being derived from the grid, this matters most for few bits
+	var sumCodes, sumCodes2, sumCodesX, sumX2 float64
+	for i, v := range x {
+		c := float64(codes[i])
+		sumCodes += c
+		sumCodes2 += c * c
+		sumCodesX += c * float64(v)
+		sumX2 += float64(v) * float64(v)
+	}
+	var lower, step float64
+	if den := float64(n)*sumCodes2 - sumCodes*sumCodes; den != 0 {
+		step = (float64(n)*sumCodesX - sumCodes*sum) / den
+		lower = (sum - step*sumCodes) / float64(n)
+	} else {
+		lower = sum / float64(n)
+	}
+
+	var residual float64
+	for i, v := range x {
+		diff := float64(v) - lower - step*float64(codes[i])
+		residual += diff * diff
+	}
+
+	out := make([]byte, rq.codeSize()+rqFooterSize)
+	for i, c := range codes {
+		rq.setCode(out, i, c)
+	}
+	footer := out[rq.codeSize():]
+	binary.LittleEndian.PutUint32(footer[0:], math.Float32bits(float32(lower)))
+	binary.LittleEndian.PutUint32(footer[4:], math.Float32bits(float32(step)))
+	binary.LittleEndian.PutUint32(footer[8:], math.Float32bits(float32(sumCodes)))
+	binary.LittleEndian.PutUint32(footer[12:], math.Float32bits(float32(sumX2)))
+	binary.LittleEndian.PutUint32(footer[16:], math.Float32bits(float32(math.Sqrt(residual))))
+	return out
+}
+
+func (rq *RotationalQuantizer) DistanceBetweenCompressedVectors(x, y []byte) (float32, error) {
+	if len(x) != len(y) {
+		return 0, errors.Errorf("vector lengths don't match: %d vs %d",
+			len(x), len(y))
+	}
+	fx, fy := rq.footer(x), rq.footer(y)
+
+	var codesDot uint32
+	for i := 0; i < rq.rotation.outputDim; i++ {
+		codesDot += uint32(rq.code(x, i)) * uint32(rq.code(y, i))
+	}
+	dot := float32(rq.rotation.outputDim)*fx.lower*fy.lower +
+		fx.lower*fy.step*fy.sumCodes + fy.lower*fx.step*fx.sumCodes +
+		fx.step*fy.step*float32(codesDot)
+	return rq.distanceFromDot(dot, fx.squaredNorm, fy.squaredNorm)
+}
+
+func (rq *RotationalQuantizer) distanceFromDot(dot, squaredNormX, squaredNormY float32) (float32, error) {
+	switch rq.distancer.Type() {
+	case "l2-squared":
+		return max(0, squaredNormX+squaredNormY-2*dot), nil
+	case "dot":
+		return -dot, nil
+	case "cosine-dot":
+		return 1 - dot, nil
+	}
+	return 0, errors.Errorf("Distance not supported yet %s", rq.distancer)
+}
+
+// errorBound turns a bound of the error of the estimated inner product into a
+// bound of the error of the estimated distance
+func (rq *RotationalQuantizer) errorBound(dotBound float32) float32 {
+	if rq.distancer.Type() == "l2-squared" {
+		return 2 * dotBound
+	}
+	return dotBound
+}
+
+func (rq *RotationalQuantizer) codeSize() int {
+	return (rq.rotation.outputDim*rq.bits + 7) / 8
+}
+
+func (rq *RotationalQuantizer) code(packed []byte, i int) byte {
+	bit := i * rq.bits
+	pos, offset := bit>>3, bit&7
+	v := uint16(packed[pos]) >> offset
+	if offset+rq.bits > 8 {
+		v |= uint16(packed[pos+1]) << (8 - offset)
+	}
+	return byte(v) & (1<<rq.bits - 1)
+}
+
+func (rq *RotationalQuantizer) setCode(packed []byte, i int, code byte) {
+	bit := i * rq.bits
+	pos, offset := bit>>3, bit&7
+	v := uint16(code) << offset
+	packed[pos] |= byte(v)
+	if offset+rq.bits > 8 {
+		packed[pos+1] |= byte(v >> 8)
+	}
+}
+
+type rqFooter struct {
+	lower        float32
+	step         float32
+	sumCodes     float32
+	squaredNorm  float32
+	residualNorm float32
+}
+
+func (rq *RotationalQuantizer) footer(code []byte) rqFooter {
+	footer := code[len(code)-rqFooterSize:]
+	return rqFooter{
+		lower:        math.Float32frombits(binary.LittleEndian.Uint32(footer[0:])),
+		step:         math.Float32frombits(binary.LittleEndian.Uint32(footer[4:])),
+		sumCodes:     math.Float32frombits(binary.LittleEndian.Uint32(footer[8:])),
+		squaredNorm:  math.Float32frombits(binary.LittleEndian.Uint32(footer[12:])),
+		residualNorm: math.Float32frombits(binary.LittleEndian.Uint32(footer[16:])),
+	}
+}
+
+func (rq *RotationalQuantizer) FromCompressedBytesWithSubsliceBuffer(compressed []byte, buffer *[]byte) []byte {
+	if len(*buffer) < len(compressed) {
+		*buffer = make([]byte, len(compressed)*1000)
+	}
+
+	// take from end so we can address the start of the buffer
+	out := (*buffer)[len(*buffer)-len(compressed):]
+	copy(out, compressed)
+	*buffer = (*buffer)[:len(*buffer)-len(compressed)]
+
+	return out
+}
+
+func (rq *RotationalQuantizer) CompressedBytes(compressed []byte) []byte {
+	return compressed
+}
+
+func (rq *RotationalQuantizer) FromCompressedBytes(compressed []byte) []byte {
+	return compressed
+}
+
+func (rq *RotationalQuantizer) PersistCompression(logger CommitLogger) {
+	logger.AddRQCompression(RQData{
+		Dimensions: uint16(rq.dimensions),
+		Bits:       uint8(rq.bits),
+		Seed:       rq.seed,
+	})
+}
+
+// RQDistancer estimates the distance between an uncompressed query and
+// compressed vectors. Only the stored vectors are quantized, the rotated
+// query is kept at full precision.
+type RQDistancer struct {
+	x          []float32
+	rq         *RotationalQuantizer
+	query      []float32
+	sum        float32
+	norm       float32
+	compressed []byte
+}
+
+func (rq *RotationalQuantizer) NewDistancer(a []float32) *RQDistancer {
+	query := rq.rotation.rotate(a)
+	var sum, squaredNorm float32
+	for _, v := range query {
+		sum += v
+		squaredNorm += v * v
+	}
+	return &RQDistancer{
+		x:     a,
+		rq:    rq,
+		query: query,
+		sum:   sum,
+		norm:  float32(math.Sqrt(float64(squaredNorm))),
+	}
+}
+
+func (d *RQDistancer) Distance(x []byte) (float32, error) {
+	dist, _, err := d.DistanceWithBound(x)
+	return dist, err
+}
+
+// DistanceWithBound returns the estimated distance along with a bound of its
+// error. The true distance lies within the bound with high probability. The
+// error is not bounded if the query is compressed as well.
+func (d *RQDistancer) DistanceWithBound(x []byte) (float32, float32, error) {
+	if d.compressed != nil {
+		dist, err := d.rq.DistanceBetweenCompressedVectors(d.compressed, x)
+		return dist, math.MaxFloat32, err
+	}
+	if len(x) != d.rq.codeSize()+rqFooterSize {
+		return 0, 0, errors.Errorf("vector lengths don't match: %d vs %d",
+			len(x), d.rq.codeSize()+rqFooterSize)
+	}
+
+	f := d.rq.footer(x)
+	var weighted float32
+	for i, v := range d.query {
+		weighted += v * float32(d.rq.code(x, i))
+	}
+	dot := f.lower*d.sum + f.step*weighted
+
+	dims := float32(len(d.query))
+	bound := min(d.norm*f.residualNorm,
+		rqErrorBoundDeviations*d.norm*f.residualNorm/float32(math.Sqrt(float64(dims))))
+
+	dist, err := d.rq.distanceFromDot(dot, d.norm*d.norm, f.squaredNorm)
+	return dist, d.rq.errorBound(bound), err
+}
+
+func (d *RQDistancer) DistanceToFloat(x []float32) (float32, error) {
+	if len(d.x) > 0 {
+		return d.rq.distancer.SingleDist(d.x, x)
+	}
+	xComp := d.rq.Encode(x)
+	return d.rq.DistanceBetweenCompressedVectors(d.compressed, xComp)
+}
+
+func (rq *RotationalQuantizer) NewQuantizerDistancer(a []float32) quantizerDistancer[byte] {
+	return rq.NewDistancer(a)
+}
+
+func (rq *RotationalQuantizer) NewCompressedQuantizerDistancer(a []byte) quantizerDistancer[byte] {
+	return &RQDistancer{
+		rq:         rq,
+		compressed: a,
+	}
+}
+
+func (rq *RotationalQuantizer) ReturnQuantizerDistancer(distancer quantizerDistancer[byte]) {}
+
+// fastRotation is a random orthogonal transformation that can be applied in
+// O(d log d). Every round flips the signs of random dimensions, mixes blocks
+// of dimensions with a Walsh-Hadamard transform and shuffles the dimensions
+// across blocks, so that after a few rounds every output depends on all
+// inputs.
+type fastRotation struct {
+	outputDim int
+	signs     [][]float32
+	perms     [][]int
+}
+
+func newFastRotation(dimensions int, seed uint64) *fastRotation {
+	outputDim := (dimensions + rqBlockSize - 1) / rqBlockSize * rqBlockSize
+	r := rand.New(rand.NewSource(int64(seed)))
+
+	rotation := &fastRotation{
+		outputDim: outputDim,
+		signs:     make([][]float32, rqRounds),
+		perms:     make([][]int, rqRounds),
+	}
+	for round := 0; round < rqRounds; round++ {
+		signs := make([]float32, outputDim)
+		for i := range signs {
+			signs[i] = 1
+			if r.Intn(2) == 0 {
+				signs[i] = -1
+			}
+		}
+		rotation.signs[round] = signs
+		rotation.perms[round] = r.Perm(outputDim)
+	}
+	return rotation
+}
+
+func (r *fastRotation) rotate(vec []float32) []float32 {
+	out := make([]float32, r.outputDim)
+	copy(out, vec)
+	tmp := make([]float32, r.outputDim)
+	for round := range r.signs {
+		for i, sign := range r.signs[round] {
+			out[i] *= sign
+		}
+		for block := 0; block < r.outputDim; block += rqBlockSize {
+			walshHadamard(out[block : block+rqBlockSize])
+		}
+		for i, j := range r.perms[round] {
+			tmp[j] = out[i]
+		}
+		out, tmp = tmp, out
+	}
+	return out
+}
+
+// walshHadamard applies the normalized Walsh-Hadamard transform in place, the
+// length of x must be a power of two
+func walshHadamard(x []float32) {
+	for h := 1; h < len(x); h *= 2 {
+		for i := 0; i < len(x); i += 2 * h {
+			for j := i; j < i+h; j++ {
+				x[j], x[j+h] = x[j]+x[j+h], x[j]-x[j+h]
+			}
+		}
+	}
+	scale := float32(1 / math.Sqrt(float64(len(x))))
+	for i := range x {
+		x[i] *= scale
+	}
+}
+
+// SortByLowerBound orders the ids of rescoring candidates by the lower bounds
+// of their distances, which are ordered along. Rescoring the candidates in
+// this order allows to skip the ones which can't beat the k-th distance found.
+func SortByLowerBound(ids []uint64, lowerBounds []float32) {
+	sort.Sort(&idsByLowerBound{ids: ids, lowerBounds: lowerBounds})
+}
+
+type idsByLowerBound struct {
+	ids         []uint64
+	lowerBounds []float32
+}
+
+func (s *idsByLowerBound) Len() int { return len(s.ids) }
+
+func (s *idsByLowerBound) Less(i, j int) bool { return s.lowerBounds[i] < s.lowerBounds[j] }
+
+func (s *idsByLowerBound) Swap(i, j int) {
+	s.ids[i], s.ids[j] = s.ids[j], s.ids[i]
+	s.lowerBounds[i], s.lowerBounds[j] = s.lowerBounds[j], s.lowerBounds[i]
+}
@@ -0,0 +1,240 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package compressionhelpers_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/compressionhelpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	testinghelpers "github.com/weaviate/weaviate/adapters/repos/db/vector/testinghelpers"
+)
+
+func TestRQInvalidConfig(t *testing.T) {
+	l2 := distancer.NewL2SquaredProvider()
+
+	_, err := compressionhelpers.NewRotationalQuantizer(0, 4, 1, l2)
+	assert.ErrorContains(t, err, "dimensions must be positive")
+
+	_, err = compressionhelpers.NewRotationalQuantizer(32, 0, 1, l2)
+	assert.ErrorContains(t, err, "bits must be between 1 and 4")
+
+	_, err = compressionhelpers.NewRotationalQuantizer(32, 5, 1, l2)
+	assert.ErrorContains(t, err, "bits must be between 1 and 4")
+
+	_, err = compressionhelpers.NewRotationalQuantizer(32, 4, 1, distancer.NewHammingProvider())
+	assert.ErrorContains(t, err, "not supported by rotational quantization")
+}
+
+func TestRQRestore(t *testing.T) {
+	vectors, _ := testinghelpers.RandomVecsFixedSeed(10, 0, 100)
+	rq, err := compressionhelpers.NewRotationalQuantizer(100, 3, 42, distancer.NewL2SquaredProvider())
+	require.Nil(t, err)
+
+	logger := &rqDataCapture{}
+	rq.PersistCompression(logger)
+	assert.Equal(t, compressionhelpers.RQData{Dimensions: 100, Bits: 3, Seed: 42}, logger.data)
+
+	restored, err := compressionhelpers.RestoreRotationalQuantizer(logger.data, distancer.NewL2SquaredProvider())
+	require.Nil(t, err)
+	for _, vec := range vectors {
+		assert.Equal(t, rq.Encode(vec), restored.Encode(vec))
+	}
+}
+
+func TestRQDistanceEstimation(t *testing.T) {
+	dims := 150
+	vectors, queries := testinghelpers.RandomVecsFixedSeed(200, 20, dims)
+	testinghelpers.Normalize(vectors)
+	testinghelpers.Normalize(queries)
+
+	providers := []distancer.Provider{
+		distancer.NewL2SquaredProvider(),
+		distancer.NewCosineDistanceProvider(),
+		distancer.NewDotProductProvider(),
+	}
+	for _, provider := range providers {
+		previousError := math.MaxFloat64
+		for bits := 1; bits <= 4; bits++ {
+			t.Run(fmt.Sprintf("%s %d bits", provider.Type(), bits), func(t *testing.T) {
+				rq, err := compressionhelpers.NewRotationalQuantizer(dims, bits, 7, provider)
+				require.Nil(t, err)
+
+				codes := make([][]byte, len(vectors))
+				for i, vec := range vectors {
+					codes[i] = rq.Encode(vec)
+				}
+
+				var sumError float64
+				var withinBound, count int
+				for _, query := range queries {
+					d := rq.NewDistancer(query)
+					encodedQuery := rq.Encode(query)
+					for i, vec := range vectors {
+						expected, err := provider.SingleDist(query, vec)
+						require.Nil(t, err)
+
+						dist, bound, err := d.DistanceWithBound(codes[i])
+						require.Nil(t, err)
+						sumError += math.Abs(float64(dist - expected))
+						if math.Abs(float64(dist-expected)) <= float64(bound) {
+							withinBound++
+						}
+						count++
+
+						// both sides compressed is less accurate, but still close
+						symmetric, err := rq.DistanceBetweenCompressedVectors(encodedQuery, codes[i])
+						require.Nil(t, err)
+						assert.InDelta(t, expected, symmetric, 0.5)
+					}
+				}
+
+				meanError := sumError / float64(count)
+				t.Logf("mean error %f, within bound %f", meanError, float64(withinBound)/float64(count))
+				assert.Less(t, meanError, previousError, "more bits must be more accurate")
+				assert.Greater(t, float64(withinBound)/float64(count), 0.95)
+				previousError = meanError
+			})
+		}
+	}
+}
+
+func TestRQErrorBound(t *testing.T) {
+	dims := 128
+	// not normalized, so that the bound has to scale with the norms
+	vectors, queries := testinghelpers.RandomVecsFixedSeed(300, 10, dims)
+
+	providers := []distancer.Provider{
+		distancer.NewL2SquaredProvider(),
+		distancer.NewDotProductProvider(),
+	}
+	for _, provider := range providers {
+		previousBound := math.MaxFloat64
+		for bits := 1; bits <= 4; bits++ {
+			t.Run(fmt.Sprintf("%s %d bits", provider.Type(), bits), func(t *testing.T) {
+				rq, err := compressionhelpers.NewRotationalQuantizer(dims, bits, 11, provider)
+				require.Nil(t, err)
+
+				var sumBound float64
+				var withinBound, count int
+				for _, query := range queries {
+					d := rq.NewDistancer(query)
+					for _, vec := range vectors {
+						expected, err := provider.SingleDist(query, vec)
+						require.Nil(t, err)
+
+						dist, bound, err := d.DistanceWithBound(rq.Encode(vec))
+						require.Nil(t, err)
+						require.Greater(t, bound, float32(0))
+						if dist-bound <= expected && expected <= dist+bound {
+							withinBound++
+						}
+						sumBound += float64(bound)
+						count++
+					}
+				}
+
+				meanBound := sumBound / float64(count)
+				t.Logf("mean bound %f, within bound %f", meanBound, float64(withinBound)/float64(count))
+				assert.GreaterOrEqual(t, float64(withinBound)/float64(count), 0.99)
+				assert.Less(t, meanBound, previousBound, "more bits must tighten the bound")
+				previousBound = meanBound
+			})
+		}
+	}
+
+	t.Run("compressed query is unbounded", func(t *testing.T) {
+		rq, err := compressionhelpers.NewRotationalQuantizer(dims, 4, 11, distancer.NewL2SquaredProvider())
+		require.Nil(t, err)
+
+		d := rq.NewCompressedQuantizerDistancer(rq.Encode(queries[0])).(*compressionhelpers.RQDistancer)
+		_, bound, err := d.DistanceWithBound(rq.Encode(vectors[0]))
+		require.Nil(t, err)
+		assert.Equal(t, float32(math.MaxFloat32), bound)
+	})
+}
+
+func TestRQRecall(t *testing.T) {
+	dims := 128
+	k := 10
+	vectors, queries := testinghelpers.RandomVecsFixedSeed(2000, 20, dims)
+	testinghelpers.Normalize(vectors)
+	testinghelpers.Normalize(queries)
+	provider := distancer.NewCosineDistanceProvider()
+	distance := func(x, y []float32) float32 {
+		dist, _ := provider.SingleDist(x, y)
+		return dist
+	}
+
+	for _, tt := range []struct {
+		bits      int
+		minRecall float32
+	}{
+		{bits: 1, minRecall: 0.3},
+		{bits: 2, minRecall: 0.5},
+		{bits: 4, minRecall: 0.8},
+	} {
+		t.Run(fmt.Sprintf("%d bits", tt.bits), func(t *testing.T) {
+			rq, err := compressionhelpers.NewRotationalQuantizer(dims, tt.bits, 7, provider)
+			require.Nil(t, err)
+			codes := make([][]byte, len(vectors))
+			for i, vec := range vectors {
+				codes[i] = rq.Encode(vec)
+			}
+
+			var relevant uint64
+			for _, query := range queries {
+				truth, _ := testinghelpers.BruteForce(logger, vectors, query, k, distance)
+
+				d := rq.NewDistancer(query)
+				estimated := make([][]float32, len(vectors))
+				for i := range codes {
+					dist, err := d.Distance(codes[i])
+					require.Nil(t, err)
+					estimated[i] = []float32{dist}
+				}
+				results, _ := testinghelpers.BruteForce(logger, estimated, []float32{0}, k, func(x, y []float32) float32 {
+					return y[0]
+				})
+				relevant += testinghelpers.MatchesInLists(truth, results)
+			}
+			recall := float32(relevant) / float32(k*len(queries))
+			t.Logf("recall %f", recall)
+			assert.GreaterOrEqual(t, recall, tt.minRecall)
+		})
+	}
+}
+
+type rqDataCapture struct {
+	data compressionhelpers.RQData
+}
+
+func (c *rqDataCapture) AddPQCompression(compressionhelpers.PQData) error {
+	return nil
+}
+
+func (c *rqDataCapture) AddSQCompression(compressionhelpers.SQData) error {
+	return nil
+}
+
+func (c *rqDataCapture) AddRQCompression(data compressionhelpers.RQData) error {
+	c.data = data
+	return nil
+}
+
+func (c *rqDataCapture) AddBQCompression() error {
+	return nil
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/flat"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/vamana"
 	"github.com/weaviate/weaviate/entities/cyclemanager"
 	"github.com/weaviate/weaviate/entities/errorcompounder"
 	schemaconfig "github.com/weaviate/weaviate/entities/schema/config"
@@ -70,6 +71,10 @@ func ValidateUserConfigUpdate(initial, updated schemaconfig.VectorIndexConfig) e
 			name:     "distance",
 			accessor: func(c ent.UserConfig) interface{} { return c.Distance },
 		},
+		{
+			name:     "upgradeTo",
+			accessor: func(c ent.UserConfig) interface{} { return c.UpgradeTo },
+		},
 	}
 
 	for _, u := range immutableFields {
@@ -83,6 +88,9 @@ func ValidateUserConfigUpdate(initial, updated schemaconfig.VectorIndexConfig) e
 	if err := hnsw.ValidateUserConfigUpdate(initialParsed.HnswUC, updatedParsed.HnswUC); err != nil {
 		return err
 	}
+	if err := vamana.ValidateUserConfigUpdate(initialParsed.VamanaUC, updatedParsed.VamanaUC); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -32,12 +32,14 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/flat"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/vamana"
 	entcfg "github.com/weaviate/weaviate/entities/config"
 	"github.com/weaviate/weaviate/entities/cyclemanager"
 	werrors "github.com/weaviate/weaviate/entities/errors"
 	schemaconfig "github.com/weaviate/weaviate/entities/schema/config"
 	ent "github.com/weaviate/weaviate/entities/vectorindex/dynamic"
 	hnswent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	vamanaent "github.com/weaviate/weaviate/entities/vectorindex/vamana"
 	"github.com/weaviate/weaviate/usecases/monitoring"
 	bolt "go.etcd.io/bbolt"
 )
@@ -93,6 +95,10 @@ type upgradableIndexer interface {
 	ShouldUpgrade() (bool, int)
 }
 
+type requantizableIndexer interface {
+	RequantizationStatus() (common.RequantizationStatus, bool)
+}
+
 type dynamic struct {
 	sync.RWMutex
 	id                    string
@@ -111,7 +117,9 @@ type dynamic struct {
 	index                 VectorIndex
 	upgraded              atomic.Bool
 	tombstoneCallbacks    cyclemanager.CycleCallbackGroup
+	upgradeTo             string
 	hnswUC                hnswent.UserConfig
+	vamanaUC              vamanaent.UserConfig
 	db                    *bolt.DB
 }
 
@@ -153,7 +161,9 @@ func New(cfg Config, uc ent.UserConfig, store *lsmkv.Store) (*dynamic, error) {
 		store:                 store,
 		threshold:             uc.Threshold,
 		tombstoneCallbacks:    cfg.TombstoneCallbacks,
+		upgradeTo:             uc.UpgradeTo,
 		hnswUC:                uc.HnswUC,
+		vamanaUC:              uc.VamanaUC,
 	}
 
 	path := filepath.Join(cfg.RootPath, "index.db")
@@ -188,27 +198,11 @@ func New(cfg Config, uc ent.UserConfig, store *lsmkv.Store) (*dynamic, error) {
 	index.db = db
 	if upgraded {
 		index.upgraded.Store(true)
-		hnsw, err := hnsw.New(
-			hnsw.Config{
-				Logger:                index.logger,
-				RootPath:              index.rootPath,
-				ID:                    index.id,
-				ShardName:             index.shardName,
-				ClassName:             index.className,
-				PrometheusMetrics:     index.prometheusMetrics,
-				VectorForIDThunk:      index.vectorForIDThunk,
-				TempVectorForIDThunk:  index.tempVectorForIDThunk,
-				DistanceProvider:      index.distanceProvider,
-				MakeCommitLoggerThunk: index.makeCommitLoggerThunk,
-			},
-			index.hnswUC,
-			index.tombstoneCallbacks,
-			index.store,
-		)
+		upgradedIndex, err := index.newUpgradedIndex()
 		if err != nil {
 			return nil, err
 		}
-		index.index = hnsw
+		index.index = upgradedIndex
 	} else {
 		flat, err := flat.New(flatConfig, uc.FlatUC, store)
 		if err != nil {
@@ -220,12 +214,59 @@ func New(cfg Config, uc ent.UserConfig, store *lsmkv.Store) (*dynamic, error) {
 	return index, nil
 }
 
+// newUpgradedIndex creates the index the flat index is upgraded to once the
+// threshold is exceeded
+func (dynamic *dynamic) newUpgradedIndex() (VectorIndex, error) {
+	if dynamic.upgradeTo == ent.UpgradeToVamana {
+		return vamana.New(
+			vamana.Config{
+				ID:                 dynamic.id,
+				TargetVector:       dynamic.targetVector,
+				RootPath:           dynamic.rootPath,
+				Logger:             dynamic.logger,
+				DistanceProvider:   dynamic.distanceProvider,
+				TombstoneCallbacks: dynamic.tombstoneCallbacks,
+			},
+			dynamic.vamanaUC,
+		)
+	}
+
+	return hnsw.New(
+		hnsw.Config{
+			Logger:                dynamic.logger,
+			RootPath:              dynamic.rootPath,
+			ID:                    dynamic.id,
+			ShardName:             dynamic.shardName,
+			ClassName:             dynamic.className,
+			PrometheusMetrics:     dynamic.prometheusMetrics,
+			VectorForIDThunk:      dynamic.vectorForIDThunk,
+			TempVectorForIDThunk:  dynamic.tempVectorForIDThunk,
+			DistanceProvider:      dynamic.distanceProvider,
+			MakeCommitLoggerThunk: dynamic.makeCommitLoggerThunk,
+		},
+		dynamic.hnswUC,
+		dynamic.tombstoneCallbacks,
+		dynamic.store,
+	)
+}
+
 func (dynamic *dynamic) Compressed() bool {
 	dynamic.RLock()
 	defer dynamic.RUnlock()
 	return dynamic.index.Compressed()
 }
 
+// RequantizationStatus returns the progress of the requantization of the
+// upgraded index. The flat index is never requantized.
+func (dynamic *dynamic) RequantizationStatus() (common.RequantizationStatus, bool) {
+	dynamic.RLock()
+	defer dynamic.RUnlock()
+	if index, ok := dynamic.index.(requantizableIndexer); ok {
+		return index.RequantizationStatus()
+	}
+	return common.RequantizationStatus{}, false
+}
+
 func (dynamic *dynamic) Multivector() bool {
 	dynamic.RLock()
 	defer dynamic.RUnlock()
@@ -274,6 +315,45 @@ func (dynamic *dynamic) SearchByVector(ctx context.Context, vector []float32, k
 	return dynamic.index.SearchByVector(ctx, vector, k, allow)
 }
 
+// FilterSearchStats describes the underlying index to the query planner of
+// the shard. Before the upgrade to hnsw there is nothing to plan.
+func (dynamic *dynamic) FilterSearchStats(k int) (common.FilterSearchStats, bool) {
+	dynamic.RLock()
+	defer dynamic.RUnlock()
+	if planned, ok := dynamic.index.(interface {
+		FilterSearchStats(k int) (common.FilterSearchStats, bool)
+	}); ok {
+		return planned.FilterSearchStats(k)
+	}
+	return common.FilterSearchStats{}, false
+}
+
+// SearchByVectorRange streams every vector within targetDistance of the query
+// vector from the underlying index
+func (dynamic *dynamic) SearchByVectorRange(ctx context.Context, vector []float32,
+	targetDistance float32, allow helpers.AllowList, fn func(id uint64, dist float32) bool,
+) error {
+	dynamic.RLock()
+	defer dynamic.RUnlock()
+	if ranged, ok := dynamic.index.(interface {
+		SearchByVectorRange(ctx context.Context, vector []float32, targetDistance float32,
+			allow helpers.AllowList, fn func(id uint64, dist float32) bool) error
+	}); ok {
+		return ranged.SearchByVectorRange(ctx, vector, targetDistance, allow, fn)
+	}
+
+	ids, dists, err := dynamic.index.SearchByVectorDistance(ctx, vector, targetDistance, -1, allow)
+	if err != nil {
+		return err
+	}
+	for i := range ids {
+		if !fn(ids[i], dists[i]) {
+			return nil
+		}
+	}
+	return nil
+}
+
 func (dynamic *dynamic) SearchByMultiVector(ctx context.Context, vectors [][]float32, k int, allow helpers.AllowList) ([]uint64, []float32, error) {
 	dynamic.RLock()
 	defer dynamic.RUnlock()
@@ -295,9 +375,14 @@ func (dynamic *dynamic) UpdateUserConfig(updated schemaconfig.VectorIndexConfig,
 	if dynamic.upgraded.Load() {
 		dynamic.RLock()
 		defer dynamic.RUnlock()
-		dynamic.index.UpdateUserConfig(parsed.HnswUC, callback)
+		if dynamic.upgradeTo == ent.UpgradeToVamana {
+			dynamic.index.UpdateUserConfig(parsed.VamanaUC, callback)
+		} else {
+			dynamic.index.UpdateUserConfig(parsed.HnswUC, callback)
+		}
 	} else {
 		dynamic.hnswUC = parsed.HnswUC
+		dynamic.vamanaUC = parsed.VamanaUC
 		dynamic.RLock()
 		defer dynamic.RUnlock()
 		dynamic.index.UpdateUserConfig(parsed.FlatUC, callback)
@@ -435,23 +520,7 @@ func (dynamic *dynamic) Upgrade(callback func()) error {
 		return dynamic.index.(upgradableIndexer).Upgrade(callback)
 	}
 
-	index, err := hnsw.New(
-		hnsw.Config{
-			Logger:                dynamic.logger,
-			RootPath:              dynamic.rootPath,
-			ID:                    dynamic.id,
-			ShardName:             dynamic.shardName,
-			ClassName:             dynamic.className,
-			PrometheusMetrics:     dynamic.prometheusMetrics,
-			VectorForIDThunk:      dynamic.vectorForIDThunk,
-			TempVectorForIDThunk:  dynamic.tempVectorForIDThunk,
-			DistanceProvider:      dynamic.distanceProvider,
-			MakeCommitLoggerThunk: dynamic.makeCommitLoggerThunk,
-		},
-		dynamic.hnswUC,
-		dynamic.tombstoneCallbacks,
-		dynamic.store,
-	)
+	index, err := dynamic.newUpgradedIndex()
 	if err != nil {
 		callback()
 		return err
@@ -32,6 +32,7 @@ import (
 	ent "github.com/weaviate/weaviate/entities/vectorindex/dynamic"
 	flatent "github.com/weaviate/weaviate/entities/vectorindex/flat"
 	hnswent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	vamanaent "github.com/weaviate/weaviate/entities/vectorindex/vamana"
 )
 
 var logger, _ = test.NewNullLogger()
@@ -108,6 +109,85 @@ func TestDynamic(t *testing.T) {
 	assert.True(t, latency1 > latency2)
 }
 
+func TestDynamicUpgradeToVamana(t *testing.T) {
+	ctx := context.Background()
+	currentIndexing := os.Getenv("ASYNC_INDEXING")
+	os.Setenv("ASYNC_INDEXING", "true")
+	defer os.Setenv("ASYNC_INDEXING", currentIndexing)
+	dimensions := 20
+	vectors_size := 2_000
+	queries_size := 10
+	k := 10
+
+	vectors, queries := testinghelpers.RandomVecs(vectors_size, queries_size, dimensions)
+	rootPath := t.TempDir()
+	distancer := distancer.NewL2SquaredProvider()
+	truths := make([][]uint64, queries_size)
+	compressionhelpers.Concurrently(logger, uint64(len(queries)), func(i uint64) {
+		truths[i], _ = testinghelpers.BruteForce(logger, vectors, queries[i], k, distanceWrapper(distancer))
+	})
+	store := testinghelpers.NewDummyStore(t)
+	fuc := flatent.NewDefaultUserConfig()
+	vuc := vamanaent.NewDefaultUserConfig()
+	vuc.PQ.Segments = 10
+	vuc.PQ.TrainingLimit = 1_000
+	config := dynamic.Config{
+		RootPath:              rootPath,
+		ID:                    "vamana-upgrade-test",
+		MakeCommitLoggerThunk: hnsw.MakeNoopCommitLogger,
+		DistanceProvider:      distancer,
+		VectorForIDThunk: func(ctx context.Context, id uint64) ([]float32, error) {
+			return vectors[int(id)], nil
+		},
+		TempVectorForIDThunk: TempVectorForIDThunk(vectors),
+		TombstoneCallbacks:   cyclemanager.NewCallbackGroupNoop(),
+	}
+	uc := ent.UserConfig{
+		Threshold: uint64(vectors_size),
+		Distance:  distancer.Type(),
+		UpgradeTo: ent.UpgradeToVamana,
+		HnswUC:    hnswent.NewDefaultUserConfig(),
+		FlatUC:    fuc,
+		VamanaUC:  vuc,
+	}
+	index, err := dynamic.New(config, uc, store)
+	assert.Nil(t, err)
+
+	compressionhelpers.Concurrently(logger, uint64(vectors_size), func(i uint64) {
+		index.Add(ctx, i, vectors[i])
+	})
+	assert.False(t, index.Upgraded())
+
+	wg := sync.WaitGroup{}
+	wg.Add(1)
+	assert.Nil(t, index.Upgrade(func() {
+		wg.Done()
+	}))
+	wg.Wait()
+	assert.True(t, index.Upgraded())
+	shouldUpgrade, _ := index.ShouldUpgrade()
+	assert.False(t, shouldUpgrade)
+
+	recall, _ := recallAndLatency(ctx, queries, k, index, truths)
+	assert.True(t, recall > 0.9)
+
+	assert.Nil(t, index.SwitchCommitLogs(ctx))
+	files, err := index.ListFiles(ctx, rootPath)
+	assert.Nil(t, err)
+	assert.Contains(t, files, "vamana.graph.snapshot")
+
+	t.Run("with restart", func(t *testing.T) {
+		assert.Nil(t, index.Shutdown(ctx))
+
+		index, err = dynamic.New(config, uc, store)
+		assert.Nil(t, err)
+		assert.True(t, index.Upgraded())
+
+		recall, _ := recallAndLatency(ctx, queries, k, index, truths)
+		assert.True(t, recall > 0.9)
+	})
+}
+
 func TestDynamicReturnsErrorIfNoAsync(t *testing.T) {
 	currentIndexing := os.Getenv("ASYNC_INDEXING")
 	os.Unsetenv("ASYNC_INDEXING")
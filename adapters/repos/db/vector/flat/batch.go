@@ -0,0 +1,161 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package flat
+
+import (
+	"context"
+	"encoding/binary"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/priorityqueue"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
+)
+
+// batchBlockSize is the number of stored vectors read from the bucket before
+// the distances to all query vectors are evaluated. Keeping the block small
+// enough to stay in the CPU cache means every stored vector is decoded once
+// and then compared to all queries while it is still hot.
+const batchBlockSize = 256
+
+// SearchByVectorBatch runs several queries against the index in a single
+// pass over the stored vectors. The results are identical to calling
+// SearchByVector for each query with the same allow list.
+func (index *flat) SearchByVectorBatch(ctx context.Context, vectors [][]float32,
+	k int, allow helpers.AllowList,
+) ([][]uint64, [][]float32, error) {
+	if index.multivector {
+		return nil, nil, errors.New("batch vector search is not supported on multivector indexes")
+	}
+
+	ids := make([][]uint64, len(vectors))
+	dists := make([][]float32, len(vectors))
+
+	if index.compression == compressionBQ || index.compression == compressionRQ {
+		// compressed vectors are held in caches that are optimized for a single
+		// query, so the batch is answered query by query
+		for i, vector := range vectors {
+			var err error
+			ids[i], dists[i], err = index.SearchByVector(ctx, vector, k, allow)
+			if err != nil {
+				return nil, nil, err
+			}
+		}
+		return ids, dists, nil
+	}
+
+	queries := make([][]float32, len(vectors))
+	heaps := make([]*priorityqueue.Queue[any], len(vectors))
+	for i, vector := range vectors {
+		vector, err := common.TruncateVector(vector, index.matryoshkaDims)
+		if err != nil {
+			return nil, nil, err
+		}
+		queries[i] = index.normalized(vector)
+		heaps[i] = index.pqResults.GetMax(k)
+	}
+	defer func() {
+		for _, heap := range heaps {
+			index.pqResults.Put(heap)
+		}
+	}()
+
+	if err := index.findTopVectorsBatch(ctx, heaps, allow, k, queries); err != nil {
+		return nil, nil, err
+	}
+
+	for i, heap := range heaps {
+		ids[i], dists[i] = index.extractHeap(heap)
+	}
+	return ids, dists, nil
+}
+
+// findTopVectorsBatch populates one heap per query with the smallest
+// distances. Stored vectors are read in blocks of batchBlockSize and each
+// block is compared to all queries before the next one is read.
+func (index *flat) findTopVectorsBatch(ctx context.Context,
+	heaps []*priorityqueue.Queue[any], allow helpers.AllowList, limit int,
+	queries [][]float32,
+) error {
+	var key []byte
+	var v []byte
+	var id uint64
+	allowMax := uint64(0)
+
+	cursor := index.store.Bucket(index.getBucketName()).Cursor()
+	defer cursor.Close()
+
+	if allow != nil {
+		// nothing allowed, skip search
+		if allow.IsEmpty() {
+			return nil
+		}
+
+		allowMax = allow.Max()
+
+		idSlice := index.pool.byteSlicePool.Get(8)
+		binary.BigEndian.PutUint64(idSlice.slice, allow.Min())
+		key, v = cursor.Seek(idSlice.slice)
+		index.pool.byteSlicePool.Put(idSlice)
+	} else {
+		key, v = cursor.First()
+	}
+
+	blockIDs := make([]uint64, 0, batchBlockSize)
+	blockVecs := make([][]float32, 0, batchBlockSize)
+	// decoded vectors are kept across blocks, so their memory is allocated
+	// once per search rather than once per stored vector
+	buffers := make([][]float32, batchBlockSize)
+
+	flush := func() error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		for q, query := range queries {
+			for j, candidate := range blockVecs {
+				distance, err := index.distancerProvider.SingleDist(query, candidate)
+				if err != nil {
+					return err
+				}
+				index.insertToHeap(heaps[q], limit, blockIDs[j], distance)
+			}
+		}
+		blockIDs = blockIDs[:0]
+		blockVecs = blockVecs[:0]
+		return nil
+	}
+
+	// since keys are sorted, once key/id get greater than max allowed one
+	// further search can be stopped
+	for ; key != nil && (allow == nil || id <= allowMax); key, v = cursor.Next() {
+		id = binary.BigEndian.Uint64(key)
+		if allow != nil && !allow.Contains(id) {
+			continue
+		}
+
+		// the cursor may reuse the value buffer, so the vector is decoded into
+		// memory owned by the block
+		j := len(blockIDs)
+		if cap(buffers[j]) < len(v)/4 {
+			buffers[j] = make([]float32, len(v)/4)
+		}
+		blockIDs = append(blockIDs, id)
+		blockVecs = append(blockVecs, float32SliceFromByteSlice(v, buffers[j][:len(v)/4]))
+		if len(blockIDs) == batchBlockSize {
+			if err := flush(); err != nil {
+				return err
+			}
+		}
+	}
+
+	return flush()
+}
@@ -0,0 +1,90 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package flat
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/testinghelpers"
+	flatent "github.com/weaviate/weaviate/entities/vectorindex/flat"
+)
+
+func TestFlatSearchByVectorBatch(t *testing.T) {
+	ctx := context.Background()
+	// more vectors than fit into a single block
+	vectorsSize := 3*batchBlockSize + 17
+	queriesSize := 20
+	k := 10
+
+	vectors, queries := testinghelpers.RandomVecs(vectorsSize, queriesSize, 32)
+
+	allow := helpers.NewAllowList()
+	for i := 0; i < vectorsSize; i += 3 {
+		allow.Insert(uint64(i))
+	}
+
+	tests := []struct {
+		name string
+		bq   bool
+	}{
+		{name: "uncompressed"},
+		{name: "bq", bq: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc := flatent.NewDefaultUserConfig()
+			uc.BQ.Enabled = tt.bq
+			index, err := New(Config{
+				ID:               uuid.New().String(),
+				DistanceProvider: distancer.NewCosineDistanceProvider(),
+				RootPath:         t.TempDir(),
+			}, uc, testinghelpers.NewDummyStore(t))
+			require.Nil(t, err)
+			defer index.Shutdown(ctx)
+
+			for i, vector := range vectors {
+				require.Nil(t, index.Add(ctx, uint64(i), vector))
+			}
+
+			for _, allowList := range []helpers.AllowList{nil, allow} {
+				batchIDs, batchDists, err := index.SearchByVectorBatch(ctx, queries, k, allowList)
+				require.Nil(t, err)
+				require.Len(t, batchIDs, queriesSize)
+				require.Len(t, batchDists, queriesSize)
+
+				for i, query := range queries {
+					ids, dists, err := index.SearchByVector(ctx, query, k, allowList)
+					require.Nil(t, err)
+					assert.Equal(t, ids, batchIDs[i])
+					assert.InDeltaSlice(t, dists, batchDists[i], 1e-6)
+					if allowList != nil {
+						for _, id := range batchIDs[i] {
+							assert.True(t, allowList.Contains(id))
+						}
+					}
+				}
+			}
+
+			batchIDs, _, err := index.SearchByVectorBatch(ctx, queries, k, helpers.NewAllowList())
+			require.Nil(t, err)
+			for _, ids := range batchIDs {
+				assert.Empty(t, ids)
+			}
+		})
+	}
+}
@@ -39,6 +39,7 @@ import (
 	entlsmkv "github.com/weaviate/weaviate/entities/lsmkv"
 	schemaConfig "github.com/weaviate/weaviate/entities/schema/config"
 	flatent "github.com/weaviate/weaviate/entities/vectorindex/flat"
+	hnswent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 	"github.com/weaviate/weaviate/usecases/floatcomp"
 	bolt "go.etcd.io/bbolt"
 )
@@ -47,6 +48,7 @@ const (
 	compressionBQ        = "bq"
 	compressionPQ        = "pq"
 	compressionSQ        = "sq"
+	compressionRQ        = "rq"
 	compressionNone      = "none"
 	defaultCachePageSize = 32
 )
@@ -64,6 +66,8 @@ type flat struct {
 	trackDimensionsOnce sync.Once
 	rescore             int64
 	bq                  compressionhelpers.BinaryQuantizer
+	rq                  *compressionhelpers.RotationalQuantizer
+	rqBits              int
 
 	pqResults *common.PqMaxPool
 	pool      *pools
@@ -72,6 +76,13 @@ type flat struct {
 	bqCache              cache.Cache[uint64]
 	count                uint64
 	concurrentCacheReads int
+
+	multivector       bool
+	multivectorConfig atomic.Pointer[hnswent.MultivectorConfig]
+
+	// matryoshkaDims is the number of leading dimensions of the vectors held by
+	// the index, 0 if the vectors are held as a whole
+	matryoshkaDims int
 }
 
 type distanceCalc func(vecAsBytes []byte) (float32, error)
@@ -101,7 +112,12 @@ func New(cfg Config, uc flatent.UserConfig, store *lsmkv.Store) (*flat, error) {
 		pool:                 newPools(),
 		store:                store,
 		concurrentCacheReads: runtime.GOMAXPROCS(0) * 2,
+		multivector:          uc.Multivector.Enabled,
+		rqBits:               uc.RQ.Bits,
+		matryoshkaDims:       uc.Matryoshka.Dimensions,
 	}
+	multivectorConfig := uc.Multivector
+	index.multivectorConfig.Store(&multivectorConfig)
 	if err := index.initBuckets(context.Background()); err != nil {
 		return nil, fmt.Errorf("init flat index buckets: %w", err)
 	}
@@ -145,6 +161,10 @@ func extractCompression(uc flatent.UserConfig) string {
 		return compressionSQ
 	}
 
+	if uc.RQ.Enabled {
+		return compressionRQ
+	}
+
 	return compressionNone
 }
 
@@ -157,6 +177,8 @@ func extractCompressionRescore(uc flatent.UserConfig) int64 {
 		return int64(uc.BQ.RescoreLimit)
 	case compressionSQ:
 		return int64(uc.SQ.RescoreLimit)
+	case compressionRQ:
+		return int64(uc.RQ.RescoreLimit)
 	default:
 		return 0
 	}
@@ -180,6 +202,16 @@ func (index *flat) isBQ() bool {
 	return index.compression == compressionBQ
 }
 
+func (index *flat) isRQ() bool {
+	return index.compression == compressionRQ
+}
+
+// storesCompressedVectors is true for the compression types that keep a
+// compressed copy of every vector next to the uncompressed one
+func (index *flat) storesCompressedVectors() bool {
+	return index.isBQ() || index.isRQ()
+}
+
 func (index *flat) isBQCached() bool {
 	return index.bqCache != nil
 }
@@ -189,7 +221,7 @@ func (index *flat) Compressed() bool {
 }
 
 func (index *flat) Multivector() bool {
-	return false
+	return index.multivector
 }
 
 func (index *flat) getBucketName() string {
@@ -229,7 +261,7 @@ func (index *flat) initBuckets(ctx context.Context) error {
 	); err != nil {
 		return fmt.Errorf("Create or load flat vectors bucket: %w", err)
 	}
-	if index.isBQ() {
+	if index.storesCompressedVectors() {
 		if err := index.store.CreateOrLoadBucket(ctx, index.getCompressedBucketName(),
 			lsmkv.WithForceCompation(forceCompaction),
 			lsmkv.WithUseBloomFilter(false),
@@ -248,6 +280,16 @@ func (index *flat) initBuckets(ctx context.Context) error {
 			return fmt.Errorf("Create or load flat compressed vectors bucket: %w", err)
 		}
 	}
+	if index.multivector {
+		if err := index.store.CreateOrLoadBucket(ctx, index.getCentroidsBucketName(),
+			lsmkv.WithForceCompation(forceCompaction),
+			lsmkv.WithUseBloomFilter(false),
+			lsmkv.WithCalcCountNetAdditions(false),
+			lsmkv.WithPread(false),
+		); err != nil {
+			return fmt.Errorf("Create or load flat multi vector centroids bucket: %w", err)
+		}
+	}
 	return nil
 }
 
@@ -309,6 +351,10 @@ func (index *flat) Add(ctx context.Context, id uint64, vector []float32) error {
 	if err := ctx.Err(); err != nil {
 		return err
 	}
+	vector, err := common.TruncateVector(vector, index.matryoshkaDims)
+	if err != nil {
+		return err
+	}
 
 	index.trackDimensionsOnce.Do(func() {
 		size := int32(len(vector))
@@ -321,6 +367,11 @@ func (index *flat) Add(ctx context.Context, id uint64, vector []float32) error {
 		if index.isBQ() {
 			index.bq = compressionhelpers.NewBinaryQuantizer(nil)
 		}
+		if index.isRQ() {
+			if err := index.initRQ(size); err != nil {
+				index.logger.WithError(err).Error("could not init rotational quantizer")
+			}
+		}
 	})
 	if len(vector) != int(index.dims) {
 		return errors.Errorf("insert called with a vector of the wrong size")
@@ -338,19 +389,17 @@ func (index *flat) Add(ctx context.Context, id uint64, vector []float32) error {
 		slice = make([]byte, len(vectorBQ)*8)
 		index.storeCompressedVector(id, byteSliceFromUint64Slice(vectorBQ, slice))
 	}
+	if index.isRQ() {
+		if index.rq == nil {
+			return errors.Errorf("rotational quantizer is not initialized")
+		}
+		index.storeCompressedVector(id, index.rq.Encode(vector))
+	}
 	newCount := atomic.LoadUint64(&index.count)
 	atomic.StoreUint64(&index.count, newCount+1)
 	return nil
 }
 
-func (index *flat) AddMulti(ctx context.Context, docID uint64, vectors [][]float32) error {
-	return errors.Errorf("AddMulti is not supported for flat index")
-}
-
-func (index *flat) AddMultiBatch(ctx context.Context, docIDs []uint64, vectors [][][]float32) error {
-	return errors.Errorf("AddMultiBatch is not supported for flat index")
-}
-
 func (index *flat) Delete(ids ...uint64) error {
 	for i := range ids {
 		if index.isBQCached() {
@@ -363,7 +412,7 @@ func (index *flat) Delete(ids ...uint64) error {
 			return err
 		}
 
-		if index.isBQ() {
+		if index.storesCompressedVectors() {
 			if err := index.store.Bucket(index.getCompressedBucketName()).Delete(idBytes); err != nil {
 				return err
 			}
@@ -372,10 +421,6 @@ func (index *flat) Delete(ids ...uint64) error {
 	return nil
 }
 
-func (index *flat) DeleteMulti(ids ...uint64) error {
-	return errors.Errorf("DeleteMulti is not supported for flat index")
-}
-
 func (index *flat) searchTimeRescore(k int) int {
 	// load atomically, so we can get away with concurrent updates of the
 	// userconfig without having to set a lock each time we try to read - which
@@ -387,9 +432,15 @@ func (index *flat) searchTimeRescore(k int) int {
 }
 
 func (index *flat) SearchByVector(ctx context.Context, vector []float32, k int, allow helpers.AllowList) ([]uint64, []float32, error) {
+	vector, err := common.TruncateVector(vector, index.matryoshkaDims)
+	if err != nil {
+		return nil, nil, err
+	}
 	switch index.compression {
 	case compressionBQ:
 		return index.searchByVectorBQ(ctx, vector, k, allow)
+	case compressionRQ:
+		return index.searchByVectorRQ(ctx, vector, k, allow)
 	case compressionPQ:
 		// use uncompressed for now
 		fallthrough
@@ -398,10 +449,6 @@ func (index *flat) SearchByVector(ctx context.Context, vector []float32, k int,
 	}
 }
 
-func (index *flat) SearchByMultiVector(ctx context.Context, vectors [][]float32, k int, allow helpers.AllowList) ([]uint64, []float32, error) {
-	return nil, nil, errors.Errorf("SearchByMultiVector is not supported for flat index")
-}
-
 func (index *flat) searchByVector(ctx context.Context, vector []float32, k int, allow helpers.AllowList) ([]uint64, []float32, error) {
 	// TODO: pass context into inner methods, so it can be checked more granuarly
 	heap := index.pqResults.GetMax(k)
@@ -452,22 +499,93 @@ func (index *flat) searchByVectorBQ(ctx context.Context, vector []float32, k int
 		}
 	}
 
+	return index.rescoreCandidates(heap, k, vector)
+}
+
+// searchByVectorRQ selects the candidates by their estimated distance to the
+// rotationally quantized vectors and rescores them with the uncompressed
+// vectors
+func (index *flat) searchByVectorRQ(ctx context.Context, vector []float32, k int, allow helpers.AllowList) ([]uint64, []float32, error) {
+	if index.rq == nil {
+		// nothing was inserted yet, so there is nothing to find
+		return []uint64{}, []float32{}, nil
+	}
+
+	rescore := index.searchTimeRescore(k)
+	heap := index.pqResults.GetMax(rescore)
+	defer index.pqResults.Put(heap)
+
+	vector = index.normalized(vector)
+	rqDistancer := index.rq.NewDistancer(vector)
+
+	// the candidates carry the lower bound of their distance, so that the
+	// ones which can't make it into the results aren't rescored
+	if err := index.visitVectors(allow, index.store.Bucket(index.getCompressedBucketName()).Cursor,
+		func(id uint64, v []byte) error {
+			distance, bound, err := rqDistancer.DistanceWithBound(v)
+			if err != nil {
+				return err
+			}
+			if heap.Len() < rescore {
+				heap.InsertWithValue(id, distance, distance-bound)
+			} else if heap.Top().Dist > distance {
+				heap.Pop()
+				heap.InsertWithValue(id, distance, distance-bound)
+			}
+			return nil
+		},
+	); err != nil {
+		return nil, nil, err
+	}
+
+	return index.rescoreCandidates(heap, k, vector)
+}
+
+// rescoreCandidates replaces the candidates in the heap with the k closest ones
+// by the distance to their uncompressed vectors. Candidates carrying the lower
+// bound of their distance are skipped once they can't beat the k-th distance
+// rescored so far.
+func (index *flat) rescoreCandidates(heap *priorityqueue.Queue[any], k int, vector []float32,
+) ([]uint64, []float32, error) {
 	distanceCalc := index.createDistanceCalc(vector)
 	idsSlice := index.pool.uint64SlicePool.Get(heap.Len())
 	defer index.pool.uint64SlicePool.Put(idsSlice)
 
+	var lowerBounds []float32
 	for i := range idsSlice.slice {
-		idsSlice.slice[i] = heap.Pop().ID
+		item := heap.Pop()
+		idsSlice.slice[i] = item.ID
+		if lowerBound, ok := item.Value.(float32); ok {
+			if lowerBounds == nil {
+				lowerBounds = make([]float32, len(idsSlice.slice))
+			}
+			lowerBounds[i] = lowerBound
+		}
+	}
+	if lowerBounds != nil {
+		compressionhelpers.SortByLowerBound(idsSlice.slice, lowerBounds)
 	}
 
-	// we expect to be mostly IO-bound, so more goroutines than CPUs is fine
-	distancesUncompressedVectors := make([]float32, len(idsSlice.slice))
+	var mu sync.Mutex // protects heap
+	canImprove := func(idPos int) bool {
+		if lowerBounds == nil {
+			return true
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		return heap.Len() < k || lowerBounds[idPos] <= heap.Top().Dist
+	}
 
+	// we expect to be mostly IO-bound, so more goroutines than CPUs is fine
 	eg := enterrors.NewErrorGroupWrapper(index.logger)
 	for workerID := 0; workerID < index.concurrentCacheReads; workerID++ {
 		workerID := workerID
 		eg.Go(func() error {
 			for idPos := workerID; idPos < len(idsSlice.slice); idPos += index.concurrentCacheReads {
+				if !canImprove(idPos) {
+					continue
+				}
+
 				id := idsSlice.slice[idPos]
 				candidateAsBytes, err := index.vectorById(id)
 				if err != nil {
@@ -481,7 +599,9 @@ func (index *flat) searchByVectorBQ(ctx context.Context, vector []float32, k int
 					return err
 				}
 
-				distancesUncompressedVectors[idPos] = distance
+				mu.Lock()
+				index.insertToHeap(heap, k, id, distance)
+				mu.Unlock()
 			}
 
 			return nil
@@ -492,10 +612,6 @@ func (index *flat) searchByVectorBQ(ctx context.Context, vector []float32, k int
 		return nil, nil, err
 	}
 
-	for i, id := range idsSlice.slice {
-		index.insertToHeap(heap, k, id, distancesUncompressedVectors[i])
-	}
-
 	ids, dists := index.extractHeap(heap)
 	return ids, dists, nil
 }
@@ -523,6 +639,20 @@ func (index *flat) vectorById(id uint64) ([]byte, error) {
 func (index *flat) findTopVectors(heap *priorityqueue.Queue[any],
 	allow helpers.AllowList, limit int, cursorFn func() *lsmkv.CursorReplace,
 	distanceCalc distanceCalc,
+) error {
+	return index.visitVectors(allow, cursorFn, func(id uint64, v []byte) error {
+		distance, err := distanceCalc(v)
+		if err != nil {
+			return err
+		}
+		index.insertToHeap(heap, limit, id, distance)
+		return nil
+	})
+}
+
+// visitVectors calls visit for every allowed vector of the cursor
+func (index *flat) visitVectors(allow helpers.AllowList, cursorFn func() *lsmkv.CursorReplace,
+	visit func(id uint64, v []byte) error,
 ) error {
 	var key []byte
 	var v []byte
@@ -553,11 +683,9 @@ func (index *flat) findTopVectors(heap *priorityqueue.Queue[any],
 	for ; key != nil && (allow == nil || id <= allowMax); key, v = cursor.Next() {
 		id = binary.BigEndian.Uint64(key)
 		if allow == nil || allow.Contains(id) {
-			distance, err := distanceCalc(v)
-			if err != nil {
+			if err := visit(id, v); err != nil {
 				return err
 			}
-			index.insertToHeap(heap, limit, id, distance)
 		}
 	}
 	return nil
@@ -731,6 +859,8 @@ func (index *flat) UpdateUserConfig(updated schemaConfig.VectorIndexConfig, call
 	// Store atomically as a lock here would be very expensive, this value is
 	// read on every single user-facing search, which can be highly concurrent
 	atomic.StoreInt64(&index.rescore, extractCompressionRescore(parsed))
+	multivectorConfig := parsed.Multivector
+	index.multivectorConfig.Store(&multivectorConfig)
 
 	callback()
 	return nil
@@ -782,7 +912,8 @@ func (index *flat) GetKeys(id uint64) (uint64, uint64, error) {
 }
 
 func (i *flat) ValidateBeforeInsert(vector []float32) error {
-	return nil
+	_, err := common.TruncateVector(vector, i.matryoshkaDims)
+	return err
 }
 
 func (i *flat) ValidateMultiBeforeInsert(vector [][]float32) error {
@@ -868,7 +999,7 @@ func (index *flat) ContainsNode(id uint64) bool {
 	// logic modeled after SearchByVector which indicates that the PQ bucket is
 	// the same as the uncompressed bucket "for now"
 	switch index.compression {
-	case compressionBQ:
+	case compressionBQ, compressionRQ:
 		bucketName = index.getCompressedBucketName()
 	case compressionPQ:
 		// use uncompressed for now
@@ -893,7 +1024,7 @@ func (index *flat) Iterate(fn func(id uint64) bool) {
 	// logic modeled after SearchByVector which indicates that the PQ bucket is
 	// the same as the uncompressed bucket "for now"
 	switch index.compression {
-	case compressionBQ:
+	case compressionBQ, compressionRQ:
 		bucketName = index.getCompressedBucketName()
 	case compressionPQ:
 		// use uncompressed for now
@@ -971,6 +1102,22 @@ func ValidateUserConfigUpdate(initial, updated schemaConfig.VectorIndexConfig) e
 			name:     "bq",
 			accessor: func(c flatent.UserConfig) interface{} { return c.BQ.Enabled },
 		},
+		{
+			name:     "rq",
+			accessor: func(c flatent.UserConfig) interface{} { return c.RQ.Enabled },
+		},
+		{
+			name:     "rq.bits",
+			accessor: func(c flatent.UserConfig) interface{} { return c.RQ.Bits },
+		},
+		{
+			name:     "multivector enabled",
+			accessor: func(c flatent.UserConfig) interface{} { return c.Multivector.Enabled },
+		},
+		{
+			name:     "matryoshka dimensions",
+			accessor: func(c flatent.UserConfig) interface{} { return c.Matryoshka.Dimensions },
+		},
 		// as of v1.25.2, updating the BQ cache setting is now possible.
 		// Note that the change does not take effect until the tenant is
 		// reloaded, either from a complete restart or from
@@ -991,6 +1138,12 @@ func (index *flat) AlreadyIndexed() uint64 {
 
 func (index *flat) QueryVectorDistancer(queryVector []float32) common.QueryVectorDistancer {
 	var distFunc func(nodeID uint64) (float32, error)
+	queryVector, err := common.TruncateVector(queryVector, index.matryoshkaDims)
+	if err != nil {
+		return common.QueryVectorDistancer{DistanceFunc: func(uint64) (float32, error) {
+			return 0, err
+		}}
+	}
 	queryVector = index.normalized(queryVector)
 	defaultDistFunc := func(nodeID uint64) (float32, error) {
 		vec, err := index.vectorById(nodeID)
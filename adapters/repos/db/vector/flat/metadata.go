@@ -14,11 +14,13 @@ package flat
 import (
 	"encoding/binary"
 	"fmt"
+	"math/rand"
 	"os"
 	"path/filepath"
 	"sync/atomic"
 
 	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/compressionhelpers"
 	bolt "go.etcd.io/bbolt"
 )
 
@@ -120,6 +122,11 @@ func (index *flat) initDimensions() {
 	if dims > 0 {
 		index.trackDimensionsOnce.Do(func() {
 			atomic.StoreInt32(&index.dims, dims)
+			if index.isRQ() {
+				if err := index.initRQ(dims); err != nil {
+					index.logger.Warnf("flat index unable to init rotational quantizer: %v", err)
+				}
+			}
 		})
 	}
 }
@@ -195,3 +202,77 @@ func (index *flat) setDimensions(dimensions int32) error {
 
 	return nil
 }
+
+// initRQ creates the rotational quantizer. The rotation is derived from a
+// random seed, which is stored with the dimensions so that the vectors stay
+// decodable after a restart.
+func (index *flat) initRQ(dimensions int32) error {
+	seed, err := index.fetchRQSeed()
+	if err != nil {
+		return err
+	}
+	if seed == 0 {
+		seed = rand.Uint64()
+		if err := index.setRQSeed(seed); err != nil {
+			return err
+		}
+	}
+
+	rq, err := compressionhelpers.NewRotationalQuantizer(int(dimensions), index.rqBits,
+		seed, index.distancerProvider)
+	if err != nil {
+		return errors.Wrap(err, "init rotational quantizer")
+	}
+	index.rq = rq
+	return nil
+}
+
+func (index *flat) fetchRQSeed() (uint64, error) {
+	err := index.openMetadata()
+	if err != nil {
+		return 0, err
+	}
+	defer index.closeMetadata()
+
+	var seed uint64
+	err = index.metadata.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(vectorMetadataBucket))
+		if b == nil {
+			return nil
+		}
+		v := b.Get([]byte("rqSeed"))
+		if v == nil {
+			return nil
+		}
+		seed = binary.LittleEndian.Uint64(v)
+		return nil
+	})
+	if err != nil {
+		return 0, errors.Wrap(err, "fetch rq seed")
+	}
+
+	return seed, nil
+}
+
+func (index *flat) setRQSeed(seed uint64) error {
+	err := index.openMetadata()
+	if err != nil {
+		return err
+	}
+	defer index.closeMetadata()
+
+	err = index.metadata.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(vectorMetadataBucket))
+		if b == nil {
+			return errors.New("failed to get bucket")
+		}
+		buf := make([]byte, 8)
+		binary.LittleEndian.PutUint64(buf, seed)
+		return b.Put([]byte("rqSeed"), buf)
+	})
+	if err != nil {
+		return errors.Wrap(err, "set rq seed")
+	}
+
+	return nil
+}
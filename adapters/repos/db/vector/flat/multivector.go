@@ -0,0 +1,226 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package flat
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"sync/atomic"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
+	hnswent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+// A multi vector document is stored under its doc id with all of its vectors
+// concatenated. The mean of its vectors is stored in a separate bucket, so the
+// aggregations that rank by the mean vector do not need to read all vectors.
+
+func (index *flat) getCentroidsBucketName() string {
+	if index.targetVector != "" {
+		return fmt.Sprintf("%s_%s", helpers.VectorsCentroidsBucketLSM, index.targetVector)
+	}
+	return helpers.VectorsCentroidsBucketLSM
+}
+
+func (index *flat) AddMulti(ctx context.Context, docID uint64, vectors [][]float32) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if !index.multivector {
+		return errors.Errorf("AddMulti called on non-multivector index")
+	}
+	if len(vectors) == 0 {
+		return errors.Errorf("insert called with empty multi vector")
+	}
+
+	index.trackDimensionsOnce.Do(func() {
+		size := int32(len(vectors[0]))
+		atomic.StoreInt32(&index.dims, size)
+		if err := index.setDimensions(size); err != nil {
+			index.logger.WithError(err).Error("could not set dimensions")
+		}
+	})
+
+	dims := int(atomic.LoadInt32(&index.dims))
+	normalized := make([][]float32, len(vectors))
+	slice := make([]byte, len(vectors)*dims*4)
+	for i, vector := range vectors {
+		if len(vector) != dims {
+			return errors.Errorf("insert called with a vector of the wrong size")
+		}
+		normalized[i] = index.normalized(vector)
+		byteSliceFromFloat32Slice(normalized[i], slice[i*dims*4:])
+	}
+	index.storeVector(docID, slice)
+
+	centroid := common.MeanVector(normalized)
+	index.storeGenericVector(docID, byteSliceFromFloat32Slice(centroid, make([]byte, dims*4)),
+		index.getCentroidsBucketName())
+
+	atomic.AddUint64(&index.count, 1)
+	return nil
+}
+
+func (index *flat) AddMultiBatch(ctx context.Context, docIDs []uint64, vectors [][][]float32) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if len(docIDs) != len(vectors) {
+		return errors.Errorf("ids and vectors sizes does not match")
+	}
+	if len(docIDs) == 0 {
+		return errors.Errorf("insertBatch called with empty lists")
+	}
+	for i := range docIDs {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := index.AddMulti(ctx, docIDs[i], vectors[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (index *flat) DeleteMulti(ids ...uint64) error {
+	if !index.multivector {
+		return errors.Errorf("DeleteMulti called on non-multivector index")
+	}
+	if err := index.Delete(ids...); err != nil {
+		return err
+	}
+
+	bucket := index.store.Bucket(index.getCentroidsBucketName())
+	idBytes := make([]byte, 8)
+	for _, id := range ids {
+		binary.BigEndian.PutUint64(idBytes, id)
+		if err := bucket.Delete(idBytes); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (index *flat) SearchByMultiVector(ctx context.Context, vectors [][]float32, k int, allow helpers.AllowList) ([]uint64, []float32, error) {
+	if !index.multivector {
+		return nil, nil, errors.New("multivector search is not enabled")
+	}
+
+	query := make([][]float32, len(vectors))
+	for i, vector := range vectors {
+		query[i] = index.normalized(vector)
+	}
+
+	config := index.multivectorConfig.Load()
+	switch config.Aggregation {
+	case hnswent.MultivectorAggregationMeanPooling:
+		return index.searchMultiVector(k, allow, index.getCentroidsBucketName(),
+			index.createCentroidScoreCalc(query))
+	case hnswent.MultivectorAggregationCentroidRerank:
+		candidates, _, err := index.searchMultiVector(config.RerankLimit, allow, index.getCentroidsBucketName(),
+			index.createCentroidScoreCalc(query))
+		if err != nil {
+			return nil, nil, err
+		}
+		return index.rerankMultiVector(query, k, candidates)
+	case hnswent.MultivectorAggregationTopKSum:
+		return index.searchMultiVector(k, allow, index.getBucketName(),
+			index.createMultiVectorScoreCalc(func(doc [][]float32) float32 {
+				return common.TopKSumScore(index.distancerProvider.Step, query, doc, config.TopK)
+			}))
+	default:
+		return index.searchMultiVector(k, allow, index.getBucketName(),
+			index.createMultiVectorScoreCalc(func(doc [][]float32) float32 {
+				return common.MaxSimScore(index.distancerProvider.Step, query, doc)
+			}))
+	}
+}
+
+// searchMultiVector returns the k documents with the highest score ordered by
+// descending score. The scores are negated to find them with the same heap
+// that keeps the smallest distances of a regular search.
+func (index *flat) searchMultiVector(k int, allow helpers.AllowList, bucketName string,
+	scoreCalc distanceCalc,
+) ([]uint64, []float32, error) {
+	heap := index.pqResults.GetMax(k)
+	defer index.pqResults.Put(heap)
+
+	if err := index.findTopVectors(heap, allow, k,
+		index.store.Bucket(bucketName).Cursor,
+		func(vecAsBytes []byte) (float32, error) {
+			score, err := scoreCalc(vecAsBytes)
+			return -score, err
+		},
+	); err != nil {
+		return nil, nil, err
+	}
+
+	ids, scores := index.extractHeap(heap)
+	for i := range scores {
+		scores[i] = -scores[i]
+	}
+	return ids, scores, nil
+}
+
+func (index *flat) rerankMultiVector(query [][]float32, k int, candidates []uint64,
+) ([]uint64, []float32, error) {
+	heap := index.pqResults.GetMax(k)
+	defer index.pqResults.Put(heap)
+
+	for _, id := range candidates {
+		vecAsBytes, err := index.vectorById(id)
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(vecAsBytes) == 0 {
+			continue
+		}
+		doc := index.multiVectorFromBytes(vecAsBytes)
+		index.insertToHeap(heap, k, id, -common.MaxSimScore(index.distancerProvider.Step, query, doc))
+	}
+
+	ids, scores := index.extractHeap(heap)
+	for i := range scores {
+		scores[i] = -scores[i]
+	}
+	return ids, scores, nil
+}
+
+func (index *flat) createCentroidScoreCalc(query [][]float32) distanceCalc {
+	queryCentroid := common.MeanVector(query)
+	return func(vecAsBytes []byte) (float32, error) {
+		vecSlice := index.pool.float32SlicePool.Get(len(vecAsBytes) / 4)
+		defer index.pool.float32SlicePool.Put(vecSlice)
+
+		centroid := float32SliceFromByteSlice(vecAsBytes, vecSlice.slice)
+		return index.distancerProvider.Step(queryCentroid, centroid), nil
+	}
+}
+
+func (index *flat) createMultiVectorScoreCalc(score func(doc [][]float32) float32) distanceCalc {
+	return func(vecAsBytes []byte) (float32, error) {
+		return score(index.multiVectorFromBytes(vecAsBytes)), nil
+	}
+}
+
+func (index *flat) multiVectorFromBytes(vecAsBytes []byte) [][]float32 {
+	dims := int(atomic.LoadInt32(&index.dims))
+	flat := float32SliceFromByteSlice(vecAsBytes, make([]float32, len(vecAsBytes)/4))
+	doc := make([][]float32, len(flat)/dims)
+	for i := range doc {
+		doc[i] = flat[i*dims : (i+1)*dims]
+	}
+	return doc
+}
@@ -0,0 +1,99 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package flat
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/testinghelpers"
+	flatent "github.com/weaviate/weaviate/entities/vectorindex/flat"
+	hnswent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestFlatMultiVector(t *testing.T) {
+	ctx := context.Background()
+	vectorsSize := 500
+	queriesSize := 20
+	k := 10
+
+	vectors, queries := testinghelpers.RandomNormalizedMultiVecs(vectorsSize, queriesSize, 8, 32)
+
+	dirName := t.TempDir()
+	uc := flatent.NewDefaultUserConfig()
+	uc.Multivector.Enabled = true
+	index, err := New(Config{
+		ID:               uuid.New().String(),
+		DistanceProvider: distancer.NewDotProductProvider(),
+		RootPath:         dirName,
+	}, uc, testinghelpers.NewDummyStore(t))
+	require.Nil(t, err)
+	defer index.Shutdown(ctx)
+
+	require.True(t, index.Multivector())
+	docIDs := make([]uint64, vectorsSize)
+	for i := range docIDs {
+		docIDs[i] = uint64(i)
+	}
+	require.Nil(t, index.AddMultiBatch(ctx, docIDs, vectors))
+
+	t.Run("single vector functions are not supported", func(t *testing.T) {
+		_, _, err := index.SearchByVector(ctx, queries[0][0], k, nil)
+		require.NotNil(t, err)
+	})
+
+	aggregation := func(aggregation string, rerankLimit int, minRecall float32) testinghelpers.MultiVectorRecallCase {
+		return testinghelpers.MultiVectorRecallCase{
+			Name:      fmt.Sprintf("%s %d", aggregation, rerankLimit),
+			MinRecall: minRecall,
+			Configure: func(t *testing.T) {
+				uc.Multivector.Aggregation = aggregation
+				uc.Multivector.RerankLimit = max(rerankLimit, hnswent.DefaultMultivectorRerankLimit)
+				require.Nil(t, index.UpdateUserConfig(uc, func() {}))
+			},
+		}
+	}
+	// the documents are scored exactly, but the aggregations other than
+	// maxSim score them differently. Mean pooling hardly finds the documents
+	// with the best matches of the individual query vectors.
+	testinghelpers.MultiVectorRecall(t, vectors, queries, k,
+		func(query [][]float32, k int) ([]uint64, []float32, error) {
+			return index.SearchByMultiVector(ctx, query, k, nil)
+		},
+		[]testinghelpers.MultiVectorRecallCase{
+			aggregation(hnswent.MultivectorAggregationMaxSim, 0, 1),
+			aggregation(hnswent.MultivectorAggregationTopKSum, 0, 0.9),
+			aggregation(hnswent.MultivectorAggregationMeanPooling, 0, 0.05),
+			// only the documents with the best mean vectors are reranked
+			aggregation(hnswent.MultivectorAggregationCentroidRerank, 100, 0.6),
+			aggregation(hnswent.MultivectorAggregationCentroidRerank, vectorsSize, 1),
+		})
+
+	t.Run("deleted documents are not returned", func(t *testing.T) {
+		uc.Multivector.Aggregation = hnswent.MultivectorAggregationMaxSim
+		require.Nil(t, index.UpdateUserConfig(uc, func() {}))
+
+		ids, _, err := index.SearchByMultiVector(ctx, queries[0], k, nil)
+		require.Nil(t, err)
+		require.Nil(t, index.DeleteMulti(ids[0]))
+
+		after, _, err := index.SearchByMultiVector(ctx, queries[0], k, nil)
+		require.Nil(t, err)
+		assert.NotContains(t, after, ids[0])
+		assert.Equal(t, ids[1], after[0])
+	})
+}
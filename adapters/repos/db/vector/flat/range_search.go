@@ -0,0 +1,91 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package flat
+
+import (
+	"context"
+	"encoding/binary"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
+)
+
+// SearchByVectorRange calls fn for every vector within targetDistance of the
+// query vector, in the order of their ids, until fn returns false. Use
+// common.RangeSearch to order and limit the results.
+//
+// The uncompressed vectors are always stored next to the compressed ones, so
+// the distances are exact for every compression type.
+func (index *flat) SearchByVectorRange(ctx context.Context, vector []float32,
+	targetDistance float32, allow helpers.AllowList,
+	fn func(id uint64, dist float32) bool,
+) error {
+	if index.multivector {
+		return errors.New("range search is not supported on multivector indexes")
+	}
+
+	vector, err := common.TruncateVector(vector, index.matryoshkaDims)
+	if err != nil {
+		return err
+	}
+	vector = index.normalized(vector)
+	distanceCalc := index.createDistanceCalc(vector)
+
+	var key []byte
+	var v []byte
+	var id uint64
+	allowMax := uint64(0)
+
+	cursor := index.store.Bucket(index.getBucketName()).Cursor()
+	defer cursor.Close()
+
+	if allow != nil {
+		// nothing allowed, skip search
+		if allow.IsEmpty() {
+			return nil
+		}
+
+		allowMax = allow.Max()
+
+		idSlice := index.pool.byteSlicePool.Get(8)
+		binary.BigEndian.PutUint64(idSlice.slice, allow.Min())
+		key, v = cursor.Seek(idSlice.slice)
+		index.pool.byteSlicePool.Put(idSlice)
+	} else {
+		key, v = cursor.First()
+	}
+
+	// since keys are sorted, once key/id get greater than max allowed one
+	// further search can be stopped
+	for i := 0; key != nil && (allow == nil || id <= allowMax); key, v = cursor.Next() {
+		if i++; i%batchBlockSize == 0 {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+		}
+
+		id = binary.BigEndian.Uint64(key)
+		if allow != nil && !allow.Contains(id) {
+			continue
+		}
+
+		distance, err := distanceCalc(v)
+		if err != nil {
+			return err
+		}
+		if distance <= targetDistance && !fn(id, distance) {
+			return nil
+		}
+	}
+	return nil
+}
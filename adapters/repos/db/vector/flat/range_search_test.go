@@ -0,0 +1,114 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package flat
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/testinghelpers"
+	flatent "github.com/weaviate/weaviate/entities/vectorindex/flat"
+)
+
+func TestFlatSearchByVectorRange(t *testing.T) {
+	ctx := context.Background()
+	vectorsSize := 1000
+	targetDistance := float32(0.3)
+	provider := distancer.NewCosineDistanceProvider()
+
+	vectors, queries := testinghelpers.RandomVecs(vectorsSize, 5, 8)
+	testinghelpers.Normalize(vectors)
+	testinghelpers.Normalize(queries)
+
+	allow := helpers.NewAllowList()
+	for i := 0; i < vectorsSize; i += 3 {
+		allow.Insert(uint64(i))
+	}
+
+	tests := []struct {
+		name string
+		bq   bool
+	}{
+		{name: "uncompressed"},
+		{name: "bq", bq: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc := flatent.NewDefaultUserConfig()
+			uc.BQ.Enabled = tt.bq
+			index, err := New(Config{
+				ID:               uuid.New().String(),
+				DistanceProvider: provider,
+				RootPath:         t.TempDir(),
+			}, uc, testinghelpers.NewDummyStore(t))
+			require.Nil(t, err)
+			defer index.Shutdown(ctx)
+
+			for i, vector := range vectors {
+				require.Nil(t, index.Add(ctx, uint64(i), vector))
+			}
+
+			for _, allowList := range []helpers.AllowList{nil, allow} {
+				for _, query := range queries {
+					expected := map[uint64]float32{}
+					for i, vector := range vectors {
+						if allowList != nil && !allowList.Contains(uint64(i)) {
+							continue
+						}
+						dist, err := provider.SingleDist(query, vector)
+						require.Nil(t, err)
+						if dist <= targetDistance {
+							expected[uint64(i)] = dist
+						}
+					}
+
+					found := map[uint64]float32{}
+					err := index.SearchByVectorRange(ctx, query, targetDistance, allowList,
+						func(id uint64, dist float32) bool {
+							found[id] = dist
+							return true
+						})
+					require.Nil(t, err)
+					require.Len(t, found, len(expected))
+					for id, dist := range expected {
+						assert.InDelta(t, dist, found[id], 1e-5)
+					}
+
+					// the closest results match a search by vector with the same limit
+					ids, dists, _, err := common.RangeSearch(func(fn func(id uint64, dist float32) bool) error {
+						return index.SearchByVectorRange(ctx, query, targetDistance, allowList, fn)
+					}, 5, nil)
+					require.Nil(t, err)
+					if len(expected) >= 5 && !tt.bq {
+						knnIDs, knnDists, err := index.SearchByVector(ctx, query, 5, allowList)
+						require.Nil(t, err)
+						assert.Equal(t, knnIDs, ids)
+						assert.InDeltaSlice(t, knnDists, dists, 1e-6)
+					}
+				}
+			}
+
+			err = index.SearchByVectorRange(ctx, queries[0], targetDistance, helpers.NewAllowList(),
+				func(id uint64, dist float32) bool {
+					t.Fatalf("unexpected result %d for empty allow list", id)
+					return false
+				})
+			require.Nil(t, err)
+		})
+	}
+}
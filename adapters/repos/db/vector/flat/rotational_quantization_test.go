@@ -0,0 +1,131 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package flat
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/testinghelpers"
+	flatent "github.com/weaviate/weaviate/entities/vectorindex/flat"
+)
+
+func TestFlatRQ(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+	store := testinghelpers.NewDummyStore(t)
+	defer store.Shutdown(context.Background())
+	rootPath := t.TempDir()
+
+	dimensions := 96
+	vectorsSize := 2000
+	queriesSize := 50
+	k := 10
+	vectors, queries := testinghelpers.RandomVecs(vectorsSize, queriesSize, dimensions)
+	distancer := distancer.NewL2SquaredProvider()
+
+	truths := make([][]uint64, queriesSize)
+	for i := range queries {
+		truths[i], _ = testinghelpers.BruteForce(logger, vectors, queries[i], k, distanceWrapper(distancer))
+	}
+
+	config := flatent.UserConfig{}
+	config.SetDefaults()
+	config.RQ.Enabled = true
+	config.RQ.RescoreLimit = 100
+
+	newIndex := func() *flat {
+		index, err := New(Config{
+			ID:               "rq",
+			RootPath:         rootPath,
+			DistanceProvider: distancer,
+		}, config, store)
+		require.Nil(t, err)
+		return index
+	}
+
+	recall := func(index *flat) float32 {
+		var relevant uint64
+		for i := range queries {
+			results, _, err := index.SearchByVector(ctx, queries[i], k, nil)
+			require.Nil(t, err)
+			relevant += testinghelpers.MatchesInLists(truths[i], results)
+		}
+		return float32(relevant) / float32(queriesSize*k)
+	}
+
+	index := newIndex()
+
+	t.Run("search on empty index", func(t *testing.T) {
+		ids, _, err := index.SearchByVector(ctx, queries[0], k, nil)
+		require.Nil(t, err)
+		assert.Len(t, ids, 0)
+	})
+
+	var recallBeforeRestart float32
+	t.Run("recall", func(t *testing.T) {
+		for id, vector := range vectors {
+			require.Nil(t, index.Add(ctx, uint64(id), vector))
+		}
+		require.NotNil(t, index.rq)
+
+		recallBeforeRestart = recall(index)
+		assert.Greater(t, recallBeforeRestart, float32(0.9))
+	})
+
+	t.Run("skipping candidates keeps the rescored distances exact", func(t *testing.T) {
+		for _, query := range queries {
+			ids, dists, err := index.SearchByVector(ctx, query, k, nil)
+			require.Nil(t, err)
+			require.Len(t, ids, k)
+			for i, id := range ids {
+				expected, err := distancer.SingleDist(query, vectors[id])
+				require.Nil(t, err)
+				assert.InDelta(t, expected, dists[i], 1e-4)
+				if i > 0 {
+					assert.LessOrEqual(t, dists[i-1], dists[i])
+				}
+			}
+		}
+	})
+
+	t.Run("recall after restart", func(t *testing.T) {
+		seed, err := index.fetchRQSeed()
+		require.Nil(t, err)
+		require.NotZero(t, seed)
+		index.Shutdown(ctx)
+
+		index = newIndex()
+		require.NotNil(t, index.rq)
+		restoredSeed, err := index.fetchRQSeed()
+		require.Nil(t, err)
+		assert.Equal(t, seed, restoredSeed)
+		assert.Equal(t, recallBeforeRestart, recall(index))
+	})
+
+	t.Run("deleted vectors are not returned", func(t *testing.T) {
+		ids, _, err := index.SearchByVector(ctx, vectors[0], 1, nil)
+		require.Nil(t, err)
+		require.Equal(t, []uint64{0}, ids)
+
+		require.Nil(t, index.Delete(0))
+		assert.False(t, index.ContainsNode(0))
+
+		ids, _, err = index.SearchByVector(ctx, vectors[0], 1, nil)
+		require.Nil(t, err)
+		assert.NotContains(t, ids, uint64(0))
+	})
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/compressionhelpers"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/commitlog"
+	"github.com/weaviate/weaviate/entities/backup"
 	"github.com/weaviate/weaviate/entities/cyclemanager"
 	"github.com/weaviate/weaviate/entities/errorcompounder"
 	"github.com/weaviate/weaviate/usecases/memwatch"
@@ -72,6 +73,9 @@ func NewCommitLogger(rootPath, name string, logger logrus.FieldLogger,
 		return strings.Join(elems, "/")
 	}
 	l.commitLogger = commitlog.NewLoggerWithFile(fd)
+	if err := l.openLogTimes(filepath.Base(fd.Name())); err != nil {
+		return nil, err
+	}
 	l.switchLogsCallbackCtrl = maintenanceCallbacks.Register(id("switch_logs"), l.startSwitchLogs)
 	l.condenseLogsCallbackCtrl = maintenanceCallbacks.Register(id("condense_logs"), l.startCombineAndCondenseLogs)
 
@@ -260,6 +264,45 @@ type hnswCommitLogger struct {
 	condenseLogsCallbackCtrl cyclemanager.CycleCallbackCtrl
 
 	allocChecker memwatch.AllocChecker
+	archiver     CommitLogArchiver
+	// records the time of every entry of the current log if it is archived
+	times *backup.LogTimes
+}
+
+// CommitLogArchiver is handed every commit log which has been switched and
+// will not be written to anymore. The log itself must stay in place, as it
+// is still needed by the index.
+type CommitLogArchiver interface {
+	ArchiveCommitLog(path string)
+	// LogTimes opens the record of the times the entries of the commit log at
+	// path are written at, which is handed over along with the log
+	LogTimes(path string) (*backup.LogTimes, error)
+}
+
+// openLogTimes starts recording the times of the entries of the current log
+// if it is archived
+func (l *hnswCommitLogger) openLogTimes(fileName string) error {
+	if l.archiver == nil {
+		return nil
+	}
+	times, err := l.archiver.LogTimes(commitLogFileName(l.rootPath, l.id, fileName))
+	if err != nil {
+		return err
+	}
+	l.times = times
+	return nil
+}
+
+// recordTime records the time of the entry just written to the current log
+func (l *hnswCommitLogger) recordTime() error {
+	if l.times == nil {
+		return nil
+	}
+	end, err := l.commitLogger.Offset()
+	if err != nil {
+		return err
+	}
+	return l.times.Record(end, time.Now())
 }
 
 type HnswCommitType uint8 // 256 options, plenty of room for future extensions
@@ -278,6 +321,8 @@ const (
 	AddLinksAtLevel   // added in v1.8.0-rc.1, see https://github.com/weaviate/weaviate/issues/1705
 	AddPQ
 	AddSQ
+	AddRQ
+	AddBQ
 )
 
 func (t HnswCommitType) String() string {
@@ -308,6 +353,10 @@ func (t HnswCommitType) String() string {
 		return "AddProductQuantizer"
 	case AddSQ:
 		return "AddScalarQuantizer"
+	case AddRQ:
+		return "AddRotationalQuantizer"
+	case AddBQ:
+		return "AddBinaryQuantizer"
 	}
 	return "unknown commit type"
 }
@@ -320,14 +369,40 @@ func (l *hnswCommitLogger) AddPQCompression(data compressionhelpers.PQData) erro
 	l.Lock()
 	defer l.Unlock()
 
-	return l.commitLogger.AddPQCompression(data)
+	if err := l.commitLogger.AddPQCompression(data); err != nil {
+		return err
+	}
+	return l.recordTime()
 }
 
 func (l *hnswCommitLogger) AddSQCompression(data compressionhelpers.SQData) error {
 	l.Lock()
 	defer l.Unlock()
 
-	return l.commitLogger.AddSQCompression(data)
+	if err := l.commitLogger.AddSQCompression(data); err != nil {
+		return err
+	}
+	return l.recordTime()
+}
+
+func (l *hnswCommitLogger) AddRQCompression(data compressionhelpers.RQData) error {
+	l.Lock()
+	defer l.Unlock()
+
+	if err := l.commitLogger.AddRQCompression(data); err != nil {
+		return err
+	}
+	return l.recordTime()
+}
+
+func (l *hnswCommitLogger) AddBQCompression() error {
+	l.Lock()
+	defer l.Unlock()
+
+	if err := l.commitLogger.AddBQCompression(); err != nil {
+		return err
+	}
+	return l.recordTime()
 }
 
 // AddNode adds an empty node
@@ -335,21 +410,30 @@ func (l *hnswCommitLogger) AddNode(node *vertex) error {
 	l.Lock()
 	defer l.Unlock()
 
-	return l.commitLogger.AddNode(node.id, node.level)
+	if err := l.commitLogger.AddNode(node.id, node.level); err != nil {
+		return err
+	}
+	return l.recordTime()
 }
 
 func (l *hnswCommitLogger) SetEntryPointWithMaxLayer(id uint64, level int) error {
 	l.Lock()
 	defer l.Unlock()
 
-	return l.commitLogger.SetEntryPointWithMaxLayer(id, level)
+	if err := l.commitLogger.SetEntryPointWithMaxLayer(id, level); err != nil {
+		return err
+	}
+	return l.recordTime()
 }
 
 func (l *hnswCommitLogger) ReplaceLinksAtLevel(nodeid uint64, level int, targets []uint64) error {
 	l.Lock()
 	defer l.Unlock()
 
-	return l.commitLogger.ReplaceLinksAtLevel(nodeid, level, targets)
+	if err := l.commitLogger.ReplaceLinksAtLevel(nodeid, level, targets); err != nil {
+		return err
+	}
+	return l.recordTime()
 }
 
 func (l *hnswCommitLogger) AddLinkAtLevel(nodeid uint64, level int,
@@ -358,49 +442,70 @@ func (l *hnswCommitLogger) AddLinkAtLevel(nodeid uint64, level int,
 	l.Lock()
 	defer l.Unlock()
 
-	return l.commitLogger.AddLinkAtLevel(nodeid, level, target)
+	if err := l.commitLogger.AddLinkAtLevel(nodeid, level, target); err != nil {
+		return err
+	}
+	return l.recordTime()
 }
 
 func (l *hnswCommitLogger) AddTombstone(nodeid uint64) error {
 	l.Lock()
 	defer l.Unlock()
 
-	return l.commitLogger.AddTombstone(nodeid)
+	if err := l.commitLogger.AddTombstone(nodeid); err != nil {
+		return err
+	}
+	return l.recordTime()
 }
 
 func (l *hnswCommitLogger) RemoveTombstone(nodeid uint64) error {
 	l.Lock()
 	defer l.Unlock()
 
-	return l.commitLogger.RemoveTombstone(nodeid)
+	if err := l.commitLogger.RemoveTombstone(nodeid); err != nil {
+		return err
+	}
+	return l.recordTime()
 }
 
 func (l *hnswCommitLogger) ClearLinks(nodeid uint64) error {
 	l.Lock()
 	defer l.Unlock()
 
-	return l.commitLogger.ClearLinks(nodeid)
+	if err := l.commitLogger.ClearLinks(nodeid); err != nil {
+		return err
+	}
+	return l.recordTime()
 }
 
 func (l *hnswCommitLogger) ClearLinksAtLevel(nodeid uint64, level uint16) error {
 	l.Lock()
 	defer l.Unlock()
 
-	return l.commitLogger.ClearLinksAtLevel(nodeid, level)
+	if err := l.commitLogger.ClearLinksAtLevel(nodeid, level); err != nil {
+		return err
+	}
+	return l.recordTime()
 }
 
 func (l *hnswCommitLogger) DeleteNode(nodeid uint64) error {
 	l.Lock()
 	defer l.Unlock()
 
-	return l.commitLogger.DeleteNode(nodeid)
+	if err := l.commitLogger.DeleteNode(nodeid); err != nil {
+		return err
+	}
+	return l.recordTime()
 }
 
 func (l *hnswCommitLogger) Reset() error {
 	l.Lock()
 	defer l.Unlock()
 
-	return l.commitLogger.Reset()
+	if err := l.commitLogger.Reset(); err != nil {
+		return err
+	}
+	return l.recordTime()
 }
 
 // Shutdown waits for ongoing maintenance processes to stop, then cancels their
@@ -464,6 +569,10 @@ func (l *hnswCommitLogger) switchCommitLogs(force bool) (bool, error) {
 	if size <= l.maxSizeIndividual && !force {
 		return false, nil
 	}
+	if size == 0 && l.archiver != nil {
+		// nothing to hand over, switching would only add empty logs
+		return false, nil
+	}
 
 	oldFileName, err := l.commitLogger.FileName()
 	if err != nil {
@@ -473,6 +582,16 @@ func (l *hnswCommitLogger) switchCommitLogs(force bool) (bool, error) {
 	if err := l.commitLogger.Close(); err != nil {
 		return true, err
 	}
+	if l.times != nil {
+		if err := l.times.Close(); err != nil {
+			return true, err
+		}
+		l.times = nil
+	}
+
+	if l.archiver != nil {
+		l.archiver.ArchiveCommitLog(commitLogFileName(l.rootPath, l.id, oldFileName))
+	}
 
 	// this is a new commit log, initialize with the current time stamp
 	fileName := fmt.Sprintf("%d", time.Now().Unix())
@@ -500,6 +619,9 @@ func (l *hnswCommitLogger) switchCommitLogs(force bool) (bool, error) {
 	}
 
 	l.commitLogger = commitlog.NewLoggerWithFile(fd)
+	if err := l.openLogTimes(fileName); err != nil {
+		return true, err
+	}
 
 	return true, nil
 }
@@ -589,5 +711,11 @@ func (l *hnswCommitLogger) Flush() error {
 	l.Lock()
 	defer l.Unlock()
 
-	return l.commitLogger.Flush()
+	if err := l.commitLogger.Flush(); err != nil {
+		return err
+	}
+	if l.times != nil {
+		return l.times.Flush()
+	}
+	return nil
 }
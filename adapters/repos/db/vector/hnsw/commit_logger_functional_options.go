@@ -42,3 +42,10 @@ func WithCondensor(condensor Condensor) CommitlogOption {
 		return nil
 	}
 }
+
+func WithCommitlogArchiver(archiver CommitLogArchiver) CommitlogOption {
+	return func(l *hnswCommitLogger) error {
+		l.archiver = archiver
+		return nil
+	}
+}
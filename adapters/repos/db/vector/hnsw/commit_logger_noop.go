@@ -33,6 +33,14 @@ func (n *NoopCommitLogger) AddSQCompression(data compressionhelpers.SQData) erro
 	return nil
 }
 
+func (n *NoopCommitLogger) AddRQCompression(data compressionhelpers.RQData) error {
+	return nil
+}
+
+func (n *NoopCommitLogger) AddBQCompression() error {
+	return nil
+}
+
 func (n *NoopCommitLogger) AddNode(node *vertex) error {
 	return nil
 }
@@ -12,16 +12,19 @@
 package hnsw
 
 import (
+	"bufio"
 	"context"
 	"fmt"
 	_ "fmt"
 	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
 	"github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/backup"
 	"github.com/weaviate/weaviate/entities/cyclemanager"
 )
 
@@ -195,3 +198,81 @@ func (f fakeAllocChecker) CheckMappingAndReserve(numberMappings int64, reservati
 	return nil
 }
 func (f fakeAllocChecker) Refresh(updateMappings bool) {}
+
+type fakeCommitLogArchiver struct {
+	timesDir string
+	archived []string
+}
+
+func (a *fakeCommitLogArchiver) ArchiveCommitLog(path string) {
+	a.archived = append(a.archived, path)
+}
+
+func (a *fakeCommitLogArchiver) LogTimes(path string) (*backup.LogTimes, error) {
+	return backup.OpenLogTimes(a.timesPath(path))
+}
+
+func (a *fakeCommitLogArchiver) timesPath(path string) string {
+	return filepath.Join(a.timesDir, filepath.Base(path)+backup.LogTimesSuffix)
+}
+
+func TestCommitLoggerArchivesSwitchedLogs(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	rootPath := t.TempDir()
+	archiver := &fakeCommitLogArchiver{timesDir: t.TempDir()}
+
+	cl, err := NewCommitLogger(rootPath, "main", logger, cyclemanager.NewCallbackGroupNoop(),
+		WithCommitlogArchiver(archiver))
+	require.Nil(t, err)
+	defer cl.Shutdown(context.Background())
+
+	require.Nil(t, cl.AddNode(&vertex{id: 1}))
+	require.Nil(t, cl.Flush())
+	before, err := cl.commitLogger.FileName()
+	require.Nil(t, err)
+
+	time.Sleep(time.Second) // log files are named by seconds
+	require.Nil(t, cl.SwitchCommitLogs(true))
+
+	require.Equal(t, []string{commitLogFileName(rootPath, "main", before)}, archiver.archived)
+	_, err = os.Stat(archiver.archived[0])
+	assert.Nil(t, err, "archived log must stay in place")
+}
+
+func TestCommitLoggerArchivedLogIsCutAtPointInTime(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	rootPath := t.TempDir()
+	archiver := &fakeCommitLogArchiver{timesDir: t.TempDir()}
+
+	cl, err := NewCommitLogger(rootPath, "main", logger, cyclemanager.NewCallbackGroupNoop(),
+		WithCommitlogArchiver(archiver))
+	require.Nil(t, err)
+	defer cl.Shutdown(context.Background())
+
+	require.Nil(t, cl.AddNode(&vertex{id: 1}))
+	time.Sleep(10 * time.Millisecond)
+	pointInTime := time.Now()
+	time.Sleep(10 * time.Millisecond)
+	require.Nil(t, cl.AddNode(&vertex{id: 2}))
+	require.Nil(t, cl.Flush())
+
+	time.Sleep(time.Second) // log files are named by seconds
+	require.Nil(t, cl.SwitchCommitLogs(true))
+	require.Len(t, archiver.archived, 1)
+
+	// cut a copy, as the archived log stays in use by the index
+	replay := filepath.Join(t.TempDir(), "replay")
+	bytes, err := os.ReadFile(archiver.archived[0])
+	require.Nil(t, err)
+	require.Nil(t, os.WriteFile(replay, bytes, 0o666))
+	require.Nil(t, backup.CutLog(replay, archiver.timesPath(archiver.archived[0]), pointInTime, time.Now()))
+
+	fd, err := os.Open(replay)
+	require.Nil(t, err)
+	defer fd.Close()
+	res, _, err := NewDeserializer(logger).Do(bufio.NewReader(fd), &DeserializationResult{}, false)
+	require.Nil(t, err)
+	require.Greater(t, len(res.Nodes), 2)
+	assert.NotNil(t, res.Nodes[1])
+	assert.Nil(t, res.Nodes[2], "node added after the point in time must not be replayed")
+}
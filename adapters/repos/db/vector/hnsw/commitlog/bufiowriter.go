@@ -32,6 +32,8 @@ type bufWriter struct {
 	buf []byte
 	n   int
 	wr  *os.File
+	// written is the number of bytes accepted since the writer was created
+	written int64
 }
 
 // NewWriterSize returns a new Writer whose buffer has at least the specified
@@ -113,11 +115,13 @@ func (b *bufWriter) Write(p []byte) (nn int, err error) {
 		p = p[n:]
 	}
 	if b.err != nil {
+		b.written += int64(nn)
 		return nn, b.err
 	}
 	n := copy(b.buf[b.n:], p)
 	b.n += n
 	nn += n
+	b.written += int64(nn)
 	return nn, nil
 }
 
@@ -131,6 +135,7 @@ func (b *bufWriter) WriteByte(c byte) error {
 	}
 	b.buf[b.n] = c
 	b.n++
+	b.written++
 	return nil
 }
 
@@ -160,6 +165,7 @@ func (b *bufWriter) WriteRune(r rune) (size int, err error) {
 	}
 	size = utf8.EncodeRune(b.buf[b.n:], r)
 	b.n += size
+	b.written += int64(size)
 	return size, nil
 }
 
@@ -177,10 +183,12 @@ func (b *bufWriter) WriteString(s string) (int, error) {
 		b.Flush()
 	}
 	if b.err != nil {
+		b.written += int64(nn)
 		return nn, b.err
 	}
 	n := copy(b.buf[b.n:], s)
 	b.n += n
 	nn += n
+	b.written += int64(nn)
 	return nn, nil
 }
@@ -23,6 +23,11 @@ import (
 type Logger struct {
 	file *os.File
 	bufw *bufWriter
+
+	// size of the file before the writer was created, set on the first call
+	// of Offset
+	base      int64
+	baseKnown bool
 }
 
 // TODO: these are duplicates with the hnsw package, unify them
@@ -43,6 +48,8 @@ const (
 	AddLinksAtLevel   // added in v1.8.0-rc.1, see https://github.com/weaviate/weaviate/issues/1705
 	AddPQ
 	AddSQ
+	AddRQ
+	AddBQ
 )
 
 func NewLogger(fileName string) *Logger {
@@ -107,6 +114,23 @@ func (l *Logger) AddSQCompression(data compressionhelpers.SQData) error {
 	return err
 }
 
+func (l *Logger) AddRQCompression(data compressionhelpers.RQData) error {
+	toWrite := make([]byte, 12)
+	toWrite[0] = byte(AddRQ)
+	binary.LittleEndian.PutUint16(toWrite[1:], data.Dimensions)
+	toWrite[3] = data.Bits
+	binary.LittleEndian.PutUint64(toWrite[4:], data.Seed)
+	_, err := l.bufw.Write(toWrite)
+	return err
+}
+
+// AddBQCompression only marks the switch to binary quantization, it has no
+// parameters to persist
+func (l *Logger) AddBQCompression() error {
+	_, err := l.bufw.Write([]byte{byte(AddBQ)})
+	return err
+}
+
 func (l *Logger) AddLinkAtLevel(id uint64, level int, target uint64) error {
 	toWrite := make([]byte, 19)
 	toWrite[0] = byte(AddLinkAtLevel)
@@ -236,6 +260,20 @@ func (l *Logger) FileSize() (int64, error) {
 	return i.Size(), nil
 }
 
+// Offset is the size of the log including the buffered writes, i.e. the
+// offset the next entry is written at
+func (l *Logger) Offset() (int64, error) {
+	if !l.baseKnown {
+		size, err := l.FileSize()
+		if err != nil {
+			return -1, err
+		}
+		l.base = size + int64(l.bufw.Buffered()) - l.bufw.written
+		l.baseKnown = true
+	}
+	return l.base + l.bufw.written, nil
+}
+
 func (l *Logger) FileName() (string, error) {
 	i, err := l.file.Stat()
 	if err != nil {
@@ -15,6 +15,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sync/atomic"
 
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/compressionhelpers"
@@ -23,85 +24,46 @@ import (
 )
 
 func (h *hnsw) compress(cfg ent.UserConfig) error {
-	if !cfg.PQ.Enabled && !cfg.BQ.Enabled && !cfg.SQ.Enabled {
+	if !cfg.PQ.Enabled && !cfg.BQ.Enabled && !cfg.SQ.Enabled && !cfg.RQ.Enabled {
 		return nil
 	}
 
 	h.compressActionLock.Lock()
 	defer h.compressActionLock.Unlock()
 	data := h.cache.All()
+	var cleanData [][]float32
 	if cfg.PQ.Enabled || cfg.SQ.Enabled {
 		if h.isEmpty() {
 			return errors.New("compress command cannot be executed before inserting some data")
 		}
-		cleanData := make([][]float32, 0, len(data))
-		sampler := common.NewSparseFisherYatesIterator(len(data))
-		for !sampler.IsDone() {
-			// Sparse Fisher Yates sampling algorithm to choose random element
-			sampledIndex := sampler.Next()
-			if sampledIndex == nil {
-				break
-			}
-			// Rather than just taking the cache dump at face value, let's explicitly
-			// request the vectors. Otherwise we would miss any vector that's currently
-			// not in the cache, for example because the cache is not hot yet after a
-			// restart.
-			p, err := h.cache.Get(context.Background(), uint64(*sampledIndex))
-			if err != nil {
-				var e storobj.ErrNotFound
-				if errors.As(err, &e) {
-					// already deleted, ignore
-					continue
-				} else {
-					return fmt.Errorf("unexpected error obtaining vectors for fitting: %w", err)
-				}
-			}
-
-			if p == nil {
-				// already deleted, ignore
-				continue
-			}
-
-			cleanData = append(cleanData, p)
-			if len(cleanData) >= cfg.PQ.TrainingLimit {
-				break
-			}
-		}
-		if cfg.PQ.Enabled {
-			dims := int(h.dims)
-
-			if cfg.PQ.Segments <= 0 {
-				cfg.PQ.Segments = common.CalculateOptimalSegments(dims)
-				h.pqConfig.Segments = cfg.PQ.Segments
-			}
-
-			var err error
-			h.compressor, err = compressionhelpers.NewHNSWPQCompressor(
-				cfg.PQ, h.distancerProvider, dims, 1e12, h.logger, cleanData, h.store,
-				h.allocChecker)
-			if err != nil {
-				h.pqConfig.Enabled = false
-				return fmt.Errorf("compressing vectors: %w", err)
-			}
-		} else if cfg.SQ.Enabled {
-			var err error
-			h.compressor, err = compressionhelpers.NewHNSWSQCompressor(
-				h.distancerProvider, 1e12, h.logger, cleanData, h.store,
-				h.allocChecker)
-			if err != nil {
-				h.sqConfig.Enabled = false
-				return fmt.Errorf("compressing vectors: %w", err)
-			}
-		}
-		h.compressor.PersistCompression(h.commitLog)
-	} else {
+		// Rather than just taking the cache dump at face value, let's explicitly
+		// request the vectors. Otherwise we would miss any vector that's currently
+		// not in the cache, for example because the cache is not hot yet after a
+		// restart.
 		var err error
-		h.compressor, err = compressionhelpers.NewBQCompressor(
-			h.distancerProvider, 1e12, h.logger, h.store, h.allocChecker)
+		cleanData, err = h.sampleTrainingData(len(data), cfg.PQ.TrainingLimit, h.cache.Get)
 		if err != nil {
 			return err
 		}
 	}
+	if cfg.PQ.Enabled && cfg.PQ.Segments <= 0 {
+		cfg.PQ.Segments = common.CalculateOptimalSegments(int(h.dims))
+		h.pqConfig.Segments = cfg.PQ.Segments
+	}
+
+	compressor, err := h.newCompressor(cfg, cleanData)
+	if err != nil {
+		switch {
+		case cfg.PQ.Enabled:
+			h.pqConfig.Enabled = false
+		case cfg.SQ.Enabled:
+			h.sqConfig.Enabled = false
+		}
+		return fmt.Errorf("compressing vectors: %w", err)
+	}
+	h.compressor = compressor
+	h.compressor.PersistCompression(h.commitLog)
+
 	compressionhelpers.Concurrently(h.logger, uint64(len(data)),
 		func(index uint64) {
 			if data[index] == nil {
@@ -114,3 +76,68 @@ func (h *hnsw) compress(cfg ent.UserConfig) error {
 	h.cache.Drop()
 	return nil
 }
+
+// sampleTrainingData picks up to limit random vectors out of the first size
+// ids to fit a quantizer on
+func (h *hnsw) sampleTrainingData(size, limit int,
+	vectorForID common.VectorForID[float32],
+) ([][]float32, error) {
+	data := make([][]float32, 0, min(size, limit))
+	sampler := common.NewSparseFisherYatesIterator(size)
+	for !sampler.IsDone() {
+		// Sparse Fisher Yates sampling algorithm to choose random element
+		sampledIndex := sampler.Next()
+		if sampledIndex == nil {
+			break
+		}
+		p, err := vectorForID(context.Background(), uint64(*sampledIndex))
+		if err != nil {
+			var e storobj.ErrNotFound
+			if errors.As(err, &e) {
+				// already deleted, ignore
+				continue
+			} else {
+				return nil, fmt.Errorf("unexpected error obtaining vectors for fitting: %w", err)
+			}
+		}
+
+		if p == nil {
+			// already deleted, ignore
+			continue
+		}
+
+		data = append(data, p)
+		if len(data) >= limit {
+			break
+		}
+	}
+	return data, nil
+}
+
+// newCompressor creates the compressor for the compression enabled in cfg.
+// PQ and SQ are fit to the given training data, RQ and BQ need none.
+func (h *hnsw) newCompressor(cfg ent.UserConfig, data [][]float32,
+) (compressionhelpers.VectorCompressor, error) {
+	dims := int(atomic.LoadInt32(&h.dims))
+	switch {
+	case cfg.PQ.Enabled:
+		return compressionhelpers.NewHNSWPQCompressor(
+			cfg.PQ, h.distancerProvider, dims, 1e12, h.logger, data, h.store,
+			h.allocChecker)
+	case cfg.SQ.Enabled:
+		return compressionhelpers.NewHNSWSQCompressor(
+			h.distancerProvider, 1e12, h.logger, data, h.store,
+			h.allocChecker)
+	case cfg.RQ.Enabled:
+		// rotational quantization needs no training data, only the dimensions
+		if dims == 0 {
+			return nil, errors.New("compress command cannot be executed before inserting some data")
+		}
+		return compressionhelpers.NewHNSWRQCompressor(
+			h.distancerProvider, dims, cfg.RQ.Bits, 1e12, h.logger, h.store,
+			h.allocChecker)
+	default:
+		return compressionhelpers.NewBQCompressor(
+			h.distancerProvider, 1e12, h.logger, h.store, h.allocChecker)
+	}
+}
@@ -65,6 +65,14 @@ func (c *MemoryCondensor) Do(fileName string) error {
 			if err := c.AddSQCompression(*res.CompressionSQData); err != nil {
 				return fmt.Errorf("write sq data: %w", err)
 			}
+		} else if res.CompressionRQData != nil {
+			if err := c.AddRQCompression(*res.CompressionRQData); err != nil {
+				return fmt.Errorf("write rq data: %w", err)
+			}
+		} else if res.CompressionBQ {
+			if err := c.AddBQCompression(); err != nil {
+				return fmt.Errorf("write bq data: %w", err)
+			}
 		} else {
 			return errors.Wrap(err, "unavailable compression data")
 		}
@@ -317,6 +325,21 @@ func (c *MemoryCondensor) AddSQCompression(data compressionhelpers.SQData) error
 	return err
 }
 
+func (c *MemoryCondensor) AddRQCompression(data compressionhelpers.RQData) error {
+	toWrite := make([]byte, 12)
+	toWrite[0] = byte(AddRQ)
+	binary.LittleEndian.PutUint16(toWrite[1:], data.Dimensions)
+	toWrite[3] = data.Bits
+	binary.LittleEndian.PutUint64(toWrite[4:], data.Seed)
+	_, err := c.newLog.Write(toWrite)
+	return err
+}
+
+func (c *MemoryCondensor) AddBQCompression() error {
+	_, err := c.newLog.Write([]byte{byte(AddBQ)})
+	return err
+}
+
 func NewMemoryCondensor(logger logrus.FieldLogger) *MemoryCondensor {
 	return &MemoryCondensor{logger: logger}
 }
@@ -60,6 +60,10 @@ func ValidateUserConfigUpdate(initial, updated config.VectorIndexConfig) error {
 			name:     "multivector enabled",
 			accessor: func(c ent.UserConfig) interface{} { return c.Multivector.Enabled },
 		},
+		{
+			name:     "matryoshka dimensions",
+			accessor: func(c ent.UserConfig) interface{} { return c.Matryoshka.Dimensions },
+		},
 	}
 
 	for _, u := range immutableFields {
@@ -105,15 +109,28 @@ func (h *hnsw) UpdateUserConfig(updated config.VectorIndexConfig, callback func(
 	atomic.StoreInt64(&h.flatSearchCutoff, int64(parsed.FlatSearchCutoff))
 
 	h.acornSearch.Store(parsed.FilterStrategy == ent.FilterStrategyAcorn)
+	h.autoFilterStrategy.Store(parsed.FilterStrategy == ent.FilterStrategyAuto)
+
+	// the aggregation only affects how search results are scored, so it can
+	// be changed at any time
+	multivectorConfig := parsed.Multivector
+	h.multivectorConfig.Store(&multivectorConfig)
 
-	if !parsed.PQ.Enabled && !parsed.BQ.Enabled && !parsed.SQ.Enabled {
+	if !parsed.PQ.Enabled && !parsed.BQ.Enabled && !parsed.SQ.Enabled && !parsed.RQ.Enabled {
 		callback()
 		return nil
 	}
 
+	if h.compressed.Load() && compressionChanged(h.compressionConfig(), parsed) {
+		// the new compression config only takes effect once all vectors are
+		// encoded with it, the requantization will fire the callback then
+		return h.requantize(parsed, parsed.VectorCacheMaxObjects, callback)
+	}
+
 	h.pqConfig = parsed.PQ
 	h.sqConfig = parsed.SQ
 	h.bqConfig = parsed.BQ
+	h.rqConfig = parsed.RQ
 	if asyncEnabled() {
 		callback()
 		return nil
@@ -150,12 +167,7 @@ func (h *hnsw) Upgrade(callback func()) error {
 func (h *hnsw) compressThenCallback(callback func()) {
 	defer callback()
 
-	uc := ent.UserConfig{
-		PQ: h.pqConfig,
-		BQ: h.bqConfig,
-		SQ: h.sqConfig,
-	}
-	if err := h.compress(uc); err != nil {
+	if err := h.compress(h.compressionConfig()); err != nil {
 		h.logger.Error(err)
 		return
 	}
@@ -111,6 +111,7 @@ func (h *hnsw) DeleteMulti(docIDs ...uint64) error {
 		}
 		h.Lock()
 		delete(h.docIDVectors, docID)
+		delete(h.docIDCentroids, docID)
 		h.Unlock()
 
 	}
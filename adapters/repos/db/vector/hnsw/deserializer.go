@@ -39,6 +39,8 @@ type DeserializationResult struct {
 	EntrypointChanged bool
 	CompressionPQData *compressionhelpers.PQData
 	CompressionSQData *compressionhelpers.SQData
+	CompressionRQData *compressionhelpers.RQData
+	CompressionBQ     bool
 	Compressed        bool
 
 	// If there is no entry for the links at a level to be replaced, we must
@@ -150,6 +152,11 @@ func (d *Deserializer) Do(fd *bufio.Reader, initialState *DeserializationResult,
 		case AddSQ:
 			err = d.ReadSQ(fd, out)
 			readThisRound = 10
+		case AddRQ:
+			err = d.ReadRQ(fd, out)
+			readThisRound = 11
+		case AddBQ:
+			d.ReadBQ(out)
 		default:
 			err = errors.Errorf("unrecognized commit type %d", ct)
 		}
@@ -592,8 +599,7 @@ func (d *Deserializer) ReadPQ(r io.Reader, res *DeserializationResult) (int, err
 		}
 		pqData.Encoders = append(pqData.Encoders, encoder)
 	}
-	res.Compressed = true
-
+	res.resetCompression()
 	res.CompressionPQData = &pqData
 
 	return totalRead, nil
@@ -612,16 +618,55 @@ func (d *Deserializer) ReadSQ(r io.Reader, res *DeserializationResult) error {
 	if err != nil {
 		return err
 	}
+	res.resetCompression()
 	res.CompressionSQData = &compressionhelpers.SQData{
 		A:          a,
 		B:          b,
 		Dimensions: dims,
 	}
-	res.Compressed = true
 
 	return nil
 }
 
+func (d *Deserializer) ReadRQ(r io.Reader, res *DeserializationResult) error {
+	dims, err := d.readUint16(r)
+	if err != nil {
+		return err
+	}
+	bits, err := d.readByte(r)
+	if err != nil {
+		return err
+	}
+	seed, err := d.readUint64(r)
+	if err != nil {
+		return err
+	}
+	res.resetCompression()
+	res.CompressionRQData = &compressionhelpers.RQData{
+		Dimensions: dims,
+		Bits:       bits,
+		Seed:       seed,
+	}
+
+	return nil
+}
+
+func (d *Deserializer) ReadBQ(res *DeserializationResult) {
+	res.resetCompression()
+	res.CompressionBQ = true
+}
+
+// resetCompression drops the data of an earlier compression. The vectors can
+// be requantized with a different quantizer, in that case the last compression
+// in the log is the one in use.
+func (res *DeserializationResult) resetCompression() {
+	res.Compressed = true
+	res.CompressionPQData = nil
+	res.CompressionSQData = nil
+	res.CompressionRQData = nil
+	res.CompressionBQ = false
+}
+
 func (d *Deserializer) readUint64(r io.Reader) (uint64, error) {
 	var value uint64
 	d.resetResusableBuffer(8)
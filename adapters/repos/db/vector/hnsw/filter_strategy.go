@@ -0,0 +1,112 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"context"
+	"math"
+	"sync/atomic"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+// FilterSearchStats describes the index to the query planner of the shard,
+// which uses it to pick the filter strategy of a search with k results
+func (h *hnsw) FilterSearchStats(k int) (common.FilterSearchStats, bool) {
+	h.compressActionLock.RLock()
+	defer h.compressActionLock.RUnlock()
+
+	strategy := ent.FilterStrategySweeping
+	if h.autoFilterStrategy.Load() {
+		strategy = ent.FilterStrategyAuto
+	} else if h.acornSearch.Load() {
+		strategy = ent.FilterStrategyAcorn
+	}
+
+	return common.FilterSearchStats{
+		IndexSize:           int(h.cacheSize()),
+		EF:                  h.searchTimeEF(k),
+		MaxConnections:      h.maximumConnectionsLayerZero,
+		FlatSearchCutoff:    int(atomic.LoadInt64(&h.flatSearchCutoff)),
+		ForbidFlat:          h.forbidFlat,
+		AcornMaxSelectivity: defaultAcornMaxFilterPercentage,
+		Strategy:            strategy,
+	}, true
+}
+
+// searchByVectorWithStrategy runs a filtered search with the strategy a query
+// planner picked instead of the one configured for the index
+func (h *hnsw) searchByVectorWithStrategy(ctx context.Context, vector []float32,
+	k int, allowList helpers.AllowList, strategy common.FilterStrategy,
+) ([]uint64, []float32, error) {
+	helpers.AnnotateSlowQueryLog(ctx, "hnsw_filter_strategy", string(strategy))
+
+	switch strategy {
+	case common.FilterStrategyFlat:
+		helpers.AnnotateSlowQueryLog(ctx, "hnsw_flat_search", true)
+		return h.flatSearch(ctx, vector, k, h.searchTimeEF(k), allowList)
+	case common.FilterStrategyPostFilter:
+		helpers.AnnotateSlowQueryLog(ctx, "hnsw_flat_search", false)
+		return h.postFilterSearch(ctx, vector, k, allowList)
+	default:
+		// sweeping and acorn only differ in how the search layer treats the
+		// allow list, see acornParams
+		helpers.AnnotateSlowQueryLog(ctx, "hnsw_flat_search", false)
+		return h.knnSearchByVector(ctx, vector, k, h.searchTimeEF(k), allowList)
+	}
+}
+
+// postFilterSearch searches the graph without the allow list and drops the
+// results which are not allowed. The search is oversampled by the inverse of
+// the filter selectivity. If that still does not yield k allowed results, the
+// search is repeated with the allow list.
+func (h *hnsw) postFilterSearch(ctx context.Context, vector []float32, k int,
+	allowList helpers.AllowList,
+) ([]uint64, []float32, error) {
+	size := h.cacheSize()
+	allowed := allowList.Len()
+	if allowed == 0 || size == 0 {
+		return nil, nil, nil
+	}
+
+	limit := int(math.Ceil(float64(k) * float64(size) / float64(allowed) * common.PostFilterOversampling))
+	limit = max(limit, k)
+
+	ids, dists, err := h.knnSearchByVector(ctx, vector, limit, h.searchTimeEF(limit), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	outIDs := make([]uint64, 0, k)
+	outDists := make([]float32, 0, k)
+	for i, id := range ids {
+		if !allowList.Contains(id) {
+			continue
+		}
+		outIDs = append(outIDs, id)
+		outDists = append(outDists, dists[i])
+		if len(outIDs) == k {
+			break
+		}
+	}
+
+	if len(outIDs) < k && len(ids) == limit {
+		// the filter excludes more of the neighborhood of the query than
+		// expected, only a filtered search can find the remaining results
+		helpers.AnnotateSlowQueryLog(ctx, "hnsw_post_filter_fallback", true)
+		return h.knnSearchByVector(ctx, vector, k, h.searchTimeEF(k), allowList)
+	}
+
+	return outIDs, outDists, nil
+}
@@ -0,0 +1,128 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/testinghelpers"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestSearchByVectorWithFilterStrategy(t *testing.T) {
+	ctx := context.Background()
+	vectors, queries := testinghelpers.RandomVecs(1000, 10, 16)
+	testinghelpers.Normalize(vectors)
+	testinghelpers.Normalize(queries)
+	k := 10
+
+	store := testinghelpers.NewDummyStore(t)
+	defer store.Shutdown(ctx)
+
+	index, err := New(Config{
+		RootPath:              "doesnt-matter-as-committlogger-is-mocked-out",
+		ID:                    "filter-strategy-test",
+		MakeCommitLoggerThunk: MakeNoopCommitLogger,
+		DistanceProvider:      distancer.NewCosineDistanceProvider(),
+		VectorForIDThunk: func(ctx context.Context, id uint64) ([]float32, error) {
+			return vectors[int(id)], nil
+		},
+		TempVectorForIDThunk: TempVectorForIDThunk(vectors),
+	}, ent.UserConfig{
+		MaxConnections:        16,
+		EFConstruction:        64,
+		EF:                    64,
+		VectorCacheMaxObjects: 10000,
+		FlatSearchCutoff:      ent.DefaultFlatSearchCutoff,
+		FilterStrategy:        ent.FilterStrategyAuto,
+	}, cyclemanager.NewCallbackGroupNoop(), store)
+	require.Nil(t, err)
+
+	for i, vec := range vectors {
+		require.Nil(t, index.Add(ctx, uint64(i), vec))
+	}
+
+	t.Run("stats", func(t *testing.T) {
+		stats, ok := index.FilterSearchStats(k)
+		require.True(t, ok)
+		assert.Equal(t, common.FilterSearchStats{
+			IndexSize:           len(vectors),
+			EF:                  64,
+			MaxConnections:      32,
+			FlatSearchCutoff:    ent.DefaultFlatSearchCutoff,
+			AcornMaxSelectivity: defaultAcornMaxFilterPercentage,
+			Strategy:            ent.FilterStrategyAuto,
+		}, stats)
+	})
+
+	allowLists := map[string]helpers.AllowList{
+		"selective": helpers.NewAllowList(),
+		"broad":     helpers.NewAllowList(),
+	}
+	for i := range vectors {
+		if i%10 == 0 {
+			allowLists["selective"].Insert(uint64(i))
+		}
+		if i%10 != 0 {
+			allowLists["broad"].Insert(uint64(i))
+		}
+	}
+
+	strategies := []common.FilterStrategy{
+		common.FilterStrategyFlat,
+		common.FilterStrategySweeping,
+		common.FilterStrategyAcorn,
+		common.FilterStrategyPostFilter,
+	}
+	for name, allowList := range allowLists {
+		var allowedVectors [][]float32
+		var allowedIDs []uint64
+		it := allowList.Iterator()
+		for id, ok := it.Next(); ok; id, ok = it.Next() {
+			allowedVectors = append(allowedVectors, vectors[id])
+			allowedIDs = append(allowedIDs, id)
+		}
+
+		for _, strategy := range strategies {
+			t.Run(name+" "+string(strategy), func(t *testing.T) {
+				strategyCtx := common.ContextWithFilterStrategy(ctx, strategy)
+				var matches uint64
+				for _, query := range queries {
+					ids, dists, err := index.SearchByVector(strategyCtx, query, k, allowList)
+					require.Nil(t, err)
+					require.Len(t, ids, k)
+					require.Len(t, dists, k)
+					for _, id := range ids {
+						assert.True(t, allowList.Contains(id))
+					}
+
+					truthPos, _ := testinghelpers.BruteForce(index.logger, allowedVectors, query, k,
+						distanceWrapper(distancer.NewCosineDistanceProvider()))
+					truth := make([]uint64, len(truthPos))
+					for i, pos := range truthPos {
+						truth[i] = allowedIDs[pos]
+					}
+					matches += testinghelpers.MatchesInLists(truth, ids)
+				}
+				recall := float32(matches) / float32(k*len(queries))
+				assert.GreaterOrEqual(t, recall, float32(0.9))
+			})
+		}
+	}
+}
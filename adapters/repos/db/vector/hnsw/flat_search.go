@@ -144,24 +144,16 @@ func (h *hnsw) flatMultiSearch(ctx context.Context, queryVectors [][]float32, k
 	allowList helpers.AllowList,
 ) ([]uint64, []float32, error) {
 	kPrime := k
-	candidateSet := make(map[uint64]bool)
-	for _, vec := range queryVectors {
+	hits := make([][]uint64, len(queryVectors))
+	for i, vec := range queryVectors {
 		ids, _, err := h.flatSearch(ctx, vec, kPrime, h.searchTimeEF(kPrime), allowList)
 		if err != nil {
 			return nil, nil, err
 		}
-		for _, id := range ids {
-			var docId uint64
-			if !h.compressed.Load() {
-				docId, _ = h.cache.GetKeys(id)
-			} else {
-				docId, _ = h.compressor.GetKeys(id)
-			}
-			candidateSet[docId] = true
-		}
+		hits[i] = ids
 	}
 
-	return h.computeLateInteraction(queryVectors, k, candidateSet)
+	return h.aggregateMultiVector(queryVectors, k, hits)
 }
 
 func addResult(results *priorityqueue.Queue[any], id uint64, dist float32, limit int) {
@@ -109,6 +109,9 @@ type hnsw struct {
 	multiVectorForID    common.MultiVectorForID
 	trackDimensionsOnce sync.Once
 	dims                int32
+	// rqCompressLock serializes the compression with rotational quantization
+	// on insert
+	rqCompressLock sync.Mutex
 
 	cache               cache.Cache[float32]
 	waitForCachePrefill bool
@@ -164,11 +167,22 @@ type hnsw struct {
 	compressed   atomic.Bool
 	doNotRescore bool
 	acornSearch  atomic.Bool
+	// the filter strategy is picked per query by the shard
+	autoFilterStrategy atomic.Bool
 
 	compressor compressionhelpers.VectorCompressor
 	pqConfig   ent.PQConfig
 	bqConfig   ent.BQConfig
 	sqConfig   ent.SQConfig
+	rqConfig   ent.RQConfig
+	// requantization is set while the vectors are switched to a new quantizer
+	requantization atomic.Pointer[requantization]
+	// restoredCompression is the compression found in the commit log on
+	// startup, it differs from the config if a requantization was interrupted
+	restoredCompression string
+	// matryoshkaDims is the number of leading dimensions of the vectors held by
+	// the index, 0 if the vectors are held as a whole
+	matryoshkaDims int
 	// rescoring compressed vectors is disk-bound. On cold starts, we cannot
 	// rescore sequentially, as that would take very long. This setting allows us
 	// to define the rescoring concurrency.
@@ -186,10 +200,14 @@ type hnsw struct {
 
 	visitedListPoolMaxSize int
 
-	multivector  atomic.Bool
-	docIDVectors map[uint64][]uint64
-	vecIDcounter uint64
-	maxDocID     uint64
+	multivector       atomic.Bool
+	multivectorConfig atomic.Pointer[ent.MultivectorConfig]
+	docIDVectors      map[uint64][]uint64
+	// docIDCentroids holds the mean of the vectors of each document, which the
+	// meanPooling and centroidRerank aggregations score documents by
+	docIDCentroids map[uint64][]float32
+	vecIDcounter   uint64
+	maxDocID       uint64
 }
 
 type CommitLogger interface {
@@ -211,6 +229,8 @@ type CommitLogger interface {
 	SwitchCommitLogs(bool) error
 	AddPQCompression(compressionhelpers.PQData) error
 	AddSQCompression(compressionhelpers.SQData) error
+	AddRQCompression(compressionhelpers.RQData) error
+	AddBQCompression() error
 }
 
 type BufferedLinksLogger interface {
@@ -234,6 +254,10 @@ func New(cfg Config, uc ent.UserConfig,
 		return nil, errors.Wrap(err, "invalid config")
 	}
 
+	if uc.Matryoshka.Enabled() {
+		truncateThunks(&cfg, uc.Matryoshka.Dimensions)
+	}
+
 	if cfg.Logger == nil {
 		logger := logrus.New()
 		logger.Out = io.Discard
@@ -302,6 +326,7 @@ func New(cfg Config, uc ent.UserConfig,
 		pqConfig:             uc.PQ,
 		bqConfig:             uc.BQ,
 		sqConfig:             uc.SQ,
+		rqConfig:             uc.RQ,
 		rescoreConcurrency:   2 * runtime.GOMAXPROCS(0), // our default for IO-bound activties
 		shardedNodeLocks:     common.NewDefaultShardedRWLocks(),
 
@@ -309,11 +334,16 @@ func New(cfg Config, uc ent.UserConfig,
 		allocChecker:           cfg.AllocChecker,
 		visitedListPoolMaxSize: cfg.VisitedListPoolMaxSize,
 
-		docIDVectors: make(map[uint64][]uint64),
+		docIDVectors:   make(map[uint64][]uint64),
+		docIDCentroids: make(map[uint64][]float32),
+		matryoshkaDims: uc.Matryoshka.Dimensions,
 	}
 	index.acornSearch.Store(uc.FilterStrategy == ent.FilterStrategyAcorn)
+	index.autoFilterStrategy.Store(uc.FilterStrategy == ent.FilterStrategyAuto)
 
 	index.multivector.Store(uc.Multivector.Enabled)
+	multivectorConfig := uc.Multivector
+	index.multivectorConfig.Store(&multivectorConfig)
 
 	if uc.BQ.Enabled {
 		var err error
@@ -729,6 +759,10 @@ func (h *hnsw) ShouldUpgrade() (bool, int) {
 	if h.sqConfig.Enabled {
 		return h.sqConfig.Enabled, h.sqConfig.TrainingLimit
 	}
+	if h.rqConfig.Enabled {
+		// no training needed, compress as soon as there is any data
+		return h.rqConfig.Enabled, 0
+	}
 	return h.pqConfig.Enabled, h.pqConfig.TrainingLimit
 }
 
@@ -737,6 +771,9 @@ func (h *hnsw) ShouldCompressFromConfig(config config.VectorIndexConfig) (bool,
 	if hnswConfig.SQ.Enabled {
 		return hnswConfig.SQ.Enabled, hnswConfig.SQ.TrainingLimit
 	}
+	if hnswConfig.RQ.Enabled {
+		return hnswConfig.RQ.Enabled, 0
+	}
 	return hnswConfig.PQ.Enabled, hnswConfig.PQ.TrainingLimit
 }
 
@@ -20,10 +20,17 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/compressionhelpers"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 )
 
 func (h *hnsw) ValidateBeforeInsert(vector []float32) error {
+	vector, err := h.truncate(vector)
+	if err != nil {
+		return err
+	}
+
 	dims := int(atomic.LoadInt32(&h.dims))
 
 	// no vectors exist
@@ -70,9 +77,23 @@ func (h *hnsw) AddBatch(ctx context.Context, ids []uint64, vectors [][]float32)
 	if len(ids) == 0 {
 		return errors.Errorf("insertBatch called with empty lists")
 	}
+	if h.matryoshkaDims > 0 {
+		truncated := make([][]float32, len(vectors))
+		for i := range vectors {
+			vec, err := h.truncate(vectors[i])
+			if err != nil {
+				return err
+			}
+			truncated[i] = vec
+		}
+		vectors = truncated
+	}
 	h.trackDimensionsOnce.Do(func() {
 		atomic.StoreInt32(&h.dims, int32(len(vectors[0])))
 	})
+	if err := h.compressOnInsert(); err != nil {
+		return errors.Wrap(err, "compress vectors")
+	}
 	levels := make([]int, len(ids))
 	maxId := uint64(0)
 	for i, id := range ids {
@@ -125,6 +146,24 @@ func (h *hnsw) AddBatch(ctx context.Context, ids []uint64, vectors [][]float32)
 	return nil
 }
 
+// compressOnInsert compresses the index with rotational quantization, which
+// needs no training data, so the vectors are compressed from the very first
+// insert on. As long as the compression fails, every insert fails with its
+// error instead of adding uncompressed vectors.
+func (h *hnsw) compressOnInsert() error {
+	if !h.rqConfig.Enabled || h.compressed.Load() {
+		return nil
+	}
+
+	h.rqCompressLock.Lock()
+	defer h.rqCompressLock.Unlock()
+
+	if h.compressed.Load() {
+		return nil
+	}
+	return h.compress(ent.UserConfig{RQ: h.rqConfig})
+}
+
 func (h *hnsw) AddMultiBatch(ctx context.Context, docIDs []uint64, vectors [][][]float32) error {
 	if err := ctx.Err(); err != nil {
 		return err
@@ -199,6 +238,11 @@ func (h *hnsw) AddMultiBatch(ctx context.Context, docIDs []uint64, vectors [][][
 		} else {
 			h.cache.PreloadMulti(docID, ids, vectors[i])
 		}
+
+		centroid := common.MeanVector(h.normalizeVecs(vectors[i]))
+		h.Lock()
+		h.docIDCentroids[docID] = centroid
+		h.Unlock()
 		for j := range numVectors {
 			if err := ctx.Err(); err != nil {
 				return err
@@ -0,0 +1,49 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"context"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
+)
+
+// truncateThunks makes the thunks return the leading dims dimensions of the
+// stored vectors only, so the cache and the compressors never see more than
+// the index holds
+func truncateThunks(cfg *Config, dims int) {
+	vectorForID := cfg.VectorForIDThunk
+	cfg.VectorForIDThunk = func(ctx context.Context, id uint64) ([]float32, error) {
+		vec, err := vectorForID(ctx, id)
+		if err != nil || len(vec) == 0 {
+			return vec, err
+		}
+		return common.TruncateVector(vec, dims)
+	}
+
+	tempVectorForID := cfg.TempVectorForIDThunk
+	if tempVectorForID == nil {
+		return
+	}
+	cfg.TempVectorForIDThunk = func(ctx context.Context, id uint64, container *common.VectorSlice) ([]float32, error) {
+		vec, err := tempVectorForID(ctx, id, container)
+		if err != nil || len(vec) == 0 {
+			return vec, err
+		}
+		return common.TruncateVector(vec, dims)
+	}
+}
+
+// truncate cuts the vector down to the dimensions held by the index
+func (h *hnsw) truncate(vector []float32) ([]float32, error) {
+	return common.TruncateVector(vector, h.matryoshkaDims)
+}
@@ -0,0 +1,101 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/testinghelpers"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	"github.com/weaviate/weaviate/entities/storobj"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestMatryoshka(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+
+	dimensions := 32
+	truncated := 8
+	vectorsSize := 500
+	queriesSize := 10
+	k := 10
+	vectors, queries := testinghelpers.RandomVecs(vectorsSize, queriesSize, dimensions)
+	distancer := distancer.NewL2SquaredProvider()
+
+	uc := ent.NewDefaultUserConfig()
+	uc.MaxConnections = 16
+	uc.EFConstruction = 64
+	uc.EF = 100
+	uc.Matryoshka.Dimensions = truncated
+
+	index, err := New(Config{
+		RootPath:              t.TempDir(),
+		ID:                    "matryoshka",
+		MakeCommitLoggerThunk: MakeNoopCommitLogger,
+		DistanceProvider:      distancer,
+		Logger:                logger,
+		VectorForIDThunk: func(ctx context.Context, id uint64) ([]float32, error) {
+			if id >= uint64(len(vectors)) {
+				return nil, storobj.NewErrNotFoundf(id, "out of range")
+			}
+			return vectors[int(id)], nil
+		},
+		TempVectorForIDThunk: func(ctx context.Context, id uint64, container *common.VectorSlice) ([]float32, error) {
+			if id >= uint64(len(vectors)) {
+				return nil, storobj.NewErrNotFoundf(id, "out of range")
+			}
+			copy(container.Slice, vectors[int(id)])
+			return container.Slice, nil
+		},
+	}, uc, cyclemanager.NewCallbackGroupNoop(), testinghelpers.NewDummyStore(t))
+	require.Nil(t, err)
+	defer index.Shutdown(ctx)
+
+	for id := 0; id < vectorsSize; id++ {
+		require.Nil(t, index.Add(ctx, uint64(id), vectors[id]))
+	}
+
+	t.Run("the index holds the truncated vectors", func(t *testing.T) {
+		node := index.nodeByID(0)
+		require.NotNil(t, node)
+		vec, err := index.TempVectorForIDThunk(ctx, 0, index.pools.tempVectors.Get(dimensions))
+		require.Nil(t, err)
+		assert.Len(t, vec, truncated)
+	})
+
+	t.Run("full length queries are searched on the truncated vectors", func(t *testing.T) {
+		var relevant uint64
+		for i := range queries {
+			truth, _ := testinghelpers.BruteForce(logger, vectors, queries[i], k, func(x, y []float32) float32 {
+				dist, _ := distancer.SingleDist(x[:truncated], y[:truncated])
+				return dist
+			})
+			ids, _, err := index.SearchByVector(ctx, queries[i], k, nil)
+			require.Nil(t, err)
+			relevant += testinghelpers.MatchesInLists(truth, ids)
+		}
+		assert.Greater(t, float32(relevant)/float32(queriesSize*k), float32(0.9))
+	})
+
+	t.Run("queries shorter than the truncated vectors are rejected", func(t *testing.T) {
+		_, _, err := index.SearchByVector(ctx, queries[0][:truncated-1], k, nil)
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "the index holds the first 8")
+	})
+}
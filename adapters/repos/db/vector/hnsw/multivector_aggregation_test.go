@@ -0,0 +1,86 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/testinghelpers"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestMultiVectorAggregations(t *testing.T) {
+	ctx := context.Background()
+	vectorsSize := 1000
+	queriesSize := 20
+	k := 10
+
+	vectors, queries := testinghelpers.RandomNormalizedMultiVecs(vectorsSize, queriesSize, 8, 32)
+
+	var index *hnsw
+	index, err := New(Config{
+		RootPath:              "doesnt-matter-as-committlogger-is-mocked-out",
+		ID:                    "multivector-aggregations",
+		MakeCommitLoggerThunk: MakeNoopCommitLogger,
+		DistanceProvider:      distancer.NewDotProductProvider(),
+		VectorForIDThunk: func(ctx context.Context, id uint64) ([]float32, error) {
+			docID, relativeID := index.cache.GetKeys(id)
+			return vectors[docID][relativeID], nil
+		},
+	}, ent.UserConfig{
+		MaxConnections: 16,
+		EFConstruction: 64,
+		EF:             128,
+		Multivector:    ent.MultivectorConfig{Enabled: true},
+	}, cyclemanager.NewCallbackGroupNoop(), nil)
+	require.Nil(t, err)
+
+	for i, doc := range vectors {
+		require.Nil(t, index.AddMulti(ctx, uint64(i), doc))
+	}
+
+	aggregation := func(aggregation string, minRecall float32) testinghelpers.MultiVectorRecallCase {
+		return testinghelpers.MultiVectorRecallCase{
+			Name:      aggregation,
+			MinRecall: minRecall,
+			Configure: func(t *testing.T) {
+				uc := ent.NewDefaultUserConfig()
+				uc.MaxConnections = 16
+				uc.EFConstruction = 64
+				uc.EF = 128
+				uc.Multivector = ent.NewDefaultMultivectorConfig()
+				uc.Multivector.Enabled = true
+				uc.Multivector.Aggregation = aggregation
+				require.Nil(t, index.UpdateUserConfig(uc, func() {}))
+			},
+		}
+	}
+	// the aggregations other than maxSim only score the documents found by
+	// the search for the individual query vectors, and score them differently
+	// than maxSim, so they are expected to miss some of the exact results.
+	// Mean pooling hardly finds the documents with the best matches of the
+	// individual query vectors.
+	testinghelpers.MultiVectorRecall(t, vectors, queries, k,
+		func(query [][]float32, k int) ([]uint64, []float32, error) {
+			return index.SearchByMultiVector(ctx, query, k, nil)
+		},
+		[]testinghelpers.MultiVectorRecallCase{
+			aggregation(ent.MultivectorAggregationMaxSim, 0.9),
+			aggregation(ent.MultivectorAggregationCentroidRerank, 0.6),
+			aggregation(ent.MultivectorAggregationTopKSum, 0.6),
+			aggregation(ent.MultivectorAggregationMeanPooling, 0.05),
+		})
+}
@@ -0,0 +1,241 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"context"
+	"sync/atomic"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/compressionhelpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+// SearchByVectorRange calls fn for every vector within targetDistance of the
+// query vector, in the order they are found, until fn returns false. Use
+// common.RangeSearch to order and limit the results.
+//
+// The graph search starts from the nearest neighbors of the query and expands
+// every node within range, so it visits the nodes within range plus their
+// direct neighbors, no matter how many there are. Like any graph search it is
+// approximate: a node within range that is only connected through nodes out
+// of range is not found. Small allow lists are checked one by one instead.
+func (h *hnsw) SearchByVectorRange(ctx context.Context, vector []float32,
+	targetDistance float32, allowList helpers.AllowList,
+	fn func(id uint64, dist float32) bool,
+) error {
+	if h.multivector.Load() {
+		return errors.New("range search is not supported for multi vector indexes")
+	}
+
+	vector, err := h.truncate(vector)
+	if err != nil {
+		return err
+	}
+
+	h.compressActionLock.RLock()
+	defer h.compressActionLock.RUnlock()
+
+	vector = h.normalizeVec(vector)
+	flatSearchCutoff := int(atomic.LoadInt64(&h.flatSearchCutoff))
+	if allowList != nil && !h.forbidFlat && allowList.Len() < flatSearchCutoff {
+		helpers.AnnotateSlowQueryLog(ctx, "hnsw_flat_search", true)
+		return h.flatRangeSearch(ctx, vector, targetDistance, allowList, fn)
+	}
+	helpers.AnnotateSlowQueryLog(ctx, "hnsw_flat_search", false)
+	return h.graphRangeSearch(ctx, vector, targetDistance, allowList, fn)
+}
+
+// rangeDistancer calculates the distance of nodes to the query. For
+// compressed indexes it tells the compressed distance, which is used to
+// navigate the graph, from the exact one, which decides whether a node is
+// within range.
+type rangeDistancer struct {
+	h                   *hnsw
+	floatDistancer      distancer.Distancer
+	compressorDistancer compressionhelpers.CompressorDistancer
+}
+
+func (h *hnsw) newRangeDistancer(vector []float32) (*rangeDistancer, func()) {
+	d := &rangeDistancer{h: h}
+	if h.compressed.Load() {
+		var returnFn compressionhelpers.ReturnDistancerFn
+		d.compressorDistancer, returnFn = h.compressor.NewDistancer(vector)
+		return d, returnFn
+	}
+	d.floatDistancer = h.distancerProvider.New(vector)
+	return d, func() {}
+}
+
+func (d *rangeDistancer) distance(id uint64) (float32, error) {
+	if d.compressorDistancer != nil {
+		return d.compressorDistancer.DistanceToNode(id)
+	}
+	return d.h.distanceToFloatNode(d.floatDistancer, id)
+}
+
+// exactDistance returns the distance which decides whether a node is within
+// range, given the distance the node was reached with
+func (d *rangeDistancer) exactDistance(id uint64, dist float32) (float32, error) {
+	if d.compressorDistancer != nil && d.h.shouldRescore() {
+		return d.h.distanceFromBytesToFloatNode(d.compressorDistancer, id)
+	}
+	return dist, nil
+}
+
+func (h *hnsw) flatRangeSearch(ctx context.Context, vector []float32,
+	targetDistance float32, allowList helpers.AllowList,
+	fn func(id uint64, dist float32) bool,
+) error {
+	d, returnFn := h.newRangeDistancer(vector)
+	defer returnFn()
+
+	it := allowList.Iterator()
+	for id, ok := it.Next(); ok; id, ok = it.Next() {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if h.hasTombstone(id) || h.nodeByID(id) == nil {
+			continue
+		}
+
+		dist, err := d.distance(id)
+		if err == nil {
+			dist, err = d.exactDistance(id, dist)
+		}
+		if err != nil {
+			var e storobj.ErrNotFound
+			if errors.As(err, &e) {
+				continue
+			}
+			return errors.Wrapf(err, "range search: distance to node %d", id)
+		}
+
+		if dist <= targetDistance && !fn(id, dist) {
+			return nil
+		}
+	}
+	return nil
+}
+
+func (h *hnsw) graphRangeSearch(ctx context.Context, vector []float32,
+	targetDistance float32, allowList helpers.AllowList,
+	fn func(id uint64, dist float32) bool,
+) error {
+	// the nearest neighbors of the query are the entrypoints into the range,
+	// if none of them is within range, nothing is
+	seedLimit := DefaultSearchByDistInitialLimit
+	seeds, seedDists, err := h.knnSearchByVector(ctx, vector, seedLimit, h.searchTimeEF(seedLimit), nil)
+	if err != nil {
+		return errors.Wrap(err, "range search: find entrypoints")
+	}
+	if len(seeds) == 0 || seedDists[0] > targetDistance {
+		return nil
+	}
+
+	d, returnFn := h.newRangeDistancer(vector)
+	defer returnFn()
+
+	h.pools.visitedListsLock.RLock()
+	visited := h.pools.visitedLists.Borrow()
+	h.pools.visitedListsLock.RUnlock()
+	defer func() {
+		h.pools.visitedListsLock.RLock()
+		h.pools.visitedLists.Return(visited)
+		h.pools.visitedListsLock.RUnlock()
+	}()
+
+	emit := func(id uint64, dist float32) bool {
+		if allowList != nil && !allowList.Contains(id) {
+			return true
+		}
+		if h.hasTombstone(id) {
+			return true
+		}
+		return fn(id, dist)
+	}
+
+	// the seeds come with exact distances, all other nodes are reached with
+	// the distance the graph is navigated with
+	var pending []uint64
+	for i, id := range seeds {
+		visited.Visit(id)
+		if seedDists[i] > targetDistance {
+			continue
+		}
+		if !emit(id, seedDists[i]) {
+			return nil
+		}
+		pending = append(pending, id)
+	}
+
+	var connections []uint64
+	for len(pending) > 0 {
+		if err := ctx.Err(); err != nil {
+			helpers.AnnotateSlowQueryLog(ctx, "context_error", "range_search")
+			return err
+		}
+
+		id := pending[len(pending)-1]
+		pending = pending[:len(pending)-1]
+
+		node := h.nodeByID(id)
+		if node == nil {
+			continue
+		}
+		node.Lock()
+		if len(node.connections) == 0 {
+			node.Unlock()
+			continue
+		}
+		connections = append(connections[:0], node.connections[0]...)
+		node.Unlock()
+
+		for _, neighbor := range connections {
+			if visited.Visited(neighbor) {
+				continue
+			}
+			visited.Visit(neighbor)
+
+			dist, err := d.distance(neighbor)
+			if err != nil {
+				var e storobj.ErrNotFound
+				if errors.As(err, &e) {
+					h.handleDeletedNode(e.DocID, "graphRangeSearch")
+					continue
+				}
+				return errors.Wrapf(err, "range search: distance to node %d", neighbor)
+			}
+			if dist > targetDistance {
+				continue
+			}
+			// nodes within range are expanded, even if they are filtered out or
+			// deleted, as they connect the rest of the range
+			pending = append(pending, neighbor)
+
+			exact, err := d.exactDistance(neighbor, dist)
+			if err != nil {
+				var e storobj.ErrNotFound
+				if errors.As(err, &e) {
+					continue
+				}
+				return errors.Wrapf(err, "range search: rescore node %d", neighbor)
+			}
+			if exact <= targetDistance && !emit(neighbor, exact) {
+				return nil
+			}
+		}
+	}
+	return nil
+}
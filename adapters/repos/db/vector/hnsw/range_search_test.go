@@ -0,0 +1,145 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/testinghelpers"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestSearchByVectorRange(t *testing.T) {
+	ctx := context.Background()
+	vectors, queries := testinghelpers.RandomVecs(2000, 10, 8)
+	testinghelpers.Normalize(vectors)
+	testinghelpers.Normalize(queries)
+	targetDistance := float32(0.1)
+	provider := distancer.NewCosineDistanceProvider()
+
+	store := testinghelpers.NewDummyStore(t)
+	defer store.Shutdown(ctx)
+
+	index, err := New(Config{
+		RootPath:              "doesnt-matter-as-committlogger-is-mocked-out",
+		ID:                    "range-search-test",
+		MakeCommitLoggerThunk: MakeNoopCommitLogger,
+		DistanceProvider:      provider,
+		VectorForIDThunk: func(ctx context.Context, id uint64) ([]float32, error) {
+			return vectors[int(id)], nil
+		},
+		TempVectorForIDThunk: TempVectorForIDThunk(vectors),
+	}, ent.UserConfig{
+		MaxConnections:        16,
+		EFConstruction:        64,
+		EF:                    64,
+		VectorCacheMaxObjects: 10000,
+		FlatSearchCutoff:      100,
+	}, cyclemanager.NewCallbackGroupNoop(), store)
+	require.Nil(t, err)
+
+	for i, vec := range vectors {
+		require.Nil(t, index.Add(ctx, uint64(i), vec))
+	}
+
+	inRange := func(query []float32, targetDistance float32, allowList helpers.AllowList) map[uint64]float32 {
+		res := map[uint64]float32{}
+		for i, vec := range vectors {
+			if allowList != nil && !allowList.Contains(uint64(i)) {
+				continue
+			}
+			dist, err := provider.SingleDist(query, vec)
+			require.Nil(t, err)
+			if dist <= targetDistance {
+				res[uint64(i)] = dist
+			}
+		}
+		return res
+	}
+
+	tests := []struct {
+		name           string
+		allowList      helpers.AllowList
+		targetDistance float32
+	}{
+		{name: "unfiltered", targetDistance: targetDistance},
+		{name: "graph with allow list", allowList: helpers.NewAllowList(), targetDistance: targetDistance},
+		{name: "flat with allow list", allowList: helpers.NewAllowList(), targetDistance: 0.3},
+	}
+	for i := range vectors {
+		if i%2 == 0 {
+			tests[1].allowList.Insert(uint64(i))
+		}
+		if i%40 == 0 {
+			tests[2].allowList.Insert(uint64(i))
+		}
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var found, expected int
+			for _, query := range queries {
+				truth := inRange(query, tt.targetDistance, tt.allowList)
+				expected += len(truth)
+
+				seen := map[uint64]struct{}{}
+				err := index.SearchByVectorRange(ctx, query, tt.targetDistance, tt.allowList,
+					func(id uint64, dist float32) bool {
+						_, duplicate := seen[id]
+						require.False(t, duplicate)
+						seen[id] = struct{}{}
+
+						truthDist, ok := truth[id]
+						require.True(t, ok, "id %d is not within range", id)
+						assert.InDelta(t, truthDist, dist, 1e-5)
+						return true
+					})
+				require.Nil(t, err)
+				found += len(seen)
+			}
+			require.Greater(t, expected, 0)
+			assert.GreaterOrEqual(t, float32(found)/float32(expected), float32(0.95))
+		})
+	}
+
+	t.Run("stop early", func(t *testing.T) {
+		calls := 0
+		err := index.SearchByVectorRange(ctx, queries[0], 2, nil, func(id uint64, dist float32) bool {
+			calls++
+			return calls < 5
+		})
+		require.Nil(t, err)
+		assert.Equal(t, 5, calls)
+	})
+
+	t.Run("limited", func(t *testing.T) {
+		search := func(fn func(id uint64, dist float32) bool) error {
+			return index.SearchByVectorRange(ctx, queries[0], 0.5, nil, fn)
+		}
+		allIDs, _, truncated, err := common.RangeSearch(search, 0, nil)
+		require.Nil(t, err)
+		require.Greater(t, len(allIDs), 3)
+		assert.False(t, truncated)
+
+		ids, _, truncated, err := common.RangeSearch(search, 3, nil)
+		require.Nil(t, err)
+		assert.True(t, truncated)
+		assert.Equal(t, allIDs[:3], ids)
+	})
+}
@@ -0,0 +1,330 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/compressionhelpers"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/storobj"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+// Requantization switches an already compressed index to a different
+// quantizer, or retrains the current one, without re-importing the data. The
+// new quantizer is trained on a sample of the vectors in the object store and
+// every vector is encoded into a temporary bucket while queries are still
+// served by the old quantizer. Only the final swap blocks queries and inserts.
+
+const (
+	compressionPQ = "pq"
+	compressionSQ = "sq"
+	compressionRQ = "rq"
+	compressionBQ = "bq"
+)
+
+type requantization struct {
+	compression string
+	processed   atomic.Uint64
+	total       atomic.Uint64
+}
+
+func compressionName(cfg ent.UserConfig) string {
+	switch {
+	case cfg.PQ.Enabled:
+		return compressionPQ
+	case cfg.SQ.Enabled:
+		return compressionSQ
+	case cfg.RQ.Enabled:
+		return compressionRQ
+	case cfg.BQ.Enabled:
+		return compressionBQ
+	default:
+		return ""
+	}
+}
+
+func compressionOfState(state *DeserializationResult) string {
+	switch {
+	case state.CompressionPQData != nil:
+		return compressionPQ
+	case state.CompressionSQData != nil:
+		return compressionSQ
+	case state.CompressionRQData != nil:
+		return compressionRQ
+	case state.CompressionBQ:
+		return compressionBQ
+	default:
+		return ""
+	}
+}
+
+// compressionChanged is true if the vectors have to be encoded again to
+// switch from the current to the next compression config. Settings that only
+// affect searches, such as the rescore limit, do not require that.
+func compressionChanged(current, next ent.UserConfig) bool {
+	if compressionName(current) != compressionName(next) {
+		return true
+	}
+
+	switch {
+	case next.PQ.Enabled:
+		// 0 segments means the number of segments is derived from the
+		// dimensions, which is what the current config already holds
+		return (next.PQ.Segments != 0 && next.PQ.Segments != current.PQ.Segments) ||
+			next.PQ.Centroids != current.PQ.Centroids ||
+			next.PQ.BitCompression != current.PQ.BitCompression ||
+			next.PQ.TrainingLimit != current.PQ.TrainingLimit ||
+			next.PQ.Encoder != current.PQ.Encoder
+	case next.SQ.Enabled:
+		return next.SQ.TrainingLimit != current.SQ.TrainingLimit
+	case next.RQ.Enabled:
+		return next.RQ.Bits != current.RQ.Bits
+	default:
+		return false
+	}
+}
+
+func (h *hnsw) compressionConfig() ent.UserConfig {
+	return ent.UserConfig{
+		PQ: h.pqConfig,
+		BQ: h.bqConfig,
+		SQ: h.sqConfig,
+		RQ: h.rqConfig,
+	}
+}
+
+// RequantizationStatus returns the progress of the running requantization.
+// The second return value is false if there is none.
+func (h *hnsw) RequantizationStatus() (common.RequantizationStatus, bool) {
+	job := h.requantization.Load()
+	if job == nil {
+		return common.RequantizationStatus{}, false
+	}
+
+	return common.RequantizationStatus{
+		Compression: job.compression,
+		Processed:   job.processed.Load(),
+		Total:       job.total.Load(),
+	}, true
+}
+
+// requantize encodes all vectors with the compression enabled in cfg in the
+// background. The callback is called once the new quantizer is in use or the
+// requantization failed.
+func (h *hnsw) requantize(cfg ent.UserConfig, vectorCacheMaxObjects int, callback func()) error {
+	if h.multivector.Load() {
+		callback()
+		return errors.New("requantization is not supported for multivector indices")
+	}
+	if cfg.PQ.Enabled {
+		if err := ent.ValidatePQConfig(cfg.PQ); err != nil {
+			callback()
+			return err
+		}
+	}
+
+	job := &requantization{compression: compressionName(cfg)}
+	if !h.requantization.CompareAndSwap(nil, job) {
+		callback()
+		return errors.New("requantization is already running")
+	}
+
+	logger := h.logger.WithField("action", "requantize").
+		WithField("compression", job.compression)
+	logger.Info("switching to a new quantizer")
+
+	enterrors.GoWrapper(func() {
+		defer callback()
+		defer h.requantization.Store(nil)
+
+		if err := h.runRequantization(cfg, job, vectorCacheMaxObjects); err != nil {
+			logger.WithError(err).Error("requantization failed")
+			return
+		}
+		logger.Info("requantization complete")
+	}, h.logger)
+
+	return nil
+}
+
+func (h *hnsw) runRequantization(cfg ent.UserConfig, job *requantization,
+	vectorCacheMaxObjects int,
+) error {
+	ctx := context.Background()
+
+	h.RLock()
+	size := len(h.nodes)
+	h.RUnlock()
+	job.total.Store(uint64(size))
+
+	var data [][]float32
+	if cfg.PQ.Enabled || cfg.SQ.Enabled {
+		// the compressed vectors cannot be used for training, the sample is read
+		// from the object store instead
+		var err error
+		data, err = h.sampleTrainingData(size, cfg.PQ.TrainingLimit, h.normalizedVectorForID)
+		if err != nil {
+			return err
+		}
+		if len(data) == 0 {
+			return errors.New("no vectors to train the quantizer on")
+		}
+	}
+	if cfg.PQ.Enabled && cfg.PQ.Segments <= 0 {
+		cfg.PQ.Segments = common.CalculateOptimalSegments(int(atomic.LoadInt32(&h.dims)))
+	}
+
+	compressor, err := h.newCompressor(cfg, data)
+	if err != nil {
+		return fmt.Errorf("create compressor: %w", err)
+	}
+
+	tempBucket := helpers.TempBucketFromBucketName(helpers.VectorsCompressedBucketLSM)
+	if err := compressor.UseBucket(tempBucket); err != nil {
+		return err
+	}
+	if err := h.clearBucket(tempBucket); err != nil {
+		return fmt.Errorf("clear leftovers of an earlier requantization: %w", err)
+	}
+
+	encoded := make([]bool, size)
+	err = compressionhelpers.ConcurrentlyWithError(h.logger, uint64(size), func(id uint64) error {
+		defer job.processed.Add(1)
+
+		ok, err := h.preloadForRequantization(ctx, compressor, id)
+		encoded[id] = ok
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	h.compressActionLock.Lock()
+	defer h.compressActionLock.Unlock()
+
+	// catch up with the inserts and deletes that happened in the meantime
+	h.RLock()
+	size = len(h.nodes)
+	h.RUnlock()
+	for id := uint64(0); id < uint64(size); id++ {
+		wasEncoded := id < uint64(len(encoded)) && encoded[id]
+		exists := h.nodeByID(id) != nil
+		if exists && !wasEncoded {
+			if _, err := h.preloadForRequantization(ctx, compressor, id); err != nil {
+				return err
+			}
+		} else if !exists && wasEncoded {
+			compressor.Delete(ctx, id)
+		}
+	}
+
+	if err := h.store.Bucket(tempBucket).FlushMemtable(); err != nil {
+		return fmt.Errorf("flush requantized vectors: %w", err)
+	}
+	if err := h.store.ReplaceBuckets(ctx, helpers.VectorsCompressedBucketLSM, tempBucket); err != nil {
+		return fmt.Errorf("replace compressed vectors: %w", err)
+	}
+	if err := compressor.UseBucket(helpers.VectorsCompressedBucketLSM); err != nil {
+		return err
+	}
+	compressor.PersistCompression(h.commitLog)
+	if err := h.commitLog.Flush(); err != nil {
+		return fmt.Errorf("persist new quantizer: %w", err)
+	}
+
+	previous := h.compressor
+	h.compressor = compressor
+	h.compressor.SetCacheMaxSize(int64(vectorCacheMaxObjects))
+	h.pqConfig = cfg.PQ
+	h.sqConfig = cfg.SQ
+	h.rqConfig = cfg.RQ
+	h.bqConfig = cfg.BQ
+	return previous.Drop()
+}
+
+// preloadForRequantization encodes the vector of the node with the given id.
+// It returns false if there is no such node or its object was deleted.
+func (h *hnsw) preloadForRequantization(ctx context.Context,
+	compressor compressionhelpers.VectorCompressor, id uint64,
+) (bool, error) {
+	if h.nodeByID(id) == nil {
+		return false, nil
+	}
+
+	vec, err := h.normalizedVectorForID(ctx, id)
+	if err != nil {
+		var e storobj.ErrNotFound
+		if errors.As(err, &e) {
+			return false, nil
+		}
+		return false, fmt.Errorf("get vector for requantization: %w", err)
+	}
+	if len(vec) == 0 {
+		return false, nil
+	}
+
+	compressor.Preload(id, vec)
+	return true, nil
+}
+
+func (h *hnsw) normalizedVectorForID(ctx context.Context, id uint64) ([]float32, error) {
+	vec, err := h.VectorForIDThunk(ctx, id)
+	if err != nil || len(vec) == 0 {
+		return vec, err
+	}
+	return h.normalizeVec(vec), nil
+}
+
+// clearBucket deletes all keys of the given bucket
+func (h *hnsw) clearBucket(name string) error {
+	bucket := h.store.Bucket(name)
+	cursor := bucket.Cursor()
+	var keys [][]byte
+	for k, _ := cursor.First(); k != nil; k, _ = cursor.Next() {
+		keys = append(keys, append([]byte(nil), k...))
+	}
+	cursor.Close()
+
+	for _, k := range keys {
+		if err := bucket.Delete(k); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// resumeRequantization restarts a requantization that was interrupted by a
+// shutdown. The object store is the source of truth for the vectors, so the
+// requantization simply starts over.
+func (h *hnsw) resumeRequantization() {
+	configured := compressionName(h.compressionConfig())
+	if h.restoredCompression == "" || configured == "" ||
+		h.restoredCompression == configured {
+		return
+	}
+
+	h.logger.WithField("action", "requantize").
+		WithField("from", h.restoredCompression).
+		Info("resuming interrupted requantization")
+	if err := h.requantize(h.compressionConfig(),
+		int(h.compressor.GetCacheMaxSize()), func() {}); err != nil {
+		h.logger.WithField("action", "requantize").WithError(err).
+			Error("resuming requantization failed")
+	}
+}
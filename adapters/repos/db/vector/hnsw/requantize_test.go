@@ -0,0 +1,229 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/testinghelpers"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	"github.com/weaviate/weaviate/entities/storobj"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestCompressionChanged(t *testing.T) {
+	pq := ent.NewDefaultUserConfig()
+	pq.PQ.Enabled = true
+	pq.PQ.Segments = 8
+
+	sq := ent.NewDefaultUserConfig()
+	sq.SQ.Enabled = true
+
+	t.Run("same config", func(t *testing.T) {
+		assert.False(t, compressionChanged(pq, pq))
+		assert.False(t, compressionChanged(sq, sq))
+	})
+
+	t.Run("different compression", func(t *testing.T) {
+		assert.True(t, compressionChanged(pq, sq))
+		assert.True(t, compressionChanged(sq, pq))
+	})
+
+	t.Run("retrained pq", func(t *testing.T) {
+		next := pq
+		next.PQ.Centroids = 128
+		assert.True(t, compressionChanged(pq, next))
+	})
+
+	t.Run("derived pq segments", func(t *testing.T) {
+		next := pq
+		next.PQ.Segments = 0
+		assert.False(t, compressionChanged(pq, next))
+	})
+
+	t.Run("rescore limit only", func(t *testing.T) {
+		next := sq
+		next.SQ.RescoreLimit = sq.SQ.RescoreLimit + 10
+		assert.False(t, compressionChanged(sq, next))
+	})
+}
+
+func TestRequantization(t *testing.T) {
+	ctx := context.Background()
+	rootPath := t.TempDir()
+	indexID := "requantize"
+	logger, _ := test.NewNullLogger()
+	store := testinghelpers.NewDummyStore(t)
+
+	dimensions := 32
+	vectorsSize := 1000
+	extraSize := 200
+	queriesSize := 20
+	k := 10
+	vectors, queries := testinghelpers.RandomVecs(vectorsSize+extraSize, queriesSize, dimensions)
+	distancer := distancer.NewL2SquaredProvider()
+
+	truths := make([][]uint64, queriesSize)
+	for i := range queries {
+		truths[i], _ = testinghelpers.BruteForce(logger, vectors, queries[i], k, func(x, y []float32) float32 {
+			dist, _ := distancer.SingleDist(x, y)
+			return dist
+		})
+	}
+
+	uc := ent.NewDefaultUserConfig()
+	uc.MaxConnections = 16
+	uc.EFConstruction = 64
+	uc.EF = 100
+
+	newIndex := func() *hnsw {
+		makeCL := func() (CommitLogger, error) {
+			return NewCommitLogger(rootPath, indexID, logger, cyclemanager.NewCallbackGroupNoop())
+		}
+		index, err := New(Config{
+			RootPath:              rootPath,
+			ID:                    indexID,
+			MakeCommitLoggerThunk: makeCL,
+			DistanceProvider:      distancer,
+			Logger:                logger,
+			VectorForIDThunk: func(ctx context.Context, id uint64) ([]float32, error) {
+				if id >= uint64(len(vectors)) {
+					return nil, storobj.NewErrNotFoundf(id, "out of range")
+				}
+				return vectors[int(id)], nil
+			},
+			TempVectorForIDThunk: func(ctx context.Context, id uint64, container *common.VectorSlice) ([]float32, error) {
+				if id >= uint64(len(vectors)) {
+					return nil, storobj.NewErrNotFoundf(id, "out of range")
+				}
+				copy(container.Slice, vectors[int(id)])
+				return container.Slice, nil
+			},
+		}, uc, cyclemanager.NewCallbackGroupNoop(), store)
+		require.Nil(t, err)
+		return index
+	}
+
+	recall := func(index *hnsw) float32 {
+		var relevant uint64
+		for i := range queries {
+			ids, _, err := index.SearchByVector(ctx, queries[i], k, nil)
+			require.Nil(t, err)
+			relevant += testinghelpers.MatchesInLists(truths[i], ids)
+		}
+		return float32(relevant) / float32(queriesSize*k)
+	}
+
+	updateConfig := func(index *hnsw) {
+		wg := sync.WaitGroup{}
+		wg.Add(1)
+		require.Nil(t, index.UpdateUserConfig(uc, wg.Done))
+		wg.Wait()
+	}
+
+	restart := func(index *hnsw) *hnsw {
+		require.Nil(t, index.Flush())
+		require.Nil(t, index.Shutdown(ctx))
+		index = newIndex()
+		index.PostStartup()
+		return index
+	}
+
+	index := newIndex()
+	for id := 0; id < vectorsSize; id++ {
+		require.Nil(t, index.Add(ctx, uint64(id), vectors[id]))
+	}
+
+	t.Run("compress with sq", func(t *testing.T) {
+		uc.SQ.Enabled = true
+		updateConfig(index)
+		require.True(t, index.Compressed())
+		assert.Greater(t, recall(index), float32(0.7))
+	})
+
+	t.Run("switch to rq while inserting", func(t *testing.T) {
+		uc.SQ.Enabled = false
+		uc.RQ.Enabled = true
+
+		wg := sync.WaitGroup{}
+		wg.Add(2)
+		require.Nil(t, index.UpdateUserConfig(uc, wg.Done))
+		go func() {
+			defer wg.Done()
+			for id := vectorsSize; id < vectorsSize+extraSize; id++ {
+				assert.Nil(t, index.Add(ctx, uint64(id), vectors[id]))
+			}
+		}()
+		wg.Wait()
+
+		_, running := index.RequantizationStatus()
+		assert.False(t, running)
+		assert.True(t, index.rqConfig.Enabled)
+		assert.False(t, index.sqConfig.Enabled)
+		assert.Greater(t, recall(index), float32(0.7))
+
+		// the vectors inserted during the requantization are encoded as well
+		for id := vectorsSize; id < vectorsSize+extraSize; id++ {
+			_, err := index.compressor.DistanceBetweenCompressedVectorsFromIDs(ctx, uint64(id), uint64(id))
+			require.Nil(t, err)
+		}
+	})
+
+	t.Run("rq is restored after a restart", func(t *testing.T) {
+		index = restart(index)
+		require.True(t, index.Compressed())
+		assert.Equal(t, compressionRQ, index.restoredCompression)
+		assert.Greater(t, recall(index), float32(0.7))
+	})
+
+	t.Run("switch to bq", func(t *testing.T) {
+		uc.RQ.Enabled = false
+		uc.BQ.Enabled = true
+		updateConfig(index)
+
+		index = restart(index)
+		require.True(t, index.Compressed())
+		assert.Equal(t, compressionBQ, index.restoredCompression)
+		// bq is too coarse for random vectors of this size to be compared with
+		// the recall of the other compressions
+		assert.Greater(t, recall(index), float32(0.3))
+	})
+
+	t.Run("interrupted requantization is resumed", func(t *testing.T) {
+		// the config asks for pq, but the commit log still holds bq
+		require.Nil(t, index.Flush())
+		require.Nil(t, index.Shutdown(ctx))
+
+		uc.BQ.Enabled = false
+		uc.PQ.Enabled = true
+		uc.PQ.Segments = dimensions / 4
+		uc.PQ.Centroids = 64
+		index = newIndex()
+		defer index.Shutdown(ctx)
+		require.Equal(t, compressionBQ, index.restoredCompression)
+
+		index.PostStartup()
+		assert.Eventually(t, func() bool {
+			_, running := index.RequantizationStatus()
+			return !running
+		}, 30*time.Second, 50*time.Millisecond)
+		assert.Greater(t, recall(index), float32(0.7))
+	})
+}
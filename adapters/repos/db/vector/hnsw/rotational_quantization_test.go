@@ -0,0 +1,154 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/testinghelpers"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestRotationalQuantization(t *testing.T) {
+	ctx := context.Background()
+	rootPath := t.TempDir()
+	indexID := "rq"
+	logger, _ := test.NewNullLogger()
+	store := testinghelpers.NewDummyStore(t)
+
+	dimensions := 64
+	vectorsSize := 1000
+	queriesSize := 20
+	k := 10
+	vectors, queries := testinghelpers.RandomVecs(vectorsSize, queriesSize, dimensions)
+	distancer := distancer.NewL2SquaredProvider()
+
+	truths := make([][]uint64, queriesSize)
+	for i := range queries {
+		truths[i], _ = testinghelpers.BruteForce(logger, vectors, queries[i], k, func(x, y []float32) float32 {
+			dist, _ := distancer.SingleDist(x, y)
+			return dist
+		})
+	}
+
+	uc := ent.NewDefaultUserConfig()
+	uc.MaxConnections = 16
+	uc.EFConstruction = 64
+	uc.EF = 64
+	uc.RQ = ent.RQConfig{Enabled: true, Bits: 4, RescoreLimit: 20}
+
+	newIndex := func() *hnsw {
+		makeCL := func() (CommitLogger, error) {
+			return NewCommitLogger(rootPath, indexID, logger, cyclemanager.NewCallbackGroupNoop())
+		}
+		index, err := New(Config{
+			RootPath:              rootPath,
+			ID:                    indexID,
+			MakeCommitLoggerThunk: makeCL,
+			DistanceProvider:      distancer,
+			VectorForIDThunk: func(ctx context.Context, id uint64) ([]float32, error) {
+				return vectors[int(id)], nil
+			},
+			TempVectorForIDThunk: func(ctx context.Context, id uint64, container *common.VectorSlice) ([]float32, error) {
+				copy(container.Slice, vectors[int(id)])
+				return container.Slice, nil
+			},
+		}, uc, cyclemanager.NewCallbackGroupNoop(), store)
+		require.Nil(t, err)
+		return index
+	}
+
+	search := func(index *hnsw) ([][]uint64, float32) {
+		results := make([][]uint64, queriesSize)
+		var relevant uint64
+		for i := range queries {
+			ids, _, err := index.SearchByVector(ctx, queries[i], k, nil)
+			require.Nil(t, err)
+			results[i] = ids
+			relevant += testinghelpers.MatchesInLists(truths[i], ids)
+		}
+		return results, float32(relevant) / float32(queriesSize*k)
+	}
+
+	index := newIndex()
+	var resultsBeforeRestart [][]uint64
+
+	t.Run("vectors are compressed on the first insert", func(t *testing.T) {
+		require.False(t, index.Compressed())
+		for id, vector := range vectors {
+			require.Nil(t, index.Add(ctx, uint64(id), vector))
+		}
+		assert.True(t, index.Compressed())
+	})
+
+	t.Run("recall", func(t *testing.T) {
+		var recall float32
+		resultsBeforeRestart, recall = search(index)
+		assert.Greater(t, recall, float32(0.8))
+	})
+
+	t.Run("quantizer is restored from the commit log", func(t *testing.T) {
+		require.Nil(t, index.Flush())
+		require.Nil(t, index.Shutdown(ctx))
+
+		index = newIndex()
+		defer index.Shutdown(ctx)
+		require.True(t, index.Compressed())
+
+		results, _ := search(index)
+		assert.Equal(t, resultsBeforeRestart, results)
+	})
+}
+
+func TestRotationalQuantizationErrorOnEveryInsert(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+	vectors, _ := testinghelpers.RandomVecs(10, 0, 16)
+
+	uc := ent.NewDefaultUserConfig()
+	uc.RQ = ent.RQConfig{Enabled: true, Bits: 4, RescoreLimit: 20}
+
+	// rotational quantization does not support manhattan distances
+	index, err := New(Config{
+		RootPath: t.TempDir(),
+		ID:       "rq-error",
+		MakeCommitLoggerThunk: func() (CommitLogger, error) {
+			return &NoopCommitLogger{}, nil
+		},
+		DistanceProvider: distancer.NewManhattanProvider(),
+		VectorForIDThunk: func(ctx context.Context, id uint64) ([]float32, error) {
+			return vectors[int(id)], nil
+		},
+		TempVectorForIDThunk: func(ctx context.Context, id uint64, container *common.VectorSlice) ([]float32, error) {
+			copy(container.Slice, vectors[int(id)])
+			return container.Slice, nil
+		},
+		Logger: logger,
+	}, uc, cyclemanager.NewCallbackGroupNoop(), testinghelpers.NewDummyStore(t))
+	require.Nil(t, err)
+	defer index.Shutdown(ctx)
+
+	for id, vector := range vectors {
+		err := index.Add(ctx, uint64(id), vector)
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "compress vectors")
+	}
+	assert.False(t, index.Compressed())
+	assert.True(t, index.isEmpty())
+}
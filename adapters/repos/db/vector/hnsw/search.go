@@ -28,6 +28,7 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/visited"
 	enterrors "github.com/weaviate/weaviate/entities/errors"
 	"github.com/weaviate/weaviate/entities/storobj"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 	"github.com/weaviate/weaviate/usecases/floatcomp"
 )
 
@@ -70,10 +71,18 @@ func (h *hnsw) autoEfFromK(k int) int {
 func (h *hnsw) SearchByVector(ctx context.Context, vector []float32,
 	k int, allowList helpers.AllowList,
 ) ([]uint64, []float32, error) {
+	vector, err := h.truncate(vector)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	h.compressActionLock.RLock()
 	defer h.compressActionLock.RUnlock()
 
 	vector = h.normalizeVec(vector)
+	if strategy, ok := common.FilterStrategyFromContext(ctx); ok && allowList != nil {
+		return h.searchByVectorWithStrategy(ctx, vector, k, allowList, strategy)
+	}
 	flatSearchCutoff := int(atomic.LoadInt64(&h.flatSearchCutoff))
 	if allowList != nil && !h.forbidFlat && allowList.Len() < flatSearchCutoff {
 		helpers.AnnotateSlowQueryLog(ctx, "hnsw_flat_search", true)
@@ -195,8 +204,11 @@ func (h *hnsw) cacheSize() int64 {
 	return size
 }
 
-func (h *hnsw) acornParams(allowList helpers.AllowList) (bool, int) {
+func (h *hnsw) acornParams(ctx context.Context, allowList helpers.AllowList) (bool, int) {
 	useAcorn := h.acornSearch.Load()
+	if strategy, ok := common.FilterStrategyFromContext(ctx); ok {
+		useAcorn = strategy == common.FilterStrategyAcorn
+	}
 	var M int
 
 	if allowList != nil && useAcorn {
@@ -226,7 +238,7 @@ func (h *hnsw) searchLayerByVectorWithDistancer(ctx context.Context,
 	visitedExp := h.pools.visitedLists.Borrow()
 	h.pools.visitedListsLock.RUnlock()
 
-	useAcorn, M := h.acornParams(allowList)
+	useAcorn, M := h.acornParams(ctx, allowList)
 
 	candidates := h.pools.pqCandidates.GetMin(ef)
 	results := h.pools.pqResults.GetMax(ef)
@@ -710,7 +722,7 @@ func (h *hnsw) knnSearchByVector(ctx context.Context, searchVec []float32, k int
 		return nil, nil, nil
 	}
 
-	useAcorn, _ := h.acornParams(allowList)
+	useAcorn, _ := h.acornParams(ctx, allowList)
 
 	if allowList != nil && useAcorn {
 		allowList = NewFastSet(allowList)
@@ -847,23 +859,122 @@ func (h *hnsw) knnSearchByVector(ctx context.Context, searchVec []float32, k int
 
 func (h *hnsw) knnSearchByMultiVector(ctx context.Context, queryVectors [][]float32, k int, allowList helpers.AllowList) ([]uint64, []float32, error) {
 	kPrime := k
-	candidateSet := make(map[uint64]bool)
-	for _, vec := range queryVectors {
+	hits := make([][]uint64, len(queryVectors))
+	for i, vec := range queryVectors {
 		ids, _, err := h.knnSearchByVector(ctx, vec, kPrime, h.searchTimeEF(kPrime), allowList)
 		if err != nil {
 			return nil, nil, err
 		}
+		hits[i] = ids
+	}
+	return h.aggregateMultiVector(queryVectors, k, hits)
+}
+
+// aggregateMultiVector scores the documents of the vectors found for each of
+// the query vectors according to the configured aggregation
+func (h *hnsw) aggregateMultiVector(queryVectors [][]float32, k int, hits [][]uint64) ([]uint64, []float32, error) {
+	config := h.multivectorConfig.Load()
+	switch config.Aggregation {
+	case ent.MultivectorAggregationTopKSum:
+		return h.computeTopKSum(queryVectors, k, config.TopK, hits)
+	case ent.MultivectorAggregationMeanPooling:
+		ids, scores := h.rankByCentroid(queryVectors, k, h.candidateDocs(hits))
+		return ids, scores, nil
+	case ent.MultivectorAggregationCentroidRerank:
+		ids, _ := h.rankByCentroid(queryVectors, config.RerankLimit, h.candidateDocs(hits))
+		candidateSet := make(map[uint64]bool, len(ids))
 		for _, id := range ids {
-			var docId uint64
-			if !h.compressed.Load() {
-				docId, _ = h.cache.GetKeys(id)
-			} else {
-				docId, _ = h.compressor.GetKeys(id)
+			candidateSet[id] = true
+		}
+		return h.computeLateInteraction(queryVectors, k, candidateSet)
+	default:
+		return h.computeLateInteraction(queryVectors, k, h.candidateDocs(hits))
+	}
+}
+
+func (h *hnsw) docIDOfVector(id uint64) uint64 {
+	if h.compressed.Load() {
+		docID, _ := h.compressor.GetKeys(id)
+		return docID
+	}
+	docID, _ := h.cache.GetKeys(id)
+	return docID
+}
+
+func (h *hnsw) candidateDocs(hits [][]uint64) map[uint64]bool {
+	candidateSet := make(map[uint64]bool)
+	for _, ids := range hits {
+		for _, id := range ids {
+			candidateSet[h.docIDOfVector(id)] = true
+		}
+	}
+	return candidateSet
+}
+
+// rankByCentroid returns the limit candidates whose mean vector is the most
+// similar to the mean of the query vectors
+func (h *hnsw) rankByCentroid(queryVectors [][]float32, limit int, candidateSet map[uint64]bool) ([]uint64, []float32) {
+	queryCentroid := common.MeanVector(queryVectors)
+
+	resultsQueue := priorityqueue.NewMin[any](limit)
+	h.RLock()
+	for docID := range candidateSet {
+		centroid, ok := h.docIDCentroids[docID]
+		if !ok {
+			continue
+		}
+		resultsQueue.Insert(docID, h.distancerProvider.Step(queryCentroid, centroid))
+		if resultsQueue.Len() > limit {
+			resultsQueue.Pop()
+		}
+	}
+	h.RUnlock()
+
+	return extractMultiVectorResults(resultsQueue)
+}
+
+// computeTopKSum scores the documents only by the vectors found by the
+// search, so unlike maxSim it does not need to read all document vectors
+func (h *hnsw) computeTopKSum(queryVectors [][]float32, k, topK int, hits [][]uint64) ([]uint64, []float32, error) {
+	maxSims := make(map[uint64][]float32)
+	for i, ids := range hits {
+		vecs, err := h.vectorsByID(ids)
+		if err != nil {
+			return nil, nil, err
+		}
+		for j, id := range ids {
+			docID := h.docIDOfVector(id)
+			sims, ok := maxSims[docID]
+			if !ok {
+				sims = make([]float32, len(queryVectors))
+				for q := range sims {
+					sims[q] = -math.MaxFloat32
+				}
+				maxSims[docID] = sims
+			}
+			if sim := h.distancerProvider.Step(queryVectors[i], vecs[j]); sim > sims[i] {
+				sims[i] = sim
+			}
+		}
+	}
+
+	resultsQueue := priorityqueue.NewMin[any](k)
+	for docID, sims := range maxSims {
+		// query vectors without a match for this document do not count
+		matched := sims[:0]
+		for _, sim := range sims {
+			if sim > -math.MaxFloat32 {
+				matched = append(matched, sim)
 			}
-			candidateSet[docId] = true
+		}
+		resultsQueue.Insert(docID, common.TopKSum(matched, topK))
+		if resultsQueue.Len() > k {
+			resultsQueue.Pop()
 		}
 	}
-	return h.computeLateInteraction(queryVectors, k, candidateSet)
+
+	ids, scores := extractMultiVectorResults(resultsQueue)
+	return ids, scores, nil
 }
 
 func (h *hnsw) computeLateInteraction(queryVectors [][]float32, k int, candidateSet map[uint64]bool) ([]uint64, []float32, error) {
@@ -879,24 +990,41 @@ func (h *hnsw) computeLateInteraction(queryVectors [][]float32, k int, candidate
 		}
 	}
 
-	distances := make([]float32, resultsQueue.Len())
+	ids, distances := extractMultiVectorResults(resultsQueue)
+	return ids, distances, nil
+}
+
+// extractMultiVectorResults returns the documents of the min queue ordered by
+// descending score
+func extractMultiVectorResults(resultsQueue *priorityqueue.Queue[any]) ([]uint64, []float32) {
+	scores := make([]float32, resultsQueue.Len())
 	ids := make([]uint64, resultsQueue.Len())
 
 	i := len(ids) - 1
 	for resultsQueue.Len() > 0 {
 		element := resultsQueue.Pop()
 		ids[i] = element.ID
-		distances[i] = element.Dist
+		scores[i] = element.Dist
 		i--
 	}
 
-	return ids, distances, nil
+	return ids, scores
 }
 
 func (h *hnsw) computeScore(searchVecs [][]float32, docID uint64) (float32, error) {
 	h.RLock()
 	vecIDs := h.docIDVectors[docID]
 	h.RUnlock()
+	docVecs, err := h.vectorsByID(vecIDs)
+	if err != nil {
+		return 0.0, err
+	}
+
+	return common.MaxSimScore(h.distancerProvider.Step, searchVecs, docVecs), nil
+}
+
+// vectorsByID returns the uncompressed vectors of a multi vector index
+func (h *hnsw) vectorsByID(vecIDs []uint64) ([][]float32, error) {
 	docVecs := make([][]float32, len(vecIDs))
 	errs := make([]error, len(vecIDs))
 	if h.compressed.Load() {
@@ -904,7 +1032,7 @@ func (h *hnsw) computeScore(searchVecs [][]float32, docID uint64) (float32, erro
 		for i, vecID := range vecIDs {
 			vec, err := h.TempVectorForIDThunk(context.Background(), vecID, slice)
 			if err != nil {
-				return 0.0, errors.Wrap(err, "get vector for docID")
+				return nil, errors.Wrap(err, "get vector for docID")
 			}
 			docVecs[i] = make([]float32, len(vec))
 			copy(docVecs[i], vec)
@@ -916,29 +1044,19 @@ func (h *hnsw) computeScore(searchVecs [][]float32, docID uint64) (float32, erro
 
 	for _, err := range errs {
 		if err != nil {
-			return 0.0, errors.Wrap(err, "get vector for docID")
+			return nil, errors.Wrap(err, "get vector for docID")
 		}
 	}
-
-	similarity := float32(0.0)
-
-	for _, searchVec := range searchVecs {
-		maxSim := float32(-math.MaxFloat32)
-
-		for _, docVec := range docVecs {
-			dist := h.distancerProvider.Step(searchVec, docVec)
-			if dist > maxSim {
-				maxSim = dist
-			}
-		}
-
-		similarity += maxSim
-	}
-
-	return similarity, nil
+	return docVecs, nil
 }
 
 func (h *hnsw) QueryVectorDistancer(queryVector []float32) common.QueryVectorDistancer {
+	queryVector, err := h.truncate(queryVector)
+	if err != nil {
+		return common.QueryVectorDistancer{DistanceFunc: func(uint64) (float32, error) {
+			return 0, err
+		}}
+	}
 	queryVector = h.normalizeVec(queryVector)
 	if h.compressed.Load() {
 		dist, returnFn := h.compressor.NewDistancer(queryVector)
@@ -969,6 +1087,11 @@ func (h *hnsw) rescore(ctx context.Context, res *priorityqueue.Queue[any], k int
 			res.Pop()
 		}
 	}
+	if h.rqConfig.Enabled && h.rqConfig.RescoreLimit >= k {
+		for res.Len() > h.rqConfig.RescoreLimit {
+			res.Pop()
+		}
+	}
 	ids := make([]uint64, res.Len())
 	i := len(ids) - 1
 	for res.Len() > 0 {
@@ -978,6 +1101,8 @@ func (h *hnsw) rescore(ctx context.Context, res *priorityqueue.Queue[any], k int
 	}
 	res.Reset()
 
+	lowerBounds := h.rescoreLowerBounds(ids, compressorDistancer)
+
 	mu := sync.Mutex{} // protect res
 	addID := func(id uint64, dist float32) {
 		mu.Lock()
@@ -988,6 +1113,17 @@ func (h *hnsw) rescore(ctx context.Context, res *priorityqueue.Queue[any], k int
 			res.Pop()
 		}
 	}
+	// a candidate whose distance is bounded to be larger than the k-th
+	// distance rescored so far can't make it into the results
+	canImprove := func(idPos int) bool {
+		if lowerBounds == nil {
+			return true
+		}
+		mu.Lock()
+		defer mu.Unlock()
+
+		return res.Len() < k || lowerBounds[idPos] <= res.Top().Dist
+	}
 
 	eg := enterrors.NewErrorGroupWrapper(h.logger)
 	for workerID := 0; workerID < h.rescoreConcurrency; workerID++ {
@@ -999,6 +1135,10 @@ func (h *hnsw) rescore(ctx context.Context, res *priorityqueue.Queue[any], k int
 					return fmt.Errorf("rescore: %w", err)
 				}
 
+				if !canImprove(idPos) {
+					continue
+				}
+
 				id := ids[idPos]
 				dist, err := h.distanceFromBytesToFloatNode(compressorDistancer, id)
 				if err == nil {
@@ -1021,6 +1161,32 @@ func (h *hnsw) rescore(ctx context.Context, res *priorityqueue.Queue[any], k int
 	return nil
 }
 
+// rescoreLowerBounds orders the ids by the lower bound of their distance if
+// the compression bounds the error of the estimated distances, which is the
+// case for rotational quantization. The lower bounds are returned in the
+// order of the ids, or nil if the distances are not bounded.
+func (h *hnsw) rescoreLowerBounds(ids []uint64, compressorDistancer compressionhelpers.CompressorDistancer) []float32 {
+	if !h.rqConfig.Enabled {
+		return nil
+	}
+	bounded, ok := compressorDistancer.(compressionhelpers.BoundedCompressorDistancer)
+	if !ok {
+		return nil
+	}
+
+	lowerBounds := make([]float32, len(ids))
+	for i, id := range ids {
+		dist, bound, err := bounded.DistanceToNodeWithBound(id)
+		if err != nil {
+			// rescore the node anyway, where the error is handled
+			dist, bound = 0, math.MaxFloat32
+		}
+		lowerBounds[i] = dist - bound
+	}
+	compressionhelpers.SortByLowerBound(ids, lowerBounds)
+	return lowerBounds
+}
+
 func newSearchByDistParams(maxLimit int64) *searchByDistParams {
 	initialOffset := 0
 	initialLimit := DefaultSearchByDistInitialLimit
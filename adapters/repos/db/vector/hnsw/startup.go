@@ -131,8 +131,12 @@ func (h *hnsw) restoreFromDisk() error {
 	h.tombstoneLock.Unlock()
 
 	if state.Compressed {
+		h.restoredCompression = compressionOfState(state)
 		h.compressed.Store(state.Compressed)
-		h.cache.Drop()
+		if h.cache != nil {
+			// the cache was already dropped if bq is enabled in the config
+			h.cache.Drop()
+		}
 		if state.CompressionPQData != nil {
 			data := state.CompressionPQData
 			h.dims = int32(data.Dimensions)
@@ -173,6 +177,37 @@ func (h *hnsw) restoreFromDisk() error {
 			if err != nil {
 				return errors.Wrap(err, "Restoring compressed data.")
 			}
+		} else if state.CompressionRQData != nil {
+			data := state.CompressionRQData
+			h.dims = int32(data.Dimensions)
+			h.compressor, err = compressionhelpers.RestoreHNSWRQCompressor(
+				h.distancerProvider,
+				1e12,
+				h.logger,
+				*data,
+				h.store,
+				h.allocChecker,
+			)
+			if err != nil {
+				return errors.Wrap(err, "Restoring compressed data.")
+			}
+		} else if state.CompressionBQ {
+			// the compressor was already created if bq is enabled in the config,
+			// otherwise the vectors were requantized to bq
+			if h.compressor == nil {
+				h.compressor, err = compressionhelpers.NewBQCompressor(
+					h.distancerProvider, 1e12, h.logger, h.store, h.allocChecker)
+				if err != nil {
+					return errors.Wrap(err, "Restoring compressed data.")
+				}
+			}
+			// bq does not log the dimensions, they are needed for rescoring and a
+			// later requantization though
+			if len(h.nodes) > 0 && !h.multivector.Load() {
+				if vec, err := h.VectorForIDThunk(context.Background(), h.entryPointID); err == nil {
+					h.dims = int32(len(vec))
+				}
+			}
 		} else {
 			return errors.New("unsupported type while loading compression data")
 		}
@@ -236,6 +271,7 @@ func (h *hnsw) tombstoneCleanup(shouldAbort cyclemanager.ShouldAbortCallback) bo
 // getVectorForID.
 func (h *hnsw) PostStartup() {
 	h.prefillCache()
+	h.resumeRequantization()
 }
 
 func (h *hnsw) prefillCache() {
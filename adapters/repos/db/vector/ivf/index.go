@@ -0,0 +1,1203 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package ivf implements an inverted file vector index. The vectors are
+// partitioned by k-means centroids, which are kept in memory, while the
+// vectors of each partition are stored in a posting list on disk. A search
+// only scans the posting lists of the nprobe centroids closest to the query.
+//
+// Until enough vectors for the training of the centroids have been imported,
+// the index is searched brute force, like the flat index.
+package ivf
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/adapters/repos/db/priorityqueue"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/compressionhelpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	entlsmkv "github.com/weaviate/weaviate/entities/lsmkv"
+	schemaConfig "github.com/weaviate/weaviate/entities/schema/config"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	ivfent "github.com/weaviate/weaviate/entities/vectorindex/ivf"
+	"github.com/weaviate/weaviate/usecases/floatcomp"
+	bolt "go.etcd.io/bbolt"
+)
+
+const (
+	compressionBQ   = "bq"
+	compressionPQ   = "pq"
+	compressionNone = "none"
+
+	// maximum number of centroids of the PQ encoder of each segment
+	pqCentroids = 256
+
+	// number of stored vectors assigned to posting lists at once after the
+	// training
+	assignBatchSize = 1000
+)
+
+type ivf struct {
+	id                string
+	targetVector      string
+	rootPath          string
+	logger            logrus.FieldLogger
+	distancerProvider distancer.Provider
+	store             *lsmkv.Store
+	metadata          *bolt.DB
+	metadataLock      *sync.Mutex
+	pqResults         *common.PqMaxPool
+
+	compression   string
+	pqSegments    int
+	centroidCount int
+	trainingLimit int
+
+	// mutable user config, read atomically on every search
+	nprobe           int64
+	rescore          int64
+	flatSearchCutoff int64
+
+	dims  int32
+	count uint64
+
+	// trainLock guards the publication of the centroids. Writes and searches
+	// hold it for reading.
+	trainLock sync.RWMutex
+	centroids [][]float32
+	pq        *compressionhelpers.ProductQuantizer
+	bq        compressionhelpers.BinaryQuantizer
+	training  atomic.Bool
+
+	// assigned is set once the vectors stored before the training have been
+	// assigned to posting lists. Until then the index is searched brute force.
+	assigned atomic.Bool
+	// assignLock is held while a batch of stored vectors is assigned and by
+	// writes during the assignment, so a vector deleted concurrently never
+	// gets a posting
+	assignLock sync.Mutex
+}
+
+type distanceCalc func(vecAsBytes []byte) (float32, error)
+
+func New(cfg Config, uc ivfent.UserConfig, store *lsmkv.Store) (*ivf, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid config")
+	}
+
+	logger := cfg.Logger
+	if logger == nil {
+		l := logrus.New()
+		l.Out = io.Discard
+		logger = l
+	}
+
+	index := &ivf{
+		id:                cfg.ID,
+		targetVector:      cfg.TargetVector,
+		rootPath:          cfg.RootPath,
+		logger:            logger,
+		distancerProvider: cfg.DistanceProvider,
+		store:             store,
+		metadataLock:      &sync.Mutex{},
+		pqResults:         common.NewPqMaxPool(100),
+		compression:       extractCompression(uc),
+		pqSegments:        uc.PQ.Segments,
+		centroidCount:     uc.Centroids,
+		trainingLimit:     uc.TrainingLimit,
+		nprobe:            int64(uc.NProbe),
+		rescore:           extractCompressionRescore(uc),
+		flatSearchCutoff:  int64(uc.FlatSearchCutoff),
+		bq:                compressionhelpers.NewBinaryQuantizer(nil),
+	}
+	if err := index.initBuckets(context.Background()); err != nil {
+		return nil, fmt.Errorf("init ivf index buckets: %w", err)
+	}
+
+	if err := index.initMetadata(); err != nil {
+		return nil, fmt.Errorf("init ivf index metadata: %w", err)
+	}
+
+	return index, nil
+}
+
+func extractCompression(uc ivfent.UserConfig) string {
+	if uc.BQ.Enabled {
+		return compressionBQ
+	}
+
+	if uc.PQ.Enabled {
+		return compressionPQ
+	}
+
+	return compressionNone
+}
+
+func extractCompressionRescore(uc ivfent.UserConfig) int64 {
+	switch extractCompression(uc) {
+	case compressionPQ:
+		return int64(uc.PQ.RescoreLimit)
+	case compressionBQ:
+		return int64(uc.BQ.RescoreLimit)
+	default:
+		return 0
+	}
+}
+
+func (index *ivf) getBucketName() string {
+	if index.targetVector != "" {
+		return fmt.Sprintf("%s_%s", helpers.VectorsBucketLSM, index.targetVector)
+	}
+	return helpers.VectorsBucketLSM
+}
+
+func (index *ivf) getPostingsBucketName() string {
+	if index.targetVector != "" {
+		return fmt.Sprintf("%s_%s", helpers.VectorsPostingsBucketLSM, index.targetVector)
+	}
+	return helpers.VectorsPostingsBucketLSM
+}
+
+func (index *ivf) initBuckets(ctx context.Context) error {
+	if err := index.store.CreateOrLoadBucket(ctx, index.getBucketName(),
+		lsmkv.WithUseBloomFilter(false),
+		lsmkv.WithCalcCountNetAdditions(false),
+		lsmkv.WithPread(false),
+	); err != nil {
+		return fmt.Errorf("create or load ivf vectors bucket: %w", err)
+	}
+
+	// each posting list is a map from the doc ids to the (compressed) vectors
+	// assigned to a centroid, so a search can read a partition at once
+	if err := index.store.CreateOrLoadBucket(ctx, index.getPostingsBucketName(),
+		lsmkv.WithStrategy(lsmkv.StrategyMapCollection),
+		lsmkv.WithPread(false),
+	); err != nil {
+		return fmt.Errorf("create or load ivf postings bucket: %w", err)
+	}
+	return nil
+}
+
+func (index *ivf) Compressed() bool {
+	return index.compression != compressionNone
+}
+
+func (index *ivf) Multivector() bool {
+	return false
+}
+
+func (index *ivf) trained() bool {
+	return index.centroids != nil
+}
+
+func (index *ivf) normalized(vector []float32) []float32 {
+	if index.distancerProvider.Type() == "cosine-dot" {
+		// cosine-dot requires normalized vectors, as the dot product and cosine
+		// similarity are only identical if the vector is normalized
+		return distancer.Normalize(vector)
+	}
+	return vector
+}
+
+func idToBytes(id uint64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, id)
+	return key
+}
+
+func centroidToBytes(centroid uint64) []byte {
+	key := make([]byte, 4)
+	binary.BigEndian.PutUint32(key, uint32(centroid))
+	return key
+}
+
+func byteSliceFromFloat32Slice(vector []float32) []byte {
+	slice := make([]byte, len(vector)*4)
+	for i := range vector {
+		binary.LittleEndian.PutUint32(slice[i*4:], math.Float32bits(vector[i]))
+	}
+	return slice
+}
+
+func float32SliceFromByteSlice(vector []byte) []float32 {
+	slice := make([]float32, len(vector)/4)
+	for i := range slice {
+		slice[i] = math.Float32frombits(binary.LittleEndian.Uint32(vector[i*4:]))
+	}
+	return slice
+}
+
+func byteSliceFromUint64Slice(vector []uint64) []byte {
+	slice := make([]byte, len(vector)*8)
+	for i := range vector {
+		binary.LittleEndian.PutUint64(slice[i*8:], vector[i])
+	}
+	return slice
+}
+
+func uint64SliceFromByteSlice(vector []byte) []uint64 {
+	slice := make([]uint64, len(vector)/8)
+	for i := range slice {
+		slice[i] = binary.LittleEndian.Uint64(vector[i*8:])
+	}
+	return slice
+}
+
+func residual(vector, centroid []float32) []float32 {
+	out := make([]float32, len(vector))
+	for i := range vector {
+		out[i] = vector[i] - centroid[i]
+	}
+	return out
+}
+
+func (index *ivf) AddBatch(ctx context.Context, ids []uint64, vectors [][]float32) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if len(ids) != len(vectors) {
+		return errors.Errorf("ids and vectors sizes does not match")
+	}
+	if len(ids) == 0 {
+		return errors.Errorf("insertBatch called with empty lists")
+	}
+	for i := range ids {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := index.Add(ctx, ids[i], vectors[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (index *ivf) Add(ctx context.Context, id uint64, vector []float32) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if err := index.ensureDimensions(int32(len(vector))); err != nil {
+		return err
+	}
+	vector = index.normalized(vector)
+
+	index.trainLock.RLock()
+	unlock := index.lockAssignment()
+	err := index.store.Bucket(index.getBucketName()).Put(idToBytes(id), byteSliceFromFloat32Slice(vector))
+	if err == nil && index.trained() {
+		err = index.addToPostingList(id, vector)
+	}
+	unlock()
+	trained := index.trained()
+	index.trainLock.RUnlock()
+	if err != nil {
+		return err
+	}
+
+	count := atomic.AddUint64(&index.count, 1)
+	if !trained && count >= uint64(index.trainingLimit) {
+		index.trainInBackground()
+	}
+	return nil
+}
+
+func (index *ivf) ensureDimensions(dims int32) error {
+	if dims == 0 {
+		return errors.Errorf("insert called with an empty vector")
+	}
+	if atomic.CompareAndSwapInt32(&index.dims, 0, dims) {
+		if err := index.setDimensions(dims); err != nil {
+			index.logger.WithError(err).Error("could not set dimensions")
+		}
+		return nil
+	}
+	if current := atomic.LoadInt32(&index.dims); current != dims {
+		return errors.Errorf("insert called with a vector of the wrong size: expected %d, got %d",
+			current, dims)
+	}
+	return nil
+}
+
+// lockAssignment locks the assignment of the stored vectors if it is in
+// progress and returns the matching unlock. Must be called with the trainLock
+// held.
+func (index *ivf) lockAssignment() func() {
+	if !index.trained() || index.assigned.Load() {
+		return func() {}
+	}
+	index.assignLock.Lock()
+	return index.assignLock.Unlock
+}
+
+// addToPostingList adds the vector to the posting list of its closest
+// centroid. Must be called once the centroids are published.
+func (index *ivf) addToPostingList(id uint64, vector []float32) error {
+	centroid := index.nearestCentroids(vector, 1)[0]
+	return index.store.Bucket(index.getPostingsBucketName()).MapSet(centroidToBytes(centroid),
+		lsmkv.MapPair{Key: idToBytes(id), Value: index.encodePosting(vector, index.centroids[centroid])})
+}
+
+// encodePosting encodes the vector the way it is stored in a posting list.
+// Compressed vectors are encoded relative to their centroid, as the residuals
+// are distributed much more evenly than the vectors themselves.
+func (index *ivf) encodePosting(vector, centroid []float32) []byte {
+	switch index.compression {
+	case compressionPQ:
+		return index.pq.Encode(residual(vector, centroid))
+	case compressionBQ:
+		return byteSliceFromUint64Slice(index.bq.Encode(residual(vector, centroid)))
+	default:
+		return byteSliceFromFloat32Slice(vector)
+	}
+}
+
+func (index *ivf) AddMulti(ctx context.Context, docID uint64, vectors [][]float32) error {
+	return errors.Errorf("AddMulti is not supported for ivf index")
+}
+
+func (index *ivf) AddMultiBatch(ctx context.Context, docIDs []uint64, vectors [][][]float32) error {
+	return errors.Errorf("AddMultiBatch is not supported for ivf index")
+}
+
+func (index *ivf) Delete(ids ...uint64) error {
+	index.trainLock.RLock()
+	defer index.trainLock.RUnlock()
+	defer index.lockAssignment()()
+
+	bucket := index.store.Bucket(index.getBucketName())
+	for _, id := range ids {
+		idBytes := idToBytes(id)
+		vecAsBytes, err := bucket.Get(idBytes)
+		if err != nil {
+			return err
+		}
+		if len(vecAsBytes) == 0 {
+			continue
+		}
+
+		if index.trained() {
+			// the posting list of a vector is found by its centroid, which does
+			// not change once trained
+			centroid := index.nearestCentroids(float32SliceFromByteSlice(vecAsBytes), 1)[0]
+			if err := index.store.Bucket(index.getPostingsBucketName()).
+				MapDeleteKey(centroidToBytes(centroid), idBytes); err != nil {
+				return err
+			}
+		}
+
+		if err := bucket.Delete(idBytes); err != nil {
+			return err
+		}
+		atomic.AddUint64(&index.count, ^uint64(0))
+	}
+	return nil
+}
+
+func (index *ivf) DeleteMulti(ids ...uint64) error {
+	return errors.Errorf("DeleteMulti is not supported for ivf index")
+}
+
+// nearestCentroids returns the n centroids closest to the vector, closest
+// first
+func (index *ivf) nearestCentroids(vector []float32, n int) []uint64 {
+	if n > len(index.centroids) {
+		n = len(index.centroids)
+	}
+
+	heap := priorityqueue.NewMax[any](n)
+	for i, centroid := range index.centroids {
+		dist, err := index.distancerProvider.SingleDist(vector, centroid)
+		if err != nil {
+			continue
+		}
+		insertToHeap(heap, n, uint64(i), dist)
+	}
+
+	ids, _ := extractHeap(heap)
+	return ids
+}
+
+func (index *ivf) searchTimeRescore(k int) int {
+	// load atomically, so we can get away with concurrent updates of the
+	// userconfig without having to set a lock each time we try to read - which
+	// can be so common that it would cause considerable overhead
+	if rescore := int(atomic.LoadInt64(&index.rescore)); rescore > k {
+		return rescore
+	}
+	return k
+}
+
+// searchTimeNProbe returns the nprobe of the query, if set, or else the one
+// of the config
+func (index *ivf) searchTimeNProbe(ctx context.Context) int {
+	if nprobe, ok := searchparams.NProbeFromContext(ctx); ok && nprobe > 0 {
+		return nprobe
+	}
+	return int(atomic.LoadInt64(&index.nprobe))
+}
+
+func (index *ivf) SearchByVector(ctx context.Context, vector []float32, k int, allow helpers.AllowList) ([]uint64, []float32, error) {
+	if allow != nil && allow.IsEmpty() {
+		return nil, nil, nil
+	}
+	vector = index.normalized(vector)
+
+	index.trainLock.RLock()
+	defer index.trainLock.RUnlock()
+
+	// a restrictive filter is faster and exact when searching the allowed
+	// vectors directly
+	if allow != nil && int64(allow.Len()) < atomic.LoadInt64(&index.flatSearchCutoff) {
+		return index.searchAllowed(ctx, vector, k, allow)
+	}
+
+	if !index.trained() || !index.assigned.Load() {
+		return index.searchFlat(ctx, vector, k, allow)
+	}
+	return index.searchPostingLists(ctx, vector, k, allow)
+}
+
+func (index *ivf) SearchByMultiVector(ctx context.Context, vectors [][]float32, k int, allow helpers.AllowList) ([]uint64, []float32, error) {
+	return nil, nil, errors.Errorf("SearchByMultiVector is not supported for ivf index")
+}
+
+func (index *ivf) searchAllowed(ctx context.Context, vector []float32, k int, allow helpers.AllowList,
+) ([]uint64, []float32, error) {
+	heap := index.pqResults.GetMax(k)
+	defer index.pqResults.Put(heap)
+
+	distanceCalc := index.createDistanceCalc(vector)
+	bucket := index.store.Bucket(index.getBucketName())
+	it := allow.Iterator()
+	for id, ok := it.Next(); ok; id, ok = it.Next() {
+		if err := ctx.Err(); err != nil {
+			return nil, nil, err
+		}
+		vecAsBytes, err := bucket.Get(idToBytes(id))
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(vecAsBytes) == 0 {
+			continue
+		}
+		dist, err := distanceCalc(vecAsBytes)
+		if err != nil {
+			return nil, nil, err
+		}
+		insertToHeap(heap, k, id, dist)
+	}
+
+	ids, dists := extractHeap(heap)
+	return ids, dists, nil
+}
+
+func (index *ivf) searchFlat(ctx context.Context, vector []float32, k int, allow helpers.AllowList,
+) ([]uint64, []float32, error) {
+	heap := index.pqResults.GetMax(k)
+	defer index.pqResults.Put(heap)
+
+	distanceCalc := index.createDistanceCalc(vector)
+	cursor := index.store.Bucket(index.getBucketName()).Cursor()
+	defer cursor.Close()
+
+	for key, v := cursor.First(); key != nil; key, v = cursor.Next() {
+		if err := ctx.Err(); err != nil {
+			return nil, nil, err
+		}
+		id := binary.BigEndian.Uint64(key)
+		if allow != nil && !allow.Contains(id) {
+			continue
+		}
+		dist, err := distanceCalc(v)
+		if err != nil {
+			return nil, nil, err
+		}
+		insertToHeap(heap, k, id, dist)
+	}
+
+	ids, dists := extractHeap(heap)
+	return ids, dists, nil
+}
+
+func (index *ivf) searchPostingLists(ctx context.Context, vector []float32, k int, allow helpers.AllowList,
+) ([]uint64, []float32, error) {
+	limit := k
+	if index.Compressed() {
+		limit = index.searchTimeRescore(k)
+	}
+	heap := index.pqResults.GetMax(limit)
+	defer index.pqResults.Put(heap)
+
+	var pqDistancer *compressionhelpers.PQDistancer
+	if index.compression == compressionPQ && !index.pqResidualQuery() {
+		// for dot products the query does not depend on the centroid
+		pqDistancer = index.pq.NewDistancer(vector)
+		defer index.pq.ReturnDistancer(pqDistancer)
+	}
+
+	postings := index.store.Bucket(index.getPostingsBucketName())
+	for _, centroid := range index.nearestCentroids(vector, index.searchTimeNProbe(ctx)) {
+		pairs, err := postings.MapList(ctx, centroidToBytes(centroid))
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "read posting list of centroid %d", centroid)
+		}
+		if len(pairs) == 0 {
+			continue
+		}
+
+		distanceCalc, release, err := index.createPostingDistanceCalc(vector, index.centroids[centroid], pqDistancer)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, pair := range pairs {
+			id := binary.BigEndian.Uint64(pair.Key)
+			if allow != nil && !allow.Contains(id) {
+				continue
+			}
+			dist, err := distanceCalc(pair.Value)
+			if err != nil {
+				release()
+				return nil, nil, err
+			}
+			insertToHeap(heap, limit, id, dist)
+		}
+		release()
+	}
+
+	if !index.Compressed() {
+		ids, dists := extractHeap(heap)
+		return ids, dists, nil
+	}
+	return index.rescoreHeap(heap, k, vector)
+}
+
+// rescoreHeap replaces the distances of the compressed vectors of the heap
+// with the exact distances and returns the k closest vectors
+func (index *ivf) rescoreHeap(heap *priorityqueue.Queue[any], k int, vector []float32,
+) ([]uint64, []float32, error) {
+	candidates := make([]uint64, heap.Len())
+	for i := range candidates {
+		candidates[i] = heap.Pop().ID
+	}
+
+	distanceCalc := index.createDistanceCalc(vector)
+	bucket := index.store.Bucket(index.getBucketName())
+	for _, id := range candidates {
+		vecAsBytes, err := bucket.Get(idToBytes(id))
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(vecAsBytes) == 0 {
+			continue
+		}
+		dist, err := distanceCalc(vecAsBytes)
+		if err != nil {
+			return nil, nil, err
+		}
+		insertToHeap(heap, k, id, dist)
+	}
+
+	ids, dists := extractHeap(heap)
+	return ids, dists, nil
+}
+
+func (index *ivf) createDistanceCalc(vector []float32) distanceCalc {
+	return func(vecAsBytes []byte) (float32, error) {
+		return index.distancerProvider.SingleDist(vector, float32SliceFromByteSlice(vecAsBytes))
+	}
+}
+
+// pqResidualQuery reports whether PQ distances are calculated between the
+// residuals of the query and the stored vectors. This is the case for
+// l2-squared, whereas the dot product of a query with a stored vector is
+// the dot product with its centroid plus the dot product with its residual.
+func (index *ivf) pqResidualQuery() bool {
+	return index.distancerProvider.Type() == "l2-squared"
+}
+
+func (index *ivf) pqDistancer() distancer.Provider {
+	if index.pqResidualQuery() {
+		return distancer.NewL2SquaredProvider()
+	}
+	return distancer.NewDotProductProvider()
+}
+
+// createPostingDistanceCalc returns the distance calculation for the vectors
+// stored in the posting list of the given centroid, along with a function
+// releasing its resources
+func (index *ivf) createPostingDistanceCalc(vector, centroid []float32,
+	pqDistancer *compressionhelpers.PQDistancer,
+) (distanceCalc, func(), error) {
+	switch index.compression {
+	case compressionPQ:
+		if index.pqResidualQuery() {
+			d := index.pq.NewDistancer(residual(vector, centroid))
+			return func(code []byte) (float32, error) {
+				return d.Distance(code)
+			}, func() { index.pq.ReturnDistancer(d) }, nil
+		}
+
+		centroidDist, err := index.distancerProvider.SingleDist(vector, centroid)
+		if err != nil {
+			return nil, nil, err
+		}
+		return func(code []byte) (float32, error) {
+			dist, err := pqDistancer.Distance(code)
+			return centroidDist + dist, err
+		}, func() {}, nil
+	case compressionBQ:
+		queryBQ := index.bq.Encode(residual(vector, centroid))
+		return func(code []byte) (float32, error) {
+			return index.bq.DistanceBetweenCompressedVectors(uint64SliceFromByteSlice(code), queryBQ)
+		}, func() {}, nil
+	default:
+		return index.createDistanceCalc(vector), func() {}, nil
+	}
+}
+
+func insertToHeap(heap *priorityqueue.Queue[any], limit int, id uint64, distance float32) {
+	if heap.Len() < limit {
+		heap.Insert(id, distance)
+	} else if heap.Top().Dist > distance {
+		heap.Pop()
+		heap.Insert(id, distance)
+	}
+}
+
+func extractHeap(heap *priorityqueue.Queue[any]) ([]uint64, []float32) {
+	len := heap.Len()
+
+	ids := make([]uint64, len)
+	dists := make([]float32, len)
+	for i := len - 1; i >= 0; i-- {
+		item := heap.Pop()
+		ids[i] = item.ID
+		dists[i] = item.Dist
+	}
+	return ids, dists
+}
+
+func (index *ivf) trainInBackground() {
+	if !index.training.CompareAndSwap(false, true) {
+		return
+	}
+
+	enterrors.GoWrapper(func() {
+		defer index.training.Store(false)
+
+		if err := index.train(); err != nil {
+			index.logger.WithField("action", "ivf_train").WithField("id", index.id).
+				WithError(err).Error("training ivf index failed")
+		}
+	}, index.logger)
+}
+
+// train fits the centroids (and PQ encoders) to the stored vectors and
+// assigns all stored vectors to the posting lists of their centroids. The
+// centroids are persisted before they are published, so an assignment
+// interrupted by a crash is resumed on startup. As the centroids do not
+// change, assigning a vector again only replaces its posting.
+func (index *ivf) train() error {
+	index.trainLock.RLock()
+	trained := index.trained()
+	index.trainLock.RUnlock()
+
+	if !trained {
+		centroids, pq, err := index.fit()
+		if err != nil {
+			return err
+		}
+
+		index.trainLock.Lock()
+		index.centroids = centroids
+		index.pq = pq
+		index.trainLock.Unlock()
+
+		index.logger.WithField("action", "ivf_train").WithField("id", index.id).
+			WithField("centroids", len(centroids)).
+			Debug("trained ivf index")
+	}
+
+	if index.assigned.Load() {
+		return nil
+	}
+	if err := index.assignStored(); err != nil {
+		return errors.Wrap(err, "assign vectors to posting lists")
+	}
+	return nil
+}
+
+// fit fits and persists the centroids (and PQ encoders)
+func (index *ivf) fit() ([][]float32, *compressionhelpers.ProductQuantizer, error) {
+	dims := int(atomic.LoadInt32(&index.dims))
+	sample, err := index.trainingSample()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	kmeans := compressionhelpers.NewKMeans(index.centroidCount, dims, 0)
+	if err := kmeans.Fit(sample); err != nil {
+		return nil, nil, errors.Wrap(err, "fit centroids")
+	}
+	centroids := kmeans.Centers()
+
+	var pq *compressionhelpers.ProductQuantizer
+	var pqEncoders []compressionhelpers.PQEncoder
+	if index.compression == compressionPQ {
+		if index.pqSegments == 0 {
+			index.pqSegments = common.CalculateOptimalSegments(dims)
+		}
+		pq, err = compressionhelpers.NewProductQuantizer(index.pqConfig(min(pqCentroids, len(sample))),
+			index.pqDistancer(), dims, index.logger)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "create pq encoder")
+		}
+
+		residuals := make([][]float32, len(sample))
+		for i, vector := range sample {
+			residuals[i] = residual(vector, centroids[kmeans.Nearest(vector)])
+		}
+		if err := pq.Fit(residuals); err != nil {
+			return nil, nil, errors.Wrap(err, "fit pq encoder")
+		}
+		// the encoders are only exposed for persistence
+		var data pqDataCapture
+		pq.PersistCompression(&data)
+		pqEncoders = data.Encoders
+	}
+
+	if err := index.persistTraining(centroids, pq, pqEncoders); err != nil {
+		return nil, nil, errors.Wrap(err, "persist centroids")
+	}
+	return centroids, pq, nil
+}
+
+// assignStored assigns the stored vectors to the posting lists in batches,
+// so that writes are only blocked for the duration of a batch
+func (index *ivf) assignStored() error {
+	var from []byte
+	for {
+		ids := index.storedIDs(from, assignBatchSize)
+		if err := index.assignBatch(ids); err != nil {
+			return err
+		}
+		if len(ids) < assignBatchSize || ids[len(ids)-1] == math.MaxUint64 {
+			break
+		}
+		from = idToBytes(ids[len(ids)-1] + 1)
+	}
+
+	if err := index.setAssigned(); err != nil {
+		return errors.Wrap(err, "persist assignment")
+	}
+	index.assigned.Store(true)
+	return nil
+}
+
+// storedIDs returns up to n ids of stored vectors, starting at the given key.
+// The cursor is closed before the batch is assigned, so that it does not
+// block flushes of the bucket.
+func (index *ivf) storedIDs(from []byte, n int) []uint64 {
+	cursor := index.store.Bucket(index.getBucketName()).Cursor()
+	defer cursor.Close()
+
+	ids := make([]uint64, 0, n)
+	var key []byte
+	if from == nil {
+		key, _ = cursor.First()
+	} else {
+		key, _ = cursor.Seek(from)
+	}
+	for ; key != nil && len(ids) < n; key, _ = cursor.Next() {
+		ids = append(ids, binary.BigEndian.Uint64(key))
+	}
+	return ids
+}
+
+func (index *ivf) assignBatch(ids []uint64) error {
+	index.assignLock.Lock()
+	defer index.assignLock.Unlock()
+
+	bucket := index.store.Bucket(index.getBucketName())
+	for _, id := range ids {
+		// the vector is read again, as it may have been deleted since the ids
+		// were listed
+		vecAsBytes, err := bucket.Get(idToBytes(id))
+		if err != nil {
+			return err
+		}
+		if len(vecAsBytes) == 0 {
+			continue
+		}
+		if err := index.addToPostingList(id, float32SliceFromByteSlice(vecAsBytes)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// pqDataCapture captures the data a product quantizer persists
+type pqDataCapture struct {
+	compressionhelpers.PQData
+}
+
+func (c *pqDataCapture) AddPQCompression(data compressionhelpers.PQData) error {
+	c.PQData = data
+	return nil
+}
+
+func (c *pqDataCapture) AddSQCompression(compressionhelpers.SQData) error {
+	return nil
+}
+
+func (c *pqDataCapture) AddRQCompression(compressionhelpers.RQData) error {
+	return nil
+}
+
+func (c *pqDataCapture) AddBQCompression() error {
+	return nil
+}
+
+// trainingSample reads up to trainingLimit of the stored vectors
+func (index *ivf) trainingSample() ([][]float32, error) {
+	sample := make([][]float32, 0, index.trainingLimit)
+	cursor := index.store.Bucket(index.getBucketName()).Cursor()
+	defer cursor.Close()
+
+	for key, v := cursor.First(); key != nil && len(sample) < index.trainingLimit; key, v = cursor.Next() {
+		sample = append(sample, float32SliceFromByteSlice(v))
+	}
+	if len(sample) < index.centroidCount {
+		return nil, errors.Errorf("not enough vectors to train %d centroids: %d", index.centroidCount, len(sample))
+	}
+	return sample, nil
+}
+
+func (index *ivf) SearchByVectorDistance(ctx context.Context, vector []float32,
+	targetDistance float32, maxLimit int64, allow helpers.AllowList,
+) ([]uint64, []float32, error) {
+	var (
+		searchParams = newSearchByDistParams(maxLimit)
+
+		resultIDs  []uint64
+		resultDist []float32
+	)
+
+	recursiveSearch := func() (bool, error) {
+		totalLimit := searchParams.TotalLimit()
+		ids, dist, err := index.SearchByVector(ctx, vector, totalLimit, allow)
+		if err != nil {
+			return false, errors.Wrap(err, "vector search")
+		}
+
+		// if there is less results than given limit search can be stopped
+		shouldContinue := !(len(ids) < totalLimit)
+
+		// ensures the indexes aren't out of range
+		offsetCap := searchParams.OffsetCapacity(ids)
+		totalLimitCap := searchParams.TotalLimitCapacity(ids)
+
+		if offsetCap == totalLimitCap {
+			return false, nil
+		}
+
+		ids, dist = ids[offsetCap:totalLimitCap], dist[offsetCap:totalLimitCap]
+		for i := range ids {
+			if aboveThresh := dist[i] <= targetDistance; aboveThresh ||
+				floatcomp.InDelta(float64(dist[i]), float64(targetDistance), 1e-6) {
+				resultIDs = append(resultIDs, ids[i])
+				resultDist = append(resultDist, dist[i])
+			} else {
+				// as soon as we encounter a certainty which
+				// is below threshold, we can stop searching
+				shouldContinue = false
+				break
+			}
+		}
+
+		return shouldContinue, nil
+	}
+
+	var shouldContinue bool
+	var err error
+	for shouldContinue, err = recursiveSearch(); shouldContinue && err == nil; {
+		searchParams.Iterate()
+		if searchParams.MaxLimitReached() {
+			index.logger.
+				WithField("action", "unlimited_vector_search").
+				Warnf("maximum search limit of %d results has been reached",
+					searchParams.MaximumSearchLimit())
+			break
+		}
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return resultIDs, resultDist, nil
+}
+
+func newSearchByDistParams(maxLimit int64) *common.SearchByDistParams {
+	initialOffset := 0
+	initialLimit := common.DefaultSearchByDistInitialLimit
+
+	return common.NewSearchByDistParams(initialOffset, initialLimit, initialOffset+initialLimit, maxLimit)
+}
+
+func (index *ivf) UpdateUserConfig(updated schemaConfig.VectorIndexConfig, callback func()) error {
+	parsed, ok := updated.(ivfent.UserConfig)
+	if !ok {
+		callback()
+		return errors.Errorf("config is not UserConfig, but %T", updated)
+	}
+
+	// Store atomically as a lock here would be very expensive, these values are
+	// read on every single user-facing search, which can be highly concurrent
+	atomic.StoreInt64(&index.nprobe, int64(parsed.NProbe))
+	atomic.StoreInt64(&index.rescore, extractCompressionRescore(parsed))
+	atomic.StoreInt64(&index.flatSearchCutoff, int64(parsed.FlatSearchCutoff))
+
+	callback()
+	return nil
+}
+
+type immutableParameter struct {
+	accessor func(c ivfent.UserConfig) interface{}
+	name     string
+}
+
+func validateImmutableField(u immutableParameter,
+	previous, next ivfent.UserConfig,
+) error {
+	oldField := u.accessor(previous)
+	newField := u.accessor(next)
+	if oldField != newField {
+		return errors.Errorf("%s is immutable: attempted change from \"%v\" to \"%v\"",
+			u.name, oldField, newField)
+	}
+
+	return nil
+}
+
+func ValidateUserConfigUpdate(initial, updated schemaConfig.VectorIndexConfig) error {
+	initialParsed, ok := initial.(ivfent.UserConfig)
+	if !ok {
+		return errors.Errorf("initial is not UserConfig, but %T", initial)
+	}
+
+	updatedParsed, ok := updated.(ivfent.UserConfig)
+	if !ok {
+		return errors.Errorf("updated is not UserConfig, but %T", updated)
+	}
+
+	immutableFields := []immutableParameter{
+		{
+			name:     "distance",
+			accessor: func(c ivfent.UserConfig) interface{} { return c.Distance },
+		},
+		{
+			name:     "centroids",
+			accessor: func(c ivfent.UserConfig) interface{} { return c.Centroids },
+		},
+		{
+			name:     "trainingLimit",
+			accessor: func(c ivfent.UserConfig) interface{} { return c.TrainingLimit },
+		},
+		{
+			name:     "pq",
+			accessor: func(c ivfent.UserConfig) interface{} { return c.PQ.Enabled },
+		},
+		{
+			name:     "pq.segments",
+			accessor: func(c ivfent.UserConfig) interface{} { return c.PQ.Segments },
+		},
+		{
+			name:     "bq",
+			accessor: func(c ivfent.UserConfig) interface{} { return c.BQ.Enabled },
+		},
+	}
+
+	for _, u := range immutableFields {
+		if err := validateImmutableField(u, initialParsed, updatedParsed); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (index *ivf) Drop(ctx context.Context) error {
+	if err := index.removeMetadataFile(); err != nil {
+		return err
+	}
+	// Shard::drop will take care of handling store's buckets
+	return nil
+}
+
+func (index *ivf) Flush() error {
+	// nothing to do here
+	// Shard will take care of handling store's buckets
+	return nil
+}
+
+func (index *ivf) Shutdown(ctx context.Context) error {
+	// Shard::shutdown will take care of handling store's buckets
+	return nil
+}
+
+func (index *ivf) SwitchCommitLogs(context.Context) error {
+	return nil
+}
+
+func (index *ivf) ListFiles(ctx context.Context, basePath string) ([]string, error) {
+	var files []string
+
+	fullPath := filepath.Join(index.rootPath, index.getMetadataFile())
+	if _, err := os.Stat(fullPath); err == nil {
+		relPath, err := filepath.Rel(basePath, fullPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get relative path: %w", err)
+		}
+		// If the file doesn't exist, we simply don't add it to the list
+		files = append(files, relPath)
+	}
+
+	return files, nil
+}
+
+func (index *ivf) GetKeys(id uint64) (uint64, uint64, error) {
+	return 0, 0, errors.Errorf("GetKeys is not supported for ivf index")
+}
+
+func (index *ivf) ValidateBeforeInsert(vector []float32) error {
+	return nil
+}
+
+func (index *ivf) ValidateMultiBeforeInsert(vector [][]float32) error {
+	return nil
+}
+
+func (index *ivf) PostStartup() {
+	// the count is not persisted
+	count := uint64(0)
+	cursor := index.store.Bucket(index.getBucketName()).Cursor()
+	for key, _ := cursor.First(); key != nil; key, _ = cursor.Next() {
+		count++
+	}
+	cursor.Close()
+	atomic.AddUint64(&index.count, count)
+
+	index.trainLock.RLock()
+	trained := index.trained()
+	index.trainLock.RUnlock()
+	if trained {
+		if !index.assigned.Load() {
+			// resume an assignment interrupted by a crash
+			index.trainInBackground()
+		}
+		return
+	}
+
+	if atomic.LoadUint64(&index.count) >= uint64(index.trainingLimit) {
+		index.trainInBackground()
+	}
+}
+
+func (index *ivf) Dump(labels ...string) {
+	if len(labels) > 0 {
+		fmt.Printf("--------------------------------------------------\n")
+		fmt.Printf("--  %s\n", strings.Join(labels, ", "))
+	}
+	fmt.Printf("--------------------------------------------------\n")
+	fmt.Printf("ID: %s\n", index.id)
+	fmt.Printf("Centroids: %d\n", len(index.centroids))
+	fmt.Printf("--------------------------------------------------\n")
+}
+
+func (index *ivf) DistanceBetweenVectors(x, y []float32) (float32, error) {
+	return index.distancerProvider.SingleDist(x, y)
+}
+
+func (index *ivf) ContainsNode(id uint64) bool {
+	v, err := index.store.Bucket(index.getBucketName()).Get(idToBytes(id))
+	if v == nil || err == entlsmkv.NotFound {
+		return false
+	}
+	return true
+}
+
+func (index *ivf) Iterate(fn func(id uint64) bool) {
+	cursor := index.store.Bucket(index.getBucketName()).Cursor()
+	defer cursor.Close()
+
+	for key, _ := cursor.First(); key != nil; key, _ = cursor.Next() {
+		if !fn(binary.BigEndian.Uint64(key)) {
+			break
+		}
+	}
+}
+
+func (index *ivf) DistancerProvider() distancer.Provider {
+	return index.distancerProvider
+}
+
+func (index *ivf) AlreadyIndexed() uint64 {
+	return atomic.LoadUint64(&index.count)
+}
+
+func (index *ivf) QueryVectorDistancer(queryVector []float32) common.QueryVectorDistancer {
+	queryVector = index.normalized(queryVector)
+	bucket := index.store.Bucket(index.getBucketName())
+	return common.QueryVectorDistancer{DistanceFunc: func(nodeID uint64) (float32, error) {
+		vec, err := bucket.Get(idToBytes(nodeID))
+		if err != nil {
+			return 0, err
+		}
+		if len(vec) == 0 {
+			return 0, fmt.Errorf("vector for node %d not found", nodeID)
+		}
+		return index.distancerProvider.SingleDist(queryVector, float32SliceFromByteSlice(vec))
+	}}
+}
+
+func (index *ivf) Stats() (common.IndexStats, error) {
+	index.trainLock.RLock()
+	defer index.trainLock.RUnlock()
+
+	return &IVFStats{
+		Trained:   index.trained(),
+		Centroids: len(index.centroids),
+	}, nil
+}
+
+type IVFStats struct {
+	Trained   bool `json:"trained"`
+	Centroids int  `json:"centroids"`
+}
+
+func (s *IVFStats) IndexType() common.IndexType {
+	return common.IndexTypeIVF
+}
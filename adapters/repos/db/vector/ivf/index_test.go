@@ -0,0 +1,354 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package ivf
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/testinghelpers"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	ivfent "github.com/weaviate/weaviate/entities/vectorindex/ivf"
+)
+
+const (
+	testDimensions = 32
+	testVectors    = 2000
+	testQueries    = 50
+	testK          = 10
+)
+
+func distanceWrapper(provider distancer.Provider) func(x, y []float32) float32 {
+	return func(x, y []float32) float32 {
+		dist, _ := provider.SingleDist(x, y)
+		return dist
+	}
+}
+
+func newTestStore(t *testing.T, dir string) *lsmkv.Store {
+	logger, _ := test.NewNullLogger()
+	store, err := lsmkv.New(dir, dir, logger, nil,
+		cyclemanager.NewCallbackGroupNoop(),
+		cyclemanager.NewCallbackGroupNoop(),
+		cyclemanager.NewCallbackGroupNoop())
+	require.Nil(t, err)
+	return store
+}
+
+func testUserConfig() ivfent.UserConfig {
+	uc := ivfent.NewDefaultUserConfig()
+	uc.Centroids = 16
+	uc.NProbe = 8
+	uc.TrainingLimit = testVectors / 2
+	return uc
+}
+
+func newTestIndex(t *testing.T, dir string, store *lsmkv.Store, provider distancer.Provider,
+	uc ivfent.UserConfig,
+) *ivf {
+	index, err := New(Config{
+		ID:               "ivf-test",
+		RootPath:         dir,
+		DistanceProvider: provider,
+	}, uc, store)
+	require.Nil(t, err)
+	return index
+}
+
+func addAndTrain(t *testing.T, index *ivf, vectors [][]float32) {
+	ctx := context.Background()
+	for i, vec := range vectors {
+		require.Nil(t, index.Add(ctx, uint64(i), vec))
+	}
+	require.Eventually(t, func() bool {
+		return index.assigned.Load()
+	}, 30*time.Second, 10*time.Millisecond)
+}
+
+func recall(t *testing.T, index *ivf, vectors, queries [][]float32, provider distancer.Provider,
+	allow helpers.AllowList,
+) float32 {
+	logger, _ := test.NewNullLogger()
+	var relevant uint64
+	for _, query := range queries {
+		var truth []uint64
+		if allow == nil {
+			truth, _ = testinghelpers.BruteForce(logger, vectors, query, testK, distanceWrapper(provider))
+		} else {
+			allowed := make([][]float32, len(vectors))
+			for i := range vectors {
+				if allow.Contains(uint64(i)) {
+					allowed[i] = vectors[i]
+				}
+			}
+			truth, _ = testinghelpers.BruteForce(logger, allowed, query, testK, distanceWrapper(provider))
+		}
+
+		results, _, err := index.SearchByVector(context.Background(), query, testK, allow)
+		require.Nil(t, err)
+		relevant += testinghelpers.MatchesInLists(truth, results)
+	}
+	return float32(relevant) / float32(testK*len(queries))
+}
+
+func TestIVF(t *testing.T) {
+	vectors, queries := testinghelpers.RandomVecsFixedSeed(testVectors, testQueries, testDimensions)
+	provider := distancer.NewL2SquaredProvider()
+
+	tests := []struct {
+		name      string
+		configure func(uc *ivfent.UserConfig)
+		minRecall float32
+	}{
+		{name: "uncompressed", configure: func(uc *ivfent.UserConfig) {}, minRecall: 0.8},
+		{name: "bq", configure: func(uc *ivfent.UserConfig) {
+			uc.BQ = ivfent.CompressionUserConfig{Enabled: true, RescoreLimit: 200}
+		}, minRecall: 0.7},
+		{name: "pq", configure: func(uc *ivfent.UserConfig) {
+			uc.PQ = ivfent.CompressionUserConfig{Enabled: true, RescoreLimit: 200, Segments: 8}
+		}, minRecall: 0.7},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			store := newTestStore(t, dir)
+			defer store.Shutdown(context.Background())
+
+			uc := testUserConfig()
+			tt.configure(&uc)
+			index := newTestIndex(t, dir, store, provider, uc)
+			addAndTrain(t, index, vectors)
+
+			assert.GreaterOrEqual(t, recall(t, index, vectors, queries, provider, nil), tt.minRecall)
+
+			t.Run("with restart", func(t *testing.T) {
+				restarted := newTestIndex(t, dir, store, provider, uc)
+				restarted.PostStartup()
+				require.True(t, restarted.trained())
+				require.True(t, restarted.assigned.Load())
+				assert.GreaterOrEqual(t, recall(t, restarted, vectors, queries, provider, nil), tt.minRecall)
+			})
+		})
+	}
+}
+
+func TestIVFBeforeTraining(t *testing.T) {
+	vectors, queries := testinghelpers.RandomVecsFixedSeed(100, testQueries, testDimensions)
+	provider := distancer.NewCosineDistanceProvider()
+
+	dir := t.TempDir()
+	store := newTestStore(t, dir)
+	defer store.Shutdown(context.Background())
+
+	index := newTestIndex(t, dir, store, provider, testUserConfig())
+	for i, vec := range vectors {
+		require.Nil(t, index.Add(context.Background(), uint64(i), vec))
+	}
+	require.False(t, index.trained())
+
+	// searched brute force
+	testinghelpers.Normalize(vectors)
+	assert.Equal(t, float32(1), recall(t, index, vectors, queries, provider, nil))
+}
+
+func TestIVFFilteredSearch(t *testing.T) {
+	vectors, queries := testinghelpers.RandomVecsFixedSeed(testVectors, testQueries, testDimensions)
+	provider := distancer.NewL2SquaredProvider()
+
+	dir := t.TempDir()
+	store := newTestStore(t, dir)
+	defer store.Shutdown(context.Background())
+
+	uc := testUserConfig()
+	index := newTestIndex(t, dir, store, provider, uc)
+	addAndTrain(t, index, vectors)
+
+	allow := helpers.NewAllowList()
+	for i := 0; i < testVectors; i += 10 {
+		allow.Insert(uint64(i))
+	}
+
+	t.Run("below flat search cutoff", func(t *testing.T) {
+		assert.Equal(t, float32(1), recall(t, index, vectors, queries, provider, allow))
+	})
+
+	t.Run("above flat search cutoff", func(t *testing.T) {
+		uc.FlatSearchCutoff = 0
+		require.Nil(t, index.UpdateUserConfig(uc, func() {}))
+
+		results, _, err := index.SearchByVector(context.Background(), queries[0], testK, allow)
+		require.Nil(t, err)
+		require.NotEmpty(t, results)
+		for _, id := range results {
+			assert.True(t, allow.Contains(id))
+		}
+	})
+
+	t.Run("empty allow list", func(t *testing.T) {
+		results, _, err := index.SearchByVector(context.Background(), queries[0], testK, helpers.NewAllowList())
+		require.Nil(t, err)
+		assert.Empty(t, results)
+	})
+}
+
+func TestIVFDelete(t *testing.T) {
+	vectors, _ := testinghelpers.RandomVecsFixedSeed(testVectors, 0, testDimensions)
+	provider := distancer.NewL2SquaredProvider()
+
+	dir := t.TempDir()
+	store := newTestStore(t, dir)
+	defer store.Shutdown(context.Background())
+
+	index := newTestIndex(t, dir, store, provider, testUserConfig())
+	addAndTrain(t, index, vectors)
+
+	results, _, err := index.SearchByVector(context.Background(), vectors[7], 1, nil)
+	require.Nil(t, err)
+	require.Equal(t, []uint64{7}, results)
+
+	require.Nil(t, index.Delete(7))
+	assert.False(t, index.ContainsNode(7))
+	assert.Equal(t, uint64(testVectors-1), index.AlreadyIndexed())
+
+	// deleting a missing vector does not change the count
+	require.Nil(t, index.Delete(7))
+	assert.Equal(t, uint64(testVectors-1), index.AlreadyIndexed())
+
+	results, _, err = index.SearchByVector(context.Background(), vectors[7], testK, nil)
+	require.Nil(t, err)
+	assert.NotContains(t, results, uint64(7))
+}
+
+func TestIVFNProbeFromContext(t *testing.T) {
+	vectors, queries := testinghelpers.RandomVecsFixedSeed(testVectors, testQueries, testDimensions)
+	provider := distancer.NewL2SquaredProvider()
+
+	dir := t.TempDir()
+	store := newTestStore(t, dir)
+	defer store.Shutdown(context.Background())
+
+	uc := testUserConfig()
+	uc.NProbe = 1
+	index := newTestIndex(t, dir, store, provider, uc)
+	addAndTrain(t, index, vectors)
+
+	assert.Equal(t, 1, index.searchTimeNProbe(context.Background()))
+	assert.Equal(t, 4, index.searchTimeNProbe(searchparams.ContextWithNProbe(context.Background(), 4)))
+
+	// scanning the posting lists of all centroids is exhaustive
+	ctx := searchparams.ContextWithNProbe(context.Background(), uc.Centroids)
+	logger, _ := test.NewNullLogger()
+	for _, query := range queries {
+		truth, _ := testinghelpers.BruteForce(logger, vectors, query, testK, distanceWrapper(provider))
+		results, _, err := index.SearchByVector(ctx, query, testK, nil)
+		require.Nil(t, err)
+		assert.Equal(t, truth, results)
+	}
+}
+
+func TestIVFResumeAssignment(t *testing.T) {
+	vectors, queries := testinghelpers.RandomVecsFixedSeed(testVectors, testQueries, testDimensions)
+	provider := distancer.NewL2SquaredProvider()
+
+	dir := t.TempDir()
+	store := newTestStore(t, dir)
+	defer store.Shutdown(context.Background())
+
+	uc := testUserConfig()
+	uc.TrainingLimit = testVectors + 1 // not trained on import
+	index := newTestIndex(t, dir, store, provider, uc)
+	for i, vec := range vectors {
+		require.Nil(t, index.Add(context.Background(), uint64(i), vec))
+	}
+
+	// crash after the centroids have been persisted, but before the stored
+	// vectors have been assigned
+	_, _, err := index.fit()
+	require.Nil(t, err)
+
+	restarted := newTestIndex(t, dir, store, provider, uc)
+	require.True(t, restarted.trained())
+	require.False(t, restarted.assigned.Load())
+
+	// searched brute force until the vectors are assigned
+	assert.Equal(t, float32(1), recall(t, restarted, vectors, queries, provider, nil))
+
+	restarted.PostStartup()
+	assert.Equal(t, uint64(testVectors), restarted.AlreadyIndexed())
+	require.Eventually(t, func() bool {
+		return restarted.assigned.Load()
+	}, 30*time.Second, 10*time.Millisecond)
+	assert.GreaterOrEqual(t, recall(t, restarted, vectors, queries, provider, nil), float32(0.8))
+
+	t.Run("assigning again does not duplicate postings", func(t *testing.T) {
+		require.Nil(t, restarted.assignStored())
+
+		postings := 0
+		for centroid := range restarted.centroids {
+			pairs, err := store.Bucket(restarted.getPostingsBucketName()).
+				MapList(context.Background(), centroidToBytes(uint64(centroid)))
+			require.Nil(t, err)
+			postings += len(pairs)
+		}
+		assert.Equal(t, testVectors, postings)
+	})
+}
+
+func TestIVFListFiles(t *testing.T) {
+	dir := t.TempDir()
+	store := newTestStore(t, dir)
+	defer store.Shutdown(context.Background())
+
+	index := newTestIndex(t, dir, store, distancer.NewL2SquaredProvider(), testUserConfig())
+	files, err := index.ListFiles(context.Background(), dir)
+	require.Nil(t, err)
+	assert.Equal(t, []string{"ivf.db"}, files)
+
+	require.Nil(t, index.Drop(context.Background()))
+	files, err = index.ListFiles(context.Background(), dir)
+	require.Nil(t, err)
+	assert.Empty(t, files)
+}
+
+func TestIVFValidateUserConfigUpdate(t *testing.T) {
+	initial := testUserConfig()
+
+	t.Run("mutable fields", func(t *testing.T) {
+		updated := initial
+		updated.NProbe = 32
+		updated.FlatSearchCutoff = 10
+		assert.Nil(t, ValidateUserConfigUpdate(initial, updated))
+	})
+
+	t.Run("immutable fields", func(t *testing.T) {
+		updated := initial
+		updated.Centroids = 1024
+		err := ValidateUserConfigUpdate(initial, updated)
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "centroids is immutable")
+
+		updated = initial
+		updated.BQ.Enabled = true
+		err = ValidateUserConfigUpdate(initial, updated)
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "bq is immutable")
+	})
+}
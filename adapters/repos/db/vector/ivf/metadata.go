@@ -0,0 +1,273 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package ivf
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/compressionhelpers"
+	hnswent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	bolt "go.etcd.io/bbolt"
+)
+
+const (
+	metadataPrefix    = "ivf"
+	ivfMetadataBucket = "ivf"
+
+	dimensionsKey = "dimensions"
+	centroidsKey  = "centroids"
+	pqKey         = "pq"
+	assignedKey   = "assigned"
+)
+
+// The metadata file holds everything the index keeps in memory, i.e. the
+// dimensions and, once trained, the centroids, the PQ encoders and whether
+// the stored vectors have been assigned to posting lists. The
+// posting lists and vectors live in the buckets of the shard's store.
+func (index *ivf) getMetadataFile() string {
+	if index.targetVector != "" {
+		// This may be redundant as target vector is already validated in the schema
+		cleanTarget := filepath.Clean(index.targetVector)
+		cleanTarget = filepath.Base(cleanTarget)
+		return fmt.Sprintf("%s_%s.db", metadataPrefix, cleanTarget)
+	}
+	return fmt.Sprintf("%s.db", metadataPrefix)
+}
+
+func (index *ivf) removeMetadataFile() error {
+	path := filepath.Join(index.rootPath, index.getMetadataFile())
+	index.closeMetadata()
+	err := os.Remove(path)
+	if err != nil && !os.IsNotExist(err) {
+		return errors.Wrapf(err, "remove metadata file %q", path)
+	}
+	return nil
+}
+
+func (index *ivf) closeMetadata() {
+	index.metadataLock.Lock()
+	defer index.metadataLock.Unlock()
+
+	if index.metadata != nil {
+		index.metadata.Close()
+		index.metadata = nil
+	}
+}
+
+func (index *ivf) openMetadata() error {
+	index.metadataLock.Lock()
+	defer index.metadataLock.Unlock()
+
+	if index.metadata != nil {
+		return nil // Already open
+	}
+
+	path := filepath.Join(index.rootPath, index.getMetadataFile())
+	db, err := bolt.Open(path, 0o600, nil)
+	if err != nil {
+		return errors.Wrapf(err, "open %q", path)
+	}
+
+	index.metadata = db
+	return nil
+}
+
+func (index *ivf) initMetadata() error {
+	if err := index.openMetadata(); err != nil {
+		return err
+	}
+	defer index.closeMetadata()
+
+	return index.metadata.Update(func(tx *bolt.Tx) error {
+		b, err := tx.CreateBucketIfNotExists([]byte(ivfMetadataBucket))
+		if err != nil {
+			return errors.Wrap(err, "create bucket")
+		}
+
+		if v := b.Get([]byte(dimensionsKey)); v != nil {
+			index.dims = int32(binary.LittleEndian.Uint32(v))
+		}
+
+		if v := b.Get([]byte(centroidsKey)); v != nil {
+			centroids, err := centroidsFromBytes(v)
+			if err != nil {
+				return errors.Wrap(err, "load centroids")
+			}
+			index.centroids = centroids
+		}
+
+		if v := b.Get([]byte(pqKey)); v != nil {
+			pq, err := index.pqFromBytes(v)
+			if err != nil {
+				return errors.Wrap(err, "load pq encoders")
+			}
+			index.pq = pq
+		}
+
+		if v := b.Get([]byte(assignedKey)); v != nil {
+			index.assigned.Store(true)
+		}
+		return nil
+	})
+}
+
+func (index *ivf) setDimensions(dimensions int32) error {
+	buf := make([]byte, 4)
+	binary.LittleEndian.PutUint32(buf, uint32(dimensions))
+	return index.putMetadata(map[string][]byte{dimensionsKey: buf})
+}
+
+// persistTraining stores the result of the training, so that the posting
+// lists can be searched again after a restart
+func (index *ivf) persistTraining(centroids [][]float32, pq *compressionhelpers.ProductQuantizer,
+	pqEncoders []compressionhelpers.PQEncoder,
+) error {
+	values := map[string][]byte{centroidsKey: centroidsToBytes(centroids)}
+	if pq != nil {
+		values[pqKey] = pqToBytes(pqEncoders)
+	}
+	return index.putMetadata(values)
+}
+
+func (index *ivf) setAssigned() error {
+	return index.putMetadata(map[string][]byte{assignedKey: {1}})
+}
+
+func (index *ivf) putMetadata(values map[string][]byte) error {
+	if err := index.openMetadata(); err != nil {
+		return err
+	}
+	defer index.closeMetadata()
+
+	return index.metadata.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(ivfMetadataBucket))
+		if b == nil {
+			return errors.New("failed to get bucket")
+		}
+		for key, value := range values {
+			if err := b.Put([]byte(key), value); err != nil {
+				return errors.Wrapf(err, "put %s", key)
+			}
+		}
+		return nil
+	})
+}
+
+func centroidsToBytes(centroids [][]float32) []byte {
+	dims := 0
+	if len(centroids) > 0 {
+		dims = len(centroids[0])
+	}
+
+	buf := make([]byte, 8+4*len(centroids)*dims)
+	binary.LittleEndian.PutUint32(buf[0:4], uint32(len(centroids)))
+	binary.LittleEndian.PutUint32(buf[4:8], uint32(dims))
+	pos := 8
+	for _, centroid := range centroids {
+		for _, v := range centroid {
+			binary.LittleEndian.PutUint32(buf[pos:], math.Float32bits(v))
+			pos += 4
+		}
+	}
+	return buf
+}
+
+func centroidsFromBytes(buf []byte) ([][]float32, error) {
+	if len(buf) < 8 {
+		return nil, errors.Errorf("invalid length %d", len(buf))
+	}
+	count := int(binary.LittleEndian.Uint32(buf[0:4]))
+	dims := int(binary.LittleEndian.Uint32(buf[4:8]))
+	if len(buf) != 8+4*count*dims {
+		return nil, errors.Errorf("invalid length %d for %d centroids of %d dimensions",
+			len(buf), count, dims)
+	}
+
+	centroids := make([][]float32, count)
+	pos := 8
+	for i := range centroids {
+		centroids[i] = make([]float32, dims)
+		for j := range centroids[i] {
+			centroids[i][j] = math.Float32frombits(binary.LittleEndian.Uint32(buf[pos:]))
+			pos += 4
+		}
+	}
+	return centroids, nil
+}
+
+// pqToBytes stores the centers of the k-means encoder of each segment
+func pqToBytes(encoders []compressionhelpers.PQEncoder) []byte {
+	buf := make([]byte, 2)
+	binary.LittleEndian.PutUint16(buf, uint16(len(encoders)))
+	for _, encoder := range encoders {
+		data := encoder.ExposeDataForRestore()
+		buf = binary.LittleEndian.AppendUint32(buf, uint32(len(data)))
+		buf = append(buf, data...)
+	}
+	return buf
+}
+
+func (index *ivf) pqFromBytes(buf []byte) (*compressionhelpers.ProductQuantizer, error) {
+	if len(buf) < 2 || index.dims == 0 {
+		return nil, errors.Errorf("invalid pq data of length %d for %d dimensions", len(buf), index.dims)
+	}
+	segments := int(binary.LittleEndian.Uint16(buf[0:2]))
+	if segments == 0 || int(index.dims)%segments != 0 {
+		return nil, errors.Errorf("invalid number of segments %d for %d dimensions", segments, index.dims)
+	}
+	ds := int(index.dims) / segments
+
+	encoders := make([]compressionhelpers.PQEncoder, segments)
+	ks := 0
+	pos := 2
+	for i := range encoders {
+		if len(buf) < pos+4 {
+			return nil, errors.Errorf("invalid pq data of length %d", len(buf))
+		}
+		length := int(binary.LittleEndian.Uint32(buf[pos:]))
+		pos += 4
+		if len(buf) < pos+length || length%(4*ds) != 0 {
+			return nil, errors.Errorf("invalid pq data of length %d", len(buf))
+		}
+
+		ks = length / (4 * ds)
+		centers := make([][]float32, ks)
+		for c := range centers {
+			centers[c] = make([]float32, ds)
+			for j := range centers[c] {
+				centers[c][j] = math.Float32frombits(binary.LittleEndian.Uint32(buf[pos:]))
+				pos += 4
+			}
+		}
+		encoders[i] = compressionhelpers.NewKMeansWithCenters(ks, ds, i, centers)
+	}
+
+	return compressionhelpers.NewProductQuantizerWithEncoders(index.pqConfig(ks), index.pqDistancer(),
+		int(index.dims), encoders, index.logger)
+}
+
+func (index *ivf) pqConfig(centroids int) hnswent.PQConfig {
+	return hnswent.PQConfig{
+		Enabled:   true,
+		Segments:  index.pqSegments,
+		Centroids: centroids,
+		Encoder: hnswent.PQEncoder{
+			Type:         hnswent.PQEncoderTypeKMeans,
+			Distribution: hnswent.PQEncoderDistributionLogNormal,
+		},
+	}
+}
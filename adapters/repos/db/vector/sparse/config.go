@@ -0,0 +1,56 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package sparse
+
+import (
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/errorcompounder"
+	schemaConfig "github.com/weaviate/weaviate/entities/schema/config"
+	sparseent "github.com/weaviate/weaviate/entities/vectorindex/sparse"
+)
+
+type Config struct {
+	ID           string
+	TargetVector string
+	Logger       logrus.FieldLogger
+}
+
+func (c Config) Validate() error {
+	ec := errorcompounder.New()
+
+	if c.ID == "" {
+		ec.Addf("id cannot be empty")
+	}
+
+	return ec.ToError()
+}
+
+// ValidateUserConfigUpdate rejects changes of the distance, which is the
+// only setting of a sparse index
+func ValidateUserConfigUpdate(initial, updated schemaConfig.VectorIndexConfig) error {
+	initialParsed, ok := initial.(sparseent.UserConfig)
+	if !ok {
+		return errors.Errorf("initial is not UserConfig, but %T", initial)
+	}
+
+	updatedParsed, ok := updated.(sparseent.UserConfig)
+	if !ok {
+		return errors.Errorf("updated is not UserConfig, but %T", updated)
+	}
+
+	if initialParsed.Distance != updatedParsed.Distance {
+		return errors.Errorf("distance is immutable: attempted change from \"%v\" to \"%v\"",
+			initialParsed.Distance, updatedParsed.Distance)
+	}
+	return nil
+}
@@ -0,0 +1,232 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package sparse indexes sparse vectors, such as the learned sparse
+// embeddings of SPLADE, in an inverted bucket. Every dimension is a key of
+// the bucket and holds the documents with a non-zero weight in it. Searches
+// score the documents by their dot product with the query using BlockMax
+// WAND, the same way BM25 searches the inverted index of a text property.
+package sparse
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/terms"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/entities/models"
+	sparseent "github.com/weaviate/weaviate/entities/vectorindex/sparse"
+)
+
+// weightScale quantizes the weights, as the inverted bucket stores them as
+// integer frequencies. Weights up to 256 are stored with a precision of
+// 1/65536, larger ones lose precision gradually.
+const weightScale = 1 << 16
+
+type Index struct {
+	id           string
+	targetVector string
+	logger       logrus.FieldLogger
+	store        *lsmkv.Store
+}
+
+func New(cfg Config, uc sparseent.UserConfig, store *lsmkv.Store) (*Index, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid config: %w", err)
+	}
+
+	logger := cfg.Logger
+	if logger == nil {
+		l := logrus.New()
+		l.Out = io.Discard
+		logger = l
+	}
+
+	index := &Index{
+		id:           cfg.ID,
+		targetVector: cfg.TargetVector,
+		logger:       logger,
+		store:        store,
+	}
+
+	if err := store.CreateOrLoadBucket(context.Background(), index.bucketName(),
+		lsmkv.WithStrategy(lsmkv.StrategyInverted),
+		lsmkv.WithPread(false),
+	); err != nil {
+		return nil, fmt.Errorf("init sparse index bucket: %w", err)
+	}
+
+	return index, nil
+}
+
+func (index *Index) bucketName() string {
+	if index.targetVector != "" {
+		return fmt.Sprintf("%s_%s", helpers.VectorsSparseBucketLSM, index.targetVector)
+	}
+	return helpers.VectorsSparseBucketLSM
+}
+
+// Validate checks that the vector can be stored or searched: indices and
+// values must be of the same length, the indices unique and the values
+// finite and not negative.
+func Validate(vector models.SparseVector) error {
+	if len(vector.Indices) != len(vector.Values) {
+		return fmt.Errorf("sparse vector has %d indices but %d values",
+			len(vector.Indices), len(vector.Values))
+	}
+
+	seen := make(map[uint32]struct{}, len(vector.Indices))
+	for i, dim := range vector.Indices {
+		if _, ok := seen[dim]; ok {
+			return fmt.Errorf("sparse vector has index %d more than once", dim)
+		}
+		seen[dim] = struct{}{}
+
+		value := vector.Values[i]
+		if value < 0 || math.IsNaN(float64(value)) || math.IsInf(float64(value), 0) {
+			return fmt.Errorf("sparse vector has invalid value %v at index %d, values must be finite and not negative",
+				value, dim)
+		}
+	}
+	return nil
+}
+
+// Add indexes the non-zero weights of the vector for the document
+func (index *Index) Add(docID uint64, vector models.SparseVector) error {
+	if err := Validate(vector); err != nil {
+		return err
+	}
+
+	bucket := index.store.Bucket(index.bucketName())
+	docIDBytes := docIDToBytes(docID)
+	for i, dim := range vector.Indices {
+		weight := quantize(vector.Values[i])
+		if weight == 0 {
+			continue
+		}
+
+		value := make([]byte, 8)
+		binary.LittleEndian.PutUint32(value[0:4], math.Float32bits(weight))
+		// all documents share the same "property length", so that the block
+		// max impacts are determined by the weights only
+		binary.LittleEndian.PutUint32(value[4:8], math.Float32bits(1))
+
+		if err := bucket.MapSet(dimToBytes(dim), lsmkv.MapPair{
+			Key:   docIDBytes,
+			Value: value,
+		}); err != nil {
+			return fmt.Errorf("add dimension %d of doc %d: %w", dim, docID, err)
+		}
+	}
+	return nil
+}
+
+// Delete removes the weights of the vector previously added for the document
+func (index *Index) Delete(docID uint64, vector models.SparseVector) error {
+	bucket := index.store.Bucket(index.bucketName())
+	docIDBytes := docIDToBytes(docID)
+	for _, dim := range vector.Indices {
+		if err := bucket.MapDeleteKey(dimToBytes(dim), docIDBytes); err != nil {
+			return fmt.Errorf("delete dimension %d of doc %d: %w", dim, docID, err)
+		}
+	}
+	return nil
+}
+
+// Search returns the ids of the k documents with the highest dot product
+// with the query, along with their scores in descending order. A k of 0 or
+// less returns all matching documents.
+func (index *Index) Search(ctx context.Context, query models.SparseVector, k int,
+	allow helpers.AllowList,
+) ([]uint64, []float32, error) {
+	if err := Validate(query); err != nil {
+		return nil, nil, fmt.Errorf("invalid query: %w", err)
+	}
+
+	keys := make([][]byte, 0, len(query.Indices))
+	weights := make([]float64, 0, len(query.Indices))
+	for i, dim := range query.Indices {
+		if query.Values[i] == 0 {
+			continue
+		}
+		keys = append(keys, dimToBytes(dim))
+		weights = append(weights, float64(query.Values[i])/weightScale)
+	}
+	if len(keys) == 0 {
+		return []uint64{}, []float32{}, nil
+	}
+
+	bucket := index.store.Bucket(index.bucketName())
+	perSegment, lock, err := bucket.CreateSparseDiskTerm(allow, keys, weights)
+	if lock != nil {
+		defer lock.RUnlock()
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("create sparse terms: %w", err)
+	}
+
+	// the weights of a document are written at once, but may still end up
+	// in separate segments if a flush happens in between. The terms of all
+	// segments are therefore evaluated together, so that the partial scores
+	// of a document are summed up before the top k are cut.
+	var allTerms []terms.TermInterface
+	for _, segmentTerms := range perSegment {
+		allTerms = append(allTerms, segmentTerms...)
+	}
+	if len(allTerms) == 0 {
+		return []uint64{}, []float32{}, nil
+	}
+
+	if k <= 0 {
+		for _, term := range allTerms {
+			k += term.Count()
+		}
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	topK := terms.DoBlockMaxWand(k, &terms.Terms{
+		T:     allTerms,
+		Count: len(keys),
+	}, 1, false)
+
+	ids := make([]uint64, topK.Len())
+	dists := make([]float32, topK.Len())
+	for i := len(ids) - 1; i >= 0; i-- {
+		item := topK.Pop()
+		ids[i] = item.ID
+		dists[i] = item.Dist
+	}
+	return ids, dists, nil
+}
+
+func quantize(weight float32) float32 {
+	return float32(math.Round(float64(weight) * weightScale))
+}
+
+func dimToBytes(dim uint32) []byte {
+	key := make([]byte, 4)
+	binary.BigEndian.PutUint32(key, dim)
+	return key
+}
+
+func docIDToBytes(docID uint64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, docID)
+	return key
+}
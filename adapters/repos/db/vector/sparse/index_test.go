@@ -0,0 +1,267 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package sparse
+
+import (
+	"context"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	"github.com/weaviate/weaviate/entities/models"
+	sparseent "github.com/weaviate/weaviate/entities/vectorindex/sparse"
+)
+
+const (
+	testDimensions = 2000
+	testNonZeros   = 30
+	testVectors    = 1000
+	testQueries    = 20
+	testK          = 10
+)
+
+func newTestStore(t *testing.T, dir string) *lsmkv.Store {
+	logger, _ := test.NewNullLogger()
+	store, err := lsmkv.New(dir, dir, logger, nil,
+		cyclemanager.NewCallbackGroupNoop(),
+		cyclemanager.NewCallbackGroupNoop(),
+		cyclemanager.NewCallbackGroupNoop())
+	require.Nil(t, err)
+	return store
+}
+
+func randomSparseVector(r *rand.Rand, nonZeros int) models.SparseVector {
+	dims := r.Perm(testDimensions)[:nonZeros]
+	vec := models.SparseVector{
+		Indices: make([]uint32, nonZeros),
+		Values:  make([]float32, nonZeros),
+	}
+	for i, dim := range dims {
+		vec.Indices[i] = uint32(dim)
+		vec.Values[i] = r.Float32() * 3
+	}
+	return vec
+}
+
+func dot(x, y models.SparseVector) float32 {
+	weights := make(map[uint32]float32, len(x.Indices))
+	for i, dim := range x.Indices {
+		weights[dim] = x.Values[i]
+	}
+	var sum float32
+	for i, dim := range y.Indices {
+		sum += weights[dim] * y.Values[i]
+	}
+	return sum
+}
+
+func bruteForce(vectors map[uint64]models.SparseVector, query models.SparseVector,
+	k int, allow helpers.AllowList,
+) []uint64 {
+	type match struct {
+		id    uint64
+		score float32
+	}
+	matches := make([]match, 0, len(vectors))
+	for id, vec := range vectors {
+		if allow != nil && !allow.Contains(id) {
+			continue
+		}
+		if score := dot(vec, query); score > 0 {
+			matches = append(matches, match{id: id, score: score})
+		}
+	}
+	sort.Slice(matches, func(a, b int) bool {
+		return matches[a].score > matches[b].score
+	})
+	if len(matches) > k {
+		matches = matches[:k]
+	}
+	ids := make([]uint64, len(matches))
+	for i := range matches {
+		ids[i] = matches[i].id
+	}
+	return ids
+}
+
+func assertRecall(t *testing.T, index *Index, vectors map[uint64]models.SparseVector,
+	queries []models.SparseVector, allow helpers.AllowList,
+) {
+	var relevant, retrieved int
+	for _, query := range queries {
+		truth := bruteForce(vectors, query, testK, allow)
+		ids, scores, err := index.Search(context.Background(), query, testK, allow)
+		require.Nil(t, err)
+		require.Len(t, ids, len(truth))
+		for i := 1; i < len(scores); i++ {
+			assert.GreaterOrEqual(t, scores[i-1], scores[i])
+		}
+		for i, id := range ids {
+			if allow != nil {
+				assert.True(t, allow.Contains(id))
+			}
+			assert.InDelta(t, dot(vectors[id], query), scores[i], 1e-2)
+		}
+
+		inTruth := make(map[uint64]struct{}, len(truth))
+		for _, id := range truth {
+			inTruth[id] = struct{}{}
+		}
+		for _, id := range ids {
+			if _, ok := inTruth[id]; ok {
+				relevant++
+			}
+		}
+		retrieved += len(truth)
+	}
+	// the quantization of the weights may swap matches with almost the same
+	// score at the end of the list
+	assert.GreaterOrEqual(t, float32(relevant)/float32(retrieved), float32(0.98))
+}
+
+func TestSparseIndex(t *testing.T) {
+	ctx := context.Background()
+	r := rand.New(rand.NewSource(42))
+
+	store := newTestStore(t, t.TempDir())
+	defer store.Shutdown(ctx)
+
+	index, err := New(Config{ID: "sparse", TargetVector: "splade"},
+		sparseent.NewDefaultUserConfig(), store)
+	require.Nil(t, err)
+
+	vectors := make(map[uint64]models.SparseVector, testVectors)
+	for id := uint64(0); id < testVectors; id++ {
+		vectors[id] = randomSparseVector(r, testNonZeros)
+	}
+	queries := make([]models.SparseVector, testQueries)
+	for i := range queries {
+		queries[i] = randomSparseVector(r, testNonZeros)
+	}
+
+	// half of the vectors end up in a segment, the other half in the memtable
+	for id := uint64(0); id < testVectors; id++ {
+		require.Nil(t, index.Add(id, vectors[id]))
+		if id == testVectors/2 {
+			require.Nil(t, store.Bucket(index.bucketName()).FlushAndSwitch())
+		}
+	}
+
+	t.Run("search", func(t *testing.T) {
+		assertRecall(t, index, vectors, queries, nil)
+	})
+
+	t.Run("search with allow list", func(t *testing.T) {
+		allowed := make([]uint64, 0, testVectors/3)
+		for id := uint64(0); id < testVectors; id += 3 {
+			allowed = append(allowed, id)
+		}
+		assertRecall(t, index, vectors, queries, helpers.NewAllowList(allowed...))
+	})
+
+	t.Run("search after flushing all vectors", func(t *testing.T) {
+		require.Nil(t, store.Bucket(index.bucketName()).FlushAndSwitch())
+		assertRecall(t, index, vectors, queries, nil)
+	})
+
+	t.Run("search after deleting", func(t *testing.T) {
+		for id := uint64(0); id < testVectors; id += 2 {
+			require.Nil(t, index.Delete(id, vectors[id]))
+			delete(vectors, id)
+		}
+		assertRecall(t, index, vectors, queries, nil)
+
+		require.Nil(t, store.Bucket(index.bucketName()).FlushAndSwitch())
+		assertRecall(t, index, vectors, queries, nil)
+	})
+
+	t.Run("search without limit", func(t *testing.T) {
+		ids, _, err := index.Search(ctx, queries[0], 0, nil)
+		require.Nil(t, err)
+		assert.Len(t, ids, len(bruteForce(vectors, queries[0], testVectors, nil)))
+	})
+}
+
+func TestSparseIndexSearchAcrossSegments(t *testing.T) {
+	ctx := context.Background()
+
+	store := newTestStore(t, t.TempDir())
+	defer store.Shutdown(ctx)
+
+	index, err := New(Config{ID: "sparse"}, sparseent.NewDefaultUserConfig(), store)
+	require.Nil(t, err)
+
+	// the weights of doc 0 are split by a flush, in each segment another doc
+	// scores higher than its partial score
+	require.Nil(t, index.Add(0, models.SparseVector{Indices: []uint32{1}, Values: []float32{1}}))
+	require.Nil(t, index.Add(1, models.SparseVector{Indices: []uint32{1}, Values: []float32{1.5}}))
+	require.Nil(t, store.Bucket(index.bucketName()).FlushAndSwitch())
+	require.Nil(t, index.Add(0, models.SparseVector{Indices: []uint32{2}, Values: []float32{1}}))
+	require.Nil(t, index.Add(2, models.SparseVector{Indices: []uint32{2}, Values: []float32{1.5}}))
+
+	query := models.SparseVector{Indices: []uint32{1, 2}, Values: []float32{1, 1}}
+	ids, scores, err := index.Search(ctx, query, 1, nil)
+	require.Nil(t, err)
+	assert.Equal(t, []uint64{0}, ids)
+	assert.InDelta(t, 2, scores[0], 1e-4)
+
+	ids, scores, err = index.Search(ctx, query, 0, nil)
+	require.Nil(t, err)
+	assert.Equal(t, []uint64{0, 1, 2}, ids)
+	assert.InDeltaSlice(t, []float32{2, 1.5, 1.5}, scores, 1e-4)
+}
+
+func TestSparseIndexValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		vector models.SparseVector
+		errMsg string
+	}{
+		{
+			name:   "valid",
+			vector: models.SparseVector{Indices: []uint32{3, 1}, Values: []float32{0.5, 0}},
+		},
+		{
+			name:   "length mismatch",
+			vector: models.SparseVector{Indices: []uint32{1, 2}, Values: []float32{0.5}},
+			errMsg: "sparse vector has 2 indices but 1 values",
+		},
+		{
+			name:   "duplicate index",
+			vector: models.SparseVector{Indices: []uint32{1, 1}, Values: []float32{0.5, 0.5}},
+			errMsg: "sparse vector has index 1 more than once",
+		},
+		{
+			name:   "negative value",
+			vector: models.SparseVector{Indices: []uint32{7}, Values: []float32{-0.5}},
+			errMsg: "invalid value -0.5 at index 7",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := Validate(test.vector)
+			if test.errMsg == "" {
+				assert.Nil(t, err)
+				return
+			}
+			require.NotNil(t, err)
+			assert.Contains(t, err.Error(), test.errMsg)
+		})
+	}
+}
@@ -29,6 +29,7 @@ import (
 	"github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/require"
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/compressionhelpers"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
 	"github.com/weaviate/weaviate/entities/cyclemanager"
@@ -152,6 +153,128 @@ func genVector(r *rand.Rand, dimensions int) []float32 {
 	return vector
 }
 
+// RandomMultiVecs generates multi vector documents whose vectors are spread
+// around a few shared topics, so that documents are only partially similar
+// to each other. Every query is a noisy copy of some of the vectors of a
+// random document.
+func RandomMultiVecs(size int, queriesSize int, vectorsPerDoc int, dimensions int) ([][][]float32, [][][]float32) {
+	r := getFixedSeed()
+	topics := make([][]float32, 16)
+	for i := range topics {
+		topics[i] = genVector(r, dimensions)
+	}
+	noisy := func(vector []float32, noise float32) []float32 {
+		out := make([]float32, dimensions)
+		for i := range out {
+			out[i] = vector[i] + (r.Float32()*2-1)*noise
+		}
+		return out
+	}
+
+	vectors := make([][][]float32, size)
+	for i := range vectors {
+		doc := make([][]float32, 1+r.Intn(vectorsPerDoc))
+		for j := range doc {
+			doc[j] = noisy(topics[r.Intn(len(topics))], 0.5)
+		}
+		vectors[i] = doc
+	}
+
+	queries := make([][][]float32, queriesSize)
+	for i := range queries {
+		doc := vectors[r.Intn(size)]
+		query := make([][]float32, 1+r.Intn(len(doc)))
+		for j := range query {
+			query[j] = noisy(doc[r.Intn(len(doc))], 0.2)
+		}
+		queries[i] = query
+	}
+	return vectors, queries
+}
+
+// RandomNormalizedMultiVecs generates multi vector documents and queries like
+// RandomMultiVecs, with all of their vectors normalized
+func RandomNormalizedMultiVecs(size int, queriesSize int, vectorsPerDoc int, dimensions int) ([][][]float32, [][][]float32) {
+	vectors, queries := RandomMultiVecs(size, queriesSize, vectorsPerDoc, dimensions)
+	for _, doc := range vectors {
+		Normalize(doc)
+	}
+	for _, query := range queries {
+		Normalize(query)
+	}
+	return vectors, queries
+}
+
+// BruteForceMultiVector returns the ids of the k documents with the highest
+// score for the given query
+func BruteForceMultiVector(vectors [][][]float32, query [][]float32, k int,
+	score func(query, doc [][]float32) float32,
+) []uint64 {
+	type scoreAndIndex struct {
+		score float32
+		index uint64
+	}
+
+	scores := make([]scoreAndIndex, len(vectors))
+	for i, doc := range vectors {
+		scores[i] = scoreAndIndex{score: score(query, doc), index: uint64(i)}
+	}
+
+	sort.Slice(scores, func(a, b int) bool {
+		return scores[a].score > scores[b].score
+	})
+
+	out := make([]uint64, min(k, len(scores)))
+	for i := range out {
+		out[i] = scores[i].index
+	}
+	return out
+}
+
+// MultiVectorRecallCase configures a multi vector index, e.g. its
+// aggregation, and sets the recall its searches need to reach at least
+type MultiVectorRecallCase struct {
+	Name      string
+	MinRecall float32
+	Configure func(t *testing.T)
+}
+
+// MultiVectorRecall runs the queries against a multi vector index for every
+// case and compares the results to the exact maxSim results, which all
+// aggregations approximate. The vectors and queries need to be normalized.
+func MultiVectorRecall(t *testing.T, vectors, queries [][][]float32, k int,
+	search func(query [][]float32, k int) ([]uint64, []float32, error),
+	cases []MultiVectorRecallCase,
+) {
+	dot := distancer.NewDotProductProvider().Step
+	truths := make([][]uint64, len(queries))
+	for i, query := range queries {
+		truths[i] = BruteForceMultiVector(vectors, query, k, func(query, doc [][]float32) float32 {
+			return common.MaxSimScore(dot, query, doc)
+		})
+	}
+
+	for _, c := range cases {
+		t.Run(c.Name, func(t *testing.T) {
+			c.Configure(t)
+
+			var relevant uint64
+			for i, query := range queries {
+				ids, scores, err := search(query, k)
+				require.Nil(t, err)
+				require.Len(t, ids, k)
+				for j := 1; j < len(scores); j++ {
+					require.GreaterOrEqual(t, scores[j-1], scores[j])
+				}
+				relevant += MatchesInLists(truths[i], ids)
+			}
+			recall := float32(relevant) / float32(k*len(queries))
+			t.Logf("recall %s: %v", c.Name, recall)
+			require.GreaterOrEqual(t, recall, c.MinRecall)
+		})
+	}
+}
+
 func Normalize(vectors [][]float32) {
 	for i := range vectors {
 		vectors[i] = distancer.Normalize(vectors[i])
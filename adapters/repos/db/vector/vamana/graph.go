@@ -0,0 +1,259 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package vamana
+
+import (
+	"encoding/binary"
+	"io"
+	"math"
+	"os"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	pageSize = 4096
+
+	// each slot starts with the flags and the degree of the node
+	nodeHeaderSize = 8
+
+	nodeFlagPresent   uint32 = 1 << 0
+	nodeFlagTombstone uint32 = 1 << 1
+
+	// number of blocks read at once when scanning the whole graph
+	scanBlocks = 64
+)
+
+// diskGraph stores the nodes of the graph in fixed size slots of a single
+// file, addressed by the id of the node. A slot holds both the full
+// precision vector and the neighbors of a node, so a search gets both with a
+// single random read. Slots never cross a page boundary: small slots are
+// packed into a page, large slots occupy a block of whole pages.
+type diskGraph struct {
+	file *os.File
+	path string
+
+	// writeLock is held for reading by every write to the file and for
+	// writing while a snapshot is taken, so a snapshot never contains a
+	// partially written slot
+	writeLock sync.RWMutex
+
+	dims          int
+	maxDegree     int
+	slotSize      int
+	blockSize     int
+	slotsPerBlock int
+}
+
+type diskNode struct {
+	id        uint64
+	tombstone bool
+	vector    []float32
+	neighbors []uint64
+}
+
+func openDiskGraph(path string) (*diskGraph, error) {
+	file, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0o600)
+	if err != nil {
+		return nil, errors.Wrapf(err, "open %q", path)
+	}
+	return &diskGraph{file: file, path: path}, nil
+}
+
+// setLayout computes the size and position of the slots, it needs to be
+// called once the dimensions are known before any node is read or written
+func (g *diskGraph) setLayout(dims, maxDegree int) {
+	g.dims = dims
+	g.maxDegree = maxDegree
+	g.slotSize = nodeHeaderSize + 4*dims + 8*maxDegree
+
+	if g.slotSize <= pageSize {
+		g.blockSize = pageSize
+		g.slotsPerBlock = pageSize / g.slotSize
+	} else {
+		g.blockSize = (g.slotSize + pageSize - 1) / pageSize * pageSize
+		g.slotsPerBlock = 1
+	}
+}
+
+func (g *diskGraph) hasLayout() bool {
+	return g.slotSize > 0
+}
+
+func (g *diskGraph) offset(id uint64) int64 {
+	block := id / uint64(g.slotsPerBlock)
+	slot := id % uint64(g.slotsPerBlock)
+	return int64(block)*int64(g.blockSize) + int64(slot)*int64(g.slotSize)
+}
+
+// read returns nil if there is no node with the given id
+func (g *diskGraph) read(id uint64) (*diskNode, error) {
+	if !g.hasLayout() {
+		return nil, nil
+	}
+
+	buf := make([]byte, g.slotSize)
+	n, err := g.file.ReadAt(buf, g.offset(id))
+	if err != nil && !(errors.Is(err, io.EOF) && n == 0) {
+		return nil, errors.Wrapf(err, "read node %d", id)
+	}
+	if n == 0 {
+		return nil, nil
+	}
+	return g.decode(id, buf), nil
+}
+
+func (g *diskGraph) decode(id uint64, buf []byte) *diskNode {
+	flags := binary.LittleEndian.Uint32(buf[0:4])
+	if flags&nodeFlagPresent == 0 {
+		return nil
+	}
+
+	degree := int(binary.LittleEndian.Uint32(buf[4:8]))
+	node := &diskNode{
+		id:        id,
+		tombstone: flags&nodeFlagTombstone != 0,
+		vector:    make([]float32, g.dims),
+		neighbors: make([]uint64, min(degree, g.maxDegree)),
+	}
+
+	pos := nodeHeaderSize
+	for i := range node.vector {
+		node.vector[i] = math.Float32frombits(binary.LittleEndian.Uint32(buf[pos:]))
+		pos += 4
+	}
+	for i := range node.neighbors {
+		node.neighbors[i] = binary.LittleEndian.Uint64(buf[pos:])
+		pos += 8
+	}
+	return node
+}
+
+func (g *diskGraph) write(node *diskNode) error {
+	if len(node.vector) != g.dims {
+		return errors.Errorf("node %d has %d dimensions, graph has %d", node.id, len(node.vector), g.dims)
+	}
+	if len(node.neighbors) > g.maxDegree {
+		return errors.Errorf("node %d has %d neighbors, maximum degree is %d",
+			node.id, len(node.neighbors), g.maxDegree)
+	}
+
+	buf := make([]byte, g.slotSize)
+	flags := nodeFlagPresent
+	if node.tombstone {
+		flags |= nodeFlagTombstone
+	}
+	binary.LittleEndian.PutUint32(buf[0:4], flags)
+	binary.LittleEndian.PutUint32(buf[4:8], uint32(len(node.neighbors)))
+
+	pos := nodeHeaderSize
+	for _, v := range node.vector {
+		binary.LittleEndian.PutUint32(buf[pos:], math.Float32bits(v))
+		pos += 4
+	}
+	for _, neighbor := range node.neighbors {
+		binary.LittleEndian.PutUint64(buf[pos:], neighbor)
+		pos += 8
+	}
+
+	g.writeLock.RLock()
+	defer g.writeLock.RUnlock()
+
+	if _, err := g.file.WriteAt(buf, g.offset(node.id)); err != nil {
+		return errors.Wrapf(err, "write node %d", node.id)
+	}
+	return nil
+}
+
+// clear frees the slot of the node, the file is not shrunk
+func (g *diskGraph) clear(id uint64) error {
+	buf := make([]byte, nodeHeaderSize)
+
+	g.writeLock.RLock()
+	defer g.writeLock.RUnlock()
+
+	if _, err := g.file.WriteAt(buf, g.offset(id)); err != nil {
+		return errors.Wrapf(err, "clear node %d", id)
+	}
+	return nil
+}
+
+// scan calls fn for every node in the graph in the order of their ids. The
+// file is read sequentially in large chunks, which is much cheaper than
+// reading every node on its own.
+func (g *diskGraph) scan(fn func(node *diskNode) error) error {
+	if !g.hasLayout() {
+		return nil
+	}
+
+	buf := make([]byte, scanBlocks*g.blockSize)
+	for block := int64(0); ; block += scanBlocks {
+		n, err := g.file.ReadAt(buf, block*int64(g.blockSize))
+		if err != nil && !errors.Is(err, io.EOF) {
+			return errors.Wrap(err, "scan graph")
+		}
+
+		for b := 0; b*g.blockSize < n; b++ {
+			for s := 0; s < g.slotsPerBlock; s++ {
+				start := b*g.blockSize + s*g.slotSize
+				if start+g.slotSize > n {
+					break
+				}
+				id := uint64(block+int64(b))*uint64(g.slotsPerBlock) + uint64(s)
+				if node := g.decode(id, buf[start:start+g.slotSize]); node != nil {
+					if err := fn(node); err != nil {
+						return err
+					}
+				}
+			}
+		}
+
+		if n < len(buf) {
+			return nil
+		}
+	}
+}
+
+func (g *diskGraph) sync() error {
+	return g.file.Sync()
+}
+
+// snapshot copies the graph file to the given path. The nodes are rewritten
+// in place, so the file itself cannot be copied while the graph is written
+// to. Writes are paused until the copy is complete.
+func (g *diskGraph) snapshot(path string) error {
+	g.writeLock.Lock()
+	defer g.writeLock.Unlock()
+
+	tmpPath := path + ".tmp"
+	out, err := os.OpenFile(tmpPath, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0o600)
+	if err != nil {
+		return errors.Wrapf(err, "create %q", tmpPath)
+	}
+	if _, err := io.Copy(out, io.NewSectionReader(g.file, 0, math.MaxInt64)); err != nil {
+		out.Close()
+		return errors.Wrap(err, "copy graph")
+	}
+	if err := out.Sync(); err != nil {
+		out.Close()
+		return errors.Wrapf(err, "sync %q", tmpPath)
+	}
+	if err := out.Close(); err != nil {
+		return errors.Wrapf(err, "close %q", tmpPath)
+	}
+	return os.Rename(tmpPath, path)
+}
+
+func (g *diskGraph) close() error {
+	return g.file.Close()
+}
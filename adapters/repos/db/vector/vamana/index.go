@@ -0,0 +1,669 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package vamana implements a disk-based graph index following Vamana and
+// FreshDiskANN. The graph and the full precision vectors live on disk in a
+// page-aligned file, only a compressed copy of the vectors is kept in memory.
+// A search navigates the graph with the compressed vectors, reading a beam of
+// nodes from disk in each step, and ranks the results by the full precision
+// vectors of the nodes it has read.
+//
+// Deleted nodes are tombstoned and stay traversable until the tombstone
+// cleanup cycle reconnects their neighbors and frees their slots.
+package vamana
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/priorityqueue"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/compressionhelpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	schemaConfig "github.com/weaviate/weaviate/entities/schema/config"
+	vamanaent "github.com/weaviate/weaviate/entities/vectorindex/vamana"
+	"github.com/weaviate/weaviate/usecases/floatcomp"
+	bolt "go.etcd.io/bbolt"
+)
+
+const (
+	compressionBQ = "bq"
+	compressionPQ = "pq"
+)
+
+type vamana struct {
+	id                string
+	targetVector      string
+	rootPath          string
+	logger            logrus.FieldLogger
+	distancerProvider distancer.Provider
+	metadata          *bolt.DB
+	metadataLock      *sync.Mutex
+	graph             *diskGraph
+	pqResults         *common.PqMaxPool
+
+	tombstoneCleanupCallbackCtrl cyclemanager.CycleCallbackCtrl
+
+	maxDegree           int
+	alpha               float32
+	buildSearchListSize int
+	compression         string
+	pqSegments          int
+	pqCentroids         int
+	pqTrainingLimit     int
+
+	// mutable user config, read atomically on every search
+	searchListSize   int64
+	beamWidth        int64
+	flatSearchCutoff int64
+
+	dims  int32
+	count uint64
+
+	// initLock serializes setting the dimensions and inserting the first node
+	initLock      sync.Mutex
+	entrypoint    atomic.Uint64
+	hasEntrypoint atomic.Bool
+
+	// nodeLocks are held while the neighbors of a node are rewritten
+	nodeLocks *common.ShardedLocks
+
+	// nodes holds the in-memory compressed vectors, indexed by node id. A nil
+	// entry means there is no node with this id.
+	nodesLock sync.RWMutex
+	nodes     []*memNode
+
+	tombstonesLock sync.RWMutex
+	tombstones     map[uint64]struct{}
+
+	// trainLock guards the switch to PQ compressed vectors, during which all
+	// nodes are encoded. Writes and searches hold it for reading.
+	trainLock sync.RWMutex
+	pq        *compressionhelpers.ProductQuantizer
+	bq        compressionhelpers.BinaryQuantizer
+	training  atomic.Bool
+}
+
+// memNode is the compressed copy of a node's vector. Before the PQ encoder is
+// trained, nodes are present without a code.
+type memNode struct {
+	code []byte
+	bits []uint64
+}
+
+func New(cfg Config, uc vamanaent.UserConfig) (*vamana, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid config")
+	}
+
+	logger := cfg.Logger
+	if logger == nil {
+		l := logrus.New()
+		l.Out = io.Discard
+		logger = l
+	}
+
+	index := &vamana{
+		id:                  cfg.ID,
+		targetVector:        cfg.TargetVector,
+		rootPath:            cfg.RootPath,
+		logger:              logger,
+		distancerProvider:   cfg.DistanceProvider,
+		metadataLock:        &sync.Mutex{},
+		pqResults:           common.NewPqMaxPool(100),
+		maxDegree:           uc.MaxDegree,
+		alpha:               float32(uc.Alpha),
+		buildSearchListSize: uc.BuildSearchListSize,
+		compression:         extractCompression(uc),
+		pqSegments:          uc.PQ.Segments,
+		pqCentroids:         uc.PQ.Centroids,
+		pqTrainingLimit:     uc.PQ.TrainingLimit,
+		searchListSize:      int64(uc.SearchListSize),
+		beamWidth:           int64(uc.BeamWidth),
+		flatSearchCutoff:    int64(uc.FlatSearchCutoff),
+		nodeLocks:           common.NewDefaultShardedLocks(),
+		tombstones:          map[uint64]struct{}{},
+		bq:                  compressionhelpers.NewBinaryQuantizer(nil),
+	}
+
+	if err := index.restoreSnapshots(); err != nil {
+		return nil, fmt.Errorf("restore vamana index snapshots: %w", err)
+	}
+
+	graph, err := openDiskGraph(filepath.Join(cfg.RootPath, index.getGraphFile()))
+	if err != nil {
+		return nil, fmt.Errorf("init vamana index graph: %w", err)
+	}
+	index.graph = graph
+
+	if err := index.initMetadata(); err != nil {
+		return nil, fmt.Errorf("init vamana index metadata: %w", err)
+	}
+
+	// the compressed vectors are not persisted, they are restored from the
+	// full precision vectors with a sequential read of the graph
+	if err := index.loadNodes(); err != nil {
+		return nil, fmt.Errorf("load vamana index nodes: %w", err)
+	}
+	if index.hasEntrypoint.Load() && index.getNode(index.entrypoint.Load()) == nil {
+		// the entrypoint was freed without the replacement being persisted
+		index.replaceEntrypoint(nil)
+	}
+
+	index.tombstoneCleanupCallbackCtrl = cfg.TombstoneCallbacks.Register(cfg.ID, index.tombstoneCleanup)
+
+	return index, nil
+}
+
+func extractCompression(uc vamanaent.UserConfig) string {
+	if uc.BQ.Enabled {
+		return compressionBQ
+	}
+	return compressionPQ
+}
+
+func (index *vamana) loadNodes() error {
+	return index.graph.scan(func(node *diskNode) error {
+		index.setNode(node.id, node.vector)
+		if node.tombstone {
+			index.tombstones[node.id] = struct{}{}
+		} else {
+			index.count++
+		}
+		return nil
+	})
+}
+
+func (index *vamana) normalized(vector []float32) []float32 {
+	if index.distancerProvider.Type() == "cosine-dot" {
+		// cosine-dot requires normalized vectors, as the dot product and cosine
+		// similarity are only identical if the vector is normalized
+		return distancer.Normalize(vector)
+	}
+	return vector
+}
+
+// initialized is false until the dimensions and with them the layout of
+// the graph file are known
+func (index *vamana) initialized() bool {
+	return atomic.LoadInt32(&index.dims) != 0
+}
+
+func (index *vamana) ensureDimensions(dims int32) error {
+	if current := atomic.LoadInt32(&index.dims); current != 0 {
+		if current != dims {
+			return errors.Errorf("insert called with a vector of the wrong size: %d, saved length: %d",
+				dims, current)
+		}
+		return nil
+	}
+
+	index.initLock.Lock()
+	defer index.initLock.Unlock()
+
+	if current := atomic.LoadInt32(&index.dims); current != 0 {
+		if current != dims {
+			return errors.Errorf("insert called with a vector of the wrong size: %d, saved length: %d",
+				dims, current)
+		}
+		return nil
+	}
+
+	index.graph.setLayout(int(dims), index.maxDegree)
+	if err := index.setDimensions(dims); err != nil {
+		return errors.Wrap(err, "persist dimensions")
+	}
+	atomic.StoreInt32(&index.dims, dims)
+	return nil
+}
+
+func (index *vamana) setNode(id uint64, vector []float32) {
+	node := &memNode{}
+	switch {
+	case index.compression == compressionBQ:
+		node.bits = index.bq.Encode(vector)
+	case index.pq != nil:
+		node.code = index.pq.Encode(vector)
+	}
+
+	index.nodesLock.Lock()
+	defer index.nodesLock.Unlock()
+
+	if id >= uint64(len(index.nodes)) {
+		grown := make([]*memNode, id+1+id/4)
+		copy(grown, index.nodes)
+		index.nodes = grown
+	}
+	index.nodes[id] = node
+}
+
+func (index *vamana) getNode(id uint64) *memNode {
+	index.nodesLock.RLock()
+	defer index.nodesLock.RUnlock()
+
+	if id >= uint64(len(index.nodes)) {
+		return nil
+	}
+	return index.nodes[id]
+}
+
+func (index *vamana) removeNode(id uint64) {
+	index.nodesLock.Lock()
+	defer index.nodesLock.Unlock()
+
+	if id < uint64(len(index.nodes)) {
+		index.nodes[id] = nil
+	}
+}
+
+func (index *vamana) isTombstoned(id uint64) bool {
+	index.tombstonesLock.RLock()
+	defer index.tombstonesLock.RUnlock()
+
+	_, ok := index.tombstones[id]
+	return ok
+}
+
+func (index *vamana) Compressed() bool {
+	return true
+}
+
+func (index *vamana) Multivector() bool {
+	return false
+}
+
+func (index *vamana) AddMulti(ctx context.Context, docID uint64, vectors [][]float32) error {
+	return errors.Errorf("AddMulti is not supported for vamana index")
+}
+
+func (index *vamana) AddMultiBatch(ctx context.Context, docIDs []uint64, vectors [][][]float32) error {
+	return errors.Errorf("AddMultiBatch is not supported for vamana index")
+}
+
+func (index *vamana) DeleteMulti(ids ...uint64) error {
+	return errors.Errorf("DeleteMulti is not supported for vamana index")
+}
+
+func (index *vamana) SearchByMultiVector(ctx context.Context, vectors [][]float32, k int, allow helpers.AllowList) ([]uint64, []float32, error) {
+	return nil, nil, errors.Errorf("SearchByMultiVector is not supported for vamana index")
+}
+
+func (index *vamana) SearchByVectorDistance(ctx context.Context, vector []float32,
+	targetDistance float32, maxLimit int64, allow helpers.AllowList,
+) ([]uint64, []float32, error) {
+	var (
+		searchParams = newSearchByDistParams(maxLimit)
+
+		resultIDs  []uint64
+		resultDist []float32
+	)
+
+	recursiveSearch := func() (bool, error) {
+		totalLimit := searchParams.TotalLimit()
+		ids, dist, err := index.SearchByVector(ctx, vector, totalLimit, allow)
+		if err != nil {
+			return false, errors.Wrap(err, "vector search")
+		}
+
+		// if there is less results than given limit search can be stopped
+		shouldContinue := !(len(ids) < totalLimit)
+
+		// ensures the indexes aren't out of range
+		offsetCap := searchParams.OffsetCapacity(ids)
+		totalLimitCap := searchParams.TotalLimitCapacity(ids)
+
+		if offsetCap == totalLimitCap {
+			return false, nil
+		}
+
+		ids, dist = ids[offsetCap:totalLimitCap], dist[offsetCap:totalLimitCap]
+		for i := range ids {
+			if aboveThresh := dist[i] <= targetDistance; aboveThresh ||
+				floatcomp.InDelta(float64(dist[i]), float64(targetDistance), 1e-6) {
+				resultIDs = append(resultIDs, ids[i])
+				resultDist = append(resultDist, dist[i])
+			} else {
+				// as soon as we encounter a certainty which
+				// is below threshold, we can stop searching
+				shouldContinue = false
+				break
+			}
+		}
+
+		return shouldContinue, nil
+	}
+
+	var shouldContinue bool
+	var err error
+	for shouldContinue, err = recursiveSearch(); shouldContinue && err == nil; {
+		searchParams.Iterate()
+		if searchParams.MaxLimitReached() {
+			index.logger.
+				WithField("action", "unlimited_vector_search").
+				Warnf("maximum search limit of %d results has been reached",
+					searchParams.MaximumSearchLimit())
+			break
+		}
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return resultIDs, resultDist, nil
+}
+
+func newSearchByDistParams(maxLimit int64) *common.SearchByDistParams {
+	initialOffset := 0
+	initialLimit := common.DefaultSearchByDistInitialLimit
+
+	return common.NewSearchByDistParams(initialOffset, initialLimit, initialOffset+initialLimit, maxLimit)
+}
+
+func (index *vamana) UpdateUserConfig(updated schemaConfig.VectorIndexConfig, callback func()) error {
+	parsed, ok := updated.(vamanaent.UserConfig)
+	if !ok {
+		callback()
+		return errors.Errorf("config is not UserConfig, but %T", updated)
+	}
+
+	// Store atomically as a lock here would be very expensive, these values are
+	// read on every single user-facing search, which can be highly concurrent
+	atomic.StoreInt64(&index.searchListSize, int64(parsed.SearchListSize))
+	atomic.StoreInt64(&index.beamWidth, int64(parsed.BeamWidth))
+	atomic.StoreInt64(&index.flatSearchCutoff, int64(parsed.FlatSearchCutoff))
+
+	callback()
+	return nil
+}
+
+type immutableParameter struct {
+	accessor func(c vamanaent.UserConfig) interface{}
+	name     string
+}
+
+func validateImmutableField(u immutableParameter,
+	previous, next vamanaent.UserConfig,
+) error {
+	oldField := u.accessor(previous)
+	newField := u.accessor(next)
+	if oldField != newField {
+		return errors.Errorf("%s is immutable: attempted change from \"%v\" to \"%v\"",
+			u.name, oldField, newField)
+	}
+
+	return nil
+}
+
+func ValidateUserConfigUpdate(initial, updated schemaConfig.VectorIndexConfig) error {
+	initialParsed, ok := initial.(vamanaent.UserConfig)
+	if !ok {
+		return errors.Errorf("initial is not UserConfig, but %T", initial)
+	}
+
+	updatedParsed, ok := updated.(vamanaent.UserConfig)
+	if !ok {
+		return errors.Errorf("updated is not UserConfig, but %T", updated)
+	}
+
+	immutableFields := []immutableParameter{
+		{
+			name:     "distance",
+			accessor: func(c vamanaent.UserConfig) interface{} { return c.Distance },
+		},
+		{
+			name:     "maxDegree",
+			accessor: func(c vamanaent.UserConfig) interface{} { return c.MaxDegree },
+		},
+		{
+			name:     "alpha",
+			accessor: func(c vamanaent.UserConfig) interface{} { return c.Alpha },
+		},
+		{
+			name:     "buildSearchListSize",
+			accessor: func(c vamanaent.UserConfig) interface{} { return c.BuildSearchListSize },
+		},
+		{
+			name:     "pq",
+			accessor: func(c vamanaent.UserConfig) interface{} { return c.PQ },
+		},
+		{
+			name:     "bq",
+			accessor: func(c vamanaent.UserConfig) interface{} { return c.BQ.Enabled },
+		},
+	}
+
+	for _, u := range immutableFields {
+		if err := validateImmutableField(u, initialParsed, updatedParsed); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (index *vamana) Drop(ctx context.Context) error {
+	if err := index.Shutdown(ctx); err != nil {
+		return err
+	}
+	for _, name := range []string{
+		index.getGraphFile(), index.getMetadataFile(),
+		index.getGraphSnapshotFile(), index.getMetadataSnapshotFile(),
+	} {
+		if err := index.removeFile(name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (index *vamana) Flush() error {
+	if !index.initialized() {
+		return nil
+	}
+	return index.graph.sync()
+}
+
+func (index *vamana) Shutdown(ctx context.Context) error {
+	if err := index.tombstoneCleanupCallbackCtrl.Unregister(ctx); err != nil {
+		return errors.Wrap(err, "vamana shutdown")
+	}
+	if err := index.Flush(); err != nil {
+		return errors.Wrap(err, "vamana shutdown")
+	}
+	index.closeMetadata()
+	return index.graph.close()
+}
+
+// SwitchCommitLogs is called before a backup. The index has no commit log
+// and its files are rewritten in place, so instead snapshots of them are
+// taken, which are listed for the backup.
+func (index *vamana) SwitchCommitLogs(context.Context) error {
+	if !index.initialized() {
+		return nil
+	}
+	return index.snapshot()
+}
+
+func (index *vamana) ListFiles(ctx context.Context, basePath string) ([]string, error) {
+	var files []string
+
+	for _, name := range []string{index.getMetadataSnapshotFile(), index.getGraphSnapshotFile()} {
+		fullPath := filepath.Join(index.rootPath, name)
+		if _, err := os.Stat(fullPath); err != nil {
+			// If the file doesn't exist, we simply don't add it to the list
+			continue
+		}
+		relPath, err := filepath.Rel(basePath, fullPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get relative path: %w", err)
+		}
+		files = append(files, relPath)
+	}
+
+	return files, nil
+}
+
+func (index *vamana) GetKeys(id uint64) (uint64, uint64, error) {
+	return 0, 0, errors.Errorf("GetKeys is not supported for vamana index")
+}
+
+func (index *vamana) ValidateBeforeInsert(vector []float32) error {
+	return nil
+}
+
+func (index *vamana) ValidateMultiBeforeInsert(vector [][]float32) error {
+	return nil
+}
+
+func (index *vamana) PostStartup() {
+	if index.shouldTrain() {
+		index.trainInBackground()
+	}
+}
+
+// The PQ encoder is trained by the index itself as soon as enough vectors
+// have been imported, so there is nothing left for the async indexing queue
+// to upgrade. These methods make the index usable as the upgraded index of
+// the dynamic index.
+func (index *vamana) ShouldUpgrade() (bool, int) {
+	return false, 0
+}
+
+func (index *vamana) Upgraded() bool {
+	return true
+}
+
+func (index *vamana) Upgrade(callback func()) error {
+	callback()
+	return nil
+}
+
+func (index *vamana) Dump(labels ...string) {
+	if len(labels) > 0 {
+		fmt.Printf("--------------------------------------------------\n")
+		fmt.Printf("--  %s\n", strings.Join(labels, ", "))
+	}
+	fmt.Printf("--------------------------------------------------\n")
+	fmt.Printf("ID: %s\n", index.id)
+	fmt.Printf("Entrypoint: %d\n", index.entrypoint.Load())
+	fmt.Printf("Nodes: %d\n", atomic.LoadUint64(&index.count))
+	fmt.Printf("--------------------------------------------------\n")
+}
+
+func (index *vamana) DistanceBetweenVectors(x, y []float32) (float32, error) {
+	return index.distancerProvider.SingleDist(x, y)
+}
+
+func (index *vamana) ContainsNode(id uint64) bool {
+	return index.getNode(id) != nil && !index.isTombstoned(id)
+}
+
+func (index *vamana) Iterate(fn func(id uint64) bool) {
+	index.nodesLock.RLock()
+	size := uint64(len(index.nodes))
+	index.nodesLock.RUnlock()
+
+	for id := uint64(0); id < size; id++ {
+		if !index.ContainsNode(id) {
+			continue
+		}
+		if !fn(id) {
+			break
+		}
+	}
+}
+
+func (index *vamana) DistancerProvider() distancer.Provider {
+	return index.distancerProvider
+}
+
+func (index *vamana) AlreadyIndexed() uint64 {
+	return atomic.LoadUint64(&index.count)
+}
+
+func (index *vamana) QueryVectorDistancer(queryVector []float32) common.QueryVectorDistancer {
+	queryVector = index.normalized(queryVector)
+	return common.QueryVectorDistancer{DistanceFunc: func(nodeID uint64) (float32, error) {
+		node, err := index.readNode(nodeID)
+		if err != nil {
+			return 0, err
+		}
+		if node == nil {
+			return 0, fmt.Errorf("vector for node %d not found", nodeID)
+		}
+		return index.distancerProvider.SingleDist(queryVector, node.vector)
+	}}
+}
+
+// readNode returns nil if there is no node with the given id
+func (index *vamana) readNode(id uint64) (*diskNode, error) {
+	if !index.initialized() {
+		return nil, nil
+	}
+	return index.graph.read(id)
+}
+
+func (index *vamana) Stats() (common.IndexStats, error) {
+	index.trainLock.RLock()
+	defer index.trainLock.RUnlock()
+
+	index.tombstonesLock.RLock()
+	defer index.tombstonesLock.RUnlock()
+
+	return &VamanaStats{
+		Dimensions: int(atomic.LoadInt32(&index.dims)),
+		Nodes:      atomic.LoadUint64(&index.count),
+		Tombstones: len(index.tombstones),
+		Compressed: index.compression == compressionBQ || index.pq != nil,
+	}, nil
+}
+
+type VamanaStats struct {
+	Dimensions int    `json:"dimensions"`
+	Nodes      uint64 `json:"nodes"`
+	Tombstones int    `json:"tombstones"`
+	Compressed bool   `json:"compressed"`
+}
+
+func (s *VamanaStats) IndexType() common.IndexType {
+	return common.IndexTypeVamana
+}
+
+func insertToHeap(heap *priorityqueue.Queue[any], limit int, id uint64, distance float32) {
+	if heap.Len() < limit {
+		heap.Insert(id, distance)
+	} else if heap.Top().Dist > distance {
+		heap.Pop()
+		heap.Insert(id, distance)
+	}
+}
+
+func extractHeap(heap *priorityqueue.Queue[any]) ([]uint64, []float32) {
+	len := heap.Len()
+
+	ids := make([]uint64, len)
+	dists := make([]float32, len)
+	for i := len - 1; i >= 0; i-- {
+		item := heap.Pop()
+		ids[i] = item.ID
+		dists[i] = item.Dist
+	}
+	return ids, dists
+}
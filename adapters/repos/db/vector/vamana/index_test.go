@@ -0,0 +1,434 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package vamana
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/testinghelpers"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	vamanaent "github.com/weaviate/weaviate/entities/vectorindex/vamana"
+)
+
+const (
+	testDimensions = 32
+	testVectors    = 2000
+	testQueries    = 50
+	testK          = 10
+)
+
+func distanceWrapper(provider distancer.Provider) func(x, y []float32) float32 {
+	return func(x, y []float32) float32 {
+		dist, _ := provider.SingleDist(x, y)
+		return dist
+	}
+}
+
+func testUserConfig() vamanaent.UserConfig {
+	uc := vamanaent.NewDefaultUserConfig()
+	uc.MaxDegree = 32
+	uc.BuildSearchListSize = 64
+	uc.SearchListSize = 64
+	uc.PQ.Segments = 8
+	uc.PQ.TrainingLimit = testVectors / 2
+	return uc
+}
+
+func newTestIndex(t *testing.T, dir string, provider distancer.Provider, uc vamanaent.UserConfig) *vamana {
+	index, err := New(Config{
+		ID:                 "vamana-test",
+		RootPath:           dir,
+		DistanceProvider:   provider,
+		TombstoneCallbacks: cyclemanager.NewCallbackGroupNoop(),
+	}, uc)
+	require.Nil(t, err)
+	return index
+}
+
+func addConcurrently(t *testing.T, index *vamana, vectors [][]float32) {
+	var wg sync.WaitGroup
+	workers := 4
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := w; i < len(vectors); i += workers {
+				require.Nil(t, index.Add(context.Background(), uint64(i), vectors[i]))
+			}
+		}(w)
+	}
+	wg.Wait()
+}
+
+func waitForTraining(t *testing.T, index *vamana) {
+	require.Eventually(t, func() bool {
+		index.trainLock.RLock()
+		defer index.trainLock.RUnlock()
+		return index.pq != nil
+	}, 30*time.Second, 10*time.Millisecond)
+}
+
+func recall(t *testing.T, index *vamana, vectors, queries [][]float32, provider distancer.Provider,
+	allow helpers.AllowList,
+) float32 {
+	logger, _ := test.NewNullLogger()
+	var relevant uint64
+	for _, query := range queries {
+		candidates := make([][]float32, len(vectors))
+		for i := range vectors {
+			if index.ContainsNode(uint64(i)) && (allow == nil || allow.Contains(uint64(i))) {
+				candidates[i] = vectors[i]
+			}
+		}
+		truth, _ := testinghelpers.BruteForce(logger, candidates, query, testK, distanceWrapper(provider))
+
+		results, _, err := index.SearchByVector(context.Background(), query, testK, allow)
+		require.Nil(t, err)
+		relevant += testinghelpers.MatchesInLists(truth, results)
+	}
+	return float32(relevant) / float32(testK*len(queries))
+}
+
+func TestVamana(t *testing.T) {
+	tests := []struct {
+		name       string
+		dimensions int
+		provider   distancer.Provider
+		configure  func(uc *vamanaent.UserConfig)
+	}{
+		{
+			name:       "pq, l2-squared",
+			dimensions: testDimensions,
+			provider:   distancer.NewL2SquaredProvider(),
+			configure:  func(uc *vamanaent.UserConfig) {},
+		},
+		{
+			name:       "pq, cosine",
+			dimensions: testDimensions,
+			provider:   distancer.NewCosineDistanceProvider(),
+			configure: func(uc *vamanaent.UserConfig) {
+				uc.Distance = "cosine"
+			},
+		},
+		{
+			name: "bq, cosine",
+			// binary codes of only a few dimensions are too coarse to navigate
+			dimensions: 4 * testDimensions,
+			provider:   distancer.NewCosineDistanceProvider(),
+			configure: func(uc *vamanaent.UserConfig) {
+				uc.Distance = "cosine"
+				uc.PQ.Enabled = false
+				uc.BQ.Enabled = true
+				uc.SearchListSize = 256
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vectors, queries := testinghelpers.RandomVecsFixedSeed(testVectors, testQueries, tt.dimensions)
+			dir := t.TempDir()
+			uc := testUserConfig()
+			tt.configure(&uc)
+
+			expected := vectors
+			if tt.provider.Type() == "cosine-dot" {
+				expected = make([][]float32, len(vectors))
+				for i := range vectors {
+					expected[i] = distancer.Normalize(vectors[i])
+				}
+			}
+
+			index := newTestIndex(t, dir, tt.provider, uc)
+			addConcurrently(t, index, vectors)
+			if uc.PQ.Enabled {
+				waitForTraining(t, index)
+			}
+			assert.Equal(t, uint64(testVectors), index.AlreadyIndexed())
+			assert.GreaterOrEqual(t, recall(t, index, expected, queries, tt.provider, nil), float32(0.9))
+
+			t.Run("with restart", func(t *testing.T) {
+				require.Nil(t, index.Shutdown(context.Background()))
+
+				restarted := newTestIndex(t, dir, tt.provider, uc)
+				defer restarted.Shutdown(context.Background())
+
+				if uc.PQ.Enabled {
+					require.NotNil(t, restarted.pq)
+				}
+				assert.Equal(t, uint64(testVectors), restarted.AlreadyIndexed())
+				assert.GreaterOrEqual(t, recall(t, restarted, expected, queries, tt.provider, nil), float32(0.9))
+			})
+		})
+	}
+}
+
+func TestVamanaBeforeTraining(t *testing.T) {
+	vectors, queries := testinghelpers.RandomVecsFixedSeed(200, testQueries, testDimensions)
+	provider := distancer.NewL2SquaredProvider()
+
+	index := newTestIndex(t, t.TempDir(), provider, testUserConfig())
+	defer index.Shutdown(context.Background())
+
+	addConcurrently(t, index, vectors)
+	require.Nil(t, index.pq)
+
+	// navigated with the full precision vectors from disk
+	assert.GreaterOrEqual(t, recall(t, index, vectors, queries, provider, nil), float32(0.95))
+}
+
+func TestVamanaFilteredSearch(t *testing.T) {
+	vectors, queries := testinghelpers.RandomVecsFixedSeed(testVectors, testQueries, testDimensions)
+	provider := distancer.NewL2SquaredProvider()
+
+	uc := testUserConfig()
+	index := newTestIndex(t, t.TempDir(), provider, uc)
+	defer index.Shutdown(context.Background())
+	addConcurrently(t, index, vectors)
+
+	allow := helpers.NewAllowList()
+	for i := 0; i < testVectors; i += 10 {
+		allow.Insert(uint64(i))
+	}
+
+	t.Run("below flat search cutoff", func(t *testing.T) {
+		assert.Equal(t, float32(1), recall(t, index, vectors, queries, provider, allow))
+	})
+
+	t.Run("above flat search cutoff", func(t *testing.T) {
+		uc.FlatSearchCutoff = 0
+		require.Nil(t, index.UpdateUserConfig(uc, func() {}))
+
+		results, _, err := index.SearchByVector(context.Background(), queries[0], testK, allow)
+		require.Nil(t, err)
+		require.NotEmpty(t, results)
+		for _, id := range results {
+			assert.True(t, allow.Contains(id))
+		}
+	})
+
+	t.Run("above flat search cutoff with a restrictive filter", func(t *testing.T) {
+		// far fewer allowed nodes than a search list visits
+		sparse := helpers.NewAllowList()
+		for i := 0; i < testVectors; i += 200 {
+			sparse.Insert(uint64(i))
+		}
+
+		for _, query := range queries {
+			results, _, err := index.SearchByVector(context.Background(), query, testK, sparse)
+			require.Nil(t, err)
+			require.Len(t, results, testK)
+			for _, id := range results {
+				assert.True(t, sparse.Contains(id))
+			}
+		}
+	})
+
+	t.Run("empty allow list", func(t *testing.T) {
+		results, _, err := index.SearchByVector(context.Background(), queries[0], testK, helpers.NewAllowList())
+		require.Nil(t, err)
+		assert.Empty(t, results)
+	})
+}
+
+func TestVamanaDelete(t *testing.T) {
+	vectors, queries := testinghelpers.RandomVecsFixedSeed(testVectors, testQueries, testDimensions)
+	provider := distancer.NewL2SquaredProvider()
+
+	dir := t.TempDir()
+	uc := testUserConfig()
+	index := newTestIndex(t, dir, provider, uc)
+	addConcurrently(t, index, vectors)
+	waitForTraining(t, index)
+
+	entrypoint := index.entrypoint.Load()
+	deleted := []uint64{entrypoint}
+	for i := uint64(1); i < testVectors; i += 5 {
+		if i != entrypoint {
+			deleted = append(deleted, i)
+		}
+	}
+	require.Nil(t, index.Delete(deleted...))
+	assert.Equal(t, uint64(testVectors-len(deleted)), index.AlreadyIndexed())
+
+	assertDeleted := func(t *testing.T, index *vamana) {
+		for _, id := range deleted {
+			assert.False(t, index.ContainsNode(id))
+		}
+		for _, query := range queries {
+			results, _, err := index.SearchByVector(context.Background(), query, testK, nil)
+			require.Nil(t, err)
+			for _, id := range results {
+				assert.NotContains(t, deleted, id)
+			}
+		}
+		assert.GreaterOrEqual(t, recall(t, index, vectors, queries, provider, nil), float32(0.9))
+	}
+
+	t.Run("tombstoned", func(t *testing.T) {
+		assertDeleted(t, index)
+	})
+
+	t.Run("after cleanup", func(t *testing.T) {
+		executed, err := index.cleanUpTombstones(func() bool { return false })
+		require.Nil(t, err)
+		require.True(t, executed)
+
+		assert.NotContains(t, deleted, index.entrypoint.Load())
+		assert.Empty(t, index.tombstones)
+		for _, id := range deleted {
+			node, err := index.graph.read(id)
+			require.Nil(t, err)
+			assert.Nil(t, node)
+		}
+		assertDeleted(t, index)
+	})
+
+	t.Run("with restart", func(t *testing.T) {
+		require.Nil(t, index.Shutdown(context.Background()))
+
+		restarted := newTestIndex(t, dir, provider, uc)
+		defer restarted.Shutdown(context.Background())
+
+		assert.Equal(t, uint64(testVectors-len(deleted)), restarted.AlreadyIndexed())
+		assertDeleted(t, restarted)
+	})
+}
+
+func TestVamanaListFiles(t *testing.T) {
+	dir := t.TempDir()
+	index := newTestIndex(t, dir, distancer.NewL2SquaredProvider(), testUserConfig())
+	require.Nil(t, index.Add(context.Background(), 0, []float32{1, 2, 3}))
+
+	require.Nil(t, index.SwitchCommitLogs(context.Background()))
+	files, err := index.ListFiles(context.Background(), dir)
+	require.Nil(t, err)
+	assert.ElementsMatch(t, []string{"vamana.db.snapshot", "vamana.graph.snapshot"}, files)
+
+	require.Nil(t, index.Drop(context.Background()))
+	files, err = index.ListFiles(context.Background(), dir)
+	require.Nil(t, err)
+	assert.Empty(t, files)
+}
+
+func TestVamanaBackupSnapshot(t *testing.T) {
+	vectors, queries := testinghelpers.RandomVecsFixedSeed(testVectors, testQueries, testDimensions)
+	provider := distancer.NewL2SquaredProvider()
+	uc := testUserConfig()
+
+	dir := t.TempDir()
+	index := newTestIndex(t, dir, provider, uc)
+	addConcurrently(t, index, vectors[:testVectors/2])
+
+	// the snapshot is taken while nodes are written
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		addConcurrently(t, index, vectors[testVectors/2:])
+	}()
+	require.Nil(t, index.SwitchCommitLogs(context.Background()))
+	files, err := index.ListFiles(context.Background(), dir)
+	require.Nil(t, err)
+	wg.Wait()
+	require.Nil(t, index.Shutdown(context.Background()))
+
+	// restore the backed up files only
+	restoreDir := t.TempDir()
+	for _, file := range files {
+		content, err := os.ReadFile(filepath.Join(dir, file))
+		require.Nil(t, err)
+		require.Nil(t, os.WriteFile(filepath.Join(restoreDir, file), content, 0o600))
+	}
+
+	restored := newTestIndex(t, restoreDir, provider, uc)
+	defer restored.Shutdown(context.Background())
+	assert.GreaterOrEqual(t, restored.AlreadyIndexed(), uint64(testVectors/2))
+	for i := 0; i < testVectors/2; i++ {
+		require.True(t, restored.ContainsNode(uint64(i)))
+	}
+	assert.GreaterOrEqual(t, recall(t, restored, vectors, queries, provider, nil), float32(0.8))
+
+	entries, err := os.ReadDir(restoreDir)
+	require.Nil(t, err)
+	var names []string
+	for _, file := range entries {
+		names = append(names, file.Name())
+	}
+	assert.ElementsMatch(t, []string{"vamana.db", "vamana.graph"}, names)
+}
+
+func TestVamanaGraphLayout(t *testing.T) {
+	tests := []struct {
+		name          string
+		dims          int
+		maxDegree     int
+		slotsPerBlock int
+		blockSize     int
+	}{
+		{name: "slots packed into a page", dims: 32, maxDegree: 32, slotsPerBlock: 10, blockSize: pageSize},
+		{name: "slot filling a page", dims: 512, maxDegree: 254, slotsPerBlock: 1, blockSize: pageSize},
+		{name: "slot spanning pages", dims: 1536, maxDegree: 64, slotsPerBlock: 1, blockSize: 2 * pageSize},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := &diskGraph{}
+			g.setLayout(tt.dims, tt.maxDegree)
+			assert.Equal(t, tt.slotsPerBlock, g.slotsPerBlock)
+			assert.Equal(t, tt.blockSize, g.blockSize)
+
+			for id := uint64(0); id < 100; id++ {
+				start := g.offset(id)
+				end := start + int64(g.slotSize) - 1
+				assert.Equal(t, start/int64(g.blockSize), end/int64(g.blockSize),
+					"slot %d crosses a block boundary", id)
+			}
+		})
+	}
+}
+
+func TestVamanaValidateUserConfigUpdate(t *testing.T) {
+	initial := testUserConfig()
+
+	t.Run("mutable fields", func(t *testing.T) {
+		updated := initial
+		updated.SearchListSize = 200
+		updated.BeamWidth = 8
+		updated.FlatSearchCutoff = 10
+		assert.Nil(t, ValidateUserConfigUpdate(initial, updated))
+	})
+
+	t.Run("immutable fields", func(t *testing.T) {
+		updated := initial
+		updated.MaxDegree = 128
+		err := ValidateUserConfigUpdate(initial, updated)
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "maxDegree is immutable")
+
+		updated = initial
+		updated.PQ.Segments = 4
+		err = ValidateUserConfigUpdate(initial, updated)
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "pq is immutable")
+	})
+}
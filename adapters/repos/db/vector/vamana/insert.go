@@ -0,0 +1,285 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package vamana
+
+import (
+	"context"
+	"sort"
+	"sync/atomic"
+
+	"github.com/pkg/errors"
+)
+
+func (index *vamana) AddBatch(ctx context.Context, ids []uint64, vectors [][]float32) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if len(ids) != len(vectors) {
+		return errors.Errorf("ids and vectors sizes does not match")
+	}
+	if len(ids) == 0 {
+		return errors.Errorf("insertBatch called with empty lists")
+	}
+
+	for i := range ids {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := index.Add(ctx, ids[i], vectors[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (index *vamana) Add(ctx context.Context, id uint64, vector []float32) error {
+	if len(vector) == 0 {
+		return errors.Errorf("insert called with empty vector")
+	}
+	if err := index.ensureDimensions(int32(len(vector))); err != nil {
+		return err
+	}
+	vector = index.normalized(vector)
+	exists := index.ContainsNode(id)
+
+	index.trainLock.RLock()
+	err := index.insert(ctx, id, vector)
+	index.trainLock.RUnlock()
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		atomic.AddUint64(&index.count, 1)
+	}
+	if index.shouldTrain() {
+		index.trainInBackground()
+	}
+	return nil
+}
+
+// insert links a new node into the graph: its neighbors are pruned from the
+// nodes visited on a search for its vector, and the node is added to the
+// neighbors of each of them
+func (index *vamana) insert(ctx context.Context, id uint64, vector []float32) error {
+	if inserted, err := index.insertFirst(id, vector); inserted || err != nil {
+		return err
+	}
+
+	visited, err := index.beamSearch(ctx, vector, index.buildSearchListSize, 1)
+	if err != nil {
+		return errors.Wrap(err, "search neighbors")
+	}
+
+	candidates := make([]scoredNode, 0, len(visited))
+	for _, scored := range visited {
+		if scored.node.id == id || scored.node.tombstone || index.isTombstoned(scored.node.id) {
+			continue
+		}
+		candidates = append(candidates, scored)
+	}
+	neighbors, err := index.robustPrune(candidates)
+	if err != nil {
+		return err
+	}
+
+	index.nodeLocks.Lock(id)
+	err = index.graph.write(&diskNode{id: id, vector: vector, neighbors: neighbors})
+	index.nodeLocks.Unlock(id)
+	if err != nil {
+		return err
+	}
+	index.setNode(id, vector)
+	index.removeTombstone(id)
+
+	for _, neighbor := range neighbors {
+		if err := index.addNeighbor(neighbor, id, vector); err != nil {
+			return errors.Wrapf(err, "link node %d", neighbor)
+		}
+	}
+	return nil
+}
+
+// insertFirst inserts the node as the entrypoint if the graph is empty
+func (index *vamana) insertFirst(id uint64, vector []float32) (bool, error) {
+	if index.hasEntrypoint.Load() {
+		return false, nil
+	}
+
+	index.initLock.Lock()
+	defer index.initLock.Unlock()
+
+	if index.hasEntrypoint.Load() {
+		return false, nil
+	}
+
+	if err := index.graph.write(&diskNode{id: id, vector: vector}); err != nil {
+		return false, err
+	}
+	if err := index.setEntrypoint(id); err != nil {
+		return false, errors.Wrap(err, "persist entrypoint")
+	}
+	index.setNode(id, vector)
+	index.removeTombstone(id)
+	index.entrypoint.Store(id)
+	index.hasEntrypoint.Store(true)
+	return true, nil
+}
+
+// addNeighbor adds the new node to the neighbors of the given node, pruning
+// them if the maximum degree is exceeded
+func (index *vamana) addNeighbor(id, newID uint64, newVector []float32) error {
+	index.nodeLocks.Lock(id)
+	defer index.nodeLocks.Unlock(id)
+
+	node, err := index.graph.read(id)
+	if err != nil || node == nil {
+		return err
+	}
+	for _, neighbor := range node.neighbors {
+		if neighbor == newID {
+			return nil
+		}
+	}
+
+	if len(node.neighbors) < index.maxDegree {
+		node.neighbors = append(node.neighbors, newID)
+		return index.graph.write(node)
+	}
+
+	candidates, err := index.scoreNodes(node.vector, node.neighbors, nil)
+	if err != nil {
+		return err
+	}
+	dist, err := index.distancerProvider.SingleDist(node.vector, newVector)
+	if err != nil {
+		return err
+	}
+	candidates = append(candidates, scoredNode{
+		node: &diskNode{id: newID, vector: newVector},
+		dist: dist,
+	})
+
+	node.neighbors, err = index.robustPrune(candidates)
+	if err != nil {
+		return err
+	}
+	return index.graph.write(node)
+}
+
+// scoreNodes reads the given nodes from disk and scores them by their
+// distance to the vector. Missing, tombstoned and excluded nodes are skipped.
+func (index *vamana) scoreNodes(vector []float32, ids []uint64, exclude map[uint64]struct{},
+) ([]scoredNode, error) {
+	scored := make([]scoredNode, 0, len(ids))
+	for _, id := range ids {
+		if _, ok := exclude[id]; ok {
+			continue
+		}
+		node, err := index.graph.read(id)
+		if err != nil {
+			return nil, err
+		}
+		if node == nil || node.tombstone || index.isTombstoned(id) {
+			continue
+		}
+		dist, err := index.distancerProvider.SingleDist(vector, node.vector)
+		if err != nil {
+			return nil, err
+		}
+		scored = append(scored, scoredNode{node: node, dist: dist})
+	}
+	return scored, nil
+}
+
+// robustPrune selects up to maxDegree neighbors from the candidates, which are
+// scored by their distance to the node. A candidate is skipped if it is
+// closer to an already selected neighbor than to the node by a factor of
+// alpha, which keeps long range edges that make the graph navigable.
+func (index *vamana) robustPrune(candidates []scoredNode) ([]uint64, error) {
+	sort.Slice(candidates, func(i, j int) bool { return candidates[i].dist < candidates[j].dist })
+
+	alpha := index.pruneAlpha()
+	neighbors := make([]uint64, 0, index.maxDegree)
+	for len(candidates) > 0 && len(neighbors) < index.maxDegree {
+		selected := candidates[0]
+		neighbors = append(neighbors, selected.node.id)
+
+		remaining := candidates[:0]
+		for _, c := range candidates[1:] {
+			if c.node.id == selected.node.id {
+				continue
+			}
+			dist, err := index.distancerProvider.SingleDist(selected.node.vector, c.node.vector)
+			if err != nil {
+				return nil, err
+			}
+			if alpha*dist > c.dist {
+				remaining = append(remaining, c)
+			}
+		}
+		candidates = remaining
+	}
+	return neighbors, nil
+}
+
+// pruneAlpha scales distances during pruning, which requires distances to be
+// non-negative. Dot product distances can be negative, so they are pruned
+// without scaling.
+func (index *vamana) pruneAlpha() float32 {
+	if index.distancerProvider.Type() == "dot" {
+		return 1
+	}
+	return index.alpha
+}
+
+func (index *vamana) Delete(ids ...uint64) error {
+	for _, id := range ids {
+		if err := index.delete(id); err != nil {
+			return errors.Wrapf(err, "delete node %d", id)
+		}
+	}
+	return nil
+}
+
+// delete tombstones the node, it remains part of the graph until the next
+// tombstone cleanup
+func (index *vamana) delete(id uint64) error {
+	if !index.ContainsNode(id) {
+		return nil
+	}
+
+	index.nodeLocks.Lock(id)
+	defer index.nodeLocks.Unlock(id)
+
+	node, err := index.graph.read(id)
+	if err != nil || node == nil || node.tombstone {
+		return err
+	}
+	node.tombstone = true
+	if err := index.graph.write(node); err != nil {
+		return err
+	}
+
+	index.tombstonesLock.Lock()
+	index.tombstones[id] = struct{}{}
+	index.tombstonesLock.Unlock()
+	atomic.AddUint64(&index.count, ^uint64(0))
+	return nil
+}
+
+func (index *vamana) removeTombstone(id uint64) {
+	index.tombstonesLock.Lock()
+	defer index.tombstonesLock.Unlock()
+
+	delete(index.tombstones, id)
+}
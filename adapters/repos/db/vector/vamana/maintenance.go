@@ -0,0 +1,298 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package vamana
+
+import (
+	"sync/atomic"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/compressionhelpers"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+)
+
+var errAborted = errors.New("aborted")
+
+// tombstoneCleanup removes the tombstoned nodes from the graph. Every node
+// with a tombstoned neighbor gets the neighbors of that neighbor as
+// replacement candidates and is pruned again, before the slots of the
+// tombstoned nodes are freed.
+func (index *vamana) tombstoneCleanup(shouldAbort cyclemanager.ShouldAbortCallback) bool {
+	executed, err := index.cleanUpTombstones(shouldAbort)
+	if err != nil {
+		index.logger.WithField("action", "vamana_tombstone_cleanup").WithField("id", index.id).
+			WithError(err).Error("tombstone cleanup errored")
+	}
+	return executed
+}
+
+func (index *vamana) cleanUpTombstones(shouldAbort cyclemanager.ShouldAbortCallback) (bool, error) {
+	index.tombstonesLock.RLock()
+	deleted := make(map[uint64]struct{}, len(index.tombstones))
+	for id := range index.tombstones {
+		deleted[id] = struct{}{}
+	}
+	index.tombstonesLock.RUnlock()
+
+	if len(deleted) == 0 || !index.initialized() {
+		return false, nil
+	}
+
+	index.trainLock.RLock()
+	defer index.trainLock.RUnlock()
+
+	var affected []uint64
+	err := index.graph.scan(func(node *diskNode) error {
+		if shouldAbort() {
+			return errAborted
+		}
+		if _, ok := deleted[node.id]; ok || node.tombstone {
+			return nil
+		}
+		for _, neighbor := range node.neighbors {
+			if _, ok := deleted[neighbor]; ok {
+				affected = append(affected, node.id)
+				break
+			}
+		}
+		return nil
+	})
+	if errors.Is(err, errAborted) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	for _, id := range affected {
+		if shouldAbort() {
+			return false, nil
+		}
+		if err := index.reconnect(id, deleted); err != nil {
+			return false, errors.Wrapf(err, "reconnect node %d", id)
+		}
+	}
+
+	if _, ok := deleted[index.entrypoint.Load()]; ok {
+		index.replaceEntrypoint(deleted)
+	}
+
+	for id := range deleted {
+		if err := index.free(id); err != nil {
+			return false, errors.Wrapf(err, "free node %d", id)
+		}
+	}
+	return true, nil
+}
+
+func (index *vamana) reconnect(id uint64, deleted map[uint64]struct{}) error {
+	index.nodeLocks.Lock(id)
+	defer index.nodeLocks.Unlock(id)
+
+	node, err := index.graph.read(id)
+	if err != nil || node == nil {
+		return err
+	}
+
+	seen := map[uint64]struct{}{id: {}}
+	var candidateIDs []uint64
+	addCandidate := func(candidate uint64) {
+		if _, ok := seen[candidate]; ok {
+			return
+		}
+		seen[candidate] = struct{}{}
+		candidateIDs = append(candidateIDs, candidate)
+	}
+
+	for _, neighbor := range node.neighbors {
+		if _, ok := deleted[neighbor]; !ok {
+			addCandidate(neighbor)
+			continue
+		}
+		deletedNode, err := index.graph.read(neighbor)
+		if err != nil {
+			return err
+		}
+		if deletedNode == nil {
+			continue
+		}
+		for _, replacement := range deletedNode.neighbors {
+			addCandidate(replacement)
+		}
+	}
+
+	candidates, err := index.scoreNodes(node.vector, candidateIDs, deleted)
+	if err != nil {
+		return err
+	}
+	if len(candidates) <= index.maxDegree {
+		node.neighbors = node.neighbors[:0]
+		for _, c := range candidates {
+			node.neighbors = append(node.neighbors, c.node.id)
+		}
+	} else if node.neighbors, err = index.robustPrune(candidates); err != nil {
+		return err
+	}
+	return index.graph.write(node)
+}
+
+// replaceEntrypoint picks the first live node as the new entrypoint
+func (index *vamana) replaceEntrypoint(deleted map[uint64]struct{}) {
+	index.initLock.Lock()
+	defer index.initLock.Unlock()
+
+	found := false
+	index.Iterate(func(id uint64) bool {
+		if _, ok := deleted[id]; ok {
+			return true
+		}
+		if err := index.setEntrypoint(id); err != nil {
+			index.logger.WithField("action", "vamana_replace_entrypoint").WithField("id", index.id).
+				WithError(err).Error("persist entrypoint")
+		}
+		index.entrypoint.Store(id)
+		found = true
+		return false
+	})
+	if !found {
+		index.hasEntrypoint.Store(false)
+	}
+}
+
+// free clears the slot of a tombstoned node, unless it has been inserted
+// again in the meantime
+func (index *vamana) free(id uint64) error {
+	index.nodeLocks.Lock(id)
+	defer index.nodeLocks.Unlock(id)
+
+	if !index.isTombstoned(id) {
+		return nil
+	}
+	if err := index.graph.clear(id); err != nil {
+		return err
+	}
+	index.removeNode(id)
+	index.removeTombstone(id)
+	return nil
+}
+
+func (index *vamana) shouldTrain() bool {
+	if index.compression != compressionPQ || index.training.Load() {
+		return false
+	}
+	if atomic.LoadUint64(&index.count) < uint64(index.pqTrainingLimit) {
+		return false
+	}
+
+	index.trainLock.RLock()
+	defer index.trainLock.RUnlock()
+	return index.pq == nil
+}
+
+func (index *vamana) trainInBackground() {
+	if !index.training.CompareAndSwap(false, true) {
+		return
+	}
+
+	enterrors.GoWrapper(func() {
+		defer index.training.Store(false)
+
+		if err := index.train(); err != nil {
+			index.logger.WithField("action", "vamana_train").WithField("id", index.id).
+				WithError(err).Error("training pq encoder failed")
+		}
+	}, index.logger)
+}
+
+// train fits the PQ encoder to the stored vectors and replaces the in-memory
+// copy of every node with its PQ code
+func (index *vamana) train() error {
+	dims := int(atomic.LoadInt32(&index.dims))
+	sample := make([][]float32, 0, index.pqTrainingLimit)
+	err := index.graph.scan(func(node *diskNode) error {
+		if len(sample) == index.pqTrainingLimit {
+			return errAborted
+		}
+		if !node.tombstone {
+			sample = append(sample, node.vector)
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, errAborted) {
+		return errors.Wrap(err, "read training sample")
+	}
+
+	segments := index.pqSegments
+	if segments == 0 {
+		segments = common.CalculateOptimalSegments(dims)
+	}
+	pq, err := compressionhelpers.NewProductQuantizer(index.pqConfig(segments, min(index.pqCentroids, len(sample))),
+		index.distancerProvider, dims, index.logger)
+	if err != nil {
+		return errors.Wrap(err, "create pq encoder")
+	}
+	if err := pq.Fit(sample); err != nil {
+		return errors.Wrap(err, "fit pq encoder")
+	}
+	// the encoders are only exposed for persistence
+	var data pqDataCapture
+	pq.PersistCompression(&data)
+
+	index.trainLock.Lock()
+	defer index.trainLock.Unlock()
+
+	if index.pq != nil {
+		return nil
+	}
+
+	index.pq = pq
+	err = index.graph.scan(func(node *diskNode) error {
+		index.setNode(node.id, node.vector)
+		return nil
+	})
+	if err != nil {
+		index.pq = nil
+		return errors.Wrap(err, "encode nodes")
+	}
+
+	if err := index.setPQ(data.Encoders); err != nil {
+		return errors.Wrap(err, "persist pq encoders")
+	}
+
+	index.logger.WithField("action", "vamana_train").WithField("id", index.id).
+		WithField("segments", segments).
+		Debug("trained pq encoder")
+	return nil
+}
+
+// pqDataCapture captures the data a product quantizer persists
+type pqDataCapture struct {
+	compressionhelpers.PQData
+}
+
+func (c *pqDataCapture) AddPQCompression(data compressionhelpers.PQData) error {
+	c.PQData = data
+	return nil
+}
+
+func (c *pqDataCapture) AddSQCompression(compressionhelpers.SQData) error {
+	return nil
+}
+
+func (c *pqDataCapture) AddRQCompression(compressionhelpers.RQData) error {
+	return nil
+}
+
+func (c *pqDataCapture) AddBQCompression() error {
+	return nil
+}
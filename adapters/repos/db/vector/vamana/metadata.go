@@ -0,0 +1,279 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package vamana
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/compressionhelpers"
+	hnswent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	bolt "go.etcd.io/bbolt"
+)
+
+const (
+	filePrefix           = "vamana"
+	vamanaMetadataBucket = "vamana"
+
+	dimensionsKey = "dimensions"
+	entrypointKey = "entrypoint"
+	pqKey         = "pq"
+)
+
+func (index *vamana) fileName(extension string) string {
+	if index.targetVector != "" {
+		// This may be redundant as target vector is already validated in the schema
+		cleanTarget := filepath.Clean(index.targetVector)
+		cleanTarget = filepath.Base(cleanTarget)
+		return fmt.Sprintf("%s_%s.%s", filePrefix, cleanTarget, extension)
+	}
+	return fmt.Sprintf("%s.%s", filePrefix, extension)
+}
+
+// The metadata file holds the dimensions, the entrypoint and, once trained,
+// the PQ encoders. The nodes of the graph live in the graph file.
+func (index *vamana) getMetadataFile() string {
+	return index.fileName("db")
+}
+
+func (index *vamana) getGraphFile() string {
+	return index.fileName("graph")
+}
+
+// A backup holds snapshots of the metadata and the graph file, as neither
+// can be copied while the index is written to
+func (index *vamana) getMetadataSnapshotFile() string {
+	return index.fileName("db.snapshot")
+}
+
+func (index *vamana) getGraphSnapshotFile() string {
+	return index.fileName("graph.snapshot")
+}
+
+// snapshot takes the snapshots of the metadata and the graph file which are
+// included in a backup
+func (index *vamana) snapshot() error {
+	if err := index.openMetadata(); err != nil {
+		return err
+	}
+	defer index.closeMetadata()
+
+	if err := index.metadata.View(func(tx *bolt.Tx) error {
+		return tx.CopyFile(filepath.Join(index.rootPath, index.getMetadataSnapshotFile()), 0o600)
+	}); err != nil {
+		return errors.Wrap(err, "snapshot metadata")
+	}
+	if err := index.graph.snapshot(filepath.Join(index.rootPath, index.getGraphSnapshotFile())); err != nil {
+		return errors.Wrap(err, "snapshot graph")
+	}
+	return nil
+}
+
+// restoreSnapshots moves the snapshots into place if the index is restored
+// from a backup, i.e. there is a snapshot but no live file. Otherwise the
+// snapshots are left over from a previous backup and are removed.
+func (index *vamana) restoreSnapshots() error {
+	files := map[string]string{
+		index.getMetadataFile(): index.getMetadataSnapshotFile(),
+		index.getGraphFile():    index.getGraphSnapshotFile(),
+	}
+	for live, snapshot := range files {
+		snapshotPath := filepath.Join(index.rootPath, snapshot)
+		if _, err := os.Stat(snapshotPath); err != nil {
+			continue
+		}
+		livePath := filepath.Join(index.rootPath, live)
+		if _, err := os.Stat(livePath); err == nil {
+			if err := index.removeFile(snapshot); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := os.Rename(snapshotPath, livePath); err != nil {
+			return errors.Wrapf(err, "restore %q", snapshotPath)
+		}
+	}
+	return nil
+}
+
+func (index *vamana) removeFile(name string) error {
+	path := filepath.Join(index.rootPath, name)
+	err := os.Remove(path)
+	if err != nil && !os.IsNotExist(err) {
+		return errors.Wrapf(err, "remove file %q", path)
+	}
+	return nil
+}
+
+func (index *vamana) closeMetadata() {
+	index.metadataLock.Lock()
+	defer index.metadataLock.Unlock()
+
+	if index.metadata != nil {
+		index.metadata.Close()
+		index.metadata = nil
+	}
+}
+
+func (index *vamana) openMetadata() error {
+	index.metadataLock.Lock()
+	defer index.metadataLock.Unlock()
+
+	if index.metadata != nil {
+		return nil // Already open
+	}
+
+	path := filepath.Join(index.rootPath, index.getMetadataFile())
+	db, err := bolt.Open(path, 0o600, nil)
+	if err != nil {
+		return errors.Wrapf(err, "open %q", path)
+	}
+
+	index.metadata = db
+	return nil
+}
+
+func (index *vamana) initMetadata() error {
+	if err := index.openMetadata(); err != nil {
+		return err
+	}
+	defer index.closeMetadata()
+
+	return index.metadata.Update(func(tx *bolt.Tx) error {
+		b, err := tx.CreateBucketIfNotExists([]byte(vamanaMetadataBucket))
+		if err != nil {
+			return errors.Wrap(err, "create bucket")
+		}
+
+		if v := b.Get([]byte(dimensionsKey)); v != nil {
+			index.dims = int32(binary.LittleEndian.Uint32(v))
+			index.graph.setLayout(int(index.dims), index.maxDegree)
+		}
+
+		if v := b.Get([]byte(entrypointKey)); v != nil {
+			index.entrypoint.Store(binary.LittleEndian.Uint64(v))
+			index.hasEntrypoint.Store(true)
+		}
+
+		if v := b.Get([]byte(pqKey)); v != nil {
+			pq, err := index.pqFromBytes(v)
+			if err != nil {
+				return errors.Wrap(err, "load pq encoders")
+			}
+			index.pq = pq
+		}
+		return nil
+	})
+}
+
+func (index *vamana) setDimensions(dimensions int32) error {
+	buf := make([]byte, 4)
+	binary.LittleEndian.PutUint32(buf, uint32(dimensions))
+	return index.putMetadata(map[string][]byte{dimensionsKey: buf})
+}
+
+func (index *vamana) setEntrypoint(entrypoint uint64) error {
+	buf := make([]byte, 8)
+	binary.LittleEndian.PutUint64(buf, entrypoint)
+	return index.putMetadata(map[string][]byte{entrypointKey: buf})
+}
+
+func (index *vamana) setPQ(encoders []compressionhelpers.PQEncoder) error {
+	return index.putMetadata(map[string][]byte{pqKey: pqToBytes(encoders)})
+}
+
+func (index *vamana) putMetadata(values map[string][]byte) error {
+	if err := index.openMetadata(); err != nil {
+		return err
+	}
+	defer index.closeMetadata()
+
+	return index.metadata.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(vamanaMetadataBucket))
+		if b == nil {
+			return errors.New("failed to get bucket")
+		}
+		for key, value := range values {
+			if err := b.Put([]byte(key), value); err != nil {
+				return errors.Wrapf(err, "put %s", key)
+			}
+		}
+		return nil
+	})
+}
+
+// pqToBytes stores the centers of the k-means encoder of each segment
+func pqToBytes(encoders []compressionhelpers.PQEncoder) []byte {
+	buf := make([]byte, 2)
+	binary.LittleEndian.PutUint16(buf, uint16(len(encoders)))
+	for _, encoder := range encoders {
+		data := encoder.ExposeDataForRestore()
+		buf = binary.LittleEndian.AppendUint32(buf, uint32(len(data)))
+		buf = append(buf, data...)
+	}
+	return buf
+}
+
+func (index *vamana) pqFromBytes(buf []byte) (*compressionhelpers.ProductQuantizer, error) {
+	if len(buf) < 2 || index.dims == 0 {
+		return nil, errors.Errorf("invalid pq data of length %d for %d dimensions", len(buf), index.dims)
+	}
+	segments := int(binary.LittleEndian.Uint16(buf[0:2]))
+	if segments == 0 || int(index.dims)%segments != 0 {
+		return nil, errors.Errorf("invalid number of segments %d for %d dimensions", segments, index.dims)
+	}
+	ds := int(index.dims) / segments
+
+	encoders := make([]compressionhelpers.PQEncoder, segments)
+	ks := 0
+	pos := 2
+	for i := range encoders {
+		if len(buf) < pos+4 {
+			return nil, errors.Errorf("invalid pq data of length %d", len(buf))
+		}
+		length := int(binary.LittleEndian.Uint32(buf[pos:]))
+		pos += 4
+		if len(buf) < pos+length || length%(4*ds) != 0 {
+			return nil, errors.Errorf("invalid pq data of length %d", len(buf))
+		}
+
+		ks = length / (4 * ds)
+		centers := make([][]float32, ks)
+		for c := range centers {
+			centers[c] = make([]float32, ds)
+			for j := range centers[c] {
+				centers[c][j] = math.Float32frombits(binary.LittleEndian.Uint32(buf[pos:]))
+				pos += 4
+			}
+		}
+		encoders[i] = compressionhelpers.NewKMeansWithCenters(ks, ds, i, centers)
+	}
+
+	return compressionhelpers.NewProductQuantizerWithEncoders(index.pqConfig(segments, ks),
+		index.distancerProvider, int(index.dims), encoders, index.logger)
+}
+
+func (index *vamana) pqConfig(segments, centroids int) hnswent.PQConfig {
+	return hnswent.PQConfig{
+		Enabled:   true,
+		Segments:  segments,
+		Centroids: centroids,
+		Encoder: hnswent.PQEncoder{
+			Type:         hnswent.PQEncoderTypeKMeans,
+			Distribution: hnswent.PQEncoderDistributionLogNormal,
+		},
+	}
+}
@@ -0,0 +1,274 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package vamana
+
+import (
+	"context"
+	"sort"
+	"sync/atomic"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+)
+
+// approxDistance returns the distance between the query and the compressed
+// vector of a node, ok is false if the node does not exist
+type approxDistance func(id uint64) (dist float32, ok bool, err error)
+
+type candidate struct {
+	id       uint64
+	dist     float32
+	expanded bool
+}
+
+// candidateList is the search list of the beam search, the candidates are
+// kept sorted by their approximate distance to the query
+type candidateList struct {
+	items []candidate
+	size  int
+}
+
+func newCandidateList(size int) *candidateList {
+	return &candidateList{items: make([]candidate, 0, size+1), size: size}
+}
+
+func (l *candidateList) insert(id uint64, dist float32) {
+	pos := sort.Search(len(l.items), func(i int) bool { return l.items[i].dist > dist })
+	if pos >= l.size {
+		return
+	}
+
+	l.items = append(l.items, candidate{})
+	copy(l.items[pos+1:], l.items[pos:])
+	l.items[pos] = candidate{id: id, dist: dist}
+	if len(l.items) > l.size {
+		l.items = l.items[:l.size]
+	}
+}
+
+// next marks up to n of the closest candidates that have not been expanded
+// yet as expanded and returns their ids
+func (l *candidateList) next(n int) []uint64 {
+	ids := make([]uint64, 0, n)
+	for i := range l.items {
+		if len(ids) == n {
+			break
+		}
+		if !l.items[i].expanded {
+			l.items[i].expanded = true
+			ids = append(ids, l.items[i].id)
+		}
+	}
+	return ids
+}
+
+// scoredNode is a node read from disk with its full precision distance
+type scoredNode struct {
+	node *diskNode
+	dist float32
+}
+
+func (index *vamana) approxDistancer(query []float32) (approxDistance, func()) {
+	switch {
+	case index.compression == compressionBQ:
+		code := index.bq.Encode(query)
+		return func(id uint64) (float32, bool, error) {
+			node := index.getNode(id)
+			if node == nil || node.bits == nil {
+				return 0, false, nil
+			}
+			dist, err := index.bq.DistanceBetweenCompressedVectors(code, node.bits)
+			return dist, err == nil, err
+		}, func() {}
+	case index.pq != nil:
+		distancer := index.pq.NewDistancer(query)
+		return func(id uint64) (float32, bool, error) {
+			node := index.getNode(id)
+			if node == nil || node.code == nil {
+				return 0, false, nil
+			}
+			dist, err := distancer.Distance(node.code)
+			return dist, err == nil, err
+		}, func() { index.pq.ReturnDistancer(distancer) }
+	default:
+		// until the PQ encoder is trained, the graph is navigated with the full
+		// precision vectors read from disk
+		return func(id uint64) (float32, bool, error) {
+			if index.getNode(id) == nil {
+				return 0, false, nil
+			}
+			node, err := index.graph.read(id)
+			if err != nil || node == nil {
+				return 0, false, err
+			}
+			dist, err := index.distancerProvider.SingleDist(query, node.vector)
+			return dist, err == nil, err
+		}, func() {}
+	}
+}
+
+// beamSearch navigates the graph from the entrypoint towards the query. In
+// each step the beamWidth closest candidates of the search list are read from
+// disk, their neighbors are scored by the compressed vectors and added to the
+// search list. It returns all nodes read from disk with their full precision
+// distance to the query.
+func (index *vamana) beamSearch(ctx context.Context, query []float32, listSize, beamWidth int,
+) ([]scoredNode, error) {
+	if !index.hasEntrypoint.Load() {
+		return nil, nil
+	}
+
+	approx, release := index.approxDistancer(query)
+	defer release()
+
+	entrypoint := index.entrypoint.Load()
+	visited := map[uint64]struct{}{entrypoint: {}}
+	list := newCandidateList(listSize)
+	dist, ok, err := approx(entrypoint)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		list.insert(entrypoint, dist)
+	}
+
+	var expanded []scoredNode
+	for beam := list.next(beamWidth); len(beam) > 0; beam = list.next(beamWidth) {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		for _, id := range beam {
+			node, err := index.graph.read(id)
+			if err != nil {
+				return nil, err
+			}
+			if node == nil {
+				// cleaned up since it was added to the search list
+				continue
+			}
+
+			dist, err := index.distancerProvider.SingleDist(query, node.vector)
+			if err != nil {
+				return nil, err
+			}
+			expanded = append(expanded, scoredNode{node: node, dist: dist})
+
+			for _, neighbor := range node.neighbors {
+				if _, ok := visited[neighbor]; ok {
+					continue
+				}
+				visited[neighbor] = struct{}{}
+
+				dist, ok, err := approx(neighbor)
+				if err != nil {
+					return nil, err
+				}
+				if ok {
+					list.insert(neighbor, dist)
+				}
+			}
+		}
+	}
+
+	return expanded, nil
+}
+
+func (index *vamana) SearchByVector(ctx context.Context, vector []float32, k int, allow helpers.AllowList) ([]uint64, []float32, error) {
+	if k == 0 || (allow != nil && allow.IsEmpty()) || !index.initialized() {
+		return nil, nil, nil
+	}
+	vector = index.normalized(vector)
+
+	index.trainLock.RLock()
+	defer index.trainLock.RUnlock()
+
+	// a restrictive filter is faster and exact when searching the allowed
+	// vectors directly
+	if allow != nil && int64(allow.Len()) < atomic.LoadInt64(&index.flatSearchCutoff) {
+		return index.searchAllowed(ctx, vector, k, allow)
+	}
+
+	listSize := max(int(atomic.LoadInt64(&index.searchListSize)), k)
+	for {
+		ids, dists, err := index.searchGraph(ctx, vector, k, listSize, allow)
+		if err != nil || allow == nil || len(ids) >= min(k, allow.Len()) {
+			return ids, dists, err
+		}
+
+		// the filter excludes too many of the nodes visited, so the search list
+		// is grown until enough allowed nodes are found. Once it covers the
+		// whole graph, the allowed nodes are searched directly instead.
+		if uint64(listSize) >= atomic.LoadUint64(&index.count) {
+			return index.searchAllowed(ctx, vector, k, allow)
+		}
+		listSize *= 2
+	}
+}
+
+// searchGraph returns the k closest of the allowed nodes read from disk by a
+// beam search with the given search list size
+func (index *vamana) searchGraph(ctx context.Context, vector []float32, k, listSize int,
+	allow helpers.AllowList,
+) ([]uint64, []float32, error) {
+	expanded, err := index.beamSearch(ctx, vector, listSize, int(atomic.LoadInt64(&index.beamWidth)))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// the nodes read from disk are ranked by their full precision distance
+	heap := index.pqResults.GetMax(k)
+	defer index.pqResults.Put(heap)
+
+	for _, scored := range expanded {
+		if scored.node.tombstone || index.isTombstoned(scored.node.id) {
+			continue
+		}
+		if allow != nil && !allow.Contains(scored.node.id) {
+			continue
+		}
+		insertToHeap(heap, k, scored.node.id, scored.dist)
+	}
+
+	ids, dists := extractHeap(heap)
+	return ids, dists, nil
+}
+
+func (index *vamana) searchAllowed(ctx context.Context, vector []float32, k int, allow helpers.AllowList,
+) ([]uint64, []float32, error) {
+	heap := index.pqResults.GetMax(k)
+	defer index.pqResults.Put(heap)
+
+	it := allow.Iterator()
+	for id, ok := it.Next(); ok; id, ok = it.Next() {
+		if err := ctx.Err(); err != nil {
+			return nil, nil, err
+		}
+		if !index.ContainsNode(id) {
+			continue
+		}
+		node, err := index.graph.read(id)
+		if err != nil {
+			return nil, nil, err
+		}
+		if node == nil || node.tombstone {
+			continue
+		}
+		dist, err := index.distancerProvider.SingleDist(vector, node.vector)
+		if err != nil {
+			return nil, nil, err
+		}
+		insertToHeap(heap, k, id, dist)
+	}
+
+	ids, dists := extractHeap(heap)
+	return ids, dists, nil
+}
@@ -60,3 +60,25 @@ type VectorIndex interface {
 	QueryVectorDistancer(queryVector []float32) common.QueryVectorDistancer
 	Stats() (common.IndexStats, error)
 }
+
+// batchVectorSearcher is implemented by vector indexes that can answer several
+// queries more efficiently together than one after another, for example by
+// comparing every stored vector to all queries in a single pass.
+type batchVectorSearcher interface {
+	SearchByVectorBatch(ctx context.Context, vectors [][]float32, k int,
+		allow helpers.AllowList) ([][]uint64, [][]float32, error)
+}
+
+// filterSearchPlanner is implemented by vector indexes whose filter strategy
+// can be picked per query, see common.ContextWithFilterStrategy. The boolean
+// is false if the index currently has nothing to plan.
+type filterSearchPlanner interface {
+	FilterSearchStats(k int) (common.FilterSearchStats, bool)
+}
+
+// rangeVectorSearcher is implemented by vector indexes that can stream every
+// vector within a distance of the query, see common.RangeSearch
+type rangeVectorSearcher interface {
+	SearchByVectorRange(ctx context.Context, vector []float32, targetDistance float32,
+		allow helpers.AllowList, fn func(id uint64, dist float32) bool) error
+}
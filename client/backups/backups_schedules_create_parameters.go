@@ -0,0 +1,161 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package backups
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// NewBackupsSchedulesCreateParams creates a new BackupsSchedulesCreateParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewBackupsSchedulesCreateParams() *BackupsSchedulesCreateParams {
+	return &BackupsSchedulesCreateParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewBackupsSchedulesCreateParamsWithTimeout creates a new BackupsSchedulesCreateParams object
+// with the ability to set a timeout on a request.
+func NewBackupsSchedulesCreateParamsWithTimeout(timeout time.Duration) *BackupsSchedulesCreateParams {
+	return &BackupsSchedulesCreateParams{
+		timeout: timeout,
+	}
+}
+
+// NewBackupsSchedulesCreateParamsWithContext creates a new BackupsSchedulesCreateParams object
+// with the ability to set a context for a request.
+func NewBackupsSchedulesCreateParamsWithContext(ctx context.Context) *BackupsSchedulesCreateParams {
+	return &BackupsSchedulesCreateParams{
+		Context: ctx,
+	}
+}
+
+// NewBackupsSchedulesCreateParamsWithHTTPClient creates a new BackupsSchedulesCreateParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewBackupsSchedulesCreateParamsWithHTTPClient(client *http.Client) *BackupsSchedulesCreateParams {
+	return &BackupsSchedulesCreateParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+BackupsSchedulesCreateParams contains all the parameters to send to the API endpoint
+
+	for the backups schedules create operation.
+
+	Typically these are written to a http.Request.
+*/
+type BackupsSchedulesCreateParams struct {
+
+	// Body.
+	Body *models.BackupSchedule
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the backups schedules create params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *BackupsSchedulesCreateParams) WithDefaults() *BackupsSchedulesCreateParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the backups schedules create params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *BackupsSchedulesCreateParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the backups schedules create params
+func (o *BackupsSchedulesCreateParams) WithTimeout(timeout time.Duration) *BackupsSchedulesCreateParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the backups schedules create params
+func (o *BackupsSchedulesCreateParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the backups schedules create params
+func (o *BackupsSchedulesCreateParams) WithContext(ctx context.Context) *BackupsSchedulesCreateParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the backups schedules create params
+func (o *BackupsSchedulesCreateParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the backups schedules create params
+func (o *BackupsSchedulesCreateParams) WithHTTPClient(client *http.Client) *BackupsSchedulesCreateParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the backups schedules create params
+func (o *BackupsSchedulesCreateParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBody adds the body to the backups schedules create params
+func (o *BackupsSchedulesCreateParams) WithBody(body *models.BackupSchedule) *BackupsSchedulesCreateParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the backups schedules create params
+func (o *BackupsSchedulesCreateParams) SetBody(body *models.BackupSchedule) {
+	o.Body = body
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *BackupsSchedulesCreateParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+	if o.Body != nil {
+		if err := r.SetBodyParam(o.Body); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,386 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package backups
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// BackupsSchedulesCreateReader is a Reader for the BackupsSchedulesCreate structure.
+type BackupsSchedulesCreateReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *BackupsSchedulesCreateReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewBackupsSchedulesCreateOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewBackupsSchedulesCreateUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewBackupsSchedulesCreateForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 422:
+		result := NewBackupsSchedulesCreateUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewBackupsSchedulesCreateInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewBackupsSchedulesCreateOK creates a BackupsSchedulesCreateOK with default headers values
+func NewBackupsSchedulesCreateOK() *BackupsSchedulesCreateOK {
+	return &BackupsSchedulesCreateOK{}
+}
+
+/*
+BackupsSchedulesCreateOK describes a response with status code 200, with default header values.
+
+Backup schedule successfully created.
+*/
+type BackupsSchedulesCreateOK struct {
+}
+
+// IsSuccess returns true when this backups schedules create o k response has a 2xx status code
+func (o *BackupsSchedulesCreateOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this backups schedules create o k response has a 3xx status code
+func (o *BackupsSchedulesCreateOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this backups schedules create o k response has a 4xx status code
+func (o *BackupsSchedulesCreateOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this backups schedules create o k response has a 5xx status code
+func (o *BackupsSchedulesCreateOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this backups schedules create o k response a status code equal to that given
+func (o *BackupsSchedulesCreateOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the backups schedules create o k response
+func (o *BackupsSchedulesCreateOK) Code() int {
+	return 200
+}
+
+func (o *BackupsSchedulesCreateOK) Error() string {
+	return fmt.Sprintf("[POST /backup-schedules][%d] backupsSchedulesCreateOK ", 200)
+}
+
+func (o *BackupsSchedulesCreateOK) String() string {
+	return fmt.Sprintf("[POST /backup-schedules][%d] backupsSchedulesCreateOK ", 200)
+}
+
+func (o *BackupsSchedulesCreateOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewBackupsSchedulesCreateUnauthorized creates a BackupsSchedulesCreateUnauthorized with default headers values
+func NewBackupsSchedulesCreateUnauthorized() *BackupsSchedulesCreateUnauthorized {
+	return &BackupsSchedulesCreateUnauthorized{}
+}
+
+/*
+BackupsSchedulesCreateUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized or invalid credentials.
+*/
+type BackupsSchedulesCreateUnauthorized struct {
+}
+
+// IsSuccess returns true when this backups schedules create unauthorized response has a 2xx status code
+func (o *BackupsSchedulesCreateUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this backups schedules create unauthorized response has a 3xx status code
+func (o *BackupsSchedulesCreateUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this backups schedules create unauthorized response has a 4xx status code
+func (o *BackupsSchedulesCreateUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this backups schedules create unauthorized response has a 5xx status code
+func (o *BackupsSchedulesCreateUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this backups schedules create unauthorized response a status code equal to that given
+func (o *BackupsSchedulesCreateUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+// Code gets the status code for the backups schedules create unauthorized response
+func (o *BackupsSchedulesCreateUnauthorized) Code() int {
+	return 401
+}
+
+func (o *BackupsSchedulesCreateUnauthorized) Error() string {
+	return fmt.Sprintf("[POST /backup-schedules][%d] backupsSchedulesCreateUnauthorized ", 401)
+}
+
+func (o *BackupsSchedulesCreateUnauthorized) String() string {
+	return fmt.Sprintf("[POST /backup-schedules][%d] backupsSchedulesCreateUnauthorized ", 401)
+}
+
+func (o *BackupsSchedulesCreateUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewBackupsSchedulesCreateForbidden creates a BackupsSchedulesCreateForbidden with default headers values
+func NewBackupsSchedulesCreateForbidden() *BackupsSchedulesCreateForbidden {
+	return &BackupsSchedulesCreateForbidden{}
+}
+
+/*
+BackupsSchedulesCreateForbidden describes a response with status code 403, with default header values.
+
+Forbidden
+*/
+type BackupsSchedulesCreateForbidden struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this backups schedules create forbidden response has a 2xx status code
+func (o *BackupsSchedulesCreateForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this backups schedules create forbidden response has a 3xx status code
+func (o *BackupsSchedulesCreateForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this backups schedules create forbidden response has a 4xx status code
+func (o *BackupsSchedulesCreateForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this backups schedules create forbidden response has a 5xx status code
+func (o *BackupsSchedulesCreateForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this backups schedules create forbidden response a status code equal to that given
+func (o *BackupsSchedulesCreateForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the backups schedules create forbidden response
+func (o *BackupsSchedulesCreateForbidden) Code() int {
+	return 403
+}
+
+func (o *BackupsSchedulesCreateForbidden) Error() string {
+	return fmt.Sprintf("[POST /backup-schedules][%d] backupsSchedulesCreateForbidden  %+v", 403, o.Payload)
+}
+
+func (o *BackupsSchedulesCreateForbidden) String() string {
+	return fmt.Sprintf("[POST /backup-schedules][%d] backupsSchedulesCreateForbidden  %+v", 403, o.Payload)
+}
+
+func (o *BackupsSchedulesCreateForbidden) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *BackupsSchedulesCreateForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewBackupsSchedulesCreateUnprocessableEntity creates a BackupsSchedulesCreateUnprocessableEntity with default headers values
+func NewBackupsSchedulesCreateUnprocessableEntity() *BackupsSchedulesCreateUnprocessableEntity {
+	return &BackupsSchedulesCreateUnprocessableEntity{}
+}
+
+/*
+BackupsSchedulesCreateUnprocessableEntity describes a response with status code 422, with default header values.
+
+Invalid backup schedule.
+*/
+type BackupsSchedulesCreateUnprocessableEntity struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this backups schedules create unprocessable entity response has a 2xx status code
+func (o *BackupsSchedulesCreateUnprocessableEntity) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this backups schedules create unprocessable entity response has a 3xx status code
+func (o *BackupsSchedulesCreateUnprocessableEntity) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this backups schedules create unprocessable entity response has a 4xx status code
+func (o *BackupsSchedulesCreateUnprocessableEntity) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this backups schedules create unprocessable entity response has a 5xx status code
+func (o *BackupsSchedulesCreateUnprocessableEntity) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this backups schedules create unprocessable entity response a status code equal to that given
+func (o *BackupsSchedulesCreateUnprocessableEntity) IsCode(code int) bool {
+	return code == 422
+}
+
+// Code gets the status code for the backups schedules create unprocessable entity response
+func (o *BackupsSchedulesCreateUnprocessableEntity) Code() int {
+	return 422
+}
+
+func (o *BackupsSchedulesCreateUnprocessableEntity) Error() string {
+	return fmt.Sprintf("[POST /backup-schedules][%d] backupsSchedulesCreateUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *BackupsSchedulesCreateUnprocessableEntity) String() string {
+	return fmt.Sprintf("[POST /backup-schedules][%d] backupsSchedulesCreateUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *BackupsSchedulesCreateUnprocessableEntity) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *BackupsSchedulesCreateUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewBackupsSchedulesCreateInternalServerError creates a BackupsSchedulesCreateInternalServerError with default headers values
+func NewBackupsSchedulesCreateInternalServerError() *BackupsSchedulesCreateInternalServerError {
+	return &BackupsSchedulesCreateInternalServerError{}
+}
+
+/*
+BackupsSchedulesCreateInternalServerError describes a response with status code 500, with default header values.
+
+An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+*/
+type BackupsSchedulesCreateInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this backups schedules create internal server error response has a 2xx status code
+func (o *BackupsSchedulesCreateInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this backups schedules create internal server error response has a 3xx status code
+func (o *BackupsSchedulesCreateInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this backups schedules create internal server error response has a 4xx status code
+func (o *BackupsSchedulesCreateInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this backups schedules create internal server error response has a 5xx status code
+func (o *BackupsSchedulesCreateInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this backups schedules create internal server error response a status code equal to that given
+func (o *BackupsSchedulesCreateInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the backups schedules create internal server error response
+func (o *BackupsSchedulesCreateInternalServerError) Code() int {
+	return 500
+}
+
+func (o *BackupsSchedulesCreateInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /backup-schedules][%d] backupsSchedulesCreateInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *BackupsSchedulesCreateInternalServerError) String() string {
+	return fmt.Sprintf("[POST /backup-schedules][%d] backupsSchedulesCreateInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *BackupsSchedulesCreateInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *BackupsSchedulesCreateInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,162 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package backups
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewBackupsSchedulesDeleteParams creates a new BackupsSchedulesDeleteParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewBackupsSchedulesDeleteParams() *BackupsSchedulesDeleteParams {
+	return &BackupsSchedulesDeleteParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewBackupsSchedulesDeleteParamsWithTimeout creates a new BackupsSchedulesDeleteParams object
+// with the ability to set a timeout on a request.
+func NewBackupsSchedulesDeleteParamsWithTimeout(timeout time.Duration) *BackupsSchedulesDeleteParams {
+	return &BackupsSchedulesDeleteParams{
+		timeout: timeout,
+	}
+}
+
+// NewBackupsSchedulesDeleteParamsWithContext creates a new BackupsSchedulesDeleteParams object
+// with the ability to set a context for a request.
+func NewBackupsSchedulesDeleteParamsWithContext(ctx context.Context) *BackupsSchedulesDeleteParams {
+	return &BackupsSchedulesDeleteParams{
+		Context: ctx,
+	}
+}
+
+// NewBackupsSchedulesDeleteParamsWithHTTPClient creates a new BackupsSchedulesDeleteParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewBackupsSchedulesDeleteParamsWithHTTPClient(client *http.Client) *BackupsSchedulesDeleteParams {
+	return &BackupsSchedulesDeleteParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+BackupsSchedulesDeleteParams contains all the parameters to send to the API endpoint
+
+	for the backups schedules delete operation.
+
+	Typically these are written to a http.Request.
+*/
+type BackupsSchedulesDeleteParams struct {
+
+	/* Name.
+
+	   The name of the backup schedule.
+	*/
+	Name string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the backups schedules delete params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *BackupsSchedulesDeleteParams) WithDefaults() *BackupsSchedulesDeleteParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the backups schedules delete params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *BackupsSchedulesDeleteParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the backups schedules delete params
+func (o *BackupsSchedulesDeleteParams) WithTimeout(timeout time.Duration) *BackupsSchedulesDeleteParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the backups schedules delete params
+func (o *BackupsSchedulesDeleteParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the backups schedules delete params
+func (o *BackupsSchedulesDeleteParams) WithContext(ctx context.Context) *BackupsSchedulesDeleteParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the backups schedules delete params
+func (o *BackupsSchedulesDeleteParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the backups schedules delete params
+func (o *BackupsSchedulesDeleteParams) WithHTTPClient(client *http.Client) *BackupsSchedulesDeleteParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the backups schedules delete params
+func (o *BackupsSchedulesDeleteParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithName adds the name to the backups schedules delete params
+func (o *BackupsSchedulesDeleteParams) WithName(name string) *BackupsSchedulesDeleteParams {
+	o.SetName(name)
+	return o
+}
+
+// SetName adds the name to the backups schedules delete params
+func (o *BackupsSchedulesDeleteParams) SetName(name string) {
+	o.Name = name
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *BackupsSchedulesDeleteParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param name
+	if err := r.SetPathParam("name", o.Name); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,374 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package backups
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// BackupsSchedulesDeleteReader is a Reader for the BackupsSchedulesDelete structure.
+type BackupsSchedulesDeleteReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *BackupsSchedulesDeleteReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 204:
+		result := NewBackupsSchedulesDeleteNoContent()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewBackupsSchedulesDeleteUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewBackupsSchedulesDeleteForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewBackupsSchedulesDeleteNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewBackupsSchedulesDeleteInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewBackupsSchedulesDeleteNoContent creates a BackupsSchedulesDeleteNoContent with default headers values
+func NewBackupsSchedulesDeleteNoContent() *BackupsSchedulesDeleteNoContent {
+	return &BackupsSchedulesDeleteNoContent{}
+}
+
+/*
+BackupsSchedulesDeleteNoContent describes a response with status code 204, with default header values.
+
+Successfully deleted.
+*/
+type BackupsSchedulesDeleteNoContent struct {
+}
+
+// IsSuccess returns true when this backups schedules delete no content response has a 2xx status code
+func (o *BackupsSchedulesDeleteNoContent) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this backups schedules delete no content response has a 3xx status code
+func (o *BackupsSchedulesDeleteNoContent) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this backups schedules delete no content response has a 4xx status code
+func (o *BackupsSchedulesDeleteNoContent) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this backups schedules delete no content response has a 5xx status code
+func (o *BackupsSchedulesDeleteNoContent) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this backups schedules delete no content response a status code equal to that given
+func (o *BackupsSchedulesDeleteNoContent) IsCode(code int) bool {
+	return code == 204
+}
+
+// Code gets the status code for the backups schedules delete no content response
+func (o *BackupsSchedulesDeleteNoContent) Code() int {
+	return 204
+}
+
+func (o *BackupsSchedulesDeleteNoContent) Error() string {
+	return fmt.Sprintf("[DELETE /backup-schedules/{name}][%d] backupsSchedulesDeleteNoContent ", 204)
+}
+
+func (o *BackupsSchedulesDeleteNoContent) String() string {
+	return fmt.Sprintf("[DELETE /backup-schedules/{name}][%d] backupsSchedulesDeleteNoContent ", 204)
+}
+
+func (o *BackupsSchedulesDeleteNoContent) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewBackupsSchedulesDeleteUnauthorized creates a BackupsSchedulesDeleteUnauthorized with default headers values
+func NewBackupsSchedulesDeleteUnauthorized() *BackupsSchedulesDeleteUnauthorized {
+	return &BackupsSchedulesDeleteUnauthorized{}
+}
+
+/*
+BackupsSchedulesDeleteUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized or invalid credentials.
+*/
+type BackupsSchedulesDeleteUnauthorized struct {
+}
+
+// IsSuccess returns true when this backups schedules delete unauthorized response has a 2xx status code
+func (o *BackupsSchedulesDeleteUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this backups schedules delete unauthorized response has a 3xx status code
+func (o *BackupsSchedulesDeleteUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this backups schedules delete unauthorized response has a 4xx status code
+func (o *BackupsSchedulesDeleteUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this backups schedules delete unauthorized response has a 5xx status code
+func (o *BackupsSchedulesDeleteUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this backups schedules delete unauthorized response a status code equal to that given
+func (o *BackupsSchedulesDeleteUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+// Code gets the status code for the backups schedules delete unauthorized response
+func (o *BackupsSchedulesDeleteUnauthorized) Code() int {
+	return 401
+}
+
+func (o *BackupsSchedulesDeleteUnauthorized) Error() string {
+	return fmt.Sprintf("[DELETE /backup-schedules/{name}][%d] backupsSchedulesDeleteUnauthorized ", 401)
+}
+
+func (o *BackupsSchedulesDeleteUnauthorized) String() string {
+	return fmt.Sprintf("[DELETE /backup-schedules/{name}][%d] backupsSchedulesDeleteUnauthorized ", 401)
+}
+
+func (o *BackupsSchedulesDeleteUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewBackupsSchedulesDeleteForbidden creates a BackupsSchedulesDeleteForbidden with default headers values
+func NewBackupsSchedulesDeleteForbidden() *BackupsSchedulesDeleteForbidden {
+	return &BackupsSchedulesDeleteForbidden{}
+}
+
+/*
+BackupsSchedulesDeleteForbidden describes a response with status code 403, with default header values.
+
+Forbidden
+*/
+type BackupsSchedulesDeleteForbidden struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this backups schedules delete forbidden response has a 2xx status code
+func (o *BackupsSchedulesDeleteForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this backups schedules delete forbidden response has a 3xx status code
+func (o *BackupsSchedulesDeleteForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this backups schedules delete forbidden response has a 4xx status code
+func (o *BackupsSchedulesDeleteForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this backups schedules delete forbidden response has a 5xx status code
+func (o *BackupsSchedulesDeleteForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this backups schedules delete forbidden response a status code equal to that given
+func (o *BackupsSchedulesDeleteForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the backups schedules delete forbidden response
+func (o *BackupsSchedulesDeleteForbidden) Code() int {
+	return 403
+}
+
+func (o *BackupsSchedulesDeleteForbidden) Error() string {
+	return fmt.Sprintf("[DELETE /backup-schedules/{name}][%d] backupsSchedulesDeleteForbidden  %+v", 403, o.Payload)
+}
+
+func (o *BackupsSchedulesDeleteForbidden) String() string {
+	return fmt.Sprintf("[DELETE /backup-schedules/{name}][%d] backupsSchedulesDeleteForbidden  %+v", 403, o.Payload)
+}
+
+func (o *BackupsSchedulesDeleteForbidden) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *BackupsSchedulesDeleteForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewBackupsSchedulesDeleteNotFound creates a BackupsSchedulesDeleteNotFound with default headers values
+func NewBackupsSchedulesDeleteNotFound() *BackupsSchedulesDeleteNotFound {
+	return &BackupsSchedulesDeleteNotFound{}
+}
+
+/*
+BackupsSchedulesDeleteNotFound describes a response with status code 404, with default header values.
+
+Backup schedule not found.
+*/
+type BackupsSchedulesDeleteNotFound struct {
+}
+
+// IsSuccess returns true when this backups schedules delete not found response has a 2xx status code
+func (o *BackupsSchedulesDeleteNotFound) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this backups schedules delete not found response has a 3xx status code
+func (o *BackupsSchedulesDeleteNotFound) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this backups schedules delete not found response has a 4xx status code
+func (o *BackupsSchedulesDeleteNotFound) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this backups schedules delete not found response has a 5xx status code
+func (o *BackupsSchedulesDeleteNotFound) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this backups schedules delete not found response a status code equal to that given
+func (o *BackupsSchedulesDeleteNotFound) IsCode(code int) bool {
+	return code == 404
+}
+
+// Code gets the status code for the backups schedules delete not found response
+func (o *BackupsSchedulesDeleteNotFound) Code() int {
+	return 404
+}
+
+func (o *BackupsSchedulesDeleteNotFound) Error() string {
+	return fmt.Sprintf("[DELETE /backup-schedules/{name}][%d] backupsSchedulesDeleteNotFound ", 404)
+}
+
+func (o *BackupsSchedulesDeleteNotFound) String() string {
+	return fmt.Sprintf("[DELETE /backup-schedules/{name}][%d] backupsSchedulesDeleteNotFound ", 404)
+}
+
+func (o *BackupsSchedulesDeleteNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewBackupsSchedulesDeleteInternalServerError creates a BackupsSchedulesDeleteInternalServerError with default headers values
+func NewBackupsSchedulesDeleteInternalServerError() *BackupsSchedulesDeleteInternalServerError {
+	return &BackupsSchedulesDeleteInternalServerError{}
+}
+
+/*
+BackupsSchedulesDeleteInternalServerError describes a response with status code 500, with default header values.
+
+An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+*/
+type BackupsSchedulesDeleteInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this backups schedules delete internal server error response has a 2xx status code
+func (o *BackupsSchedulesDeleteInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this backups schedules delete internal server error response has a 3xx status code
+func (o *BackupsSchedulesDeleteInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this backups schedules delete internal server error response has a 4xx status code
+func (o *BackupsSchedulesDeleteInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this backups schedules delete internal server error response has a 5xx status code
+func (o *BackupsSchedulesDeleteInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this backups schedules delete internal server error response a status code equal to that given
+func (o *BackupsSchedulesDeleteInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the backups schedules delete internal server error response
+func (o *BackupsSchedulesDeleteInternalServerError) Code() int {
+	return 500
+}
+
+func (o *BackupsSchedulesDeleteInternalServerError) Error() string {
+	return fmt.Sprintf("[DELETE /backup-schedules/{name}][%d] backupsSchedulesDeleteInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *BackupsSchedulesDeleteInternalServerError) String() string {
+	return fmt.Sprintf("[DELETE /backup-schedules/{name}][%d] backupsSchedulesDeleteInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *BackupsSchedulesDeleteInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *BackupsSchedulesDeleteInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,139 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package backups
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewBackupsSchedulesListParams creates a new BackupsSchedulesListParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewBackupsSchedulesListParams() *BackupsSchedulesListParams {
+	return &BackupsSchedulesListParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewBackupsSchedulesListParamsWithTimeout creates a new BackupsSchedulesListParams object
+// with the ability to set a timeout on a request.
+func NewBackupsSchedulesListParamsWithTimeout(timeout time.Duration) *BackupsSchedulesListParams {
+	return &BackupsSchedulesListParams{
+		timeout: timeout,
+	}
+}
+
+// NewBackupsSchedulesListParamsWithContext creates a new BackupsSchedulesListParams object
+// with the ability to set a context for a request.
+func NewBackupsSchedulesListParamsWithContext(ctx context.Context) *BackupsSchedulesListParams {
+	return &BackupsSchedulesListParams{
+		Context: ctx,
+	}
+}
+
+// NewBackupsSchedulesListParamsWithHTTPClient creates a new BackupsSchedulesListParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewBackupsSchedulesListParamsWithHTTPClient(client *http.Client) *BackupsSchedulesListParams {
+	return &BackupsSchedulesListParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+BackupsSchedulesListParams contains all the parameters to send to the API endpoint
+
+	for the backups schedules list operation.
+
+	Typically these are written to a http.Request.
+*/
+type BackupsSchedulesListParams struct {
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the backups schedules list params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *BackupsSchedulesListParams) WithDefaults() *BackupsSchedulesListParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the backups schedules list params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *BackupsSchedulesListParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the backups schedules list params
+func (o *BackupsSchedulesListParams) WithTimeout(timeout time.Duration) *BackupsSchedulesListParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the backups schedules list params
+func (o *BackupsSchedulesListParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the backups schedules list params
+func (o *BackupsSchedulesListParams) WithContext(ctx context.Context) *BackupsSchedulesListParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the backups schedules list params
+func (o *BackupsSchedulesListParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the backups schedules list params
+func (o *BackupsSchedulesListParams) WithHTTPClient(client *http.Client) *BackupsSchedulesListParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the backups schedules list params
+func (o *BackupsSchedulesListParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *BackupsSchedulesListParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
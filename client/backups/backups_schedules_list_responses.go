@@ -0,0 +1,322 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package backups
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// BackupsSchedulesListReader is a Reader for the BackupsSchedulesList structure.
+type BackupsSchedulesListReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *BackupsSchedulesListReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewBackupsSchedulesListOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewBackupsSchedulesListUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewBackupsSchedulesListForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewBackupsSchedulesListInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewBackupsSchedulesListOK creates a BackupsSchedulesListOK with default headers values
+func NewBackupsSchedulesListOK() *BackupsSchedulesListOK {
+	return &BackupsSchedulesListOK{}
+}
+
+/*
+BackupsSchedulesListOK describes a response with status code 200, with default header values.
+
+Existing backup schedules
+*/
+type BackupsSchedulesListOK struct {
+	Payload []*models.BackupSchedule
+}
+
+// IsSuccess returns true when this backups schedules list o k response has a 2xx status code
+func (o *BackupsSchedulesListOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this backups schedules list o k response has a 3xx status code
+func (o *BackupsSchedulesListOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this backups schedules list o k response has a 4xx status code
+func (o *BackupsSchedulesListOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this backups schedules list o k response has a 5xx status code
+func (o *BackupsSchedulesListOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this backups schedules list o k response a status code equal to that given
+func (o *BackupsSchedulesListOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the backups schedules list o k response
+func (o *BackupsSchedulesListOK) Code() int {
+	return 200
+}
+
+func (o *BackupsSchedulesListOK) Error() string {
+	return fmt.Sprintf("[GET /backup-schedules][%d] backupsSchedulesListOK  %+v", 200, o.Payload)
+}
+
+func (o *BackupsSchedulesListOK) String() string {
+	return fmt.Sprintf("[GET /backup-schedules][%d] backupsSchedulesListOK  %+v", 200, o.Payload)
+}
+
+func (o *BackupsSchedulesListOK) GetPayload() []*models.BackupSchedule {
+	return o.Payload
+}
+
+func (o *BackupsSchedulesListOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewBackupsSchedulesListUnauthorized creates a BackupsSchedulesListUnauthorized with default headers values
+func NewBackupsSchedulesListUnauthorized() *BackupsSchedulesListUnauthorized {
+	return &BackupsSchedulesListUnauthorized{}
+}
+
+/*
+BackupsSchedulesListUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized or invalid credentials.
+*/
+type BackupsSchedulesListUnauthorized struct {
+}
+
+// IsSuccess returns true when this backups schedules list unauthorized response has a 2xx status code
+func (o *BackupsSchedulesListUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this backups schedules list unauthorized response has a 3xx status code
+func (o *BackupsSchedulesListUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this backups schedules list unauthorized response has a 4xx status code
+func (o *BackupsSchedulesListUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this backups schedules list unauthorized response has a 5xx status code
+func (o *BackupsSchedulesListUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this backups schedules list unauthorized response a status code equal to that given
+func (o *BackupsSchedulesListUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+// Code gets the status code for the backups schedules list unauthorized response
+func (o *BackupsSchedulesListUnauthorized) Code() int {
+	return 401
+}
+
+func (o *BackupsSchedulesListUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /backup-schedules][%d] backupsSchedulesListUnauthorized ", 401)
+}
+
+func (o *BackupsSchedulesListUnauthorized) String() string {
+	return fmt.Sprintf("[GET /backup-schedules][%d] backupsSchedulesListUnauthorized ", 401)
+}
+
+func (o *BackupsSchedulesListUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewBackupsSchedulesListForbidden creates a BackupsSchedulesListForbidden with default headers values
+func NewBackupsSchedulesListForbidden() *BackupsSchedulesListForbidden {
+	return &BackupsSchedulesListForbidden{}
+}
+
+/*
+BackupsSchedulesListForbidden describes a response with status code 403, with default header values.
+
+Forbidden
+*/
+type BackupsSchedulesListForbidden struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this backups schedules list forbidden response has a 2xx status code
+func (o *BackupsSchedulesListForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this backups schedules list forbidden response has a 3xx status code
+func (o *BackupsSchedulesListForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this backups schedules list forbidden response has a 4xx status code
+func (o *BackupsSchedulesListForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this backups schedules list forbidden response has a 5xx status code
+func (o *BackupsSchedulesListForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this backups schedules list forbidden response a status code equal to that given
+func (o *BackupsSchedulesListForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the backups schedules list forbidden response
+func (o *BackupsSchedulesListForbidden) Code() int {
+	return 403
+}
+
+func (o *BackupsSchedulesListForbidden) Error() string {
+	return fmt.Sprintf("[GET /backup-schedules][%d] backupsSchedulesListForbidden  %+v", 403, o.Payload)
+}
+
+func (o *BackupsSchedulesListForbidden) String() string {
+	return fmt.Sprintf("[GET /backup-schedules][%d] backupsSchedulesListForbidden  %+v", 403, o.Payload)
+}
+
+func (o *BackupsSchedulesListForbidden) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *BackupsSchedulesListForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewBackupsSchedulesListInternalServerError creates a BackupsSchedulesListInternalServerError with default headers values
+func NewBackupsSchedulesListInternalServerError() *BackupsSchedulesListInternalServerError {
+	return &BackupsSchedulesListInternalServerError{}
+}
+
+/*
+BackupsSchedulesListInternalServerError describes a response with status code 500, with default header values.
+
+An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+*/
+type BackupsSchedulesListInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this backups schedules list internal server error response has a 2xx status code
+func (o *BackupsSchedulesListInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this backups schedules list internal server error response has a 3xx status code
+func (o *BackupsSchedulesListInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this backups schedules list internal server error response has a 4xx status code
+func (o *BackupsSchedulesListInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this backups schedules list internal server error response has a 5xx status code
+func (o *BackupsSchedulesListInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this backups schedules list internal server error response a status code equal to that given
+func (o *BackupsSchedulesListInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the backups schedules list internal server error response
+func (o *BackupsSchedulesListInternalServerError) Code() int {
+	return 500
+}
+
+func (o *BackupsSchedulesListInternalServerError) Error() string {
+	return fmt.Sprintf("[GET /backup-schedules][%d] backupsSchedulesListInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *BackupsSchedulesListInternalServerError) String() string {
+	return fmt.Sprintf("[GET /backup-schedules][%d] backupsSchedulesListInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *BackupsSchedulesListInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *BackupsSchedulesListInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,161 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package graph
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// NewGraphTraverseParams creates a new GraphTraverseParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewGraphTraverseParams() *GraphTraverseParams {
+	return &GraphTraverseParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewGraphTraverseParamsWithTimeout creates a new GraphTraverseParams object
+// with the ability to set a timeout on a request.
+func NewGraphTraverseParamsWithTimeout(timeout time.Duration) *GraphTraverseParams {
+	return &GraphTraverseParams{
+		timeout: timeout,
+	}
+}
+
+// NewGraphTraverseParamsWithContext creates a new GraphTraverseParams object
+// with the ability to set a context for a request.
+func NewGraphTraverseParamsWithContext(ctx context.Context) *GraphTraverseParams {
+	return &GraphTraverseParams{
+		Context: ctx,
+	}
+}
+
+// NewGraphTraverseParamsWithHTTPClient creates a new GraphTraverseParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewGraphTraverseParamsWithHTTPClient(client *http.Client) *GraphTraverseParams {
+	return &GraphTraverseParams{
+		HTTPClient: client,
+	}
+}
+
+/*
+GraphTraverseParams contains all the parameters to send to the API endpoint
+
+	for the graph traverse operation.
+
+	Typically these are written to a http.Request.
+*/
+type GraphTraverseParams struct {
+
+	// Body.
+	Body *models.TraverseRequest
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the graph traverse params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GraphTraverseParams) WithDefaults() *GraphTraverseParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the graph traverse params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GraphTraverseParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the graph traverse params
+func (o *GraphTraverseParams) WithTimeout(timeout time.Duration) *GraphTraverseParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the graph traverse params
+func (o *GraphTraverseParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the graph traverse params
+func (o *GraphTraverseParams) WithContext(ctx context.Context) *GraphTraverseParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the graph traverse params
+func (o *GraphTraverseParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the graph traverse params
+func (o *GraphTraverseParams) WithHTTPClient(client *http.Client) *GraphTraverseParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the graph traverse params
+func (o *GraphTraverseParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBody adds the body to the graph traverse params
+func (o *GraphTraverseParams) WithBody(body *models.TraverseRequest) *GraphTraverseParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the graph traverse params
+func (o *GraphTraverseParams) SetBody(body *models.TraverseRequest) {
+	o.Body = body
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *GraphTraverseParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+	if o.Body != nil {
+		if err := r.SetBodyParam(o.Body); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,398 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package graph
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// GraphTraverseReader is a Reader for the GraphTraverse structure.
+type GraphTraverseReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GraphTraverseReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewGraphTraverseOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 401:
+		result := NewGraphTraverseUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 403:
+		result := NewGraphTraverseForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 422:
+		result := NewGraphTraverseUnprocessableEntity()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewGraphTraverseInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		return nil, runtime.NewAPIError("response status code does not match any response statuses defined for this endpoint in the swagger spec", response, response.Code())
+	}
+}
+
+// NewGraphTraverseOK creates a GraphTraverseOK with default headers values
+func NewGraphTraverseOK() *GraphTraverseOK {
+	return &GraphTraverseOK{}
+}
+
+/*
+GraphTraverseOK describes a response with status code 200, with default header values.
+
+Successful traversal.
+*/
+type GraphTraverseOK struct {
+	Payload *models.TraverseResponse
+}
+
+// IsSuccess returns true when this graph traverse o k response has a 2xx status code
+func (o *GraphTraverseOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this graph traverse o k response has a 3xx status code
+func (o *GraphTraverseOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this graph traverse o k response has a 4xx status code
+func (o *GraphTraverseOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this graph traverse o k response has a 5xx status code
+func (o *GraphTraverseOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this graph traverse o k response a status code equal to that given
+func (o *GraphTraverseOK) IsCode(code int) bool {
+	return code == 200
+}
+
+// Code gets the status code for the graph traverse o k response
+func (o *GraphTraverseOK) Code() int {
+	return 200
+}
+
+func (o *GraphTraverseOK) Error() string {
+	return fmt.Sprintf("[POST /graph/traverse][%d] graphTraverseOK  %+v", 200, o.Payload)
+}
+
+func (o *GraphTraverseOK) String() string {
+	return fmt.Sprintf("[POST /graph/traverse][%d] graphTraverseOK  %+v", 200, o.Payload)
+}
+
+func (o *GraphTraverseOK) GetPayload() *models.TraverseResponse {
+	return o.Payload
+}
+
+func (o *GraphTraverseOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.TraverseResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGraphTraverseUnauthorized creates a GraphTraverseUnauthorized with default headers values
+func NewGraphTraverseUnauthorized() *GraphTraverseUnauthorized {
+	return &GraphTraverseUnauthorized{}
+}
+
+/*
+GraphTraverseUnauthorized describes a response with status code 401, with default header values.
+
+Unauthorized or invalid credentials.
+*/
+type GraphTraverseUnauthorized struct {
+}
+
+// IsSuccess returns true when this graph traverse unauthorized response has a 2xx status code
+func (o *GraphTraverseUnauthorized) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this graph traverse unauthorized response has a 3xx status code
+func (o *GraphTraverseUnauthorized) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this graph traverse unauthorized response has a 4xx status code
+func (o *GraphTraverseUnauthorized) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this graph traverse unauthorized response has a 5xx status code
+func (o *GraphTraverseUnauthorized) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this graph traverse unauthorized response a status code equal to that given
+func (o *GraphTraverseUnauthorized) IsCode(code int) bool {
+	return code == 401
+}
+
+// Code gets the status code for the graph traverse unauthorized response
+func (o *GraphTraverseUnauthorized) Code() int {
+	return 401
+}
+
+func (o *GraphTraverseUnauthorized) Error() string {
+	return fmt.Sprintf("[POST /graph/traverse][%d] graphTraverseUnauthorized ", 401)
+}
+
+func (o *GraphTraverseUnauthorized) String() string {
+	return fmt.Sprintf("[POST /graph/traverse][%d] graphTraverseUnauthorized ", 401)
+}
+
+func (o *GraphTraverseUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewGraphTraverseForbidden creates a GraphTraverseForbidden with default headers values
+func NewGraphTraverseForbidden() *GraphTraverseForbidden {
+	return &GraphTraverseForbidden{}
+}
+
+/*
+GraphTraverseForbidden describes a response with status code 403, with default header values.
+
+Forbidden
+*/
+type GraphTraverseForbidden struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this graph traverse forbidden response has a 2xx status code
+func (o *GraphTraverseForbidden) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this graph traverse forbidden response has a 3xx status code
+func (o *GraphTraverseForbidden) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this graph traverse forbidden response has a 4xx status code
+func (o *GraphTraverseForbidden) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this graph traverse forbidden response has a 5xx status code
+func (o *GraphTraverseForbidden) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this graph traverse forbidden response a status code equal to that given
+func (o *GraphTraverseForbidden) IsCode(code int) bool {
+	return code == 403
+}
+
+// Code gets the status code for the graph traverse forbidden response
+func (o *GraphTraverseForbidden) Code() int {
+	return 403
+}
+
+func (o *GraphTraverseForbidden) Error() string {
+	return fmt.Sprintf("[POST /graph/traverse][%d] graphTraverseForbidden  %+v", 403, o.Payload)
+}
+
+func (o *GraphTraverseForbidden) String() string {
+	return fmt.Sprintf("[POST /graph/traverse][%d] graphTraverseForbidden  %+v", 403, o.Payload)
+}
+
+func (o *GraphTraverseForbidden) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *GraphTraverseForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGraphTraverseUnprocessableEntity creates a GraphTraverseUnprocessableEntity with default headers values
+func NewGraphTraverseUnprocessableEntity() *GraphTraverseUnprocessableEntity {
+	return &GraphTraverseUnprocessableEntity{}
+}
+
+/*
+GraphTraverseUnprocessableEntity describes a response with status code 422, with default header values.
+
+Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?
+*/
+type GraphTraverseUnprocessableEntity struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this graph traverse unprocessable entity response has a 2xx status code
+func (o *GraphTraverseUnprocessableEntity) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this graph traverse unprocessable entity response has a 3xx status code
+func (o *GraphTraverseUnprocessableEntity) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this graph traverse unprocessable entity response has a 4xx status code
+func (o *GraphTraverseUnprocessableEntity) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this graph traverse unprocessable entity response has a 5xx status code
+func (o *GraphTraverseUnprocessableEntity) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this graph traverse unprocessable entity response a status code equal to that given
+func (o *GraphTraverseUnprocessableEntity) IsCode(code int) bool {
+	return code == 422
+}
+
+// Code gets the status code for the graph traverse unprocessable entity response
+func (o *GraphTraverseUnprocessableEntity) Code() int {
+	return 422
+}
+
+func (o *GraphTraverseUnprocessableEntity) Error() string {
+	return fmt.Sprintf("[POST /graph/traverse][%d] graphTraverseUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *GraphTraverseUnprocessableEntity) String() string {
+	return fmt.Sprintf("[POST /graph/traverse][%d] graphTraverseUnprocessableEntity  %+v", 422, o.Payload)
+}
+
+func (o *GraphTraverseUnprocessableEntity) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *GraphTraverseUnprocessableEntity) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGraphTraverseInternalServerError creates a GraphTraverseInternalServerError with default headers values
+func NewGraphTraverseInternalServerError() *GraphTraverseInternalServerError {
+	return &GraphTraverseInternalServerError{}
+}
+
+/*
+GraphTraverseInternalServerError describes a response with status code 500, with default header values.
+
+An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.
+*/
+type GraphTraverseInternalServerError struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this graph traverse internal server error response has a 2xx status code
+func (o *GraphTraverseInternalServerError) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this graph traverse internal server error response has a 3xx status code
+func (o *GraphTraverseInternalServerError) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this graph traverse internal server error response has a 4xx status code
+func (o *GraphTraverseInternalServerError) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this graph traverse internal server error response has a 5xx status code
+func (o *GraphTraverseInternalServerError) IsServerError() bool {
+	return true
+}
+
+// IsCode returns true when this graph traverse internal server error response a status code equal to that given
+func (o *GraphTraverseInternalServerError) IsCode(code int) bool {
+	return code == 500
+}
+
+// Code gets the status code for the graph traverse internal server error response
+func (o *GraphTraverseInternalServerError) Code() int {
+	return 500
+}
+
+func (o *GraphTraverseInternalServerError) Error() string {
+	return fmt.Sprintf("[POST /graph/traverse][%d] graphTraverseInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *GraphTraverseInternalServerError) String() string {
+	return fmt.Sprintf("[POST /graph/traverse][%d] graphTraverseInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *GraphTraverseInternalServerError) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *GraphTraverseInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
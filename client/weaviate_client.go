@@ -26,6 +26,7 @@ import (
 	"github.com/weaviate/weaviate/client/batch"
 	"github.com/weaviate/weaviate/client/classifications"
 	"github.com/weaviate/weaviate/client/cluster"
+	"github.com/weaviate/weaviate/client/graph"
 	"github.com/weaviate/weaviate/client/graphql"
 	"github.com/weaviate/weaviate/client/meta"
 	"github.com/weaviate/weaviate/client/nodes"
@@ -82,6 +83,7 @@ func New(transport runtime.ClientTransport, formats strfmt.Registry) *Weaviate {
 	cli.Batch = batch.New(transport, formats)
 	cli.Classifications = classifications.New(transport, formats)
 	cli.Cluster = cluster.New(transport, formats)
+	cli.Graph = graph.New(transport, formats)
 	cli.Graphql = graphql.New(transport, formats)
 	cli.Meta = meta.New(transport, formats)
 	cli.Nodes = nodes.New(transport, formats)
@@ -143,6 +145,8 @@ type Weaviate struct {
 
 	Cluster cluster.ClientService
 
+	Graph graph.ClientService
+
 	Graphql graphql.ClientService
 
 	Meta meta.ClientService
@@ -168,6 +172,7 @@ func (c *Weaviate) SetTransport(transport runtime.ClientTransport) {
 	c.Batch.SetTransport(transport)
 	c.Classifications.SetTransport(transport)
 	c.Cluster.SetTransport(transport)
+	c.Graph.SetTransport(transport)
 	c.Graphql.SetTransport(transport)
 	c.Meta.SetTransport(transport)
 	c.Nodes.SetTransport(transport)
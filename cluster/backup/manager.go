@@ -0,0 +1,149 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package backup
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+	cmd "github.com/weaviate/weaviate/cluster/proto/api"
+	"github.com/weaviate/weaviate/entities/backup"
+)
+
+var ErrBadRequest = errors.New("bad request")
+
+// Manager holds the backup schedules replicated by RAFT
+type Manager struct {
+	sync.RWMutex
+	schedules map[string]backup.Schedule
+	logger    logrus.FieldLogger
+}
+
+func NewManager(logger logrus.FieldLogger) *Manager {
+	return &Manager{schedules: make(map[string]backup.Schedule), logger: logger}
+}
+
+func (m *Manager) UpsertSchedule(c *cmd.ApplyRequest) error {
+	req := &cmd.UpsertBackupScheduleRequest{}
+	if err := json.Unmarshal(c.SubCommand, req); err != nil {
+		return fmt.Errorf("%w: %w", ErrBadRequest, err)
+	}
+	if err := req.Schedule.Validate(); err != nil {
+		return fmt.Errorf("%w: %w", ErrBadRequest, err)
+	}
+
+	m.Lock()
+	defer m.Unlock()
+	s := req.Schedule
+	if old, ok := m.schedules[s.Name]; ok {
+		// updating a schedule does not reset its progress
+		s.CreatedAt, s.LastRun = old.CreatedAt, old.LastRun
+	}
+	m.schedules[s.Name] = s
+	return nil
+}
+
+func (m *Manager) DeleteSchedule(c *cmd.ApplyRequest) error {
+	req := &cmd.DeleteBackupScheduleRequest{}
+	if err := json.Unmarshal(c.SubCommand, req); err != nil {
+		return fmt.Errorf("%w: %w", ErrBadRequest, err)
+	}
+
+	m.Lock()
+	defer m.Unlock()
+	delete(m.schedules, req.Name)
+	return nil
+}
+
+func (m *Manager) RecordRun(c *cmd.ApplyRequest) error {
+	req := &cmd.BackupScheduleRunRequest{}
+	if err := json.Unmarshal(c.SubCommand, req); err != nil {
+		return fmt.Errorf("%w: %w", ErrBadRequest, err)
+	}
+
+	m.Lock()
+	defer m.Unlock()
+	s, ok := m.schedules[req.Name]
+	if !ok {
+		// the schedule has been deleted in the meantime
+		return nil
+	}
+	if req.Time.After(s.LastRun) {
+		s.LastRun = req.Time
+		m.schedules[req.Name] = s
+	}
+	return nil
+}
+
+func (m *Manager) GetSchedules(req *cmd.QueryRequest) ([]byte, error) {
+	subCommand := cmd.QueryGetBackupSchedulesRequest{}
+	if err := json.Unmarshal(req.SubCommand, &subCommand); err != nil {
+		return []byte{}, fmt.Errorf("%w: %w", ErrBadRequest, err)
+	}
+
+	response := cmd.QueryGetBackupSchedulesResponse{Schedules: m.Schedules(subCommand.Names...)}
+	payload, err := json.Marshal(response)
+	if err != nil {
+		return []byte{}, fmt.Errorf("could not marshal query response: %w", err)
+	}
+	return payload, nil
+}
+
+// Schedules returns the schedules with the given names sorted by name.
+// All schedules are returned if no name is given.
+func (m *Manager) Schedules(names ...string) []backup.Schedule {
+	m.RLock()
+	defer m.RUnlock()
+
+	var schedules []backup.Schedule
+	if len(names) == 0 {
+		schedules = make([]backup.Schedule, 0, len(m.schedules))
+		for _, s := range m.schedules {
+			schedules = append(schedules, s)
+		}
+	} else {
+		for _, name := range names {
+			if s, ok := m.schedules[name]; ok {
+				schedules = append(schedules, s)
+			}
+		}
+	}
+	sort.Slice(schedules, func(i, j int) bool { return schedules[i].Name < schedules[j].Name })
+	return schedules
+}
+
+// Snapshot returns the JSON encoded schedules
+func (m *Manager) Snapshot() ([]byte, error) {
+	m.RLock()
+	defer m.RUnlock()
+	return json.Marshal(m.schedules)
+}
+
+// Restore replaces all schedules by the ones encoded in data.
+// Empty data removes all schedules.
+func (m *Manager) Restore(data []byte) error {
+	schedules := make(map[string]backup.Schedule)
+	if len(data) > 0 {
+		if err := json.Unmarshal(data, &schedules); err != nil {
+			return fmt.Errorf("decode backup schedules: %w", err)
+		}
+	}
+
+	m.Lock()
+	defer m.Unlock()
+	m.schedules = schedules
+	return nil
+}
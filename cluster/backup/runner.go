@@ -0,0 +1,113 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package backup
+
+import (
+	"context"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/backup"
+)
+
+const (
+	// RunInterval is how often the leader checks for due schedules
+	RunInterval = 15 * time.Second
+	// PruneInterval is how often the leader applies the retention policies
+	PruneInterval = 10 * time.Minute
+)
+
+// Executor creates and prunes the backups of a schedule
+type Executor interface {
+	// ScheduledBackup starts the backup id as described by the schedule
+	ScheduledBackup(ctx context.Context, s backup.Schedule, id string) error
+	// PruneBackups deletes backups of the schedule not covered by its retention policy
+	PruneBackups(ctx context.Context, s backup.Schedule) error
+}
+
+// Runner triggers due schedules. It only acts while the local node is the leader.
+type Runner struct {
+	schedules *Manager
+	isLeader  func() bool
+	// record replicates the time a schedule has been triggered at
+	record   func(name string, t time.Time) error
+	executor Executor
+	logger   logrus.FieldLogger
+
+	lastPrune time.Time
+}
+
+func NewRunner(schedules *Manager, isLeader func() bool,
+	record func(name string, t time.Time) error, executor Executor, logger logrus.FieldLogger,
+) *Runner {
+	return &Runner{
+		schedules: schedules,
+		isLeader:  isLeader,
+		record:    record,
+		executor:  executor,
+		logger:    logger.WithField("action", "backup_schedule"),
+	}
+}
+
+// Run checks for due schedules every interval until ctx is done
+func (r *Runner) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			r.Tick(ctx, time.Now())
+		}
+	}
+}
+
+// Tick triggers all schedules due at now and prunes backups if PruneInterval has passed
+func (r *Runner) Tick(ctx context.Context, now time.Time) {
+	if !r.isLeader() {
+		return
+	}
+
+	schedules := r.schedules.Schedules()
+	for _, s := range schedules {
+		at, due := s.Due(now)
+		if !due {
+			continue
+		}
+		id := s.BackupID(at)
+		logger := r.logger.WithField("schedule", s.Name).WithField("backup_id", id)
+		// record first so that a new leader does not trigger the same run again
+		if err := r.record(s.Name, at); err != nil {
+			logger.WithError(err).Error("record schedule run")
+			continue
+		}
+		if err := r.executor.ScheduledBackup(ctx, s, id); err != nil {
+			logger.WithError(err).Error("start scheduled backup")
+			continue
+		}
+		logger.Info("started scheduled backup")
+	}
+
+	if now.Sub(r.lastPrune) < PruneInterval {
+		return
+	}
+	r.lastPrune = now
+	for _, s := range schedules {
+		if s.Retention.IsZero() {
+			continue
+		}
+		if err := r.executor.PruneBackups(ctx, s); err != nil {
+			r.logger.WithField("schedule", s.Name).WithError(err).Error("prune backups")
+		}
+	}
+}
@@ -0,0 +1,107 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package backup
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	cmd "github.com/weaviate/weaviate/cluster/proto/api"
+	"github.com/weaviate/weaviate/entities/backup"
+)
+
+type fakeExecutor struct {
+	backups []string
+	pruned  []string
+}
+
+func (f *fakeExecutor) ScheduledBackup(ctx context.Context, s backup.Schedule, id string) error {
+	f.backups = append(f.backups, id)
+	return nil
+}
+
+func (f *fakeExecutor) PruneBackups(ctx context.Context, s backup.Schedule) error {
+	f.pruned = append(f.pruned, s.Name)
+	return nil
+}
+
+func TestRunnerTick(t *testing.T) {
+	var (
+		ctx       = context.Background()
+		logger, _ = test.NewNullLogger()
+		manager   = NewManager(logger)
+		executor  = &fakeExecutor{}
+		leader    = false
+		created   = time.Date(2024, 5, 15, 10, 30, 0, 0, time.UTC)
+	)
+	apply := func(typ cmd.ApplyRequest_Type, req any) {
+		sub, err := json.Marshal(req)
+		require.Nil(t, err)
+		c := &cmd.ApplyRequest{Type: typ, SubCommand: sub}
+		switch typ {
+		case cmd.ApplyRequest_TYPE_UPSERT_BACKUP_SCHEDULE:
+			require.Nil(t, manager.UpsertSchedule(c))
+		case cmd.ApplyRequest_TYPE_BACKUP_SCHEDULE_RUN:
+			require.Nil(t, manager.RecordRun(c))
+		}
+	}
+	record := func(name string, at time.Time) error {
+		apply(cmd.ApplyRequest_TYPE_BACKUP_SCHEDULE_RUN, cmd.BackupScheduleRunRequest{Name: name, Time: at})
+		return nil
+	}
+	r := NewRunner(manager, func() bool { return leader }, record, executor, logger)
+
+	apply(cmd.ApplyRequest_TYPE_UPSERT_BACKUP_SCHEDULE, cmd.UpsertBackupScheduleRequest{Schedule: backup.Schedule{
+		Name: "hourly", Cron: "@hourly", Backend: "s3", CreatedAt: created,
+		Retention: backup.Retention{KeepLast: 1},
+	}})
+	apply(cmd.ApplyRequest_TYPE_UPSERT_BACKUP_SCHEDULE, cmd.UpsertBackupScheduleRequest{Schedule: backup.Schedule{
+		Name: "daily", Cron: "@daily", Backend: "s3", CreatedAt: created,
+	}})
+
+	t.Run("follower does nothing", func(t *testing.T) {
+		r.Tick(ctx, created.Add(2*time.Hour))
+		assert.Empty(t, executor.backups)
+		assert.Empty(t, executor.pruned)
+	})
+
+	leader = true
+	t.Run("leader triggers due schedules and prunes", func(t *testing.T) {
+		r.Tick(ctx, created.Add(2*time.Hour))
+		assert.Equal(t, []string{"hourly-20240515120000"}, executor.backups)
+		assert.Equal(t, []string{"hourly"}, executor.pruned)
+		assert.Equal(t, created.Add(90*time.Minute), manager.Schedules("hourly")[0].LastRun)
+	})
+
+	t.Run("schedules are triggered once", func(t *testing.T) {
+		r.Tick(ctx, created.Add(2*time.Hour+time.Minute))
+		assert.Len(t, executor.backups, 1)
+		assert.Len(t, executor.pruned, 1, "prune interval has not passed")
+	})
+
+	t.Run("updating a schedule keeps its progress", func(t *testing.T) {
+		apply(cmd.ApplyRequest_TYPE_UPSERT_BACKUP_SCHEDULE, cmd.UpsertBackupScheduleRequest{Schedule: backup.Schedule{
+			Name: "hourly", Cron: "*/30 * * * *", Backend: "s3", CreatedAt: created.Add(time.Hour),
+		}})
+		s := manager.Schedules("hourly")[0]
+		assert.Equal(t, created, s.CreatedAt)
+		assert.Equal(t, created.Add(90*time.Minute), s.LastRun)
+
+		r.Tick(ctx, created.Add(2*time.Hour+time.Minute))
+		assert.Equal(t, []string{"hourly-20240515120000", "hourly-20240515123000"}, executor.backups)
+	})
+}
@@ -0,0 +1,39 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package api
+
+import (
+	"time"
+
+	"github.com/weaviate/weaviate/entities/backup"
+)
+
+type UpsertBackupScheduleRequest struct {
+	Schedule backup.Schedule
+}
+
+type DeleteBackupScheduleRequest struct {
+	Name string
+}
+
+type BackupScheduleRunRequest struct {
+	Name string
+	Time time.Time
+}
+
+type QueryGetBackupSchedulesRequest struct {
+	Names []string
+}
+
+type QueryGetBackupSchedulesResponse struct {
+	Schedules []backup.Schedule
+}
@@ -29,6 +29,9 @@ type Raft struct {
 	store        *Store
 	cl           client
 	log          *logrus.Logger
+
+	// stopBackupSchedules stops the backup schedule runner, if started
+	stopBackupSchedules context.CancelFunc
 }
 
 // client to communicate with remote services
@@ -54,6 +57,9 @@ func (s *Raft) Open(ctx context.Context, db schema.Indexer) error {
 
 func (s *Raft) Close(ctx context.Context) (err error) {
 	s.log.Info("shutting down raft sub-system ...")
+	if s.stopBackupSchedules != nil {
+		s.stopBackupSchedules()
+	}
 
 	// non-voter can be safely removed, as they don't partake in RAFT elections
 	if !s.store.IsVoter() {
@@ -0,0 +1,112 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package cluster
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	clusterbackup "github.com/weaviate/weaviate/cluster/backup"
+	cmd "github.com/weaviate/weaviate/cluster/proto/api"
+	"github.com/weaviate/weaviate/cluster/schema"
+	"github.com/weaviate/weaviate/entities/backup"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+)
+
+func (s *Raft) UpsertBackupSchedule(schedule backup.Schedule) error {
+	if err := schedule.Validate(); err != nil {
+		return fmt.Errorf("%w: %w", schema.ErrBadRequest, err)
+	}
+	req := cmd.UpsertBackupScheduleRequest{Schedule: schedule}
+	subCommand, err := json.Marshal(&req)
+	if err != nil {
+		return fmt.Errorf("marshal request: %w", err)
+	}
+	command := &cmd.ApplyRequest{
+		Type:       cmd.ApplyRequest_TYPE_UPSERT_BACKUP_SCHEDULE,
+		SubCommand: subCommand,
+	}
+	if _, err := s.Execute(context.Background(), command); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (s *Raft) DeleteBackupSchedule(name string) error {
+	if name == "" {
+		return fmt.Errorf("no schedule to delete: %w", schema.ErrBadRequest)
+	}
+	req := cmd.DeleteBackupScheduleRequest{Name: name}
+	subCommand, err := json.Marshal(&req)
+	if err != nil {
+		return fmt.Errorf("marshal request: %w", err)
+	}
+	command := &cmd.ApplyRequest{
+		Type:       cmd.ApplyRequest_TYPE_DELETE_BACKUP_SCHEDULE,
+		SubCommand: subCommand,
+	}
+	if _, err := s.Execute(context.Background(), command); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (s *Raft) recordBackupScheduleRun(name string, t time.Time) error {
+	req := cmd.BackupScheduleRunRequest{Name: name, Time: t}
+	subCommand, err := json.Marshal(&req)
+	if err != nil {
+		return fmt.Errorf("marshal request: %w", err)
+	}
+	command := &cmd.ApplyRequest{
+		Type:       cmd.ApplyRequest_TYPE_BACKUP_SCHEDULE_RUN,
+		SubCommand: subCommand,
+	}
+	if _, err := s.Execute(context.Background(), command); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (s *Raft) GetBackupSchedules(names ...string) ([]backup.Schedule, error) {
+	req := cmd.QueryGetBackupSchedulesRequest{Names: names}
+	subCommand, err := json.Marshal(&req)
+	if err != nil {
+		return nil, fmt.Errorf("marshal request: %w", err)
+	}
+
+	command := &cmd.QueryRequest{
+		Type:       cmd.QueryRequest_TYPE_GET_BACKUP_SCHEDULES,
+		SubCommand: subCommand,
+	}
+	queryResp, err := s.Query(context.Background(), command)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+
+	response := cmd.QueryGetBackupSchedulesResponse{}
+	if err := json.Unmarshal(queryResp.Payload, &response); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal query result: %w", err)
+	}
+	return response.Schedules, nil
+}
+
+// StartBackupSchedules starts triggering the backup schedules through executor
+// while this node is the leader. It stops when the raft sub-system is closed.
+func (s *Raft) StartBackupSchedules(executor clusterbackup.Executor) {
+	ctx, cancel := context.WithCancel(context.Background())
+	s.stopBackupSchedules = cancel
+	runner := clusterbackup.NewRunner(s.store.backupSchedules, s.store.IsLeader,
+		s.recordBackupScheduleRun, executor, s.log)
+	enterrors.GoWrapper(func() { runner.Run(ctx, clusterbackup.RunInterval) }, s.log)
+}
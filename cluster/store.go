@@ -22,6 +22,7 @@ import (
 	"sync/atomic"
 	"time"
 
+	clusterbackup "github.com/weaviate/weaviate/cluster/backup"
 	enterrors "github.com/weaviate/weaviate/entities/errors"
 	"github.com/weaviate/weaviate/usecases/auth/authorization"
 	"github.com/weaviate/weaviate/usecases/cluster"
@@ -189,6 +190,9 @@ type Store struct {
 	// authZManager is responsible for applying/querying changes committed by RAFT to the rbac representation
 	authZManager *rbac.Manager
 
+	// backupSchedules is responsible for applying/querying changes committed by RAFT to the backup schedules
+	backupSchedules *clusterbackup.Manager
+
 	// lastAppliedIndexToDB represents the index of the last applied command when the store is opened.
 	lastAppliedIndexToDB atomic.Uint64
 	// / lastAppliedIndex index of latest update to the store
@@ -224,6 +228,8 @@ func NewFSM(cfg Config) Store {
 		raftResolver:  raftResolver,
 		schemaManager: schemaManager,
 		authZManager:  rbac.NewManager(cfg.AuthzController, cfg.Logger),
+
+		backupSchedules: clusterbackup.NewManager(cfg.Logger),
 	}
 }
 
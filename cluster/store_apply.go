@@ -214,6 +214,19 @@ func (st *Store) Apply(l *raft.Log) interface{} {
 			ret.Error = st.authZManager.RevokeRolesForUser(&cmd)
 		}
 
+	case api.ApplyRequest_TYPE_UPSERT_BACKUP_SCHEDULE:
+		f = func() {
+			ret.Error = st.backupSchedules.UpsertSchedule(&cmd)
+		}
+	case api.ApplyRequest_TYPE_DELETE_BACKUP_SCHEDULE:
+		f = func() {
+			ret.Error = st.backupSchedules.DeleteSchedule(&cmd)
+		}
+	case api.ApplyRequest_TYPE_BACKUP_SCHEDULE_RUN:
+		f = func() {
+			ret.Error = st.backupSchedules.RecordRun(&cmd)
+		}
+
 	default:
 		// This could occur when a new command has been introduced in a later app version
 		// At this point, we need to panic so that the app undergo an upgrade during restart
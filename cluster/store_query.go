@@ -74,6 +74,11 @@ func (st *Store) Query(req *cmd.QueryRequest) (*cmd.QueryResponse, error) {
 		if err != nil {
 			return &cmd.QueryResponse{}, fmt.Errorf("could not get sharding state: %w", err)
 		}
+	case cmd.QueryRequest_TYPE_GET_BACKUP_SCHEDULES:
+		payload, err = st.backupSchedules.GetSchedules(req)
+		if err != nil {
+			return &cmd.QueryResponse{}, fmt.Errorf("could not get backup schedules: %w", err)
+		}
 
 	default:
 		// This could occur when a new command has been introduced in a later app version
@@ -12,6 +12,8 @@
 package cluster
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
 	"io"
 	"sync"
@@ -35,9 +37,60 @@ import (
 // be implemented to allow for concurrent updates while a snapshot is happening.
 func (st *Store) Snapshot() (raft.FSMSnapshot, error) {
 	st.log.Info("persisting snapshot")
-	return st.schemaManager.Snapshot(), nil
+	schedules, err := st.backupSchedules.Snapshot()
+	if err != nil {
+		return nil, fmt.Errorf("snapshot backup schedules: %w", err)
+	}
+	return &storeSnapshot{schema: st.schemaManager.Snapshot(), backupSchedules: schedules}, nil
+}
+
+// storeSnapshot adds the state which is not part of the schema to the schema snapshot.
+// The additional state is added as fields to the JSON object written by the schema
+// snapshot, which keeps snapshots readable by the schema and by older versions.
+type storeSnapshot struct {
+	schema          raft.FSMSnapshot
+	backupSchedules json.RawMessage
+}
+
+// storeSnapshotState is the state stored next to the schema
+type storeSnapshotState struct {
+	BackupSchedules json.RawMessage `json:"backup_schedules,omitempty"`
+}
+
+func (s *storeSnapshot) Persist(sink raft.SnapshotSink) error {
+	buf := &bufferedSink{SnapshotSink: sink}
+	if err := s.schema.Persist(buf); err != nil {
+		sink.Cancel()
+		return err
+	}
+
+	snap := map[string]json.RawMessage{}
+	if err := json.Unmarshal(buf.Bytes(), &snap); err != nil {
+		sink.Cancel()
+		return fmt.Errorf("decode schema snapshot: %w", err)
+	}
+	snap["backup_schedules"] = s.backupSchedules
+	if err := json.NewEncoder(sink).Encode(snap); err != nil {
+		sink.Cancel()
+		return fmt.Errorf("encode: %w", err)
+	}
+	return sink.Close()
+}
+
+func (s *storeSnapshot) Release() {
+	s.schema.Release()
+}
+
+// bufferedSink buffers everything written to it, Close is a no-op
+type bufferedSink struct {
+	raft.SnapshotSink
+	bytes.Buffer
 }
 
+func (b *bufferedSink) Write(p []byte) (int, error) { return b.Buffer.Write(p) }
+func (b *bufferedSink) Close() error                { return nil }
+func (b *bufferedSink) Cancel() error               { return nil }
+
 // Restore is used to restore an FSM from a snapshot. It is not called
 // concurrently with any other command. The FSM must discard all previous
 // state before restoring the snapshot.
@@ -50,12 +103,24 @@ func (st *Store) Restore(rc io.ReadCloser) error {
 			}
 		}()
 
-		if err := st.schemaManager.Restore(rc, st.cfg.Parser); err != nil {
+		data, err := io.ReadAll(rc)
+		if err != nil {
+			return fmt.Errorf("read snapshot: %w", err)
+		}
+		if err := st.schemaManager.Restore(io.NopCloser(bytes.NewReader(data)), st.cfg.Parser); err != nil {
 			st.log.WithError(err).Error("restoring schema from snapshot")
 			return fmt.Errorf("restore schema from snapshot: %w", err)
 		}
 		st.log.Info("successfully restored schema from snapshot")
 
+		var state storeSnapshotState
+		if err := json.Unmarshal(data, &state); err != nil {
+			return fmt.Errorf("restore snapshot: decode json: %w", err)
+		}
+		if err := st.backupSchedules.Restore(state.BackupSchedules); err != nil {
+			return fmt.Errorf("restore backup schedules from snapshot: %w", err)
+		}
+
 		if st.cfg.MetadataOnlyVoters {
 			return nil
 		}
@@ -0,0 +1,90 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package cluster
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/raft"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	cmd "github.com/weaviate/weaviate/cluster/proto/api"
+	"github.com/weaviate/weaviate/entities/backup"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/sharding"
+)
+
+func TestStoreSnapshotBackupSchedules(t *testing.T) {
+	m := NewMockStore(t, "Node-1", 0)
+	m.parser.On("ParseClass", mock.Anything).Return(nil)
+	m.indexer.On("AddClass", mock.Anything).Return(nil)
+	m.indexer.On("TriggerSchemaUpdateCallbacks").Return()
+	st := m.store
+
+	cls := &models.Class{Class: "C1"}
+	ss := &sharding.State{Physical: map[string]sharding.Physical{"S1": {Name: "S1", BelongsToNodes: []string{"Node-1"}}}}
+	resp := st.Apply(&raft.Log{Index: 1, Data: cmdAsBytes("C1", cmd.ApplyRequest_TYPE_ADD_CLASS,
+		cmd.AddClassRequest{Class: cls, State: ss}, nil)})
+	require.Nil(t, resp.(Response).Error)
+
+	created := time.Date(2024, 5, 15, 0, 0, 0, 0, time.UTC)
+	schedule := backup.Schedule{
+		Name: "nightly", Cron: "0 3 * * *", Backend: "s3",
+		Retention: backup.Retention{KeepLast: 3}, CreatedAt: created,
+	}
+	resp = st.Apply(&raft.Log{Index: 2, Data: cmdAsBytes("", cmd.ApplyRequest_TYPE_UPSERT_BACKUP_SCHEDULE,
+		cmd.UpsertBackupScheduleRequest{Schedule: schedule}, nil)})
+	require.Nil(t, resp.(Response).Error)
+	schedule.LastRun = created.Add(3 * time.Hour)
+	resp = st.Apply(&raft.Log{Index: 3, Data: cmdAsBytes("", cmd.ApplyRequest_TYPE_BACKUP_SCHEDULE_RUN,
+		cmd.BackupScheduleRunRequest{Name: schedule.Name, Time: schedule.LastRun}, nil)})
+	require.Nil(t, resp.(Response).Error)
+
+	snapshots := raft.NewInmemSnapshotStore()
+	sink, err := snapshots.Create(raft.SnapshotVersionMax, 3, 1, raft.Configuration{}, 0, nil)
+	require.Nil(t, err)
+	snap, err := st.Snapshot()
+	require.Nil(t, err)
+	require.Nil(t, snap.Persist(sink))
+	snap.Release()
+
+	restore := func(t *testing.T) *Store {
+		m := NewMockStore(t, "Node-1", 0)
+		m.parser.On("ParseClass", mock.Anything).Return(nil)
+		m.store.cfg.MetadataOnlyVoters = true
+		_, rc, err := snapshots.Open(sink.ID())
+		require.Nil(t, err)
+		require.Nil(t, m.store.Restore(rc))
+		return m.store
+	}
+
+	t.Run("restore", func(t *testing.T) {
+		restored := restore(t)
+		assert.NotNil(t, restored.SchemaReader().ReadOnlyClass("C1"))
+		assert.Equal(t, []backup.Schedule{schedule}, restored.backupSchedules.Schedules())
+	})
+
+	t.Run("restore discards previous schedules", func(t *testing.T) {
+		resp = st.Apply(&raft.Log{Index: 4, Data: cmdAsBytes("", cmd.ApplyRequest_TYPE_DELETE_BACKUP_SCHEDULE,
+			cmd.DeleteBackupScheduleRequest{Name: schedule.Name}, nil)})
+		require.Nil(t, resp.(Response).Error)
+		assert.Empty(t, st.backupSchedules.Schedules())
+
+		_, rc, err := snapshots.Open(sink.ID())
+		require.Nil(t, err)
+		st.cfg.MetadataOnlyVoters = true
+		require.Nil(t, st.Restore(rc))
+		assert.Equal(t, []backup.Schedule{schedule}, st.backupSchedules.Schedules())
+	})
+}
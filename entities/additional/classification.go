@@ -37,6 +37,12 @@ type Properties struct {
 	ExplainScore       bool                   `json:"explainScore"`
 	IsConsistent       bool                   `json:"isConsistent"`
 	Group              bool                   `json:"group"`
+	Highlights         bool                   `json:"highlights"`
+	// QueryIndex returns the position of the query vector of a batch search
+	// that produced the result
+	QueryIndex bool `json:"queryIndex"`
+	// QueryPlan returns how the filter of a vector search was applied
+	QueryPlan bool `json:"queryPlan"`
 
 	// The User is not interested in returning props, we can skip any costly
 	// operation that isn't required.
@@ -31,4 +31,5 @@ type GroupHitAdditional struct {
 	ID       strfmt.UUID `json:"id"`
 	Vector   []float32   `json:"vector"`
 	Distance float32     `json:"distance"`
+	Score    float32     `json:"score"`
 }
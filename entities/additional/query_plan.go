@@ -0,0 +1,28 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package additional
+
+// QueryPlan describes how a shard searched the vector index of a target
+// vector and why
+type QueryPlan struct {
+	Shard        string `json:"shard"`
+	TargetVector string `json:"targetVector"`
+	// Strategy is the way the filter was applied: unfiltered, flat, sweeping,
+	// acorn or postFilter
+	Strategy string `json:"strategy"`
+	// Selectivity is the share of the vectors in the index the filter allows
+	Selectivity   float64 `json:"selectivity"`
+	AllowListSize int     `json:"allowListSize"`
+	IndexSize     int     `json:"indexSize"`
+	// EstimatedCost is the estimated number of distance calculations
+	EstimatedCost float64 `json:"estimatedCost"`
+}
@@ -13,6 +13,9 @@ package aggregation
 
 import (
 	"fmt"
+	"math"
+	"strconv"
+	"time"
 
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/schema"
@@ -24,6 +27,7 @@ type Params struct {
 	ClassName        schema.ClassName           `json:"className"`
 	Properties       []ParamProperty            `json:"properties"`
 	GroupBy          *filters.Path              `json:"groupBy"`
+	GroupByKeys      []GroupByKey               `json:"groupByKeys"`
 	IncludeMetaCount bool                       `json:"includeMetaCount"`
 	Limit            *int                       `json:"limit"`
 	ObjectLimit      *int                       `json:"objectLimit"`
@@ -37,14 +41,155 @@ type Params struct {
 	Hybrid           *searchparams.HybridSearch `json:"hybrid"`
 }
 
+// GroupByLevels are the keys of the nested groups, from the outermost to the
+// innermost one. GroupByKeys take precedence over the single GroupBy path.
+func (p Params) GroupByLevels() []GroupByKey {
+	if len(p.GroupByKeys) > 0 {
+		return p.GroupByKeys
+	}
+	if p.GroupBy != nil {
+		return []GroupByKey{{Path: p.GroupBy}}
+	}
+	return nil
+}
+
+// GroupByKey is one level of nested groups. Without Ranges or a
+// DateInterval, every value of the property is the key of a group.
+type GroupByKey struct {
+	Path *filters.Path `json:"path"`
+
+	// Ranges group numbers, or with a GeoOrigin geo coordinates by their
+	// distance to the origin in meters. The ranges may overlap.
+	Ranges    []GroupByRange `json:"ranges,omitempty"`
+	GeoOrigin *GeoOrigin     `json:"geoOrigin,omitempty"`
+
+	// DateInterval groups dates by the calendar interval they fall into
+	DateInterval DateInterval `json:"dateInterval,omitempty"`
+}
+
+func (k GroupByKey) Validate() error {
+	if k.Path == nil {
+		return fmt.Errorf("groupBy: a path is required")
+	}
+	if len(k.Ranges) > 0 && k.DateInterval != "" {
+		return fmt.Errorf("groupBy %s: set either ranges or a dateInterval, not both", k.Path.Property)
+	}
+	if k.GeoOrigin != nil && len(k.Ranges) == 0 {
+		return fmt.Errorf("groupBy %s: a geoOrigin requires distance ranges", k.Path.Property)
+	}
+	for _, r := range k.Ranges {
+		if r.From == nil && r.To == nil {
+			return fmt.Errorf("groupBy %s: range needs a from or to value", k.Path.Property)
+		}
+		if r.From != nil && r.To != nil && !(*r.From < *r.To) {
+			return fmt.Errorf("groupBy %s: range from %v must be lower than to %v", k.Path.Property, *r.From, *r.To)
+		}
+	}
+	if k.DateInterval != "" && !k.DateInterval.IsValid() {
+		return fmt.Errorf("groupBy %s: unknown dateInterval %q", k.Path.Property, k.DateInterval)
+	}
+	return nil
+}
+
+// GroupByRange includes From and excludes To, a missing bound is unbounded
+type GroupByRange struct {
+	From *float64 `json:"from,omitempty"`
+	To   *float64 `json:"to,omitempty"`
+}
+
+func (r GroupByRange) Contains(value float64) bool {
+	return (r.From == nil || value >= *r.From) && (r.To == nil || value < *r.To)
+}
+
+// String is the key of the range group, e.g. "10-20" or "*-10"
+func (r GroupByRange) String() string {
+	bound := func(b *float64) string {
+		if b == nil {
+			return "*"
+		}
+		return strconv.FormatFloat(*b, 'f', -1, 64)
+	}
+	return bound(r.From) + "-" + bound(r.To)
+}
+
+type GeoOrigin struct {
+	Latitude  float32 `json:"latitude"`
+	Longitude float32 `json:"longitude"`
+}
+
+// DateInterval is a calendar interval in UTC, weeks start on Monday
+type DateInterval string
+
+const (
+	DateIntervalMinute  DateInterval = "minute"
+	DateIntervalHour    DateInterval = "hour"
+	DateIntervalDay     DateInterval = "day"
+	DateIntervalWeek    DateInterval = "week"
+	DateIntervalMonth   DateInterval = "month"
+	DateIntervalQuarter DateInterval = "quarter"
+	DateIntervalYear    DateInterval = "year"
+)
+
+func (d DateInterval) IsValid() bool {
+	switch d {
+	case DateIntervalMinute, DateIntervalHour, DateIntervalDay, DateIntervalWeek,
+		DateIntervalMonth, DateIntervalQuarter, DateIntervalYear:
+		return true
+	default:
+		return false
+	}
+}
+
+// Start is the beginning of the interval t falls into
+func (d DateInterval) Start(t time.Time) time.Time {
+	t = t.UTC()
+	switch d {
+	case DateIntervalMinute:
+		return t.Truncate(time.Minute)
+	case DateIntervalHour:
+		return t.Truncate(time.Hour)
+	case DateIntervalDay:
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	case DateIntervalWeek:
+		daysSinceMonday := (int(t.Weekday()) + 6) % 7
+		return time.Date(t.Year(), t.Month(), t.Day()-daysSinceMonday, 0, 0, 0, 0, time.UTC)
+	case DateIntervalMonth:
+		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+	case DateIntervalQuarter:
+		return time.Date(t.Year(), (t.Month()-1)/3*3+1, 1, 0, 0, 0, 0, time.UTC)
+	case DateIntervalYear:
+		return time.Date(t.Year(), 1, 1, 0, 0, 0, 0, time.UTC)
+	default:
+		return t
+	}
+}
+
 type ParamProperty struct {
 	Name        schema.PropertyName `json:"name"`
 	Aggregators []Aggregator        `json:"aggregators"`
 }
 
 type Aggregator struct {
-	Type  string `json:"type"`
-	Limit *int   `json:"limit"` // used on TopOccurrence Agg
+	Type       string            `json:"type"`
+	Limit      *int              `json:"limit"`                // used on TopOccurrence Agg
+	Percentile *PercentileParams `json:"percentile,omitempty"` // used on Percentile Agg
+	Histogram  *HistogramParams  `json:"histogram,omitempty"`  // used on Histogram Agg
+}
+
+// PercentileParams are the quantiles, between 0 and 1, a percentile
+// aggregation estimates
+type PercentileParams struct {
+	Quantiles []float64 `json:"quantiles"`
+}
+
+// HistogramParams configure the buckets of a histogram aggregation. Either
+// the buckets are Interval wide and aligned to zero, or they lie between the
+// given ascending Edges. Every bucket includes its lower bound and excludes
+// its upper bound. On date properties the interval is in seconds and the
+// edges are unix timestamps in seconds.
+type HistogramParams struct {
+	Interval float64   `json:"interval,omitempty"`
+	Edges    []float64 `json:"edges,omitempty"`
 }
 
 func (a Aggregator) String() string {
@@ -88,6 +233,56 @@ var (
 	PointingToAggregator = Aggregator{Type: "pointingTo"}
 )
 
+const (
+	PercentileType  = "percentile"
+	HistogramType   = "histogram"
+	CardinalityType = "cardinality"
+)
+
+// DefaultPercentileQuantiles are estimated if a percentile aggregation does
+// not specify any quantiles
+var DefaultPercentileQuantiles = []float64{0.5, 0.95, 0.99}
+
+// CardinalityAggregator approximates the number of distinct values
+var CardinalityAggregator = Aggregator{Type: CardinalityType}
+
+func NewPercentileAggregator(quantiles []float64) (Aggregator, error) {
+	if len(quantiles) == 0 {
+		quantiles = DefaultPercentileQuantiles
+	}
+	for _, q := range quantiles {
+		if q < 0 || q > 1 || math.IsNaN(q) {
+			return Aggregator{}, fmt.Errorf("percentile: quantile %v must be between 0 and 1", q)
+		}
+	}
+	return Aggregator{
+		Type:       PercentileType,
+		Percentile: &PercentileParams{Quantiles: quantiles},
+	}, nil
+}
+
+func NewHistogramAggregator(interval float64, edges []float64) (Aggregator, error) {
+	switch {
+	case interval != 0 && len(edges) != 0:
+		return Aggregator{}, fmt.Errorf("histogram: set either an interval or bucket edges, not both")
+	case len(edges) != 0:
+		if len(edges) < 2 {
+			return Aggregator{}, fmt.Errorf("histogram: at least two bucket edges are required")
+		}
+		for i := 1; i < len(edges); i++ {
+			if !(edges[i] > edges[i-1]) {
+				return Aggregator{}, fmt.Errorf("histogram: bucket edges must be ascending")
+			}
+		}
+	case !(interval > 0) || math.IsInf(interval, 1):
+		return Aggregator{}, fmt.Errorf("histogram: interval must be a positive number")
+	}
+	return Aggregator{
+		Type:      HistogramType,
+		Histogram: &HistogramParams{Interval: interval, Edges: edges},
+	}, nil
+}
+
 func ParseAggregatorProp(name string) (Aggregator, error) {
 	switch name {
 	// common
@@ -128,6 +323,13 @@ func ParseAggregatorProp(name string) (Aggregator, error) {
 	case PointingToAggregator.String():
 		return PointingToAggregator, nil
 
+	// distributions, histograms need an interval or bucket edges and can't be
+	// parsed from their name alone
+	case PercentileType:
+		return NewPercentileAggregator(nil)
+	case CardinalityType:
+		return CardinalityAggregator, nil
+
 	default:
 		return Aggregator{}, fmt.Errorf("unrecognized aggregator prop '%s'", name)
 	}
@@ -19,6 +19,7 @@ type Group struct {
 	Properties map[string]Property `json:"properties"`
 	GroupedBy  *GroupedBy          `json:"groupedBy"` // optional to support ungrouped aggregations (formerly meta)
 	Count      int                 `json:"count"`
+	Groups     []Group             `json:"groups,omitempty"` // nested groups of the next group by key
 }
 
 type Property struct {
@@ -32,8 +33,13 @@ type Property struct {
 }
 
 type Text struct {
-	Items []TextOccurrence `json:"items"`
-	Count int              `json:"count"`
+	Items       []TextOccurrence `json:"items"`
+	Count       int              `json:"count"`
+	Cardinality *int64           `json:"cardinality,omitempty"`
+
+	// CardinalitySketch is only used to merge the cardinality of shards and is
+	// removed before the results are returned
+	CardinalitySketch []byte `json:"_cardinalitySketch,omitempty"`
 }
 
 type PropertyType string
@@ -47,8 +53,9 @@ const (
 )
 
 type GroupedBy struct {
-	Value interface{} `json:"value"`
-	Path  []string    `json:"path"`
+	Value interface{}   `json:"value"`
+	Path  []string      `json:"path"`
+	Range *GroupByRange `json:"range,omitempty"` // bounds of a range group
 }
 
 type TextOccurrence struct {
@@ -67,3 +74,25 @@ type Boolean struct {
 type Reference struct {
 	PointingTo []string `json:"pointingTo"`
 }
+
+// Percentile is the estimated value at a quantile of a percentile
+// aggregation
+type Percentile struct {
+	Quantile float64 `json:"quantile"`
+	Value    float64 `json:"value"`
+}
+
+// HistogramBucket counts the numbers from From (inclusive) to To (exclusive)
+type HistogramBucket struct {
+	From  float64 `json:"from"`
+	To    float64 `json:"to"`
+	Count int64   `json:"count"`
+}
+
+// DateHistogramBucket counts the dates from From (inclusive) to To
+// (exclusive), both formatted as RFC3339
+type DateHistogramBucket struct {
+	From  string `json:"from"`
+	To    string `json:"to"`
+	Count int64  `json:"count"`
+}
@@ -0,0 +1,118 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package backup
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCronNext(t *testing.T) {
+	// a wednesday
+	from := time.Date(2024, 5, 15, 10, 30, 45, 0, time.UTC)
+	date := func(month time.Month, day, hour, min int) time.Time {
+		return time.Date(2024, month, day, hour, min, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		expr string
+		want time.Time
+	}{
+		{"* * * * *", date(5, 15, 10, 31)},
+		{"*/15 * * * *", date(5, 15, 10, 45)},
+		{"0 * * * *", date(5, 15, 11, 0)},
+		{"@hourly", date(5, 15, 11, 0)},
+		{"0 3 * * *", date(5, 16, 3, 0)},
+		{"@daily", date(5, 16, 0, 0)},
+		{"30 10 * * *", date(5, 16, 10, 30)},
+		{"0 0 * * 0", date(5, 19, 0, 0)},
+		{"0 0 * * 7", date(5, 19, 0, 0)},
+		{"0 0 * * 1-5", date(5, 16, 0, 0)},
+		{"0 0 1 * *", date(6, 1, 0, 0)},
+		{"0 0 1,15 * *", date(6, 1, 0, 0)},
+		{"0 0 31 * *", date(5, 31, 0, 0)},
+		// june has 30 days
+		{"0 0 31 6 *", time.Time{}},
+		// day of month or day of week
+		{"0 0 20 * 5", date(5, 17, 0, 0)},
+		{"0 12 29 2 *", time.Date(2028, 2, 29, 12, 0, 0, 0, time.UTC)},
+		{"0 0 30 2 *", time.Time{}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.expr, func(t *testing.T) {
+			c, err := ParseCron(tc.expr)
+			require.Nil(t, err)
+			assert.Equal(t, tc.want, c.Next(from))
+		})
+	}
+}
+
+func TestParseCronInvalid(t *testing.T) {
+	for _, expr := range []string{
+		"",
+		"* * * *",
+		"* * * * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"5-1 * * * *",
+		"*/0 * * * *",
+		"a * * * *",
+		"@often",
+	} {
+		_, err := ParseCron(expr)
+		assert.NotNil(t, err, expr)
+	}
+}
+
+func TestScheduleBackupID(t *testing.T) {
+	s := Schedule{Name: "nightly"}
+	at := time.Date(2024, 5, 15, 3, 0, 0, 0, time.UTC)
+
+	id := s.BackupID(at)
+	assert.Equal(t, "nightly-20240515030000", id)
+
+	got, ok := s.Owns(id)
+	assert.True(t, ok)
+	assert.Equal(t, at, got)
+
+	for _, id := range []string{"nightly", "nightly-", "nightly-x-20240515030000", "weekly-20240515030000", "nightly-2024051503000a"} {
+		_, ok := s.Owns(id)
+		assert.False(t, ok, id)
+	}
+}
+
+func TestScheduleDue(t *testing.T) {
+	created := time.Date(2024, 5, 15, 10, 30, 0, 0, time.UTC)
+	s := Schedule{Name: "hourly", Cron: "0 * * * *", CreatedAt: created}
+
+	_, due := s.Due(created.Add(29 * time.Minute))
+	assert.False(t, due)
+
+	at, due := s.Due(created.Add(30 * time.Minute))
+	assert.True(t, due)
+	assert.Equal(t, time.Date(2024, 5, 15, 11, 0, 0, 0, time.UTC), at)
+
+	// missed runs are only triggered once
+	at, due = s.Due(created.Add(5 * time.Hour))
+	assert.True(t, due)
+	assert.Equal(t, time.Date(2024, 5, 15, 15, 0, 0, 0, time.UTC), at)
+
+	s.LastRun = at
+	_, due = s.Due(at.Add(59 * time.Minute))
+	assert.False(t, due)
+}
@@ -35,6 +35,10 @@ type DistributedBackupDescriptor struct {
 	ServerVersion string                     `json:"serverVersion"`
 	Leader        string                     `json:"leader"`
 	Error         string                     `json:"error"`
+
+	// IncrementalBaseBackupID is the id of the backup this one builds upon.
+	// Following it recursively yields the whole chain needed for a restore.
+	IncrementalBaseBackupID string `json:"incrementalBaseBackupId,omitempty"`
 }
 
 // Len returns how many nodes exist in d
@@ -224,6 +228,30 @@ type ShardDescriptor struct {
 	ShardVersionPath      string `json:"shardVersionPath,omitempty"`
 	Version               []byte `json:"version,omitempty"`
 	Chunk                 int32  `json:"chunk"`
+
+	// FileInfos describes every file of the shard, whether it is stored in
+	// this backup or in a base backup. It allows later incremental backups
+	// to detect which files have not changed since.
+	FileInfos map[string]FileInfo `json:"fileInfos,omitempty"`
+	// BaseFiles are files which were not uploaded by this backup because
+	// they did not change since its base. It maps each of them to the id of
+	// the backup holding its content.
+	BaseFiles map[string]string `json:"baseFiles,omitempty"`
+
+	// HaltedAt is the time the shard was halted for the transfer. Commit logs
+	// archived before that time are part of the backed up files.
+	HaltedAt time.Time `json:"haltedAt"`
+}
+
+// FileInfo describes a shard file at the time it has been backed up
+type FileInfo struct {
+	Size    int64     `json:"size"`
+	ModTime time.Time `json:"modTime"`
+}
+
+// Unchanged returns true if both infos describe the same version of a file
+func (f FileInfo) Unchanged(other FileInfo) bool {
+	return f.Size == other.Size && f.ModTime.Equal(other.ModTime)
 }
 
 // ClearTemporary clears fields that are no longer needed once compression is done.
@@ -259,6 +287,22 @@ type BackupDescriptor struct {
 	Version       string            `json:"version"` //
 	ServerVersion string            `json:"serverVersion"`
 	Error         string            `json:"error"`
+
+	// IncrementalBaseBackupID is the id of the backup this one builds upon
+	IncrementalBaseBackupID string `json:"incrementalBaseBackupId,omitempty"`
+
+	// CompressionCodec used for chunks, empty for gzip
+	CompressionCodec string `json:"compressionCodec,omitempty"`
+	// Encryption is set if chunks are encrypted
+	Encryption *EncryptionDescriptor `json:"encryption,omitempty"`
+}
+
+// EncryptionDescriptor describes the envelope encryption of the files of a node.
+// Files are encrypted with a data key which is stored sealed by the key encryption key.
+type EncryptionDescriptor struct {
+	Algorithm  string `json:"algorithm"`
+	KeyID      string `json:"keyId"`      // fingerprint of the key encryption key
+	WrappedKey []byte `json:"wrappedKey"` // data key sealed with the key encryption key
 }
 
 // List all existing classes in d
@@ -380,6 +424,26 @@ func (d *BackupDescriptor) Validate(newSchema bool) error {
 	return nil
 }
 
+// GetClassDescriptor returns the descriptor of the class named className or nil if it does not exist
+func (d *BackupDescriptor) GetClassDescriptor(className string) *ClassDescriptor {
+	for i := range d.Classes {
+		if d.Classes[i].Name == className {
+			return &d.Classes[i]
+		}
+	}
+	return nil
+}
+
+// GetShardDescriptor returns the descriptor of the shard named shardName or nil if it does not exist
+func (c *ClassDescriptor) GetShardDescriptor(shardName string) *ShardDescriptor {
+	for _, s := range c.Shards {
+		if s.Name == shardName {
+			return s
+		}
+	}
+	return nil
+}
+
 // ToDistributed is used just for backward compatibility with the old version.
 func (d *BackupDescriptor) ToDistributed() *DistributedBackupDescriptor {
 	node, cs := "", d.List()
@@ -396,6 +460,8 @@ func (d *BackupDescriptor) ToDistributed() *DistributedBackupDescriptor {
 		Version:       d.Version,
 		ServerVersion: d.ServerVersion,
 		Error:         d.Error,
+
+		IncrementalBaseBackupID: d.IncrementalBaseBackupID,
 	}
 	if node != "" && len(cs) > 0 {
 		result.Nodes = map[string]*NodeDescriptor{node: {Classes: cs}}
@@ -0,0 +1,221 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package backup
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+)
+
+// LogArchiveDirectory is the directory, relative to the data path, in which
+// commit logs are collected until they are shipped alongside a backup.
+// Its layout is <class>/<shard>/<path of the log relative to the data path>.
+const LogArchiveDirectory = ".log-archive"
+
+// ArchivedLog describes a commit log shipped alongside a backup
+type ArchivedLog struct {
+	Class string `json:"class"`
+	Shard string `json:"shard"`
+	// Path of the log relative to the data path
+	Path string `json:"path"`
+	Size int64  `json:"size"`
+	// ArchivedAt is the time the log was completed, i.e. all of its entries
+	// have been written before.
+	ArchivedAt time.Time `json:"archivedAt"`
+	// Times is set if the times the entries have been written at are shipped
+	// along, so that the log can be replayed up to a point in time
+	Times bool `json:"times,omitempty"`
+}
+
+// LogArchiveDescriptor lists the commit logs a node shipped after taking
+// part in a backup. Replaying them on top of the backup restores the node
+// to any point in time until the last shipped log.
+type LogArchiveDescriptor struct {
+	ID        string        `json:"id"` // id of the backup the logs build upon
+	Node      string        `json:"node"`
+	Logs      []ArchivedLog `json:"logs"`
+	UpdatedAt time.Time     `json:"updatedAt"`
+
+	// ArchivedUntil is the time per class until which the entries of all logs
+	// of the class have been shipped. Logs completed later only partially
+	// cover the time after it.
+	ArchivedUntil map[string]time.Time `json:"archivedUntil,omitempty"`
+}
+
+// Add adds l to the archive, replacing a previous version of the same log
+func (d *LogArchiveDescriptor) Add(l ArchivedLog) {
+	for i := range d.Logs {
+		if d.Logs[i].Class == l.Class && d.Logs[i].Path == l.Path {
+			d.Logs[i] = l
+			return
+		}
+	}
+	d.Logs = append(d.Logs, l)
+}
+
+// Completed returns the logs of a shard completed after the time after,
+// ordered by completion
+func (d *LogArchiveDescriptor) Completed(class, shard string, after time.Time) []ArchivedLog {
+	var logs []ArchivedLog
+	for _, l := range d.Logs {
+		if l.Class == class && l.Shard == shard && l.ArchivedAt.After(after) {
+			logs = append(logs, l)
+		}
+	}
+	sort.SliceStable(logs, func(i, j int) bool { return logs[i].ArchivedAt.Before(logs[j].ArchivedAt) })
+	return logs
+}
+
+// archivePath is the path of the commit log at logPath in the log archive
+func archivePath(dataPath, class, shard, logPath string) (string, error) {
+	rel, err := filepath.Rel(dataPath, logPath)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dataPath, LogArchiveDirectory, class, shard, rel), nil
+}
+
+// LogTimesPath is the path in the log archive at which the times of the
+// entries of the commit log at logPath are recorded while it is written
+func LogTimesPath(dataPath, class, shard, logPath string) (string, error) {
+	dst, err := archivePath(dataPath, class, shard, logPath)
+	if err != nil {
+		return "", fmt.Errorf("log times of %s: %w", logPath, err)
+	}
+	if err := os.MkdirAll(filepath.Dir(dst), os.ModePerm); err != nil {
+		return "", fmt.Errorf("log times of %s: %w", logPath, err)
+	}
+	return dst + LogTimesSuffix + LogArchiveTmpSuffix, nil
+}
+
+// ArchiveLog hands over a completed commit log of a shard to the log archive
+// in dataPath, along with the times of its entries if they have been
+// recorded. The log is moved unless keep is set, in which case it is
+// linked, or copied if linking is not possible. The modification time of the
+// archived log is set to the time of archiving.
+func ArchiveLog(dataPath, class, shard, logPath string, keep bool) error {
+	dst, err := archivePath(dataPath, class, shard, logPath)
+	if err != nil {
+		return fmt.Errorf("archive log %s: %w", logPath, err)
+	}
+	if err := os.MkdirAll(filepath.Dir(dst), os.ModePerm); err != nil {
+		return fmt.Errorf("archive log %s: %w", logPath, err)
+	}
+
+	// the log becomes visible in the archive only once it is complete
+	tmp := dst + LogArchiveTmpSuffix
+	if !keep {
+		err = os.Rename(logPath, tmp)
+	} else if err = os.Link(logPath, tmp); err != nil {
+		err = copyFile(logPath, tmp)
+	}
+	if err != nil {
+		return fmt.Errorf("archive log %s: %w", logPath, err)
+	}
+	now := time.Now()
+	if err := os.Chtimes(tmp, now, now); err != nil {
+		return fmt.Errorf("archive log %s: %w", logPath, err)
+	}
+	// the times need to be in place once the log becomes visible
+	times := dst + LogTimesSuffix
+	if err := os.Rename(times+LogArchiveTmpSuffix, times); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("archive log %s: %w", logPath, err)
+	}
+	if err := os.Rename(tmp, dst); err != nil {
+		return fmt.Errorf("archive log %s: %w", logPath, err)
+	}
+	return nil
+}
+
+// ArchiveLogPrefix hands over the first size bytes of a commit log of a shard
+// which is still being written to the log archive in dataPath, along with the
+// times of these entries. Once the log is completed, it is archived again by
+// ArchiveLog, replacing the prefix.
+func ArchiveLogPrefix(dataPath, class, shard, logPath string, size int64) error {
+	dst, err := archivePath(dataPath, class, shard, logPath)
+	if err != nil {
+		return fmt.Errorf("archive log prefix %s: %w", logPath, err)
+	}
+	if err := os.MkdirAll(filepath.Dir(dst), os.ModePerm); err != nil {
+		return fmt.Errorf("archive log prefix %s: %w", logPath, err)
+	}
+
+	tmp := dst + LogArchiveTmpSuffix
+	if err := copyFilePrefix(logPath, tmp, size); err != nil {
+		return fmt.Errorf("archive log prefix %s: %w", logPath, err)
+	}
+	now := time.Now()
+	if err := os.Chtimes(tmp, now, now); err != nil {
+		return fmt.Errorf("archive log prefix %s: %w", logPath, err)
+	}
+	// the times are still being recorded at their temporary path
+	times := dst + LogTimesSuffix
+	timesTmp := times + logPrefixTmpSuffix + LogArchiveTmpSuffix
+	copied, err := copyLogTimes(times+LogArchiveTmpSuffix, timesTmp, size)
+	if err != nil {
+		return fmt.Errorf("archive log prefix %s: %w", logPath, err)
+	}
+	if copied {
+		if err := os.Rename(timesTmp, times); err != nil {
+			return fmt.Errorf("archive log prefix %s: %w", logPath, err)
+		}
+	}
+	if err := os.Rename(tmp, dst); err != nil {
+		return fmt.Errorf("archive log prefix %s: %w", logPath, err)
+	}
+	return nil
+}
+
+// LogArchiveTmpSuffix marks logs which are still being added to the archive
+const LogArchiveTmpSuffix = ".archiving"
+
+// logPrefixTmpSuffix tells the times of an archived log prefix apart from the
+// times still being recorded for the log
+const logPrefixTmpSuffix = ".prefix"
+
+func copyFile(src, dst string) error {
+	r, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+	w, err := os.OpenFile(dst, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o666)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(w, r); err != nil {
+		w.Close()
+		return err
+	}
+	return w.Close()
+}
+
+func copyFilePrefix(src, dst string, size int64) error {
+	r, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+	w, err := os.OpenFile(dst, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o666)
+	if err != nil {
+		return err
+	}
+	if _, err := io.CopyN(w, r, size); err != nil {
+		w.Close()
+		return err
+	}
+	return w.Close()
+}
@@ -0,0 +1,197 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package backup
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+)
+
+// LogTimesSuffix is appended to the path of an archived commit log to name
+// the file recording the times its entries have been written at
+const LogTimesSuffix = ".times"
+
+// log times record format
+// -------------------------------------------
+// | end offset of the entry (8 bytes)       |
+// | unix time of the write in ns (8 bytes)  |
+// -------------------------------------------
+const logTimesRecordSize = 16
+
+// LogTimes records the time at which each entry of a commit log has been
+// written, so that replaying the log can stop at a point in time.
+type LogTimes struct {
+	path   string
+	file   *os.File
+	writer *bufio.Writer
+	buf    [logTimesRecordSize]byte
+}
+
+// OpenLogTimes opens the log times stored at path, appending to existing
+// ones. The file is only created once the first time is recorded, so that
+// there are no times of empty logs.
+func OpenLogTimes(path string) (*LogTimes, error) {
+	if _, err := os.Stat(path); err != nil {
+		if os.IsNotExist(err) {
+			return &LogTimes{path: path}, nil
+		}
+		return nil, fmt.Errorf("open log times %s: %w", path, err)
+	}
+	lt := &LogTimes{path: path}
+	return lt, lt.open()
+}
+
+func (lt *LogTimes) open() error {
+	f, err := os.OpenFile(lt.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o666)
+	if err != nil {
+		return fmt.Errorf("open log times %s: %w", lt.path, err)
+	}
+	lt.file, lt.writer = f, bufio.NewWriter(f)
+	return nil
+}
+
+// Record records that the entry of the log ending at offset end has been
+// written at t
+func (lt *LogTimes) Record(end int64, t time.Time) error {
+	if lt.file == nil {
+		if err := lt.open(); err != nil {
+			return err
+		}
+	}
+	binary.LittleEndian.PutUint64(lt.buf[:8], uint64(end))
+	binary.LittleEndian.PutUint64(lt.buf[8:], uint64(t.UnixNano()))
+	_, err := lt.writer.Write(lt.buf[:])
+	return err
+}
+
+func (lt *LogTimes) Flush() error {
+	if lt.file == nil {
+		return nil
+	}
+	return lt.writer.Flush()
+}
+
+func (lt *LogTimes) Close() error {
+	if lt.file == nil {
+		return nil
+	}
+	if err := lt.writer.Flush(); err != nil {
+		lt.file.Close()
+		return err
+	}
+	return lt.file.Close()
+}
+
+// copyLogTimes copies the times recorded at src of the entries ending within
+// the first size bytes of the log to dst. It reports false if no times have
+// been recorded.
+func copyLogTimes(src, dst string, size int64) (bool, error) {
+	r, err := os.Open(src)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	defer r.Close()
+	f, err := os.OpenFile(dst, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o666)
+	if err != nil {
+		return false, err
+	}
+
+	var (
+		br  = bufio.NewReader(r)
+		w   = bufio.NewWriter(f)
+		buf [logTimesRecordSize]byte
+	)
+	for {
+		if _, err := io.ReadFull(br, buf[:]); err != nil {
+			if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+				break // a partially written record is ignored
+			}
+			f.Close()
+			return false, err
+		}
+		if int64(binary.LittleEndian.Uint64(buf[:8])) > size {
+			break
+		}
+		if _, err := w.Write(buf[:]); err != nil {
+			f.Close()
+			return false, err
+		}
+	}
+	if err := w.Flush(); err != nil {
+		f.Close()
+		return false, err
+	}
+	return true, f.Close()
+}
+
+// CutLog truncates the commit log at logPath after the last entry written
+// until the given time, as recorded by the log times at timesPath. Entries
+// without a recorded time, which can be the last ones after a crash, are
+// only kept if the log has been completed until then. The log is removed if
+// no entry remains.
+func CutLog(logPath, timesPath string, until, completedAt time.Time) error {
+	info, err := os.Stat(logPath)
+	if err != nil {
+		return fmt.Errorf("cut log %s: %w", logPath, err)
+	}
+	size := info.Size()
+
+	f, err := os.Open(timesPath)
+	if err != nil {
+		return fmt.Errorf("cut log %s: %w", logPath, err)
+	}
+	defer f.Close()
+
+	var (
+		r     = bufio.NewReader(f)
+		buf   [logTimesRecordSize]byte
+		cut   int64
+		after bool // an entry has been written after until
+	)
+	for {
+		if _, err := io.ReadFull(r, buf[:]); err != nil {
+			if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+				break // a partially written record is ignored
+			}
+			return fmt.Errorf("cut log %s: read times: %w", logPath, err)
+		}
+		end := int64(binary.LittleEndian.Uint64(buf[:8]))
+		at := time.Unix(0, int64(binary.LittleEndian.Uint64(buf[8:])))
+		if at.After(until) {
+			after = true
+			break
+		}
+		cut = end
+	}
+	if !after && !completedAt.After(until) {
+		cut = size
+	}
+
+	switch {
+	case cut <= 0:
+		err = os.Remove(logPath)
+	case cut < size:
+		err = os.Truncate(logPath, cut)
+	}
+	if err != nil {
+		return fmt.Errorf("cut log %s: %w", logPath, err)
+	}
+	return nil
+}
@@ -0,0 +1,127 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package backup
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCutLog(t *testing.T) {
+	var (
+		t0    = time.Date(2024, 5, 15, 10, 0, 0, 0, time.UTC)
+		entry = func(i int) time.Time { return t0.Add(time.Duration(i) * time.Minute) }
+	)
+	// three entries of 4 bytes written a minute apart, the times of the last
+	// one have not been recorded before a crash
+	write := func(t *testing.T, recorded int) (string, string) {
+		dir := t.TempDir()
+		logPath, timesPath := filepath.Join(dir, "log"), filepath.Join(dir, "log"+LogTimesSuffix)
+		require.Nil(t, os.WriteFile(logPath, []byte("aaaabbbbcccc"), 0o666))
+		times, err := OpenLogTimes(timesPath)
+		require.Nil(t, err)
+		for i := 0; i < recorded; i++ {
+			require.Nil(t, times.Record(int64(4*(i+1)), entry(i)))
+		}
+		require.Nil(t, times.Close())
+		return logPath, timesPath
+	}
+
+	tests := []struct {
+		name        string
+		recorded    int
+		until       time.Time
+		completedAt time.Time
+		want        string // empty if the log is removed
+	}{
+		{"cut between entries", 3, entry(1).Add(time.Second), entry(2), "aaaabbbb"},
+		{"entry at point in time is kept", 3, entry(1), entry(2), "aaaabbbb"},
+		{"all entries after point in time", 3, entry(-1), entry(2), ""},
+		{"all entries before point in time", 3, entry(3), entry(3), "aaaabbbbcccc"},
+		{"unrecorded entry of log completed later", 2, entry(3), entry(4), "aaaabbbb"},
+		{"unrecorded entry of log completed before", 2, entry(3), entry(2), "aaaabbbbcccc"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			logPath, timesPath := write(t, tc.recorded)
+			require.Nil(t, CutLog(logPath, timesPath, tc.until, tc.completedAt))
+
+			b, err := os.ReadFile(logPath)
+			if tc.want == "" {
+				assert.True(t, os.IsNotExist(err))
+				return
+			}
+			require.Nil(t, err)
+			assert.Equal(t, tc.want, string(b))
+		})
+	}
+}
+
+func TestArchiveLogPrefix(t *testing.T) {
+	var (
+		dataPath = t.TempDir()
+		logPath  = filepath.Join(dataPath, "class", "shard", "lsm", "objects", "segment-1.wal")
+		t0       = time.Date(2024, 5, 15, 10, 0, 0, 0, time.UTC)
+	)
+	require.Nil(t, os.MkdirAll(filepath.Dir(logPath), os.ModePerm))
+	timesPath, err := LogTimesPath(dataPath, "Class", "shard", logPath)
+	require.Nil(t, err)
+	times, err := OpenLogTimes(timesPath)
+	require.Nil(t, err)
+
+	// two entries of 4 bytes have been written when the prefix is archived,
+	// a third one is written meanwhile
+	require.Nil(t, os.WriteFile(logPath, []byte("aaaabbbbcccc"), 0o666))
+	for i := 0; i < 3; i++ {
+		require.Nil(t, times.Record(int64(4*(i+1)), t0.Add(time.Duration(i)*time.Minute)))
+	}
+	require.Nil(t, times.Flush())
+	require.Nil(t, ArchiveLogPrefix(dataPath, "Class", "shard", logPath, 8))
+
+	dst, err := archivePath(dataPath, "Class", "shard", logPath)
+	require.Nil(t, err)
+	t.Run("prefix is archived", func(t *testing.T) {
+		b, err := os.ReadFile(dst)
+		require.Nil(t, err)
+		assert.Equal(t, "aaaabbbb", string(b))
+	})
+
+	t.Run("times of the prefix are archived along", func(t *testing.T) {
+		info, err := os.Stat(dst + LogTimesSuffix)
+		require.Nil(t, err)
+		assert.Equal(t, int64(2*logTimesRecordSize), info.Size())
+	})
+
+	t.Run("log is still being written", func(t *testing.T) {
+		b, err := os.ReadFile(logPath)
+		require.Nil(t, err)
+		assert.Equal(t, "aaaabbbbcccc", string(b))
+		require.Nil(t, os.WriteFile(logPath, []byte("aaaabbbbccccdddd"), 0o666))
+		require.Nil(t, times.Record(16, t0.Add(3*time.Minute)))
+		require.Nil(t, times.Close())
+	})
+
+	t.Run("completed log replaces the prefix", func(t *testing.T) {
+		require.Nil(t, ArchiveLog(dataPath, "Class", "shard", logPath, false))
+		b, err := os.ReadFile(dst)
+		require.Nil(t, err)
+		assert.Equal(t, "aaaabbbbccccdddd", string(b))
+		info, err := os.Stat(dst + LogTimesSuffix)
+		require.Nil(t, err)
+		assert.Equal(t, int64(4*logTimesRecordSize), info.Size())
+	})
+}
@@ -0,0 +1,116 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package backup
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// scheduleIDLayout is the layout of the timestamp appended to the
+// schedule name to form the ID of a scheduled backup
+const scheduleIDLayout = "20060102150405"
+
+// Schedule describes a recurring backup. Schedules are part of the
+// replicated cluster state and triggered by the cluster leader.
+type Schedule struct {
+	Name      string    `json:"name"`
+	Cron      string    `json:"cron"`
+	Backend   string    `json:"backend"`
+	Include   []string  `json:"include,omitempty"`
+	Exclude   []string  `json:"exclude,omitempty"`
+	Retention Retention `json:"retention"`
+
+	// CreatedAt is the time the schedule has been created. It is not
+	// triggered for times before it.
+	CreatedAt time.Time `json:"createdAt"`
+	// LastRun is the time the schedule has been triggered last
+	LastRun time.Time `json:"lastRun,omitempty"`
+}
+
+// Retention tells which backups created by a schedule are kept.
+// A backup is kept if it satisfies any of the non-zero constraints.
+// A zero Retention keeps all backups.
+type Retention struct {
+	// KeepLast is the number of most recent successful backups to keep
+	KeepLast int `json:"keepLast,omitempty"`
+	// KeepDays is the number of days backups are kept
+	KeepDays int `json:"keepDays,omitempty"`
+}
+
+// IsZero returns true if no retention constraint is set
+func (r Retention) IsZero() bool {
+	return r.KeepLast == 0 && r.KeepDays == 0
+}
+
+// Validate validates the schedule without checking its backend
+func (s *Schedule) Validate() error {
+	if s.Name == "" {
+		return fmt.Errorf("schedule name is required")
+	}
+	for _, c := range s.Name {
+		if !(c >= 'a' && c <= 'z' || c >= '0' && c <= '9' || c == '_' || c == '-') {
+			return fmt.Errorf("schedule name %q must only contain lowercase letters, digits, '_' and '-'", s.Name)
+		}
+	}
+	if s.Backend == "" {
+		return fmt.Errorf("schedule backend is required")
+	}
+	if len(s.Include) > 0 && len(s.Exclude) > 0 {
+		return fmt.Errorf("'include' and 'exclude' cannot both contain values")
+	}
+	if s.Retention.KeepLast < 0 || s.Retention.KeepDays < 0 {
+		return fmt.Errorf("retention values must not be negative")
+	}
+	if _, err := ParseCron(s.Cron); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Due returns the latest time the schedule should have been triggered at
+// and which is not later than now. It returns false if the schedule is not due.
+func (s *Schedule) Due(now time.Time) (time.Time, bool) {
+	c, err := ParseCron(s.Cron)
+	if err != nil {
+		return time.Time{}, false
+	}
+	from := s.LastRun
+	if from.Before(s.CreatedAt) {
+		from = s.CreatedAt
+	}
+	var due time.Time
+	for next := c.Next(from); !next.IsZero() && !next.After(now); next = c.Next(next) {
+		due = next
+	}
+	return due, !due.IsZero()
+}
+
+// BackupID returns the ID of the backup created by the schedule at time t
+func (s *Schedule) BackupID(t time.Time) string {
+	return s.Name + "-" + t.UTC().Format(scheduleIDLayout)
+}
+
+// Owns reports whether the backup id has been created by the schedule
+// and returns the time it was triggered at
+func (s *Schedule) Owns(id string) (time.Time, bool) {
+	ts, ok := strings.CutPrefix(id, s.Name+"-")
+	if !ok || len(ts) != len(scheduleIDLayout) {
+		return time.Time{}, false
+	}
+	t, err := time.Parse(scheduleIDLayout, ts)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return t, true
+}
@@ -63,6 +63,7 @@ func Prop(p *models.Property) *models.Property {
 		ModuleConfig:      p.ModuleConfig,
 		Name:              p.Name,
 		Tokenization:      p.Tokenization,
+		Analyzer:          p.Analyzer,
 		IndexFilterable:   ptrBoolCopy(p.IndexFilterable),
 		IndexSearchable:   ptrBoolCopy(p.IndexSearchable),
 		IndexRangeFilters: ptrBoolCopy(p.IndexRangeFilters),
@@ -92,12 +93,70 @@ func InvertedIndexConfig(i *models.InvertedIndexConfig) *models.InvertedIndexCon
 		stopwords = &models.StopwordConfig{Additions: i.Stopwords.Additions, Preset: i.Stopwords.Preset, Removals: i.Stopwords.Removals}
 	}
 
+	var synonyms *models.SynonymConfig = nil
+	if i.Synonyms != nil {
+		synonyms = &models.SynonymConfig{Boost: i.Synonyms.Boost, Groups: append([]string(nil), i.Synonyms.Groups...)}
+	}
+
+	var analyzers []*models.TextAnalyzerConfig
+	for _, analyzer := range i.Analyzers {
+		analyzers = append(analyzers, TextAnalyzerConfig(analyzer))
+	}
+
 	return &models.InvertedIndexConfig{
+		Analyzers:              analyzers,
 		Bm25:                   bm25,
 		CleanupIntervalSeconds: i.CleanupIntervalSeconds,
 		IndexNullState:         i.IndexNullState,
+		IndexPositions:         append([]string(nil), i.IndexPositions...),
 		IndexPropertyLength:    i.IndexPropertyLength,
 		IndexTimestamps:        i.IndexTimestamps,
 		Stopwords:              stopwords,
+		Synonyms:               synonyms,
+	}
+}
+
+func TextAnalyzerConfig(a *models.TextAnalyzerConfig) *models.TextAnalyzerConfig {
+	if a == nil {
+		return nil
+	}
+
+	var charFilters []*models.TextAnalyzerCharFilter
+	for _, cf := range a.CharFilters {
+		if cf == nil {
+			continue
+		}
+		var mappings map[string]string
+		if cf.Mappings != nil {
+			mappings = make(map[string]string, len(cf.Mappings))
+			for k, v := range cf.Mappings {
+				mappings[k] = v
+			}
+		}
+		charFilters = append(charFilters, &models.TextAnalyzerCharFilter{
+			Type: cf.Type, Mappings: mappings, Pattern: cf.Pattern, Replacement: cf.Replacement,
+		})
+	}
+
+	var tokenFilters []*models.TextAnalyzerTokenFilter
+	for _, tf := range a.TokenFilters {
+		if tf == nil {
+			continue
+		}
+		tokenFilters = append(tokenFilters, &models.TextAnalyzerTokenFilter{
+			Type:      tf.Type,
+			Language:  tf.Language,
+			Stopwords: append([]string(nil), tf.Stopwords...),
+			Synonyms:  append([]string(nil), tf.Synonyms...),
+			MinGram:   tf.MinGram,
+			MaxGram:   tf.MaxGram,
+		})
+	}
+
+	return &models.TextAnalyzerConfig{
+		Name:         a.Name,
+		CharFilters:  charFilters,
+		Tokenizer:    a.Tokenizer,
+		TokenFilters: tokenFilters,
 	}
 }
@@ -13,6 +13,7 @@ package dto
 
 import (
 	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/facets"
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/search"
 	"github.com/weaviate/weaviate/entities/searchparams"
@@ -50,10 +51,12 @@ type GetParams struct {
 	Sort                    []filters.Sort
 	Properties              search.SelectProperties
 	NearVector              *searchparams.NearVector
+	NearVectorBatch         *searchparams.NearVectorBatch
 	NearObject              *searchparams.NearObject
 	KeywordRanking          *searchparams.KeywordRanking
 	HybridSearch            *searchparams.HybridSearch
 	GroupBy                 *searchparams.GroupBy
+	Facets                  []facets.Facet
 	TargetVector            string
 	TargetVectorCombination *TargetCombination
 	Group                   *GroupParams
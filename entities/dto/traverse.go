@@ -0,0 +1,109 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package dto
+
+import (
+	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/entities/filters"
+)
+
+// TraverseParams describes a breadth-first expansion over the
+// cross-reference properties of a class starting at StartIDs
+type TraverseParams struct {
+	ClassName string
+	StartIDs  []strfmt.UUID
+	// Tenant is used for all multi-tenant classes reached by the traversal,
+	// classes without multi-tenancy are queried without tenant
+	Tenant string
+	// Hops configures the expansion at each depth. Hop i is used to expand
+	// the nodes at depth i, the last hop is reused for all further depths.
+	// Without hops all reference properties are followed unfiltered.
+	Hops []TraverseHop
+	// MaxDepth is the number of hops to expand
+	MaxDepth int
+	// MaxFanOut limits the number of references followed per node and
+	// property, 0 means no limit
+	MaxFanOut int
+	// MaxNodes limits the number of nodes returned, 0 means no limit
+	MaxNodes int
+	// TargetID turns the traversal into a shortest path search. The
+	// traversal stops as soon as the target is reached.
+	TargetID strfmt.UUID
+}
+
+// TraverseHop configures a single expansion step
+type TraverseHop struct {
+	// Properties are the reference properties to follow, all reference
+	// properties are followed if empty
+	Properties []string
+	// ClassName restricts the nodes reached in this hop to a single class.
+	// It is required if Filters are set.
+	ClassName string
+	// Filters must be matched by the nodes reached in this hop
+	Filters *filters.LocalFilter
+}
+
+// Hop returns the hop used to expand nodes at the given depth
+func (p TraverseParams) Hop(depth int) TraverseHop {
+	if len(p.Hops) == 0 {
+		return TraverseHop{}
+	}
+	return p.Hops[min(depth, len(p.Hops)-1)]
+}
+
+// Follows returns true if the reference property prop is followed in this hop
+func (h TraverseHop) Follows(prop string) bool {
+	if len(h.Properties) == 0 {
+		return true
+	}
+	for _, p := range h.Properties {
+		if p == prop {
+			return true
+		}
+	}
+	return false
+}
+
+// Reaches returns true if nodes of class className can be reached in this hop
+func (h TraverseHop) Reaches(className string) bool {
+	return h.ClassName == "" || h.ClassName == className
+}
+
+// TraverseResult is the subgraph visited by a traversal
+type TraverseResult struct {
+	Nodes []TraverseNode
+	Edges []TraverseEdge
+	// Path holds the IDs of the nodes on the shortest path from a start
+	// node to TraverseParams.TargetID. It is empty if the target was not
+	// reached or no target was given.
+	Path []strfmt.UUID
+	// Truncated is set if nodes or references have been skipped because of
+	// the fan-out or node limits
+	Truncated bool
+}
+
+// TraverseNode is an object reached by a traversal
+type TraverseNode struct {
+	ClassName  string
+	ID         strfmt.UUID
+	Depth      int
+	Properties map[string]interface{}
+}
+
+// TraverseEdge is a followed reference between two nodes of the result
+type TraverseEdge struct {
+	FromClass string
+	From      strfmt.UUID
+	Property  string
+	ToClass   string
+	To        strfmt.UUID
+}
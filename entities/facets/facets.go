@@ -0,0 +1,183 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package facets counts the objects per value of a property among all
+// objects matching a search, not only among the returned page of results.
+package facets
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/weaviate/weaviate/entities/aggregation"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/searchparams"
+)
+
+// DefaultLimit is the number of values returned per facet if it doesn't set
+// a limit
+const DefaultLimit = 10
+
+// Facet counts the objects per value of a property. Text properties need
+// field tokenization, so that whole values are counted rather than tokens.
+type Facet struct {
+	Property string `json:"property"`
+	// Limit is the number of values with the most objects, it is ignored on
+	// ranges
+	Limit int `json:"limit,omitempty"`
+	// Ranges counts the objects of a numerical property per range instead of
+	// per value. The ranges may overlap.
+	Ranges []aggregation.GroupByRange `json:"ranges,omitempty"`
+}
+
+func (f Facet) Validate() error {
+	if f.Property == "" {
+		return fmt.Errorf("facet: a property is required")
+	}
+	if f.Limit < 0 {
+		return fmt.Errorf("facet %s: limit must not be negative, got %d", f.Property, f.Limit)
+	}
+	for _, r := range f.Ranges {
+		if r.From == nil && r.To == nil {
+			return fmt.Errorf("facet %s: range needs a from or to value", f.Property)
+		}
+		if r.From != nil && r.To != nil && !(*r.From < *r.To) {
+			return fmt.Errorf("facet %s: range from %v must be lower than to %v", f.Property, *r.From, *r.To)
+		}
+	}
+	return nil
+}
+
+func (f Facet) limit() int {
+	if f.Limit > 0 {
+		return f.Limit
+	}
+	return DefaultLimit
+}
+
+// ShardLimit is the number of values with the most objects a shard returns.
+// It is larger than the limit, so that a value which is among the top values
+// overall, but not on every shard, is still counted on most of them.
+func (f Facet) ShardLimit() int {
+	limit := f.limit()
+	return limit + limit/2 + 10
+}
+
+// Params select the candidates the facets are counted on: all objects
+// matching the filters, narrowed down to the objects containing at least one
+// of the query terms of a bm25 KeywordRanking. Vector searches rank rather
+// than exclude objects, so they don't narrow down the candidates.
+type Params struct {
+	ClassName      schema.ClassName             `json:"className"`
+	Tenant         string                       `json:"tenant"`
+	Filters        *filters.LocalFilter         `json:"filters"`
+	KeywordRanking *searchparams.KeywordRanking `json:"keywordRanking"`
+	Facets         []Facet                      `json:"facets"`
+}
+
+// Result holds the counts of a facet. Values are ordered by their count, the
+// values of ranges are in the order of the ranges.
+type Result struct {
+	Property string  `json:"property"`
+	Values   []Value `json:"values"`
+}
+
+// Value is the number of candidates with a value of the property, or within
+// a range of the property. Values are strings, numbers (float64) or booleans,
+// dates are formatted as RFC3339.
+type Value struct {
+	Value interface{} `json:"value,omitempty"`
+	From  *float64    `json:"from,omitempty"`
+	To    *float64    `json:"to,omitempty"`
+	Count int64       `json:"count"`
+}
+
+// Merge sums up the counts of the facets of several shards. Shards only
+// return their top values, see Facet.ShardLimit, so the count of a value
+// misses the shards on which it was not among the top values. The counts of
+// ranges are exact.
+func Merge(facets []Facet, shards [][]Result) []Result {
+	out := make([]Result, len(facets))
+	for i, facet := range facets {
+		out[i].Property = facet.Property
+		if len(facet.Ranges) > 0 {
+			out[i].Values = make([]Value, len(facet.Ranges))
+			for j, r := range facet.Ranges {
+				out[i].Values[j] = Value{From: r.From, To: r.To}
+			}
+		}
+
+		counts := map[interface{}]int64{}
+		for _, shard := range shards {
+			if i >= len(shard) {
+				continue
+			}
+			for j, value := range shard[i].Values {
+				if len(facet.Ranges) > 0 {
+					if j < len(out[i].Values) {
+						out[i].Values[j].Count += value.Count
+					}
+					continue
+				}
+				counts[value.Value] += value.Count
+			}
+		}
+
+		if len(facet.Ranges) == 0 {
+			out[i].Values = topValues(counts, facet.limit())
+		}
+	}
+	return out
+}
+
+func topValues(counts map[interface{}]int64, limit int) []Value {
+	values := make([]Value, 0, len(counts))
+	for value, count := range counts {
+		if count > 0 {
+			values = append(values, Value{Value: value, Count: count})
+		}
+	}
+	return Top(values, limit)
+}
+
+// Top orders the values by their count and keeps the limit first ones
+func Top(values []Value, limit int) []Value {
+	sort.Slice(values, func(a, b int) bool {
+		if values[a].Count != values[b].Count {
+			return values[a].Count > values[b].Count
+		}
+		return lessValue(values[a].Value, values[b].Value)
+	})
+
+	if len(values) > limit {
+		values = values[:limit]
+	}
+	return values
+}
+
+// lessValue orders values of the same type, which all values of a property
+// are, to break ties between equal counts
+func lessValue(a, b interface{}) bool {
+	switch av := a.(type) {
+	case string:
+		bv, _ := b.(string)
+		return av < bv
+	case float64:
+		bv, _ := b.(float64)
+		return av < bv
+	case bool:
+		bv, _ := b.(bool)
+		return !av && bv
+	default:
+		return fmt.Sprint(a) < fmt.Sprint(b)
+	}
+}
@@ -0,0 +1,78 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package facets
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/entities/aggregation"
+)
+
+func TestMerge(t *testing.T) {
+	ptFloat64 := func(in float64) *float64 { return &in }
+
+	t.Run("values are summed up, ordered by count and limited", func(t *testing.T) {
+		facets := []Facet{{Property: "brand", Limit: 2}, {Property: "inStock"}}
+		shards := [][]Result{
+			{
+				{Property: "brand", Values: []Value{{Value: "acme", Count: 2}, {Value: "globex", Count: 3}}},
+				{Property: "inStock", Values: []Value{{Value: true, Count: 4}}},
+			},
+			{
+				{Property: "brand", Values: []Value{{Value: "acme", Count: 2}, {Value: "initech", Count: 1}}},
+				{Property: "inStock", Values: []Value{{Value: false, Count: 4}}},
+			},
+		}
+
+		assert.Equal(t, []Result{
+			{Property: "brand", Values: []Value{{Value: "acme", Count: 4}, {Value: "globex", Count: 3}}},
+			{Property: "inStock", Values: []Value{{Value: false, Count: 4}, {Value: true, Count: 4}}},
+		}, Merge(facets, shards))
+	})
+
+	t.Run("ranges keep their order and empty ranges", func(t *testing.T) {
+		ranges := []aggregation.GroupByRange{{To: ptFloat64(10)}, {From: ptFloat64(10), To: ptFloat64(20)}, {From: ptFloat64(20)}}
+		facets := []Facet{{Property: "price", Ranges: ranges}}
+		shards := [][]Result{
+			{{Property: "price", Values: []Value{{Count: 1}, {Count: 0}, {Count: 5}}}},
+			{{Property: "price", Values: []Value{{Count: 2}, {Count: 0}, {Count: 1}}}},
+		}
+
+		assert.Equal(t, []Result{{Property: "price", Values: []Value{
+			{To: ptFloat64(10), Count: 3},
+			{From: ptFloat64(10), To: ptFloat64(20), Count: 0},
+			{From: ptFloat64(20), Count: 6},
+		}}}, Merge(facets, shards))
+	})
+
+	t.Run("without shards", func(t *testing.T) {
+		assert.Equal(t, []Result{{Property: "brand", Values: []Value{}}},
+			Merge([]Facet{{Property: "brand"}}, nil))
+	})
+}
+
+func TestTop(t *testing.T) {
+	values := []Value{
+		{Value: "globex", Count: 1}, {Value: "acme", Count: 3}, {Value: "initech", Count: 3}, {Value: "hooli", Count: 2},
+	}
+
+	assert.Equal(t, []Value{{Value: "acme", Count: 3}, {Value: "initech", Count: 3}, {Value: "hooli", Count: 2}},
+		Top(values, 3))
+	assert.Len(t, Top(values, 10), 4)
+}
+
+func TestShardLimit(t *testing.T) {
+	assert.Equal(t, 25, Facet{Property: "brand"}.ShardLimit())
+	assert.Equal(t, 11, Facet{Property: "brand", Limit: 1}.ShardLimit())
+	assert.Equal(t, 160, Facet{Property: "brand", Limit: 100}.ShardLimit())
+}
@@ -34,6 +34,8 @@ const (
 	OperatorIsNull
 	ContainsAny
 	ContainsAll
+	OperatorPrefix
+	OperatorRegex
 )
 
 func (o Operator) OnValue() bool {
@@ -48,7 +50,9 @@ func (o Operator) OnValue() bool {
 		OperatorLike,
 		OperatorIsNull,
 		ContainsAny,
-		ContainsAll:
+		ContainsAll,
+		OperatorPrefix,
+		OperatorRegex:
 		return true
 	default:
 		return false
@@ -83,6 +87,10 @@ func (o Operator) Name() string {
 		return "ContainsAny"
 	case ContainsAll:
 		return "ContainsAll"
+	case OperatorPrefix:
+		return "Prefix"
+	case OperatorRegex:
+		return "Regex"
 	default:
 		panic("Unknown operator")
 	}
@@ -33,6 +33,8 @@ func TestOperators(t *testing.T) {
 		{op: OperatorLessThan, expectedName: "LessThan", expectedOnValue: true},
 		{op: OperatorWithinGeoRange, expectedName: "WithinGeoRange", expectedOnValue: true},
 		{op: OperatorLike, expectedName: "Like", expectedOnValue: true},
+		{op: OperatorPrefix, expectedName: "Prefix", expectedOnValue: true},
+		{op: OperatorRegex, expectedName: "Regex", expectedOnValue: true},
 		{op: OperatorAnd, expectedName: "And", expectedOnValue: false},
 		{op: OperatorOr, expectedName: "Or", expectedOnValue: false},
 	}
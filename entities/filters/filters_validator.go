@@ -13,6 +13,7 @@ package filters
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -117,6 +118,10 @@ func validateClause(authorizedGetClass func(string) (*models.Class, error), cw *
 		return validateUUIDType(propName, cw)
 	}
 
+	if op := cw.getOperator(); op == OperatorPrefix || op == OperatorRegex {
+		return validateTermOperator(prop, cw)
+	}
+
 	if schema.IsRefDataType(prop.DataType) {
 		// bit of an edge case, directly on refs (i.e. not on a primitive prop of a
 		// ref) we only allow valueInt which is what's used to count references
@@ -216,6 +221,42 @@ func validateUUIDOperators(propName schema.PropertyName, cw *clauseWrapper) erro
 	}
 }
 
+// validateTermOperator validates operators which match individual terms of
+// the inverted index, so they require a text prop with a tokenization whose
+// terms can be matched by their beginning
+func validateTermOperator(prop *models.Property, cw *clauseWrapper) error {
+	op := cw.getOperator()
+
+	dt := schema.DataType(prop.DataType[0])
+	if dt != schema.DataTypeText && dt != schema.DataTypeTextArray {
+		return errors.Errorf("operator %q can only be used on text/text[] props, "+
+			"prop %q is of type %q", op.Name(), prop.Name, dt)
+	}
+	if !cw.isType(schema.DataTypeText) {
+		return errors.Errorf("operator %q requires %q, got %q instead",
+			op.Name(), valueNameFromDataType(schema.DataTypeText), cw.getValueNameFromType())
+	}
+
+	switch prop.Tokenization {
+	case models.PropertyTokenizationWord, models.PropertyTokenizationLowercase,
+		models.PropertyTokenizationWhitespace, models.PropertyTokenizationField:
+	default:
+		return errors.Errorf("operator %q cannot be used on prop %q with tokenization %q",
+			op.Name(), prop.Name, prop.Tokenization)
+	}
+
+	if op == OperatorRegex {
+		pattern, ok := cw.getValue().(string)
+		if !ok {
+			return errors.Errorf("operator %q requires a string pattern, got %T", op.Name(), cw.getValue())
+		}
+		if _, err := regexp.Compile(pattern); err != nil {
+			return errors.Wrapf(err, "operator %q", op.Name())
+		}
+	}
+	return nil
+}
+
 type clauseWrapper struct {
 	clause    *Clause
 	origType  schema.DataType
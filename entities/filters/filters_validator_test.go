@@ -320,3 +320,53 @@ func TestClauseWrapper(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateTermOperators(t *testing.T) {
+	tests := []struct {
+		name     string
+		prop     string
+		operator Operator
+		value    interface{}
+		dataType schema.DataType
+		valid    bool
+	}{
+		{name: "prefix on word", prop: "word", operator: OperatorPrefix, value: "foo", dataType: schema.DataTypeText, valid: true},
+		{name: "prefix on field array", prop: "fields", operator: OperatorPrefix, value: "foo", dataType: schema.DataTypeText, valid: true},
+		{name: "regex on whitespace", prop: "whitespace", operator: OperatorRegex, value: "fo+.*", dataType: schema.DataTypeText, valid: true},
+		{name: "prefix on deprecated string", prop: "word", operator: OperatorPrefix, value: "foo", dataType: schema.DataTypeString, valid: true},
+		{name: "invalid regex", prop: "word", operator: OperatorRegex, value: "fo(o", dataType: schema.DataTypeText, valid: false},
+		{name: "trigram tokenization", prop: "trigram", operator: OperatorPrefix, value: "foo", dataType: schema.DataTypeText, valid: false},
+		{name: "int prop", prop: "number", operator: OperatorPrefix, value: 1, dataType: schema.DataTypeInt, valid: false},
+		{name: "int value", prop: "word", operator: OperatorRegex, value: 1, dataType: schema.DataTypeInt, valid: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cl := Clause{
+				Operator: tt.operator,
+				Value:    &Value{Value: tt.value, Type: tt.dataType},
+				On:       &Path{Class: "Car", Property: schema.PropertyName(tt.prop)},
+			}
+
+			f := &fakeFinder{}
+			f.On("ReadOnlyClass", mock.Anything).Return(
+				&models.Class{
+					Class: "Car",
+					Properties: []*models.Property{
+						{Name: "word", DataType: schema.DataTypeText.PropString(), Tokenization: models.PropertyTokenizationWord},
+						{Name: "whitespace", DataType: schema.DataTypeText.PropString(), Tokenization: models.PropertyTokenizationWhitespace},
+						{Name: "fields", DataType: schema.DataTypeTextArray.PropString(), Tokenization: models.PropertyTokenizationField},
+						{Name: "trigram", DataType: schema.DataTypeText.PropString(), Tokenization: models.PropertyTokenizationTrigram},
+						{Name: "number", DataType: schema.DataTypeInt.PropString()},
+					},
+				},
+			)
+			err := validateClause(f.ReadOnlyClass, newClauseWrapper(&cl))
+			if tt.valid {
+				require.Nil(t, err)
+			} else {
+				require.NotNil(t, err)
+			}
+		})
+	}
+}
@@ -44,10 +44,17 @@ type BackupConfig struct {
 	// Minimum: 2
 	ChunkSize int64 `json:"ChunkSize,omitempty"`
 
+	// compression algorithm used for backup files
+	// Enum: [gzip zstd none]
+	CompressionCodec string `json:"CompressionCodec,omitempty"`
+
 	// compression level used by compression algorithm
 	// Enum: [DefaultCompression BestSpeed BestCompression]
 	CompressionLevel string `json:"CompressionLevel,omitempty"`
 
+	// Encrypt backup files client-side with AES-GCM. Requires the key encryption key to be configured with BACKUP_ENCRYPTION_KEY or BACKUP_ENCRYPTION_KEY_FILE. Restores decrypt automatically.
+	Encrypt bool `json:"Encrypt,omitempty"`
+
 	// name of the endpoint, e.g. s3.amazonaws.com
 	Endpoint string `json:"Endpoint,omitempty"`
 
@@ -67,6 +74,10 @@ func (m *BackupConfig) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateCompressionCodec(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateCompressionLevel(formats); err != nil {
 		res = append(res, err)
 	}
@@ -109,6 +120,51 @@ func (m *BackupConfig) validateChunkSize(formats strfmt.Registry) error {
 	return nil
 }
 
+var backupConfigTypeCompressionCodecPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["gzip","zstd","none"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		backupConfigTypeCompressionCodecPropEnum = append(backupConfigTypeCompressionCodecPropEnum, v)
+	}
+}
+
+const (
+
+	// BackupConfigCompressionCodecGzip captures enum value "gzip"
+	BackupConfigCompressionCodecGzip string = "gzip"
+
+	// BackupConfigCompressionCodecZstd captures enum value "zstd"
+	BackupConfigCompressionCodecZstd string = "zstd"
+
+	// BackupConfigCompressionCodecNone captures enum value "none"
+	BackupConfigCompressionCodecNone string = "none"
+)
+
+// prop value enum
+func (m *BackupConfig) validateCompressionCodecEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, backupConfigTypeCompressionCodecPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *BackupConfig) validateCompressionCodec(formats strfmt.Registry) error {
+	if swag.IsZero(m.CompressionCodec) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateCompressionCodecEnum("CompressionCodec", "body", m.CompressionCodec); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 var backupConfigTypeCompressionLevelPropEnum []interface{}
 
 func init() {
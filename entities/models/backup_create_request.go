@@ -40,6 +40,9 @@ type BackupCreateRequest struct {
 
 	// List of collections to include in the backup creation process. If not set, all collections are included. Cannot be used together with `exclude`.
 	Include []string `json:"include"`
+
+	// The ID of a previous successful backup on the same backend. If set, the backup is incremental: only files which changed since the base backup are uploaded. Restoring it requires all backups of the chain to be present.
+	IncrementalBaseBackupID string `json:"incremental_base_backup_id,omitempty"`
 }
 
 // Validate validates this backup create request
@@ -22,6 +22,7 @@ import (
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
 )
 
 // BackupRestoreRequest Request body for restoring a backup for a set of classes
@@ -40,6 +41,10 @@ type BackupRestoreRequest struct {
 
 	// Allows overriding the node names stored in the backup with different ones. Useful when restoring backups to a different environment.
 	NodeMapping map[string]string `json:"node_mapping,omitempty"`
+
+	// Restore the classes to their state at this time, which must be after the backup completed. Requires commit log archiving to be enabled: all commit logs shipped alongside the backup and completed until then are replayed on top of it.
+	// Format: date-time
+	PointInTime strfmt.DateTime `json:"point_in_time,omitempty"`
 }
 
 // Validate validates this backup restore request
@@ -50,6 +55,10 @@ func (m *BackupRestoreRequest) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validatePointInTime(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -75,6 +84,18 @@ func (m *BackupRestoreRequest) validateConfig(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *BackupRestoreRequest) validatePointInTime(formats strfmt.Registry) error {
+	if swag.IsZero(m.PointInTime) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("point_in_time", "body", "date-time", m.PointInTime.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // ContextValidate validate this backup restore request based on the context it is used
 func (m *BackupRestoreRequest) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
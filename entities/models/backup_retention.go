@@ -0,0 +1,64 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// BackupRetention Retention policy of scheduled backups. A backup is kept if it satisfies any of the set constraints. All backups are kept if none is set.
+//
+// swagger:model BackupRetention
+type BackupRetention struct {
+
+	// Number of days to keep backups for
+	KeepDays int64 `json:"keepDays,omitempty"`
+
+	// Number of most recent successful backups to keep
+	KeepLast int64 `json:"keepLast,omitempty"`
+}
+
+// Validate validates this backup retention
+func (m *BackupRetention) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this backup retention based on context it is used
+func (m *BackupRetention) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *BackupRetention) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *BackupRetention) UnmarshalBinary(b []byte) error {
+	var res BackupRetention
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,241 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// BackupSchedule A recurring backup of a set of collections
+//
+// swagger:model BackupSchedule
+type BackupSchedule struct {
+
+	// Backup backend name e.g. `filesystem`, `gcs`, `s3`, `azure`.
+	// Required: true
+	Backend *string `json:"backend"`
+
+	// Time the schedule has been created
+	// Read Only: true
+	// Format: date-time
+	CreatedAt strfmt.DateTime `json:"createdAt,omitempty"`
+
+	// Five field cron expression (minute hour day-of-month month day-of-week) in UTC, e.g. `0 3 * * *`. The macros `@hourly`, `@daily`, `@weekly`, `@monthly` and `@yearly` are supported as well.
+	// Required: true
+	Cron *string `json:"cron"`
+
+	// List of collections to exclude from the backups. Cannot be used together with 'include'.
+	Exclude []string `json:"exclude"`
+
+	// List of collections to include in the backups. Cannot be used together with 'exclude'.
+	Include []string `json:"include"`
+
+	// Time the schedule has been triggered last
+	// Read Only: true
+	// Format: date-time
+	LastRun strfmt.DateTime `json:"lastRun,omitempty"`
+
+	// The name of the schedule. Only lowercase, numbers, underscore, minus characters allowed.
+	// Required: true
+	Name *string `json:"name"`
+
+	// retention
+	Retention *BackupRetention `json:"retention,omitempty"`
+}
+
+// Validate validates this backup schedule
+func (m *BackupSchedule) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateBackend(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateCreatedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateCron(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateLastRun(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateName(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateRetention(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *BackupSchedule) validateBackend(formats strfmt.Registry) error {
+
+	if err := validate.Required("backend", "body", m.Backend); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *BackupSchedule) validateCreatedAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.CreatedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("createdAt", "body", "date-time", m.CreatedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *BackupSchedule) validateCron(formats strfmt.Registry) error {
+
+	if err := validate.Required("cron", "body", m.Cron); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *BackupSchedule) validateLastRun(formats strfmt.Registry) error {
+	if swag.IsZero(m.LastRun) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("lastRun", "body", "date-time", m.LastRun.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *BackupSchedule) validateName(formats strfmt.Registry) error {
+
+	if err := validate.Required("name", "body", m.Name); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *BackupSchedule) validateRetention(formats strfmt.Registry) error {
+	if swag.IsZero(m.Retention) { // not required
+		return nil
+	}
+
+	if m.Retention != nil {
+		if err := m.Retention.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("retention")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("retention")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ContextValidate validate this backup schedule based on the context it is used
+func (m *BackupSchedule) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateCreatedAt(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateLastRun(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateRetention(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *BackupSchedule) contextValidateCreatedAt(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := validate.ReadOnly(ctx, "createdAt", "body", strfmt.DateTime(m.CreatedAt)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *BackupSchedule) contextValidateLastRun(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := validate.ReadOnly(ctx, "lastRun", "body", strfmt.DateTime(m.LastRun)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *BackupSchedule) contextValidateRetention(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Retention != nil {
+		if err := m.Retention.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("retention")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("retention")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *BackupSchedule) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *BackupSchedule) UnmarshalBinary(b []byte) error {
+	var res BackupSchedule
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,70 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NodeShardRequantizationStatus The progress of re-encoding the vectors of a shard with a new quantizer
+//
+// swagger:model NodeShardRequantizationStatus
+type NodeShardRequantizationStatus struct {
+
+	// The compression the vectors are switched to.
+	Compression string `json:"compression,omitempty"`
+
+	// The share of the vectors that are already encoded, between 0 and 1.
+	Progress float32 `json:"progress,omitempty"`
+
+	// The number of vectors that are already encoded with the new quantizer.
+	VectorsProcessed int64 `json:"vectorsProcessed,omitempty"`
+
+	// The number of vectors to encode.
+	VectorsTotal int64 `json:"vectorsTotal,omitempty"`
+}
+
+// Validate validates this node shard requantization status
+func (m *NodeShardRequantizationStatus) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this node shard requantization status based on context it is used
+func (m *NodeShardRequantizationStatus) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *NodeShardRequantizationStatus) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *NodeShardRequantizationStatus) UnmarshalBinary(b []byte) error {
+	var res NodeShardRequantizationStatus
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -19,6 +19,7 @@ package models
 import (
 	"context"
 
+	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
 )
@@ -43,6 +44,9 @@ type NodeShardStatus struct {
 	// The number of objects in shard.
 	ObjectCount int64 `json:"objectCount"`
 
+	// The progress of switching the compressed vectors to a new quantizer. Only present while a requantization is running.
+	Requantization *NodeShardRequantizationStatus `json:"requantization,omitempty"`
+
 	// The status of the vector indexing process.
 	VectorIndexingStatus string `json:"vectorIndexingStatus"`
 
@@ -52,11 +56,64 @@ type NodeShardStatus struct {
 
 // Validate validates this node shard status
 func (m *NodeShardStatus) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateRequantization(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
 	return nil
 }
 
-// ContextValidate validates this node shard status based on context it is used
+func (m *NodeShardStatus) validateRequantization(formats strfmt.Registry) error {
+	if swag.IsZero(m.Requantization) { // not required
+		return nil
+	}
+
+	if m.Requantization != nil {
+		if err := m.Requantization.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("requantization")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("requantization")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ContextValidate validate this node shard status based on the context it is used
 func (m *NodeShardStatus) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateRequantization(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *NodeShardStatus) contextValidateRequantization(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Requantization != nil {
+		if err := m.Requantization.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("requantization")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("requantization")
+			}
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -49,6 +49,9 @@ type Object struct {
 	// properties
 	Properties PropertySchema `json:"properties,omitempty"`
 
+	// This field returns sparse vectors associated with the Object.
+	SparseVectors SparseVectors `json:"sparseVectors,omitempty"`
+
 	// Name of the Objects tenant.
 	Tenant string `json:"tenant,omitempty"`
 
@@ -74,6 +77,10 @@ func (m *Object) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateSparseVectors(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateVector(formats); err != nil {
 		res = append(res, err)
 	}
@@ -119,6 +126,25 @@ func (m *Object) validateID(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Object) validateSparseVectors(formats strfmt.Registry) error {
+	if swag.IsZero(m.SparseVectors) { // not required
+		return nil
+	}
+
+	if m.SparseVectors != nil {
+		if err := m.SparseVectors.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("sparseVectors")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("sparseVectors")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *Object) validateVector(formats strfmt.Registry) error {
 	if swag.IsZero(m.Vector) { // not required
 		return nil
@@ -163,6 +189,10 @@ func (m *Object) ContextValidate(ctx context.Context, formats strfmt.Registry) e
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateSparseVectors(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateVector(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -191,6 +221,20 @@ func (m *Object) contextValidateAdditional(ctx context.Context, formats strfmt.R
 	return nil
 }
 
+func (m *Object) contextValidateSparseVectors(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := m.SparseVectors.ContextValidate(ctx, formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("sparseVectors")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("sparseVectors")
+		}
+		return err
+	}
+
+	return nil
+}
+
 func (m *Object) contextValidateVector(ctx context.Context, formats strfmt.Registry) error {
 
 	if err := m.Vector.ContextValidate(ctx, formats); err != nil {
@@ -32,6 +32,9 @@ import (
 // swagger:model Property
 type Property struct {
 
+	// Name of a custom analyzer defined in the class' invertedIndexConfig.analyzers. Optional. Applies to text and text[] data types. The analyzer is used to index the property and to tokenize filters and bm25 queries on it. The tokenization of the property has to match the tokenizer of the analyzer and defaults to it.
+	Analyzer string `json:"analyzer,omitempty"`
+
 	// Data type of the property (required). If it starts with a capital (for example Person), may be a reference to another type.
 	DataType []string `json:"dataType"`
 
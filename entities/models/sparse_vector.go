@@ -0,0 +1,64 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// SparseVector A sparse vector representation of the object, e.g. a learned sparse embedding such as SPLADE. Only the dimensions with a non-zero weight are listed.
+//
+// swagger:model SparseVector
+type SparseVector struct {
+
+	// The dimensions with a non-zero weight in ascending order.
+	Indices []uint32 `json:"indices"`
+
+	// The weights of the dimensions listed in indices.
+	Values []float32 `json:"values"`
+}
+
+// Validate validates this sparse vector
+func (m *SparseVector) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this sparse vector based on context it is used
+func (m *SparseVector) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *SparseVector) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *SparseVector) UnmarshalBinary(b []byte) error {
+	var res SparseVector
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,64 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// SynonymConfig Synonyms which the terms of BM25 and hybrid queries are expanded by.
+//
+// swagger:model SynonymConfig
+type SynonymConfig struct {
+
+	// Weight of the expanded terms relative to the terms of the query (default: 1).
+	Boost float32 `json:"boost,omitempty"`
+
+	// Groups of equivalent terms separated by commas, e.g. 'laptop, notebook'. A query term matching a term of a group is expanded by the other terms of the group.
+	Groups []string `json:"groups"`
+}
+
+// Validate validates this synonym config
+func (m *SynonymConfig) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this synonym config based on context it is used
+func (m *SynonymConfig) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *SynonymConfig) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *SynonymConfig) UnmarshalBinary(b []byte) error {
+	var res SynonymConfig
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,125 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// TextAnalyzerCharFilter Rewrites the text before it is tokenized.
+//
+// swagger:model TextAnalyzerCharFilter
+type TextAnalyzerCharFilter struct {
+
+	// Strings to replace (mapping only).
+	Mappings map[string]string `json:"mappings,omitempty"`
+
+	// RE2 regular expression (patternReplace only).
+	Pattern string `json:"pattern,omitempty"`
+
+	// Replacement for matches of pattern, may refer to submatches as $1 (patternReplace only).
+	Replacement string `json:"replacement,omitempty"`
+
+	// `mapping` replaces each key of mappings with its value, `patternReplace` replaces all matches of the regular expression pattern with replacement.
+	// Enum: [mapping patternReplace]
+	Type string `json:"type,omitempty"`
+}
+
+// Validate validates this text analyzer char filter
+func (m *TextAnalyzerCharFilter) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateType(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var textAnalyzerCharFilterTypeTypePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["mapping","patternReplace"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		textAnalyzerCharFilterTypeTypePropEnum = append(textAnalyzerCharFilterTypeTypePropEnum, v)
+	}
+}
+
+const (
+
+	// TextAnalyzerCharFilterTypeMapping captures enum value "mapping"
+	TextAnalyzerCharFilterTypeMapping string = "mapping"
+
+	// TextAnalyzerCharFilterTypePatternReplace captures enum value "patternReplace"
+	TextAnalyzerCharFilterTypePatternReplace string = "patternReplace"
+)
+
+// prop value enum
+func (m *TextAnalyzerCharFilter) validateTypeEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, textAnalyzerCharFilterTypeTypePropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *TextAnalyzerCharFilter) validateType(formats strfmt.Registry) error {
+	if swag.IsZero(m.Type) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateTypeEnum("type", "body", m.Type); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this text analyzer char filter based on context it is used
+func (m *TextAnalyzerCharFilter) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *TextAnalyzerCharFilter) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *TextAnalyzerCharFilter) UnmarshalBinary(b []byte) error {
+	var res TextAnalyzerCharFilter
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,257 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// TextAnalyzerConfig A custom text analysis pipeline: the char filters are applied to the text, which is then split by the tokenizer, and the resulting tokens run through the token filters in order.
+//
+// swagger:model TextAnalyzerConfig
+type TextAnalyzerConfig struct {
+
+	// Filters applied to the text before tokenization.
+	CharFilters []*TextAnalyzerCharFilter `json:"charFilters"`
+
+	// Name of the analyzer, referenced by the analyzer setting of a property.
+	Name string `json:"name,omitempty"`
+
+	// Filters applied to the tokens in order.
+	TokenFilters []*TextAnalyzerTokenFilter `json:"tokenFilters"`
+
+	// Tokenization used to split the text, see the tokenization of a property for the allowed values.
+	// Enum: [word lowercase whitespace field trigram gse kagome_kr kagome_ja]
+	Tokenizer string `json:"tokenizer,omitempty"`
+}
+
+// Validate validates this text analyzer config
+func (m *TextAnalyzerConfig) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateCharFilters(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTokenFilters(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTokenizer(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *TextAnalyzerConfig) validateCharFilters(formats strfmt.Registry) error {
+	if swag.IsZero(m.CharFilters) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.CharFilters); i++ {
+		if swag.IsZero(m.CharFilters[i]) { // not required
+			continue
+		}
+
+		if m.CharFilters[i] != nil {
+			if err := m.CharFilters[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("charFilters" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("charFilters" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *TextAnalyzerConfig) validateTokenFilters(formats strfmt.Registry) error {
+	if swag.IsZero(m.TokenFilters) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.TokenFilters); i++ {
+		if swag.IsZero(m.TokenFilters[i]) { // not required
+			continue
+		}
+
+		if m.TokenFilters[i] != nil {
+			if err := m.TokenFilters[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("tokenFilters" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("tokenFilters" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+var textAnalyzerConfigTypeTokenizerPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["word","lowercase","whitespace","field","trigram","gse","kagome_kr","kagome_ja"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		textAnalyzerConfigTypeTokenizerPropEnum = append(textAnalyzerConfigTypeTokenizerPropEnum, v)
+	}
+}
+
+const (
+
+	// TextAnalyzerConfigTokenizerWord captures enum value "word"
+	TextAnalyzerConfigTokenizerWord string = "word"
+
+	// TextAnalyzerConfigTokenizerLowercase captures enum value "lowercase"
+	TextAnalyzerConfigTokenizerLowercase string = "lowercase"
+
+	// TextAnalyzerConfigTokenizerWhitespace captures enum value "whitespace"
+	TextAnalyzerConfigTokenizerWhitespace string = "whitespace"
+
+	// TextAnalyzerConfigTokenizerField captures enum value "field"
+	TextAnalyzerConfigTokenizerField string = "field"
+
+	// TextAnalyzerConfigTokenizerTrigram captures enum value "trigram"
+	TextAnalyzerConfigTokenizerTrigram string = "trigram"
+
+	// TextAnalyzerConfigTokenizerGse captures enum value "gse"
+	TextAnalyzerConfigTokenizerGse string = "gse"
+
+	// TextAnalyzerConfigTokenizerKagomeKr captures enum value "kagome_kr"
+	TextAnalyzerConfigTokenizerKagomeKr string = "kagome_kr"
+
+	// TextAnalyzerConfigTokenizerKagomeJa captures enum value "kagome_ja"
+	TextAnalyzerConfigTokenizerKagomeJa string = "kagome_ja"
+)
+
+// prop value enum
+func (m *TextAnalyzerConfig) validateTokenizerEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, textAnalyzerConfigTypeTokenizerPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *TextAnalyzerConfig) validateTokenizer(formats strfmt.Registry) error {
+	if swag.IsZero(m.Tokenizer) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateTokenizerEnum("tokenizer", "body", m.Tokenizer); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this text analyzer config based on the context it is used
+func (m *TextAnalyzerConfig) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateCharFilters(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateTokenFilters(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *TextAnalyzerConfig) contextValidateCharFilters(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.CharFilters); i++ {
+
+		if m.CharFilters[i] != nil {
+			if err := m.CharFilters[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("charFilters" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("charFilters" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *TextAnalyzerConfig) contextValidateTokenFilters(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.TokenFilters); i++ {
+
+		if m.TokenFilters[i] != nil {
+			if err := m.TokenFilters[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("tokenFilters" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("tokenFilters" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *TextAnalyzerConfig) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *TextAnalyzerConfig) UnmarshalBinary(b []byte) error {
+	var res TextAnalyzerConfig
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,146 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// TextAnalyzerTokenFilter Transforms, removes or adds tokens.
+//
+// swagger:model TextAnalyzerTokenFilter
+type TextAnalyzerTokenFilter struct {
+
+	// Language of the stemmer (stemmer only, default: 'english').
+	Language string `json:"language,omitempty"`
+
+	// Maximum length of n-grams (edge_ngram and ngram only, default: 2).
+	MaxGram int64 `json:"maxGram,omitempty"`
+
+	// Minimum length of n-grams (edge_ngram and ngram only, default: 1).
+	MinGram int64 `json:"minGram,omitempty"`
+
+	// Stopwords to remove (stop only). Defaults to the stopwords of the inverted index config.
+	Stopwords []string `json:"stopwords"`
+
+	// Groups of equivalent tokens, each given as a comma separated list such as 'laptop, notebook' (synonym only).
+	Synonyms []string `json:"synonyms"`
+
+	// `lowercase` lowercases tokens, `asciifold` removes diacritics, `stemmer` reduces tokens to their snowball stem, `stop` removes stopwords, `synonym` adds the synonyms of tokens, `edge_ngram` replaces tokens with their prefixes and `ngram` with their substrings of minGram to maxGram characters.
+	// Enum: [lowercase asciifold stemmer stop synonym edge_ngram ngram]
+	Type string `json:"type,omitempty"`
+}
+
+// Validate validates this text analyzer token filter
+func (m *TextAnalyzerTokenFilter) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateType(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var textAnalyzerTokenFilterTypeTypePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["lowercase","asciifold","stemmer","stop","synonym","edge_ngram","ngram"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		textAnalyzerTokenFilterTypeTypePropEnum = append(textAnalyzerTokenFilterTypeTypePropEnum, v)
+	}
+}
+
+const (
+
+	// TextAnalyzerTokenFilterTypeLowercase captures enum value "lowercase"
+	TextAnalyzerTokenFilterTypeLowercase string = "lowercase"
+
+	// TextAnalyzerTokenFilterTypeAsciifold captures enum value "asciifold"
+	TextAnalyzerTokenFilterTypeAsciifold string = "asciifold"
+
+	// TextAnalyzerTokenFilterTypeStemmer captures enum value "stemmer"
+	TextAnalyzerTokenFilterTypeStemmer string = "stemmer"
+
+	// TextAnalyzerTokenFilterTypeStop captures enum value "stop"
+	TextAnalyzerTokenFilterTypeStop string = "stop"
+
+	// TextAnalyzerTokenFilterTypeSynonym captures enum value "synonym"
+	TextAnalyzerTokenFilterTypeSynonym string = "synonym"
+
+	// TextAnalyzerTokenFilterTypeEdgeNgram captures enum value "edge_ngram"
+	TextAnalyzerTokenFilterTypeEdgeNgram string = "edge_ngram"
+
+	// TextAnalyzerTokenFilterTypeNgram captures enum value "ngram"
+	TextAnalyzerTokenFilterTypeNgram string = "ngram"
+)
+
+// prop value enum
+func (m *TextAnalyzerTokenFilter) validateTypeEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, textAnalyzerTokenFilterTypeTypePropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *TextAnalyzerTokenFilter) validateType(formats strfmt.Registry) error {
+	if swag.IsZero(m.Type) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateTypeEnum("type", "body", m.Type); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this text analyzer token filter based on context it is used
+func (m *TextAnalyzerTokenFilter) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *TextAnalyzerTokenFilter) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *TextAnalyzerTokenFilter) UnmarshalBinary(b []byte) error {
+	var res TextAnalyzerTokenFilter
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,114 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// TraverseEdge A followed reference between two objects of a traversal.
+//
+// swagger:model TraverseEdge
+type TraverseEdge struct {
+
+	// from
+	// Format: uuid
+	From strfmt.UUID `json:"from,omitempty"`
+
+	// from class
+	FromClass string `json:"fromClass,omitempty"`
+
+	// property
+	Property string `json:"property,omitempty"`
+
+	// to
+	// Format: uuid
+	To strfmt.UUID `json:"to,omitempty"`
+
+	// to class
+	ToClass string `json:"toClass,omitempty"`
+}
+
+// Validate validates this traverse edge
+func (m *TraverseEdge) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateFrom(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTo(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *TraverseEdge) validateFrom(formats strfmt.Registry) error {
+	if swag.IsZero(m.From) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("from", "body", "uuid", m.From.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *TraverseEdge) validateTo(formats strfmt.Registry) error {
+	if swag.IsZero(m.To) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("to", "body", "uuid", m.To.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this traverse edge based on context it is used
+func (m *TraverseEdge) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *TraverseEdge) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *TraverseEdge) UnmarshalBinary(b []byte) error {
+	var res TraverseEdge
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,187 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// TraverseRequest Request to expand the cross-references of a set of objects breadth-first.
+//
+// swagger:model TraverseRequest
+type TraverseRequest struct {
+
+	// Class of the start objects.
+	// Example: City
+	Class string `json:"class,omitempty"`
+
+	// Hop i configures the expansion of the objects at depth i. The last hop is reused for all further depths. Without hops all reference properties are followed.
+	Hops []*TraverseHop `json:"hops"`
+
+	// Number of hops to expand. Defaults to 1 and is limited by QUERY_CROSS_REFERENCE_DEPTH_LIMIT.
+	MaxDepth int64 `json:"maxDepth,omitempty"`
+
+	// Maximum number of references followed per object and property, 0 means no limit.
+	MaxFanOut int64 `json:"maxFanOut,omitempty"`
+
+	// Maximum number of objects returned. Limited by QUERY_MAXIMUM_RESULTS.
+	MaxNodes int64 `json:"maxNodes,omitempty"`
+
+	// IDs of the objects the traversal starts at.
+	StartIds []strfmt.UUID `json:"startIds"`
+
+	// If set, the traversal stops once the object is reached and returns the shortest path to it.
+	// Format: uuid
+	TargetID strfmt.UUID `json:"targetId,omitempty"`
+
+	// Tenant used for all multi-tenant classes reached by the traversal.
+	Tenant string `json:"tenant,omitempty"`
+}
+
+// Validate validates this traverse request
+func (m *TraverseRequest) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateHops(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStartIds(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTargetID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *TraverseRequest) validateHops(formats strfmt.Registry) error {
+	if swag.IsZero(m.Hops) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Hops); i++ {
+		if swag.IsZero(m.Hops[i]) { // not required
+			continue
+		}
+
+		if m.Hops[i] != nil {
+			if err := m.Hops[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("hops" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("hops" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *TraverseRequest) validateStartIds(formats strfmt.Registry) error {
+	if swag.IsZero(m.StartIds) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.StartIds); i++ {
+
+		if err := validate.FormatOf("startIds"+"."+strconv.Itoa(i), "body", "uuid", m.StartIds[i].String(), formats); err != nil {
+			return err
+		}
+
+	}
+
+	return nil
+}
+
+func (m *TraverseRequest) validateTargetID(formats strfmt.Registry) error {
+	if swag.IsZero(m.TargetID) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("targetId", "body", "uuid", m.TargetID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this traverse request based on the context it is used
+func (m *TraverseRequest) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateHops(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *TraverseRequest) contextValidateHops(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Hops); i++ {
+
+		if m.Hops[i] != nil {
+			if err := m.Hops[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("hops" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("hops" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *TraverseRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *TraverseRequest) UnmarshalBinary(b []byte) error {
+	var res TraverseRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
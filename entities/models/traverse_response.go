@@ -0,0 +1,211 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// TraverseResponse The subgraph visited by a traversal.
+//
+// swagger:model TraverseResponse
+type TraverseResponse struct {
+
+	// edges
+	Edges []*TraverseEdge `json:"edges"`
+
+	// nodes
+	Nodes []*TraverseNode `json:"nodes"`
+
+	// IDs of the objects on the shortest path to 'targetId'. Empty if the target was not reached.
+	Path []strfmt.UUID `json:"path"`
+
+	// True if objects or references have been skipped because of 'maxFanOut' or 'maxNodes'.
+	Truncated bool `json:"truncated,omitempty"`
+}
+
+// Validate validates this traverse response
+func (m *TraverseResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateEdges(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateNodes(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validatePath(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *TraverseResponse) validateEdges(formats strfmt.Registry) error {
+	if swag.IsZero(m.Edges) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Edges); i++ {
+		if swag.IsZero(m.Edges[i]) { // not required
+			continue
+		}
+
+		if m.Edges[i] != nil {
+			if err := m.Edges[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("edges" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("edges" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *TraverseResponse) validateNodes(formats strfmt.Registry) error {
+	if swag.IsZero(m.Nodes) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Nodes); i++ {
+		if swag.IsZero(m.Nodes[i]) { // not required
+			continue
+		}
+
+		if m.Nodes[i] != nil {
+			if err := m.Nodes[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("nodes" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("nodes" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *TraverseResponse) validatePath(formats strfmt.Registry) error {
+	if swag.IsZero(m.Path) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Path); i++ {
+
+		if err := validate.FormatOf("path"+"."+strconv.Itoa(i), "body", "uuid", m.Path[i].String(), formats); err != nil {
+			return err
+		}
+
+	}
+
+	return nil
+}
+
+// ContextValidate validate this traverse response based on the context it is used
+func (m *TraverseResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateEdges(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateNodes(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *TraverseResponse) contextValidateEdges(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Edges); i++ {
+
+		if m.Edges[i] != nil {
+			if err := m.Edges[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("edges" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("edges" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *TraverseResponse) contextValidateNodes(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Nodes); i++ {
+
+		if m.Nodes[i] != nil {
+			if err := m.Nodes[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("nodes" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("nodes" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *TraverseResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *TraverseResponse) UnmarshalBinary(b []byte) error {
+	var res TraverseResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -51,4 +51,7 @@ type BackupBackend interface {
 	// Allows restores from a different bucket to the designated backup bucket
 	Write(ctx context.Context, backupID, key, overrideBucket, overridePath string, r io.ReadCloser) (int64, error)
 	Read(ctx context.Context, backupID, key, overrideBucket, overridePath string, w io.WriteCloser) (int64, error)
+
+	// Delete removes all objects stored for backupID
+	Delete(ctx context.Context, backupID, overrideBucket, overridePath string) error
 }
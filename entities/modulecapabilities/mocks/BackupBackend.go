@@ -58,6 +58,24 @@ func (_m *BackupBackend) AllBackups(ctx context.Context) ([]*backup.DistributedB
 	return r0, r1
 }
 
+// Delete provides a mock function with given fields: ctx, backupID, overrideBucket, overridePath
+func (_m *BackupBackend) Delete(ctx context.Context, backupID string, overrideBucket string, overridePath string) error {
+	ret := _m.Called(ctx, backupID, overrideBucket, overridePath)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+		r0 = rf(ctx, backupID, overrideBucket, overridePath)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // GetObject provides a mock function with given fields: ctx, backupID, key, overrideBucket, overridePath
 func (_m *BackupBackend) GetObject(ctx context.Context, backupID string, key string, overrideBucket string, overridePath string) ([]byte, error) {
 	ret := _m.Called(ctx, backupID, key, overrideBucket, overridePath)
@@ -133,6 +133,9 @@ type Property struct {
 	// Determines tokenization of the property as separate words or whole field. Optional. Applies to text and text[] data types. Allowed values are `word` (default; splits on any non-alphanumerical, lowercases), `lowercase` (splits on white spaces, lowercases), `whitespace` (splits on white spaces), `field` (trims). Not supported for remaining data types
 	// Enum: [word lowercase whitespace field]
 	Tokenization string `json:"tokenization,omitempty"`
+
+	// The name of a custom analyzer defined in the inverted index config of the class. Applies to text and text[] data types.
+	Analyzer string `json:"analyzer,omitempty"`
 }
 
 type NestedProperty struct {
@@ -252,6 +255,7 @@ func PropertyFromModel(m models.Property) Property {
 		p.ModuleConfig = v
 	}
 	p.Tokenization = m.Tokenization
+	p.Analyzer = m.Analyzer
 	if len(m.NestedProperties) > 0 {
 		p.NestedProperties = make([]NestedProperty, 0, len(m.NestedProperties))
 		for _, npm := range m.NestedProperties {
@@ -282,6 +286,7 @@ func PropertyToModel(p Property) models.Property {
 	m.ModuleConfig = p.ModuleConfig
 	m.Name = p.Name
 	m.Tokenization = p.Tokenization
+	m.Analyzer = p.Analyzer
 	if len(p.NestedProperties) > 0 {
 		m.NestedProperties = make([]*models.NestedProperty, 0, len(p.NestedProperties))
 		for _, np := range p.NestedProperties {
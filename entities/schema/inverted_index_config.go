@@ -22,6 +22,9 @@ type InvertedIndexConfig struct {
 	IndexTimestamps        bool
 	IndexNullState         bool
 	IndexPropertyLength    bool
+	Analyzers              []*models.TextAnalyzerConfig
+	Synonyms               *models.SynonymConfig
+	IndexPositions         []string
 }
 
 type BM25Config struct {
@@ -43,6 +46,9 @@ func InvertedIndexConfigFromModel(m models.InvertedIndexConfig) InvertedIndexCon
 	i.IndexTimestamps = m.IndexTimestamps
 	i.IndexNullState = m.IndexNullState
 	i.IndexPropertyLength = m.IndexPropertyLength
+	i.Analyzers = m.Analyzers
+	i.Synonyms = m.Synonyms
+	i.IndexPositions = m.IndexPositions
 
 	return i
 }
@@ -62,6 +68,9 @@ func InvertedIndexConfigToModel(i InvertedIndexConfig) models.InvertedIndexConfi
 	m.IndexTimestamps = i.IndexTimestamps
 	m.IndexNullState = i.IndexNullState
 	m.IndexPropertyLength = i.IndexPropertyLength
+	m.Analyzers = i.Analyzers
+	m.Synonyms = i.Synonyms
+	m.IndexPositions = i.IndexPositions
 
 	return m
 }
@@ -30,6 +30,7 @@ type Result struct {
 	Vector               []float32
 	Vectors              models.Vectors
 	MultiVectors         map[string][][]float32
+	SparseVectors        models.SparseVectors
 	Beacon               string
 	Certainty            float32
 	Schema               models.PropertySchema
@@ -74,6 +75,7 @@ func (r Result) ObjectWithVector(includeVector bool) *models.Object {
 	if includeVector {
 		t.Vector = r.Vector
 		t.Vectors = r.Vectors
+		t.SparseVectors = r.SparseVectors
 	}
 
 	return t
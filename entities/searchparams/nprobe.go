@@ -0,0 +1,29 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package searchparams
+
+import "context"
+
+type nprobeKey struct{}
+
+// ContextWithNProbe returns a context which asks an ivf index to scan the
+// posting lists of the given number of centroids instead of the nprobe of
+// its config
+func ContextWithNProbe(ctx context.Context, nprobe int) context.Context {
+	return context.WithValue(ctx, nprobeKey{}, nprobe)
+}
+
+// NProbeFromContext returns the nprobe set with ContextWithNProbe, if any
+func NProbeFromContext(ctx context.Context) (int, bool) {
+	nprobe, ok := ctx.Value(nprobeKey{}).(int)
+	return nprobe, ok
+}
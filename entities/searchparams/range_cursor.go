@@ -0,0 +1,42 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package searchparams
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+)
+
+// RangeCursor is the position of the last result of a page of a search by
+// distance. The results of all shards are ordered by distance and then by
+// id, so the position is unique even if several objects have the same
+// distance to the query.
+type RangeCursor struct {
+	Distance float32     `json:"distance"`
+	ID       strfmt.UUID `json:"id"`
+}
+
+type rangeCursorKey struct{}
+
+// ContextWithRangeCursor returns a context which asks a search by distance
+// to skip the results up to and including the cursor
+func ContextWithRangeCursor(ctx context.Context, cursor *RangeCursor) context.Context {
+	return context.WithValue(ctx, rangeCursorKey{}, cursor)
+}
+
+// RangeCursorFromContext returns the cursor set with ContextWithRangeCursor,
+// if any
+func RangeCursorFromContext(ctx context.Context) (*RangeCursor, bool) {
+	cursor, ok := ctx.Value(rangeCursorKey{}).(*RangeCursor)
+	return cursor, ok && cursor != nil
+}
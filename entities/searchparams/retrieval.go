@@ -27,6 +27,27 @@ type NearVector struct {
 	WithDistance  bool        `json:"-"`
 	Vectors       [][]float32 `json:"vectors"`
 	TargetVectors []string    `json:"targetVectors"`
+	// MatryoshkaDimensions is the number of leading dimensions the candidates
+	// are re-ranked with if the index holds truncated vectors. 0 means the
+	// full vectors are used.
+	MatryoshkaDimensions int `json:"matryoshkaDimensions"`
+	// NProbe is the number of centroids whose posting lists an ivf index
+	// scans. 0 means the nprobe of the index config is used.
+	NProbe int `json:"nprobe"`
+	// After continues a search by distance behind the last result of the
+	// previous page
+	After *RangeCursor `json:"after"`
+}
+
+// NearVectorBatch holds several near vector queries against the same target
+// vector. The queries share all other parameters of the search and are
+// answered with one result list per vector.
+type NearVectorBatch struct {
+	Certainty    float64     `json:"certainty"`
+	Distance     float64     `json:"distance"`
+	WithDistance bool        `json:"-"`
+	Vectors      [][]float32 `json:"vectors"`
+	TargetVector string      `json:"targetVector"`
 }
 
 type KeywordRanking struct {
@@ -34,6 +55,14 @@ type KeywordRanking struct {
 	Properties             []string `json:"properties"`
 	Query                  string   `json:"query"`
 	AdditionalExplanations bool     `json:"additionalExplanations"`
+	// Slop is the number of other tokens allowed between the tokens of
+	// quoted phrases in Query
+	Slop int `json:"slop"`
+	// TargetVector and SparseVector are set instead of Properties and Query
+	// if Type is "sparseVector", which ranks by the dot product with the
+	// sparse vectors of the target vector
+	TargetVector string              `json:"targetVector"`
+	SparseVector models.SparseVector `json:"sparseVector"`
 }
 
 // Indicates whether property should be indexed
@@ -110,6 +139,19 @@ type HybridSearch struct {
 	WithDistance     bool        `json:"withDistance"`
 	NearTextParams   *NearTextParams
 	NearVectorParams *NearVector
+	// SparseVectorParams adds a search over sparse vectors to the vector and
+	// keyword searches weighted by Alpha
+	SparseVectorParams *NearSparseVector
+}
+
+// NearSparseVector searches the sparse vectors of TargetVector by their dot
+// product with Vector. Within a hybrid search, Weight is the weight of its
+// results relative to the combined weight of 1 of the vector and keyword
+// results.
+type NearSparseVector struct {
+	TargetVector string              `json:"targetVector"`
+	Vector       models.SparseVector `json:"vector"`
+	Weight       float64             `json:"weight"`
 }
 
 type NearObject struct {
@@ -119,6 +161,9 @@ type NearObject struct {
 	Distance      float64  `json:"distance"`
 	WithDistance  bool     `json:"-"`
 	TargetVectors []string `json:"targetVectors"`
+	// After continues a search by distance behind the last result of the
+	// previous page
+	After *RangeCursor `json:"after"`
 }
 
 type ObjectMove struct {
@@ -151,4 +196,77 @@ type GroupBy struct {
 	Groups          int
 	ObjectsPerGroup int
 	Properties      search.SelectProperties
+	// Strategy selects the hits of a group, the closest hits if not set
+	Strategy string
+	// Sort orders the groups, by the distance of their closest hit if not set
+	Sort *GroupBySort
+}
+
+const (
+	// GroupByStrategyClosest picks the hits closest to the search
+	GroupByStrategyClosest = "closest"
+	// GroupByStrategyDiverse picks the hits most distant to each other,
+	// starting with the closest hit
+	GroupByStrategyDiverse = "diverse"
+)
+
+const (
+	// GroupBySortDistance orders the groups by the distance of their closest hit
+	GroupBySortDistance = "distance"
+	// GroupBySortScore orders the groups by the aggregated score of their
+	// hits, the highest first
+	GroupBySortScore = "score"
+	// GroupBySortProperty orders the groups by the value they are grouped by
+	GroupBySortProperty = "property"
+	// GroupBySortSize orders the groups by the number of objects, the largest first
+	GroupBySortSize = "size"
+)
+
+const (
+	GroupByScoreMax = "max"
+	GroupByScoreAvg = "avg"
+	GroupByScoreSum = "sum"
+)
+
+type GroupBySort struct {
+	By string
+	// Aggregation of the hit scores when ordering by score, max if not set
+	Aggregation string
+	// Descending reverses the order, when ordering by property
+	Descending bool
+}
+
+// Exhaustive is true if the groups can only be built after all objects of
+// the search are grouped, because the hits are not simply the closest ones
+// or the groups are not ordered by their closest hit
+func (g *GroupBy) Exhaustive() bool {
+	return g.Strategy == GroupByStrategyDiverse ||
+		(g.Sort != nil && g.Sort.By != GroupBySortDistance)
+}
+
+func (g *GroupBy) Validate() error {
+	switch g.Strategy {
+	case "", GroupByStrategyClosest, GroupByStrategyDiverse:
+	default:
+		return fmt.Errorf("unrecognized strategy %q, must be one of %q, %q",
+			g.Strategy, GroupByStrategyClosest, GroupByStrategyDiverse)
+	}
+
+	if g.Sort == nil {
+		return nil
+	}
+	switch g.Sort.By {
+	case GroupBySortScore:
+		switch g.Sort.Aggregation {
+		case "", GroupByScoreMax, GroupByScoreAvg, GroupByScoreSum:
+		default:
+			return fmt.Errorf("unrecognized score aggregation %q, must be one of %q, %q, %q",
+				g.Sort.Aggregation, GroupByScoreMax, GroupByScoreAvg, GroupByScoreSum)
+		}
+	case GroupBySortDistance, GroupBySortProperty, GroupBySortSize:
+	default:
+		return fmt.Errorf("unrecognized sort %q, must be one of %q, %q, %q, %q", g.Sort.By,
+			GroupBySortDistance, GroupBySortScore, GroupBySortProperty, GroupBySortSize)
+	}
+	return nil
 }
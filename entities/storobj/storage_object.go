@@ -57,6 +57,7 @@ type Object struct {
 	DocID             uint64
 	Vectors           map[string][]float32   `json:"vectors"`
 	MultiVectors      map[string][][]float32 `json:"multivectors"`
+	SparseVectors     models.SparseVectors   `json:"sparseVectors"`
 }
 
 func New(docID uint64) *Object {
@@ -107,6 +108,7 @@ func FromObjectMulti(object *models.Object, vector []float32, vectors models.Vec
 		VectorLen:         len(vector),
 		Vectors:           vecs,
 		MultiVectors:      multiVectors,
+		SparseVectors:     object.SparseVectors,
 	}
 }
 
@@ -241,6 +243,23 @@ func FromBinaryOptional(data []byte,
 					return nil, errors.Wrap(err, "Could not unmarshal multivectors")
 				}
 			}
+		} else {
+			rw.MoveBufferPositionForward(uint64(multiVectorsLength))
+		}
+	}
+
+	if rw.Position < uint64(len(rw.Buffer)) {
+		sparseVectorsLength := rw.ReadUint32()
+		if len(addProp.Vectors) > 0 && sparseVectorsLength > 0 {
+			sparseVectors, err := rw.CopyBytesFromBuffer(uint64(sparseVectorsLength), nil)
+			if err != nil {
+				return nil, errors.Wrap(err, "Could not copy sparse vectors")
+			}
+			if ko.SparseVectors, err = unmarshalSparseVectors(sparseVectors); err != nil {
+				return nil, errors.Wrap(err, "Could not unmarshal sparse vectors")
+			}
+		} else {
+			rw.MoveBufferPositionForward(uint64(sparseVectorsLength))
 		}
 	}
 
@@ -274,6 +293,7 @@ func FromBinaryOptional(data []byte,
 		ko.Object.LastUpdateTimeUnix = updateTime
 		ko.Object.Class = className
 	}
+	ko.Object.SparseVectors = ko.SparseVectors
 
 	return ko, nil
 }
@@ -529,19 +549,23 @@ func (ko *Object) SearchResult(additional additional.Properties, tenant string)
 		if additional.Group {
 			additionalProperties["group"] = ko.AdditionalProperties()["group"]
 		}
+		if additional.QueryPlan {
+			additionalProperties["queryPlan"] = ko.AdditionalProperties()["queryPlan"]
+		}
 	}
 	if ko.ExplainScore() != "" {
 		additionalProperties["explainScore"] = ko.ExplainScore()
 	}
 
 	return &search.Result{
-		ID:        ko.ID(),
-		DocID:     &ko.DocID,
-		ClassName: ko.Class().String(),
-		Schema:    ko.Properties(),
-		Vector:    ko.Vector,
-		Vectors:   ko.asVectors(ko.Vectors),
-		Dims:      ko.VectorLen,
+		ID:            ko.ID(),
+		DocID:         &ko.DocID,
+		ClassName:     ko.Class().String(),
+		Schema:        ko.Properties(),
+		Vector:        ko.Vector,
+		Vectors:       ko.asVectors(ko.Vectors),
+		SparseVectors: ko.SparseVectors,
+		Dims:          ko.VectorLen,
 		// VectorWeights: ko.VectorWeights(), // TODO: add vector weights
 		Created:              ko.CreationTimeUnix(),
 		Updated:              ko.LastUpdateTimeUnix(),
@@ -694,6 +718,8 @@ func DocIDAndTimeFromBinary(in []byte) (docID uint64, updateTime int64, err erro
 // n          | uint16+[]byte | target vectors segment: sequence of vec_length + vec (uint16 + []byte), (uint16 + []byte) ...
 // 4          | uint32        | length of multivectors as msgpack
 // n          | []byte        | multivectors as msgpack
+// 4          | uint32        | length of sparse vectors as msgpack
+// n          | []byte        | sparse vectors as msgpack
 
 const (
 	maxVectorLength               int = math.MaxUint16
@@ -806,6 +832,17 @@ func (ko *Object) MarshalBinary() ([]byte, error) {
 		}
 	}
 
+	var sparseVectorsPacked []byte
+	if len(ko.SparseVectors) > 0 {
+		sparseVectorsPacked, err = marshalSparseVectors(ko.SparseVectors)
+		if err != nil {
+			return nil, fmt.Errorf("could not marshal sparse vectors: %w", err)
+		}
+		if len(sparseVectorsPacked) > maxTargetVectorsSegmentLength {
+			return nil, fmt.Errorf("could not marshal '%s' max length exceeded (%d/%d)", "sparseVectors", len(sparseVectorsPacked), maxTargetVectorsSegmentLength)
+		}
+	}
+
 	totalBufferLength := 1 + 8 + 1 + 16 + 8 + 8 +
 		2 + vectorLength*4 +
 		2 + classNameLength +
@@ -814,7 +851,8 @@ func (ko *Object) MarshalBinary() ([]byte, error) {
 		4 + vectorWeightsLength +
 		4 + targetVectorsOffsetsLength +
 		4 + uint32(targetVectorsSegmentLength) +
-		4 + uint32(len(multiVectorsPacked)) // multivectors
+		4 + uint32(len(multiVectorsPacked)) + // multivectors
+		4 + uint32(len(sparseVectorsPacked)) // sparse vectors
 
 	byteBuffer := make([]byte, totalBufferLength)
 	rw := byteops.NewReadWriter(byteBuffer)
@@ -883,6 +921,14 @@ func (ko *Object) MarshalBinary() ([]byte, error) {
 		}
 	}
 
+	rw.WriteUint32(uint32(len(sparseVectorsPacked)))
+	if len(sparseVectorsPacked) > 0 {
+		err = rw.CopyBytesToBuffer(sparseVectorsPacked)
+		if err != nil {
+			return byteBuffer, errors.Wrap(err, "Could not copy sparse vectors")
+		}
+	}
+
 	return byteBuffer, nil
 }
 
@@ -1087,7 +1133,20 @@ func (ko *Object) UnmarshalBinary(data []byte) error {
 		}
 	}
 
-	return ko.parseObject(
+	if rw.Position < uint64(len(rw.Buffer)) {
+		sparseVectorsLength := rw.ReadUint32()
+		if sparseVectorsLength > 0 {
+			sparseVectors, err := rw.CopyBytesFromBuffer(uint64(sparseVectorsLength), nil)
+			if err != nil {
+				return errors.Wrap(err, "Could not copy sparse vectors")
+			}
+			if ko.SparseVectors, err = unmarshalSparseVectors(sparseVectors); err != nil {
+				return errors.Wrap(err, "Could not unmarshal sparse vectors")
+			}
+		}
+	}
+
+	if err := ko.parseObject(
 		strfmt.UUID(uuidParsed.String()),
 		createTime,
 		updateTime,
@@ -1095,7 +1154,39 @@ func (ko *Object) UnmarshalBinary(data []byte) error {
 		schema,
 		meta,
 		vectorWeights, nil, 0,
-	)
+	); err != nil {
+		return err
+	}
+	ko.Object.SparseVectors = ko.SparseVectors
+
+	return nil
+}
+
+// sparseVector is the msgpack representation of models.SparseVector, whose
+// generated binary marshaller can't be used by msgpack
+type sparseVector struct {
+	Indices []uint32  `msgpack:"i"`
+	Values  []float32 `msgpack:"v"`
+}
+
+func marshalSparseVectors(in models.SparseVectors) ([]byte, error) {
+	out := make(map[string]sparseVector, len(in))
+	for name, vec := range in {
+		out[name] = sparseVector{Indices: vec.Indices, Values: vec.Values}
+	}
+	return msgpack.Marshal(out)
+}
+
+func unmarshalSparseVectors(in []byte) (models.SparseVectors, error) {
+	var packed map[string]sparseVector
+	if err := msgpack.Unmarshal(in, &packed); err != nil {
+		return nil, err
+	}
+	out := make(models.SparseVectors, len(packed))
+	for name, vec := range packed {
+		out[name] = models.SparseVector{Indices: vec.Indices, Values: vec.Values}
+	}
+	return out, nil
 }
 
 func unmarshalTargetVectors(rw *byteops.ReadWriter) (map[string][]float32, error) {
@@ -1349,6 +1440,21 @@ func (ko *Object) parseObject(uuid strfmt.UUID, create, update int64, className
 				additionalProperties["group"] = &group
 			}
 		}
+
+		if prop, ok := additionalProperties["queryPlan"]; ok {
+			if planSlice, ok := prop.([]interface{}); ok {
+				marshalled, err := json.Marshal(planSlice)
+				if err != nil {
+					return err
+				}
+				var plans []*additional.QueryPlan
+				err = json.Unmarshal(marshalled, &plans)
+				if err != nil {
+					return err
+				}
+				additionalProperties["queryPlan"] = plans
+			}
+		}
 	}
 
 	var vectorWeights interface{}
@@ -1382,6 +1488,7 @@ func (ko *Object) DeepCopyDangerous() *Object {
 		Object:            deepCopyObject(ko.Object),
 		Vector:            deepCopyVector(ko.Vector),
 		Vectors:           deepCopyVectors(ko.Vectors),
+		SparseVectors:     deepCopySparseVectors(ko.SparseVectors),
 	}
 
 	return o
@@ -1419,7 +1526,21 @@ func deepCopyObject(orig models.Object) models.Object {
 		Additional:         orig.Additional, // WARNING: not a deep copy!!
 		Properties:         deepCopyProperties(orig.Properties),
 		Vectors:            deepCopyVectors(orig.Vectors),
+		SparseVectors:      deepCopySparseVectors(orig.SparseVectors),
+	}
+}
+
+func deepCopySparseVectors(orig models.SparseVectors) models.SparseVectors {
+	if orig == nil {
+		return nil
 	}
+	out := make(models.SparseVectors, len(orig))
+	for key, vec := range orig {
+		indices := make([]uint32, len(vec.Indices))
+		copy(indices, vec.Indices)
+		out[key] = models.SparseVector{Indices: indices, Values: deepCopyVector(vec.Values)}
+	}
+	return out
 }
 
 func deepCopyProperties(orig models.PropertySchema) models.PropertySchema {
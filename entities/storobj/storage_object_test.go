@@ -364,6 +364,65 @@ func TestStorageObjectUnMarshallingMultiVector(t *testing.T) {
 	})
 }
 
+func TestStorageObjectMarshallingSparseVectors(t *testing.T) {
+	before := FromObjectMulti(
+		&models.Object{
+			Class:              "MyFavoriteClass",
+			CreationTimeUnix:   123456,
+			LastUpdateTimeUnix: 56789,
+			ID:                 strfmt.UUID("73f2eb5f-5abf-447a-81ca-74b1dd168247"),
+			Properties: map[string]interface{}{
+				"name": "MyName",
+			},
+			SparseVectors: models.SparseVectors{
+				"splade": {Indices: []uint32{3, 17, 2048}, Values: []float32{0.5, 1.25, 0.125}},
+				"bge":    {Indices: []uint32{1}, Values: []float32{2}},
+			},
+		},
+		[]float32{1, 2, 0.7},
+		models.Vectors{
+			"vector1": {1, 2, 3},
+		},
+		map[string][][]float32{
+			"vector2": {{7, 8, 9}, {10, 11, 12}},
+		},
+	)
+	before.DocID = 7
+
+	asBinary, err := before.MarshalBinary()
+	require.Nil(t, err)
+
+	t.Run("compare", func(t *testing.T) {
+		after, err := FromBinary(asBinary)
+		require.Nil(t, err)
+		assert.Equal(t, before, after)
+	})
+
+	t.Run("with vectors but without multivectors", func(t *testing.T) {
+		after, err := FromBinaryOptional(asBinary, additional.Properties{Vectors: []string{"splade"}}, nil)
+		require.Nil(t, err)
+		assert.Equal(t, before.SparseVectors, after.SparseVectors)
+		assert.Equal(t, before.SparseVectors, after.Object.SparseVectors)
+		assert.Nil(t, after.MultiVectors)
+	})
+
+	t.Run("without vectors", func(t *testing.T) {
+		after, err := FromBinaryOptional(asBinary, additional.Properties{}, nil)
+		require.Nil(t, err)
+		assert.Nil(t, after.SparseVectors)
+		assert.Equal(t, "MyName", after.Properties().(map[string]interface{})["name"])
+	})
+
+	t.Run("search result", func(t *testing.T) {
+		after, err := FromBinary(asBinary)
+		require.Nil(t, err)
+		res := after.SearchResult(additional.Properties{}, "")
+		assert.Equal(t, before.SparseVectors, res.SparseVectors)
+		assert.Equal(t, before.SparseVectors, res.ObjectWithVector(true).SparseVectors)
+		assert.Nil(t, res.ObjectWithVector(false).SparseVectors)
+	})
+}
+
 func TestFilteringNilProperty(t *testing.T) {
 	object := FromObject(
 		&models.Object{
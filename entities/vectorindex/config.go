@@ -18,6 +18,9 @@ import (
 	"github.com/weaviate/weaviate/entities/vectorindex/dynamic"
 	"github.com/weaviate/weaviate/entities/vectorindex/flat"
 	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/entities/vectorindex/ivf"
+	"github.com/weaviate/weaviate/entities/vectorindex/sparse"
+	"github.com/weaviate/weaviate/entities/vectorindex/vamana"
 )
 
 const (
@@ -25,6 +28,9 @@ const (
 	VectorIndexTypeHNSW    = "hnsw"
 	VectorIndexTypeFLAT    = "flat"
 	VectorIndexTypeDYNAMIC = "dynamic"
+	VectorIndexTypeIVF     = "ivf"
+	VectorIndexTypeVAMANA  = "vamana"
+	VectorIndexTypeSPARSE  = "sparse"
 )
 
 // ParseAndValidateConfig from an unknown input value, as this is not further
@@ -38,10 +44,16 @@ func ParseAndValidateConfig(input interface{}, vectorIndexType string, isMultiVe
 	case VectorIndexTypeHNSW:
 		return hnsw.ParseAndValidateConfig(input, isMultiVector)
 	case VectorIndexTypeFLAT:
-		return flat.ParseAndValidateConfig(input)
+		return flat.ParseAndValidateConfig(input, isMultiVector)
 	case VectorIndexTypeDYNAMIC:
 		return dynamic.ParseAndValidateConfig(input, isMultiVector)
+	case VectorIndexTypeIVF:
+		return ivf.ParseAndValidateConfig(input)
+	case VectorIndexTypeVAMANA:
+		return vamana.ParseAndValidateConfig(input)
+	case VectorIndexTypeSPARSE:
+		return sparse.ParseAndValidateConfig(input)
 	default:
-		return nil, fmt.Errorf("invalid vector index %q. Supported types are hnsw and flat", vectorIndexType)
+		return nil, fmt.Errorf("invalid vector index %q. Supported types are hnsw, flat, dynamic, ivf, vamana and sparse", vectorIndexType)
 	}
 }
@@ -12,23 +12,33 @@
 package dynamic
 
 import (
+	"errors"
 	"fmt"
 
 	schemaConfig "github.com/weaviate/weaviate/entities/schema/config"
 	"github.com/weaviate/weaviate/entities/vectorindex/common"
 	"github.com/weaviate/weaviate/entities/vectorindex/flat"
 	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/entities/vectorindex/vamana"
 )
 
 const (
 	DefaultThreshold = 10_000
+	DefaultUpgradeTo = UpgradeToHNSW
+
+	// UpgradeToHNSW and UpgradeToVamana are the index types a dynamic index
+	// can be upgraded to once it exceeds the threshold
+	UpgradeToHNSW   = "hnsw"
+	UpgradeToVamana = "vamana"
 )
 
 type UserConfig struct {
-	Distance  string          `json:"distance"`
-	Threshold uint64          `json:"threshold"`
-	HnswUC    hnsw.UserConfig `json:"hnsw"`
-	FlatUC    flat.UserConfig `json:"flat"`
+	Distance  string            `json:"distance"`
+	Threshold uint64            `json:"threshold"`
+	UpgradeTo string            `json:"upgradeTo"`
+	HnswUC    hnsw.UserConfig   `json:"hnsw"`
+	FlatUC    flat.UserConfig   `json:"flat"`
+	VamanaUC  vamana.UserConfig `json:"vamana"`
 }
 
 // IndexType returns the type of the underlying vector index, thus making sure
@@ -48,9 +58,11 @@ func (u UserConfig) IsMultiVector() bool {
 // SetDefaults in the user-specifyable part of the config
 func (u *UserConfig) SetDefaults() {
 	u.Threshold = DefaultThreshold
+	u.UpgradeTo = DefaultUpgradeTo
 	u.Distance = common.DefaultDistanceMetric
 	u.HnswUC = hnsw.NewDefaultUserConfig()
 	u.FlatUC = flat.NewDefaultUserConfig()
+	u.VamanaUC = vamana.NewDefaultUserConfig()
 }
 
 func NewDefaultUserConfig() UserConfig {
@@ -61,6 +73,10 @@ func NewDefaultUserConfig() UserConfig {
 
 // ParseAndValidateConfig from an unknown input value, as this is not further
 // specified in the API to allow of exchanging the index type
+// the candidates are only re-ranked with the full vectors for hnsw and flat
+// indexes
+var errMatryoshkaNotSupported = errors.New("matryoshka is not supported for dynamic indices")
+
 func ParseAndValidateConfig(input interface{}, isMultiVector bool) (schemaConfig.VectorIndexConfig, error) {
 	uc := UserConfig{}
 	uc.SetDefaults()
@@ -86,6 +102,16 @@ func ParseAndValidateConfig(input interface{}, isMultiVector bool) (schemaConfig
 		return uc, err
 	}
 
+	if err := common.OptionalStringFromMap(asMap, "upgradeTo", func(v string) {
+		uc.UpgradeTo = v
+	}); err != nil {
+		return uc, err
+	}
+	if uc.UpgradeTo != UpgradeToHNSW && uc.UpgradeTo != UpgradeToVamana {
+		return uc, fmt.Errorf("upgradeTo must be one of %q or %q, got %q",
+			UpgradeToHNSW, UpgradeToVamana, uc.UpgradeTo)
+	}
+
 	hnswConfig, ok := asMap["hnsw"]
 	if ok && hnswConfig != nil {
 		hnswUC, err := hnsw.ParseAndValidateConfig(hnswConfig, isMultiVector)
@@ -97,15 +123,32 @@ func ParseAndValidateConfig(input interface{}, isMultiVector bool) (schemaConfig
 		if !ok {
 			return uc, fmt.Errorf("invalid hnsw configuration")
 		}
+		if castedHnswUC.Matryoshka.Enabled() {
+			return uc, errMatryoshkaNotSupported
+		}
 		uc.HnswUC = castedHnswUC
 	}
 
+	vamanaConfig, ok := asMap["vamana"]
+	if ok && vamanaConfig != nil {
+		vamanaUC, err := vamana.ParseAndValidateConfig(vamanaConfig)
+		if err != nil {
+			return uc, err
+		}
+
+		castedVamanaUC, ok := vamanaUC.(vamana.UserConfig)
+		if !ok {
+			return uc, fmt.Errorf("invalid vamana configuration")
+		}
+		uc.VamanaUC = castedVamanaUC
+	}
+
 	flatConfig, ok := asMap["flat"]
 	if !ok || flatConfig == nil {
 		return uc, nil
 	}
 
-	flatUC, err := flat.ParseAndValidateConfig(flatConfig)
+	flatUC, err := flat.ParseAndValidateConfig(flatConfig, isMultiVector)
 	if err != nil {
 		return uc, err
 	}
@@ -114,6 +157,9 @@ func ParseAndValidateConfig(input interface{}, isMultiVector bool) (schemaConfig
 	if !ok {
 		return uc, fmt.Errorf("invalid flat configuration")
 	}
+	if castedFlatUC.Matryoshka.Enabled() {
+		return uc, errMatryoshkaNotSupported
+	}
 	uc.FlatUC = castedFlatUC
 
 	return uc, nil
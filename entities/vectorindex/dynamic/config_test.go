@@ -19,6 +19,7 @@ import (
 	"github.com/weaviate/weaviate/entities/vectorindex/common"
 	"github.com/weaviate/weaviate/entities/vectorindex/flat"
 	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/entities/vectorindex/vamana"
 )
 
 func Test_DynamicUserConfig(t *testing.T) {
@@ -37,6 +38,7 @@ func Test_DynamicUserConfig(t *testing.T) {
 			expected: UserConfig{
 				Distance:  common.DefaultDistanceMetric,
 				Threshold: DefaultThreshold,
+				UpgradeTo: DefaultUpgradeTo,
 				HnswUC: hnsw.UserConfig{
 					CleanupIntervalSeconds: hnsw.DefaultCleanupIntervalSeconds,
 					MaxConnections:         hnsw.DefaultMaxConnections,
@@ -64,11 +66,19 @@ func Test_DynamicUserConfig(t *testing.T) {
 						TrainingLimit: hnsw.DefaultSQTrainingLimit,
 						RescoreLimit:  hnsw.DefaultSQRescoreLimit,
 					},
+					RQ: hnsw.RQConfig{
+						Enabled:      hnsw.DefaultRQEnabled,
+						Bits:         hnsw.DefaultRQBits,
+						RescoreLimit: hnsw.DefaultRQRescoreLimit,
+					},
 					FilterStrategy: hnsw.DefaultFilterStrategy,
 					Multivector: hnsw.MultivectorConfig{
 						Enabled:     hnsw.DefaultMultivectorEnabled,
 						Aggregation: hnsw.DefaultMultivectorAggregation,
+						TopK:        hnsw.DefaultMultivectorTopK,
+						RerankLimit: hnsw.DefaultMultivectorRerankLimit,
 					},
+					Matryoshka: hnsw.NewDefaultMatryoshkaConfig(),
 				},
 				FlatUC: flat.UserConfig{
 					VectorCacheMaxObjects: common.DefaultVectorCacheMaxObjects,
@@ -88,7 +98,15 @@ func Test_DynamicUserConfig(t *testing.T) {
 						RescoreLimit: flat.DefaultCompressionRescore,
 						Cache:        flat.DefaultVectorCache,
 					},
+					RQ: hnsw.RQConfig{
+						Enabled:      flat.DefaultCompressionEnabled,
+						Bits:         hnsw.DefaultRQBits,
+						RescoreLimit: flat.DefaultCompressionRescore,
+					},
+					Multivector: hnsw.NewDefaultMultivectorConfig(),
+					Matryoshka:  hnsw.NewDefaultMatryoshkaConfig(),
 				},
+				VamanaUC: vamana.NewDefaultUserConfig(),
 			},
 		},
 		{
@@ -99,6 +117,7 @@ func Test_DynamicUserConfig(t *testing.T) {
 			expected: UserConfig{
 				Distance:  common.DefaultDistanceMetric,
 				Threshold: 100,
+				UpgradeTo: DefaultUpgradeTo,
 				HnswUC: hnsw.UserConfig{
 					CleanupIntervalSeconds: hnsw.DefaultCleanupIntervalSeconds,
 					MaxConnections:         hnsw.DefaultMaxConnections,
@@ -126,11 +145,19 @@ func Test_DynamicUserConfig(t *testing.T) {
 						TrainingLimit: hnsw.DefaultSQTrainingLimit,
 						RescoreLimit:  hnsw.DefaultSQRescoreLimit,
 					},
+					RQ: hnsw.RQConfig{
+						Enabled:      hnsw.DefaultRQEnabled,
+						Bits:         hnsw.DefaultRQBits,
+						RescoreLimit: hnsw.DefaultRQRescoreLimit,
+					},
 					FilterStrategy: hnsw.DefaultFilterStrategy,
 					Multivector: hnsw.MultivectorConfig{
 						Enabled:     hnsw.DefaultMultivectorEnabled,
 						Aggregation: hnsw.DefaultMultivectorAggregation,
+						TopK:        hnsw.DefaultMultivectorTopK,
+						RerankLimit: hnsw.DefaultMultivectorRerankLimit,
 					},
+					Matryoshka: hnsw.NewDefaultMatryoshkaConfig(),
 				},
 				FlatUC: flat.UserConfig{
 					VectorCacheMaxObjects: common.DefaultVectorCacheMaxObjects,
@@ -150,7 +177,15 @@ func Test_DynamicUserConfig(t *testing.T) {
 						RescoreLimit: flat.DefaultCompressionRescore,
 						Cache:        flat.DefaultVectorCache,
 					},
+					RQ: hnsw.RQConfig{
+						Enabled:      flat.DefaultCompressionEnabled,
+						Bits:         hnsw.DefaultRQBits,
+						RescoreLimit: flat.DefaultCompressionRescore,
+					},
+					Multivector: hnsw.NewDefaultMultivectorConfig(),
+					Matryoshka:  hnsw.NewDefaultMatryoshkaConfig(),
 				},
+				VamanaUC: vamana.NewDefaultUserConfig(),
 			},
 		},
 		{
@@ -182,6 +217,7 @@ func Test_DynamicUserConfig(t *testing.T) {
 			expected: UserConfig{
 				Distance:  common.DefaultDistanceMetric,
 				Threshold: DefaultThreshold,
+				UpgradeTo: DefaultUpgradeTo,
 				HnswUC: hnsw.UserConfig{
 					CleanupIntervalSeconds: 11,
 					MaxConnections:         12,
@@ -208,11 +244,19 @@ func Test_DynamicUserConfig(t *testing.T) {
 						TrainingLimit: hnsw.DefaultSQTrainingLimit,
 						RescoreLimit:  hnsw.DefaultSQRescoreLimit,
 					},
+					RQ: hnsw.RQConfig{
+						Enabled:      hnsw.DefaultRQEnabled,
+						Bits:         hnsw.DefaultRQBits,
+						RescoreLimit: hnsw.DefaultRQRescoreLimit,
+					},
 					FilterStrategy: hnsw.FilterStrategyAcorn,
 					Multivector: hnsw.MultivectorConfig{
 						Enabled:     hnsw.DefaultMultivectorEnabled,
 						Aggregation: hnsw.DefaultMultivectorAggregation,
+						TopK:        hnsw.DefaultMultivectorTopK,
+						RerankLimit: hnsw.DefaultMultivectorRerankLimit,
 					},
+					Matryoshka: hnsw.NewDefaultMatryoshkaConfig(),
 				},
 				FlatUC: flat.UserConfig{
 					VectorCacheMaxObjects: common.DefaultVectorCacheMaxObjects,
@@ -232,7 +276,15 @@ func Test_DynamicUserConfig(t *testing.T) {
 						RescoreLimit: flat.DefaultCompressionRescore,
 						Cache:        flat.DefaultVectorCache,
 					},
+					RQ: hnsw.RQConfig{
+						Enabled:      flat.DefaultCompressionEnabled,
+						Bits:         hnsw.DefaultRQBits,
+						RescoreLimit: flat.DefaultCompressionRescore,
+					},
+					Multivector: hnsw.NewDefaultMultivectorConfig(),
+					Matryoshka:  hnsw.NewDefaultMatryoshkaConfig(),
 				},
+				VamanaUC: vamana.NewDefaultUserConfig(),
 			},
 		},
 		{
@@ -251,6 +303,7 @@ func Test_DynamicUserConfig(t *testing.T) {
 			expected: UserConfig{
 				Distance:  common.DefaultDistanceMetric,
 				Threshold: DefaultThreshold,
+				UpgradeTo: DefaultUpgradeTo,
 				HnswUC: hnsw.UserConfig{
 					CleanupIntervalSeconds: hnsw.DefaultCleanupIntervalSeconds,
 					MaxConnections:         hnsw.DefaultMaxConnections,
@@ -278,11 +331,19 @@ func Test_DynamicUserConfig(t *testing.T) {
 						TrainingLimit: hnsw.DefaultSQTrainingLimit,
 						RescoreLimit:  hnsw.DefaultSQRescoreLimit,
 					},
+					RQ: hnsw.RQConfig{
+						Enabled:      hnsw.DefaultRQEnabled,
+						Bits:         hnsw.DefaultRQBits,
+						RescoreLimit: hnsw.DefaultRQRescoreLimit,
+					},
 					FilterStrategy: hnsw.DefaultFilterStrategy,
 					Multivector: hnsw.MultivectorConfig{
 						Enabled:     hnsw.DefaultMultivectorEnabled,
 						Aggregation: hnsw.DefaultMultivectorAggregation,
+						TopK:        hnsw.DefaultMultivectorTopK,
+						RerankLimit: hnsw.DefaultMultivectorRerankLimit,
 					},
+					Matryoshka: hnsw.NewDefaultMatryoshkaConfig(),
 				},
 				FlatUC: flat.UserConfig{
 					VectorCacheMaxObjects: 100,
@@ -302,8 +363,56 @@ func Test_DynamicUserConfig(t *testing.T) {
 						RescoreLimit: flat.DefaultCompressionRescore,
 						Cache:        flat.DefaultVectorCache,
 					},
+					RQ: hnsw.RQConfig{
+						Enabled:      flat.DefaultCompressionEnabled,
+						Bits:         hnsw.DefaultRQBits,
+						RescoreLimit: flat.DefaultCompressionRescore,
+					},
+					Multivector: hnsw.NewDefaultMultivectorConfig(),
+					Matryoshka:  hnsw.NewDefaultMatryoshkaConfig(),
+				},
+				VamanaUC: vamana.NewDefaultUserConfig(),
+			},
+		},
+		{
+			name: "upgrade to vamana",
+			input: map[string]interface{}{
+				"upgradeTo": "vamana",
+				"vamana": map[string]interface{}{
+					"maxDegree": float64(32),
+				},
+			},
+			expected: UserConfig{
+				Distance:  common.DefaultDistanceMetric,
+				Threshold: DefaultThreshold,
+				UpgradeTo: UpgradeToVamana,
+				HnswUC:    hnsw.NewDefaultUserConfig(),
+				FlatUC:    flat.NewDefaultUserConfig(),
+				VamanaUC: func() vamana.UserConfig {
+					uc := vamana.NewDefaultUserConfig()
+					uc.MaxDegree = 32
+					return uc
+				}(),
+			},
+		},
+		{
+			name: "unsupported upgrade target returns error",
+			input: map[string]interface{}{
+				"upgradeTo": "flat",
+			},
+			expectErr:    true,
+			expectErrMsg: `upgradeTo must be one of "hnsw" or "vamana", got "flat"`,
+		},
+		{
+			name: "invalid vamana config returns error",
+			input: map[string]interface{}{
+				"upgradeTo": "vamana",
+				"vamana": map[string]interface{}{
+					"alpha": 0.5,
 				},
 			},
+			expectErr:    true,
+			expectErrMsg: "alpha",
 		},
 		{
 			name: "pq enabled with flat returns error",
@@ -321,6 +430,18 @@ func Test_DynamicUserConfig(t *testing.T) {
 			expectErr:    true,
 			expectErrMsg: "PQ is not currently supported for flat indices",
 		},
+		{
+			name: "matryoshka with hnsw returns error",
+			input: map[string]interface{}{
+				"hnsw": map[string]interface{}{
+					"matryoshka": map[string]interface{}{
+						"dimensions": float64(256),
+					},
+				},
+			},
+			expectErr:    true,
+			expectErrMsg: "matryoshka is not supported for dynamic indices",
+		},
 	}
 
 	for _, test := range tests {
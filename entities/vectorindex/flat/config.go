@@ -17,6 +17,7 @@ import (
 
 	schemaConfig "github.com/weaviate/weaviate/entities/schema/config"
 	vectorindexcommon "github.com/weaviate/weaviate/entities/vectorindex/common"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 )
 
 const (
@@ -33,11 +34,14 @@ type CompressionUserConfig struct {
 }
 
 type UserConfig struct {
-	Distance              string                `json:"distance"`
-	VectorCacheMaxObjects int                   `json:"vectorCacheMaxObjects"`
-	PQ                    CompressionUserConfig `json:"pq"`
-	BQ                    CompressionUserConfig `json:"bq"`
-	SQ                    CompressionUserConfig `json:"sq"`
+	Distance              string                 `json:"distance"`
+	VectorCacheMaxObjects int                    `json:"vectorCacheMaxObjects"`
+	PQ                    CompressionUserConfig  `json:"pq"`
+	BQ                    CompressionUserConfig  `json:"bq"`
+	SQ                    CompressionUserConfig  `json:"sq"`
+	RQ                    hnsw.RQConfig          `json:"rq"`
+	Multivector           hnsw.MultivectorConfig `json:"multivector"`
+	Matryoshka            hnsw.MatryoshkaConfig  `json:"matryoshka"`
 }
 
 // IndexType returns the type of the underlying vector index, thus making sure
@@ -51,7 +55,7 @@ func (u UserConfig) DistanceName() string {
 }
 
 func (u UserConfig) IsMultiVector() bool {
-	return false
+	return u.Multivector.Enabled
 }
 
 // SetDefaults in the user-specifyable part of the config
@@ -66,11 +70,16 @@ func (u *UserConfig) SetDefaults() {
 	u.BQ.RescoreLimit = DefaultCompressionRescore
 	u.SQ.Enabled = DefaultCompressionEnabled
 	u.SQ.RescoreLimit = DefaultCompressionRescore
+	u.RQ.Enabled = DefaultCompressionEnabled
+	u.RQ.Bits = hnsw.DefaultRQBits
+	u.RQ.RescoreLimit = DefaultCompressionRescore
+	u.Multivector = hnsw.NewDefaultMultivectorConfig()
+	u.Matryoshka = hnsw.NewDefaultMatryoshkaConfig()
 }
 
 // ParseAndValidateConfig from an unknown input value, as this is not further
 // specified in the API to allow of exchanging the index type
-func ParseAndValidateConfig(input interface{}) (schemaConfig.VectorIndexConfig, error) {
+func ParseAndValidateConfig(input interface{}, isMultiVector bool) (schemaConfig.VectorIndexConfig, error) {
 	uc := UserConfig{}
 	uc.SetDefaults()
 
@@ -99,9 +108,35 @@ func ParseAndValidateConfig(input interface{}) (schemaConfig.VectorIndexConfig,
 		return uc, err
 	}
 
+	if err := hnsw.ValidateRQConfig(uc.RQ, uc.Distance); err != nil {
+		return uc, err
+	}
+
+	if err := hnsw.ParseMultivectorMap(asMap, &uc.Multivector, isMultiVector); err != nil {
+		return uc, err
+	}
+	if err := hnsw.ValidateMultivectorConfig(uc.Multivector); err != nil {
+		return uc, err
+	}
+	if err := hnsw.ParseMatryoshkaMap(asMap, &uc.Matryoshka); err != nil {
+		return uc, err
+	}
+	if err := hnsw.ValidateMatryoshkaConfig(uc.Matryoshka, uc.Multivector.Enabled); err != nil {
+		return uc, err
+	}
+
+	// TODO: remove once compressed multi vectors are supported
+	if uc.Multivector.Enabled && extractEnabledCompression(uc) {
+		return uc, errors.New("compression is not currently supported for flat multivector indices")
+	}
+
 	return uc, nil
 }
 
+func extractEnabledCompression(uc UserConfig) bool {
+	return uc.PQ.Enabled || uc.BQ.Enabled || uc.SQ.Enabled || uc.RQ.Enabled
+}
+
 func parseCompressionMap(in interface{}, cuc *CompressionUserConfig) error {
 	configMap, ok := in.(map[string]interface{})
 	if ok {
@@ -130,8 +165,9 @@ func parseCompression(in map[string]interface{}, uc *UserConfig) error {
 	pqConfigValue, pqOk := in["pq"]
 	bqConfigValue, bqOk := in["bq"]
 	sqConfigValue, sqOk := in["sq"]
+	_, rqOk := in["rq"]
 
-	if !pqOk && !bqOk && !sqOk {
+	if !pqOk && !bqOk && !sqOk && !rqOk {
 		return nil
 	}
 
@@ -156,8 +192,18 @@ func parseCompression(in map[string]interface{}, uc *UserConfig) error {
 		}
 	}
 
+	if rqOk {
+		err := hnsw.ParseRQMap(in, &uc.RQ)
+		if err != nil {
+			return err
+		}
+	}
+
 	compressionConfigs := []CompressionUserConfig{uc.PQ, uc.BQ, uc.SQ}
 	totalEnabled := 0
+	if uc.RQ.Enabled {
+		totalEnabled++
+	}
 
 	for _, compressionConfig := range compressionConfigs {
 		if compressionConfig.Cache && !compressionConfig.Enabled {
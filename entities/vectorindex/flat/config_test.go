@@ -17,6 +17,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/weaviate/weaviate/entities/vectorindex/common"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 )
 
 func Test_FlatUserConfig(t *testing.T) {
@@ -50,6 +51,13 @@ func Test_FlatUserConfig(t *testing.T) {
 					RescoreLimit: DefaultCompressionRescore,
 					Cache:        DefaultVectorCache,
 				},
+				RQ: hnsw.RQConfig{
+					Enabled:      DefaultCompressionEnabled,
+					Bits:         hnsw.DefaultRQBits,
+					RescoreLimit: DefaultCompressionRescore,
+				},
+				Multivector: hnsw.NewDefaultMultivectorConfig(),
+				Matryoshka:  hnsw.NewDefaultMatryoshkaConfig(),
 			},
 		},
 		{
@@ -81,6 +89,13 @@ func Test_FlatUserConfig(t *testing.T) {
 					RescoreLimit: DefaultCompressionRescore,
 					Cache:        DefaultVectorCache,
 				},
+				RQ: hnsw.RQConfig{
+					Enabled:      DefaultCompressionEnabled,
+					Bits:         hnsw.DefaultRQBits,
+					RescoreLimit: DefaultCompressionRescore,
+				},
+				Multivector: hnsw.NewDefaultMultivectorConfig(),
+				Matryoshka:  hnsw.NewDefaultMatryoshkaConfig(),
 			},
 		},
 		{
@@ -130,11 +145,84 @@ func Test_FlatUserConfig(t *testing.T) {
 			expectErr:    true,
 			expectErrMsg: "cannot enable multiple quantization methods at the same time",
 		},
+		{
+			name: "rq enabled",
+			input: map[string]interface{}{
+				"distance": "l2-squared",
+				"rq": map[string]interface{}{
+					"enabled":      true,
+					"bits":         float64(1),
+					"rescoreLimit": float64(50),
+				},
+			},
+			expected: func() UserConfig {
+				uc := NewDefaultUserConfig()
+				uc.Distance = "l2-squared"
+				uc.RQ = hnsw.RQConfig{Enabled: true, Bits: 1, RescoreLimit: 50}
+				return uc
+			}(),
+		},
+		{
+			name: "rq with invalid bits",
+			input: map[string]interface{}{
+				"rq": map[string]interface{}{
+					"enabled": true,
+					"bits":    float64(0),
+				},
+			},
+			expectErr:    true,
+			expectErrMsg: "rq bits must be between 1 and 4, got 0",
+		},
+		{
+			name: "rq and bq enabled",
+			input: map[string]interface{}{
+				"rq": map[string]interface{}{
+					"enabled": true,
+				},
+				"bq": map[string]interface{}{
+					"enabled": true,
+				},
+			},
+			expectErr:    true,
+			expectErrMsg: "cannot enable multiple quantization methods at the same time",
+		},
+		{
+			name: "multivector enabled",
+			input: map[string]interface{}{
+				"distance": "dot",
+				"multivector": map[string]interface{}{
+					"enabled":     true,
+					"aggregation": "centroidRerank",
+					"rerankLimit": float64(20),
+				},
+			},
+			expected: func() UserConfig {
+				uc := NewDefaultUserConfig()
+				uc.Distance = "dot"
+				uc.Multivector.Enabled = true
+				uc.Multivector.Aggregation = hnsw.MultivectorAggregationCentroidRerank
+				uc.Multivector.RerankLimit = 20
+				return uc
+			}(),
+		},
+		{
+			name: "multivector with bq enabled",
+			input: map[string]interface{}{
+				"bq": map[string]interface{}{
+					"enabled": true,
+				},
+				"multivector": map[string]interface{}{
+					"enabled": true,
+				},
+			},
+			expectErr:    true,
+			expectErrMsg: "compression is not currently supported for flat multivector indices",
+		},
 	}
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			cfg, err := ParseAndValidateConfig(test.input)
+			cfg, err := ParseAndValidateConfig(test.input, false)
 			if test.expectErr {
 				require.NotNil(t, err)
 				assert.Contains(t, err.Error(), test.expectErrMsg)
@@ -33,6 +33,9 @@ const (
 
 	FilterStrategySweeping = "sweeping"
 	FilterStrategyAcorn    = "acorn"
+	// FilterStrategyAuto lets the shard pick a filter strategy per query based
+	// on the selectivity of the filter
+	FilterStrategyAuto = "auto"
 
 	DefaultFilterStrategy = FilterStrategySweeping
 
@@ -57,8 +60,10 @@ type UserConfig struct {
 	PQ                     PQConfig          `json:"pq"`
 	BQ                     BQConfig          `json:"bq"`
 	SQ                     SQConfig          `json:"sq"`
+	RQ                     RQConfig          `json:"rq"`
 	FilterStrategy         string            `json:"filterStrategy"`
 	Multivector            MultivectorConfig `json:"multivector"`
+	Matryoshka             MatryoshkaConfig  `json:"matryoshka"`
 }
 
 // IndexType returns the type of the underlying vector index, thus making sure
@@ -107,11 +112,14 @@ func (u *UserConfig) SetDefaults() {
 		TrainingLimit: DefaultSQTrainingLimit,
 		RescoreLimit:  DefaultSQRescoreLimit,
 	}
-	u.FilterStrategy = DefaultFilterStrategy
-	u.Multivector = MultivectorConfig{
-		Enabled:     DefaultMultivectorEnabled,
-		Aggregation: DefaultMultivectorAggregation,
+	u.RQ = RQConfig{
+		Enabled:      DefaultRQEnabled,
+		Bits:         DefaultRQBits,
+		RescoreLimit: DefaultRQRescoreLimit,
 	}
+	u.FilterStrategy = DefaultFilterStrategy
+	u.Multivector = NewDefaultMultivectorConfig()
+	u.Matryoshka = NewDefaultMatryoshkaConfig()
 }
 
 // ParseAndValidateConfig from an unknown input value, as this is not further
@@ -207,13 +215,21 @@ func ParseAndValidateConfig(input interface{}, isMultiVector bool) (config.Vecto
 		return uc, err
 	}
 
+	if err := ParseRQMap(asMap, &uc.RQ); err != nil {
+		return uc, err
+	}
+
 	if err := vectorIndexCommon.OptionalStringFromMap(asMap, "filterStrategy", func(v string) {
 		uc.FilterStrategy = v
 	}); err != nil {
 		return uc, err
 	}
 
-	if err := parseMultivectorMap(asMap, &uc.Multivector, isMultiVector); err != nil {
+	if err := ParseMultivectorMap(asMap, &uc.Multivector, isMultiVector); err != nil {
+		return uc, err
+	}
+
+	if err := ParseMatryoshkaMap(asMap, &uc.Matryoshka); err != nil {
 		return uc, err
 	}
 
@@ -236,8 +252,9 @@ func (u *UserConfig) validate() error {
 		))
 	}
 
-	if u.FilterStrategy != FilterStrategySweeping && u.FilterStrategy != FilterStrategyAcorn {
-		errMsgs = append(errMsgs, "filterStrategy must be either 'sweeping' or 'acorn'")
+	if u.FilterStrategy != FilterStrategySweeping && u.FilterStrategy != FilterStrategyAcorn &&
+		u.FilterStrategy != FilterStrategyAuto {
+		errMsgs = append(errMsgs, "filterStrategy must be one of 'sweeping', 'acorn' or 'auto'")
 	}
 
 	if len(errMsgs) > 0 {
@@ -255,10 +272,28 @@ func (u *UserConfig) validate() error {
 	if u.SQ.Enabled {
 		enabled++
 	}
+	if u.RQ.Enabled {
+		enabled++
+	}
 	if enabled > 1 {
 		return fmt.Errorf("invalid hnsw config: more than a single compression methods enabled")
 	}
 
+	if err := ValidateRQConfig(u.RQ, u.Distance); err != nil {
+		return fmt.Errorf("invalid hnsw config: %w", err)
+	}
+	if u.RQ.Enabled && u.Multivector.Enabled {
+		return fmt.Errorf("invalid hnsw config: rq is not supported for multivector indices")
+	}
+
+	if err := ValidateMultivectorConfig(u.Multivector); err != nil {
+		return fmt.Errorf("invalid hnsw config: %w", err)
+	}
+
+	if err := ValidateMatryoshkaConfig(u.Matryoshka, u.Multivector.Enabled); err != nil {
+		return fmt.Errorf("invalid hnsw config: %w", err)
+	}
+
 	return nil
 }
 
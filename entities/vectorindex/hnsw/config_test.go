@@ -62,11 +62,19 @@ func Test_UserConfig(t *testing.T) {
 					TrainingLimit: DefaultSQTrainingLimit,
 					RescoreLimit:  DefaultSQRescoreLimit,
 				},
+				RQ: RQConfig{
+					Enabled:      DefaultRQEnabled,
+					Bits:         DefaultRQBits,
+					RescoreLimit: DefaultRQRescoreLimit,
+				},
 				FilterStrategy: DefaultFilterStrategy,
 				Multivector: MultivectorConfig{
 					Enabled:     DefaultMultivectorEnabled,
 					Aggregation: DefaultMultivectorAggregation,
+					TopK:        DefaultMultivectorTopK,
+					RerankLimit: DefaultMultivectorRerankLimit,
 				},
+				Matryoshka: NewDefaultMatryoshkaConfig(),
 			},
 		},
 
@@ -102,11 +110,19 @@ func Test_UserConfig(t *testing.T) {
 					TrainingLimit: DefaultSQTrainingLimit,
 					RescoreLimit:  DefaultSQRescoreLimit,
 				},
+				RQ: RQConfig{
+					Enabled:      DefaultRQEnabled,
+					Bits:         DefaultRQBits,
+					RescoreLimit: DefaultRQRescoreLimit,
+				},
 				FilterStrategy: DefaultFilterStrategy,
 				Multivector: MultivectorConfig{
 					Enabled:     DefaultMultivectorEnabled,
 					Aggregation: DefaultMultivectorAggregation,
+					TopK:        DefaultMultivectorTopK,
+					RerankLimit: DefaultMultivectorRerankLimit,
 				},
+				Matryoshka: NewDefaultMatryoshkaConfig(),
 			},
 		},
 
@@ -153,11 +169,19 @@ func Test_UserConfig(t *testing.T) {
 					TrainingLimit: DefaultSQTrainingLimit,
 					RescoreLimit:  DefaultSQRescoreLimit,
 				},
+				RQ: RQConfig{
+					Enabled:      DefaultRQEnabled,
+					Bits:         DefaultRQBits,
+					RescoreLimit: DefaultRQRescoreLimit,
+				},
 				FilterStrategy: DefaultFilterStrategy,
 				Multivector: MultivectorConfig{
 					Enabled:     DefaultMultivectorEnabled,
 					Aggregation: DefaultMultivectorAggregation,
+					TopK:        DefaultMultivectorTopK,
+					RerankLimit: DefaultMultivectorRerankLimit,
 				},
+				Matryoshka: NewDefaultMatryoshkaConfig(),
 			},
 		},
 
@@ -204,11 +228,19 @@ func Test_UserConfig(t *testing.T) {
 					TrainingLimit: DefaultSQTrainingLimit,
 					RescoreLimit:  DefaultSQRescoreLimit,
 				},
+				RQ: RQConfig{
+					Enabled:      DefaultRQEnabled,
+					Bits:         DefaultRQBits,
+					RescoreLimit: DefaultRQRescoreLimit,
+				},
 				FilterStrategy: DefaultFilterStrategy,
 				Multivector: MultivectorConfig{
 					Enabled:     DefaultMultivectorEnabled,
 					Aggregation: DefaultMultivectorAggregation,
+					TopK:        DefaultMultivectorTopK,
+					RerankLimit: DefaultMultivectorRerankLimit,
 				},
+				Matryoshka: NewDefaultMatryoshkaConfig(),
 			},
 		},
 
@@ -256,11 +288,19 @@ func Test_UserConfig(t *testing.T) {
 					TrainingLimit: DefaultSQTrainingLimit,
 					RescoreLimit:  DefaultSQRescoreLimit,
 				},
+				RQ: RQConfig{
+					Enabled:      DefaultRQEnabled,
+					Bits:         DefaultRQBits,
+					RescoreLimit: DefaultRQRescoreLimit,
+				},
 				FilterStrategy: DefaultFilterStrategy,
 				Multivector: MultivectorConfig{
 					Enabled:     DefaultMultivectorEnabled,
 					Aggregation: DefaultMultivectorAggregation,
+					TopK:        DefaultMultivectorTopK,
+					RerankLimit: DefaultMultivectorRerankLimit,
 				},
+				Matryoshka: NewDefaultMatryoshkaConfig(),
 			},
 		},
 
@@ -305,11 +345,19 @@ func Test_UserConfig(t *testing.T) {
 					TrainingLimit: DefaultSQTrainingLimit,
 					RescoreLimit:  DefaultSQRescoreLimit,
 				},
+				RQ: RQConfig{
+					Enabled:      DefaultRQEnabled,
+					Bits:         DefaultRQBits,
+					RescoreLimit: DefaultRQRescoreLimit,
+				},
 				FilterStrategy: DefaultFilterStrategy,
 				Multivector: MultivectorConfig{
 					Enabled:     DefaultMultivectorEnabled,
 					Aggregation: DefaultMultivectorAggregation,
+					TopK:        DefaultMultivectorTopK,
+					RerankLimit: DefaultMultivectorRerankLimit,
 				},
+				Matryoshka: NewDefaultMatryoshkaConfig(),
 			},
 		},
 
@@ -363,11 +411,19 @@ func Test_UserConfig(t *testing.T) {
 					TrainingLimit: DefaultSQTrainingLimit,
 					RescoreLimit:  DefaultSQRescoreLimit,
 				},
+				RQ: RQConfig{
+					Enabled:      DefaultRQEnabled,
+					Bits:         DefaultRQBits,
+					RescoreLimit: DefaultRQRescoreLimit,
+				},
 				FilterStrategy: DefaultFilterStrategy,
 				Multivector: MultivectorConfig{
 					Enabled:     DefaultMultivectorEnabled,
 					Aggregation: DefaultMultivectorAggregation,
+					TopK:        DefaultMultivectorTopK,
+					RerankLimit: DefaultMultivectorRerankLimit,
 				},
+				Matryoshka: NewDefaultMatryoshkaConfig(),
 			},
 		},
 
@@ -420,11 +476,19 @@ func Test_UserConfig(t *testing.T) {
 					TrainingLimit: DefaultSQTrainingLimit,
 					RescoreLimit:  DefaultSQRescoreLimit,
 				},
+				RQ: RQConfig{
+					Enabled:      DefaultRQEnabled,
+					Bits:         DefaultRQBits,
+					RescoreLimit: DefaultRQRescoreLimit,
+				},
 				FilterStrategy: DefaultFilterStrategy,
 				Multivector: MultivectorConfig{
 					Enabled:     DefaultMultivectorEnabled,
 					Aggregation: DefaultMultivectorAggregation,
+					TopK:        DefaultMultivectorTopK,
+					RerankLimit: DefaultMultivectorRerankLimit,
 				},
+				Matryoshka: NewDefaultMatryoshkaConfig(),
 			},
 		},
 
@@ -497,11 +561,19 @@ func Test_UserConfig(t *testing.T) {
 					TrainingLimit: DefaultSQTrainingLimit,
 					RescoreLimit:  DefaultSQRescoreLimit,
 				},
+				RQ: RQConfig{
+					Enabled:      DefaultRQEnabled,
+					Bits:         DefaultRQBits,
+					RescoreLimit: DefaultRQRescoreLimit,
+				},
 				FilterStrategy: DefaultFilterStrategy,
 				Multivector: MultivectorConfig{
 					Enabled:     DefaultMultivectorEnabled,
 					Aggregation: DefaultMultivectorAggregation,
+					TopK:        DefaultMultivectorTopK,
+					RerankLimit: DefaultMultivectorRerankLimit,
 				},
+				Matryoshka: NewDefaultMatryoshkaConfig(),
 			},
 		},
 		{
@@ -585,11 +657,19 @@ func Test_UserConfig(t *testing.T) {
 					TrainingLimit: DefaultSQTrainingLimit,
 					RescoreLimit:  DefaultSQRescoreLimit,
 				},
+				RQ: RQConfig{
+					Enabled:      DefaultRQEnabled,
+					Bits:         DefaultRQBits,
+					RescoreLimit: DefaultRQRescoreLimit,
+				},
 				FilterStrategy: DefaultFilterStrategy,
 				Multivector: MultivectorConfig{
 					Enabled:     DefaultMultivectorEnabled,
 					Aggregation: DefaultMultivectorAggregation,
+					TopK:        DefaultMultivectorTopK,
+					RerankLimit: DefaultMultivectorRerankLimit,
 				},
+				Matryoshka: NewDefaultMatryoshkaConfig(),
 			},
 		},
 		{
@@ -634,11 +714,19 @@ func Test_UserConfig(t *testing.T) {
 					TrainingLimit: DefaultSQTrainingLimit,
 					RescoreLimit:  DefaultSQRescoreLimit,
 				},
+				RQ: RQConfig{
+					Enabled:      DefaultRQEnabled,
+					Bits:         DefaultRQBits,
+					RescoreLimit: DefaultRQRescoreLimit,
+				},
 				FilterStrategy: DefaultFilterStrategy,
 				Multivector: MultivectorConfig{
 					Enabled:     DefaultMultivectorEnabled,
 					Aggregation: DefaultMultivectorAggregation,
+					TopK:        DefaultMultivectorTopK,
+					RerankLimit: DefaultMultivectorRerankLimit,
 				},
+				Matryoshka: NewDefaultMatryoshkaConfig(),
 			},
 		},
 		{
@@ -663,7 +751,7 @@ func Test_UserConfig(t *testing.T) {
 				"filterStrategy": "chestnut",
 			},
 			expectErr:    true,
-			expectErrMsg: "invalid hnsw config: filterStrategy must be either 'sweeping' or 'acorn'",
+			expectErrMsg: "invalid hnsw config: filterStrategy must be one of 'sweeping', 'acorn' or 'auto'",
 		},
 		{
 			name: "acorn enabled, all defaults",
@@ -698,12 +786,177 @@ func Test_UserConfig(t *testing.T) {
 					TrainingLimit: DefaultSQTrainingLimit,
 					RescoreLimit:  DefaultSQRescoreLimit,
 				},
+				RQ: RQConfig{
+					Enabled:      DefaultRQEnabled,
+					Bits:         DefaultRQBits,
+					RescoreLimit: DefaultRQRescoreLimit,
+				},
 				FilterStrategy: FilterStrategyAcorn,
 				Multivector: MultivectorConfig{
 					Enabled:     DefaultMultivectorEnabled,
 					Aggregation: DefaultMultivectorAggregation,
+					TopK:        DefaultMultivectorTopK,
+					RerankLimit: DefaultMultivectorRerankLimit,
+				},
+				Matryoshka: NewDefaultMatryoshkaConfig(),
+			},
+		},
+		{
+			name: "auto filter strategy",
+			input: map[string]interface{}{
+				"filterStrategy": "auto",
+			},
+			expected: func() UserConfig {
+				uc := NewDefaultUserConfig()
+				uc.FilterStrategy = FilterStrategyAuto
+				return uc
+			}(),
+		},
+		{
+			name: "multivector with topKSum aggregation",
+			input: map[string]interface{}{
+				"multivector": map[string]interface{}{
+					"enabled":     true,
+					"aggregation": "topKSum",
+					"topK":        float64(8),
+				},
+			},
+			expected: func() UserConfig {
+				uc := NewDefaultUserConfig()
+				uc.Multivector.Enabled = true
+				uc.Multivector.Aggregation = MultivectorAggregationTopKSum
+				uc.Multivector.TopK = 8
+				return uc
+			}(),
+		},
+		{
+			name: "multivector with centroidRerank aggregation",
+			input: map[string]interface{}{
+				"multivector": map[string]interface{}{
+					"enabled":     true,
+					"aggregation": "centroidRerank",
+					"rerankLimit": float64(50),
+				},
+			},
+			expected: func() UserConfig {
+				uc := NewDefaultUserConfig()
+				uc.Multivector.Enabled = true
+				uc.Multivector.Aggregation = MultivectorAggregationCentroidRerank
+				uc.Multivector.RerankLimit = 50
+				return uc
+			}(),
+		},
+		{
+			name: "multivector with invalid aggregation",
+			input: map[string]interface{}{
+				"multivector": map[string]interface{}{
+					"enabled":     true,
+					"aggregation": "minSim",
+				},
+			},
+			expectErr:    true,
+			expectErrMsg: "invalid hnsw config: invalid aggregation type minSim",
+		},
+		{
+			name: "multivector with invalid topK",
+			input: map[string]interface{}{
+				"multivector": map[string]interface{}{
+					"enabled":     true,
+					"aggregation": "topKSum",
+					"topK":        float64(0),
 				},
 			},
+			expectErr:    true,
+			expectErrMsg: "multivector topK must be a positive integer, got 0",
+		},
+		{
+			name: "with rq",
+			input: map[string]interface{}{
+				"distance": "l2-squared",
+				"rq": map[string]interface{}{
+					"enabled":      true,
+					"bits":         float64(2),
+					"rescoreLimit": float64(100),
+				},
+			},
+			expected: func() UserConfig {
+				uc := NewDefaultUserConfig()
+				uc.Distance = common.DistanceL2Squared
+				uc.RQ = RQConfig{Enabled: true, Bits: 2, RescoreLimit: 100}
+				return uc
+			}(),
+		},
+		{
+			name: "with rq and too many bits",
+			input: map[string]interface{}{
+				"rq": map[string]interface{}{
+					"enabled": true,
+					"bits":    float64(8),
+				},
+			},
+			expectErr:    true,
+			expectErrMsg: "invalid hnsw config: rq bits must be between 1 and 4, got 8",
+		},
+		{
+			name: "with rq and unsupported distance",
+			input: map[string]interface{}{
+				"distance": "hamming",
+				"rq": map[string]interface{}{
+					"enabled": true,
+				},
+			},
+			expectErr:    true,
+			expectErrMsg: "invalid hnsw config: rq does not support distance \"hamming\"",
+		},
+		{
+			name: "with rq and sq",
+			input: map[string]interface{}{
+				"rq": map[string]interface{}{
+					"enabled": true,
+				},
+				"sq": map[string]interface{}{
+					"enabled": true,
+				},
+			},
+			expectErr:    true,
+			expectErrMsg: "invalid hnsw config: more than a single compression methods enabled",
+		},
+		{
+			name: "with matryoshka",
+			input: map[string]interface{}{
+				"matryoshka": map[string]interface{}{
+					"dimensions":   float64(256),
+					"rescoreLimit": float64(50),
+				},
+			},
+			expected: func() UserConfig {
+				uc := NewDefaultUserConfig()
+				uc.Matryoshka = MatryoshkaConfig{Dimensions: 256, RescoreLimit: 50}
+				return uc
+			}(),
+		},
+		{
+			name: "with negative matryoshka dimensions",
+			input: map[string]interface{}{
+				"matryoshka": map[string]interface{}{
+					"dimensions": float64(-1),
+				},
+			},
+			expectErr:    true,
+			expectErrMsg: "invalid hnsw config: matryoshka dimensions must not be negative, got -1",
+		},
+		{
+			name: "with matryoshka and multivector",
+			input: map[string]interface{}{
+				"matryoshka": map[string]interface{}{
+					"dimensions": float64(256),
+				},
+				"multivector": map[string]interface{}{
+					"enabled": true,
+				},
+			},
+			expectErr:    true,
+			expectErrMsg: "invalid hnsw config: matryoshka is not supported for multivector indices",
 		},
 	}
 
@@ -0,0 +1,86 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"fmt"
+
+	"github.com/weaviate/weaviate/entities/vectorindex/common"
+)
+
+const (
+	DefaultMatryoshkaDimensions   = 0
+	DefaultMatryoshkaRescoreLimit = 100
+)
+
+// MatryoshkaConfig makes the index hold only the leading dimensions of the
+// vectors. The candidates found on the truncated vectors are re-ranked with
+// the full vectors. A Dimensions of 0 disables the truncation.
+type MatryoshkaConfig struct {
+	Dimensions   int `json:"dimensions"`
+	RescoreLimit int `json:"rescoreLimit"`
+}
+
+func (m MatryoshkaConfig) Enabled() bool {
+	return m.Dimensions > 0
+}
+
+func NewDefaultMatryoshkaConfig() MatryoshkaConfig {
+	return MatryoshkaConfig{
+		Dimensions:   DefaultMatryoshkaDimensions,
+		RescoreLimit: DefaultMatryoshkaRescoreLimit,
+	}
+}
+
+// ParseMatryoshkaMap parses the "matryoshka" section of a vector index config
+func ParseMatryoshkaMap(in map[string]interface{}, matryoshka *MatryoshkaConfig) error {
+	matryoshkaConfigValue, ok := in["matryoshka"]
+	if !ok {
+		return nil
+	}
+
+	matryoshkaConfigMap, ok := matryoshkaConfigValue.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	if err := common.OptionalIntFromMap(matryoshkaConfigMap, "dimensions", func(v int) {
+		matryoshka.Dimensions = v
+	}); err != nil {
+		return err
+	}
+
+	if err := common.OptionalIntFromMap(matryoshkaConfigMap, "rescoreLimit", func(v int) {
+		matryoshka.RescoreLimit = v
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func ValidateMatryoshkaConfig(cfg MatryoshkaConfig, multivector bool) error {
+	if cfg.Dimensions < 0 {
+		return fmt.Errorf("matryoshka dimensions must not be negative, got %d", cfg.Dimensions)
+	}
+	if !cfg.Enabled() {
+		return nil
+	}
+	if multivector {
+		return fmt.Errorf("matryoshka is not supported for multivector indices")
+	}
+	if cfg.RescoreLimit < 0 {
+		return fmt.Errorf("matryoshka rescoreLimit must not be negative, got %d", cfg.RescoreLimit)
+	}
+
+	return nil
+}
@@ -18,23 +18,50 @@ import (
 )
 
 const (
+	// MultivectorAggregationMaxSim scores a document by the sum of the highest
+	// similarity of each query vector to any of the document vectors
 	MultivectorAggregationMaxSim = "maxSim"
+	// MultivectorAggregationMeanPooling scores a document by the similarity of
+	// the mean of the query vectors to the mean of the document vectors
+	MultivectorAggregationMeanPooling = "meanPooling"
+	// MultivectorAggregationTopKSum scores a document by the sum of the topK
+	// highest similarities of the query vectors to the document vectors, each
+	// query vector contributing its best match only
+	MultivectorAggregationTopKSum = "topKSum"
+	// MultivectorAggregationCentroidRerank ranks the candidates by the
+	// similarity of the mean vectors and reranks the best rerankLimit of them
+	// by maxSim
+	MultivectorAggregationCentroidRerank = "centroidRerank"
 )
 
 const (
 	DefaultMultivectorEnabled     = false
 	DefaultMultivectorAggregation = "maxSim"
+	DefaultMultivectorTopK        = 4
+	DefaultMultivectorRerankLimit = 100
 )
 
 // Multivector configuration
 type MultivectorConfig struct {
 	Enabled     bool   `json:"enabled"`
 	Aggregation string `json:"aggregation"`
+	TopK        int    `json:"topK"`
+	RerankLimit int    `json:"rerankLimit"`
+}
+
+func NewDefaultMultivectorConfig() MultivectorConfig {
+	return MultivectorConfig{
+		Enabled:     DefaultMultivectorEnabled,
+		Aggregation: DefaultMultivectorAggregation,
+		TopK:        DefaultMultivectorTopK,
+		RerankLimit: DefaultMultivectorRerankLimit,
+	}
 }
 
 func validAggregation(v string) error {
 	switch v {
-	case MultivectorAggregationMaxSim:
+	case MultivectorAggregationMaxSim, MultivectorAggregationMeanPooling,
+		MultivectorAggregationTopKSum, MultivectorAggregationCentroidRerank:
 	default:
 		return fmt.Errorf("invalid aggregation type %s", v)
 	}
@@ -51,10 +78,19 @@ func ValidateMultivectorConfig(cfg MultivectorConfig) error {
 		return err
 	}
 
+	if cfg.Aggregation == MultivectorAggregationTopKSum && cfg.TopK < 1 {
+		return fmt.Errorf("multivector topK must be a positive integer, got %d", cfg.TopK)
+	}
+	if cfg.Aggregation == MultivectorAggregationCentroidRerank && cfg.RerankLimit < 1 {
+		return fmt.Errorf("multivector rerankLimit must be a positive integer, got %d", cfg.RerankLimit)
+	}
+
 	return nil
 }
 
-func parseMultivectorMap(in map[string]interface{}, multivector *MultivectorConfig, isMultiVector bool) error {
+// ParseMultivectorMap parses the "multivector" section of a vector index
+// config
+func ParseMultivectorMap(in map[string]interface{}, multivector *MultivectorConfig, isMultiVector bool) error {
 	multivectorConfigValue, ok := in["multivector"]
 	if !ok {
 		return nil
@@ -82,5 +118,17 @@ func parseMultivectorMap(in map[string]interface{}, multivector *MultivectorConf
 		return err
 	}
 
+	if err := common.OptionalIntFromMap(multivectorConfigMap, "topK", func(v int) {
+		multivector.TopK = v
+	}); err != nil {
+		return err
+	}
+
+	if err := common.OptionalIntFromMap(multivectorConfigMap, "rerankLimit", func(v int) {
+		multivector.RerankLimit = v
+	}); err != nil {
+		return err
+	}
+
 	return nil
 }
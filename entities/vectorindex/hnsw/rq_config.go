@@ -0,0 +1,87 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"fmt"
+
+	"github.com/weaviate/weaviate/entities/vectorindex/common"
+)
+
+const (
+	DefaultRQEnabled      = false
+	DefaultRQBits         = 4
+	DefaultRQRescoreLimit = 20
+
+	MinRQBits = 1
+	MaxRQBits = 4
+)
+
+// RQConfig configures rotational quantization. Unlike PQ and SQ it needs no
+// training, vectors are compressed from the first insert on.
+type RQConfig struct {
+	Enabled      bool `json:"enabled"`
+	Bits         int  `json:"bits"`
+	RescoreLimit int  `json:"rescoreLimit"`
+}
+
+func ParseRQMap(in map[string]interface{}, rq *RQConfig) error {
+	rqConfigValue, ok := in["rq"]
+	if !ok {
+		return nil
+	}
+
+	rqConfigMap, ok := rqConfigValue.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	if err := common.OptionalBoolFromMap(rqConfigMap, "enabled", func(v bool) {
+		rq.Enabled = v
+	}); err != nil {
+		return err
+	}
+
+	if err := common.OptionalIntFromMap(rqConfigMap, "bits", func(v int) {
+		rq.Bits = v
+	}); err != nil {
+		return err
+	}
+
+	if err := common.OptionalIntFromMap(rqConfigMap, "rescoreLimit", func(v int) {
+		rq.RescoreLimit = v
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ValidateRQConfig checks the bits and whether the distance can be estimated
+// from rotated vectors
+func ValidateRQConfig(cfg RQConfig, distance string) error {
+	if !cfg.Enabled {
+		return nil
+	}
+
+	if cfg.Bits < MinRQBits || cfg.Bits > MaxRQBits {
+		return fmt.Errorf("rq bits must be between %d and %d, got %d", MinRQBits, MaxRQBits, cfg.Bits)
+	}
+
+	switch distance {
+	case common.DistanceCosine, common.DistanceDot, common.DistanceL2Squared:
+	default:
+		return fmt.Errorf("rq does not support distance %q", distance)
+	}
+
+	return nil
+}
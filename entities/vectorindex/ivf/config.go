@@ -0,0 +1,198 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package ivf
+
+import (
+	"errors"
+	"fmt"
+
+	schemaConfig "github.com/weaviate/weaviate/entities/schema/config"
+	vectorindexcommon "github.com/weaviate/weaviate/entities/vectorindex/common"
+)
+
+const (
+	DefaultCentroids          = 256
+	DefaultNProbe             = 16
+	DefaultTrainingLimit      = 100_000
+	DefaultFlatSearchCutoff   = 40_000
+	DefaultCompressionEnabled = false
+	DefaultCompressionRescore = -1 // indicates "let Weaviate pick"
+	DefaultPQSegments         = 0  // indicates "let Weaviate pick"
+)
+
+type CompressionUserConfig struct {
+	Enabled      bool `json:"enabled"`
+	RescoreLimit int  `json:"rescoreLimit"`
+	// Segments is only used by PQ
+	Segments int `json:"segments,omitempty"`
+}
+
+type UserConfig struct {
+	Distance         string                `json:"distance"`
+	Centroids        int                   `json:"centroids"`
+	NProbe           int                   `json:"nprobe"`
+	TrainingLimit    int                   `json:"trainingLimit"`
+	FlatSearchCutoff int                   `json:"flatSearchCutoff"`
+	PQ               CompressionUserConfig `json:"pq"`
+	BQ               CompressionUserConfig `json:"bq"`
+}
+
+// IndexType returns the type of the underlying vector index, thus making sure
+// the schema.VectorIndexConfig interface is implemented
+func (u UserConfig) IndexType() string {
+	return "ivf"
+}
+
+func (u UserConfig) DistanceName() string {
+	return u.Distance
+}
+
+func (u UserConfig) IsMultiVector() bool {
+	return false
+}
+
+// SetDefaults in the user-specifyable part of the config
+func (u *UserConfig) SetDefaults() {
+	u.Distance = vectorindexcommon.DefaultDistanceMetric
+	u.Centroids = DefaultCentroids
+	u.NProbe = DefaultNProbe
+	u.TrainingLimit = DefaultTrainingLimit
+	u.FlatSearchCutoff = DefaultFlatSearchCutoff
+	u.PQ.Enabled = DefaultCompressionEnabled
+	u.PQ.RescoreLimit = DefaultCompressionRescore
+	u.PQ.Segments = DefaultPQSegments
+	u.BQ.Enabled = DefaultCompressionEnabled
+	u.BQ.RescoreLimit = DefaultCompressionRescore
+}
+
+// ParseAndValidateConfig from an unknown input value, as this is not further
+// specified in the API to allow of exchanging the index type
+func ParseAndValidateConfig(input interface{}) (schemaConfig.VectorIndexConfig, error) {
+	uc := UserConfig{}
+	uc.SetDefaults()
+
+	if input == nil {
+		return uc, nil
+	}
+
+	asMap, ok := input.(map[string]interface{})
+	if !ok || asMap == nil {
+		return uc, fmt.Errorf("input must be a non-nil map")
+	}
+
+	if err := vectorindexcommon.OptionalStringFromMap(asMap, "distance", func(v string) {
+		uc.Distance = v
+	}); err != nil {
+		return uc, err
+	}
+
+	if err := vectorindexcommon.OptionalIntFromMap(asMap, "centroids", func(v int) {
+		uc.Centroids = v
+	}); err != nil {
+		return uc, err
+	}
+
+	if err := vectorindexcommon.OptionalIntFromMap(asMap, "nprobe", func(v int) {
+		uc.NProbe = v
+	}); err != nil {
+		return uc, err
+	}
+
+	if err := vectorindexcommon.OptionalIntFromMap(asMap, "trainingLimit", func(v int) {
+		uc.TrainingLimit = v
+	}); err != nil {
+		return uc, err
+	}
+
+	if err := vectorindexcommon.OptionalIntFromMap(asMap, "flatSearchCutoff", func(v int) {
+		uc.FlatSearchCutoff = v
+	}); err != nil {
+		return uc, err
+	}
+
+	if err := parseCompression(asMap, &uc); err != nil {
+		return uc, err
+	}
+
+	return uc, uc.validate()
+}
+
+func (u UserConfig) validate() error {
+	if u.Centroids < 1 {
+		return fmt.Errorf("centroids must be at least 1, got %d", u.Centroids)
+	}
+	if u.NProbe < 1 {
+		return fmt.Errorf("nprobe must be at least 1, got %d", u.NProbe)
+	}
+	if u.TrainingLimit < u.Centroids {
+		return fmt.Errorf("trainingLimit (%d) must not be smaller than centroids (%d)",
+			u.TrainingLimit, u.Centroids)
+	}
+	if u.PQ.Segments < 0 {
+		return fmt.Errorf("pq.segments must not be negative, got %d", u.PQ.Segments)
+	}
+	if (u.PQ.Enabled || u.BQ.Enabled) && u.Distance != vectorindexcommon.DistanceCosine &&
+		u.Distance != vectorindexcommon.DistanceDot && u.Distance != vectorindexcommon.DistanceL2Squared {
+		return fmt.Errorf("compression is not supported for distance %q", u.Distance)
+	}
+	return nil
+}
+
+func parseCompressionMap(in interface{}, cuc *CompressionUserConfig) error {
+	configMap, ok := in.(map[string]interface{})
+	if ok {
+		if err := vectorindexcommon.OptionalBoolFromMap(configMap, "enabled", func(v bool) {
+			cuc.Enabled = v
+		}); err != nil {
+			return err
+		}
+
+		if err := vectorindexcommon.OptionalIntFromMap(configMap, "rescoreLimit", func(v int) {
+			cuc.RescoreLimit = v
+		}); err != nil {
+			return err
+		}
+
+		if err := vectorindexcommon.OptionalIntFromMap(configMap, "segments", func(v int) {
+			cuc.Segments = v
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func parseCompression(in map[string]interface{}, uc *UserConfig) error {
+	if pqConfigValue, ok := in["pq"]; ok {
+		if err := parseCompressionMap(pqConfigValue, &uc.PQ); err != nil {
+			return err
+		}
+	}
+
+	if bqConfigValue, ok := in["bq"]; ok {
+		if err := parseCompressionMap(bqConfigValue, &uc.BQ); err != nil {
+			return err
+		}
+	}
+
+	if uc.PQ.Enabled && uc.BQ.Enabled {
+		return errors.New("cannot enable multiple quantization methods at the same time")
+	}
+
+	return nil
+}
+
+func NewDefaultUserConfig() UserConfig {
+	uc := UserConfig{}
+	uc.SetDefaults()
+	return uc
+}
@@ -0,0 +1,131 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package ivf
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/vectorindex/common"
+)
+
+func Test_IVFUserConfig(t *testing.T) {
+	type test struct {
+		name         string
+		input        interface{}
+		expected     UserConfig
+		expectErr    bool
+		expectErrMsg string
+	}
+
+	tests := []test{
+		{
+			name:  "nothing specified, all defaults",
+			input: nil,
+			expected: UserConfig{
+				Distance:         common.DefaultDistanceMetric,
+				Centroids:        DefaultCentroids,
+				NProbe:           DefaultNProbe,
+				TrainingLimit:    DefaultTrainingLimit,
+				FlatSearchCutoff: DefaultFlatSearchCutoff,
+				PQ: CompressionUserConfig{
+					Enabled:      DefaultCompressionEnabled,
+					RescoreLimit: DefaultCompressionRescore,
+					Segments:     DefaultPQSegments,
+				},
+				BQ: CompressionUserConfig{
+					Enabled:      DefaultCompressionEnabled,
+					RescoreLimit: DefaultCompressionRescore,
+				},
+			},
+		},
+		{
+			name: "all fields specified",
+			input: map[string]interface{}{
+				"distance":         "l2-squared",
+				"centroids":        float64(1024),
+				"nprobe":           float64(32),
+				"trainingLimit":    float64(200_000),
+				"flatSearchCutoff": float64(1000),
+				"pq": map[string]interface{}{
+					"enabled":      true,
+					"rescoreLimit": float64(200),
+					"segments":     float64(64),
+				},
+			},
+			expected: UserConfig{
+				Distance:         common.DistanceL2Squared,
+				Centroids:        1024,
+				NProbe:           32,
+				TrainingLimit:    200_000,
+				FlatSearchCutoff: 1000,
+				PQ: CompressionUserConfig{
+					Enabled:      true,
+					RescoreLimit: 200,
+					Segments:     64,
+				},
+				BQ: CompressionUserConfig{
+					Enabled:      DefaultCompressionEnabled,
+					RescoreLimit: DefaultCompressionRescore,
+				},
+			},
+		},
+		{
+			name: "pq and bq enabled",
+			input: map[string]interface{}{
+				"pq": map[string]interface{}{"enabled": true},
+				"bq": map[string]interface{}{"enabled": true},
+			},
+			expectErr:    true,
+			expectErrMsg: "cannot enable multiple quantization methods at the same time",
+		},
+		{
+			name: "bq with unsupported distance",
+			input: map[string]interface{}{
+				"distance": "manhattan",
+				"bq":       map[string]interface{}{"enabled": true},
+			},
+			expectErr:    true,
+			expectErrMsg: "compression is not supported for distance \"manhattan\"",
+		},
+		{
+			name:         "invalid nprobe",
+			input:        map[string]interface{}{"nprobe": float64(0)},
+			expectErr:    true,
+			expectErrMsg: "nprobe must be at least 1",
+		},
+		{
+			name: "training limit smaller than centroids",
+			input: map[string]interface{}{
+				"centroids":     float64(100),
+				"trainingLimit": float64(50),
+			},
+			expectErr:    true,
+			expectErrMsg: "trainingLimit (50) must not be smaller than centroids (100)",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg, err := ParseAndValidateConfig(test.input)
+			if test.expectErr {
+				require.NotNil(t, err)
+				assert.Contains(t, err.Error(), test.expectErrMsg)
+				return
+			} else {
+				assert.Nil(t, err)
+				assert.Equal(t, test.expected, cfg)
+			}
+		})
+	}
+}
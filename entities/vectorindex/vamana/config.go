@@ -0,0 +1,247 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package vamana
+
+import (
+	"errors"
+	"fmt"
+
+	schemaConfig "github.com/weaviate/weaviate/entities/schema/config"
+	vectorindexcommon "github.com/weaviate/weaviate/entities/vectorindex/common"
+)
+
+const (
+	DefaultMaxDegree           = 64
+	DefaultAlpha               = 1.2
+	DefaultBuildSearchListSize = 100
+	DefaultSearchListSize      = 100
+	DefaultBeamWidth           = 4
+	DefaultFlatSearchCutoff    = 40_000
+	DefaultPQEnabled           = true
+	DefaultPQSegments          = 0 // indicates "let Weaviate pick"
+	DefaultPQCentroids         = 256
+	DefaultPQTrainingLimit     = 100_000
+	DefaultBQEnabled           = false
+)
+
+type PQConfig struct {
+	Enabled       bool `json:"enabled"`
+	Segments      int  `json:"segments"`
+	Centroids     int  `json:"centroids"`
+	TrainingLimit int  `json:"trainingLimit"`
+}
+
+type BQConfig struct {
+	Enabled bool `json:"enabled"`
+}
+
+// UserConfig bundles all values settable by a user in the per-class settings
+type UserConfig struct {
+	Distance            string   `json:"distance"`
+	MaxDegree           int      `json:"maxDegree"`
+	Alpha               float64  `json:"alpha"`
+	BuildSearchListSize int      `json:"buildSearchListSize"`
+	SearchListSize      int      `json:"searchListSize"`
+	BeamWidth           int      `json:"beamWidth"`
+	FlatSearchCutoff    int      `json:"flatSearchCutoff"`
+	PQ                  PQConfig `json:"pq"`
+	BQ                  BQConfig `json:"bq"`
+}
+
+// IndexType returns the type of the underlying vector index, thus making sure
+// the schema.VectorIndexConfig interface is implemented
+func (u UserConfig) IndexType() string {
+	return "vamana"
+}
+
+func (u UserConfig) DistanceName() string {
+	return u.Distance
+}
+
+func (u UserConfig) IsMultiVector() bool {
+	return false
+}
+
+// SetDefaults in the user-specifyable part of the config
+func (u *UserConfig) SetDefaults() {
+	u.Distance = vectorindexcommon.DefaultDistanceMetric
+	u.MaxDegree = DefaultMaxDegree
+	u.Alpha = DefaultAlpha
+	u.BuildSearchListSize = DefaultBuildSearchListSize
+	u.SearchListSize = DefaultSearchListSize
+	u.BeamWidth = DefaultBeamWidth
+	u.FlatSearchCutoff = DefaultFlatSearchCutoff
+	u.PQ = PQConfig{
+		Enabled:       DefaultPQEnabled,
+		Segments:      DefaultPQSegments,
+		Centroids:     DefaultPQCentroids,
+		TrainingLimit: DefaultPQTrainingLimit,
+	}
+	u.BQ = BQConfig{Enabled: DefaultBQEnabled}
+}
+
+func NewDefaultUserConfig() UserConfig {
+	uc := UserConfig{}
+	uc.SetDefaults()
+	return uc
+}
+
+// ParseAndValidateConfig from an unknown input value, as this is not further
+// specified in the API to allow of exchanging the index type
+func ParseAndValidateConfig(input interface{}) (schemaConfig.VectorIndexConfig, error) {
+	uc := UserConfig{}
+	uc.SetDefaults()
+
+	if input == nil {
+		return uc, nil
+	}
+
+	asMap, ok := input.(map[string]interface{})
+	if !ok || asMap == nil {
+		return uc, fmt.Errorf("input must be a non-nil map")
+	}
+
+	if err := vectorindexcommon.OptionalStringFromMap(asMap, "distance", func(v string) {
+		uc.Distance = v
+	}); err != nil {
+		return uc, err
+	}
+
+	if err := vectorindexcommon.OptionalIntFromMap(asMap, "maxDegree", func(v int) {
+		uc.MaxDegree = v
+	}); err != nil {
+		return uc, err
+	}
+
+	if err := vectorindexcommon.OptionalFloatFromMap(asMap, "alpha", func(v float64) {
+		uc.Alpha = v
+	}); err != nil {
+		return uc, err
+	}
+
+	if err := vectorindexcommon.OptionalIntFromMap(asMap, "buildSearchListSize", func(v int) {
+		uc.BuildSearchListSize = v
+	}); err != nil {
+		return uc, err
+	}
+
+	if err := vectorindexcommon.OptionalIntFromMap(asMap, "searchListSize", func(v int) {
+		uc.SearchListSize = v
+	}); err != nil {
+		return uc, err
+	}
+
+	if err := vectorindexcommon.OptionalIntFromMap(asMap, "beamWidth", func(v int) {
+		uc.BeamWidth = v
+	}); err != nil {
+		return uc, err
+	}
+
+	if err := vectorindexcommon.OptionalIntFromMap(asMap, "flatSearchCutoff", func(v int) {
+		uc.FlatSearchCutoff = v
+	}); err != nil {
+		return uc, err
+	}
+
+	if err := parseCompression(asMap, &uc); err != nil {
+		return uc, err
+	}
+
+	return uc, uc.validate()
+}
+
+func parseCompression(in map[string]interface{}, uc *UserConfig) error {
+	pqConfigValue, pqSet := in["pq"]
+	if pqConfigMap, ok := pqConfigValue.(map[string]interface{}); pqSet && ok {
+		if err := vectorindexcommon.OptionalBoolFromMap(pqConfigMap, "enabled", func(v bool) {
+			uc.PQ.Enabled = v
+		}); err != nil {
+			return err
+		}
+
+		if err := vectorindexcommon.OptionalIntFromMap(pqConfigMap, "segments", func(v int) {
+			uc.PQ.Segments = v
+		}); err != nil {
+			return err
+		}
+
+		if err := vectorindexcommon.OptionalIntFromMap(pqConfigMap, "centroids", func(v int) {
+			uc.PQ.Centroids = v
+		}); err != nil {
+			return err
+		}
+
+		if err := vectorindexcommon.OptionalIntFromMap(pqConfigMap, "trainingLimit", func(v int) {
+			uc.PQ.TrainingLimit = v
+		}); err != nil {
+			return err
+		}
+	}
+
+	if bqConfigMap, ok := in["bq"].(map[string]interface{}); ok {
+		if err := vectorindexcommon.OptionalBoolFromMap(bqConfigMap, "enabled", func(v bool) {
+			uc.BQ.Enabled = v
+		}); err != nil {
+			return err
+		}
+	}
+
+	// PQ is enabled by default, so enabling BQ alone replaces it
+	if uc.BQ.Enabled && !pqSet {
+		uc.PQ.Enabled = false
+	}
+
+	if uc.PQ.Enabled && uc.BQ.Enabled {
+		return errors.New("cannot enable multiple quantization methods at the same time")
+	}
+	if !uc.PQ.Enabled && !uc.BQ.Enabled {
+		return errors.New("the vamana index keeps compressed vectors in memory, either pq or bq must be enabled")
+	}
+
+	return nil
+}
+
+func (u UserConfig) validate() error {
+	if u.MaxDegree < 2 {
+		return fmt.Errorf("maxDegree must be at least 2, got %d", u.MaxDegree)
+	}
+	if u.Alpha < 1 {
+		return fmt.Errorf("alpha must be at least 1, got %v", u.Alpha)
+	}
+	if u.BuildSearchListSize < u.MaxDegree {
+		return fmt.Errorf("buildSearchListSize (%d) must not be smaller than maxDegree (%d)",
+			u.BuildSearchListSize, u.MaxDegree)
+	}
+	if u.SearchListSize < 1 {
+		return fmt.Errorf("searchListSize must be at least 1, got %d", u.SearchListSize)
+	}
+	if u.BeamWidth < 1 {
+		return fmt.Errorf("beamWidth must be at least 1, got %d", u.BeamWidth)
+	}
+	if u.PQ.Enabled {
+		if u.PQ.Segments < 0 {
+			return fmt.Errorf("pq.segments must not be negative, got %d", u.PQ.Segments)
+		}
+		if u.PQ.Centroids < 1 || u.PQ.Centroids > 256 {
+			return fmt.Errorf("pq.centroids must be between 1 and 256, got %d", u.PQ.Centroids)
+		}
+		if u.PQ.TrainingLimit < u.PQ.Centroids {
+			return fmt.Errorf("pq.trainingLimit (%d) must not be smaller than pq.centroids (%d)",
+				u.PQ.TrainingLimit, u.PQ.Centroids)
+		}
+	}
+	if u.Distance != vectorindexcommon.DistanceCosine && u.Distance != vectorindexcommon.DistanceDot &&
+		u.Distance != vectorindexcommon.DistanceL2Squared {
+		return fmt.Errorf("distance %q is not supported by the vamana index", u.Distance)
+	}
+	return nil
+}
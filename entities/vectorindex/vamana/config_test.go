@@ -0,0 +1,157 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package vamana
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/vectorindex/common"
+)
+
+func Test_VamanaUserConfig(t *testing.T) {
+	type test struct {
+		name         string
+		input        interface{}
+		expected     UserConfig
+		expectErr    bool
+		expectErrMsg string
+	}
+
+	tests := []test{
+		{
+			name:     "nothing specified, all defaults",
+			input:    nil,
+			expected: NewDefaultUserConfig(),
+		},
+		{
+			name: "all fields specified",
+			input: map[string]interface{}{
+				"distance":            "l2-squared",
+				"maxDegree":           float64(32),
+				"alpha":               float64(1.5),
+				"buildSearchListSize": float64(64),
+				"searchListSize":      float64(200),
+				"beamWidth":           float64(8),
+				"flatSearchCutoff":    float64(1000),
+				"pq": map[string]interface{}{
+					"enabled":       true,
+					"segments":      float64(16),
+					"centroids":     float64(128),
+					"trainingLimit": float64(50_000),
+				},
+			},
+			expected: UserConfig{
+				Distance:            common.DistanceL2Squared,
+				MaxDegree:           32,
+				Alpha:               1.5,
+				BuildSearchListSize: 64,
+				SearchListSize:      200,
+				BeamWidth:           8,
+				FlatSearchCutoff:    1000,
+				PQ: PQConfig{
+					Enabled:       true,
+					Segments:      16,
+					Centroids:     128,
+					TrainingLimit: 50_000,
+				},
+			},
+		},
+		{
+			name: "with json.Number values",
+			input: map[string]interface{}{
+				"maxDegree": json.Number("48"),
+				"alpha":     json.Number("1.1"),
+			},
+			expected: func() UserConfig {
+				uc := NewDefaultUserConfig()
+				uc.MaxDegree = 48
+				uc.Alpha = 1.1
+				return uc
+			}(),
+		},
+		{
+			name: "bq replaces the default pq",
+			input: map[string]interface{}{
+				"bq": map[string]interface{}{"enabled": true},
+			},
+			expected: func() UserConfig {
+				uc := NewDefaultUserConfig()
+				uc.PQ.Enabled = false
+				uc.BQ.Enabled = true
+				return uc
+			}(),
+		},
+		{
+			name: "pq and bq enabled",
+			input: map[string]interface{}{
+				"pq": map[string]interface{}{"enabled": true},
+				"bq": map[string]interface{}{"enabled": true},
+			},
+			expectErr:    true,
+			expectErrMsg: "cannot enable multiple quantization methods at the same time",
+		},
+		{
+			name: "no compression",
+			input: map[string]interface{}{
+				"pq": map[string]interface{}{"enabled": false},
+			},
+			expectErr:    true,
+			expectErrMsg: "either pq or bq must be enabled",
+		},
+		{
+			name:         "unsupported distance",
+			input:        map[string]interface{}{"distance": "manhattan"},
+			expectErr:    true,
+			expectErrMsg: "distance \"manhattan\" is not supported by the vamana index",
+		},
+		{
+			name:         "alpha below 1",
+			input:        map[string]interface{}{"alpha": float64(0.5)},
+			expectErr:    true,
+			expectErrMsg: "alpha must be at least 1",
+		},
+		{
+			name: "build search list smaller than max degree",
+			input: map[string]interface{}{
+				"maxDegree":           float64(128),
+				"buildSearchListSize": float64(64),
+			},
+			expectErr:    true,
+			expectErrMsg: "buildSearchListSize (64) must not be smaller than maxDegree (128)",
+		},
+		{
+			name: "too many pq centroids",
+			input: map[string]interface{}{
+				"pq": map[string]interface{}{"centroids": float64(512)},
+			},
+			expectErr:    true,
+			expectErrMsg: "pq.centroids must be between 1 and 256",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg, err := ParseAndValidateConfig(test.input)
+			if test.expectErr {
+				require.NotNil(t, err)
+				assert.Contains(t, err.Error(), test.expectErrMsg)
+				return
+			} else {
+				assert.Nil(t, err)
+				assert.Equal(t, test.expected, cfg)
+			}
+		})
+	}
+}
@@ -0,0 +1,2845 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+
+package protocol
+
+import (
+	reflect "reflect"
+	sync "sync"
+
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Aggregator_Type int32
+
+const (
+	Aggregator_TYPE_UNSPECIFIED Aggregator_Type = 0
+	Aggregator_TYPE_COUNT       Aggregator_Type = 1
+	// the data type of the property
+	Aggregator_TYPE_TYPE             Aggregator_Type = 2
+	Aggregator_TYPE_MEAN             Aggregator_Type = 3
+	Aggregator_TYPE_MEDIAN           Aggregator_Type = 4
+	Aggregator_TYPE_MODE             Aggregator_Type = 5
+	Aggregator_TYPE_MAXIMUM          Aggregator_Type = 6
+	Aggregator_TYPE_MINIMUM          Aggregator_Type = 7
+	Aggregator_TYPE_SUM              Aggregator_Type = 8
+	Aggregator_TYPE_TOTAL_TRUE       Aggregator_Type = 9
+	Aggregator_TYPE_TOTAL_FALSE      Aggregator_Type = 10
+	Aggregator_TYPE_PERCENTAGE_TRUE  Aggregator_Type = 11
+	Aggregator_TYPE_PERCENTAGE_FALSE Aggregator_Type = 12
+	Aggregator_TYPE_TOP_OCCURRENCES  Aggregator_Type = 13
+	Aggregator_TYPE_POINTING_TO      Aggregator_Type = 14
+	Aggregator_TYPE_PERCENTILE       Aggregator_Type = 15
+	Aggregator_TYPE_HISTOGRAM        Aggregator_Type = 16
+	Aggregator_TYPE_CARDINALITY      Aggregator_Type = 17
+)
+
+// Enum value maps for Aggregator_Type.
+var (
+	Aggregator_Type_name = map[int32]string{
+		0:  "TYPE_UNSPECIFIED",
+		1:  "TYPE_COUNT",
+		2:  "TYPE_TYPE",
+		3:  "TYPE_MEAN",
+		4:  "TYPE_MEDIAN",
+		5:  "TYPE_MODE",
+		6:  "TYPE_MAXIMUM",
+		7:  "TYPE_MINIMUM",
+		8:  "TYPE_SUM",
+		9:  "TYPE_TOTAL_TRUE",
+		10: "TYPE_TOTAL_FALSE",
+		11: "TYPE_PERCENTAGE_TRUE",
+		12: "TYPE_PERCENTAGE_FALSE",
+		13: "TYPE_TOP_OCCURRENCES",
+		14: "TYPE_POINTING_TO",
+		15: "TYPE_PERCENTILE",
+		16: "TYPE_HISTOGRAM",
+		17: "TYPE_CARDINALITY",
+	}
+	Aggregator_Type_value = map[string]int32{
+		"TYPE_UNSPECIFIED":      0,
+		"TYPE_COUNT":            1,
+		"TYPE_TYPE":             2,
+		"TYPE_MEAN":             3,
+		"TYPE_MEDIAN":           4,
+		"TYPE_MODE":             5,
+		"TYPE_MAXIMUM":          6,
+		"TYPE_MINIMUM":          7,
+		"TYPE_SUM":              8,
+		"TYPE_TOTAL_TRUE":       9,
+		"TYPE_TOTAL_FALSE":      10,
+		"TYPE_PERCENTAGE_TRUE":  11,
+		"TYPE_PERCENTAGE_FALSE": 12,
+		"TYPE_TOP_OCCURRENCES":  13,
+		"TYPE_POINTING_TO":      14,
+		"TYPE_PERCENTILE":       15,
+		"TYPE_HISTOGRAM":        16,
+		"TYPE_CARDINALITY":      17,
+	}
+)
+
+func (x Aggregator_Type) Enum() *Aggregator_Type {
+	p := new(Aggregator_Type)
+	*p = x
+	return p
+}
+
+func (x Aggregator_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Aggregator_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_aggregate_proto_enumTypes[0].Descriptor()
+}
+
+func (Aggregator_Type) Type() protoreflect.EnumType {
+	return &file_v1_aggregate_proto_enumTypes[0]
+}
+
+func (x Aggregator_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Aggregator_Type.Descriptor instead.
+func (Aggregator_Type) EnumDescriptor() ([]byte, []int) {
+	return file_v1_aggregate_proto_rawDescGZIP(), []int{2, 0}
+}
+
+type AggregateGroupBy_DateInterval int32
+
+const (
+	AggregateGroupBy_DATE_INTERVAL_UNSPECIFIED AggregateGroupBy_DateInterval = 0
+	AggregateGroupBy_DATE_INTERVAL_MINUTE      AggregateGroupBy_DateInterval = 1
+	AggregateGroupBy_DATE_INTERVAL_HOUR        AggregateGroupBy_DateInterval = 2
+	AggregateGroupBy_DATE_INTERVAL_DAY         AggregateGroupBy_DateInterval = 3
+	AggregateGroupBy_DATE_INTERVAL_WEEK        AggregateGroupBy_DateInterval = 4
+	AggregateGroupBy_DATE_INTERVAL_MONTH       AggregateGroupBy_DateInterval = 5
+	AggregateGroupBy_DATE_INTERVAL_QUARTER     AggregateGroupBy_DateInterval = 6
+	AggregateGroupBy_DATE_INTERVAL_YEAR        AggregateGroupBy_DateInterval = 7
+)
+
+// Enum value maps for AggregateGroupBy_DateInterval.
+var (
+	AggregateGroupBy_DateInterval_name = map[int32]string{
+		0: "DATE_INTERVAL_UNSPECIFIED",
+		1: "DATE_INTERVAL_MINUTE",
+		2: "DATE_INTERVAL_HOUR",
+		3: "DATE_INTERVAL_DAY",
+		4: "DATE_INTERVAL_WEEK",
+		5: "DATE_INTERVAL_MONTH",
+		6: "DATE_INTERVAL_QUARTER",
+		7: "DATE_INTERVAL_YEAR",
+	}
+	AggregateGroupBy_DateInterval_value = map[string]int32{
+		"DATE_INTERVAL_UNSPECIFIED": 0,
+		"DATE_INTERVAL_MINUTE":      1,
+		"DATE_INTERVAL_HOUR":        2,
+		"DATE_INTERVAL_DAY":         3,
+		"DATE_INTERVAL_WEEK":        4,
+		"DATE_INTERVAL_MONTH":       5,
+		"DATE_INTERVAL_QUARTER":     6,
+		"DATE_INTERVAL_YEAR":        7,
+	}
+)
+
+func (x AggregateGroupBy_DateInterval) Enum() *AggregateGroupBy_DateInterval {
+	p := new(AggregateGroupBy_DateInterval)
+	*p = x
+	return p
+}
+
+func (x AggregateGroupBy_DateInterval) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AggregateGroupBy_DateInterval) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_aggregate_proto_enumTypes[1].Descriptor()
+}
+
+func (AggregateGroupBy_DateInterval) Type() protoreflect.EnumType {
+	return &file_v1_aggregate_proto_enumTypes[1]
+}
+
+func (x AggregateGroupBy_DateInterval) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AggregateGroupBy_DateInterval.Descriptor instead.
+func (AggregateGroupBy_DateInterval) EnumDescriptor() ([]byte, []int) {
+	return file_v1_aggregate_proto_rawDescGZIP(), []int{5, 0}
+}
+
+type AggregateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Collection string  `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+	Tenant     *string `protobuf:"bytes,2,opt,name=tenant,proto3,oneof" json:"tenant,omitempty"`
+	// what is returned
+	ObjectsCount bool                 `protobuf:"varint,3,opt,name=objects_count,json=objectsCount,proto3" json:"objects_count,omitempty"`
+	Properties   []*AggregateProperty `protobuf:"bytes,4,rep,name=properties,proto3" json:"properties,omitempty"`
+	GroupBy      *AggregateGroupBy    `protobuf:"bytes,5,opt,name=group_by,json=groupBy,proto3,oneof" json:"group_by,omitempty"`
+	// aggregates only the closest objects of a search, requires a certainty
+	// or distance on the near search if not set
+	ObjectLimit *uint32 `protobuf:"varint,10,opt,name=object_limit,json=objectLimit,proto3,oneof" json:"object_limit,omitempty"`
+	// matches/searches for objects, at most one search can be set
+	Filters      *Filters           `protobuf:"bytes,40,opt,name=filters,proto3,oneof" json:"filters,omitempty"`
+	HybridSearch *Hybrid            `protobuf:"bytes,41,opt,name=hybrid_search,json=hybridSearch,proto3,oneof" json:"hybrid_search,omitempty"`
+	NearVector   *NearVector        `protobuf:"bytes,43,opt,name=near_vector,json=nearVector,proto3,oneof" json:"near_vector,omitempty"`
+	NearObject   *NearObject        `protobuf:"bytes,44,opt,name=near_object,json=nearObject,proto3,oneof" json:"near_object,omitempty"`
+	NearText     *NearTextSearch    `protobuf:"bytes,45,opt,name=near_text,json=nearText,proto3,oneof" json:"near_text,omitempty"`
+	NearImage    *NearImageSearch   `protobuf:"bytes,46,opt,name=near_image,json=nearImage,proto3,oneof" json:"near_image,omitempty"`
+	NearAudio    *NearAudioSearch   `protobuf:"bytes,47,opt,name=near_audio,json=nearAudio,proto3,oneof" json:"near_audio,omitempty"`
+	NearVideo    *NearVideoSearch   `protobuf:"bytes,48,opt,name=near_video,json=nearVideo,proto3,oneof" json:"near_video,omitempty"`
+	NearDepth    *NearDepthSearch   `protobuf:"bytes,49,opt,name=near_depth,json=nearDepth,proto3,oneof" json:"near_depth,omitempty"`
+	NearThermal  *NearThermalSearch `protobuf:"bytes,50,opt,name=near_thermal,json=nearThermal,proto3,oneof" json:"near_thermal,omitempty"`
+	NearImu      *NearIMUSearch     `protobuf:"bytes,51,opt,name=near_imu,json=nearImu,proto3,oneof" json:"near_imu,omitempty"`
+}
+
+func (x *AggregateRequest) Reset() {
+	*x = AggregateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_aggregate_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AggregateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregateRequest) ProtoMessage() {}
+
+func (x *AggregateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_aggregate_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregateRequest.ProtoReflect.Descriptor instead.
+func (*AggregateRequest) Descriptor() ([]byte, []int) {
+	return file_v1_aggregate_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AggregateRequest) GetCollection() string {
+	if x != nil {
+		return x.Collection
+	}
+	return ""
+}
+
+func (x *AggregateRequest) GetTenant() string {
+	if x != nil && x.Tenant != nil {
+		return *x.Tenant
+	}
+	return ""
+}
+
+func (x *AggregateRequest) GetObjectsCount() bool {
+	if x != nil {
+		return x.ObjectsCount
+	}
+	return false
+}
+
+func (x *AggregateRequest) GetProperties() []*AggregateProperty {
+	if x != nil {
+		return x.Properties
+	}
+	return nil
+}
+
+func (x *AggregateRequest) GetGroupBy() *AggregateGroupBy {
+	if x != nil {
+		return x.GroupBy
+	}
+	return nil
+}
+
+func (x *AggregateRequest) GetObjectLimit() uint32 {
+	if x != nil && x.ObjectLimit != nil {
+		return *x.ObjectLimit
+	}
+	return 0
+}
+
+func (x *AggregateRequest) GetFilters() *Filters {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
+func (x *AggregateRequest) GetHybridSearch() *Hybrid {
+	if x != nil {
+		return x.HybridSearch
+	}
+	return nil
+}
+
+func (x *AggregateRequest) GetNearVector() *NearVector {
+	if x != nil {
+		return x.NearVector
+	}
+	return nil
+}
+
+func (x *AggregateRequest) GetNearObject() *NearObject {
+	if x != nil {
+		return x.NearObject
+	}
+	return nil
+}
+
+func (x *AggregateRequest) GetNearText() *NearTextSearch {
+	if x != nil {
+		return x.NearText
+	}
+	return nil
+}
+
+func (x *AggregateRequest) GetNearImage() *NearImageSearch {
+	if x != nil {
+		return x.NearImage
+	}
+	return nil
+}
+
+func (x *AggregateRequest) GetNearAudio() *NearAudioSearch {
+	if x != nil {
+		return x.NearAudio
+	}
+	return nil
+}
+
+func (x *AggregateRequest) GetNearVideo() *NearVideoSearch {
+	if x != nil {
+		return x.NearVideo
+	}
+	return nil
+}
+
+func (x *AggregateRequest) GetNearDepth() *NearDepthSearch {
+	if x != nil {
+		return x.NearDepth
+	}
+	return nil
+}
+
+func (x *AggregateRequest) GetNearThermal() *NearThermalSearch {
+	if x != nil {
+		return x.NearThermal
+	}
+	return nil
+}
+
+func (x *AggregateRequest) GetNearImu() *NearIMUSearch {
+	if x != nil {
+		return x.NearImu
+	}
+	return nil
+}
+
+type AggregateProperty struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string        `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Aggregators []*Aggregator `protobuf:"bytes,2,rep,name=aggregators,proto3" json:"aggregators,omitempty"`
+}
+
+func (x *AggregateProperty) Reset() {
+	*x = AggregateProperty{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_aggregate_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AggregateProperty) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregateProperty) ProtoMessage() {}
+
+func (x *AggregateProperty) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_aggregate_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregateProperty.ProtoReflect.Descriptor instead.
+func (*AggregateProperty) Descriptor() ([]byte, []int) {
+	return file_v1_aggregate_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AggregateProperty) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AggregateProperty) GetAggregators() []*Aggregator {
+	if x != nil {
+		return x.Aggregators
+	}
+	return nil
+}
+
+type Aggregator struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type Aggregator_Type `protobuf:"varint,1,opt,name=type,proto3,enum=weaviate.v1.Aggregator_Type" json:"type,omitempty"`
+	// number of top occurrences, defaults to 5
+	Limit *uint32 `protobuf:"varint,2,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	// quantiles between 0 and 1 of a percentile aggregator, defaults to 0.5, 0.95 and 0.99
+	Quantiles []float64             `protobuf:"fixed64,3,rep,packed,name=quantiles,proto3" json:"quantiles,omitempty"`
+	Histogram *Aggregator_Histogram `protobuf:"bytes,4,opt,name=histogram,proto3,oneof" json:"histogram,omitempty"`
+}
+
+func (x *Aggregator) Reset() {
+	*x = Aggregator{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_aggregate_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Aggregator) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Aggregator) ProtoMessage() {}
+
+func (x *Aggregator) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_aggregate_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Aggregator.ProtoReflect.Descriptor instead.
+func (*Aggregator) Descriptor() ([]byte, []int) {
+	return file_v1_aggregate_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Aggregator) GetType() Aggregator_Type {
+	if x != nil {
+		return x.Type
+	}
+	return Aggregator_TYPE_UNSPECIFIED
+}
+
+func (x *Aggregator) GetLimit() uint32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+func (x *Aggregator) GetQuantiles() []float64 {
+	if x != nil {
+		return x.Quantiles
+	}
+	return nil
+}
+
+func (x *Aggregator) GetHistogram() *Aggregator_Histogram {
+	if x != nil {
+		return x.Histogram
+	}
+	return nil
+}
+
+type AggregateReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Took float32 `protobuf:"fixed32,1,opt,name=took,proto3" json:"took,omitempty"`
+	// Types that are assignable to Result:
+	//
+	//	*AggregateReply_SingleResult
+	//	*AggregateReply_GroupedResults
+	Result isAggregateReply_Result `protobuf_oneof:"result"`
+}
+
+func (x *AggregateReply) Reset() {
+	*x = AggregateReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_aggregate_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AggregateReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregateReply) ProtoMessage() {}
+
+func (x *AggregateReply) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_aggregate_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregateReply.ProtoReflect.Descriptor instead.
+func (*AggregateReply) Descriptor() ([]byte, []int) {
+	return file_v1_aggregate_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AggregateReply) GetTook() float32 {
+	if x != nil {
+		return x.Took
+	}
+	return 0
+}
+
+func (m *AggregateReply) GetResult() isAggregateReply_Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (x *AggregateReply) GetSingleResult() *AggregateReply_Single {
+	if x, ok := x.GetResult().(*AggregateReply_SingleResult); ok {
+		return x.SingleResult
+	}
+	return nil
+}
+
+func (x *AggregateReply) GetGroupedResults() *AggregateReply_Grouped {
+	if x, ok := x.GetResult().(*AggregateReply_GroupedResults); ok {
+		return x.GroupedResults
+	}
+	return nil
+}
+
+type isAggregateReply_Result interface {
+	isAggregateReply_Result()
+}
+
+type AggregateReply_SingleResult struct {
+	SingleResult *AggregateReply_Single `protobuf:"bytes,2,opt,name=single_result,json=singleResult,proto3,oneof"`
+}
+
+type AggregateReply_GroupedResults struct {
+	GroupedResults *AggregateReply_Grouped `protobuf:"bytes,3,opt,name=grouped_results,json=groupedResults,proto3,oneof"`
+}
+
+func (*AggregateReply_SingleResult) isAggregateReply_Result() {}
+
+func (*AggregateReply_GroupedResults) isAggregateReply_Result() {}
+
+type AggregateResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Property string `protobuf:"bytes,1,opt,name=property,proto3" json:"property,omitempty"`
+	// data type of the property, set by the type aggregator
+	Type *string `protobuf:"bytes,2,opt,name=type,proto3,oneof" json:"type,omitempty"`
+	// Types that are assignable to Aggregation:
+	//
+	//	*AggregateResult_Numerical_
+	//	*AggregateResult_Text_
+	//	*AggregateResult_Boolean_
+	//	*AggregateResult_Date_
+	//	*AggregateResult_Reference_
+	Aggregation isAggregateResult_Aggregation `protobuf_oneof:"aggregation"`
+}
+
+func (x *AggregateResult) Reset() {
+	*x = AggregateResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_aggregate_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AggregateResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregateResult) ProtoMessage() {}
+
+func (x *AggregateResult) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_aggregate_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregateResult.ProtoReflect.Descriptor instead.
+func (*AggregateResult) Descriptor() ([]byte, []int) {
+	return file_v1_aggregate_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *AggregateResult) GetProperty() string {
+	if x != nil {
+		return x.Property
+	}
+	return ""
+}
+
+func (x *AggregateResult) GetType() string {
+	if x != nil && x.Type != nil {
+		return *x.Type
+	}
+	return ""
+}
+
+func (m *AggregateResult) GetAggregation() isAggregateResult_Aggregation {
+	if m != nil {
+		return m.Aggregation
+	}
+	return nil
+}
+
+func (x *AggregateResult) GetNumerical() *AggregateResult_Numerical {
+	if x, ok := x.GetAggregation().(*AggregateResult_Numerical_); ok {
+		return x.Numerical
+	}
+	return nil
+}
+
+func (x *AggregateResult) GetText() *AggregateResult_Text {
+	if x, ok := x.GetAggregation().(*AggregateResult_Text_); ok {
+		return x.Text
+	}
+	return nil
+}
+
+func (x *AggregateResult) GetBoolean() *AggregateResult_Boolean {
+	if x, ok := x.GetAggregation().(*AggregateResult_Boolean_); ok {
+		return x.Boolean
+	}
+	return nil
+}
+
+func (x *AggregateResult) GetDate() *AggregateResult_Date {
+	if x, ok := x.GetAggregation().(*AggregateResult_Date_); ok {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *AggregateResult) GetReference() *AggregateResult_Reference {
+	if x, ok := x.GetAggregation().(*AggregateResult_Reference_); ok {
+		return x.Reference
+	}
+	return nil
+}
+
+type isAggregateResult_Aggregation interface {
+	isAggregateResult_Aggregation()
+}
+
+type AggregateResult_Numerical_ struct {
+	Numerical *AggregateResult_Numerical `protobuf:"bytes,3,opt,name=numerical,proto3,oneof"`
+}
+
+type AggregateResult_Text_ struct {
+	Text *AggregateResult_Text `protobuf:"bytes,4,opt,name=text,proto3,oneof"`
+}
+
+type AggregateResult_Boolean_ struct {
+	Boolean *AggregateResult_Boolean `protobuf:"bytes,5,opt,name=boolean,proto3,oneof"`
+}
+
+type AggregateResult_Date_ struct {
+	Date *AggregateResult_Date `protobuf:"bytes,6,opt,name=date,proto3,oneof"`
+}
+
+type AggregateResult_Reference_ struct {
+	Reference *AggregateResult_Reference `protobuf:"bytes,7,opt,name=reference,proto3,oneof"`
+}
+
+func (*AggregateResult_Numerical_) isAggregateResult_Aggregation() {}
+
+func (*AggregateResult_Text_) isAggregateResult_Aggregation() {}
+
+func (*AggregateResult_Boolean_) isAggregateResult_Aggregation() {}
+
+func (*AggregateResult_Date_) isAggregateResult_Aggregation() {}
+
+func (*AggregateResult_Reference_) isAggregateResult_Aggregation() {}
+
+// AggregateGroupBy groups the aggregated objects by one or more keys, every
+// key adds a level of nested groups
+type AggregateGroupBy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Keys []*AggregateGroupBy_Key `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	// maximum number of groups on every level
+	Limit *uint32 `protobuf:"varint,2,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+}
+
+func (x *AggregateGroupBy) Reset() {
+	*x = AggregateGroupBy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_aggregate_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AggregateGroupBy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregateGroupBy) ProtoMessage() {}
+
+func (x *AggregateGroupBy) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_aggregate_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregateGroupBy.ProtoReflect.Descriptor instead.
+func (*AggregateGroupBy) Descriptor() ([]byte, []int) {
+	return file_v1_aggregate_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *AggregateGroupBy) GetKeys() []*AggregateGroupBy_Key {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+func (x *AggregateGroupBy) GetLimit() uint32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+type AggregateGroup struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupedBy  *AggregateGroup_GroupedBy `protobuf:"bytes,1,opt,name=grouped_by,json=groupedBy,proto3" json:"grouped_by,omitempty"`
+	Count      int64                     `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Groups     []*AggregateGroup         `protobuf:"bytes,3,rep,name=groups,proto3" json:"groups,omitempty"`
+	Properties []*AggregateResult        `protobuf:"bytes,4,rep,name=properties,proto3" json:"properties,omitempty"`
+}
+
+func (x *AggregateGroup) Reset() {
+	*x = AggregateGroup{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_aggregate_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AggregateGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregateGroup) ProtoMessage() {}
+
+func (x *AggregateGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_aggregate_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregateGroup.ProtoReflect.Descriptor instead.
+func (*AggregateGroup) Descriptor() ([]byte, []int) {
+	return file_v1_aggregate_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *AggregateGroup) GetGroupedBy() *AggregateGroup_GroupedBy {
+	if x != nil {
+		return x.GroupedBy
+	}
+	return nil
+}
+
+func (x *AggregateGroup) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *AggregateGroup) GetGroups() []*AggregateGroup {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+func (x *AggregateGroup) GetProperties() []*AggregateResult {
+	if x != nil {
+		return x.Properties
+	}
+	return nil
+}
+
+type Aggregator_Histogram struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// either the buckets are interval wide and aligned to zero, or they lie
+	// between the ascending edges. On dates both are in seconds.
+	Interval float64   `protobuf:"fixed64,1,opt,name=interval,proto3" json:"interval,omitempty"`
+	Edges    []float64 `protobuf:"fixed64,2,rep,packed,name=edges,proto3" json:"edges,omitempty"`
+}
+
+func (x *Aggregator_Histogram) Reset() {
+	*x = Aggregator_Histogram{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_aggregate_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Aggregator_Histogram) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Aggregator_Histogram) ProtoMessage() {}
+
+func (x *Aggregator_Histogram) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_aggregate_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Aggregator_Histogram.ProtoReflect.Descriptor instead.
+func (*Aggregator_Histogram) Descriptor() ([]byte, []int) {
+	return file_v1_aggregate_proto_rawDescGZIP(), []int{2, 0}
+}
+
+func (x *Aggregator_Histogram) GetInterval() float64 {
+	if x != nil {
+		return x.Interval
+	}
+	return 0
+}
+
+func (x *Aggregator_Histogram) GetEdges() []float64 {
+	if x != nil {
+		return x.Edges
+	}
+	return nil
+}
+
+type AggregateReply_Single struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ObjectsCount *int64             `protobuf:"varint,1,opt,name=objects_count,json=objectsCount,proto3,oneof" json:"objects_count,omitempty"`
+	Properties   []*AggregateResult `protobuf:"bytes,2,rep,name=properties,proto3" json:"properties,omitempty"`
+}
+
+func (x *AggregateReply_Single) Reset() {
+	*x = AggregateReply_Single{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_aggregate_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AggregateReply_Single) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregateReply_Single) ProtoMessage() {}
+
+func (x *AggregateReply_Single) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_aggregate_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregateReply_Single.ProtoReflect.Descriptor instead.
+func (*AggregateReply_Single) Descriptor() ([]byte, []int) {
+	return file_v1_aggregate_proto_rawDescGZIP(), []int{3, 0}
+}
+
+func (x *AggregateReply_Single) GetObjectsCount() int64 {
+	if x != nil && x.ObjectsCount != nil {
+		return *x.ObjectsCount
+	}
+	return 0
+}
+
+func (x *AggregateReply_Single) GetProperties() []*AggregateResult {
+	if x != nil {
+		return x.Properties
+	}
+	return nil
+}
+
+type AggregateReply_Grouped struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Groups []*AggregateGroup `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+}
+
+func (x *AggregateReply_Grouped) Reset() {
+	*x = AggregateReply_Grouped{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_aggregate_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AggregateReply_Grouped) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregateReply_Grouped) ProtoMessage() {}
+
+func (x *AggregateReply_Grouped) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_aggregate_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregateReply_Grouped.ProtoReflect.Descriptor instead.
+func (*AggregateReply_Grouped) Descriptor() ([]byte, []int) {
+	return file_v1_aggregate_proto_rawDescGZIP(), []int{3, 1}
+}
+
+func (x *AggregateReply_Grouped) GetGroups() []*AggregateGroup {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+type AggregateResult_Percentile struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Quantile float64 `protobuf:"fixed64,1,opt,name=quantile,proto3" json:"quantile,omitempty"`
+	Value    float64 `protobuf:"fixed64,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *AggregateResult_Percentile) Reset() {
+	*x = AggregateResult_Percentile{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_aggregate_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AggregateResult_Percentile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregateResult_Percentile) ProtoMessage() {}
+
+func (x *AggregateResult_Percentile) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_aggregate_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregateResult_Percentile.ProtoReflect.Descriptor instead.
+func (*AggregateResult_Percentile) Descriptor() ([]byte, []int) {
+	return file_v1_aggregate_proto_rawDescGZIP(), []int{4, 0}
+}
+
+func (x *AggregateResult_Percentile) GetQuantile() float64 {
+	if x != nil {
+		return x.Quantile
+	}
+	return 0
+}
+
+func (x *AggregateResult_Percentile) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+type AggregateResult_HistogramBucket struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	From  float64 `protobuf:"fixed64,1,opt,name=from,proto3" json:"from,omitempty"`
+	To    float64 `protobuf:"fixed64,2,opt,name=to,proto3" json:"to,omitempty"`
+	Count int64   `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *AggregateResult_HistogramBucket) Reset() {
+	*x = AggregateResult_HistogramBucket{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_aggregate_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AggregateResult_HistogramBucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregateResult_HistogramBucket) ProtoMessage() {}
+
+func (x *AggregateResult_HistogramBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_aggregate_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregateResult_HistogramBucket.ProtoReflect.Descriptor instead.
+func (*AggregateResult_HistogramBucket) Descriptor() ([]byte, []int) {
+	return file_v1_aggregate_proto_rawDescGZIP(), []int{4, 1}
+}
+
+func (x *AggregateResult_HistogramBucket) GetFrom() float64 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *AggregateResult_HistogramBucket) GetTo() float64 {
+	if x != nil {
+		return x.To
+	}
+	return 0
+}
+
+func (x *AggregateResult_HistogramBucket) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type AggregateResult_DateHistogramBucket struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	From  string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To    string `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Count int64  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *AggregateResult_DateHistogramBucket) Reset() {
+	*x = AggregateResult_DateHistogramBucket{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_aggregate_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AggregateResult_DateHistogramBucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregateResult_DateHistogramBucket) ProtoMessage() {}
+
+func (x *AggregateResult_DateHistogramBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_aggregate_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregateResult_DateHistogramBucket.ProtoReflect.Descriptor instead.
+func (*AggregateResult_DateHistogramBucket) Descriptor() ([]byte, []int) {
+	return file_v1_aggregate_proto_rawDescGZIP(), []int{4, 2}
+}
+
+func (x *AggregateResult_DateHistogramBucket) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *AggregateResult_DateHistogramBucket) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *AggregateResult_DateHistogramBucket) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type AggregateResult_Numerical struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count       *int64                             `protobuf:"varint,1,opt,name=count,proto3,oneof" json:"count,omitempty"`
+	Mean        *float64                           `protobuf:"fixed64,2,opt,name=mean,proto3,oneof" json:"mean,omitempty"`
+	Median      *float64                           `protobuf:"fixed64,3,opt,name=median,proto3,oneof" json:"median,omitempty"`
+	Mode        *float64                           `protobuf:"fixed64,4,opt,name=mode,proto3,oneof" json:"mode,omitempty"`
+	Maximum     *float64                           `protobuf:"fixed64,5,opt,name=maximum,proto3,oneof" json:"maximum,omitempty"`
+	Minimum     *float64                           `protobuf:"fixed64,6,opt,name=minimum,proto3,oneof" json:"minimum,omitempty"`
+	Sum         *float64                           `protobuf:"fixed64,7,opt,name=sum,proto3,oneof" json:"sum,omitempty"`
+	Percentiles []*AggregateResult_Percentile      `protobuf:"bytes,8,rep,name=percentiles,proto3" json:"percentiles,omitempty"`
+	Histogram   []*AggregateResult_HistogramBucket `protobuf:"bytes,9,rep,name=histogram,proto3" json:"histogram,omitempty"`
+	Cardinality *int64                             `protobuf:"varint,10,opt,name=cardinality,proto3,oneof" json:"cardinality,omitempty"`
+}
+
+func (x *AggregateResult_Numerical) Reset() {
+	*x = AggregateResult_Numerical{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_aggregate_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AggregateResult_Numerical) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregateResult_Numerical) ProtoMessage() {}
+
+func (x *AggregateResult_Numerical) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_aggregate_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregateResult_Numerical.ProtoReflect.Descriptor instead.
+func (*AggregateResult_Numerical) Descriptor() ([]byte, []int) {
+	return file_v1_aggregate_proto_rawDescGZIP(), []int{4, 3}
+}
+
+func (x *AggregateResult_Numerical) GetCount() int64 {
+	if x != nil && x.Count != nil {
+		return *x.Count
+	}
+	return 0
+}
+
+func (x *AggregateResult_Numerical) GetMean() float64 {
+	if x != nil && x.Mean != nil {
+		return *x.Mean
+	}
+	return 0
+}
+
+func (x *AggregateResult_Numerical) GetMedian() float64 {
+	if x != nil && x.Median != nil {
+		return *x.Median
+	}
+	return 0
+}
+
+func (x *AggregateResult_Numerical) GetMode() float64 {
+	if x != nil && x.Mode != nil {
+		return *x.Mode
+	}
+	return 0
+}
+
+func (x *AggregateResult_Numerical) GetMaximum() float64 {
+	if x != nil && x.Maximum != nil {
+		return *x.Maximum
+	}
+	return 0
+}
+
+func (x *AggregateResult_Numerical) GetMinimum() float64 {
+	if x != nil && x.Minimum != nil {
+		return *x.Minimum
+	}
+	return 0
+}
+
+func (x *AggregateResult_Numerical) GetSum() float64 {
+	if x != nil && x.Sum != nil {
+		return *x.Sum
+	}
+	return 0
+}
+
+func (x *AggregateResult_Numerical) GetPercentiles() []*AggregateResult_Percentile {
+	if x != nil {
+		return x.Percentiles
+	}
+	return nil
+}
+
+func (x *AggregateResult_Numerical) GetHistogram() []*AggregateResult_HistogramBucket {
+	if x != nil {
+		return x.Histogram
+	}
+	return nil
+}
+
+func (x *AggregateResult_Numerical) GetCardinality() int64 {
+	if x != nil && x.Cardinality != nil {
+		return *x.Cardinality
+	}
+	return 0
+}
+
+type AggregateResult_Text struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count          int64                                 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	TopOccurrences []*AggregateResult_Text_TopOccurrence `protobuf:"bytes,2,rep,name=top_occurrences,json=topOccurrences,proto3" json:"top_occurrences,omitempty"`
+	Cardinality    *int64                                `protobuf:"varint,3,opt,name=cardinality,proto3,oneof" json:"cardinality,omitempty"`
+}
+
+func (x *AggregateResult_Text) Reset() {
+	*x = AggregateResult_Text{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_aggregate_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AggregateResult_Text) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregateResult_Text) ProtoMessage() {}
+
+func (x *AggregateResult_Text) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_aggregate_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregateResult_Text.ProtoReflect.Descriptor instead.
+func (*AggregateResult_Text) Descriptor() ([]byte, []int) {
+	return file_v1_aggregate_proto_rawDescGZIP(), []int{4, 4}
+}
+
+func (x *AggregateResult_Text) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *AggregateResult_Text) GetTopOccurrences() []*AggregateResult_Text_TopOccurrence {
+	if x != nil {
+		return x.TopOccurrences
+	}
+	return nil
+}
+
+func (x *AggregateResult_Text) GetCardinality() int64 {
+	if x != nil && x.Cardinality != nil {
+		return *x.Cardinality
+	}
+	return 0
+}
+
+type AggregateResult_Boolean struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count           int64   `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	TotalTrue       int64   `protobuf:"varint,2,opt,name=total_true,json=totalTrue,proto3" json:"total_true,omitempty"`
+	TotalFalse      int64   `protobuf:"varint,3,opt,name=total_false,json=totalFalse,proto3" json:"total_false,omitempty"`
+	PercentageTrue  float64 `protobuf:"fixed64,4,opt,name=percentage_true,json=percentageTrue,proto3" json:"percentage_true,omitempty"`
+	PercentageFalse float64 `protobuf:"fixed64,5,opt,name=percentage_false,json=percentageFalse,proto3" json:"percentage_false,omitempty"`
+}
+
+func (x *AggregateResult_Boolean) Reset() {
+	*x = AggregateResult_Boolean{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_aggregate_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AggregateResult_Boolean) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregateResult_Boolean) ProtoMessage() {}
+
+func (x *AggregateResult_Boolean) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_aggregate_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregateResult_Boolean.ProtoReflect.Descriptor instead.
+func (*AggregateResult_Boolean) Descriptor() ([]byte, []int) {
+	return file_v1_aggregate_proto_rawDescGZIP(), []int{4, 5}
+}
+
+func (x *AggregateResult_Boolean) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *AggregateResult_Boolean) GetTotalTrue() int64 {
+	if x != nil {
+		return x.TotalTrue
+	}
+	return 0
+}
+
+func (x *AggregateResult_Boolean) GetTotalFalse() int64 {
+	if x != nil {
+		return x.TotalFalse
+	}
+	return 0
+}
+
+func (x *AggregateResult_Boolean) GetPercentageTrue() float64 {
+	if x != nil {
+		return x.PercentageTrue
+	}
+	return 0
+}
+
+func (x *AggregateResult_Boolean) GetPercentageFalse() float64 {
+	if x != nil {
+		return x.PercentageFalse
+	}
+	return 0
+}
+
+type AggregateResult_Date struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count *int64 `protobuf:"varint,1,opt,name=count,proto3,oneof" json:"count,omitempty"`
+	// dates are formatted as RFC3339
+	Median      *string                                `protobuf:"bytes,2,opt,name=median,proto3,oneof" json:"median,omitempty"`
+	Mode        *string                                `protobuf:"bytes,3,opt,name=mode,proto3,oneof" json:"mode,omitempty"`
+	Maximum     *string                                `protobuf:"bytes,4,opt,name=maximum,proto3,oneof" json:"maximum,omitempty"`
+	Minimum     *string                                `protobuf:"bytes,5,opt,name=minimum,proto3,oneof" json:"minimum,omitempty"`
+	Histogram   []*AggregateResult_DateHistogramBucket `protobuf:"bytes,6,rep,name=histogram,proto3" json:"histogram,omitempty"`
+	Cardinality *int64                                 `protobuf:"varint,7,opt,name=cardinality,proto3,oneof" json:"cardinality,omitempty"`
+}
+
+func (x *AggregateResult_Date) Reset() {
+	*x = AggregateResult_Date{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_aggregate_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AggregateResult_Date) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregateResult_Date) ProtoMessage() {}
+
+func (x *AggregateResult_Date) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_aggregate_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregateResult_Date.ProtoReflect.Descriptor instead.
+func (*AggregateResult_Date) Descriptor() ([]byte, []int) {
+	return file_v1_aggregate_proto_rawDescGZIP(), []int{4, 6}
+}
+
+func (x *AggregateResult_Date) GetCount() int64 {
+	if x != nil && x.Count != nil {
+		return *x.Count
+	}
+	return 0
+}
+
+func (x *AggregateResult_Date) GetMedian() string {
+	if x != nil && x.Median != nil {
+		return *x.Median
+	}
+	return ""
+}
+
+func (x *AggregateResult_Date) GetMode() string {
+	if x != nil && x.Mode != nil {
+		return *x.Mode
+	}
+	return ""
+}
+
+func (x *AggregateResult_Date) GetMaximum() string {
+	if x != nil && x.Maximum != nil {
+		return *x.Maximum
+	}
+	return ""
+}
+
+func (x *AggregateResult_Date) GetMinimum() string {
+	if x != nil && x.Minimum != nil {
+		return *x.Minimum
+	}
+	return ""
+}
+
+func (x *AggregateResult_Date) GetHistogram() []*AggregateResult_DateHistogramBucket {
+	if x != nil {
+		return x.Histogram
+	}
+	return nil
+}
+
+func (x *AggregateResult_Date) GetCardinality() int64 {
+	if x != nil && x.Cardinality != nil {
+		return *x.Cardinality
+	}
+	return 0
+}
+
+type AggregateResult_Reference struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PointingTo []string `protobuf:"bytes,1,rep,name=pointing_to,json=pointingTo,proto3" json:"pointing_to,omitempty"`
+}
+
+func (x *AggregateResult_Reference) Reset() {
+	*x = AggregateResult_Reference{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_aggregate_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AggregateResult_Reference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregateResult_Reference) ProtoMessage() {}
+
+func (x *AggregateResult_Reference) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_aggregate_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregateResult_Reference.ProtoReflect.Descriptor instead.
+func (*AggregateResult_Reference) Descriptor() ([]byte, []int) {
+	return file_v1_aggregate_proto_rawDescGZIP(), []int{4, 7}
+}
+
+func (x *AggregateResult_Reference) GetPointingTo() []string {
+	if x != nil {
+		return x.PointingTo
+	}
+	return nil
+}
+
+type AggregateResult_Text_TopOccurrence struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value  string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Occurs int64  `protobuf:"varint,2,opt,name=occurs,proto3" json:"occurs,omitempty"`
+}
+
+func (x *AggregateResult_Text_TopOccurrence) Reset() {
+	*x = AggregateResult_Text_TopOccurrence{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_aggregate_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AggregateResult_Text_TopOccurrence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregateResult_Text_TopOccurrence) ProtoMessage() {}
+
+func (x *AggregateResult_Text_TopOccurrence) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_aggregate_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregateResult_Text_TopOccurrence.ProtoReflect.Descriptor instead.
+func (*AggregateResult_Text_TopOccurrence) Descriptor() ([]byte, []int) {
+	return file_v1_aggregate_proto_rawDescGZIP(), []int{4, 4, 0}
+}
+
+func (x *AggregateResult_Text_TopOccurrence) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *AggregateResult_Text_TopOccurrence) GetOccurs() int64 {
+	if x != nil {
+		return x.Occurs
+	}
+	return 0
+}
+
+type AggregateGroupBy_Range struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// included in the range, unbounded if not set
+	From *float64 `protobuf:"fixed64,1,opt,name=from,proto3,oneof" json:"from,omitempty"`
+	// excluded from the range, unbounded if not set
+	To *float64 `protobuf:"fixed64,2,opt,name=to,proto3,oneof" json:"to,omitempty"`
+}
+
+func (x *AggregateGroupBy_Range) Reset() {
+	*x = AggregateGroupBy_Range{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_aggregate_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AggregateGroupBy_Range) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregateGroupBy_Range) ProtoMessage() {}
+
+func (x *AggregateGroupBy_Range) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_aggregate_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregateGroupBy_Range.ProtoReflect.Descriptor instead.
+func (*AggregateGroupBy_Range) Descriptor() ([]byte, []int) {
+	return file_v1_aggregate_proto_rawDescGZIP(), []int{5, 0}
+}
+
+func (x *AggregateGroupBy_Range) GetFrom() float64 {
+	if x != nil && x.From != nil {
+		return *x.From
+	}
+	return 0
+}
+
+func (x *AggregateGroupBy_Range) GetTo() float64 {
+	if x != nil && x.To != nil {
+		return *x.To
+	}
+	return 0
+}
+
+type AggregateGroupBy_NumericRanges struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ranges []*AggregateGroupBy_Range `protobuf:"bytes,1,rep,name=ranges,proto3" json:"ranges,omitempty"`
+}
+
+func (x *AggregateGroupBy_NumericRanges) Reset() {
+	*x = AggregateGroupBy_NumericRanges{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_aggregate_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AggregateGroupBy_NumericRanges) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregateGroupBy_NumericRanges) ProtoMessage() {}
+
+func (x *AggregateGroupBy_NumericRanges) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_aggregate_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregateGroupBy_NumericRanges.ProtoReflect.Descriptor instead.
+func (*AggregateGroupBy_NumericRanges) Descriptor() ([]byte, []int) {
+	return file_v1_aggregate_proto_rawDescGZIP(), []int{5, 1}
+}
+
+func (x *AggregateGroupBy_NumericRanges) GetRanges() []*AggregateGroupBy_Range {
+	if x != nil {
+		return x.Ranges
+	}
+	return nil
+}
+
+// groups geo coordinates by their distance in meters to the origin
+type AggregateGroupBy_GeoRings struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Latitude  float32                   `protobuf:"fixed32,1,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude float32                   `protobuf:"fixed32,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	Ranges    []*AggregateGroupBy_Range `protobuf:"bytes,3,rep,name=ranges,proto3" json:"ranges,omitempty"`
+}
+
+func (x *AggregateGroupBy_GeoRings) Reset() {
+	*x = AggregateGroupBy_GeoRings{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_aggregate_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AggregateGroupBy_GeoRings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregateGroupBy_GeoRings) ProtoMessage() {}
+
+func (x *AggregateGroupBy_GeoRings) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_aggregate_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregateGroupBy_GeoRings.ProtoReflect.Descriptor instead.
+func (*AggregateGroupBy_GeoRings) Descriptor() ([]byte, []int) {
+	return file_v1_aggregate_proto_rawDescGZIP(), []int{5, 2}
+}
+
+func (x *AggregateGroupBy_GeoRings) GetLatitude() float32 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *AggregateGroupBy_GeoRings) GetLongitude() float32 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *AggregateGroupBy_GeoRings) GetRanges() []*AggregateGroupBy_Range {
+	if x != nil {
+		return x.Ranges
+	}
+	return nil
+}
+
+type AggregateGroupBy_Key struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path []string `protobuf:"bytes,1,rep,name=path,proto3" json:"path,omitempty"`
+	// without a derivation every value of the property is the key of a group
+	//
+	// Types that are assignable to Derivation:
+	//
+	//	*AggregateGroupBy_Key_Ranges
+	//	*AggregateGroupBy_Key_DateInterval
+	//	*AggregateGroupBy_Key_GeoRings
+	Derivation isAggregateGroupBy_Key_Derivation `protobuf_oneof:"derivation"`
+}
+
+func (x *AggregateGroupBy_Key) Reset() {
+	*x = AggregateGroupBy_Key{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_aggregate_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AggregateGroupBy_Key) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregateGroupBy_Key) ProtoMessage() {}
+
+func (x *AggregateGroupBy_Key) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_aggregate_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregateGroupBy_Key.ProtoReflect.Descriptor instead.
+func (*AggregateGroupBy_Key) Descriptor() ([]byte, []int) {
+	return file_v1_aggregate_proto_rawDescGZIP(), []int{5, 3}
+}
+
+func (x *AggregateGroupBy_Key) GetPath() []string {
+	if x != nil {
+		return x.Path
+	}
+	return nil
+}
+
+func (m *AggregateGroupBy_Key) GetDerivation() isAggregateGroupBy_Key_Derivation {
+	if m != nil {
+		return m.Derivation
+	}
+	return nil
+}
+
+func (x *AggregateGroupBy_Key) GetRanges() *AggregateGroupBy_NumericRanges {
+	if x, ok := x.GetDerivation().(*AggregateGroupBy_Key_Ranges); ok {
+		return x.Ranges
+	}
+	return nil
+}
+
+func (x *AggregateGroupBy_Key) GetDateInterval() AggregateGroupBy_DateInterval {
+	if x, ok := x.GetDerivation().(*AggregateGroupBy_Key_DateInterval); ok {
+		return x.DateInterval
+	}
+	return AggregateGroupBy_DATE_INTERVAL_UNSPECIFIED
+}
+
+func (x *AggregateGroupBy_Key) GetGeoRings() *AggregateGroupBy_GeoRings {
+	if x, ok := x.GetDerivation().(*AggregateGroupBy_Key_GeoRings); ok {
+		return x.GeoRings
+	}
+	return nil
+}
+
+type isAggregateGroupBy_Key_Derivation interface {
+	isAggregateGroupBy_Key_Derivation()
+}
+
+type AggregateGroupBy_Key_Ranges struct {
+	Ranges *AggregateGroupBy_NumericRanges `protobuf:"bytes,2,opt,name=ranges,proto3,oneof"`
+}
+
+type AggregateGroupBy_Key_DateInterval struct {
+	DateInterval AggregateGroupBy_DateInterval `protobuf:"varint,3,opt,name=date_interval,json=dateInterval,proto3,enum=weaviate.v1.AggregateGroupBy_DateInterval,oneof"`
+}
+
+type AggregateGroupBy_Key_GeoRings struct {
+	GeoRings *AggregateGroupBy_GeoRings `protobuf:"bytes,4,opt,name=geo_rings,json=geoRings,proto3,oneof"`
+}
+
+func (*AggregateGroupBy_Key_Ranges) isAggregateGroupBy_Key_Derivation() {}
+
+func (*AggregateGroupBy_Key_DateInterval) isAggregateGroupBy_Key_Derivation() {}
+
+func (*AggregateGroupBy_Key_GeoRings) isAggregateGroupBy_Key_Derivation() {}
+
+type AggregateGroup_GroupedBy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path []string `protobuf:"bytes,1,rep,name=path,proto3" json:"path,omitempty"`
+	// Types that are assignable to Value:
+	//
+	//	*AggregateGroup_GroupedBy_Text
+	//	*AggregateGroup_GroupedBy_Number
+	//	*AggregateGroup_GroupedBy_Boolean
+	Value isAggregateGroup_GroupedBy_Value `protobuf_oneof:"value"`
+	// bounds of a range group
+	From *float64 `protobuf:"fixed64,5,opt,name=from,proto3,oneof" json:"from,omitempty"`
+	To   *float64 `protobuf:"fixed64,6,opt,name=to,proto3,oneof" json:"to,omitempty"`
+}
+
+func (x *AggregateGroup_GroupedBy) Reset() {
+	*x = AggregateGroup_GroupedBy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_aggregate_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AggregateGroup_GroupedBy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AggregateGroup_GroupedBy) ProtoMessage() {}
+
+func (x *AggregateGroup_GroupedBy) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_aggregate_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AggregateGroup_GroupedBy.ProtoReflect.Descriptor instead.
+func (*AggregateGroup_GroupedBy) Descriptor() ([]byte, []int) {
+	return file_v1_aggregate_proto_rawDescGZIP(), []int{6, 0}
+}
+
+func (x *AggregateGroup_GroupedBy) GetPath() []string {
+	if x != nil {
+		return x.Path
+	}
+	return nil
+}
+
+func (m *AggregateGroup_GroupedBy) GetValue() isAggregateGroup_GroupedBy_Value {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (x *AggregateGroup_GroupedBy) GetText() string {
+	if x, ok := x.GetValue().(*AggregateGroup_GroupedBy_Text); ok {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *AggregateGroup_GroupedBy) GetNumber() float64 {
+	if x, ok := x.GetValue().(*AggregateGroup_GroupedBy_Number); ok {
+		return x.Number
+	}
+	return 0
+}
+
+func (x *AggregateGroup_GroupedBy) GetBoolean() bool {
+	if x, ok := x.GetValue().(*AggregateGroup_GroupedBy_Boolean); ok {
+		return x.Boolean
+	}
+	return false
+}
+
+func (x *AggregateGroup_GroupedBy) GetFrom() float64 {
+	if x != nil && x.From != nil {
+		return *x.From
+	}
+	return 0
+}
+
+func (x *AggregateGroup_GroupedBy) GetTo() float64 {
+	if x != nil && x.To != nil {
+		return *x.To
+	}
+	return 0
+}
+
+type isAggregateGroup_GroupedBy_Value interface {
+	isAggregateGroup_GroupedBy_Value()
+}
+
+type AggregateGroup_GroupedBy_Text struct {
+	Text string `protobuf:"bytes,2,opt,name=text,proto3,oneof"`
+}
+
+type AggregateGroup_GroupedBy_Number struct {
+	Number float64 `protobuf:"fixed64,3,opt,name=number,proto3,oneof"`
+}
+
+type AggregateGroup_GroupedBy_Boolean struct {
+	Boolean bool `protobuf:"varint,4,opt,name=boolean,proto3,oneof"`
+}
+
+func (*AggregateGroup_GroupedBy_Text) isAggregateGroup_GroupedBy_Value() {}
+
+func (*AggregateGroup_GroupedBy_Number) isAggregateGroup_GroupedBy_Value() {}
+
+func (*AggregateGroup_GroupedBy_Boolean) isAggregateGroup_GroupedBy_Value() {}
+
+var File_v1_aggregate_proto protoreflect.FileDescriptor
+
+var file_v1_aggregate_proto_rawDesc = []byte{
+	0x0a, 0x12, 0x76, 0x31, 0x2f, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0b, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x2e, 0x76,
+	0x31, 0x1a, 0x0d, 0x76, 0x31, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x13, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x5f, 0x67, 0x65, 0x74, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa7, 0x09, 0x0a, 0x10, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x06, 0x74, 0x65,
+	0x6e, 0x61, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x74, 0x65,
+	0x6e, 0x61, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x23, 0x0a, 0x0d, 0x6f, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c,
+	0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3e, 0x0a, 0x0a,
+	0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1e, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41,
+	0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79,
+	0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0x3d, 0x0a, 0x08,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x62, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d,
+	0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x67, 0x67,
+	0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x48, 0x01, 0x52,
+	0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x0c, 0x6f,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x0d, 0x48, 0x02, 0x52, 0x0b, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x88, 0x01, 0x01, 0x12, 0x33, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x28,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x48, 0x03, 0x52, 0x07, 0x66, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x73, 0x88, 0x01, 0x01, 0x12, 0x3d, 0x0a, 0x0d, 0x68, 0x79, 0x62, 0x72,
+	0x69, 0x64, 0x5f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x18, 0x29, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x79,
+	0x62, 0x72, 0x69, 0x64, 0x48, 0x04, 0x52, 0x0c, 0x68, 0x79, 0x62, 0x72, 0x69, 0x64, 0x53, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x88, 0x01, 0x01, 0x12, 0x3d, 0x0a, 0x0b, 0x6e, 0x65, 0x61, 0x72, 0x5f,
+	0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x2b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x77,
+	0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x65, 0x61, 0x72, 0x56,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x48, 0x05, 0x52, 0x0a, 0x6e, 0x65, 0x61, 0x72, 0x56, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x12, 0x3d, 0x0a, 0x0b, 0x6e, 0x65, 0x61, 0x72, 0x5f, 0x6f,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x2c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x77, 0x65,
+	0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x65, 0x61, 0x72, 0x4f, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x48, 0x06, 0x52, 0x0a, 0x6e, 0x65, 0x61, 0x72, 0x4f, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x88, 0x01, 0x01, 0x12, 0x3d, 0x0a, 0x09, 0x6e, 0x65, 0x61, 0x72, 0x5f, 0x74, 0x65,
+	0x78, 0x74, 0x18, 0x2d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69,
+	0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x65, 0x61, 0x72, 0x54, 0x65, 0x78, 0x74, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x48, 0x07, 0x52, 0x08, 0x6e, 0x65, 0x61, 0x72, 0x54, 0x65, 0x78,
+	0x74, 0x88, 0x01, 0x01, 0x12, 0x40, 0x0a, 0x0a, 0x6e, 0x65, 0x61, 0x72, 0x5f, 0x69, 0x6d, 0x61,
+	0x67, 0x65, 0x18, 0x2e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69,
+	0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x65, 0x61, 0x72, 0x49, 0x6d, 0x61, 0x67, 0x65,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x48, 0x08, 0x52, 0x09, 0x6e, 0x65, 0x61, 0x72, 0x49, 0x6d,
+	0x61, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x40, 0x0a, 0x0a, 0x6e, 0x65, 0x61, 0x72, 0x5f, 0x61,
+	0x75, 0x64, 0x69, 0x6f, 0x18, 0x2f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x77, 0x65, 0x61,
+	0x76, 0x69, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x65, 0x61, 0x72, 0x41, 0x75, 0x64,
+	0x69, 0x6f, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x48, 0x09, 0x52, 0x09, 0x6e, 0x65, 0x61, 0x72,
+	0x41, 0x75, 0x64, 0x69, 0x6f, 0x88, 0x01, 0x01, 0x12, 0x40, 0x0a, 0x0a, 0x6e, 0x65, 0x61, 0x72,
+	0x5f, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x18, 0x30, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x77,
+	0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x65, 0x61, 0x72, 0x56,
+	0x69, 0x64, 0x65, 0x6f, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x48, 0x0a, 0x52, 0x09, 0x6e, 0x65,
+	0x61, 0x72, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x88, 0x01, 0x01, 0x12, 0x40, 0x0a, 0x0a, 0x6e, 0x65,
+	0x61, 0x72, 0x5f, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x31, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x65, 0x61,
+	0x72, 0x44, 0x65, 0x70, 0x74, 0x68, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x48, 0x0b, 0x52, 0x09,
+	0x6e, 0x65, 0x61, 0x72, 0x44, 0x65, 0x70, 0x74, 0x68, 0x88, 0x01, 0x01, 0x12, 0x46, 0x0a, 0x0c,
+	0x6e, 0x65, 0x61, 0x72, 0x5f, 0x74, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x6c, 0x18, 0x32, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x4e, 0x65, 0x61, 0x72, 0x54, 0x68, 0x65, 0x72, 0x6d, 0x61, 0x6c, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x48, 0x0c, 0x52, 0x0b, 0x6e, 0x65, 0x61, 0x72, 0x54, 0x68, 0x65, 0x72, 0x6d, 0x61,
+	0x6c, 0x88, 0x01, 0x01, 0x12, 0x3a, 0x0a, 0x08, 0x6e, 0x65, 0x61, 0x72, 0x5f, 0x69, 0x6d, 0x75,
+	0x18, 0x33, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x65, 0x61, 0x72, 0x49, 0x4d, 0x55, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x48, 0x0d, 0x52, 0x07, 0x6e, 0x65, 0x61, 0x72, 0x49, 0x6d, 0x75, 0x88, 0x01, 0x01,
+	0x42, 0x09, 0x0a, 0x07, 0x5f, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x42, 0x0b, 0x0a, 0x09, 0x5f,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x62, 0x79, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x6f, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x66, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x73, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x68, 0x79, 0x62, 0x72, 0x69, 0x64,
+	0x5f, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x6e, 0x65, 0x61, 0x72,
+	0x5f, 0x76, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x6e, 0x65, 0x61, 0x72,
+	0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6e, 0x65, 0x61, 0x72,
+	0x5f, 0x74, 0x65, 0x78, 0x74, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x6e, 0x65, 0x61, 0x72, 0x5f, 0x69,
+	0x6d, 0x61, 0x67, 0x65, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x6e, 0x65, 0x61, 0x72, 0x5f, 0x61, 0x75,
+	0x64, 0x69, 0x6f, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x6e, 0x65, 0x61, 0x72, 0x5f, 0x76, 0x69, 0x64,
+	0x65, 0x6f, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x6e, 0x65, 0x61, 0x72, 0x5f, 0x64, 0x65, 0x70, 0x74,
+	0x68, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x6e, 0x65, 0x61, 0x72, 0x5f, 0x74, 0x68, 0x65, 0x72, 0x6d,
+	0x61, 0x6c, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6e, 0x65, 0x61, 0x72, 0x5f, 0x69, 0x6d, 0x75, 0x22,
+	0x62, 0x0a, 0x11, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x70,
+	0x65, 0x72, 0x74, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x39, 0x0a, 0x0b, 0x61, 0x67, 0x67, 0x72,
+	0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x67, 0x67, 0x72,
+	0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x0b, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74,
+	0x6f, 0x72, 0x73, 0x22, 0x82, 0x05, 0x0a, 0x0a, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74,
+	0x6f, 0x72, 0x12, 0x30, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1c, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41,
+	0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x12, 0x19, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x88, 0x01, 0x01, 0x12,
+	0x1c, 0x0a, 0x09, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x01, 0x52, 0x09, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x44, 0x0a,
+	0x09, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x21, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41,
+	0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x67,
+	0x72, 0x61, 0x6d, 0x48, 0x01, 0x52, 0x09, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x6d,
+	0x88, 0x01, 0x01, 0x1a, 0x3d, 0x0a, 0x09, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x6d,
+	0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x14, 0x0a, 0x05,
+	0x65, 0x64, 0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x01, 0x52, 0x05, 0x65, 0x64, 0x67,
+	0x65, 0x73, 0x22, 0xeb, 0x02, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x10,
+	0x01, 0x12, 0x0d, 0x0a, 0x09, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10, 0x02,
+	0x12, 0x0d, 0x0a, 0x09, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x45, 0x41, 0x4e, 0x10, 0x03, 0x12,
+	0x0f, 0x0a, 0x0b, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x45, 0x44, 0x49, 0x41, 0x4e, 0x10, 0x04,
+	0x12, 0x0d, 0x0a, 0x09, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x10, 0x05, 0x12,
+	0x10, 0x0a, 0x0c, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x41, 0x58, 0x49, 0x4d, 0x55, 0x4d, 0x10,
+	0x06, 0x12, 0x10, 0x0a, 0x0c, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x49, 0x4e, 0x49, 0x4d, 0x55,
+	0x4d, 0x10, 0x07, 0x12, 0x0c, 0x0a, 0x08, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x55, 0x4d, 0x10,
+	0x08, 0x12, 0x13, 0x0a, 0x0f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x54, 0x4f, 0x54, 0x41, 0x4c, 0x5f,
+	0x54, 0x52, 0x55, 0x45, 0x10, 0x09, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x54,
+	0x4f, 0x54, 0x41, 0x4c, 0x5f, 0x46, 0x41, 0x4c, 0x53, 0x45, 0x10, 0x0a, 0x12, 0x18, 0x0a, 0x14,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x45, 0x52, 0x43, 0x45, 0x4e, 0x54, 0x41, 0x47, 0x45, 0x5f,
+	0x54, 0x52, 0x55, 0x45, 0x10, 0x0b, 0x12, 0x19, 0x0a, 0x15, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50,
+	0x45, 0x52, 0x43, 0x45, 0x4e, 0x54, 0x41, 0x47, 0x45, 0x5f, 0x46, 0x41, 0x4c, 0x53, 0x45, 0x10,
+	0x0c, 0x12, 0x18, 0x0a, 0x14, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x54, 0x4f, 0x50, 0x5f, 0x4f, 0x43,
+	0x43, 0x55, 0x52, 0x52, 0x45, 0x4e, 0x43, 0x45, 0x53, 0x10, 0x0d, 0x12, 0x14, 0x0a, 0x10, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x54, 0x4f, 0x10,
+	0x0e, 0x12, 0x13, 0x0a, 0x0f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x45, 0x52, 0x43, 0x45, 0x4e,
+	0x54, 0x49, 0x4c, 0x45, 0x10, 0x0f, 0x12, 0x12, 0x0a, 0x0e, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x48,
+	0x49, 0x53, 0x54, 0x4f, 0x47, 0x52, 0x41, 0x4d, 0x10, 0x10, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x43, 0x41, 0x52, 0x44, 0x49, 0x4e, 0x41, 0x4c, 0x49, 0x54, 0x59, 0x10, 0x11,
+	0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x68,
+	0x69, 0x73, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x22, 0x8e, 0x03, 0x0a, 0x0e, 0x41, 0x67, 0x67,
+	0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x6f, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x52, 0x04, 0x74, 0x6f, 0x6f, 0x6b, 0x12,
+	0x49, 0x0a, 0x0d, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x2e, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x48, 0x00, 0x52, 0x0c, 0x73, 0x69,
+	0x6e, 0x67, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x4e, 0x0a, 0x0f, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0e, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x65, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x1a, 0x82, 0x01, 0x0a, 0x06, 0x53,
+	0x69, 0x6e, 0x67, 0x6c, 0x65, 0x12, 0x28, 0x0a, 0x0d, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x0c,
+	0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12,
+	0x3c, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x42, 0x10, 0x0a,
+	0x0e, 0x5f, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a,
+	0x3e, 0x0a, 0x07, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x64, 0x12, 0x33, 0x0a, 0x06, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x65, 0x61,
+	0x76, 0x69, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61,
+	0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x42,
+	0x08, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x8a, 0x0f, 0x0a, 0x0f, 0x41, 0x67,
+	0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1a, 0x0a,
+	0x08, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x12, 0x17, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x88,
+	0x01, 0x01, 0x12, 0x46, 0x0a, 0x09, 0x6e, 0x75, 0x6d, 0x65, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x2e, 0x4e, 0x75, 0x6d, 0x65, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x48, 0x00, 0x52,
+	0x09, 0x6e, 0x75, 0x6d, 0x65, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x12, 0x37, 0x0a, 0x04, 0x74, 0x65,
+	0x78, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69,
+	0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x2e, 0x54, 0x65, 0x78, 0x74, 0x48, 0x00, 0x52, 0x04, 0x74,
+	0x65, 0x78, 0x74, 0x12, 0x40, 0x0a, 0x07, 0x62, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x48, 0x00, 0x52, 0x07, 0x62, 0x6f,
+	0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x12, 0x37, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x46,
+	0x0a, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x26, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x2e,
+	0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x48, 0x00, 0x52, 0x09, 0x72, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x1a, 0x3e, 0x0a, 0x0a, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e,
+	0x74, 0x69, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x6c, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x6c, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x4b, 0x0a, 0x0f, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x67,
+	0x72, 0x61, 0x6d, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f,
+	0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a,
+	0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x14, 0x0a,
+	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x1a, 0x4f, 0x0a, 0x13, 0x44, 0x61, 0x74, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x67, 0x72, 0x61, 0x6d, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72,
+	0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e,
+	0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x14,
+	0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x1a, 0xdf, 0x03, 0x0a, 0x09, 0x4e, 0x75, 0x6d, 0x65, 0x72, 0x69, 0x63,
+	0x61, 0x6c, 0x12, 0x19, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x48, 0x00, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a,
+	0x04, 0x6d, 0x65, 0x61, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x48, 0x01, 0x52, 0x04, 0x6d,
+	0x65, 0x61, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x48, 0x02, 0x52, 0x06, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x6e,
+	0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x01, 0x48, 0x03, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07,
+	0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x48, 0x04, 0x52,
+	0x07, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x6d,
+	0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x48, 0x05, 0x52, 0x07,
+	0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x15, 0x0a, 0x03, 0x73, 0x75,
+	0x6d, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x48, 0x06, 0x52, 0x03, 0x73, 0x75, 0x6d, 0x88, 0x01,
+	0x01, 0x12, 0x49, 0x0a, 0x0b, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x73,
+	0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x2e, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x52,
+	0x0b, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x4a, 0x0a, 0x09,
+	0x68, 0x69, 0x73, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x2c, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x67,
+	0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x2e, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x09, 0x68,
+	0x69, 0x73, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x12, 0x25, 0x0a, 0x0b, 0x63, 0x61, 0x72, 0x64,
+	0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x48, 0x07, 0x52,
+	0x0b, 0x63, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x88, 0x01, 0x01, 0x42,
+	0x08, 0x0a, 0x06, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6d, 0x65,
+	0x61, 0x6e, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x6e, 0x42, 0x07, 0x0a,
+	0x05, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6d, 0x61, 0x78, 0x69, 0x6d,
+	0x75, 0x6d, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x42, 0x06,
+	0x0a, 0x04, 0x5f, 0x73, 0x75, 0x6d, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x63, 0x61, 0x72, 0x64, 0x69,
+	0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x1a, 0xec, 0x01, 0x0a, 0x04, 0x54, 0x65, 0x78, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x58, 0x0a, 0x0f, 0x74, 0x6f, 0x70, 0x5f, 0x6f, 0x63, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f,
+	0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x67, 0x67,
+	0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x2e, 0x54, 0x65, 0x78,
+	0x74, 0x2e, 0x54, 0x6f, 0x70, 0x4f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52,
+	0x0e, 0x74, 0x6f, 0x70, 0x4f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12,
+	0x25, 0x0a, 0x0b, 0x63, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x0b, 0x63, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x6c,
+	0x69, 0x74, 0x79, 0x88, 0x01, 0x01, 0x1a, 0x3d, 0x0a, 0x0d, 0x54, 0x6f, 0x70, 0x4f, 0x63, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f,
+	0x63, 0x63, 0x75, 0x72, 0x73, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x63, 0x61, 0x72, 0x64, 0x69, 0x6e,
+	0x61, 0x6c, 0x69, 0x74, 0x79, 0x1a, 0xb3, 0x01, 0x0a, 0x07, 0x42, 0x6f, 0x6f, 0x6c, 0x65, 0x61,
+	0x6e, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x5f, 0x74, 0x72, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x54, 0x72, 0x75, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f,
+	0x66, 0x61, 0x6c, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x46, 0x61, 0x6c, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x65, 0x72, 0x63, 0x65,
+	0x6e, 0x74, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x72, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0e, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x54, 0x72, 0x75, 0x65,
+	0x12, 0x29, 0x0a, 0x10, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x5f, 0x66,
+	0x61, 0x6c, 0x73, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x70, 0x65, 0x72, 0x63,
+	0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x46, 0x61, 0x6c, 0x73, 0x65, 0x1a, 0xd2, 0x02, 0x0a, 0x04,
+	0x44, 0x61, 0x74, 0x65, 0x12, 0x19, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12,
+	0x1b, 0x0a, 0x06, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x01, 0x52, 0x06, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04,
+	0x6d, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x04, 0x6d, 0x6f,
+	0x64, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75,
+	0x6d, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x04, 0x52, 0x07, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d,
+	0x88, 0x01, 0x01, 0x12, 0x4e, 0x0a, 0x09, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x6d,
+	0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x67, 0x72,
+	0x61, 0x6d, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x09, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x67,
+	0x72, 0x61, 0x6d, 0x12, 0x25, 0x0a, 0x0b, 0x63, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x6c, 0x69,
+	0x74, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x48, 0x05, 0x52, 0x0b, 0x63, 0x61, 0x72, 0x64,
+	0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x6e, 0x42,
+	0x07, 0x0a, 0x05, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6d, 0x61, 0x78,
+	0x69, 0x6d, 0x75, 0x6d, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d,
+	0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x63, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79,
+	0x1a, 0x2c, 0x0a, 0x09, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1f, 0x0a,
+	0x0b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x6f, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0a, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x54, 0x6f, 0x42, 0x0d,
+	0x0a, 0x0b, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x07, 0x0a,
+	0x05, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x22, 0xef, 0x06, 0x0a, 0x10, 0x41, 0x67, 0x67, 0x72, 0x65,
+	0x67, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x12, 0x35, 0x0a, 0x04, 0x6b,
+	0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x77, 0x65, 0x61, 0x76,
+	0x69, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74,
+	0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x2e, 0x4b, 0x65, 0x79, 0x52, 0x04, 0x6b, 0x65,
+	0x79, 0x73, 0x12, 0x19, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0d, 0x48, 0x00, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x88, 0x01, 0x01, 0x1a, 0x45, 0x0a,
+	0x05, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x17, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x88, 0x01, 0x01, 0x12,
+	0x13, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x48, 0x01, 0x52, 0x02, 0x74,
+	0x6f, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x42, 0x05, 0x0a,
+	0x03, 0x5f, 0x74, 0x6f, 0x1a, 0x4c, 0x0a, 0x0d, 0x4e, 0x75, 0x6d, 0x65, 0x72, 0x69, 0x63, 0x52,
+	0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x06, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x42, 0x79, 0x2e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x06, 0x72, 0x61, 0x6e, 0x67,
+	0x65, 0x73, 0x1a, 0x81, 0x01, 0x0a, 0x08, 0x47, 0x65, 0x6f, 0x52, 0x69, 0x6e, 0x67, 0x73, 0x12,
+	0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x02, 0x52, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6c,
+	0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x09,
+	0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x3b, 0x0a, 0x06, 0x72, 0x61, 0x6e,
+	0x67, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x77, 0x65, 0x61, 0x76,
+	0x69, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74,
+	0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x2e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x06,
+	0x72, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x1a, 0x88, 0x02, 0x0a, 0x03, 0x4b, 0x65, 0x79, 0x12, 0x12,
+	0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61,
+	0x74, 0x68, 0x12, 0x45, 0x0a, 0x06, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42,
+	0x79, 0x2e, 0x4e, 0x75, 0x6d, 0x65, 0x72, 0x69, 0x63, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x48,
+	0x00, 0x52, 0x06, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x51, 0x0a, 0x0d, 0x64, 0x61, 0x74,
+	0x65, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x2a, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41,
+	0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x2e,
+	0x44, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x48, 0x00, 0x52, 0x0c,
+	0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x45, 0x0a, 0x09,
+	0x67, 0x65, 0x6f, 0x5f, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x26, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x67,
+	0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x2e, 0x47,
+	0x65, 0x6f, 0x52, 0x69, 0x6e, 0x67, 0x73, 0x48, 0x00, 0x52, 0x08, 0x67, 0x65, 0x6f, 0x52, 0x69,
+	0x6e, 0x67, 0x73, 0x42, 0x0c, 0x0a, 0x0a, 0x64, 0x65, 0x72, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0xda, 0x01, 0x0a, 0x0c, 0x44, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76,
+	0x61, 0x6c, 0x12, 0x1d, 0x0a, 0x19, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52,
+	0x56, 0x41, 0x4c, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x18, 0x0a, 0x14, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x56,
+	0x41, 0x4c, 0x5f, 0x4d, 0x49, 0x4e, 0x55, 0x54, 0x45, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x44,
+	0x41, 0x54, 0x45, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x56, 0x41, 0x4c, 0x5f, 0x48, 0x4f, 0x55,
+	0x52, 0x10, 0x02, 0x12, 0x15, 0x0a, 0x11, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x49, 0x4e, 0x54, 0x45,
+	0x52, 0x56, 0x41, 0x4c, 0x5f, 0x44, 0x41, 0x59, 0x10, 0x03, 0x12, 0x16, 0x0a, 0x12, 0x44, 0x41,
+	0x54, 0x45, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x56, 0x41, 0x4c, 0x5f, 0x57, 0x45, 0x45, 0x4b,
+	0x10, 0x04, 0x12, 0x17, 0x0a, 0x13, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52,
+	0x56, 0x41, 0x4c, 0x5f, 0x4d, 0x4f, 0x4e, 0x54, 0x48, 0x10, 0x05, 0x12, 0x19, 0x0a, 0x15, 0x44,
+	0x41, 0x54, 0x45, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x56, 0x41, 0x4c, 0x5f, 0x51, 0x55, 0x41,
+	0x52, 0x54, 0x45, 0x52, 0x10, 0x06, 0x12, 0x16, 0x0a, 0x12, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x49,
+	0x4e, 0x54, 0x45, 0x52, 0x56, 0x41, 0x4c, 0x5f, 0x59, 0x45, 0x41, 0x52, 0x10, 0x07, 0x42, 0x08,
+	0x0a, 0x06, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x94, 0x03, 0x0a, 0x0e, 0x41, 0x67, 0x67,
+	0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x44, 0x0a, 0x0a, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x25, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x67,
+	0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x65, 0x64, 0x42, 0x79, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x65, 0x64, 0x42,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x33, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61,
+	0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x3c, 0x0a, 0x0a,
+	0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41,
+	0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x0a,
+	0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x1a, 0xb2, 0x01, 0x0a, 0x09, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x65, 0x64, 0x42, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x04,
+	0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x74, 0x65,
+	0x78, 0x74, 0x12, 0x18, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x01, 0x48, 0x00, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x07,
+	0x62, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52,
+	0x07, 0x62, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x12, 0x17, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x48, 0x01, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x88, 0x01,
+	0x01, 0x12, 0x13, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x48, 0x02, 0x52,
+	0x02, 0x74, 0x6f, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x42,
+	0x07, 0x0a, 0x05, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x74, 0x6f, 0x42,
+	0x73, 0x0a, 0x23, 0x69, 0x6f, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x2e, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x42, 0x16, 0x57, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x5a, 0x34,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x77, 0x65, 0x61, 0x76, 0x69,
+	0x61, 0x74, 0x65, 0x2f, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x2f, 0x67, 0x72, 0x70,
+	0x63, 0x2f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x3b, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_v1_aggregate_proto_rawDescOnce sync.Once
+	file_v1_aggregate_proto_rawDescData = file_v1_aggregate_proto_rawDesc
+)
+
+func file_v1_aggregate_proto_rawDescGZIP() []byte {
+	file_v1_aggregate_proto_rawDescOnce.Do(func() {
+		file_v1_aggregate_proto_rawDescData = protoimpl.X.CompressGZIP(file_v1_aggregate_proto_rawDescData)
+	})
+	return file_v1_aggregate_proto_rawDescData
+}
+
+var file_v1_aggregate_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_v1_aggregate_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
+var file_v1_aggregate_proto_goTypes = []interface{}{
+	(Aggregator_Type)(0),                        // 0: weaviate.v1.Aggregator.Type
+	(AggregateGroupBy_DateInterval)(0),          // 1: weaviate.v1.AggregateGroupBy.DateInterval
+	(*AggregateRequest)(nil),                    // 2: weaviate.v1.AggregateRequest
+	(*AggregateProperty)(nil),                   // 3: weaviate.v1.AggregateProperty
+	(*Aggregator)(nil),                          // 4: weaviate.v1.Aggregator
+	(*AggregateReply)(nil),                      // 5: weaviate.v1.AggregateReply
+	(*AggregateResult)(nil),                     // 6: weaviate.v1.AggregateResult
+	(*AggregateGroupBy)(nil),                    // 7: weaviate.v1.AggregateGroupBy
+	(*AggregateGroup)(nil),                      // 8: weaviate.v1.AggregateGroup
+	(*Aggregator_Histogram)(nil),                // 9: weaviate.v1.Aggregator.Histogram
+	(*AggregateReply_Single)(nil),               // 10: weaviate.v1.AggregateReply.Single
+	(*AggregateReply_Grouped)(nil),              // 11: weaviate.v1.AggregateReply.Grouped
+	(*AggregateResult_Percentile)(nil),          // 12: weaviate.v1.AggregateResult.Percentile
+	(*AggregateResult_HistogramBucket)(nil),     // 13: weaviate.v1.AggregateResult.HistogramBucket
+	(*AggregateResult_DateHistogramBucket)(nil), // 14: weaviate.v1.AggregateResult.DateHistogramBucket
+	(*AggregateResult_Numerical)(nil),           // 15: weaviate.v1.AggregateResult.Numerical
+	(*AggregateResult_Text)(nil),                // 16: weaviate.v1.AggregateResult.Text
+	(*AggregateResult_Boolean)(nil),             // 17: weaviate.v1.AggregateResult.Boolean
+	(*AggregateResult_Date)(nil),                // 18: weaviate.v1.AggregateResult.Date
+	(*AggregateResult_Reference)(nil),           // 19: weaviate.v1.AggregateResult.Reference
+	(*AggregateResult_Text_TopOccurrence)(nil),  // 20: weaviate.v1.AggregateResult.Text.TopOccurrence
+	(*AggregateGroupBy_Range)(nil),              // 21: weaviate.v1.AggregateGroupBy.Range
+	(*AggregateGroupBy_NumericRanges)(nil),      // 22: weaviate.v1.AggregateGroupBy.NumericRanges
+	(*AggregateGroupBy_GeoRings)(nil),           // 23: weaviate.v1.AggregateGroupBy.GeoRings
+	(*AggregateGroupBy_Key)(nil),                // 24: weaviate.v1.AggregateGroupBy.Key
+	(*AggregateGroup_GroupedBy)(nil),            // 25: weaviate.v1.AggregateGroup.GroupedBy
+	(*Filters)(nil),                             // 26: weaviate.v1.Filters
+	(*Hybrid)(nil),                              // 27: weaviate.v1.Hybrid
+	(*NearVector)(nil),                          // 28: weaviate.v1.NearVector
+	(*NearObject)(nil),                          // 29: weaviate.v1.NearObject
+	(*NearTextSearch)(nil),                      // 30: weaviate.v1.NearTextSearch
+	(*NearImageSearch)(nil),                     // 31: weaviate.v1.NearImageSearch
+	(*NearAudioSearch)(nil),                     // 32: weaviate.v1.NearAudioSearch
+	(*NearVideoSearch)(nil),                     // 33: weaviate.v1.NearVideoSearch
+	(*NearDepthSearch)(nil),                     // 34: weaviate.v1.NearDepthSearch
+	(*NearThermalSearch)(nil),                   // 35: weaviate.v1.NearThermalSearch
+	(*NearIMUSearch)(nil),                       // 36: weaviate.v1.NearIMUSearch
+}
+var file_v1_aggregate_proto_depIdxs = []int32{
+	3,  // 0: weaviate.v1.AggregateRequest.properties:type_name -> weaviate.v1.AggregateProperty
+	7,  // 1: weaviate.v1.AggregateRequest.group_by:type_name -> weaviate.v1.AggregateGroupBy
+	26, // 2: weaviate.v1.AggregateRequest.filters:type_name -> weaviate.v1.Filters
+	27, // 3: weaviate.v1.AggregateRequest.hybrid_search:type_name -> weaviate.v1.Hybrid
+	28, // 4: weaviate.v1.AggregateRequest.near_vector:type_name -> weaviate.v1.NearVector
+	29, // 5: weaviate.v1.AggregateRequest.near_object:type_name -> weaviate.v1.NearObject
+	30, // 6: weaviate.v1.AggregateRequest.near_text:type_name -> weaviate.v1.NearTextSearch
+	31, // 7: weaviate.v1.AggregateRequest.near_image:type_name -> weaviate.v1.NearImageSearch
+	32, // 8: weaviate.v1.AggregateRequest.near_audio:type_name -> weaviate.v1.NearAudioSearch
+	33, // 9: weaviate.v1.AggregateRequest.near_video:type_name -> weaviate.v1.NearVideoSearch
+	34, // 10: weaviate.v1.AggregateRequest.near_depth:type_name -> weaviate.v1.NearDepthSearch
+	35, // 11: weaviate.v1.AggregateRequest.near_thermal:type_name -> weaviate.v1.NearThermalSearch
+	36, // 12: weaviate.v1.AggregateRequest.near_imu:type_name -> weaviate.v1.NearIMUSearch
+	4,  // 13: weaviate.v1.AggregateProperty.aggregators:type_name -> weaviate.v1.Aggregator
+	0,  // 14: weaviate.v1.Aggregator.type:type_name -> weaviate.v1.Aggregator.Type
+	9,  // 15: weaviate.v1.Aggregator.histogram:type_name -> weaviate.v1.Aggregator.Histogram
+	10, // 16: weaviate.v1.AggregateReply.single_result:type_name -> weaviate.v1.AggregateReply.Single
+	11, // 17: weaviate.v1.AggregateReply.grouped_results:type_name -> weaviate.v1.AggregateReply.Grouped
+	15, // 18: weaviate.v1.AggregateResult.numerical:type_name -> weaviate.v1.AggregateResult.Numerical
+	16, // 19: weaviate.v1.AggregateResult.text:type_name -> weaviate.v1.AggregateResult.Text
+	17, // 20: weaviate.v1.AggregateResult.boolean:type_name -> weaviate.v1.AggregateResult.Boolean
+	18, // 21: weaviate.v1.AggregateResult.date:type_name -> weaviate.v1.AggregateResult.Date
+	19, // 22: weaviate.v1.AggregateResult.reference:type_name -> weaviate.v1.AggregateResult.Reference
+	24, // 23: weaviate.v1.AggregateGroupBy.keys:type_name -> weaviate.v1.AggregateGroupBy.Key
+	25, // 24: weaviate.v1.AggregateGroup.grouped_by:type_name -> weaviate.v1.AggregateGroup.GroupedBy
+	8,  // 25: weaviate.v1.AggregateGroup.groups:type_name -> weaviate.v1.AggregateGroup
+	6,  // 26: weaviate.v1.AggregateGroup.properties:type_name -> weaviate.v1.AggregateResult
+	6,  // 27: weaviate.v1.AggregateReply.Single.properties:type_name -> weaviate.v1.AggregateResult
+	8,  // 28: weaviate.v1.AggregateReply.Grouped.groups:type_name -> weaviate.v1.AggregateGroup
+	12, // 29: weaviate.v1.AggregateResult.Numerical.percentiles:type_name -> weaviate.v1.AggregateResult.Percentile
+	13, // 30: weaviate.v1.AggregateResult.Numerical.histogram:type_name -> weaviate.v1.AggregateResult.HistogramBucket
+	20, // 31: weaviate.v1.AggregateResult.Text.top_occurrences:type_name -> weaviate.v1.AggregateResult.Text.TopOccurrence
+	14, // 32: weaviate.v1.AggregateResult.Date.histogram:type_name -> weaviate.v1.AggregateResult.DateHistogramBucket
+	21, // 33: weaviate.v1.AggregateGroupBy.NumericRanges.ranges:type_name -> weaviate.v1.AggregateGroupBy.Range
+	21, // 34: weaviate.v1.AggregateGroupBy.GeoRings.ranges:type_name -> weaviate.v1.AggregateGroupBy.Range
+	22, // 35: weaviate.v1.AggregateGroupBy.Key.ranges:type_name -> weaviate.v1.AggregateGroupBy.NumericRanges
+	1,  // 36: weaviate.v1.AggregateGroupBy.Key.date_interval:type_name -> weaviate.v1.AggregateGroupBy.DateInterval
+	23, // 37: weaviate.v1.AggregateGroupBy.Key.geo_rings:type_name -> weaviate.v1.AggregateGroupBy.GeoRings
+	38, // [38:38] is the sub-list for method output_type
+	38, // [38:38] is the sub-list for method input_type
+	38, // [38:38] is the sub-list for extension type_name
+	38, // [38:38] is the sub-list for extension extendee
+	0,  // [0:38] is the sub-list for field type_name
+}
+
+func init() { file_v1_aggregate_proto_init() }
+func file_v1_aggregate_proto_init() {
+	if File_v1_aggregate_proto != nil {
+		return
+	}
+	file_v1_base_proto_init()
+	file_v1_search_get_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_v1_aggregate_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AggregateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_aggregate_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AggregateProperty); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_aggregate_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Aggregator); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_aggregate_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AggregateReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_aggregate_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AggregateResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_aggregate_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AggregateGroupBy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_aggregate_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AggregateGroup); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_aggregate_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Aggregator_Histogram); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_aggregate_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AggregateReply_Single); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_aggregate_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AggregateReply_Grouped); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_aggregate_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AggregateResult_Percentile); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_aggregate_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AggregateResult_HistogramBucket); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_aggregate_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AggregateResult_DateHistogramBucket); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_aggregate_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AggregateResult_Numerical); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_aggregate_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AggregateResult_Text); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_aggregate_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AggregateResult_Boolean); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_aggregate_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AggregateResult_Date); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_aggregate_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AggregateResult_Reference); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_aggregate_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AggregateResult_Text_TopOccurrence); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_aggregate_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AggregateGroupBy_Range); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_aggregate_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AggregateGroupBy_NumericRanges); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_aggregate_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AggregateGroupBy_GeoRings); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_aggregate_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AggregateGroupBy_Key); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_aggregate_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AggregateGroup_GroupedBy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_v1_aggregate_proto_msgTypes[0].OneofWrappers = []interface{}{}
+	file_v1_aggregate_proto_msgTypes[2].OneofWrappers = []interface{}{}
+	file_v1_aggregate_proto_msgTypes[3].OneofWrappers = []interface{}{
+		(*AggregateReply_SingleResult)(nil),
+		(*AggregateReply_GroupedResults)(nil),
+	}
+	file_v1_aggregate_proto_msgTypes[4].OneofWrappers = []interface{}{
+		(*AggregateResult_Numerical_)(nil),
+		(*AggregateResult_Text_)(nil),
+		(*AggregateResult_Boolean_)(nil),
+		(*AggregateResult_Date_)(nil),
+		(*AggregateResult_Reference_)(nil),
+	}
+	file_v1_aggregate_proto_msgTypes[5].OneofWrappers = []interface{}{}
+	file_v1_aggregate_proto_msgTypes[8].OneofWrappers = []interface{}{}
+	file_v1_aggregate_proto_msgTypes[13].OneofWrappers = []interface{}{}
+	file_v1_aggregate_proto_msgTypes[14].OneofWrappers = []interface{}{}
+	file_v1_aggregate_proto_msgTypes[16].OneofWrappers = []interface{}{}
+	file_v1_aggregate_proto_msgTypes[19].OneofWrappers = []interface{}{}
+	file_v1_aggregate_proto_msgTypes[22].OneofWrappers = []interface{}{
+		(*AggregateGroupBy_Key_Ranges)(nil),
+		(*AggregateGroupBy_Key_DateInterval)(nil),
+		(*AggregateGroupBy_Key_GeoRings)(nil),
+	}
+	file_v1_aggregate_proto_msgTypes[23].OneofWrappers = []interface{}{
+		(*AggregateGroup_GroupedBy_Text)(nil),
+		(*AggregateGroup_GroupedBy_Number)(nil),
+		(*AggregateGroup_GroupedBy_Boolean)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_v1_aggregate_proto_rawDesc,
+			NumEnums:      2,
+			NumMessages:   24,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_v1_aggregate_proto_goTypes,
+		DependencyIndexes: file_v1_aggregate_proto_depIdxs,
+		EnumInfos:         file_v1_aggregate_proto_enumTypes,
+		MessageInfos:      file_v1_aggregate_proto_msgTypes,
+	}.Build()
+	File_v1_aggregate_proto = out.File
+	file_v1_aggregate_proto_rawDesc = nil
+	file_v1_aggregate_proto_goTypes = nil
+	file_v1_aggregate_proto_depIdxs = nil
+}
@@ -0,0 +1,624 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+
+package protocol
+
+import (
+	reflect "reflect"
+	sync "sync"
+
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type TraverseRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Collection string   `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+	StartUuids []string `protobuf:"bytes,2,rep,name=start_uuids,json=startUuids,proto3" json:"start_uuids,omitempty"`
+	Tenant     string   `protobuf:"bytes,3,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	// hop i configures the expansion of the nodes at depth i, the last hop is
+	// reused for all further depths
+	Hops     []*TraverseHop `protobuf:"bytes,4,rep,name=hops,proto3" json:"hops,omitempty"`
+	MaxDepth uint32         `protobuf:"varint,5,opt,name=max_depth,json=maxDepth,proto3" json:"max_depth,omitempty"`
+	// maximum number of references followed per node and property, 0 means no limit
+	MaxFanOut uint32 `protobuf:"varint,6,opt,name=max_fan_out,json=maxFanOut,proto3" json:"max_fan_out,omitempty"`
+	MaxNodes  uint32 `protobuf:"varint,7,opt,name=max_nodes,json=maxNodes,proto3" json:"max_nodes,omitempty"`
+	// stops the traversal once reached and returns the shortest path to it
+	TargetUuid *string `protobuf:"bytes,8,opt,name=target_uuid,json=targetUuid,proto3,oneof" json:"target_uuid,omitempty"`
+}
+
+func (x *TraverseRequest) Reset() {
+	*x = TraverseRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_traverse_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TraverseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TraverseRequest) ProtoMessage() {}
+
+func (x *TraverseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_traverse_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TraverseRequest.ProtoReflect.Descriptor instead.
+func (*TraverseRequest) Descriptor() ([]byte, []int) {
+	return file_v1_traverse_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TraverseRequest) GetCollection() string {
+	if x != nil {
+		return x.Collection
+	}
+	return ""
+}
+
+func (x *TraverseRequest) GetStartUuids() []string {
+	if x != nil {
+		return x.StartUuids
+	}
+	return nil
+}
+
+func (x *TraverseRequest) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
+
+func (x *TraverseRequest) GetHops() []*TraverseHop {
+	if x != nil {
+		return x.Hops
+	}
+	return nil
+}
+
+func (x *TraverseRequest) GetMaxDepth() uint32 {
+	if x != nil {
+		return x.MaxDepth
+	}
+	return 0
+}
+
+func (x *TraverseRequest) GetMaxFanOut() uint32 {
+	if x != nil {
+		return x.MaxFanOut
+	}
+	return 0
+}
+
+func (x *TraverseRequest) GetMaxNodes() uint32 {
+	if x != nil {
+		return x.MaxNodes
+	}
+	return 0
+}
+
+func (x *TraverseRequest) GetTargetUuid() string {
+	if x != nil && x.TargetUuid != nil {
+		return *x.TargetUuid
+	}
+	return ""
+}
+
+type TraverseHop struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// reference properties to follow, all if empty
+	Properties []string `protobuf:"bytes,1,rep,name=properties,proto3" json:"properties,omitempty"`
+	// restricts the nodes reached in this hop to a collection, required for filters
+	Collection *string  `protobuf:"bytes,2,opt,name=collection,proto3,oneof" json:"collection,omitempty"`
+	Filters    *Filters `protobuf:"bytes,3,opt,name=filters,proto3,oneof" json:"filters,omitempty"`
+}
+
+func (x *TraverseHop) Reset() {
+	*x = TraverseHop{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_traverse_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TraverseHop) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TraverseHop) ProtoMessage() {}
+
+func (x *TraverseHop) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_traverse_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TraverseHop.ProtoReflect.Descriptor instead.
+func (*TraverseHop) Descriptor() ([]byte, []int) {
+	return file_v1_traverse_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TraverseHop) GetProperties() []string {
+	if x != nil {
+		return x.Properties
+	}
+	return nil
+}
+
+func (x *TraverseHop) GetCollection() string {
+	if x != nil && x.Collection != nil {
+		return *x.Collection
+	}
+	return ""
+}
+
+func (x *TraverseHop) GetFilters() *Filters {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
+type TraverseReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Took      float32         `protobuf:"fixed32,1,opt,name=took,proto3" json:"took,omitempty"`
+	Nodes     []*TraverseNode `protobuf:"bytes,2,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	Edges     []*TraverseEdge `protobuf:"bytes,3,rep,name=edges,proto3" json:"edges,omitempty"`
+	Path      []string        `protobuf:"bytes,4,rep,name=path,proto3" json:"path,omitempty"`
+	Truncated bool            `protobuf:"varint,5,opt,name=truncated,proto3" json:"truncated,omitempty"`
+}
+
+func (x *TraverseReply) Reset() {
+	*x = TraverseReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_traverse_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TraverseReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TraverseReply) ProtoMessage() {}
+
+func (x *TraverseReply) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_traverse_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TraverseReply.ProtoReflect.Descriptor instead.
+func (*TraverseReply) Descriptor() ([]byte, []int) {
+	return file_v1_traverse_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TraverseReply) GetTook() float32 {
+	if x != nil {
+		return x.Took
+	}
+	return 0
+}
+
+func (x *TraverseReply) GetNodes() []*TraverseNode {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+func (x *TraverseReply) GetEdges() []*TraverseEdge {
+	if x != nil {
+		return x.Edges
+	}
+	return nil
+}
+
+func (x *TraverseReply) GetPath() []string {
+	if x != nil {
+		return x.Path
+	}
+	return nil
+}
+
+func (x *TraverseReply) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+type TraverseNode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Collection string           `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+	Uuid       string           `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Depth      uint32           `protobuf:"varint,3,opt,name=depth,proto3" json:"depth,omitempty"`
+	Properties *structpb.Struct `protobuf:"bytes,4,opt,name=properties,proto3" json:"properties,omitempty"`
+}
+
+func (x *TraverseNode) Reset() {
+	*x = TraverseNode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_traverse_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TraverseNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TraverseNode) ProtoMessage() {}
+
+func (x *TraverseNode) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_traverse_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TraverseNode.ProtoReflect.Descriptor instead.
+func (*TraverseNode) Descriptor() ([]byte, []int) {
+	return file_v1_traverse_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *TraverseNode) GetCollection() string {
+	if x != nil {
+		return x.Collection
+	}
+	return ""
+}
+
+func (x *TraverseNode) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *TraverseNode) GetDepth() uint32 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+func (x *TraverseNode) GetProperties() *structpb.Struct {
+	if x != nil {
+		return x.Properties
+	}
+	return nil
+}
+
+type TraverseEdge struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FromCollection string `protobuf:"bytes,1,opt,name=from_collection,json=fromCollection,proto3" json:"from_collection,omitempty"`
+	FromUuid       string `protobuf:"bytes,2,opt,name=from_uuid,json=fromUuid,proto3" json:"from_uuid,omitempty"`
+	Property       string `protobuf:"bytes,3,opt,name=property,proto3" json:"property,omitempty"`
+	ToCollection   string `protobuf:"bytes,4,opt,name=to_collection,json=toCollection,proto3" json:"to_collection,omitempty"`
+	ToUuid         string `protobuf:"bytes,5,opt,name=to_uuid,json=toUuid,proto3" json:"to_uuid,omitempty"`
+}
+
+func (x *TraverseEdge) Reset() {
+	*x = TraverseEdge{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_traverse_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TraverseEdge) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TraverseEdge) ProtoMessage() {}
+
+func (x *TraverseEdge) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_traverse_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TraverseEdge.ProtoReflect.Descriptor instead.
+func (*TraverseEdge) Descriptor() ([]byte, []int) {
+	return file_v1_traverse_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *TraverseEdge) GetFromCollection() string {
+	if x != nil {
+		return x.FromCollection
+	}
+	return ""
+}
+
+func (x *TraverseEdge) GetFromUuid() string {
+	if x != nil {
+		return x.FromUuid
+	}
+	return ""
+}
+
+func (x *TraverseEdge) GetProperty() string {
+	if x != nil {
+		return x.Property
+	}
+	return ""
+}
+
+func (x *TraverseEdge) GetToCollection() string {
+	if x != nil {
+		return x.ToCollection
+	}
+	return ""
+}
+
+func (x *TraverseEdge) GetToUuid() string {
+	if x != nil {
+		return x.ToUuid
+	}
+	return ""
+}
+
+var File_v1_traverse_proto protoreflect.FileDescriptor
+
+var file_v1_traverse_proto_rawDesc = []byte{
+	0x0a, 0x11, 0x76, 0x31, 0x2f, 0x74, 0x72, 0x61, 0x76, 0x65, 0x72, 0x73, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x0b, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31,
+	0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0d,
+	0x76, 0x31, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa8, 0x02,
+	0x0a, 0x0f, 0x54, 0x72, 0x61, 0x76, 0x65, 0x72, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x55, 0x75, 0x69,
+	0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x04, 0x68, 0x6f,
+	0x70, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69,
+	0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x76, 0x65, 0x72, 0x73, 0x65, 0x48,
+	0x6f, 0x70, 0x52, 0x04, 0x68, 0x6f, 0x70, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f,
+	0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6d, 0x61, 0x78,
+	0x44, 0x65, 0x70, 0x74, 0x68, 0x12, 0x1e, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x66, 0x61, 0x6e,
+	0x5f, 0x6f, 0x75, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x46,
+	0x61, 0x6e, 0x4f, 0x75, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x6e, 0x6f, 0x64,
+	0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x4e, 0x6f, 0x64,
+	0x65, 0x73, 0x12, 0x24, 0x0a, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x75, 0x75, 0x69,
+	0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x55, 0x75, 0x69, 0x64, 0x88, 0x01, 0x01, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x22, 0xa2, 0x01, 0x0a, 0x0b, 0x54, 0x72, 0x61,
+	0x76, 0x65, 0x72, 0x73, 0x65, 0x48, 0x6f, 0x70, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70,
+	0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72,
+	0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0a, 0x63, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0a,
+	0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x33, 0x0a,
+	0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x73, 0x48, 0x01, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x88,
+	0x01, 0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x22, 0xb7, 0x01,
+	0x0a, 0x0d, 0x54, 0x72, 0x61, 0x76, 0x65, 0x72, 0x73, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x6f, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x52, 0x04, 0x74,
+	0x6f, 0x6f, 0x6b, 0x12, 0x2f, 0x0a, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x54, 0x72, 0x61, 0x76, 0x65, 0x72, 0x73, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x05, 0x6e,
+	0x6f, 0x64, 0x65, 0x73, 0x12, 0x2f, 0x0a, 0x05, 0x65, 0x64, 0x67, 0x65, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x54, 0x72, 0x61, 0x76, 0x65, 0x72, 0x73, 0x65, 0x45, 0x64, 0x67, 0x65, 0x52, 0x05,
+	0x65, 0x64, 0x67, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x72, 0x75,
+	0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x74, 0x72,
+	0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x22, 0x91, 0x01, 0x0a, 0x0c, 0x54, 0x72, 0x61, 0x76,
+	0x65, 0x72, 0x73, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05,
+	0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x64, 0x65, 0x70,
+	0x74, 0x68, 0x12, 0x37, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52,
+	0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0xae, 0x01, 0x0a, 0x0c,
+	0x54, 0x72, 0x61, 0x76, 0x65, 0x72, 0x73, 0x65, 0x45, 0x64, 0x67, 0x65, 0x12, 0x27, 0x0a, 0x0f,
+	0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x66, 0x72, 0x6f, 0x6d, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x75, 0x75,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x55, 0x75,
+	0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x12, 0x23,
+	0x0a, 0x0d, 0x74, 0x6f, 0x5f, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x6f, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x6f, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x6f, 0x55, 0x75, 0x69, 0x64, 0x42, 0x72, 0x0a, 0x23,
+	0x69, 0x6f, 0x2e, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x2e, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x2e, 0x76, 0x31, 0x42, 0x15, 0x57, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x54, 0x72, 0x61, 0x76, 0x65, 0x72, 0x73, 0x65, 0x5a, 0x34, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x2f,
+	0x77, 0x65, 0x61, 0x76, 0x69, 0x61, 0x74, 0x65, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x67, 0x65,
+	0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x3b, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_v1_traverse_proto_rawDescOnce sync.Once
+	file_v1_traverse_proto_rawDescData = file_v1_traverse_proto_rawDesc
+)
+
+func file_v1_traverse_proto_rawDescGZIP() []byte {
+	file_v1_traverse_proto_rawDescOnce.Do(func() {
+		file_v1_traverse_proto_rawDescData = protoimpl.X.CompressGZIP(file_v1_traverse_proto_rawDescData)
+	})
+	return file_v1_traverse_proto_rawDescData
+}
+
+var file_v1_traverse_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_v1_traverse_proto_goTypes = []interface{}{
+	(*TraverseRequest)(nil), // 0: weaviate.v1.TraverseRequest
+	(*TraverseHop)(nil),     // 1: weaviate.v1.TraverseHop
+	(*TraverseReply)(nil),   // 2: weaviate.v1.TraverseReply
+	(*TraverseNode)(nil),    // 3: weaviate.v1.TraverseNode
+	(*TraverseEdge)(nil),    // 4: weaviate.v1.TraverseEdge
+	(*Filters)(nil),         // 5: weaviate.v1.Filters
+	(*structpb.Struct)(nil), // 6: google.protobuf.Struct
+}
+var file_v1_traverse_proto_depIdxs = []int32{
+	1, // 0: weaviate.v1.TraverseRequest.hops:type_name -> weaviate.v1.TraverseHop
+	5, // 1: weaviate.v1.TraverseHop.filters:type_name -> weaviate.v1.Filters
+	3, // 2: weaviate.v1.TraverseReply.nodes:type_name -> weaviate.v1.TraverseNode
+	4, // 3: weaviate.v1.TraverseReply.edges:type_name -> weaviate.v1.TraverseEdge
+	6, // 4: weaviate.v1.TraverseNode.properties:type_name -> google.protobuf.Struct
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_v1_traverse_proto_init() }
+func file_v1_traverse_proto_init() {
+	if File_v1_traverse_proto != nil {
+		return
+	}
+	file_v1_base_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_v1_traverse_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TraverseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_traverse_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TraverseHop); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_traverse_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TraverseReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_traverse_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TraverseNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_traverse_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TraverseEdge); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_v1_traverse_proto_msgTypes[0].OneofWrappers = []interface{}{}
+	file_v1_traverse_proto_msgTypes[1].OneofWrappers = []interface{}{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_v1_traverse_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_v1_traverse_proto_goTypes,
+		DependencyIndexes: file_v1_traverse_proto_depIdxs,
+		MessageInfos:      file_v1_traverse_proto_msgTypes,
+	}.Build()
+	File_v1_traverse_proto = out.File
+	file_v1_traverse_proto_rawDesc = nil
+	file_v1_traverse_proto_goTypes = nil
+	file_v1_traverse_proto_depIdxs = nil
+}
@@ -23,6 +23,9 @@ type WeaviateClient interface {
 	BatchObjects(ctx context.Context, in *BatchObjectsRequest, opts ...grpc.CallOption) (*BatchObjectsReply, error)
 	BatchDelete(ctx context.Context, in *BatchDeleteRequest, opts ...grpc.CallOption) (*BatchDeleteReply, error)
 	TenantsGet(ctx context.Context, in *TenantsGetRequest, opts ...grpc.CallOption) (*TenantsGetReply, error)
+	Traverse(ctx context.Context, in *TraverseRequest, opts ...grpc.CallOption) (*TraverseReply, error)
+	SearchBatch(ctx context.Context, in *SearchBatchRequest, opts ...grpc.CallOption) (*SearchBatchReply, error)
+	Aggregate(ctx context.Context, in *AggregateRequest, opts ...grpc.CallOption) (*AggregateReply, error)
 }
 
 type weaviateClient struct {
@@ -69,6 +72,33 @@ func (c *weaviateClient) TenantsGet(ctx context.Context, in *TenantsGetRequest,
 	return out, nil
 }
 
+func (c *weaviateClient) Traverse(ctx context.Context, in *TraverseRequest, opts ...grpc.CallOption) (*TraverseReply, error) {
+	out := new(TraverseReply)
+	err := c.cc.Invoke(ctx, "/weaviate.v1.Weaviate/Traverse", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weaviateClient) SearchBatch(ctx context.Context, in *SearchBatchRequest, opts ...grpc.CallOption) (*SearchBatchReply, error) {
+	out := new(SearchBatchReply)
+	err := c.cc.Invoke(ctx, "/weaviate.v1.Weaviate/SearchBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weaviateClient) Aggregate(ctx context.Context, in *AggregateRequest, opts ...grpc.CallOption) (*AggregateReply, error) {
+	out := new(AggregateReply)
+	err := c.cc.Invoke(ctx, "/weaviate.v1.Weaviate/Aggregate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // WeaviateServer is the server API for Weaviate service.
 // All implementations must embed UnimplementedWeaviateServer
 // for forward compatibility
@@ -77,6 +107,9 @@ type WeaviateServer interface {
 	BatchObjects(context.Context, *BatchObjectsRequest) (*BatchObjectsReply, error)
 	BatchDelete(context.Context, *BatchDeleteRequest) (*BatchDeleteReply, error)
 	TenantsGet(context.Context, *TenantsGetRequest) (*TenantsGetReply, error)
+	Traverse(context.Context, *TraverseRequest) (*TraverseReply, error)
+	SearchBatch(context.Context, *SearchBatchRequest) (*SearchBatchReply, error)
+	Aggregate(context.Context, *AggregateRequest) (*AggregateReply, error)
 	mustEmbedUnimplementedWeaviateServer()
 }
 
@@ -96,6 +129,15 @@ func (UnimplementedWeaviateServer) BatchDelete(context.Context, *BatchDeleteRequ
 func (UnimplementedWeaviateServer) TenantsGet(context.Context, *TenantsGetRequest) (*TenantsGetReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TenantsGet not implemented")
 }
+func (UnimplementedWeaviateServer) Traverse(context.Context, *TraverseRequest) (*TraverseReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Traverse not implemented")
+}
+func (UnimplementedWeaviateServer) SearchBatch(context.Context, *SearchBatchRequest) (*SearchBatchReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchBatch not implemented")
+}
+func (UnimplementedWeaviateServer) Aggregate(context.Context, *AggregateRequest) (*AggregateReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Aggregate not implemented")
+}
 func (UnimplementedWeaviateServer) mustEmbedUnimplementedWeaviateServer() {}
 
 // UnsafeWeaviateServer may be embedded to opt out of forward compatibility for this service.
@@ -181,6 +223,60 @@ func _Weaviate_TenantsGet_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Weaviate_Traverse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TraverseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).Traverse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/weaviate.v1.Weaviate/Traverse",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).Traverse(ctx, req.(*TraverseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Weaviate_SearchBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).SearchBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/weaviate.v1.Weaviate/SearchBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).SearchBatch(ctx, req.(*SearchBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Weaviate_Aggregate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AggregateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).Aggregate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/weaviate.v1.Weaviate/Aggregate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).Aggregate(ctx, req.(*AggregateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Weaviate_ServiceDesc is the grpc.ServiceDesc for Weaviate service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -204,6 +300,18 @@ var Weaviate_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "TenantsGet",
 			Handler:    _Weaviate_TenantsGet_Handler,
 		},
+		{
+			MethodName: "Traverse",
+			Handler:    _Weaviate_Traverse_Handler,
+		},
+		{
+			MethodName: "SearchBatch",
+			Handler:    _Weaviate_SearchBatch_Handler,
+		},
+		{
+			MethodName: "Aggregate",
+			Handler:    _Weaviate_Aggregate_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "v1/weaviate.proto",
@@ -361,6 +361,36 @@ func (a *azureClient) Read(ctx context.Context, backupID, key, overrideBucket, o
 	return read, nil
 }
 
+// Delete removes all blobs stored under backupID
+func (a *azureClient) Delete(ctx context.Context, backupID, overrideBucket, overridePath string) error {
+	containerName := a.config.Container
+	if overrideBucket != "" {
+		containerName = overrideBucket
+	}
+
+	prefix := a.makeObjectName(overridePath, []string{backupID}) + "/"
+	blobs := a.client.NewListBlobsFlatPager(containerName, &azblob.ListBlobsFlatOptions{Prefix: to.Ptr(prefix)})
+	for blobs.More() {
+		page, err := blobs.NextPage(ctx)
+		if err != nil {
+			return backup.NewErrInternal(errors.Wrapf(err, "list blobs %s", prefix))
+		}
+		if page.Segment == nil {
+			continue
+		}
+		for _, item := range page.Segment.BlobItems {
+			if item.Name == nil {
+				continue
+			}
+			if _, err := a.client.DeleteBlob(ctx, containerName, *item.Name, nil); err != nil &&
+				!bloberror.HasCode(err, bloberror.BlobNotFound) {
+				return backup.NewErrInternal(errors.Wrapf(err, "delete blob %s", *item.Name))
+			}
+		}
+	}
+	return nil
+}
+
 func (a *azureClient) SourceDataPath() string {
 	return a.dataPath
 }
@@ -218,6 +218,18 @@ func (m *Module) Read(ctx context.Context, backupID, key, overrideBucket, overri
 	return read, err
 }
 
+// Delete removes the directory holding all files of backupID
+func (m *Module) Delete(ctx context.Context, backupID, overrideBucket, overridePath string) error {
+	backupPath := m.makeBackupDirPath(m.backupsPath, backupID)
+	if overridePath != "" {
+		backupPath = filepath.Join(overridePath, backupID)
+	}
+	if err := os.RemoveAll(backupPath); err != nil {
+		return backup.NewErrInternal(errors.Wrapf(err, "delete backup %s", backupPath))
+	}
+	return nil
+}
+
 func (m *Module) SourceDataPath() string {
 	return m.dataPath
 }
@@ -52,3 +52,22 @@ func TestBackend_StoreBackup(t *testing.T) {
 		assert.Nil(t, err)
 	})
 }
+
+func TestBackend_Delete(t *testing.T) {
+	ctx := context.Background()
+	backupPath := t.TempDir()
+	module := New()
+	assert.Nil(t, module.initBackupBackend(ctx, backupPath))
+
+	for _, id := range []string{"nightly", "nightly-2"} {
+		assert.Nil(t, module.PutObject(ctx, id, "backup_config.json", "", "", []byte("{}")))
+	}
+
+	assert.Nil(t, module.Delete(ctx, "nightly", "", ""))
+	_, err := os.Stat(filepath.Join(backupPath, "nightly"))
+	assert.True(t, os.IsNotExist(err))
+	_, err = os.Stat(filepath.Join(backupPath, "nightly-2", "backup_config.json"))
+	assert.Nil(t, err)
+
+	assert.Nil(t, module.Delete(ctx, "missing", "", ""), "deleting a missing backup is a no-op")
+}
@@ -372,6 +372,29 @@ func (g *gcsClient) Read(ctx context.Context, backupID, key, overrideBucket, ove
 	return read, nil
 }
 
+// Delete removes all objects stored under backupID
+func (g *gcsClient) Delete(ctx context.Context, backupID, overrideBucket, overridePath string) error {
+	bucket, err := g.findBucket(ctx, overrideBucket)
+	if err != nil {
+		return errors.Wrap(err, "find bucket")
+	}
+
+	prefix := g.makeObjectName(overridePath, []string{backupID}) + "/"
+	iter := bucket.Objects(ctx, &storage.Query{Prefix: prefix})
+	for {
+		next, err := iter.Next()
+		if errors.Is(err, iterator.Done) {
+			return nil
+		}
+		if err != nil {
+			return backup.NewErrInternal(errors.Wrapf(err, "list objects %s", prefix))
+		}
+		if err := bucket.Object(next.Name).Delete(ctx); err != nil && !errors.Is(err, storage.ErrObjectNotExist) {
+			return backup.NewErrInternal(errors.Wrapf(err, "delete object %s", next.Name))
+		}
+	}
+}
+
 func (g *gcsClient) SourceDataPath() string {
 	return g.dataPath
 }
@@ -331,6 +331,32 @@ func (s *s3Client) Read(ctx context.Context, backupID, key, overrideBucket, over
 	return read, nil
 }
 
+// Delete removes all objects stored under backupID
+func (s *s3Client) Delete(ctx context.Context, backupID, overrideBucket, overridePath string) error {
+	client, err := s.getClient(ctx)
+	if err != nil {
+		return errors.Wrap(err, "delete: failed to get client")
+	}
+	prefix := s.makeObjectName(backupID) + "/"
+	if overridePath != "" {
+		prefix = path.Join(overridePath, backupID) + "/"
+	}
+	bucket := s.config.Bucket
+	if overrideBucket != "" {
+		bucket = overrideBucket
+	}
+
+	objects := client.ListObjects(ctx, bucket, minio.ListObjectsOptions{Recursive: true, Prefix: prefix})
+	// the error channel is drained, so that the removal isn't blocked on it
+	var err1 error
+	for rerr := range client.RemoveObjects(ctx, bucket, objects, minio.RemoveObjectsOptions{}) {
+		if err1 == nil {
+			err1 = backup.NewErrInternal(errors.Wrapf(rerr.Err, "delete object %s:%s", bucket, rerr.ObjectName))
+		}
+	}
+	return err1
+}
+
 func (s *s3Client) SourceDataPath() string {
 	return s.dataPath
 }
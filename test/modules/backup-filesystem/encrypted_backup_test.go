@@ -0,0 +1,136 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package test
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/backup"
+	"github.com/weaviate/weaviate/entities/moduletools"
+	modstgfs "github.com/weaviate/weaviate/modules/backup-filesystem"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/mocks"
+	ubak "github.com/weaviate/weaviate/usecases/backup"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+func Test_FileSystemBackend_EncryptedBackup(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	dataDir := t.TempDir()
+	backupDir := t.TempDir()
+	t.Setenv("BACKUP_FILESYSTEM_PATH", backupDir)
+	key := base64.StdEncoding.EncodeToString([]byte(strings.Repeat("k", 32)))
+	t.Setenv(ubak.EncryptionKeyEnv, key)
+	config.ServerVersion = "1.27.0"
+
+	logger, _ := test.NewNullLogger()
+	fs := modstgfs.New()
+	require.Nil(t, fs.Init(ctx, moduletools.NewInitParams(fakeStorageProvider{dataDir}, nil, config.Config{}, logger)))
+
+	shardDir := filepath.Join(incClassName, incShardName)
+	writeFile(t, dataDir, filepath.Join(shardDir, "indexcount"), "1")
+	writeFile(t, dataDir, filepath.Join(shardDir, "proplengths"), "{}")
+	writeFile(t, dataDir, filepath.Join(shardDir, "version"), "2")
+	writeFile(t, dataDir, filepath.Join(shardDir, "lsm/objects/segment-1.db"), "segment 1")
+
+	handler := ubak.NewHandler(logger, mocks.NewMockAuthorizer(), fakeSchemaManager{},
+		&fakeSourcer{dataDir: dataDir}, fakeBackendProvider{fs})
+	handler.StartLogShipping(dataDir, 20*time.Millisecond, nil)
+
+	t.Run("encrypted backup", func(t *testing.T) {
+		resp := handler.OnCanCommit(ctx, &ubak.Request{
+			Method:      ubak.OpCreate,
+			ID:          "enc",
+			Backend:     modstgfs.Name,
+			Classes:     []string{incClassName},
+			Compression: ubak.Compression{Codec: ubak.CodecZstd},
+			Encrypt:     true,
+		})
+		require.Empty(t, resp.Err)
+		waitForStatus(ctx, t, handler, ubak.OpCreate, "enc")
+
+		b, err := fs.GetObject(ctx, "enc/"+incNodeName, ubak.BackupFile, "", "")
+		require.Nil(t, err)
+		var desc backup.BackupDescriptor
+		require.Nil(t, json.Unmarshal(b, &desc))
+		assert.Equal(t, string(ubak.CodecZstd), desc.CompressionCodec)
+		require.NotNil(t, desc.Encryption)
+		assert.NotEmpty(t, desc.Encryption.WrappedKey)
+
+		chunk, err := fs.GetObject(ctx, "enc/"+incNodeName, incClassName+"/chunk-1", "", "")
+		require.Nil(t, err)
+		assert.NotContains(t, string(chunk), "segment 1")
+	})
+
+	rel := filepath.Join(shardDir, "lsm/objects/segment-2.wal")
+	writeFile(t, dataDir, rel, "wal 2")
+	require.Nil(t, backup.ArchiveLog(dataDir, incClassName, incShardName, filepath.Join(dataDir, rel), false))
+
+	t.Run("shipped logs are encrypted", func(t *testing.T) {
+		require.Eventually(t, func() bool {
+			b, err := fs.GetObject(ctx, "enc/"+incNodeName, ubak.LogArchiveFile, "", "")
+			if err != nil {
+				return false
+			}
+			var desc backup.LogArchiveDescriptor
+			require.Nil(t, json.Unmarshal(b, &desc))
+			return len(desc.Logs) == 1
+		}, 5*time.Second, 20*time.Millisecond)
+
+		log, err := fs.GetObject(ctx, "enc/"+incNodeName, "logs/"+incClassName+"/"+incShardName+"/"+rel, "", "")
+		require.Nil(t, err)
+		assert.NotContains(t, string(log), "wal 2")
+	})
+
+	restore := func() *ubak.CanCommitResponse {
+		return handler.OnCanCommit(ctx, &ubak.Request{
+			Method:      ubak.OpRestore,
+			ID:          "enc",
+			Backend:     modstgfs.Name,
+			Classes:     []string{incClassName},
+			PointInTime: time.Now(),
+		})
+	}
+
+	t.Run("restore requires the key", func(t *testing.T) {
+		t.Setenv(ubak.EncryptionKeyEnv, "")
+		resp := restore()
+		assert.Contains(t, resp.Err, ubak.EncryptionKeyEnv)
+	})
+
+	t.Run("restore decrypts and decompresses", func(t *testing.T) {
+		resp := restore()
+		require.Empty(t, resp.Err)
+		waitForStatus(ctx, t, handler, ubak.OpRestore, "enc")
+
+		restored := filepath.Join(dataDir, ubak.TempDirectory, incClassName, shardDir, "lsm/objects")
+		for file, content := range map[string]string{
+			"segment-1.db":  "segment 1",
+			"segment-2.wal": "wal 2",
+		} {
+			b, err := os.ReadFile(filepath.Join(restored, file))
+			require.Nil(t, err)
+			assert.Equal(t, content, string(b))
+		}
+	})
+}
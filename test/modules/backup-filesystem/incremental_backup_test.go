@@ -0,0 +1,226 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package test
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/backup"
+	"github.com/weaviate/weaviate/entities/modulecapabilities"
+	"github.com/weaviate/weaviate/entities/moduletools"
+	modstgfs "github.com/weaviate/weaviate/modules/backup-filesystem"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/mocks"
+	ubak "github.com/weaviate/weaviate/usecases/backup"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+const (
+	incClassName = "IncrementalClass"
+	incShardName = "shard1"
+	incNodeName  = "node1"
+)
+
+func Test_FileSystemBackend_IncrementalBackup(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	dataDir := t.TempDir()
+	backupDir := t.TempDir()
+	t.Setenv("BACKUP_FILESYSTEM_PATH", backupDir)
+	config.ServerVersion = "1.27.0"
+
+	logger, _ := test.NewNullLogger()
+	fs := modstgfs.New()
+	require.Nil(t, fs.Init(ctx, moduletools.NewInitParams(fakeStorageProvider{dataDir}, nil, config.Config{}, logger)))
+
+	shardDir := filepath.Join(incClassName, incShardName)
+	writeFile(t, dataDir, filepath.Join(shardDir, "indexcount"), "1")
+	writeFile(t, dataDir, filepath.Join(shardDir, "proplengths"), "{}")
+	writeFile(t, dataDir, filepath.Join(shardDir, "version"), "2")
+	writeFile(t, dataDir, filepath.Join(shardDir, "lsm/objects/segment-1.db"), "segment 1")
+	writeFile(t, dataDir, filepath.Join(shardDir, "lsm/objects/segment-2.db"), "segment 2")
+	writeFile(t, dataDir, filepath.Join(shardDir, "main.hnsw.commitlog.d/1"), "log")
+
+	handler := ubak.NewHandler(logger, mocks.NewMockAuthorizer(), fakeSchemaManager{},
+		&fakeSourcer{dataDir: dataDir}, fakeBackendProvider{fs})
+
+	nodeMeta := func(t *testing.T, id string) *backup.ShardDescriptor {
+		b, err := fs.GetObject(ctx, id+"/"+incNodeName, ubak.BackupFile, "", "")
+		require.Nil(t, err)
+		var desc backup.BackupDescriptor
+		require.Nil(t, json.Unmarshal(b, &desc))
+		require.Len(t, desc.Classes, 1)
+		require.Len(t, desc.Classes[0].Shards, 1)
+		return desc.Classes[0].Shards[0]
+	}
+
+	t.Run("full backup", func(t *testing.T) {
+		runBackup(ctx, t, handler, "base", "")
+		sd := nodeMeta(t, "base")
+		assert.ElementsMatch(t, []string{
+			filepath.Join(shardDir, "lsm/objects/segment-1.db"),
+			filepath.Join(shardDir, "lsm/objects/segment-2.db"),
+			filepath.Join(shardDir, "main.hnsw.commitlog.d/1"),
+		}, sd.Files)
+		assert.Empty(t, sd.BaseFiles)
+	})
+
+	t.Run("incremental backup uploads changed files only", func(t *testing.T) {
+		// simulate a compaction, a new segment and a growing commit log
+		require.Nil(t, os.Remove(filepath.Join(dataDir, shardDir, "lsm/objects/segment-1.db")))
+		writeFile(t, dataDir, filepath.Join(shardDir, "lsm/objects/segment-3.db"), "segment 3")
+		writeFile(t, dataDir, filepath.Join(shardDir, "main.hnsw.commitlog.d/1"), "log extended")
+
+		runBackup(ctx, t, handler, "inc1", "base")
+		sd := nodeMeta(t, "inc1")
+		assert.ElementsMatch(t, []string{
+			filepath.Join(shardDir, "lsm/objects/segment-3.db"),
+			filepath.Join(shardDir, "main.hnsw.commitlog.d/1"),
+		}, sd.Files)
+		assert.Equal(t, map[string]string{
+			filepath.Join(shardDir, "lsm/objects/segment-2.db"): "base",
+		}, sd.BaseFiles)
+	})
+
+	t.Run("incremental backup of incremental backup", func(t *testing.T) {
+		runBackup(ctx, t, handler, "inc2", "inc1")
+		sd := nodeMeta(t, "inc2")
+		assert.Empty(t, sd.Files)
+		assert.Equal(t, map[string]string{
+			filepath.Join(shardDir, "lsm/objects/segment-2.db"): "base",
+			filepath.Join(shardDir, "lsm/objects/segment-3.db"): "inc1",
+			filepath.Join(shardDir, "main.hnsw.commitlog.d/1"):  "inc1",
+		}, sd.BaseFiles)
+	})
+
+	t.Run("restore resolves the chain", func(t *testing.T) {
+		resp := handler.OnCanCommit(ctx, &ubak.Request{
+			Method:  ubak.OpRestore,
+			ID:      "inc2",
+			Backend: modstgfs.Name,
+			Classes: []string{incClassName},
+		})
+		require.Empty(t, resp.Err)
+		waitForStatus(ctx, t, handler, ubak.OpRestore, "inc2")
+
+		restored := filepath.Join(dataDir, ubak.TempDirectory, incClassName)
+		for file, content := range map[string]string{
+			"indexcount":               "1",
+			"lsm/objects/segment-2.db": "segment 2",
+			"lsm/objects/segment-3.db": "segment 3",
+			"main.hnsw.commitlog.d/1":  "log extended",
+		} {
+			b, err := os.ReadFile(filepath.Join(restored, shardDir, file))
+			require.Nil(t, err)
+			assert.Equal(t, content, string(b))
+		}
+		_, err := os.Stat(filepath.Join(restored, shardDir, "lsm/objects/segment-1.db"))
+		assert.True(t, os.IsNotExist(err), "compacted segment must not be restored")
+	})
+}
+
+func runBackup(ctx context.Context, t *testing.T, handler *ubak.Handler, id, baseID string) {
+	resp := handler.OnCanCommit(ctx, &ubak.Request{
+		Method:                  ubak.OpCreate,
+		ID:                      id,
+		Backend:                 modstgfs.Name,
+		Classes:                 []string{incClassName},
+		IncrementalBaseBackupID: baseID,
+	})
+	require.Empty(t, resp.Err)
+	waitForStatus(ctx, t, handler, ubak.OpCreate, id)
+}
+
+func waitForStatus(ctx context.Context, t *testing.T, handler *ubak.Handler, op ubak.Op, id string) {
+	require.Eventually(t, func() bool {
+		st := handler.OnStatus(ctx, &ubak.StatusRequest{Method: op, ID: id, Backend: modstgfs.Name})
+		require.NotEqual(t, backup.Failed, st.Status, st.Err)
+		return st.Status == backup.Success
+	}, 10*time.Second, 50*time.Millisecond)
+}
+
+func writeFile(t *testing.T, dir, relPath, content string) {
+	path := filepath.Join(dir, relPath)
+	require.Nil(t, os.MkdirAll(filepath.Dir(path), os.ModePerm))
+	require.Nil(t, os.WriteFile(path, []byte(content), os.ModePerm))
+}
+
+type fakeSourcer struct {
+	dataDir string
+}
+
+func (s *fakeSourcer) ReleaseBackup(_ context.Context, id, class string) error { return nil }
+
+func (s *fakeSourcer) Backupable(_ context.Context, classes []string) error { return nil }
+
+func (s *fakeSourcer) ClassExists(name string) bool { return name == incClassName }
+
+func (s *fakeSourcer) ListBackupable() []string { return []string{incClassName} }
+
+func (s *fakeSourcer) BackupDescriptors(_ context.Context, bakid string, classes []string,
+) <-chan backup.ClassDescriptor {
+	ch := make(chan backup.ClassDescriptor, 1)
+	defer close(ch)
+
+	shardDir := filepath.Join(incClassName, incShardName)
+	sd := &backup.ShardDescriptor{
+		Name:                  incShardName,
+		Node:                  incNodeName,
+		DocIDCounterPath:      filepath.Join(shardDir, "indexcount"),
+		PropLengthTrackerPath: filepath.Join(shardDir, "proplengths"),
+		ShardVersionPath:      filepath.Join(shardDir, "version"),
+	}
+	sd.DocIDCounter, _ = os.ReadFile(filepath.Join(s.dataDir, sd.DocIDCounterPath))
+	sd.PropLengthTracker, _ = os.ReadFile(filepath.Join(s.dataDir, sd.PropLengthTrackerPath))
+	sd.Version, _ = os.ReadFile(filepath.Join(s.dataDir, sd.ShardVersionPath))
+	for _, dir := range []string{"lsm/objects", "main.hnsw.commitlog.d"} {
+		entries, _ := os.ReadDir(filepath.Join(s.dataDir, shardDir, dir))
+		for _, e := range entries {
+			sd.Files = append(sd.Files, filepath.Join(shardDir, dir, e.Name()))
+		}
+	}
+	ch <- backup.ClassDescriptor{
+		Name:          incClassName,
+		Shards:        []*backup.ShardDescriptor{sd},
+		Schema:        []byte(`{"class":"` + incClassName + `"}`),
+		ShardingState: []byte(`{}`),
+	}
+	return ch
+}
+
+type fakeSchemaManager struct{}
+
+func (fakeSchemaManager) RestoreClass(context.Context, *backup.ClassDescriptor, map[string]string) error {
+	return nil
+}
+
+func (fakeSchemaManager) NodeName() string { return incNodeName }
+
+type fakeBackendProvider struct {
+	backend modulecapabilities.BackupBackend
+}
+
+func (p fakeBackendProvider) BackupBackend(string) (modulecapabilities.BackupBackend, error) {
+	return p.backend, nil
+}
+
+func (p fakeBackendProvider) EnabledBackupBackends() []modulecapabilities.BackupBackend {
+	return []modulecapabilities.BackupBackend{p.backend}
+}
@@ -0,0 +1,119 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package test
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/backup"
+	"github.com/weaviate/weaviate/entities/moduletools"
+	modstgfs "github.com/weaviate/weaviate/modules/backup-filesystem"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/mocks"
+	ubak "github.com/weaviate/weaviate/usecases/backup"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+func Test_FileSystemBackend_PointInTimeRestore(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	dataDir := t.TempDir()
+	backupDir := t.TempDir()
+	t.Setenv("BACKUP_FILESYSTEM_PATH", backupDir)
+	config.ServerVersion = "1.27.0"
+
+	logger, _ := test.NewNullLogger()
+	fs := modstgfs.New()
+	require.Nil(t, fs.Init(ctx, moduletools.NewInitParams(fakeStorageProvider{dataDir}, nil, config.Config{}, logger)))
+
+	shardDir := filepath.Join(incClassName, incShardName)
+	writeFile(t, dataDir, filepath.Join(shardDir, "indexcount"), "1")
+	writeFile(t, dataDir, filepath.Join(shardDir, "proplengths"), "{}")
+	writeFile(t, dataDir, filepath.Join(shardDir, "version"), "2")
+	writeFile(t, dataDir, filepath.Join(shardDir, "lsm/objects/segment-1.db"), "segment 1")
+
+	handler := ubak.NewHandler(logger, mocks.NewMockAuthorizer(), fakeSchemaManager{},
+		&fakeSourcer{dataDir: dataDir}, fakeBackendProvider{fs})
+	handler.StartLogShipping(dataDir, 20*time.Millisecond, nil)
+
+	runBackup(ctx, t, handler, "pitr", "")
+
+	archive := func(name string) {
+		path := filepath.Join(dataDir, shardDir, "lsm/objects", name)
+		require.Nil(t, backup.ArchiveLog(dataDir, incClassName, incShardName, path, false))
+	}
+	// entries are written to the same log before and after the point in time
+	rel := filepath.Join(shardDir, "lsm/objects/segment-2.wal")
+	timesPath, err := backup.LogTimesPath(dataDir, incClassName, incShardName, filepath.Join(dataDir, rel))
+	require.Nil(t, err)
+	times, err := backup.OpenLogTimes(timesPath)
+	require.Nil(t, err)
+	writeFile(t, dataDir, rel, "before")
+	require.Nil(t, times.Record(int64(len("before")), time.Now()))
+	time.Sleep(10 * time.Millisecond)
+	pointInTime := time.Now()
+	time.Sleep(10 * time.Millisecond)
+	writeFile(t, dataDir, rel, "before|after")
+	require.Nil(t, times.Record(int64(len("before|after")), time.Now()))
+	require.Nil(t, times.Close())
+	archive("segment-2.wal")
+	writeFile(t, dataDir, filepath.Join(shardDir, "lsm/objects/segment-3.wal"), "wal 3")
+	archive("segment-3.wal")
+
+	t.Run("archived logs are shipped alongside the backup", func(t *testing.T) {
+		var desc backup.LogArchiveDescriptor
+		require.Eventually(t, func() bool {
+			b, err := fs.GetObject(ctx, "pitr/"+incNodeName, ubak.LogArchiveFile, "", "")
+			if err != nil {
+				return false
+			}
+			require.Nil(t, json.Unmarshal(b, &desc))
+			return len(desc.Logs) == 2
+		}, 5*time.Second, 20*time.Millisecond)
+		assert.Equal(t, "pitr", desc.ID)
+
+		// shipped logs are removed from the archive directory
+		entries, err := os.ReadDir(filepath.Join(dataDir, backup.LogArchiveDirectory, incClassName, incShardName, shardDir, "lsm/objects"))
+		require.Nil(t, err)
+		assert.Empty(t, entries)
+	})
+
+	t.Run("restore replays logs until point in time", func(t *testing.T) {
+		resp := handler.OnCanCommit(ctx, &ubak.Request{
+			Method:      ubak.OpRestore,
+			ID:          "pitr",
+			Backend:     modstgfs.Name,
+			Classes:     []string{incClassName},
+			PointInTime: pointInTime,
+		})
+		require.Empty(t, resp.Err)
+		waitForStatus(ctx, t, handler, ubak.OpRestore, "pitr")
+
+		restored := filepath.Join(dataDir, ubak.TempDirectory, incClassName, shardDir, "lsm/objects")
+		b, err := os.ReadFile(filepath.Join(restored, "segment-1.db"))
+		require.Nil(t, err)
+		assert.Equal(t, "segment 1", string(b))
+		b, err = os.ReadFile(filepath.Join(restored, "segment-2.wal"))
+		require.Nil(t, err)
+		assert.Equal(t, "before", string(b), "entries written after point in time must not be replayed")
+		_, err = os.Stat(filepath.Join(restored, "segment-3.wal"))
+		assert.True(t, os.IsNotExist(err), "log written after point in time must not be replayed")
+	})
+}
@@ -85,6 +85,26 @@ func Test_Authorization(t *testing.T) {
 			classes:        []string{"ABC"},
 			ignoreAuthZ:    true,
 		},
+		{
+			methodName:       "CreateSchedule",
+			additionalArgs:   []interface{}{backup.Schedule{Name: "nightly", Cron: "@daily", Backend: "filesystem", Include: []string{"ABC"}}},
+			expectedVerb:     authorization.CREATE,
+			expectedResource: authorization.Backups("ABC")[0],
+			classes:          []string{"ABC"},
+		},
+		{
+			methodName:       "Schedules",
+			expectedVerb:     authorization.READ,
+			expectedResource: authorization.Backups()[0],
+			classes:          []string{"ABC"},
+		},
+		{
+			methodName:       "DeleteSchedule",
+			additionalArgs:   []interface{}{"nightly"},
+			expectedVerb:     authorization.DELETE,
+			expectedResource: authorization.Backups()[0],
+			classes:          []string{"ABC"},
+		},
 	}
 
 	t.Run("verify that a test for every public method exists", func(t *testing.T) {
@@ -96,7 +116,8 @@ func Test_Authorization(t *testing.T) {
 		for _, method := range allExportedMethods(&Scheduler{}) {
 			switch method {
 			case "OnCommit", "OnAbort", "OnCanCommit",
-				"OnStatus", "CleanupUnfinishedBackups":
+				"OnStatus", "CleanupUnfinishedBackups",
+				"ScheduledBackup", "PruneBackups":
 				continue
 			}
 			assert.Contains(t, testedMethods, method)
@@ -158,7 +179,7 @@ func Test_Authorization(t *testing.T) {
 				selector.On("ListClasses", mock.Anything).Return(test.classes).Maybe()
 				selector.On("Backupable", mock.Anything, mock.Anything).Return(nil).Maybe()
 
-				s := NewScheduler(authorizer, nil, selector, backupProvider, nodeResolver, &fakeSchemaManger{}, logger)
+				s := NewScheduler(authorizer, nil, selector, backupProvider, nodeResolver, &fakeSchemaManger{}, &fakeScheduleStore{}, logger)
 				require.NotNil(t, s)
 
 				if !test.ignoreAuthZ {
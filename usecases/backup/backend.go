@@ -186,6 +186,8 @@ type uploader struct {
 	zipConfig
 	setStatus func(st backup.Status)
 	log       logrus.FieldLogger
+	base      *incrementalBase // set for incremental backups
+	key       []byte           // data key if files are encrypted
 }
 
 func newUploader(sourcer Sourcer, backend nodeStore,
@@ -201,6 +203,8 @@ func newUploader(sourcer Sourcer, backend nodeStore,
 		}),
 		setstatus,
 		l,
+		nil,
+		nil,
 	}
 }
 
@@ -209,6 +213,16 @@ func (u *uploader) withCompression(cfg zipConfig) *uploader {
 	return u
 }
 
+func (u *uploader) withEncryption(key []byte) *uploader {
+	u.key = key
+	return u
+}
+
+func (u *uploader) withIncrementalBase(base *incrementalBase) *uploader {
+	u.base = base
+	return u
+}
+
 // all uploads all files in addition to the metadata file
 func (u *uploader) all(ctx context.Context, classes []string, desc *backup.BackupDescriptor, overrideBucket, overridePath string) (err error) {
 	u.setStatus(backup.Transferring)
@@ -316,6 +330,13 @@ func (u *uploader) class(ctx context.Context, id string, desc *backup.ClassDescr
 		return nil
 	}
 
+	for _, shard := range desc.Shards {
+		if err := collectFileInfos(u.backend.SourceDataPath(), shard); err != nil {
+			return fmt.Errorf("shard %s: %w", shard.Name, err)
+		}
+		u.base.skipUnchanged(desc.Name, shard)
+	}
+
 	desc.Chunks = make(map[int32][]string, 1+nShards/2)
 	var (
 		hasJobs   atomic.Bool
@@ -407,7 +428,10 @@ func (u *uploader) compress(ctx context.Context,
 		// add tolerance to enable better optimization of the chunk size
 		maxSize = int64(u.ChunkSize + u.ChunkSize/20) // size + 5%
 	)
-	zip, reader := NewZip(u.backend.SourceDataPath(), u.Level)
+	zip, reader, err := NewZip(u.backend.SourceDataPath(), u.Level, u.Codec, u.key)
+	if err != nil {
+		return shards, err
+	}
 	producer := func() error {
 		defer zip.Close()
 		lastShardSize := int64(0)
@@ -454,8 +478,12 @@ type fileWriter struct {
 	destDir    string
 	movedFiles []string // files successfully moved to destination folder
 	compressed bool
+	codec      CompressionCodec
+	key        []byte // data key if files are encrypted
 	GoPoolSize int
 	migrator   func(classPath string) error
+	logs       []backup.ArchivedLog // commit logs to replay
+	logsUntil  time.Time            // point in time the logs are replayed until
 	logger     logrus.FieldLogger
 }
 
@@ -480,6 +508,10 @@ func (fw *fileWriter) WithPoolPercentage(p int) *fileWriter {
 	return fw
 }
 
+func (fw *fileWriter) setEncoding(codec CompressionCodec, key []byte) {
+	fw.codec, fw.key = codec, key
+}
+
 func (fw *fileWriter) setMigrator(m func(classPath string) error) { fw.migrator = m }
 
 // Write downloads files and put them in the destination directory
@@ -493,6 +525,14 @@ func (fw *fileWriter) Write(ctx context.Context, desc *backup.ClassDescriptor, o
 		return fmt.Errorf("get files: %w", err)
 	}
 
+	if err := fw.writeBaseFiles(ctx, classTempDir, overrideBucket, overridePath, desc); err != nil {
+		return fmt.Errorf("get files of incremental bases: %w", err)
+	}
+
+	if err := fw.writeLogs(ctx, classTempDir, overrideBucket, overridePath); err != nil {
+		return fmt.Errorf("get archived commit logs: %w", err)
+	}
+
 	if fw.migrator != nil {
 		if err := fw.migrator(classTempDir); err != nil {
 			return fmt.Errorf("migrate from pre 1.23: %w", err)
@@ -532,7 +572,7 @@ func (fw *fileWriter) writeTempFiles(ctx context.Context, classTempDir, override
 	for k := range desc.Chunks {
 		chunk := chunkKey(desc.Name, k)
 		eg.Go(func() error {
-			uz, w := NewUnzip(classTempDir)
+			uz, w := NewUnzip(classTempDir, fw.codec, fw.key)
 			enterrors.GoWrapper(func() {
 				fw.backend.Read(ctx, chunk, overrideBucket, overridePath, w)
 			}, fw.logger)
@@ -30,6 +30,8 @@ type backupper struct {
 	logger   logrus.FieldLogger
 	sourcer  Sourcer
 	backends BackupBackendProvider
+	// shipper is set if commit logs are shipped alongside backups
+	shipper *logShipper
 	// shardCoordinationChan is sync and coordinate operations
 	shardSyncChan
 }
@@ -138,6 +140,11 @@ func (b *backupper) backup(store nodeStore, req *Request) (CanCommitResponse, er
 		Timeout: expiration,
 	}
 
+	codec, key, enc, err := b.encoding(req)
+	if err != nil {
+		return ret, err
+	}
+
 	// make sure there is no active backup
 	if prevID := b.lastOp.renew(id, store.HomeDir(req.Bucket, req.Path), req.Bucket, req.Path); prevID != "" {
 		return ret, fmt.Errorf("backup %s already in progress", prevID)
@@ -154,7 +161,8 @@ func (b *backupper) backup(store nodeStore, req *Request) (CanCommitResponse, er
 
 		}
 		provider := newUploader(b.sourcer, store, req.ID, b.lastOp.set, b.logger).
-			withCompression(newZipConfig(req.Compression))
+			withCompression(newZipConfig(req.Compression)).
+			withEncryption(key)
 
 		result := backup.BackupDescriptor{
 			StartedAt:     time.Now().UTC(),
@@ -162,6 +170,10 @@ func (b *backupper) backup(store nodeStore, req *Request) (CanCommitResponse, er
 			Classes:       make([]backup.ClassDescriptor, 0, len(req.Classes)),
 			Version:       Version,
 			ServerVersion: config.ServerVersion,
+
+			IncrementalBaseBackupID: req.IncrementalBaseBackupID,
+			CompressionCodec:        string(codec),
+			Encryption:              enc,
 		}
 
 		// the coordinator might want to abort the backup
@@ -170,12 +182,22 @@ func (b *backupper) backup(store nodeStore, req *Request) (CanCommitResponse, er
 		defer close(done)
 
 		logFields := logrus.Fields{"action": "create_backup", "backup_id": req.ID, "override_bucket": req.Bucket, "override_path": req.Path}
+		if req.IncrementalBaseBackupID != "" {
+			base, err := b.incrementalBase(ctx, req)
+			if err != nil {
+				b.logger.WithFields(logFields).Error(err)
+				b.lastAsyncError = err
+				return
+			}
+			provider.withIncrementalBase(base)
+		}
 		if err := provider.all(ctx, req.Classes, &result, req.Bucket, req.Path); err != nil {
 			b.logger.WithFields(logFields).Error(err)
 			b.lastAsyncError = err
 
 		} else {
 			b.logger.WithFields(logFields).Info("backup completed successfully")
+			b.shipLogsTo(ctx, req, &result)
 		}
 		result.CompletedAt = time.Now().UTC()
 	}
@@ -183,3 +205,60 @@ func (b *backupper) backup(store nodeStore, req *Request) (CanCommitResponse, er
 
 	return ret, nil
 }
+
+// shipLogsTo makes the commit logs archived from now on being shipped
+// alongside the backup requested by req
+func (b *backupper) shipLogsTo(ctx context.Context, req *Request, desc *backup.BackupDescriptor) {
+	if b.shipper == nil {
+		return
+	}
+	target := &logShippingTarget{
+		Backend:    req.Backend,
+		ID:         req.ID,
+		Bucket:     req.Bucket,
+		Path:       req.Path,
+		Classes:    req.Classes,
+		Since:      desc.StartedAt,
+		Encryption: desc.Encryption,
+	}
+	if err := b.shipper.switchTarget(ctx, target); err != nil {
+		b.logger.WithField("action", "create_backup").WithField("backup_id", req.ID).
+			Errorf("ship commit logs alongside backup: %v", err)
+	}
+}
+
+// incrementalBase loads what this node stored for the base of an incremental backup
+func (b *backupper) incrementalBase(ctx context.Context, req *Request) (*incrementalBase, error) {
+	baseID := req.IncrementalBaseBackupID
+	store, err := nodeBackend(b.node, b.backends, req.Backend, baseID, req.Bucket, req.Path)
+	if err != nil {
+		return nil, fmt.Errorf("no backup provider %q, did you enable the right module?", req.Backend)
+	}
+	base, err := loadIncrementalBase(ctx, store, baseID, req.Bucket, req.Path)
+	if err == nil && base == nil {
+		b.logger.WithField("action", "create_backup").WithField("backup_id", req.ID).
+			Infof("node did not take part in incremental base %q, uploading all files", baseID)
+	}
+	return base, err
+}
+
+// encoding returns the compression codec and, if the backup is to be encrypted,
+// a new data key along with its descriptor
+func (b *backupper) encoding(req *Request) (CompressionCodec, []byte, *backup.EncryptionDescriptor, error) {
+	codec := req.Codec
+	if codec == "" {
+		codec = CodecGzip
+	}
+	if !req.Encrypt {
+		return codec, nil, nil, nil
+	}
+	kek, err := encryptionKey()
+	if err != nil {
+		return codec, nil, nil, err
+	}
+	if kek == nil {
+		return codec, nil, nil, errNoEncryptionKey
+	}
+	key, enc, err := newDataKey(kek)
+	return codec, key, enc, err
+}
@@ -177,6 +177,8 @@ func (c *coordinator) Backup(ctx context.Context, cstore coordStore, req *Reques
 		Version:       Version,
 		ServerVersion: config.ServerVersion,
 		Leader:        leader,
+
+		IncrementalBaseBackupID: req.IncrementalBaseBackupID,
 	}
 
 	for key := range c.Participants {
@@ -397,6 +399,10 @@ func (c *coordinator) canCommit(ctx context.Context, req *Request) (map[string]s
 					Compression: req.Compression,
 					Bucket:      req.Bucket,
 					Path:        req.Path,
+					Encrypt:     req.Encrypt,
+
+					IncrementalBaseBackupID: req.IncrementalBaseBackupID,
+					PointInTime:             req.PointInTime,
 				},
 			}
 		}
@@ -0,0 +1,310 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package backup
+
+import (
+	"bufio"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/backup"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+)
+
+const (
+	// EncryptionKeyEnv holds the base64 encoded key encryption key
+	EncryptionKeyEnv = "BACKUP_ENCRYPTION_KEY"
+	// EncryptionKeyFileEnv points to a file holding the base64 encoded key encryption key
+	EncryptionKeyFileEnv = "BACKUP_ENCRYPTION_KEY_FILE"
+
+	encryptionAlgorithm = "AES-256-GCM"
+	dataKeySize         = 32
+
+	// encrypted streams are sealed in segments of segmentSize bytes.
+	// The nonce of a segment consists of a random prefix written at
+	// the beginning of the stream, the segment counter and a flag
+	// marking the last segment, which makes truncation detectable.
+	segmentSize     = 64 * 1024
+	noncePrefixSize = 7
+)
+
+var errNoEncryptionKey = fmt.Errorf("backup encryption requires %s or %s to be set",
+	EncryptionKeyEnv, EncryptionKeyFileEnv)
+
+// encryptionKey returns the configured key encryption key or nil if none is configured
+func encryptionKey() ([]byte, error) {
+	encoded := os.Getenv(EncryptionKeyEnv)
+	if encoded == "" {
+		path := os.Getenv(EncryptionKeyFileEnv)
+		if path == "" {
+			return nil, nil
+		}
+		b, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("read %s: %w", EncryptionKeyFileEnv, err)
+		}
+		encoded = string(b)
+	}
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encoded))
+	if err != nil {
+		return nil, fmt.Errorf("decode backup encryption key: %w", err)
+	}
+	switch len(key) {
+	case 16, 24, 32:
+		return key, nil
+	default:
+		return nil, fmt.Errorf("backup encryption key must be 16, 24 or 32 bytes long, got %d", len(key))
+	}
+}
+
+// keyID is the fingerprint of a key encryption key
+func keyID(kek []byte) string {
+	sum := sha256.Sum256(kek)
+	return hex.EncodeToString(sum[:8])
+}
+
+// newDataKey generates a data key and seals it with the key encryption key kek
+func newDataKey(kek []byte) ([]byte, *backup.EncryptionDescriptor, error) {
+	dek := make([]byte, dataKeySize)
+	if _, err := rand.Read(dek); err != nil {
+		return nil, nil, fmt.Errorf("generate data key: %w", err)
+	}
+	aead, err := newAEAD(kek)
+	if err != nil {
+		return nil, nil, err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, nil, fmt.Errorf("generate nonce: %w", err)
+	}
+	return dek, &backup.EncryptionDescriptor{
+		Algorithm:  encryptionAlgorithm,
+		KeyID:      keyID(kek),
+		WrappedKey: aead.Seal(nonce, nonce, dek, nil),
+	}, nil
+}
+
+// dataKey unseals the data key described by desc using the configured key encryption key.
+// It returns nil if desc is nil, i.e. the files are not encrypted.
+func dataKey(desc *backup.EncryptionDescriptor) ([]byte, error) {
+	if desc == nil {
+		return nil, nil
+	}
+	if desc.Algorithm != encryptionAlgorithm {
+		return nil, fmt.Errorf("unsupported encryption algorithm %q", desc.Algorithm)
+	}
+	kek, err := encryptionKey()
+	if err != nil {
+		return nil, err
+	}
+	if kek == nil {
+		return nil, errNoEncryptionKey
+	}
+	if id := keyID(kek); id != desc.KeyID {
+		return nil, fmt.Errorf("backup was encrypted with key %s, configured key is %s", desc.KeyID, id)
+	}
+	aead, err := newAEAD(kek)
+	if err != nil {
+		return nil, err
+	}
+	n := aead.NonceSize()
+	if len(desc.WrappedKey) < n {
+		return nil, fmt.Errorf("wrapped data key too short")
+	}
+	dek, err := aead.Open(nil, desc.WrappedKey[:n], desc.WrappedKey[n:], nil)
+	if err != nil {
+		return nil, fmt.Errorf("unwrap data key: %w", err)
+	}
+	return dek, nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("create cipher: %w", err)
+	}
+	return cipher.NewGCM(block)
+}
+
+func segmentNonce(prefix []byte, counter uint32, last bool) []byte {
+	nonce := make([]byte, noncePrefixSize+5)
+	copy(nonce, prefix)
+	binary.BigEndian.PutUint32(nonce[noncePrefixSize:], counter)
+	if last {
+		nonce[len(nonce)-1] = 1
+	}
+	return nonce
+}
+
+// encryptWriter seals everything written to it with the data key
+type encryptWriter struct {
+	w       io.Writer
+	aead    cipher.AEAD
+	prefix  []byte
+	counter uint32
+	buf     []byte
+	closed  bool
+}
+
+func newEncryptWriter(w io.Writer, dek []byte) (*encryptWriter, error) {
+	aead, err := newAEAD(dek)
+	if err != nil {
+		return nil, err
+	}
+	prefix := make([]byte, noncePrefixSize)
+	if _, err := rand.Read(prefix); err != nil {
+		return nil, fmt.Errorf("generate nonce prefix: %w", err)
+	}
+	return &encryptWriter{
+		w:      w,
+		aead:   aead,
+		prefix: prefix,
+		buf:    make([]byte, 0, segmentSize),
+	}, nil
+}
+
+func (e *encryptWriter) Write(p []byte) (n int, err error) {
+	for len(p) > 0 {
+		if len(e.buf) == segmentSize { // more data follows
+			if err := e.seal(false); err != nil {
+				return n, err
+			}
+		}
+		k := copy(e.buf[len(e.buf):segmentSize], p)
+		e.buf = e.buf[:len(e.buf)+k]
+		p = p[k:]
+		n += k
+	}
+	return n, nil
+}
+
+// Close seals the last segment. It does not close the underlying writer.
+func (e *encryptWriter) Close() error {
+	if e.closed {
+		return nil
+	}
+	e.closed = true
+	return e.seal(true)
+}
+
+func (e *encryptWriter) seal(last bool) error {
+	if e.counter == ^uint32(0) {
+		return fmt.Errorf("encrypted stream too long")
+	}
+	var out []byte
+	if e.counter == 0 { // the stream starts with the nonce prefix
+		out = append(out, e.prefix...)
+	}
+	out = e.aead.Seal(out, segmentNonce(e.prefix, e.counter, last), e.buf, nil)
+	e.counter++
+	e.buf = e.buf[:0]
+	_, err := e.w.Write(out)
+	return err
+}
+
+// decryptReader opens a stream written by encryptWriter
+type decryptReader struct {
+	r       *bufio.Reader
+	aead    cipher.AEAD
+	prefix  []byte
+	counter uint32
+	seg     []byte // ciphertext of the current segment
+	buf     []byte // plaintext not read yet
+	done    bool   // last segment has been opened
+}
+
+func newDecryptReader(r io.Reader, dek []byte) (*decryptReader, error) {
+	aead, err := newAEAD(dek)
+	if err != nil {
+		return nil, err
+	}
+	return &decryptReader{
+		r:    bufio.NewReaderSize(r, segmentSize+aead.Overhead()+1),
+		aead: aead,
+		seg:  make([]byte, segmentSize+aead.Overhead()),
+	}, nil
+}
+
+func (d *decryptReader) Read(p []byte) (int, error) {
+	for len(d.buf) == 0 {
+		if d.done {
+			return 0, io.EOF
+		}
+		if err := d.open(); err != nil {
+			return 0, err
+		}
+	}
+	n := copy(p, d.buf)
+	d.buf = d.buf[n:]
+	return n, nil
+}
+
+func (d *decryptReader) open() error {
+	if d.prefix == nil {
+		d.prefix = make([]byte, noncePrefixSize)
+		if _, err := io.ReadFull(d.r, d.prefix); err != nil {
+			return fmt.Errorf("read nonce prefix: %w", truncated(err))
+		}
+	}
+	n, err := io.ReadFull(d.r, d.seg)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return fmt.Errorf("read segment: %w", truncated(err))
+	}
+	last := err != nil
+	if !last {
+		if _, err := d.r.Peek(1); errors.Is(err, io.EOF) {
+			last = true
+		}
+	}
+	plain, err := d.aead.Open(d.seg[:0], segmentNonce(d.prefix, d.counter, last), d.seg[:n], nil)
+	if err != nil {
+		return fmt.Errorf("decrypt segment %d: %w", d.counter, err)
+	}
+	d.counter++
+	d.buf = plain
+	d.done = last
+	return nil
+}
+
+func truncated(err error) error {
+	if errors.Is(err, io.EOF) {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
+
+// encryptedReader returns a reader yielding the content of r sealed with the data key
+func encryptedReader(r io.ReadCloser, dek []byte, logger logrus.FieldLogger) io.ReadCloser {
+	pr, pw := io.Pipe()
+	enterrors.GoWrapper(func() {
+		defer r.Close()
+		ew, err := newEncryptWriter(pw, dek)
+		if err == nil {
+			if _, err = io.Copy(ew, r); err == nil {
+				err = ew.Close()
+			}
+		}
+		pw.CloseWithError(err)
+	}, logger)
+	return pr
+}
@@ -0,0 +1,146 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package backup
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/base64"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncryptedStream(t *testing.T) {
+	key := make([]byte, dataKeySize)
+	_, err := rand.Read(key)
+	require.Nil(t, err)
+
+	encrypt := func(t *testing.T, data []byte) []byte {
+		var buf bytes.Buffer
+		w, err := newEncryptWriter(&buf, key)
+		require.Nil(t, err)
+		_, err = w.Write(data)
+		require.Nil(t, err)
+		require.Nil(t, w.Close())
+		return buf.Bytes()
+	}
+	decrypt := func(ciphertext []byte) ([]byte, error) {
+		r, err := newDecryptReader(bytes.NewReader(ciphertext), key)
+		if err != nil {
+			return nil, err
+		}
+		return io.ReadAll(r)
+	}
+
+	for _, size := range []int{0, 1, segmentSize - 1, segmentSize, segmentSize + 1, 3 * segmentSize} {
+		data := make([]byte, size)
+		_, err := rand.Read(data)
+		require.Nil(t, err)
+
+		got, err := decrypt(encrypt(t, data))
+		require.Nil(t, err, "size %d", size)
+		assert.Equal(t, data, got, "size %d", size)
+	}
+
+	data := bytes.Repeat([]byte("weaviate"), segmentSize)
+	ciphertext := encrypt(t, data)
+
+	t.Run("truncated", func(t *testing.T) {
+		for _, n := range []int{noncePrefixSize, noncePrefixSize + segmentSize + 16, len(ciphertext) - 1} {
+			_, err := decrypt(ciphertext[:n])
+			assert.NotNil(t, err, "truncated at %d", n)
+		}
+	})
+
+	t.Run("tampered", func(t *testing.T) {
+		tampered := bytes.Clone(ciphertext)
+		tampered[len(tampered)/2] ^= 1
+		_, err := decrypt(tampered)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("wrong key", func(t *testing.T) {
+		r, err := newDecryptReader(bytes.NewReader(ciphertext), bytes.Repeat([]byte{1}, dataKeySize))
+		require.Nil(t, err)
+		_, err = io.ReadAll(r)
+		assert.NotNil(t, err)
+	})
+}
+
+func TestDataKey(t *testing.T) {
+	kek := make([]byte, 32)
+	_, err := rand.Read(kek)
+	require.Nil(t, err)
+	encoded := base64.StdEncoding.EncodeToString(kek)
+
+	t.Run("no key configured", func(t *testing.T) {
+		t.Setenv(EncryptionKeyEnv, "")
+		t.Setenv(EncryptionKeyFileEnv, "")
+		key, err := encryptionKey()
+		assert.Nil(t, err)
+		assert.Nil(t, key)
+	})
+
+	t.Run("key from env", func(t *testing.T) {
+		t.Setenv(EncryptionKeyEnv, encoded)
+		key, err := encryptionKey()
+		require.Nil(t, err)
+		assert.Equal(t, kek, key)
+	})
+
+	t.Run("key from file", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "key")
+		require.Nil(t, os.WriteFile(path, []byte(encoded+"\n"), 0o600))
+		t.Setenv(EncryptionKeyEnv, "")
+		t.Setenv(EncryptionKeyFileEnv, path)
+		key, err := encryptionKey()
+		require.Nil(t, err)
+		assert.Equal(t, kek, key)
+	})
+
+	t.Run("invalid key length", func(t *testing.T) {
+		t.Setenv(EncryptionKeyEnv, base64.StdEncoding.EncodeToString([]byte("short")))
+		_, err := encryptionKey()
+		assert.NotNil(t, err)
+	})
+
+	t.Run("wrap and unwrap", func(t *testing.T) {
+		dek, desc, err := newDataKey(kek)
+		require.Nil(t, err)
+		assert.Len(t, dek, dataKeySize)
+		assert.NotContains(t, string(desc.WrappedKey), string(dek))
+
+		t.Setenv(EncryptionKeyEnv, encoded)
+		got, err := dataKey(desc)
+		require.Nil(t, err)
+		assert.Equal(t, dek, got)
+
+		t.Setenv(EncryptionKeyEnv, base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{1}, 32)))
+		_, err = dataKey(desc)
+		assert.ErrorContains(t, err, desc.KeyID)
+
+		t.Setenv(EncryptionKeyEnv, "")
+		_, err = dataKey(desc)
+		assert.ErrorIs(t, err, errNoEncryptionKey)
+	})
+
+	t.Run("not encrypted", func(t *testing.T) {
+		key, err := dataKey(nil)
+		assert.Nil(t, err)
+		assert.Nil(t, key)
+	})
+}
@@ -133,6 +133,13 @@ func (fb *fakeBackend) PutObject(ctx context.Context, backupID, key, overrideBuc
 	return args.Error(0)
 }
 
+func (fb *fakeBackend) Delete(ctx context.Context, backupID, overrideBucket, overridePath string) error {
+	fb.Lock()
+	defer fb.Unlock()
+	args := fb.Called(ctx, backupID)
+	return args.Error(0)
+}
+
 func (fb *fakeBackend) GetObject(ctx context.Context, backupID, key, overrideBucket, overridePath string) ([]byte, error) {
 	fb.RLock()
 	defer fb.RUnlock()
@@ -203,3 +210,37 @@ func (fb *fakeBackend) Write(ctx context.Context, backupID, key, overrideBucket,
 
 	return n, err
 }
+
+type fakeScheduleStore struct {
+	sync.Mutex
+	schedules map[string]backup.Schedule
+}
+
+func (f *fakeScheduleStore) UpsertBackupSchedule(s backup.Schedule) error {
+	f.Lock()
+	defer f.Unlock()
+	if f.schedules == nil {
+		f.schedules = make(map[string]backup.Schedule)
+	}
+	f.schedules[s.Name] = s
+	return nil
+}
+
+func (f *fakeScheduleStore) DeleteBackupSchedule(name string) error {
+	f.Lock()
+	defer f.Unlock()
+	delete(f.schedules, name)
+	return nil
+}
+
+func (f *fakeScheduleStore) GetBackupSchedules(names ...string) ([]backup.Schedule, error) {
+	f.Lock()
+	defer f.Unlock()
+	var res []backup.Schedule
+	for name, s := range f.schedules {
+		if len(names) == 0 || name == names[0] {
+			res = append(res, s)
+		}
+	}
+	return res, nil
+}
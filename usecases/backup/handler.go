@@ -26,8 +26,10 @@ import (
 // Version of backup structure
 const (
 	// Version > version1 support compression
+	// "2.2" support incremental backups
+	Version = "2.2"
 	// "2.1" support restore on 2 phases
-	Version = "2.1"
+	// Version = "2.1"
 	// "2.0" support compression
 	// Version = "2.0"
 	// version1 store plain files without compression
@@ -101,11 +103,22 @@ func NewHandler(
 	return m
 }
 
+// StartLogShipping ships the commit logs archived in dataPath every interval
+// to the backend of the latest backup this node took part in. Before, the
+// checkpointer hands over the logs which are still being written.
+func (m *Handler) StartLogShipping(dataPath string, interval time.Duration, checkpointer LogCheckpointer) {
+	m.backupper.shipper = newLogShipper(m.node, m.logger, m.backends, checkpointer, dataPath)
+	m.backupper.shipper.run(interval)
+}
+
 // Compression is the compression configuration.
 type Compression struct {
 	// Level is one of DefaultCompression, BestSpeed, BestCompression
 	Level CompressionLevel
 
+	// Codec is one of CodecGzip, CodecZstd, CodecNone, default: CodecGzip
+	Codec CompressionCodec
+
 	// ChunkSize represents the desired size for chunks between 1 - 512  MB
 	// However, during compression, the chunk size might
 	// slightly deviate from this value, being either slightly
@@ -142,6 +155,18 @@ type BackupRequest struct {
 
 	// Override path (optional) - replaces environement variable for one call
 	Path string
+
+	// IncrementalBaseBackupID (optional) makes the backup incremental:
+	// only files which changed since the referenced backup are uploaded
+	IncrementalBaseBackupID string
+
+	// PointInTime (optional) restores the state at this time by replaying
+	// the commit logs shipped alongside the backup
+	PointInTime time.Time
+
+	// Encrypt the backup with a data key sealed by the key
+	// configured in BACKUP_ENCRYPTION_KEY or BACKUP_ENCRYPTION_KEY_FILE
+	Encrypt bool
 }
 
 // OnCanCommit will be triggered when coordinator asks the node to participate
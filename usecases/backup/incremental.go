@@ -0,0 +1,177 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package backup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/weaviate/weaviate/entities/backup"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+)
+
+// incrementalBase is the node descriptor of the backup an incremental backup builds upon
+type incrementalBase struct {
+	id   string
+	desc *backup.BackupDescriptor
+}
+
+// loadIncrementalBase fetches the descriptor of the base backup stored by this node.
+// It returns nil if the node did not take part in the base backup,
+// in which case all files have to be uploaded.
+func loadIncrementalBase(ctx context.Context, store nodeStore, baseID, overrideBucket, overridePath string,
+) (*incrementalBase, error) {
+	desc, err := store.Meta(ctx, baseID, overrideBucket, overridePath, false)
+	if err != nil {
+		var nerr backup.ErrNotFound
+		if errors.As(err, &nerr) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("get meta of incremental base %q: %w", baseID, err)
+	}
+	if desc.Status != string(backup.Success) {
+		return nil, fmt.Errorf("incremental base %q has status %s", baseID, desc.Status)
+	}
+	return &incrementalBase{id: baseID, desc: desc}, nil
+}
+
+// skipUnchanged moves all files of sd which did not change since the base backup
+// from sd.Files to sd.BaseFiles. It expects sd.FileInfos to be populated.
+func (b *incrementalBase) skipUnchanged(class string, sd *backup.ShardDescriptor) {
+	if b == nil {
+		return
+	}
+	cdesc := b.desc.GetClassDescriptor(class)
+	if cdesc == nil {
+		return
+	}
+	base := cdesc.GetShardDescriptor(sd.Name)
+	if base == nil || len(base.FileInfos) == 0 {
+		return
+	}
+
+	files := make([]string, 0, len(sd.Files))
+	for _, file := range sd.Files {
+		prev, ok := base.FileInfos[file]
+		if !ok || !prev.Unchanged(sd.FileInfos[file]) {
+			files = append(files, file)
+			continue
+		}
+		// the base might have inherited the file from its own base
+		holder, ok := base.BaseFiles[file]
+		if !ok {
+			holder = b.id
+		}
+		if sd.BaseFiles == nil {
+			sd.BaseFiles = make(map[string]string, len(sd.Files)-len(files))
+		}
+		sd.BaseFiles[file] = holder
+	}
+	sd.Files = files
+}
+
+// collectFileInfos records size and modification time of all files of sd
+func collectFileInfos(sourcePath string, sd *backup.ShardDescriptor) error {
+	sd.FileInfos = make(map[string]backup.FileInfo, len(sd.Files))
+	for _, relPath := range sd.Files {
+		info, err := os.Stat(filepath.Join(sourcePath, relPath))
+		if err != nil {
+			return fmt.Errorf("stat %s: %w", relPath, err)
+		}
+		sd.FileInfos[relPath] = backup.FileInfo{Size: info.Size(), ModTime: info.ModTime()}
+	}
+	return nil
+}
+
+// baseChunk identifies a chunk of a previous backup in an incremental chain
+type baseChunk struct {
+	backupID string
+	node     string
+	chunk    int32
+}
+
+// writeBaseFiles downloads the files an incremental backup shares with the backups
+// it builds upon. Only the required files are extracted from their chunks.
+func (fw *fileWriter) writeBaseFiles(ctx context.Context, classTempDir, overrideBucket, overridePath string,
+	desc *backup.ClassDescriptor,
+) error {
+	// needed files grouped by the chunk holding them
+	wanted := make(map[baseChunk]map[string]struct{})
+	metas := make(map[string]*backup.BackupDescriptor)
+	// data keys of the bases, resolved before any download starts
+	keys := make(map[string][]byte)
+	for _, sd := range desc.Shards {
+		for file, holder := range sd.BaseFiles {
+			key := holder + "/" + sd.Node
+			meta, ok := metas[key]
+			if !ok {
+				store := fw.baseStore(holder, sd.Node)
+				m, err := store.Meta(ctx, holder, overrideBucket, overridePath, false)
+				if err != nil {
+					return fmt.Errorf("get meta of incremental base %q: %w", holder, err)
+				}
+				dk, err := dataKey(m.Encryption)
+				if err != nil {
+					return fmt.Errorf("incremental base %q: %w", holder, err)
+				}
+				meta, metas[key], keys[key] = m, m, dk
+			}
+			cdesc := meta.GetClassDescriptor(desc.Name)
+			if cdesc == nil {
+				return fmt.Errorf("incremental base %q: class %q not found", holder, desc.Name)
+			}
+			shard := cdesc.GetShardDescriptor(sd.Name)
+			if shard == nil {
+				return fmt.Errorf("incremental base %q: shard %q not found", holder, sd.Name)
+			}
+			bc := baseChunk{backupID: holder, node: sd.Node, chunk: shard.Chunk}
+			if wanted[bc] == nil {
+				wanted[bc] = make(map[string]struct{})
+			}
+			wanted[bc][file] = struct{}{}
+		}
+	}
+
+	eg, ctx := enterrors.NewErrorGroupWithContextWrapper(fw.logger, ctx)
+	eg.SetLimit(fw.GoPoolSize)
+	for bc, files := range wanted {
+		bc, files := bc, files
+		meta := metas[bc.backupID+"/"+bc.node]
+		key := keys[bc.backupID+"/"+bc.node]
+		eg.Go(func() error {
+			store := fw.baseStore(bc.backupID, bc.node)
+			uz, w := NewUnzip(classTempDir, CompressionCodec(meta.CompressionCodec), key)
+			uz.files = files
+			enterrors.GoWrapper(func() {
+				store.Read(ctx, chunkKey(desc.Name, bc.chunk), overrideBucket, overridePath, w)
+			}, fw.logger)
+			if _, err := uz.ReadChunk(); err != nil {
+				return fmt.Errorf("incremental base %q chunk %d: %w", bc.backupID, bc.chunk, err)
+			}
+			return nil
+		})
+	}
+	return eg.Wait()
+}
+
+// baseStore returns a store pointing at the files a node stored for backup id
+func (fw *fileWriter) baseStore(id, node string) nodeStore {
+	return nodeStore{objectStore{
+		backend:  fw.backend.backend,
+		backupId: fmt.Sprintf("%s/%s", id, node),
+		bucket:   fw.backend.bucket,
+		path:     fw.backend.path,
+	}}
+}
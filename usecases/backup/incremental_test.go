@@ -0,0 +1,79 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package backup
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/entities/backup"
+)
+
+func TestIncrementalBaseSkipUnchanged(t *testing.T) {
+	now := time.Now()
+	info := func(size int64) backup.FileInfo { return backup.FileInfo{Size: size, ModTime: now} }
+
+	base := &incrementalBase{id: "base", desc: &backup.BackupDescriptor{
+		Classes: []backup.ClassDescriptor{{
+			Name: "C1",
+			Shards: []*backup.ShardDescriptor{{
+				Name:      "s1",
+				Files:     []string{"changed", "own"},
+				FileInfos: map[string]backup.FileInfo{"changed": info(1), "own": info(2), "inherited": info(3)},
+				BaseFiles: map[string]string{"inherited": "older"},
+			}},
+		}},
+	}}
+
+	t.Run("unchanged files are taken from the chain", func(t *testing.T) {
+		sd := &backup.ShardDescriptor{
+			Name:      "s1",
+			Files:     []string{"changed", "own", "inherited", "new"},
+			FileInfos: map[string]backup.FileInfo{"changed": info(5), "own": info(2), "inherited": info(3), "new": info(4)},
+		}
+		base.skipUnchanged("C1", sd)
+		assert.ElementsMatch(t, []string{"changed", "new"}, sd.Files)
+		assert.Equal(t, map[string]string{"own": "base", "inherited": "older"}, sd.BaseFiles)
+	})
+
+	t.Run("modification time is considered", func(t *testing.T) {
+		sd := &backup.ShardDescriptor{
+			Name:      "s1",
+			Files:     []string{"own"},
+			FileInfos: map[string]backup.FileInfo{"own": {Size: 2, ModTime: now.Add(time.Second)}},
+		}
+		base.skipUnchanged("C1", sd)
+		assert.Equal(t, []string{"own"}, sd.Files)
+		assert.Empty(t, sd.BaseFiles)
+	})
+
+	t.Run("unknown shard or class is uploaded completely", func(t *testing.T) {
+		for _, c := range []struct{ class, shard string }{{"C1", "s2"}, {"C2", "s1"}} {
+			sd := &backup.ShardDescriptor{
+				Name:      c.shard,
+				Files:     []string{"own"},
+				FileInfos: map[string]backup.FileInfo{"own": info(2)},
+			}
+			base.skipUnchanged(c.class, sd)
+			assert.Equal(t, []string{"own"}, sd.Files)
+			assert.Empty(t, sd.BaseFiles)
+		}
+	})
+
+	t.Run("no base", func(t *testing.T) {
+		var noBase *incrementalBase
+		sd := &backup.ShardDescriptor{Name: "s1", Files: []string{"own"}}
+		noBase.skipUnchanged("C1", sd)
+		assert.Equal(t, []string{"own"}, sd.Files)
+	})
+}
@@ -0,0 +1,329 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package backup
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/entities/backup"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+)
+
+const (
+	// LogArchiveFile lists the commit logs a node shipped alongside a backup
+	LogArchiveFile = "log_archive.json"
+	// logArchivePrefix is the key prefix of shipped commit logs
+	logArchivePrefix = "logs"
+	// logShippingTargetFile persists the current target in the log archive directory
+	logShippingTargetFile = "target.json"
+)
+
+// logShippingTarget is the backup archived commit logs are shipped to
+type logShippingTarget struct {
+	Backend string    `json:"backend"`
+	ID      string    `json:"id"`
+	Bucket  string    `json:"bucket,omitempty"`
+	Path    string    `json:"path,omitempty"`
+	Classes []string  `json:"classes"`
+	Since   time.Time `json:"since"` // older logs are part of the backup
+
+	// Encryption of the backup, shipped logs are encrypted with the same data key
+	Encryption *backup.EncryptionDescriptor `json:"encryption,omitempty"`
+}
+
+func (t *logShippingTarget) hasClass(class string) bool {
+	for _, c := range t.Classes {
+		if c == class {
+			return true
+		}
+	}
+	return false
+}
+
+// LogCheckpointer hands over the commit logs of classes which are still
+// being written to the log archive, so that the logs of all buckets and
+// vector indexes of the classes cover the same time.
+type LogCheckpointer interface {
+	CheckpointLogs(ctx context.Context, classes []string) error
+}
+
+// logShipper uploads the commit logs collected in the log archive of a node to
+// the backend of the latest backup the node took part in. Replaying them on top
+// of that backup allows for restoring it to a later point in time.
+type logShipper struct {
+	node         string
+	logger       logrus.FieldLogger
+	backends     BackupBackendProvider
+	checkpointer LogCheckpointer
+	dir          string // log archive directory
+
+	sync.Mutex
+	target  *logShippingTarget
+	archive *backup.LogArchiveDescriptor // logs shipped to target so far
+}
+
+func newLogShipper(node string, logger logrus.FieldLogger, backends BackupBackendProvider,
+	checkpointer LogCheckpointer, dataPath string,
+) *logShipper {
+	s := &logShipper{
+		node:         node,
+		logger:       logger.WithField("action", "log_shipping"),
+		backends:     backends,
+		checkpointer: checkpointer,
+		dir:          filepath.Join(dataPath, backup.LogArchiveDirectory),
+	}
+	bytes, err := os.ReadFile(filepath.Join(s.dir, logShippingTargetFile))
+	if err != nil {
+		if !os.IsNotExist(err) {
+			s.logger.Errorf("read log shipping target: %v", err)
+		}
+		return s
+	}
+	var target logShippingTarget
+	if err := json.Unmarshal(bytes, &target); err != nil {
+		s.logger.Errorf("unmarshal log shipping target: %v", err)
+		return s
+	}
+	s.target = &target
+	return s
+}
+
+// run ships archived logs every interval
+func (s *logShipper) run(interval time.Duration) {
+	enterrors.GoWrapper(func() {
+		t := time.NewTicker(interval)
+		defer t.Stop()
+		for range t.C {
+			s.Lock()
+			if err := s.ship(context.Background(), false); err != nil {
+				s.logger.Error(err)
+			}
+			s.Unlock()
+		}
+	}, s.logger)
+}
+
+// switchTarget makes the logs archived from now on being shipped alongside
+// the backup described by target. Logs which have not been shipped yet are
+// shipped to both the previous and the new target.
+func (s *logShipper) switchTarget(ctx context.Context, target *logShippingTarget) error {
+	s.Lock()
+	defer s.Unlock()
+
+	if s.target != nil {
+		if err := s.ship(ctx, true); err != nil {
+			s.logger.WithField("backup_id", s.target.ID).
+				Errorf("ship remaining logs to previous backup: %v", err)
+		}
+	}
+
+	bytes, err := json.Marshal(target)
+	if err != nil {
+		return fmt.Errorf("marshal log shipping target: %w", err)
+	}
+	if err := os.MkdirAll(s.dir, os.ModePerm); err != nil {
+		return fmt.Errorf("create log archive directory: %w", err)
+	}
+	if err := os.WriteFile(filepath.Join(s.dir, logShippingTargetFile), bytes, os.ModePerm); err != nil {
+		return fmt.Errorf("write log shipping target: %w", err)
+	}
+	s.target = target
+	s.archive = &backup.LogArchiveDescriptor{ID: target.ID, Node: s.node}
+	return s.ship(ctx, false)
+}
+
+// ship uploads all logs found in the archive directory to the current target,
+// after handing over the logs which are still being written. Logs are removed
+// from the archive directory afterwards unless keep is set. Logs which are of
+// no use for the target are removed without being uploaded.
+func (s *logShipper) ship(ctx context.Context, keep bool) error {
+	var checkpoint time.Time
+	if s.target != nil && s.checkpointer != nil {
+		checkpoint = time.Now().UTC()
+		if err := s.checkpointer.CheckpointLogs(ctx, s.target.Classes); err != nil {
+			s.logger.Errorf("hand over commit logs being written: %v", err)
+			checkpoint = time.Time{}
+		}
+	}
+
+	var logs, obsolete []string
+	err := filepath.WalkDir(s.dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+		rel, err := filepath.Rel(s.dir, p)
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || rel == logShippingTargetFile || strings.HasSuffix(p, backup.LogArchiveTmpSuffix) {
+			return nil
+		}
+		if strings.HasSuffix(p, backup.LogTimesSuffix) {
+			return nil // handled along with its log
+		}
+		if s.target == nil || !s.target.hasClass(strings.SplitN(rel, string(filepath.Separator), 2)[0]) {
+			obsolete = append(obsolete, p)
+		} else {
+			logs = append(logs, rel)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("list archived logs: %w", err)
+	}
+	if !keep {
+		for _, p := range obsolete {
+			s.remove(p)
+		}
+	}
+	if len(logs) == 0 && checkpoint.IsZero() {
+		return nil
+	}
+
+	store, err := nodeBackend(s.node, s.backends, s.target.Backend, s.target.ID, s.target.Bucket, s.target.Path)
+	if err != nil {
+		return fmt.Errorf("no backup provider %q: %w", s.target.Backend, err)
+	}
+	key, err := dataKey(s.target.Encryption)
+	if err != nil {
+		return fmt.Errorf("encryption key of backup %q: %w", s.target.ID, err)
+	}
+	if s.archive == nil { // node restarted, continue previous archive
+		s.archive = &backup.LogArchiveDescriptor{ID: s.target.ID, Node: s.node}
+		var prev backup.LogArchiveDescriptor
+		if err := store.meta(ctx, LogArchiveFile, s.target.Bucket, s.target.Path, &prev); err == nil {
+			s.archive = &prev
+		}
+	}
+
+	var (
+		shipped []string
+		failed  = map[string]bool{} // classes with logs which could not be shipped
+	)
+	for _, rel := range logs {
+		p := filepath.Join(s.dir, rel)
+		l, err := s.upload(ctx, store, rel, key)
+		if err != nil {
+			s.logger.WithField("path", rel).Errorf("ship archived log: %v", err)
+			failed[strings.SplitN(rel, string(filepath.Separator), 2)[0]] = true
+			continue
+		}
+		if l != nil {
+			s.archive.Add(*l)
+		}
+		shipped = append(shipped, p)
+	}
+
+	if !checkpoint.IsZero() {
+		if s.archive.ArchivedUntil == nil {
+			s.archive.ArchivedUntil = map[string]time.Time{}
+		}
+		for _, class := range s.target.Classes {
+			if !failed[class] {
+				s.archive.ArchivedUntil[class] = checkpoint
+			}
+		}
+	}
+
+	s.archive.UpdatedAt = time.Now().UTC()
+	if err := store.putMeta(ctx, LogArchiveFile, s.target.Bucket, s.target.Path, s.archive); err != nil {
+		return fmt.Errorf("put log archive descriptor: %w", err)
+	}
+	if !keep {
+		for _, p := range shipped {
+			s.remove(p)
+		}
+	}
+	return nil
+}
+
+// remove removes an archived log along with its times
+func (s *logShipper) remove(p string) {
+	for _, p := range []string{p, p + backup.LogTimesSuffix} {
+		if err := os.Remove(p); err != nil && !os.IsNotExist(err) {
+			s.logger.Errorf("remove archived log: %v", err)
+		}
+	}
+}
+
+// upload uploads the archived log stored at rel, relative to the archive directory.
+// The log is encrypted if a data key is passed.
+// It returns nil if the log is already part of the target backup.
+func (s *logShipper) upload(ctx context.Context, store nodeStore, rel string, key []byte,
+) (*backup.ArchivedLog, error) {
+	parts := strings.SplitN(filepath.ToSlash(rel), "/", 3)
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("unexpected archive path %q", rel)
+	}
+	p := filepath.Join(s.dir, rel)
+	info, err := os.Stat(p)
+	if err != nil {
+		return nil, err
+	}
+	if info.ModTime().Before(s.target.Since) {
+		return nil, nil
+	}
+
+	l := &backup.ArchivedLog{
+		Class:      parts[0],
+		Shard:      parts[1],
+		Path:       parts[2],
+		Size:       info.Size(),
+		ArchivedAt: info.ModTime().UTC(),
+	}
+	if _, err := os.Stat(p + backup.LogTimesSuffix); err == nil {
+		if err := s.uploadFile(ctx, store, p+backup.LogTimesSuffix, archivedLogKey(l)+backup.LogTimesSuffix, key); err != nil {
+			return nil, fmt.Errorf("log times: %w", err)
+		}
+		l.Times = true
+	}
+	if err := s.uploadFile(ctx, store, p, archivedLogKey(l), key); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+// uploadFile uploads the file at p under key, encrypted if a data key is passed
+func (s *logShipper) uploadFile(ctx context.Context, store nodeStore, p, key string, dataKey []byte) error {
+	var (
+		f   io.ReadCloser
+		err error
+	)
+	if f, err = os.Open(p); err != nil {
+		return err
+	}
+	if dataKey != nil {
+		f = encryptedReader(f, dataKey, s.logger)
+	}
+	_, err = store.Write(ctx, key, s.target.Bucket, s.target.Path, f)
+	return err
+}
+
+// archivedLogKey is the key of a shipped log in the node store
+func archivedLogKey(l *backup.ArchivedLog) string {
+	return path.Join(logArchivePrefix, l.Class, l.Shard, l.Path)
+}
@@ -0,0 +1,175 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package backup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/weaviate/weaviate/entities/backup"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+)
+
+// logArchive fetches the descriptor of the commit logs this node shipped alongside backup id
+func (r *restorer) logArchive(ctx context.Context, store nodeStore, id, overrideBucket, overridePath string,
+) (*backup.LogArchiveDescriptor, error) {
+	var archive backup.LogArchiveDescriptor
+	if err := store.meta(ctx, LogArchiveFile, overrideBucket, overridePath, &archive); err != nil {
+		var nerr backup.ErrNotFound
+		if !errors.As(err, &nerr) {
+			return nil, fmt.Errorf("get log archive of backup %q: %w", id, err)
+		}
+		r.logger.WithField("action", "restore").WithField("backup_id", id).
+			Warn("no commit logs have been shipped alongside the backup, restoring it as is")
+	}
+	return &archive, nil
+}
+
+// replayCutoff is the point in time the commit logs of a class are replayed
+// until. It is shared by all buckets and vector indexes of the class, so that
+// the restored objects and vector indexes reflect the same point in time.
+// This is the requested time, unless the logs of the class have only been
+// shipped until an earlier time.
+func replayCutoff(archive *backup.LogArchiveDescriptor, class string, until time.Time) time.Time {
+	if archivedUntil, ok := archive.ArchivedUntil[class]; ok && archivedUntil.Before(until) {
+		return archivedUntil
+	}
+	return until
+}
+
+// logsToReplay selects the commit logs of a class which need to be replayed on
+// top of the backup to restore the state at the cutoff. Of every log written
+// after it, only the first one is needed, as it is cut at the cutoff. The
+// backup started at startedAt.
+func logsToReplay(archive *backup.LogArchiveDescriptor, desc *backup.ClassDescriptor, startedAt, cutoff time.Time,
+) []backup.ArchivedLog {
+	var logs []backup.ArchivedLog
+	for _, sd := range desc.Shards {
+		after := sd.HaltedAt
+		if after.IsZero() {
+			after = startedAt
+		}
+		files := make(map[string]struct{}, len(sd.Files)+len(sd.BaseFiles))
+		for _, f := range sd.Files {
+			files[f] = struct{}{}
+		}
+		for f := range sd.BaseFiles {
+			files[f] = struct{}{}
+		}
+		// logs of the same directory, i.e. bucket or vector index, follow each other
+		completed := map[string]bool{}
+		for _, l := range archive.Completed(desc.Name, sd.Name, after) {
+			dir := path.Dir(l.Path)
+			if completed[dir] || backedUp(l.Path, files) {
+				continue
+			}
+			if l.ArchivedAt.After(cutoff) {
+				completed[dir] = true
+				if !l.Times {
+					continue // can't be cut, all of its entries might be written later
+				}
+			}
+			logs = append(logs, l)
+		}
+	}
+	return logs
+}
+
+// backedUp reports whether the content of the commit log at p is part of the
+// backed up files already. This is the case for logs completed while the shard
+// was halted for the backup.
+func backedUp(p string, files map[string]struct{}) bool {
+	if filepath.Ext(p) == ".wal" {
+		// the memtable has been flushed into a segment of the same name
+		_, ok := files[strings.TrimSuffix(p, ".wal")+".db"]
+		return ok
+	}
+	// vector index commit logs keep their name unless condensed
+	_, ok := files[p]
+	_, condensed := files[p+".condensed"]
+	return ok || condensed
+}
+
+// writeLogs downloads the commit logs to replay into the class directory,
+// cutting those completed after the cutoff. They are recovered like logs of
+// a crashed node once the class is loaded.
+func (fw *fileWriter) writeLogs(ctx context.Context, classTempDir, overrideBucket, overridePath string) error {
+	for i := range fw.logs {
+		l := &fw.logs[i]
+		destPath := path.Join(classTempDir, l.Path)
+		if err := os.MkdirAll(path.Dir(destPath), os.ModePerm); err != nil {
+			return fmt.Errorf("create folder %s: %w", path.Dir(destPath), err)
+		}
+		if err := fw.writeLogFile(ctx, archivedLogKey(l), destPath, overrideBucket, overridePath); err != nil {
+			return err
+		}
+		if !l.ArchivedAt.After(fw.logsUntil) {
+			continue
+		}
+
+		timesPath := destPath + backup.LogTimesSuffix
+		if err := fw.writeLogFile(ctx, archivedLogKey(l)+backup.LogTimesSuffix, timesPath, overrideBucket, overridePath); err != nil {
+			return err
+		}
+		if err := backup.CutLog(destPath, timesPath, fw.logsUntil, l.ArchivedAt); err != nil {
+			return err
+		}
+		if err := os.Remove(timesPath); err != nil {
+			return fmt.Errorf("remove log times %s: %w", timesPath, err)
+		}
+	}
+	return nil
+}
+
+func (fw *fileWriter) writeLogFile(ctx context.Context, key, destPath, overrideBucket, overridePath string) error {
+	var err error
+	if fw.key != nil {
+		err = fw.writeEncryptedFile(ctx, key, destPath, overrideBucket, overridePath)
+	} else {
+		err = fw.backend.WriteToFile(ctx, key, destPath, overrideBucket, overridePath)
+	}
+	if err != nil {
+		return fmt.Errorf("write file %s: %w", destPath, err)
+	}
+	return nil
+}
+
+// writeEncryptedFile downloads the object stored under key and writes its decrypted content to destPath
+func (fw *fileWriter) writeEncryptedFile(ctx context.Context, key, destPath, overrideBucket, overridePath string) error {
+	pr, pw := io.Pipe()
+	defer pr.Close()
+	enterrors.GoWrapper(func() {
+		_, err := fw.backend.Read(ctx, key, overrideBucket, overridePath, pw)
+		pw.CloseWithError(err)
+	}, fw.logger)
+
+	r, err := newDecryptReader(pr, fw.key)
+	if err != nil {
+		return err
+	}
+	f, err := os.Create(destPath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if _, err := io.Copy(f, r); err != nil {
+		return err
+	}
+	return f.Close()
+}
@@ -0,0 +1,76 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package backup
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/entities/backup"
+)
+
+func TestLogsToReplay(t *testing.T) {
+	var (
+		startedAt = time.Now()
+		haltedAt  = startedAt.Add(time.Minute)
+		at        = func(d time.Duration) time.Time { return haltedAt.Add(d) }
+	)
+	archived := func(shard, path string, d time.Duration) backup.ArchivedLog {
+		return backup.ArchivedLog{Class: "C1", Shard: shard, Path: path, ArchivedAt: at(d)}
+	}
+	archive := &backup.LogArchiveDescriptor{Logs: []backup.ArchivedLog{
+		archived("s1", "c1/s1/lsm/objects/segment-1.wal", -time.Second), // before halt
+		archived("s1", "c1/s1/lsm/objects/segment-2.wal", time.Second),  // flushed while halted
+		archived("s1", "c1/s1/main.hnsw.commitlog.d/10", time.Second),   // switched while halted
+		archived("s1", "c1/s1/lsm/objects/segment-4.wal", 3*time.Second),
+		archived("s1", "c1/s1/lsm/objects/segment-3.wal", 2*time.Second),
+		archived("s1", "c1/s1/main.hnsw.commitlog.d/11", 2*time.Second),
+		archived("s1", "c1/s1/lsm/objects/segment-5.wal", time.Hour), // after point in time
+		archived("s1", "c1/s1/lsm/objects/segment-8.wal", 2*time.Hour),
+		{Class: "C1", Shard: "s1", Path: "c1/s1/main.hnsw.commitlog.d/12", ArchivedAt: at(time.Hour), Times: true},
+		archived("s1", "c1/s1/main.hnsw.commitlog.d/13", 2*time.Hour),
+		archived("s2", "c1/s2/lsm/objects/segment-6.wal", time.Second),
+		{Class: "C2", Shard: "s1", Path: "c2/s1/lsm/objects/segment-7.wal", ArchivedAt: at(time.Second)},
+	}}
+	desc := &backup.ClassDescriptor{Name: "C1", Shards: []*backup.ShardDescriptor{
+		{
+			Name:      "s1",
+			HaltedAt:  haltedAt,
+			Files:     []string{"c1/s1/lsm/objects/segment-2.db"},
+			BaseFiles: map[string]string{"c1/s1/main.hnsw.commitlog.d/10.condensed": "base"},
+		},
+		{Name: "s2"}, // no halt time recorded, falls back to start of backup
+	}}
+
+	var paths []string
+	for _, l := range logsToReplay(archive, desc, startedAt, at(time.Minute)) {
+		paths = append(paths, l.Path)
+	}
+	assert.Equal(t, []string{
+		"c1/s1/lsm/objects/segment-3.wal",
+		"c1/s1/main.hnsw.commitlog.d/11",
+		"c1/s1/lsm/objects/segment-4.wal",
+		"c1/s1/main.hnsw.commitlog.d/12", // cut at the point in time on restore
+		"c1/s2/lsm/objects/segment-6.wal",
+	}, paths)
+}
+
+func TestReplayCutoff(t *testing.T) {
+	var (
+		now     = time.Now()
+		archive = &backup.LogArchiveDescriptor{ArchivedUntil: map[string]time.Time{"C1": now}}
+	)
+	assert.Equal(t, now, replayCutoff(archive, "C1", now.Add(time.Hour)), "logs are only archived until then")
+	assert.Equal(t, now.Add(-time.Hour), replayCutoff(archive, "C1", now.Add(-time.Hour)))
+	assert.Equal(t, now.Add(time.Hour), replayCutoff(archive, "C2", now.Add(time.Hour)))
+}
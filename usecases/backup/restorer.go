@@ -112,7 +112,7 @@ func (r *restorer) restore(
 		overrideBucket := req.Bucket
 		overridePath := req.Path
 
-		err = r.restoreAll(context.Background(), desc, req.CPUPercentage, store, overrideBucket, overridePath)
+		err = r.restoreAll(context.Background(), desc, req.CPUPercentage, req.PointInTime, store, overrideBucket, overridePath)
 		logFields := logrus.Fields{"action": "restore", "backup_id": req.ID}
 		if err != nil {
 			r.logger.WithFields(logFields).Error(err)
@@ -128,13 +128,39 @@ func (r *restorer) restore(
 // restoreAll restores classes in temporary directories on the filesystem.
 // The final backup restoration is orchestrated by the raft store.
 func (r *restorer) restoreAll(ctx context.Context,
-	desc *backup.BackupDescriptor, cpuPercentage int,
+	desc *backup.BackupDescriptor, cpuPercentage int, pointInTime time.Time,
 	store nodeStore, overrideBucket, overridePath string,
 ) (err error) {
 	compressed := desc.Version > version1
+	codec := CompressionCodec(desc.CompressionCodec)
+	key, err := dataKey(desc.Encryption)
+	if err != nil {
+		return fmt.Errorf("decrypt backup: %w", err)
+	}
 	r.lastOp.set(backup.Transferring)
+	var archive *backup.LogArchiveDescriptor
+	if !pointInTime.IsZero() {
+		if archive, err = r.logArchive(ctx, store, desc.ID, overrideBucket, overridePath); err != nil {
+			return err
+		}
+	}
 	for _, cdesc := range desc.Classes {
-		if err := r.restoreOne(ctx, &cdesc, desc.ServerVersion, compressed, cpuPercentage, store, overrideBucket, overridePath); err != nil {
+		var (
+			logs   []backup.ArchivedLog
+			cutoff time.Time
+		)
+		if archive != nil {
+			cutoff = replayCutoff(archive, cdesc.Name, pointInTime)
+			if cutoff.Before(pointInTime) {
+				r.logger.WithField("action", "restore").WithField("backup_id", desc.ID).
+					WithField("class", cdesc.Name).
+					Warnf("commit logs have only been shipped until %s, restoring the state at that time",
+						cutoff.Format(time.RFC3339Nano))
+			}
+			logs = logsToReplay(archive, &cdesc, desc.StartedAt, cutoff)
+		}
+		if err := r.restoreOne(ctx, &cdesc, desc.ServerVersion, compressed, codec, key, cpuPercentage,
+			logs, cutoff, store, overrideBucket, overridePath); err != nil {
 			return fmt.Errorf("restore class %s: %w", cdesc.Name, err)
 		}
 		r.logger.WithField("action", "restore").
@@ -154,7 +180,8 @@ func getType(myvar interface{}) string {
 
 func (r *restorer) restoreOne(ctx context.Context,
 	desc *backup.ClassDescriptor, serverVersion string,
-	compressed bool, cpuPercentage int, store nodeStore,
+	compressed bool, codec CompressionCodec, key []byte, cpuPercentage int,
+	logs []backup.ArchivedLog, logsUntil time.Time, store nodeStore,
 	overrideBucket, overridePath string,
 ) (err error) {
 	classLabel := desc.Name
@@ -169,6 +196,8 @@ func (r *restorer) restoreOne(ctx context.Context,
 
 	fw := newFileWriter(r.sourcer, store, compressed, r.logger).
 		WithPoolPercentage(cpuPercentage)
+	fw.setEncoding(codec, key)
+	fw.logs, fw.logsUntil = logs, logsUntil
 
 	// Pre-v1.23 versions store files in a flat format
 	if serverVersion < "1.23" {
@@ -226,6 +255,9 @@ func (r *restorer) validate(ctx context.Context, store *nodeStore, req *Request)
 	if v := meta.Version; v[0] > Version[0] {
 		return nil, nil, fmt.Errorf("%s: %s > %s", errMsgHigherVersion, v, Version)
 	}
+	if _, err := dataKey(meta.Encryption); err != nil {
+		return nil, nil, fmt.Errorf("encrypted backup: %w", err)
+	}
 	cs := meta.List()
 	if len(req.Classes) > 0 {
 		if first := meta.AllExist(req.Classes); first != "" {
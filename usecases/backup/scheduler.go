@@ -40,6 +40,7 @@ type Scheduler struct {
 	backupper  *coordinator
 	restorer   *coordinator
 	backends   BackupBackendProvider
+	schedules  ScheduleStore
 }
 
 // NewScheduler creates a new scheduler with two coordinators
@@ -50,12 +51,14 @@ func NewScheduler(
 	backends BackupBackendProvider,
 	nodeResolver NodeResolver,
 	schema schemaManger,
+	schedules ScheduleStore,
 	logger logrus.FieldLogger,
 ) *Scheduler {
 	m := &Scheduler{
 		logger:     logger,
 		authorizer: authorizer,
 		backends:   backends,
+		schedules:  schedules,
 		backupper: newCoordinator(
 			sourcer,
 			client,
@@ -113,6 +116,15 @@ func (s *Scheduler) Backup(ctx context.Context, pr *models.Principal, req *Backu
 		logOperation(s.logger, "try_backup", req.ID, req.Backend, begin, err)
 	}(time.Now())
 
+	return s.backup(ctx, req, func(classes []string) error {
+		return s.authorizer.Authorize(pr, authorization.CREATE, authorization.Backups(classes...)...)
+	})
+}
+
+// backup starts the backup described by req. If authorize is not nil, it is
+// called with the classes to back up before the backup is started.
+func (s *Scheduler) backup(ctx context.Context, req *BackupRequest, authorize func(classes []string) error,
+) (*models.BackupCreateResponse, error) {
 	store, err := coordBackend(s.backends, req.Backend, req.ID, req.Bucket, req.Path)
 	if err != nil {
 		err = fmt.Errorf("no backup backend %q: %w, did you enable the right module?", req.Backend, err)
@@ -124,8 +136,10 @@ func (s *Scheduler) Backup(ctx context.Context, pr *models.Principal, req *Backu
 		return nil, backup.NewErrUnprocessable(err)
 	}
 
-	if err := s.authorizer.Authorize(pr, authorization.CREATE, authorization.Backups(classes...)...); err != nil {
-		return nil, err
+	if authorize != nil {
+		if err := authorize(classes); err != nil {
+			return nil, err
+		}
 	}
 
 	if err := store.Initialize(ctx, req.Bucket, req.Path); err != nil {
@@ -139,6 +153,9 @@ func (s *Scheduler) Backup(ctx context.Context, pr *models.Principal, req *Backu
 		Compression: req.Compression,
 		Bucket:      req.Bucket,
 		Path:        req.Path,
+		Encrypt:     req.Encrypt,
+
+		IncrementalBaseBackupID: req.IncrementalBaseBackupID,
 	}
 	if err := s.backupper.Backup(ctx, store, &breq); err != nil {
 		return nil, backup.NewErrUnprocessable(err)
@@ -202,6 +219,7 @@ func (s *Scheduler) Restore(ctx context.Context, pr *models.Principal,
 		Classes:     meta.Classes(),
 		Bucket:      req.Bucket,
 		Path:        req.Path,
+		PointInTime: req.PointInTime,
 	}
 	err = s.restorer.Restore(ctx, store, &rReq, meta, schema)
 	if err != nil {
@@ -355,9 +373,64 @@ func (s *Scheduler) validateBackupRequest(ctx context.Context, store coordStore,
 	if err := s.checkIfBackupExists(ctx, store, req); err != nil {
 		return nil, err
 	}
+	if req.IncrementalBaseBackupID != "" {
+		if err := s.validateIncrementalBase(ctx, req); err != nil {
+			return nil, err
+		}
+	}
 	return classes, nil
 }
 
+// validateIncrementalBase makes sure that the base of an incremental backup
+// has been completed successfully on the same backend
+func (s *Scheduler) validateIncrementalBase(ctx context.Context, req *BackupRequest) error {
+	baseID := req.IncrementalBaseBackupID
+	if err := validateID(baseID); err != nil {
+		return fmt.Errorf("incremental base: %w", err)
+	}
+	if baseID == req.ID {
+		return fmt.Errorf("backup %q cannot be its own incremental base", req.ID)
+	}
+	store, err := coordBackend(s.backends, req.Backend, baseID, req.Bucket, req.Path)
+	if err != nil {
+		return err
+	}
+	meta, err := store.Meta(ctx, GlobalBackupFile, req.Bucket, req.Path)
+	if err != nil {
+		return fmt.Errorf("find incremental base %q: %w", baseID, err)
+	}
+	if meta.Status != backup.Success {
+		return fmt.Errorf("incremental base %q has status %s, expected %s", baseID, meta.Status, backup.Success)
+	}
+	return nil
+}
+
+// validateIncrementalChain follows the base backups of an incremental backup
+// and makes sure that each of them is still available for the restore
+func (s *Scheduler) validateIncrementalChain(ctx context.Context, req *BackupRequest, meta *backup.DistributedBackupDescriptor) error {
+	seen := map[string]struct{}{meta.ID: {}}
+	for baseID := meta.IncrementalBaseBackupID; baseID != ""; {
+		if _, ok := seen[baseID]; ok {
+			return fmt.Errorf("incremental chain of backup %q contains a cycle at %q", meta.ID, baseID)
+		}
+		seen[baseID] = struct{}{}
+
+		store, err := coordBackend(s.backends, req.Backend, baseID, req.Bucket, req.Path)
+		if err != nil {
+			return err
+		}
+		base, err := store.Meta(ctx, GlobalBackupFile, req.Bucket, req.Path)
+		if err != nil {
+			return fmt.Errorf("find incremental base %q: %w", baseID, err)
+		}
+		if base.Status != backup.Success {
+			return fmt.Errorf("invalid incremental base %q status: %s", baseID, base.Status)
+		}
+		baseID = base.IncrementalBaseBackupID
+	}
+	return nil
+}
+
 func (s *Scheduler) checkIfBackupExists(ctx context.Context, store coordStore, req *BackupRequest) error {
 	destPath := store.HomeDir(req.Bucket, req.Path)
 	// there is no backup with given id on the backend, regardless of its state (valid or corrupted)
@@ -406,6 +479,13 @@ func (s *Scheduler) validateRestoreRequest(ctx context.Context, store coordStore
 	if v := meta.Version; v[0] > Version[0] {
 		return nil, fmt.Errorf("%s: %s > %s", errMsgHigherVersion, v, Version)
 	}
+	if err := s.validateIncrementalChain(ctx, req, meta); err != nil {
+		return nil, err
+	}
+	if !req.PointInTime.IsZero() && req.PointInTime.Before(meta.CompletedAt) {
+		return nil, fmt.Errorf("point in time %s is before backup %q completed at %s",
+			req.PointInTime.UTC().Format(time.RFC3339), req.ID, meta.CompletedAt.Format(time.RFC3339))
+	}
 	cs := meta.Classes()
 	if len(req.Include) > 0 {
 		if first := meta.AllExist(req.Include); first != "" {
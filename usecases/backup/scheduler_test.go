@@ -759,6 +759,7 @@ type fakeScheduler struct {
 	backendErr   error
 	auth         authorization.Authorizer
 	nodeResolver NodeResolver
+	schedules    fakeScheduleStore
 	log          logrus.FieldLogger
 }
 
@@ -780,7 +781,7 @@ func newFakeScheduler(resolver NodeResolver) *fakeScheduler {
 func (f *fakeScheduler) scheduler() *Scheduler {
 	provider := &fakeBackupBackendProvider{f.backend, f.backendErr}
 	c := NewScheduler(f.auth, &f.client, &f.selector, provider,
-		f.nodeResolver, &f.schema, f.log)
+		f.nodeResolver, &f.schema, &f.schedules, f.log)
 	c.backupper.timeoutNextRound = time.Millisecond * 200
 	c.restorer.timeoutNextRound = time.Millisecond * 200
 	return c
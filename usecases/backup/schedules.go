@@ -0,0 +1,173 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package backup
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/weaviate/weaviate/entities/backup"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+)
+
+// ScheduleStore stores backup schedules in the replicated cluster state
+type ScheduleStore interface {
+	UpsertBackupSchedule(schedule backup.Schedule) error
+	DeleteBackupSchedule(name string) error
+	GetBackupSchedules(names ...string) ([]backup.Schedule, error)
+}
+
+// CreateSchedule creates or replaces the backup schedule s
+func (s *Scheduler) CreateSchedule(ctx context.Context, pr *models.Principal, schedule backup.Schedule) (err error) {
+	defer func(begin time.Time) {
+		logOperation(s.logger, "create_backup_schedule", schedule.Name, schedule.Backend, begin, err)
+	}(time.Now())
+
+	if err := s.authorizer.Authorize(pr, authorization.CREATE, authorization.Backups(schedule.Include...)...); err != nil {
+		return err
+	}
+	if err := schedule.Validate(); err != nil {
+		return backup.NewErrUnprocessable(err)
+	}
+	if _, err := s.backends.BackupBackend(schedule.Backend); err != nil {
+		err = fmt.Errorf("no backup backend %q: %w, did you enable the right module?", schedule.Backend, err)
+		return backup.NewErrUnprocessable(err)
+	}
+	schedule.CreatedAt = time.Now().UTC()
+	schedule.LastRun = time.Time{}
+	return s.schedules.UpsertBackupSchedule(schedule)
+}
+
+// Schedules returns all backup schedules
+func (s *Scheduler) Schedules(ctx context.Context, pr *models.Principal) ([]backup.Schedule, error) {
+	if err := s.authorizer.Authorize(pr, authorization.READ, authorization.Backups()...); err != nil {
+		return nil, err
+	}
+	return s.schedules.GetBackupSchedules()
+}
+
+// DeleteSchedule deletes the backup schedule name. Backups created by it are kept.
+func (s *Scheduler) DeleteSchedule(ctx context.Context, pr *models.Principal, name string) (err error) {
+	defer func(begin time.Time) {
+		logOperation(s.logger, "delete_backup_schedule", name, "", begin, err)
+	}(time.Now())
+
+	if err := s.authorizer.Authorize(pr, authorization.DELETE, authorization.Backups()...); err != nil {
+		return err
+	}
+	schedules, err := s.schedules.GetBackupSchedules(name)
+	if err != nil {
+		return err
+	}
+	if len(schedules) == 0 {
+		return backup.NewErrNotFound(fmt.Errorf("backup schedule %q not found", name))
+	}
+	return s.schedules.DeleteBackupSchedule(name)
+}
+
+// ScheduledBackup starts backup id of the schedule.
+// The principal has been authorized when the schedule was created.
+func (s *Scheduler) ScheduledBackup(ctx context.Context, schedule backup.Schedule, id string) (err error) {
+	defer func(begin time.Time) {
+		logOperation(s.logger, "try_scheduled_backup", id, schedule.Backend, begin, err)
+	}(time.Now())
+
+	req := &BackupRequest{
+		ID:      id,
+		Backend: schedule.Backend,
+		Include: schedule.Include,
+		Exclude: schedule.Exclude,
+		Compression: Compression{
+			Level:         DefaultCompression,
+			Codec:         CodecGzip,
+			CPUPercentage: DefaultCPUPercentage,
+			ChunkSize:     DefaultChunkSize,
+		},
+	}
+	_, err = s.backup(ctx, req, nil)
+	return err
+}
+
+// PruneBackups deletes the backups of the schedule which are not covered by its retention policy
+func (s *Scheduler) PruneBackups(ctx context.Context, schedule backup.Schedule) error {
+	backend, err := s.backends.BackupBackend(schedule.Backend)
+	if err != nil {
+		return fmt.Errorf("no backup backend %q: %w", schedule.Backend, err)
+	}
+	backups, err := backend.AllBackups(ctx)
+	if err != nil {
+		return fmt.Errorf("get all backups: %w", err)
+	}
+	for _, id := range expiredBackups(schedule, backups, time.Now()) {
+		if err := backend.Delete(ctx, id, "", ""); err != nil {
+			return fmt.Errorf("delete backup %s: %w", id, err)
+		}
+		s.logger.WithField("action", "prune_backups").
+			WithField("schedule", schedule.Name).
+			WithField("backup_id", id).
+			Info("deleted backup according to retention policy")
+	}
+	return nil
+}
+
+// expiredBackups returns the IDs of finished backups created by the schedule
+// which are neither among the Retention.KeepLast most recent successful ones
+// nor younger than Retention.KeepDays. Backups referenced as the base of an
+// incremental backup are never expired.
+func expiredBackups(schedule backup.Schedule, backups []*backup.DistributedBackupDescriptor, now time.Time) []string {
+	if schedule.Retention.IsZero() {
+		return nil
+	}
+
+	type owned struct {
+		id     string
+		at     time.Time
+		status backup.Status
+	}
+	var candidates []owned
+	bases := make(map[string]struct{})
+	for _, b := range backups {
+		if b.IncrementalBaseBackupID != "" {
+			bases[b.IncrementalBaseBackupID] = struct{}{}
+		}
+		if backupNotCompleted(b.Status) {
+			continue
+		}
+		if at, ok := schedule.Owns(b.ID); ok {
+			candidates = append(candidates, owned{b.ID, at, b.Status})
+		}
+	}
+	sort.Slice(candidates, func(i, j int) bool { return candidates[i].at.After(candidates[j].at) })
+
+	var (
+		expired []string
+		kept    int
+		cutoff  = now.AddDate(0, 0, -schedule.Retention.KeepDays)
+	)
+	for _, c := range candidates {
+		if c.status == backup.Success && kept < schedule.Retention.KeepLast {
+			kept++
+			continue
+		}
+		if schedule.Retention.KeepDays > 0 && c.at.After(cutoff) {
+			continue
+		}
+		if _, ok := bases[c.id]; ok {
+			continue
+		}
+		expired = append(expired, c.id)
+	}
+	return expired
+}
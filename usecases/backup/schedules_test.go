@@ -0,0 +1,130 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package backup
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/backup"
+)
+
+func TestSchedulerSchedules(t *testing.T) {
+	var (
+		ctx = context.Background()
+		fs  = newFakeScheduler(nil)
+		s   = fs.scheduler()
+	)
+
+	t.Run("invalid cron", func(t *testing.T) {
+		err := s.CreateSchedule(ctx, nil, backup.Schedule{Name: "nightly", Cron: "0 3 * *", Backend: "s3"})
+		assert.ErrorAs(t, err, &backup.ErrUnprocessable{})
+	})
+
+	t.Run("unknown backend", func(t *testing.T) {
+		fs := newFakeScheduler(nil)
+		fs.backendErr = errors.New("not found")
+		err := fs.scheduler().CreateSchedule(ctx, nil, backup.Schedule{Name: "nightly", Cron: "0 3 * * *", Backend: "s3"})
+		assert.ErrorAs(t, err, &backup.ErrUnprocessable{})
+	})
+
+	t.Run("create", func(t *testing.T) {
+		err := s.CreateSchedule(ctx, nil, backup.Schedule{
+			Name: "nightly", Cron: "0 3 * * *", Backend: "s3",
+			Retention: backup.Retention{KeepLast: 7},
+			LastRun:   time.Now(),
+		})
+		require.Nil(t, err)
+
+		schedules, err := s.Schedules(ctx, nil)
+		require.Nil(t, err)
+		require.Len(t, schedules, 1)
+		assert.Equal(t, "nightly", schedules[0].Name)
+		assert.Equal(t, 7, schedules[0].Retention.KeepLast)
+		assert.False(t, schedules[0].CreatedAt.IsZero())
+		assert.True(t, schedules[0].LastRun.IsZero())
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		require.Nil(t, s.DeleteSchedule(ctx, nil, "nightly"))
+		schedules, err := s.Schedules(ctx, nil)
+		require.Nil(t, err)
+		assert.Empty(t, schedules)
+
+		err = s.DeleteSchedule(ctx, nil, "nightly")
+		assert.ErrorAs(t, err, &backup.ErrNotFound{})
+	})
+}
+
+func TestExpiredBackups(t *testing.T) {
+	now := time.Date(2024, 5, 15, 12, 0, 0, 0, time.UTC)
+	s := backup.Schedule{Name: "daily"}
+	day := func(n int) time.Time { return now.AddDate(0, 0, -n) }
+	desc := func(at time.Time, status backup.Status) *backup.DistributedBackupDescriptor {
+		return &backup.DistributedBackupDescriptor{ID: s.BackupID(at), Status: status}
+	}
+
+	backups := []*backup.DistributedBackupDescriptor{
+		desc(day(0), backup.Started),
+		desc(day(1), backup.Failed),
+		desc(day(2), backup.Success),
+		desc(day(3), backup.Success),
+		desc(day(4), backup.Success),
+		desc(day(5), backup.Success),
+		{ID: "manual", Status: backup.Success},
+		{ID: "other-20240101000000", Status: backup.Success},
+	}
+
+	tests := []struct {
+		name      string
+		retention backup.Retention
+		want      []string
+	}{
+		{
+			name: "no retention",
+		},
+		{
+			name:      "keep last",
+			retention: backup.Retention{KeepLast: 2},
+			want:      []string{s.BackupID(day(1)), s.BackupID(day(4)), s.BackupID(day(5))},
+		},
+		{
+			name:      "keep days",
+			retention: backup.Retention{KeepDays: 3},
+			want:      []string{s.BackupID(day(3)), s.BackupID(day(4)), s.BackupID(day(5))},
+		},
+		{
+			name:      "keep last or days",
+			retention: backup.Retention{KeepLast: 3, KeepDays: 2},
+			want:      []string{s.BackupID(day(5))},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			s.Retention = tc.retention
+			assert.Equal(t, tc.want, expiredBackups(s, backups, now))
+		})
+	}
+
+	t.Run("incremental base is kept", func(t *testing.T) {
+		s.Retention = backup.Retention{KeepLast: 1}
+		incremental := desc(day(2), backup.Success)
+		incremental.ID = "manual-incremental"
+		incremental.IncrementalBaseBackupID = s.BackupID(day(4))
+		got := expiredBackups(s, append(backups, incremental), now)
+		assert.Equal(t, []string{s.BackupID(day(1)), s.BackupID(day(3)), s.BackupID(day(5))}, got)
+	})
+}
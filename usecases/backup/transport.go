@@ -54,6 +54,15 @@ type Request struct {
 
 	// Additional path prefix override
 	Path string
+
+	// IncrementalBaseBackupID is the id of the backup an incremental backup builds upon
+	IncrementalBaseBackupID string
+
+	// PointInTime up to which shipped commit logs are replayed on restore
+	PointInTime time.Time
+
+	// Encrypt backup files with the configured encryption key
+	Encrypt bool
 }
 
 type CanCommitResponse struct {
@@ -25,6 +25,7 @@ import (
 	"sync/atomic"
 	"time"
 
+	"github.com/klauspost/compress/zstd"
 	"github.com/weaviate/weaviate/entities/backup"
 )
 
@@ -37,37 +38,116 @@ const (
 	BestCompression
 )
 
+// CompressionCodec represents supported compression algorithms
+type CompressionCodec string
+
+const (
+	CodecGzip CompressionCodec = "gzip"
+	CodecZstd CompressionCodec = "zstd"
+	CodecNone CompressionCodec = "none"
+)
+
+// compressor compresses chunks. Flush makes all data written so far visible to the reader.
+type compressor interface {
+	io.WriteCloser
+	Flush() error
+}
+
 type zip struct {
 	sourcePath string
 	w          *tar.Writer
-	gzw        *gzip.Writer
+	gzw        compressor
+	enc        *encryptWriter // set if chunks are encrypted
 	pipeWriter *io.PipeWriter
 	counter    func() int64
 }
 
-func NewZip(sourcePath string, level int) (zip, io.ReadCloser) {
+// NewZip creates a tarball of files in sourcePath compressed with codec.
+// The tarball is encrypted if a data key is passed.
+func NewZip(sourcePath string, level int, codec CompressionCodec, key []byte) (zip, io.ReadCloser, error) {
 	pr, pw := io.Pipe()
-	gzw, _ := gzip.NewWriterLevel(pw, zipLevel(level))
 	reader := &readCloser{src: pr, n: 0}
-
-	return zip{
+	z := zip{
 		sourcePath: sourcePath,
-		gzw:        gzw,
-		w:          tar.NewWriter(gzw),
 		pipeWriter: pw,
 		counter:    reader.counter(),
-	}, reader
+	}
+
+	var w io.Writer = pw
+	if key != nil {
+		enc, err := newEncryptWriter(pw, key)
+		if err != nil {
+			pw.Close()
+			return z, nil, fmt.Errorf("init encryption: %w", err)
+		}
+		z.enc, w = enc, enc
+	}
+	gzw, err := newCompressor(w, level, codec)
+	if err != nil {
+		pw.Close()
+		return z, nil, err
+	}
+	z.gzw = gzw
+	z.w = tar.NewWriter(gzw)
+	return z, reader, nil
 }
 
+func newCompressor(w io.Writer, level int, codec CompressionCodec) (compressor, error) {
+	switch codec {
+	case CodecZstd:
+		zw, err := zstd.NewWriter(w, zstd.WithEncoderLevel(zstdLevel(level)), zstd.WithEncoderConcurrency(1))
+		if err != nil {
+			return nil, fmt.Errorf("zstd.NewWriter: %w", err)
+		}
+		return zw, nil
+	case CodecNone:
+		return nopCompressor{w}, nil
+	case CodecGzip, "":
+		gzw, _ := gzip.NewWriterLevel(w, zipLevel(level))
+		return gzw, nil
+	default:
+		return nil, fmt.Errorf("unsupported compression codec %q", codec)
+	}
+}
+
+func newDecompressor(r io.Reader, codec CompressionCodec) (io.ReadCloser, error) {
+	switch codec {
+	case CodecZstd:
+		zr, err := zstd.NewReader(r, zstd.WithDecoderConcurrency(1))
+		if err != nil {
+			return nil, fmt.Errorf("zstd.NewReader: %w", err)
+		}
+		return zr.IOReadCloser(), nil
+	case CodecNone:
+		return io.NopCloser(r), nil
+	case CodecGzip, "":
+		gz, err := gzip.NewReader(r)
+		if err != nil {
+			return nil, fmt.Errorf("gzip.NewReader: %w", err)
+		}
+		return gz, nil
+	default:
+		return nil, fmt.Errorf("unsupported compression codec %q", codec)
+	}
+}
+
+type nopCompressor struct{ io.Writer }
+
+func (nopCompressor) Flush() error { return nil }
+func (nopCompressor) Close() error { return nil }
+
 func (z *zip) Close() error {
-	var err1, err2, err3 error
+	var err1, err2, err3, err4 error
 	err1 = z.w.Close()
 	err2 = z.gzw.Close()
+	if z.enc != nil {
+		err3 = z.enc.Close()
+	}
 	if err := z.pipeWriter.Close(); err != nil && err != io.ErrClosedPipe {
-		err3 = err
+		err4 = err
 	}
-	if err1 != nil || err2 != nil || err3 != nil {
-		return fmt.Errorf("tar: %w, gzip: %w, pw: %w", err1, err2, err3)
+	if err1 != nil || err2 != nil || err3 != nil || err4 != nil {
+		return fmt.Errorf("tar: %w, compress: %w, encrypt: %w, pw: %w", err1, err2, err3, err4)
 	}
 	return nil
 }
@@ -176,15 +256,21 @@ func (z *zip) lastWritten() int64 {
 
 type unzip struct {
 	destPath   string
-	gzr        *gzip.Reader
+	codec      CompressionCodec
+	key        []byte // data key if chunks are encrypted
+	gzr        io.ReadCloser
 	r          *tar.Reader
 	pipeReader *io.PipeReader
+	files      map[string]struct{} // if set, only these files are extracted
 }
 
-func NewUnzip(dst string) (unzip, io.WriteCloser) {
+// NewUnzip extracts a tarball created by NewZip with the same codec and key into dst
+func NewUnzip(dst string, codec CompressionCodec, key []byte) (unzip, io.WriteCloser) {
 	pr, pw := io.Pipe()
 	return unzip{
 		destPath:   dst,
+		codec:      codec,
+		key:        key,
 		pipeReader: pr,
 	}, pw
 }
@@ -193,9 +279,17 @@ func (u *unzip) init() error {
 	if u.gzr != nil {
 		return nil
 	}
-	gz, err := gzip.NewReader(u.pipeReader)
+	var r io.Reader = u.pipeReader
+	if u.key != nil {
+		dr, err := newDecryptReader(r, u.key)
+		if err != nil {
+			return fmt.Errorf("init decryption: %w", err)
+		}
+		r = dr
+	}
+	gz, err := newDecompressor(r, u.codec)
 	if err != nil {
-		return fmt.Errorf("gzip.NewReader: %w", err)
+		return err
 	}
 	u.gzr = gz
 	u.r = tar.NewReader(gz)
@@ -211,7 +305,7 @@ func (u *unzip) Close() (err error) {
 		err2 = u.gzr.Close()
 	}
 	if err1 != nil || err2 != nil {
-		return fmt.Errorf("close pr: %w, gunzip: %w", err1, err2)
+		return fmt.Errorf("close pr: %w, decompress: %w", err1, err2)
 	}
 
 	return nil
@@ -226,6 +320,11 @@ func (u *unzip) ReadChunk() (written int64, err error) {
 		header, err := u.r.Next()
 		if err != nil {
 			if err == io.EOF { // end of the loop
+				// consume the rest of the stream, which also
+				// authenticates the end of encrypted chunks
+				if _, err := io.Copy(io.Discard, u.gzr); err != nil {
+					return written, fmt.Errorf("read chunk trailer: %w", err)
+				}
 				return written, nil
 			}
 			return written, fmt.Errorf("fetch next: %w", err)
@@ -242,6 +341,9 @@ func (u *unzip) ReadChunk() (written int64, err error) {
 				return written, fmt.Errorf("crateDir %s: %w", target, err)
 			}
 		case tar.TypeReg:
+			if _, ok := u.files[header.Name]; u.files != nil && !ok {
+				continue
+			}
 			if pp := filepath.Dir(target); pp != parentPath {
 				parentPath = pp
 				if err := os.MkdirAll(parentPath, 0o755); err != nil {
@@ -316,8 +418,20 @@ func zipLevel(level int) int {
 	}
 }
 
+func zstdLevel(level int) zstd.EncoderLevel {
+	switch CompressionLevel(level) {
+	case BestSpeed:
+		return zstd.SpeedFastest
+	case BestCompression:
+		return zstd.SpeedBestCompression
+	default:
+		return zstd.SpeedDefault
+	}
+}
+
 type zipConfig struct {
 	Level      int
+	Codec      CompressionCodec
 	GoPoolSize int
 	ChunkSize  int
 }
@@ -336,6 +450,7 @@ func newZipConfig(c Compression) zipConfig {
 
 	return zipConfig{
 		Level:      int(c.Level),
+		Codec:      c.Codec,
 		GoPoolSize: routinePoolSize(c.CPUPercentage),
 		ChunkSize:  c.ChunkSize,
 	}
@@ -26,6 +26,24 @@ import (
 )
 
 func TestZip(t *testing.T) {
+	key := bytes.Repeat([]byte{1}, dataKeySize)
+	for _, tc := range []struct {
+		codec CompressionCodec
+		key   []byte
+	}{
+		{CodecGzip, nil},
+		{CodecZstd, nil},
+		{CodecNone, nil},
+		{CodecGzip, key},
+		{CodecZstd, key},
+	} {
+		t.Run(fmt.Sprintf("%s encrypted=%v", tc.codec, tc.key != nil), func(t *testing.T) {
+			testZip(t, tc.codec, tc.key)
+		})
+	}
+}
+
+func testZip(t *testing.T, codec CompressionCodec, key []byte) {
 	var (
 		pathNode = "test_data/node1"
 		pathDest = "./test_data/node-unzipped"
@@ -41,7 +59,10 @@ func TestZip(t *testing.T) {
 
 	// compression writer
 	compressBuf := bytes.NewBuffer(make([]byte, 0, 1000_000))
-	z, rc := NewZip(pathNode, 0)
+	z, rc, err := NewZip(pathNode, 0, codec, key)
+	if err != nil {
+		t.Fatal(err)
+	}
 	var zInputLen int64
 	go func() {
 		zInputLen, err = z.WriteShard(ctx, &sd)
@@ -65,7 +86,7 @@ func TestZip(t *testing.T) {
 	fmt.Printf("compression input_size=%d output_size=%d factor=%v\n", zInputLen, zOutputLen, f)
 	os.RemoveAll(pathDest)
 	// decompression
-	uz, wc := NewUnzip(pathDest)
+	uz, wc := NewUnzip(pathDest, codec, key)
 
 	// decompression reader
 	var uzInputLen int64
@@ -28,6 +28,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/aggregation"
+	"github.com/weaviate/weaviate/entities/facets"
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
@@ -428,11 +429,18 @@ func (f *fakeRemoteClient) SearchShard(ctx context.Context, hostName, indexName,
 	shardName string, vector [][]float32, targetVector []string, limit int, filters *filters.LocalFilter,
 	keywordRanking *searchparams.KeywordRanking, sort []filters.Sort,
 	cursor *filters.Cursor, groupBy *searchparams.GroupBy, additional additional.Properties, targetCombination *dto.TargetCombination,
-	properties []string,
+	properties []string, matryoshkaDims int,
 ) ([]*storobj.Object, []float32, error) {
 	return nil, nil, nil
 }
 
+func (f *fakeRemoteClient) SearchShardBatch(ctx context.Context, hostName, indexName,
+	shardName string, searchVectors [][]float32, targetVector string, distance float32,
+	limit int, filters *filters.LocalFilter, additional additional.Properties, properties []string,
+) ([][]*storobj.Object, [][]float32, error) {
+	return nil, nil, nil
+}
+
 func (f *fakeRemoteClient) BatchPutObjects(ctx context.Context, hostName, indexName, shardName string, objs []*storobj.Object, repl *additional.ReplicationProperties, schemaVersion uint64) []error {
 	return nil
 }
@@ -455,6 +463,12 @@ func (f *fakeRemoteClient) Aggregate(ctx context.Context, hostName, indexName,
 	return nil, nil
 }
 
+func (f *fakeRemoteClient) Facets(ctx context.Context, hostName, indexName,
+	shardName string, params facets.Params,
+) ([]facets.Result, error) {
+	return nil, nil
+}
+
 func (f *fakeRemoteClient) FindUUIDs(ctx context.Context, hostName, indexName, shardName string,
 	filters *filters.LocalFilter,
 ) ([]strfmt.UUID, error) {
@@ -231,6 +231,8 @@ type Persistence struct {
 	LSMSegmentsCleanupIntervalSeconds int    `json:"lsmSegmentsCleanupIntervalSeconds" yaml:"lsmSegmentsCleanupIntervalSeconds"`
 	LSMSeparateObjectsCompactions     bool   `json:"lsmSeparateObjectsCompactions" yaml:"lsmSeparateObjectsCompactions"`
 	HNSWMaxLogSize                    int64  `json:"hnswMaxLogSize" yaml:"hnswMaxLogSize"`
+	LogArchivingEnabled               bool   `json:"logArchivingEnabled" yaml:"logArchivingEnabled"`
+	LogShippingIntervalSeconds        int    `json:"logShippingIntervalSeconds" yaml:"logShippingIntervalSeconds"`
 }
 
 // DefaultPersistenceDataPath is the default location for data directory when no location is provided
@@ -247,6 +249,10 @@ const DefaultPersistenceLSMSegmentsCleanupIntervalSeconds = 0
 
 const DefaultPersistenceHNSWMaxLogSize = 500 * 1024 * 1024 // 500MB for backward compatibility
 
+// DefaultPersistenceLogShippingIntervalSeconds is how often archived commit
+// logs are shipped to the backend of the latest backup
+const DefaultPersistenceLogShippingIntervalSeconds = 60
+
 // MetadataServer is experimental.
 type MetadataServer struct {
 	// When enabled startup will include a "metadata server"
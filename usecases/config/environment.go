@@ -248,6 +248,18 @@ func FromEnv(config *Config) error {
 		config.Persistence.LSMSeparateObjectsCompactions = true
 	}
 
+	if entcfg.Enabled(os.Getenv("PERSISTENCE_LOG_ARCHIVING_ENABLED")) {
+		config.Persistence.LogArchivingEnabled = true
+	}
+
+	if err := parsePositiveInt(
+		"PERSISTENCE_LOG_SHIPPING_INTERVAL_SECONDS",
+		func(seconds int) { config.Persistence.LogShippingIntervalSeconds = seconds },
+		DefaultPersistenceLogShippingIntervalSeconds,
+	); err != nil {
+		return err
+	}
+
 	if v := os.Getenv("PERSISTENCE_HNSW_MAX_LOG_SIZE"); v != "" {
 		parsed, err := parseResourceString(v)
 		if err != nil {
@@ -516,6 +516,10 @@ func (m *dummyBackupModuleWithAltNames) AllBackups(context.Context) ([]*backup.D
 	return nil, nil
 }
 
+func (m *dummyBackupModuleWithAltNames) Delete(ctx context.Context, backupID, overrideBucket, overridePath string) error {
+	return nil
+}
+
 func (m *dummyBackupModuleWithAltNames) GetObject(ctx context.Context, backupID, key, overrideBucket, overridePath string) ([]byte, error) {
 	return nil, nil
 }
@@ -34,6 +34,7 @@ type MergeDocument struct {
 	References           BatchReferences             `json:"references"`
 	Vector               []float32                   `json:"vector"`
 	Vectors              models.Vectors              `json:"vectors"`
+	SparseVectors        models.SparseVectors        `json:"sparseVectors"`
 	UpdateTime           int64                       `json:"updateTime"`
 	AdditionalProperties models.AdditionalProperties `json:"additionalProperties"`
 	PropertiesToDelete   []string                    `json:"propertiesToDelete"`
@@ -125,6 +126,7 @@ func (m *Manager) patchObject(ctx context.Context, principal *models.Principal,
 		References:         refs,
 		Vector:             objWithVec.Vector,
 		Vectors:            objWithVec.Vectors,
+		SparseVectors:      updates.SparseVectors,
 		UpdateTime:         m.timeSource.Now(),
 		PropertiesToDelete: propertiesToDelete,
 	}
@@ -25,6 +25,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted/stopwords"
 	"github.com/weaviate/weaviate/entities/backup"
 	"github.com/weaviate/weaviate/entities/classcache"
@@ -341,6 +342,7 @@ func (h *Handler) setClassDefaults(class *models.Class, globalCfg replication.Gl
 	}
 
 	setInvertedConfigDefaults(class)
+	setPropertyAnalyzerTokenization(class, class.Properties...)
 	for _, prop := range class.Properties {
 		setPropertyDefaults(prop)
 	}
@@ -393,6 +395,34 @@ func setPropertyDefaultTokenization(props ...*models.Property) {
 	}
 }
 
+// setPropertyAnalyzerTokenization defaults the tokenization of properties with
+// a custom analyzer to the tokenizer of the analyzer
+func setPropertyAnalyzerTokenization(class *models.Class, props ...*models.Property) {
+	for _, prop := range props {
+		if prop.Analyzer == "" || prop.Tokenization != "" {
+			continue
+		}
+		if dt, _ := schema.AsPrimitive(prop.DataType); dt != schema.DataTypeText && dt != schema.DataTypeTextArray {
+			continue
+		}
+		if analyzer := findAnalyzer(class, prop.Analyzer); analyzer != nil {
+			prop.Tokenization = analyzer.Tokenizer
+		}
+	}
+}
+
+func findAnalyzer(class *models.Class, name string) *models.TextAnalyzerConfig {
+	if class.InvertedIndexConfig == nil {
+		return nil
+	}
+	for _, analyzer := range class.InvertedIndexConfig.Analyzers {
+		if analyzer != nil && analyzer.Name == name {
+			return analyzer
+		}
+	}
+	return nil
+}
+
 func setPropertyDefaultIndexing(props ...*models.Property) {
 	for _, prop := range props {
 		// if IndexInverted is set but IndexFilterable and IndexSearchable are not
@@ -590,6 +620,10 @@ func (h *Handler) validateProperty(
 			return err
 		}
 
+		if err := validatePropertyAnalyzer(class, property, propertyDataType); err != nil {
+			return err
+		}
+
 		if err := h.validatePropertyIndexing(property); err != nil {
 			return err
 		}
@@ -633,6 +667,15 @@ func (h *Handler) validateCanAddClass(
 		return err
 	}
 
+	if class.InvertedIndexConfig != nil {
+		if err := inverted.ValidateTextAnalyzers(class.InvertedIndexConfig); err != nil {
+			return err
+		}
+		if err := inverted.ValidateSynonyms(class.InvertedIndexConfig.Synonyms); err != nil {
+			return err
+		}
+	}
+
 	existingPropertyNames := map[string]bool{}
 	for _, property := range class.Properties {
 		if err := h.validateProperty(class, existingPropertyNames, relaxCrossRefValidation, classGetterWithAuth, property); err != nil {
@@ -641,6 +684,10 @@ func (h *Handler) validateCanAddClass(
 		existingPropertyNames[strings.ToLower(property.Name)] = true
 	}
 
+	if err := validateIndexPositions(class); err != nil {
+		return err
+	}
+
 	if err := h.validateVectorSettings(class); err != nil {
 		return err
 	}
@@ -713,6 +760,61 @@ func (h *Handler) validatePropertyTokenization(tokenization string, propertyData
 	return fmt.Errorf("Tokenization is not allowed for reference data type")
 }
 
+func validatePropertyAnalyzer(class *models.Class, prop *models.Property,
+	propertyDataType schema.PropertyDataType,
+) error {
+	if prop.Analyzer == "" {
+		return nil
+	}
+	switch propertyDataType.AsPrimitive() {
+	case schema.DataTypeText, schema.DataTypeTextArray:
+	default:
+		return fmt.Errorf("property '%s': analyzer is only allowed for text/text[] data types", prop.Name)
+	}
+
+	analyzer := findAnalyzer(class, prop.Analyzer)
+	if analyzer == nil {
+		return fmt.Errorf("property '%s': analyzer '%s' is not defined in invertedIndexConfig.analyzers",
+			prop.Name, prop.Analyzer)
+	}
+	if prop.Tokenization != analyzer.Tokenizer {
+		return fmt.Errorf("property '%s': tokenization '%s' does not match tokenizer '%s' of analyzer '%s'",
+			prop.Name, prop.Tokenization, analyzer.Tokenizer, prop.Analyzer)
+	}
+	return nil
+}
+
+// validateIndexPositions checks that positions are only stored for
+// properties with a searchable index
+func validateIndexPositions(class *models.Class) error {
+	if class.InvertedIndexConfig == nil {
+		return nil
+	}
+
+	seen := map[string]struct{}{}
+	for _, name := range class.InvertedIndexConfig.IndexPositions {
+		if _, ok := seen[name]; ok {
+			return fmt.Errorf("invertedIndexConfig.indexPositions: property '%s' is listed more than once", name)
+		}
+		seen[name] = struct{}{}
+
+		var prop *models.Property
+		for _, p := range class.Properties {
+			if p.Name == name {
+				prop = p
+				break
+			}
+		}
+		if prop == nil {
+			return fmt.Errorf("invertedIndexConfig.indexPositions: property '%s' does not exist", name)
+		}
+		if !inverted.HasSearchableIndex(prop) {
+			return fmt.Errorf("invertedIndexConfig.indexPositions: property '%s' is not searchable", name)
+		}
+	}
+	return nil
+}
+
 func (h *Handler) validatePropertyIndexing(prop *models.Property) error {
 	if prop.IndexInverted != nil {
 		if prop.IndexFilterable != nil || prop.IndexSearchable != nil || prop.IndexRangeFilters != nil {
@@ -762,6 +864,9 @@ func (h *Handler) validateVectorSettings(class *models.Class) error {
 		if err := h.validateVectorIndexType(class.VectorIndexType); err != nil {
 			return err
 		}
+		if class.VectorIndexType == vectorindex.VectorIndexTypeSPARSE {
+			return fmt.Errorf("vectorIndexType %q is only supported for named vectors", class.VectorIndexType)
+		}
 		return nil
 	}
 
@@ -785,6 +890,14 @@ func (h *Handler) validateVectorSettings(class *models.Class) error {
 		if err := h.validateVectorIndexType(cfg.VectorIndexType); err != nil {
 			return fmt.Errorf("target vector %q: %w", name, err)
 		}
+		if cfg.VectorIndexType == vectorindex.VectorIndexTypeSPARSE {
+			// no module creates sparse vectors, they are always provided by the user
+			vm, ok := cfg.Vectorizer.(map[string]interface{})
+			if _, isNone := vm[config.VectorizerModuleNone]; !ok || len(vm) != 1 || !isNone {
+				return fmt.Errorf("target vector %q: vectorIndexType %q requires vectorizer %q",
+					name, cfg.VectorIndexType, config.VectorizerModuleNone)
+			}
+		}
 	}
 	return nil
 }
@@ -803,7 +916,8 @@ func (h *Handler) validateVectorizer(vectorizer string) error {
 
 func (h *Handler) validateVectorIndexType(vectorIndexType string) error {
 	switch vectorIndexType {
-	case vectorindex.VectorIndexTypeHNSW, vectorindex.VectorIndexTypeFLAT, vectorindex.VectorIndexTypeDYNAMIC:
+	case vectorindex.VectorIndexTypeHNSW, vectorindex.VectorIndexTypeFLAT, vectorindex.VectorIndexTypeDYNAMIC,
+		vectorindex.VectorIndexTypeIVF, vectorindex.VectorIndexTypeVAMANA, vectorindex.VectorIndexTypeSPARSE:
 		return nil
 	default:
 		return errors.Errorf("unrecognized or unsupported vectorIndexType %q",
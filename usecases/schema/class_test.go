@@ -28,6 +28,7 @@ import (
 	"github.com/weaviate/weaviate/entities/replication"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/entities/vectorindex/sparse"
 	"github.com/weaviate/weaviate/usecases/cluster/mocks"
 	"github.com/weaviate/weaviate/usecases/config"
 	"github.com/weaviate/weaviate/usecases/sharding"
@@ -389,6 +390,134 @@ func Test_AddClass(t *testing.T) {
 			}},
 		})
 		assert.EqualError(t, err, "target vector \"custom\": vectorizer: invalid vectorizer \"invalid\"")
+
+		// VectorConfig is sparse with a vectorizer module
+		_, _, err = handler.AddClass(ctx, nil, &models.Class{
+			Class: "NewClass",
+			VectorConfig: map[string]models.VectorConfig{"custom": {
+				VectorIndexType:   "sparse",
+				VectorIndexConfig: sparse.UserConfig{},
+				Vectorizer:        map[string]interface{}{"model1": map[string]interface{}{}},
+			}},
+		})
+		assert.EqualError(t, err, "target vector \"custom\": vectorIndexType \"sparse\" requires vectorizer \"none\"")
+	})
+
+	t.Run("with analyzers", func(t *testing.T) {
+		newClass := func(props ...*models.Property) *models.Class {
+			return &models.Class{
+				Class:      "NewClass",
+				Vectorizer: "none",
+				InvertedIndexConfig: &models.InvertedIndexConfig{
+					Analyzers: []*models.TextAnalyzerConfig{{
+						Name:      "stemmed",
+						Tokenizer: models.PropertyTokenizationLowercase,
+						TokenFilters: []*models.TextAnalyzerTokenFilter{
+							{Type: models.TextAnalyzerTokenFilterTypeStemmer},
+						},
+					}},
+				},
+				Properties: props,
+			}
+		}
+
+		t.Run("tokenization defaults to the tokenizer", func(t *testing.T) {
+			handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+			fakeSchemaManager.On("AddClass", mock.Anything, mock.Anything).Return(nil)
+
+			class := newClass(&models.Property{Name: "title", DataType: schema.DataTypeText.PropString(), Analyzer: "stemmed"})
+			_, _, err := handler.AddClass(ctx, nil, class)
+			require.Nil(t, err)
+			assert.Equal(t, models.PropertyTokenizationLowercase, class.Properties[0].Tokenization)
+		})
+
+		for name, tc := range map[string]struct {
+			class          *models.Class
+			expectedErrMsg string
+		}{
+			"unknown analyzer": {
+				class:          newClass(&models.Property{Name: "title", DataType: schema.DataTypeText.PropString(), Analyzer: "unknown"}),
+				expectedErrMsg: "property 'title': analyzer 'unknown' is not defined in invertedIndexConfig.analyzers",
+			},
+			"tokenization mismatch": {
+				class: newClass(&models.Property{
+					Name: "title", DataType: schema.DataTypeText.PropString(), Analyzer: "stemmed",
+					Tokenization: models.PropertyTokenizationWord,
+				}),
+				expectedErrMsg: "property 'title': tokenization 'word' does not match tokenizer 'lowercase' of analyzer 'stemmed'",
+			},
+			"non-text property": {
+				class:          newClass(&models.Property{Name: "count", DataType: schema.DataTypeInt.PropString(), Analyzer: "stemmed"}),
+				expectedErrMsg: "property 'count': analyzer is only allowed for text/text[] data types",
+			},
+			"invalid analyzer": {
+				class: &models.Class{
+					Class:      "NewClass",
+					Vectorizer: "none",
+					InvertedIndexConfig: &models.InvertedIndexConfig{
+						Analyzers: []*models.TextAnalyzerConfig{{Name: "broken", Tokenizer: "unknown"}},
+					},
+				},
+				expectedErrMsg: `analyzer "broken": tokenizer "unknown" is not supported`,
+			},
+		} {
+			t.Run(name, func(t *testing.T) {
+				handler, _ := newTestHandler(t, &fakeDB{})
+				_, _, err := handler.AddClass(ctx, nil, tc.class)
+				assert.EqualError(t, err, tc.expectedErrMsg)
+			})
+		}
+	})
+
+	t.Run("with invalid synonyms", func(t *testing.T) {
+		handler, _ := newTestHandler(t, &fakeDB{})
+		_, _, err := handler.AddClass(ctx, nil, &models.Class{
+			Class:      "NewClass",
+			Vectorizer: "none",
+			InvertedIndexConfig: &models.InvertedIndexConfig{
+				Synonyms: &models.SynonymConfig{Groups: []string{"laptop"}},
+			},
+		})
+		assert.EqualError(t, err, `synonym group "laptop" requires at least two tokens`)
+	})
+
+	t.Run("with index positions", func(t *testing.T) {
+		vFalse := false
+		properties := func() []*models.Property {
+			return []*models.Property{
+				{Name: "title", DataType: schema.DataTypeText.PropString()},
+				{Name: "tags", DataType: schema.DataTypeText.PropString(), IndexSearchable: &vFalse},
+				{Name: "count", DataType: schema.DataTypeInt.PropString()},
+			}
+		}
+
+		for indexPositions, expectedErr := range map[string]string{
+			"title":       "",
+			"title,title": "invertedIndexConfig.indexPositions: property 'title' is listed more than once",
+			"body":        "invertedIndexConfig.indexPositions: property 'body' does not exist",
+			"tags":        "invertedIndexConfig.indexPositions: property 'tags' is not searchable",
+			"count":       "invertedIndexConfig.indexPositions: property 'count' is not searchable",
+		} {
+			t.Run(indexPositions, func(t *testing.T) {
+				handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+				if expectedErr == "" {
+					fakeSchemaManager.On("AddClass", mock.Anything, mock.Anything).Return(nil)
+				}
+				_, _, err := handler.AddClass(ctx, nil, &models.Class{
+					Class:      "NewClass",
+					Vectorizer: "none",
+					Properties: properties(),
+					InvertedIndexConfig: &models.InvertedIndexConfig{
+						IndexPositions: strings.Split(indexPositions, ","),
+					},
+				})
+				if expectedErr == "" {
+					assert.Nil(t, err)
+				} else {
+					assert.EqualError(t, err, expectedErr)
+				}
+			})
+		}
 	})
 }
 
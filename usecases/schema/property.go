@@ -107,6 +107,7 @@ func (h *Handler) DeleteClassProperty(ctx context.Context, principal *models.Pri
 }
 
 func (h *Handler) setNewPropDefaults(class *models.Class, props ...*models.Property) error {
+	setPropertyAnalyzerTokenization(class, props...)
 	setPropertyDefaults(props...)
 	h.moduleConfig.SetSinglePropertyDefaults(class, props...)
 	return nil
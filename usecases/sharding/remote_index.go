@@ -27,6 +27,7 @@ import (
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/aggregation"
 	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/facets"
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/search"
 	"github.com/weaviate/weaviate/entities/searchparams"
@@ -86,10 +87,17 @@ type RemoteIndexClient interface {
 		keywordRanking *searchparams.KeywordRanking, sort []filters.Sort,
 		cursor *filters.Cursor, groupBy *searchparams.GroupBy,
 		additional additional.Properties, targetCombination *dto.TargetCombination, properties []string,
+		matryoshkaDims int,
 	) ([]*storobj.Object, []float32, error)
+	SearchShardBatch(ctx context.Context, hostname, indexName, shardName string,
+		searchVectors [][]float32, targetVector string, distance float32, limit int,
+		filters *filters.LocalFilter, additional additional.Properties, properties []string,
+	) ([][]*storobj.Object, [][]float32, error)
 
 	Aggregate(ctx context.Context, hostname, indexName, shardName string,
 		params aggregation.Params) (*aggregation.Result, error)
+	Facets(ctx context.Context, hostname, indexName, shardName string,
+		params facets.Params) ([]facets.Result, error)
 	FindUUIDs(ctx context.Context, hostName, indexName, shardName string,
 		filters *filters.LocalFilter) ([]strfmt.UUID, error)
 	DeleteObjectBatch(ctx context.Context, hostName, indexName, shardName string,
@@ -267,10 +275,11 @@ func (ri *RemoteIndex) SearchAllReplicas(ctx context.Context,
 	localNode string,
 	targetCombination *dto.TargetCombination,
 	properties []string,
+	matryoshkaDims int,
 ) ([]ReplicasSearchResult, error) {
 	remoteShardQuery := func(node, host string) (ReplicasSearchResult, error) {
 		objs, scores, err := ri.client.SearchShard(ctx, host, ri.class, shard,
-			queryVec, targetVector, limit, filters, keywordRanking, sort, cursor, groupBy, adds, targetCombination, properties)
+			queryVec, targetVector, limit, filters, keywordRanking, sort, cursor, groupBy, adds, targetCombination, properties, matryoshkaDims)
 		if err != nil {
 			return ReplicasSearchResult{}, err
 		}
@@ -292,6 +301,7 @@ func (ri *RemoteIndex) SearchShard(ctx context.Context, shard string,
 	replEnabled bool,
 	targetCombination *dto.TargetCombination,
 	properties []string,
+	matryoshkaDims int,
 ) ([]*storobj.Object, []float32, string, error) {
 	type pair struct {
 		first  []*storobj.Object
@@ -299,7 +309,39 @@ func (ri *RemoteIndex) SearchShard(ctx context.Context, shard string,
 	}
 	f := func(node, host string) (interface{}, error) {
 		objs, scores, err := ri.client.SearchShard(ctx, host, ri.class, shard,
-			queryVec, targetVector, limit, filters, keywordRanking, sort, cursor, groupBy, adds, targetCombination, properties)
+			queryVec, targetVector, limit, filters, keywordRanking, sort, cursor, groupBy, adds, targetCombination, properties, matryoshkaDims)
+		if err != nil {
+			return nil, err
+		}
+		return pair{objs, scores}, err
+	}
+	rr, node, err := ri.queryReplicas(ctx, shard, f)
+	if err != nil {
+		return nil, nil, node, err
+	}
+	r := rr.(pair)
+	return r.first, r.second, node, err
+}
+
+// SearchShardBatch runs several near vector queries against the same
+// target vector of a remote shard in a single request. The result holds one
+// list of objects and distances per query vector.
+func (ri *RemoteIndex) SearchShardBatch(ctx context.Context, shard string,
+	searchVectors [][]float32,
+	targetVector string,
+	distance float32,
+	limit int,
+	filters *filters.LocalFilter,
+	adds additional.Properties,
+	properties []string,
+) ([][]*storobj.Object, [][]float32, string, error) {
+	type pair struct {
+		first  [][]*storobj.Object
+		second [][]float32
+	}
+	f := func(node, host string) (interface{}, error) {
+		objs, scores, err := ri.client.SearchShardBatch(ctx, host, ri.class, shard,
+			searchVectors, targetVector, distance, limit, filters, adds, properties)
 		if err != nil {
 			return nil, err
 		}
@@ -332,6 +374,25 @@ func (ri *RemoteIndex) Aggregate(
 	return rr.(*aggregation.Result), err
 }
 
+func (ri *RemoteIndex) Facets(
+	ctx context.Context,
+	shard string,
+	params facets.Params,
+) ([]facets.Result, error) {
+	f := func(_, host string) (interface{}, error) {
+		r, err := ri.client.Facets(ctx, host, ri.class, shard, params)
+		if err != nil {
+			return nil, err
+		}
+		return r, nil
+	}
+	rr, _, err := ri.queryReplicas(ctx, shard, f)
+	if err != nil {
+		return nil, err
+	}
+	return rr.([]facets.Result), err
+}
+
 func (ri *RemoteIndex) FindUUIDs(ctx context.Context, shardName string,
 	filters *filters.LocalFilter,
 ) ([]strfmt.UUID, error) {
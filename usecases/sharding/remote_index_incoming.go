@@ -24,6 +24,7 @@ import (
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/aggregation"
 	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/facets"
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
@@ -66,9 +67,16 @@ type RemoteIndexIncomingRepo interface {
 		filters *filters.LocalFilter, keywordRanking *searchparams.KeywordRanking,
 		sort []filters.Sort, cursor *filters.Cursor, groupBy *searchparams.GroupBy,
 		additional additional.Properties, targetCombination *dto.TargetCombination, properties []string,
+		matryoshkaDims int,
 	) ([]*storobj.Object, []float32, error)
+	IncomingSearchBatch(ctx context.Context, shardName string,
+		vectors [][]float32, targetVector string, distance float32, limit int,
+		filters *filters.LocalFilter, additional additional.Properties, properties []string,
+	) ([][]*storobj.Object, [][]float32, error)
 	IncomingAggregate(ctx context.Context, shardName string,
 		params aggregation.Params, modules interface{}) (*aggregation.Result, error)
+	IncomingFacets(ctx context.Context, shardName string,
+		params facets.Params) ([]facets.Result, error)
 
 	IncomingFindUUIDs(ctx context.Context, shardName string,
 		filters *filters.LocalFilter) ([]strfmt.UUID, error)
@@ -200,7 +208,7 @@ func (rii *RemoteIndexIncoming) Search(ctx context.Context, indexName, shardName
 	vectors [][]float32, targetVectors []string, distance float32, limit int, filters *filters.LocalFilter,
 	keywordRanking *searchparams.KeywordRanking, sort []filters.Sort, cursor *filters.Cursor,
 	groupBy *searchparams.GroupBy, additional additional.Properties, targetCombination *dto.TargetCombination,
-	properties []string,
+	properties []string, matryoshkaDims int,
 ) ([]*storobj.Object, []float32, error) {
 	index := rii.repo.GetIndexForIncomingSharding(schema.ClassName(indexName))
 	if index == nil {
@@ -208,7 +216,20 @@ func (rii *RemoteIndexIncoming) Search(ctx context.Context, indexName, shardName
 	}
 
 	return index.IncomingSearch(
-		ctx, shardName, vectors, targetVectors, distance, limit, filters, keywordRanking, sort, cursor, groupBy, additional, targetCombination, properties)
+		ctx, shardName, vectors, targetVectors, distance, limit, filters, keywordRanking, sort, cursor, groupBy, additional, targetCombination, properties, matryoshkaDims)
+}
+
+func (rii *RemoteIndexIncoming) SearchBatch(ctx context.Context, indexName, shardName string,
+	vectors [][]float32, targetVector string, distance float32, limit int,
+	filters *filters.LocalFilter, additional additional.Properties, properties []string,
+) ([][]*storobj.Object, [][]float32, error) {
+	index := rii.repo.GetIndexForIncomingSharding(schema.ClassName(indexName))
+	if index == nil {
+		return nil, nil, enterrors.NewErrUnprocessable(errors.Errorf("local index %q not found", indexName))
+	}
+
+	return index.IncomingSearchBatch(
+		ctx, shardName, vectors, targetVector, distance, limit, filters, additional, properties)
 }
 
 func (rii *RemoteIndexIncoming) Aggregate(ctx context.Context, indexName, shardName string,
@@ -222,6 +243,17 @@ func (rii *RemoteIndexIncoming) Aggregate(ctx context.Context, indexName, shardN
 	return index.IncomingAggregate(ctx, shardName, params, rii.modules)
 }
 
+func (rii *RemoteIndexIncoming) Facets(ctx context.Context, indexName, shardName string,
+	params facets.Params,
+) ([]facets.Result, error) {
+	index := rii.repo.GetIndexForIncomingSharding(schema.ClassName(indexName))
+	if index == nil {
+		return nil, enterrors.NewErrUnprocessable(errors.Errorf("local index %q not found", indexName))
+	}
+
+	return index.IncomingFacets(ctx, shardName, params)
+}
+
 func (rii *RemoteIndexIncoming) FindUUIDs(ctx context.Context, indexName, shardName string,
 	filters *filters.LocalFilter,
 ) ([]strfmt.UUID, error) {
@@ -50,7 +50,7 @@ func (i *typeInspector) WithTypes(res *aggregation.Result, params aggregation.Pa
 			return nil, err
 		}
 
-		err = i.extendResWithType(res, prop.Name.String(), schemaProp.DataType)
+		err = i.extendResWithType(res.Groups, prop.Name.String(), schemaProp.DataType)
 		if err != nil {
 			return nil, fmt.Errorf("with types: prop %s: %v", prop.Name, err)
 		}
@@ -69,8 +69,8 @@ func (i *typeInspector) hasTypeAggregator(aggs []aggregation.Aggregator) bool {
 	return false
 }
 
-func (i *typeInspector) extendResWithType(res *aggregation.Result, propName string, dataType []string) error {
-	for groupIndex, group := range res.Groups {
+func (i *typeInspector) extendResWithType(groups []aggregation.Group, propName string, dataType []string) error {
+	for groupIndex, group := range groups {
 		prop, ok := group.Properties[propName]
 		if !ok {
 			prop = aggregation.Property{}
@@ -91,7 +91,11 @@ func (i *typeInspector) extendResWithType(res *aggregation.Result, propName stri
 			prop.ReferenceAggregation.PointingTo = dataType
 		}
 
-		res.Groups[groupIndex].Properties[propName] = prop
+		groups[groupIndex].Properties[propName] = prop
+
+		if err := i.extendResWithType(group.Groups, propName, dataType); err != nil {
+			return err
+		}
 	}
 
 	return nil
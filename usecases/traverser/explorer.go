@@ -26,8 +26,10 @@ import (
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/autocut"
 	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/facets"
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/inverted"
+	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/modulecapabilities"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/schema/crossref"
@@ -85,10 +87,13 @@ type ModulesProvider interface {
 
 type objectsSearcher interface {
 	hybridSearcher
+	textAnalyzersGetter
 
 	// GraphQL Get{} queries
 	Search(ctx context.Context, params dto.GetParams) ([]search.Result, error)
 	VectorSearch(ctx context.Context, params dto.GetParams, targetVectors []string, searchVectors [][]float32) ([]search.Result, error)
+	VectorSearchBatch(ctx context.Context, params dto.GetParams, targetVector string, searchVectors [][]float32) ([][]search.Result, error)
+	Facets(ctx context.Context, params facets.Params) ([]facets.Result, error)
 
 	// GraphQL Explore{} queries
 	CrossClassVectorSearch(ctx context.Context, vector []float32, targetVector string, offset, limit int,
@@ -125,9 +130,33 @@ func (e *Explorer) SetSchemaGetter(sg uc.SchemaGetter) {
 	e.schemaGetter = sg
 }
 
-// GetClass from search and connector repo
+// GetClass from search and connector repo. Facets, if requested, are counted
+// by GetClassWithFacets or GetFacets.
 func (e *Explorer) GetClass(ctx context.Context,
 	params dto.GetParams,
+) ([]interface{}, error) {
+	return e.getClass(ctx, params)
+}
+
+// GetClassWithFacets returns the results and the facets of the search
+// separately, so that the facets are returned even if there are no results
+func (e *Explorer) GetClassWithFacets(ctx context.Context,
+	params dto.GetParams,
+) ([]interface{}, []facets.Result, error) {
+	res, err := e.getClass(ctx, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	facetResults, err := e.facets(ctx, params)
+	if err != nil {
+		return nil, nil, err
+	}
+	return res, facetResults, nil
+}
+
+func (e *Explorer) getClass(ctx context.Context,
+	params dto.GetParams,
 ) ([]interface{}, error) {
 	if params.Pagination == nil {
 		params.Pagination = &filters.Pagination{
@@ -144,6 +173,28 @@ func (e *Explorer) GetClass(ctx context.Context,
 		return nil, errors.Wrap(err, "cursor api: invalid 'after' parameter")
 	}
 
+	if err := e.validateRangeCursor(params); err != nil {
+		return nil, errors.Wrap(err, "invalid 'after' parameter of a search by distance")
+	}
+
+	if err := e.validateFacets(params); err != nil {
+		return nil, errors.Wrap(err, "invalid 'facets' parameter")
+	}
+
+	if params.GroupBy != nil {
+		if err := params.GroupBy.Validate(); err != nil {
+			return nil, errors.Wrap(err, "invalid 'groupBy' parameter")
+		}
+		var err error
+		if params, err = e.withGroupHitVectors(params); err != nil {
+			return nil, err
+		}
+	}
+
+	if params.NearVectorBatch != nil {
+		return e.getClassVectorSearchBatchFlat(ctx, params)
+	}
+
 	if params.KeywordRanking != nil {
 		res, err := e.getClassKeywordBased(ctx, params)
 		if err != nil {
@@ -172,7 +223,11 @@ func (e *Explorer) getClassKeywordBased(ctx context.Context, params dto.GetParam
 		return nil, errors.Errorf("conflict: both near<Media> and keyword-based (bm25) arguments present, choose one")
 	}
 
-	if len(params.KeywordRanking.Query) == 0 {
+	if params.KeywordRanking.Type == "sparseVector" {
+		if params.KeywordRanking.TargetVector == "" {
+			return nil, errors.Errorf("sparse vector search must have a target vector set")
+		}
+	} else if len(params.KeywordRanking.Query) == 0 {
 		return nil, errors.Errorf("keyword search (bm25) must have query set")
 	}
 
@@ -183,6 +238,11 @@ func (e *Explorer) getClassKeywordBased(ctx context.Context, params dto.GetParam
 		params.AdditionalProperties.Vector = true
 	}
 
+	highlighter, err := e.highlighterFromParams(&params)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid 'highlights' parameter")
+	}
+
 	res, err := e.searcher.Search(ctx, params)
 	if err != nil {
 		var e inverted.MissingIndexError
@@ -192,6 +252,10 @@ func (e *Explorer) getClassKeywordBased(ctx context.Context, params dto.GetParam
 		return nil, errors.Errorf("explorer: get class: vector search: %v", err)
 	}
 
+	if highlighter != nil {
+		highlighter.addHighlights(res)
+	}
+
 	if e.modulesProvider != nil {
 		res, err = e.modulesProvider.GetExploreAdditionalExtend(ctx, res, params.AdditionalProperties.ModuleParams, nil, params.ModuleParams)
 		if err != nil {
@@ -200,7 +264,7 @@ func (e *Explorer) getClassKeywordBased(ctx context.Context, params dto.GetParam
 	}
 
 	if params.GroupBy != nil {
-		groupedResults, err := e.groupSearchResults(ctx, res, params.GroupBy)
+		groupedResults, err := e.groupSearchResults(ctx, res, params)
 		if err != nil {
 			return nil, err
 		}
@@ -359,8 +423,13 @@ func (e *Explorer) getClassList(ctx context.Context,
 	if params.Group != nil && (params.Filters != nil || params.Sort != nil) {
 		params.AdditionalProperties.Vector = true
 	}
+
+	highlighter, err := e.highlighterFromParams(&params)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid 'highlights' parameter")
+	}
+
 	var res []search.Result
-	var err error
 	if params.HybridSearch != nil {
 		res, err = e.Hybrid(ctx, params)
 		if err != nil {
@@ -377,6 +446,10 @@ func (e *Explorer) getClassList(ctx context.Context,
 		}
 	}
 
+	if highlighter != nil {
+		highlighter.addHighlights(res)
+	}
+
 	if params.Group != nil {
 		grouped, err := grouper.New(e.logger).Group(res, params.Group.Strategy, params.Group.Force)
 		if err != nil {
@@ -495,10 +568,21 @@ func (e *Explorer) searchResultsToGetResponseWithType(ctx context.Context, input
 
 		if len(params.AdditionalProperties.Vectors) > 0 {
 			vectors := make(map[string][]float32)
+			var sparseVectors models.SparseVectors
 			for _, targetVector := range params.AdditionalProperties.Vectors {
+				if sparseVector, ok := res.SparseVectors[targetVector]; ok {
+					if sparseVectors == nil {
+						sparseVectors = models.SparseVectors{}
+					}
+					sparseVectors[targetVector] = sparseVector
+					continue
+				}
 				vectors[targetVector] = res.Vectors[targetVector]
 			}
 			additionalProperties["vectors"] = vectors
+			if sparseVectors != nil {
+				additionalProperties["sparseVectors"] = sparseVectors
+			}
 		}
 
 		if params.AdditionalProperties.CreationTimeUnix {
@@ -755,6 +839,12 @@ func ExtractDistanceFromParams(params dto.GetParams) (distance float64, withDist
 		return
 	}
 
+	if params.NearVectorBatch != nil {
+		distance = params.NearVectorBatch.Distance
+		withDistance = params.NearVectorBatch.WithDistance
+		return
+	}
+
 	if params.NearObject != nil {
 		distance = params.NearObject.Distance
 		withDistance = params.NearObject.WithDistance
@@ -787,6 +877,11 @@ func ExtractCertaintyFromParams(params dto.GetParams) (certainty float64) {
 		return
 	}
 
+	if params.NearVectorBatch != nil {
+		certainty = params.NearVectorBatch.Certainty
+		return
+	}
+
 	if params.NearObject != nil {
 		certainty = params.NearObject.Certainty
 		return
@@ -898,6 +993,10 @@ func (e *Explorer) usageOperationFromGetParams(params dto.GetParams) string {
 		return "nearVector"
 	}
 
+	if params.NearVectorBatch != nil {
+		return "nearVectorBatch"
+	}
+
 	// there is at most one module param, so we can return the first we find
 	for param := range params.ModuleParams {
 		return param
@@ -0,0 +1,160 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/autocut"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/search"
+)
+
+// GetClassBatch runs the near vector queries of params.NearVectorBatch and
+// returns one result list per query vector, in the order of the vectors.
+func (e *Explorer) GetClassBatch(ctx context.Context,
+	params dto.GetParams,
+) ([][]interface{}, error) {
+	if params.Pagination == nil {
+		params.Pagination = &filters.Pagination{
+			Offset: 0,
+			Limit:  100,
+		}
+	}
+
+	res, err := e.getClassVectorSearchBatch(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([][]interface{}, len(res))
+	for i := range res {
+		out[i], err = e.searchResultsToGetResponse(ctx, res[i], params.NearVectorBatch.Vectors[i], params)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+// getClassVectorSearchBatchFlat answers a GraphQL Get{} with a nearVectorBatch
+// argument. GraphQL has no way to return nested result lists, so the results
+// of all queries are concatenated. The position of the query that produced a
+// result can be requested with _additional { queryIndex }.
+func (e *Explorer) getClassVectorSearchBatchFlat(ctx context.Context,
+	params dto.GetParams,
+) ([]interface{}, error) {
+	res, err := e.getClassVectorSearchBatch(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	var out []interface{}
+	for i := range res {
+		if params.AdditionalProperties.QueryIndex {
+			for j := range res[i] {
+				if res[i][j].AdditionalProperties == nil {
+					res[i][j].AdditionalProperties = models.AdditionalProperties{}
+				}
+				res[i][j].AdditionalProperties["queryIndex"] = i
+			}
+		}
+		queryOut, err := e.searchResultsToGetResponse(ctx, res[i], params.NearVectorBatch.Vectors[i], params)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, queryOut...)
+	}
+	return out, nil
+}
+
+func (e *Explorer) getClassVectorSearchBatch(ctx context.Context,
+	params dto.GetParams,
+) ([][]search.Result, error) {
+	if err := validateNearVectorBatch(params); err != nil {
+		return nil, err
+	}
+
+	var targetVectors []string
+	if params.NearVectorBatch.TargetVector != "" {
+		targetVectors = []string{params.NearVectorBatch.TargetVector}
+	}
+	targetVectors, err := e.targetParamHelper.GetTargetVectorOrDefault(e.schemaGetter.GetSchemaSkipAuth(),
+		params.ClassName, targetVectors)
+	if err != nil {
+		return nil, errors.Errorf("explorer: get class: validate target vector: %v", err)
+	}
+	var targetVector string
+	if len(targetVectors) > 0 {
+		targetVector = targetVectors[0]
+	}
+
+	if len(params.AdditionalProperties.ModuleParams) > 0 {
+		// if a module-specific additional prop is set, assume it needs the vector
+		// present for backward-compatibility. This could be improved by actually
+		// asking the module based on specific conditions
+		params.AdditionalProperties.Vector = true
+	}
+
+	res, err := e.searcher.VectorSearchBatch(ctx, params, targetVector, params.NearVectorBatch.Vectors)
+	if err != nil {
+		return nil, errors.Errorf("explorer: get class: vector search batch: %v", err)
+	}
+
+	for i := range res {
+		if params.Pagination.Autocut > 0 {
+			scores := make([]float32, len(res[i]))
+			for j := range res[i] {
+				scores[j] = res[i][j].Dist
+			}
+			res[i] = res[i][:autocut.Autocut(scores, params.Pagination.Autocut)]
+		}
+
+		if e.modulesProvider != nil {
+			res[i], err = e.modulesProvider.GetExploreAdditionalExtend(ctx, res[i],
+				params.AdditionalProperties.ModuleParams, params.NearVectorBatch.Vectors[i], params.ModuleParams)
+			if err != nil {
+				return nil, errors.Errorf("explorer: get class: extend: %v", err)
+			}
+		}
+		e.trackUsageGet(res[i], params)
+	}
+
+	return res, nil
+}
+
+func validateNearVectorBatch(params dto.GetParams) error {
+	batch := params.NearVectorBatch
+	if batch == nil {
+		return errors.Errorf("nearVectorBatch must be set")
+	}
+	if len(batch.Vectors) == 0 {
+		return errors.Errorf("nearVectorBatch must have at least one vector")
+	}
+	if batch.Certainty != 0 && batch.WithDistance {
+		return errors.Errorf("nearVectorBatch cannot have both distance and certainty set")
+	}
+
+	if params.NearVector != nil || params.NearObject != nil || len(params.ModuleParams) > 0 {
+		return errors.Errorf("conflict: both near<Media> and nearVectorBatch arguments present, choose one")
+	}
+	if params.KeywordRanking != nil || params.HybridSearch != nil {
+		return errors.Errorf("conflict: nearVectorBatch cannot be combined with bm25 or hybrid search")
+	}
+	if len(params.Sort) > 0 || params.Cursor != nil || params.GroupBy != nil || params.Group != nil {
+		return errors.Errorf("nearVectorBatch cannot be combined with sort, cursor, group or groupBy")
+	}
+	return nil
+}
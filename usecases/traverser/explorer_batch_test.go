@@ -0,0 +1,152 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/searchparams"
+)
+
+func Test_Explorer_GetClass_NearVectorBatch(t *testing.T) {
+	vectors := [][]float32{{0.8, 0.2, 0.7}, {0.1, 0.9, 0.3}}
+	searchResults := [][]search.Result{
+		{
+			{ID: "id1", Schema: map[string]interface{}{"name": "Foo"}, Dims: 3},
+			{ID: "id2", Schema: map[string]interface{}{"name": "Bar"}, Dims: 3},
+		},
+		{
+			{ID: "id2", Schema: map[string]interface{}{"name": "Bar"}, Dims: 3},
+		},
+	}
+
+	newExplorer := func() (*Explorer, *fakeVectorSearcher) {
+		search := &fakeVectorSearcher{}
+		metrics := &fakeMetrics{}
+		metrics.On("AddUsageDimensions", "BestClass", "get_graphql", "nearVectorBatch", 3)
+		log, _ := test.NewNullLogger()
+		explorer := NewExplorer(search, log, getFakeModulesProvider(), metrics, defaultConfig)
+		explorer.SetSchemaGetter(&fakeSchemaGetter{
+			schema: schema.Schema{Objects: &models.Schema{Classes: []*models.Class{
+				{Class: "BestClass"},
+			}}},
+		})
+		return explorer, search
+	}
+
+	t.Run("results per query", func(t *testing.T) {
+		params := dto.GetParams{
+			ClassName:       "BestClass",
+			NearVectorBatch: &searchparams.NearVectorBatch{Vectors: vectors},
+			Pagination:      &filters.Pagination{Limit: 10},
+		}
+		explorer, search := newExplorer()
+		search.On("VectorSearchBatch", params, "", vectors).Return(copyBatchResults(searchResults), nil)
+
+		res, err := explorer.GetClassBatch(context.Background(), params)
+		require.Nil(t, err)
+		search.AssertExpectations(t)
+		require.Len(t, res, 2)
+		assert.Equal(t, []interface{}{
+			map[string]interface{}{"name": "Foo"},
+			map[string]interface{}{"name": "Bar"},
+		}, res[0])
+		assert.Equal(t, []interface{}{
+			map[string]interface{}{"name": "Bar"},
+		}, res[1])
+	})
+
+	t.Run("flattened results with query index", func(t *testing.T) {
+		params := dto.GetParams{
+			ClassName:            "BestClass",
+			NearVectorBatch:      &searchparams.NearVectorBatch{Vectors: vectors},
+			Pagination:           &filters.Pagination{Limit: 10},
+			AdditionalProperties: additional.Properties{QueryIndex: true},
+		}
+		explorer, search := newExplorer()
+		search.On("VectorSearchBatch", params, "", vectors).Return(copyBatchResults(searchResults), nil)
+
+		res, err := explorer.GetClass(context.Background(), params)
+		require.Nil(t, err)
+		require.Len(t, res, 3)
+		queryIndexes := make([]interface{}, len(res))
+		for i := range res {
+			queryIndexes[i] = res[i].(map[string]interface{})["_additional"].(map[string]interface{})["queryIndex"]
+		}
+		assert.Equal(t, []interface{}{0, 0, 1}, queryIndexes)
+	})
+
+	t.Run("conflicting arguments", func(t *testing.T) {
+		tests := []struct {
+			name   string
+			params dto.GetParams
+		}{
+			{
+				name:   "nearVector",
+				params: dto.GetParams{NearVector: &searchparams.NearVector{Vectors: vectors}},
+			},
+			{
+				name:   "bm25",
+				params: dto.GetParams{KeywordRanking: &searchparams.KeywordRanking{Query: "foo"}},
+			},
+			{
+				name:   "sort",
+				params: dto.GetParams{Sort: []filters.Sort{{Path: []string{"name"}, Order: "asc"}}},
+			},
+			{
+				name:   "groupBy",
+				params: dto.GetParams{GroupBy: &searchparams.GroupBy{Property: "name"}},
+			},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				params := tt.params
+				params.ClassName = "BestClass"
+				params.NearVectorBatch = &searchparams.NearVectorBatch{Vectors: vectors}
+				params.Pagination = &filters.Pagination{Limit: 10}
+				explorer, search := newExplorer()
+
+				_, err := explorer.GetClassBatch(context.Background(), params)
+				require.NotNil(t, err)
+				search.AssertNotCalled(t, "VectorSearchBatch")
+			})
+		}
+	})
+}
+
+// copyBatchResults returns fresh result maps, as the explorer adds
+// _additional properties to the results it is handed
+func copyBatchResults(in [][]search.Result) [][]search.Result {
+	out := make([][]search.Result, len(in))
+	for i := range in {
+		out[i] = make([]search.Result, len(in[i]))
+		for j, res := range in[i] {
+			schema := map[string]interface{}{}
+			for k, v := range res.Schema.(map[string]interface{}) {
+				schema[k] = v
+			}
+			res.Schema = schema
+			out[i][j] = res
+		}
+	}
+	return out
+}
@@ -0,0 +1,150 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/facets"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/searchparams"
+)
+
+func (e *Explorer) validateFacets(params dto.GetParams) error {
+	if len(params.Facets) == 0 {
+		return nil
+	}
+	if params.NearVectorBatch != nil {
+		return fmt.Errorf("facets are not supported on batched near vector searches")
+	}
+	if hasVectorThreshold(params) {
+		return fmt.Errorf("facets are not supported on vector searches with a distance or certainty limit")
+	}
+
+	class := e.schemaGetter.ReadOnlyClass(params.ClassName)
+	if class == nil {
+		return fmt.Errorf("class %q not found in schema", params.ClassName)
+	}
+
+	for _, facet := range params.Facets {
+		if err := facet.Validate(); err != nil {
+			return err
+		}
+
+		prop, err := schema.GetPropertyByName(class, facet.Property)
+		if err != nil {
+			return fmt.Errorf("facet %s: %w", facet.Property, err)
+		}
+		dt, ok := schema.AsPrimitive(prop.DataType)
+		if !ok {
+			return fmt.Errorf("facet %s: data type %v is not supported", facet.Property, prop.DataType)
+		}
+		switch dt {
+		case schema.DataTypeInt, schema.DataTypeIntArray, schema.DataTypeNumber, schema.DataTypeNumberArray:
+		case schema.DataTypeText, schema.DataTypeTextArray:
+			if len(facet.Ranges) > 0 {
+				return fmt.Errorf("facet %s: ranges require a numerical property, got %s", facet.Property, dt)
+			}
+			// the filterable index holds the tokens of a text, which are only
+			// whole values with field tokenization
+			if prop.Tokenization != models.PropertyTokenizationField {
+				return fmt.Errorf("facet %s: text properties require %s tokenization, got %s",
+					facet.Property, models.PropertyTokenizationField, prop.Tokenization)
+			}
+		case schema.DataTypeBoolean, schema.DataTypeBooleanArray,
+			schema.DataTypeDate, schema.DataTypeDateArray, schema.DataTypeUUID, schema.DataTypeUUIDArray:
+			if len(facet.Ranges) > 0 {
+				return fmt.Errorf("facet %s: ranges require a numerical property, got %s", facet.Property, dt)
+			}
+		default:
+			return fmt.Errorf("facet %s: data type %s is not supported", facet.Property, dt)
+		}
+	}
+	return nil
+}
+
+// facetParams select the candidates of the search, which are the objects
+// matching the filters, and if the search is a keyword search, containing at
+// least one of the query terms. A hybrid search only is a keyword search if
+// it gives no weight to the vector search.
+func facetParams(params dto.GetParams) facets.Params {
+	out := facets.Params{
+		ClassName: schema.ClassName(params.ClassName),
+		Tenant:    params.Tenant,
+		Filters:   params.Filters,
+		Facets:    params.Facets,
+	}
+
+	if params.KeywordRanking != nil && params.KeywordRanking.Type == "bm25" {
+		out.KeywordRanking = params.KeywordRanking
+	} else if hs := params.HybridSearch; hs != nil && hs.Alpha == 0 &&
+		(hs.SparseVectorParams == nil || hs.SparseVectorParams.Weight <= 0) {
+		out.KeywordRanking = &searchparams.KeywordRanking{
+			Type:       "bm25",
+			Query:      hs.Query,
+			Properties: hs.Properties,
+		}
+	}
+	return out
+}
+
+// hasVectorThreshold reports whether the vector search only returns the
+// objects within a distance or certainty of the query. Facets count all
+// objects matching the filters, so they can't honor such a limit.
+func hasVectorThreshold(params dto.GetParams) bool {
+	if _, withDistance := ExtractDistanceFromParams(params); withDistance {
+		return true
+	}
+	if ExtractCertaintyFromParams(params) != 0 {
+		return true
+	}
+	if hs := params.HybridSearch; hs != nil {
+		if hs.WithDistance {
+			return true
+		}
+		if hs.NearTextParams != nil && hs.NearTextParams.Certainty != 0 {
+			return true
+		}
+		if hs.NearVectorParams != nil && hs.NearVectorParams.Certainty != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// GetFacets counts the facets of the search without searching for its results
+func (e *Explorer) GetFacets(ctx context.Context, params dto.GetParams) ([]facets.Result, error) {
+	if len(params.Facets) == 0 {
+		return nil, errors.New("invalid 'facets' parameter: at least one facet is required")
+	}
+	if err := e.validateFacets(params); err != nil {
+		return nil, errors.Wrap(err, "invalid 'facets' parameter")
+	}
+	return e.facets(ctx, params)
+}
+
+// facets counts the facets of the search, independently of its results
+func (e *Explorer) facets(ctx context.Context, params dto.GetParams) ([]facets.Result, error) {
+	if len(params.Facets) == 0 {
+		return nil, nil
+	}
+
+	results, err := e.searcher.Facets(ctx, facetParams(params))
+	if err != nil {
+		return nil, errors.Errorf("explorer: get class: facets: %v", err)
+	}
+	return results, nil
+}
@@ -0,0 +1,229 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/aggregation"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/facets"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/searchparams"
+)
+
+func Test_Explorer_GetClass_Facets(t *testing.T) {
+	ptFloat64 := func(in float64) *float64 { return &in }
+
+	newExplorer := func() (*Explorer, *fakeVectorSearcher) {
+		searcher := &fakeVectorSearcher{}
+		log, _ := test.NewNullLogger()
+		explorer := NewExplorer(searcher, log, getFakeModulesProvider(), nil, defaultConfig)
+		explorer.SetSchemaGetter(&fakeSchemaGetter{
+			schema: schema.Schema{Objects: &models.Schema{Classes: []*models.Class{
+				{
+					Class: "Product",
+					Properties: []*models.Property{
+						{Name: "brand", DataType: schema.DataTypeText.PropString(), Tokenization: models.PropertyTokenizationField},
+						{Name: "name", DataType: schema.DataTypeText.PropString(), Tokenization: models.PropertyTokenizationWord},
+						{Name: "price", DataType: schema.DataTypeNumber.PropString()},
+					},
+				},
+			}}},
+		})
+		return explorer, searcher
+	}
+
+	t.Run("facets of a keyword search", func(t *testing.T) {
+		keywordRanking := &searchparams.KeywordRanking{Type: "bm25", Query: "shoe"}
+		params := dto.GetParams{
+			ClassName:      "Product",
+			KeywordRanking: keywordRanking,
+			Facets: []facets.Facet{
+				{Property: "brand"},
+				{Property: "price", Ranges: []aggregation.GroupByRange{{To: ptFloat64(50)}, {From: ptFloat64(50)}}},
+			},
+		}
+		facetResults := []facets.Result{
+			{Property: "brand", Values: []facets.Value{{Value: "acme", Count: 7}}},
+			{Property: "price", Values: []facets.Value{{To: ptFloat64(50), Count: 3}, {From: ptFloat64(50), Count: 4}}},
+		}
+
+		explorer, searcher := newExplorer()
+		searcher.On("Facets", facets.Params{
+			ClassName:      "Product",
+			KeywordRanking: keywordRanking,
+			Facets:         params.Facets,
+		}).Return(facetResults, nil)
+
+		gotFacets, err := explorer.GetFacets(context.Background(), params)
+		require.Nil(t, err)
+		searcher.AssertExpectations(t)
+		assert.Equal(t, facetResults, gotFacets)
+	})
+
+	t.Run("facets are not added to the results", func(t *testing.T) {
+		params := dto.GetParams{
+			ClassName:  "Product",
+			Pagination: &filters.Pagination{Limit: 2},
+			Facets:     []facets.Facet{{Property: "brand"}},
+		}
+
+		explorer, searcher := newExplorer()
+		searcher.On("Search", params).Return([]search.Result{
+			{ID: "id1", Schema: map[string]interface{}{"brand": "acme"}},
+		}, nil)
+
+		res, err := explorer.GetClass(context.Background(), params)
+		require.Nil(t, err)
+		searcher.AssertExpectations(t)
+		assert.Equal(t, []interface{}{map[string]interface{}{"brand": "acme"}}, res)
+	})
+
+	t.Run("facets are required", func(t *testing.T) {
+		explorer, _ := newExplorer()
+		_, err := explorer.GetFacets(context.Background(), dto.GetParams{ClassName: "Product"})
+		assert.ErrorContains(t, err, "at least one facet is required")
+	})
+
+	t.Run("facets are returned without results", func(t *testing.T) {
+		keywordRanking := &searchparams.KeywordRanking{Type: "bm25", Query: "boot"}
+		params := dto.GetParams{
+			ClassName:      "Product",
+			KeywordRanking: keywordRanking,
+			Pagination:     &filters.Pagination{Offset: 20, Limit: 2},
+			Facets:         []facets.Facet{{Property: "brand"}},
+		}
+		facetResults := []facets.Result{
+			{Property: "brand", Values: []facets.Value{{Value: "acme", Count: 7}}},
+		}
+
+		explorer, searcher := newExplorer()
+		searcher.On("Search", params).Return([]search.Result{}, nil)
+		searcher.On("Facets", facets.Params{
+			ClassName:      "Product",
+			KeywordRanking: keywordRanking,
+			Facets:         params.Facets,
+		}).Return(facetResults, nil)
+
+		res, gotFacets, err := explorer.GetClassWithFacets(context.Background(), params)
+		require.Nil(t, err)
+		searcher.AssertExpectations(t)
+		assert.Empty(t, res)
+		assert.Equal(t, facetResults, gotFacets)
+	})
+
+	t.Run("facets with a vector threshold", func(t *testing.T) {
+		tests := []struct {
+			name   string
+			params dto.GetParams
+		}{
+			{name: "near vector distance", params: dto.GetParams{
+				NearVector: &searchparams.NearVector{Distance: 0.3, WithDistance: true},
+			}},
+			{name: "near vector certainty", params: dto.GetParams{
+				NearVector: &searchparams.NearVector{Certainty: 0.7},
+			}},
+			{name: "near object distance", params: dto.GetParams{
+				NearObject: &searchparams.NearObject{ID: "id", Distance: 0.3, WithDistance: true},
+			}},
+			{name: "hybrid vector distance", params: dto.GetParams{
+				HybridSearch: &searchparams.HybridSearch{Query: "shoe", Alpha: 0.5, Distance: 0.3, WithDistance: true},
+			}},
+			{name: "hybrid near vector certainty", params: dto.GetParams{
+				HybridSearch: &searchparams.HybridSearch{
+					Query: "shoe", Alpha: 0.5, NearVectorParams: &searchparams.NearVector{Certainty: 0.7},
+				},
+			}},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				explorer, _ := newExplorer()
+				params := tt.params
+				params.ClassName = "Product"
+				params.Facets = []facets.Facet{{Property: "brand"}}
+				_, err := explorer.GetFacets(context.Background(), params)
+				assert.ErrorContains(t, err, "distance or certainty limit")
+			})
+		}
+	})
+
+	t.Run("invalid facets", func(t *testing.T) {
+		tests := []struct {
+			name  string
+			facet facets.Facet
+		}{
+			{name: "unknown property", facet: facets.Facet{Property: "color"}},
+			{name: "negative limit", facet: facets.Facet{Property: "brand", Limit: -1}},
+			{name: "ranges on text", facet: facets.Facet{
+				Property: "brand", Ranges: []aggregation.GroupByRange{{To: ptFloat64(1)}},
+			}},
+			{name: "text without field tokenization", facet: facets.Facet{Property: "name"}},
+			{name: "empty range", facet: facets.Facet{
+				Property: "price", Ranges: []aggregation.GroupByRange{{From: ptFloat64(2), To: ptFloat64(1)}},
+			}},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				explorer, _ := newExplorer()
+				_, err := explorer.GetFacets(context.Background(), dto.GetParams{
+					ClassName: "Product",
+					Facets:    []facets.Facet{tt.facet},
+				})
+				assert.ErrorContains(t, err, "invalid 'facets' parameter")
+			})
+		}
+	})
+}
+
+func TestFacetParams(t *testing.T) {
+	where := &filters.LocalFilter{Root: &filters.Clause{Operator: filters.OperatorEqual}}
+
+	t.Run("vector searches don't narrow down the candidates", func(t *testing.T) {
+		out := facetParams(dto.GetParams{
+			ClassName:    "Product",
+			Filters:      where,
+			HybridSearch: &searchparams.HybridSearch{Query: "shoe", Alpha: 0.5},
+		})
+		assert.Equal(t, facets.Params{ClassName: "Product", Filters: where}, out)
+	})
+
+	t.Run("hybrid search without vector weight is a keyword search", func(t *testing.T) {
+		out := facetParams(dto.GetParams{
+			ClassName:    "Product",
+			Filters:      where,
+			HybridSearch: &searchparams.HybridSearch{Query: "shoe", Properties: []string{"name"}},
+		})
+		assert.Equal(t, facets.Params{
+			ClassName: "Product",
+			Filters:   where,
+			KeywordRanking: &searchparams.KeywordRanking{
+				Type: "bm25", Query: "shoe", Properties: []string{"name"},
+			},
+		}, out)
+	})
+
+	t.Run("sparse vector searches don't narrow down the candidates", func(t *testing.T) {
+		out := facetParams(dto.GetParams{
+			ClassName:      "Product",
+			KeywordRanking: &searchparams.KeywordRanking{Type: "sparseVector", TargetVector: "splade"},
+		})
+		assert.Equal(t, facets.Params{ClassName: "Product"}, out)
+	})
+}
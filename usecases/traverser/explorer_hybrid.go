@@ -70,6 +70,45 @@ func sparseSearch(ctx context.Context, e *Explorer, params dto.GetParams) ([]*se
 	return out, "keyword,bm25", nil
 }
 
+// Do a sparse vector search.  The results will be used in the hybrid algorithm
+func sparseVectorSearch(ctx context.Context, e *Explorer, params dto.GetParams) ([]*search.Result, string, error) {
+	sparseParams := params.HybridSearch.SparseVectorParams
+	params.KeywordRanking = &searchparams.KeywordRanking{
+		Type:         "sparseVector",
+		TargetVector: sparseParams.TargetVector,
+		SparseVector: sparseParams.Vector,
+	}
+
+	params.Group = nil
+	params.GroupBy = nil
+
+	if params.Pagination == nil {
+		return nil, "", fmt.Errorf("invalid params, pagination object is nil")
+	}
+
+	totalLimit, err := e.CalculateTotalLimit(params.Pagination)
+	if err != nil {
+		return nil, "", err
+	}
+
+	enforcedMin := MaxInt(params.Pagination.Offset+hybrid.DefaultLimit, totalLimit)
+	params.Pagination.Limit = enforcedMin - params.Pagination.Offset
+
+	results, scores, err := e.searcher.SparseObjectSearch(ctx, params)
+	if err != nil {
+		return nil, "", err
+	}
+
+	out := make([]*search.Result, len(results))
+	for i, obj := range results {
+		sr := obj.SearchResultWithScore(additional.Properties{}, scores[i])
+		sr.SecondarySortValue = sr.Score
+		out[i] = &sr
+	}
+
+	return out, "sparseVector," + sparseParams.TargetVector, nil
+}
+
 // Do a nearvector search.  The results will be used in the hybrid algorithm
 func denseSearch(ctx context.Context, e *Explorer, params dto.GetParams, searchname string, targetVectors []string, searchVector *searchparams.NearVector) ([]*search.Result, string, error) {
 	params.Pagination.Offset = 0
@@ -226,6 +265,18 @@ func (e *Explorer) Hybrid(ctx context.Context, params dto.GetParams) ([]search.R
 	if params.HybridSearch.Alpha != 0 && params.HybridSearch.Alpha != 1 {
 		resultsCount = 2
 	}
+	keywordResultsIndex := resultsCount - 1
+
+	// a search over sparse vectors is added as a third result set
+	sparseVectorParams := params.HybridSearch.SparseVectorParams
+	sparseVectorResultsIndex := -1
+	if sparseVectorParams != nil && sparseVectorParams.Weight > 0 {
+		if sparseVectorParams.TargetVector == "" {
+			return nil, fmt.Errorf("hybrid search with sparse vector needs a target vector")
+		}
+		sparseVectorResultsIndex = resultsCount
+		resultsCount++
+	}
 
 	eg := enterrors.NewErrorGroupWrapper(e.logger)
 	eg.SetLimit(resultsCount)
@@ -328,29 +379,62 @@ func (e *Explorer) Hybrid(ctx context.Context, params dto.GetParams) ([]search.R
 				e.logger.WithField("action", "hybrid").WithError(err).Error("sparseSearch failed")
 				return err
 			} else {
-				weights[len(weights)-1] = 1 - params.HybridSearch.Alpha
-				results[len(weights)-1] = sparseResults
-				names[len(weights)-1] = name
-				sparseSearchIndex = len(weights) - 1
+				weights[keywordResultsIndex] = 1 - params.HybridSearch.Alpha
+				results[keywordResultsIndex] = sparseResults
+				names[keywordResultsIndex] = name
+				sparseSearchIndex = keywordResultsIndex
 			}
 
 			return nil
 		})
 	}
 
+	if sparseVectorResultsIndex >= 0 {
+		eg.Go(func() error {
+			params := keywordParams
+			sparseVectorResults, name, err := sparseVectorSearch(ctx, e, params)
+			if err != nil {
+				e.logger.WithField("action", "hybrid").WithError(err).Error("sparseVectorSearch failed")
+				return err
+			}
+			weights[sparseVectorResultsIndex] = sparseVectorParams.Weight
+			results[sparseVectorResultsIndex] = sparseVectorResults
+			names[sparseVectorResultsIndex] = name
+			return nil
+		})
+	}
+
 	if err := eg.Wait(); err != nil {
 		return nil, err
 	}
 
-	// remove results with a vector distance above the cutoff from the BM25 results
-	if sparseSearchIndex >= 0 && belowCutoffSet != nil {
-		newResults := make([]*search.Result, 0, len(results[sparseSearchIndex]))
-		for i := range results[sparseSearchIndex] {
-			if _, ok := belowCutoffSet[results[sparseSearchIndex][i].ID]; ok {
-				newResults = append(newResults, results[sparseSearchIndex][i])
+	// remove results with a vector distance above the cutoff from the BM25 and
+	// sparse vector results
+	if belowCutoffSet != nil {
+		for _, index := range []int{sparseSearchIndex, sparseVectorResultsIndex} {
+			if index < 0 {
+				continue
 			}
+			newResults := make([]*search.Result, 0, len(results[index]))
+			for i := range results[index] {
+				if _, ok := belowCutoffSet[results[index][i].ID]; ok {
+					newResults = append(newResults, results[index][i])
+				}
+			}
+			results[index] = newResults
+		}
+	}
+
+	// the weights of the vector and keyword results add up to 1, the weight
+	// of the sparse vector results is relative to them
+	if sparseVectorResultsIndex >= 0 {
+		total := 0.0
+		for _, weight := range weights {
+			total += weight
+		}
+		for i := range weights {
+			weights[i] /= total
 		}
-		results[sparseSearchIndex] = newResults
 	}
 
 	// The postProcess function is used to limit the number of results and to resolve references
@@ -415,7 +499,7 @@ func (e *Explorer) Hybrid(ctx context.Context, params dto.GetParams) ([]search.R
 	}
 
 	if origParams.GroupBy != nil {
-		groupedResults, err := e.groupSearchResults(ctx, out, origParams.GroupBy)
+		groupedResults, err := e.groupSearchResults(ctx, out, origParams)
 		if err != nil {
 			return nil, err
 		}
@@ -17,6 +17,7 @@ import (
 	"github.com/weaviate/weaviate/entities/dto"
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/searchparams"
 )
 
 func (e *Explorer) validateCursor(params dto.GetParams) error {
@@ -32,3 +33,33 @@ func (e *Explorer) validateCursor(params dto.GetParams) error {
 	}
 	return nil
 }
+
+// validateRangeCursor checks that the cursor of a search by distance is only
+// set on a search by distance of a single target vector, which isn't limited,
+// sorted or grouped, as the cursor is a position in the order by distance
+func (e *Explorer) validateRangeCursor(params dto.GetParams) error {
+	var after *searchparams.RangeCursor
+	var targetVectors []string
+	if params.NearVector != nil {
+		after, targetVectors = params.NearVector.After, params.NearVector.TargetVectors
+	} else if params.NearObject != nil {
+		after, targetVectors = params.NearObject.After, params.NearObject.TargetVectors
+	}
+	if after == nil {
+		return nil
+	}
+
+	if params.Pagination.Limit != filters.LimitFlagSearchByDist {
+		return fmt.Errorf("after requires a distance or certainty and cannot be set with limit")
+	}
+	if params.Pagination.Offset != 0 || params.Pagination.Autocut > 0 {
+		return fmt.Errorf("after cannot be set with offset or autocut")
+	}
+	if len(params.Sort) > 0 || params.GroupBy != nil || params.Group != nil {
+		return fmt.Errorf("after cannot be set with sort, group or groupBy")
+	}
+	if len(targetVectors) > 1 {
+		return fmt.Errorf("after cannot be set with multiple target vectors")
+	}
+	return nil
+}
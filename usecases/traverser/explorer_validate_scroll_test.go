@@ -0,0 +1,106 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/searchparams"
+)
+
+func Test_Explorer_GetClass_RangeCursor(t *testing.T) {
+	newExplorer := func() (*Explorer, *fakeVectorSearcher) {
+		searcher := &fakeVectorSearcher{}
+		log, _ := test.NewNullLogger()
+		explorer := NewExplorer(searcher, log, getFakeModulesProvider(), nil, defaultConfig)
+		explorer.SetSchemaGetter(&fakeSchemaGetter{
+			schema: schema.Schema{Objects: &models.Schema{Classes: []*models.Class{
+				{Class: "BestClass", Properties: []*models.Property{
+					{Name: "name", DataType: schema.DataTypeText.PropString()},
+				}},
+			}}},
+		})
+		return explorer, searcher
+	}
+	after := &searchparams.RangeCursor{Distance: 0.2, ID: "c6f85bf5-c3b7-4c1d-bd51-e899f9605336"}
+
+	t.Run("continues a search by distance", func(t *testing.T) {
+		params := dto.GetParams{
+			ClassName: "BestClass",
+			NearVector: &searchparams.NearVector{
+				Vectors:      [][]float32{{0.8, 0.2, 0.7}},
+				Distance:     0.4,
+				WithDistance: true,
+				After:        after,
+			},
+			Pagination: &filters.Pagination{Limit: filters.LimitFlagSearchByDist},
+		}
+
+		explorer, searcher := newExplorer()
+		searcher.On("VectorSearch", params, [][]float32{{0.8, 0.2, 0.7}}).
+			Return([]search.Result{{ID: "id1", Dist: 0.3}}, nil)
+
+		res, err := explorer.GetClass(context.Background(), params)
+		require.Nil(t, err)
+		searcher.AssertExpectations(t)
+		assert.Len(t, res, 1)
+	})
+
+	t.Run("invalid combinations", func(t *testing.T) {
+		tests := []struct {
+			name   string
+			params dto.GetParams
+		}{
+			{name: "with limit", params: dto.GetParams{
+				NearVector: &searchparams.NearVector{Distance: 0.4, WithDistance: true, After: after},
+				Pagination: &filters.Pagination{Limit: 10},
+			}},
+			{name: "with offset", params: dto.GetParams{
+				NearObject: &searchparams.NearObject{ID: "id", Distance: 0.4, WithDistance: true, After: after},
+				Pagination: &filters.Pagination{Limit: filters.LimitFlagSearchByDist, Offset: 10},
+			}},
+			{name: "with autocut", params: dto.GetParams{
+				NearVector: &searchparams.NearVector{Distance: 0.4, WithDistance: true, After: after},
+				Pagination: &filters.Pagination{Limit: filters.LimitFlagSearchByDist, Autocut: 1},
+			}},
+			{name: "with sort", params: dto.GetParams{
+				NearVector: &searchparams.NearVector{Distance: 0.4, WithDistance: true, After: after},
+				Pagination: &filters.Pagination{Limit: filters.LimitFlagSearchByDist},
+				Sort:       []filters.Sort{{Path: []string{"name"}, Order: "asc"}},
+			}},
+			{name: "with multiple target vectors", params: dto.GetParams{
+				NearVector: &searchparams.NearVector{
+					Distance: 0.4, WithDistance: true, After: after, TargetVectors: []string{"a", "b"},
+				},
+				Pagination: &filters.Pagination{Limit: filters.LimitFlagSearchByDist},
+			}},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				explorer, _ := newExplorer()
+				params := tt.params
+				params.ClassName = "BestClass"
+				_, err := explorer.GetClass(context.Background(), params)
+				assert.ErrorContains(t, err, "invalid 'after' parameter of a search by distance")
+			})
+		}
+	})
+}
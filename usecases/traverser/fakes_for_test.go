@@ -22,9 +22,11 @@ import (
 	"github.com/tailor-inc/graphql"
 	"github.com/tailor-inc/graphql/language/ast"
 	"github.com/weaviate/weaviate/adapters/handlers/graphql/descriptions"
+	dbinverted "github.com/weaviate/weaviate/adapters/repos/db/inverted"
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/aggregation"
 	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/facets"
 	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/modulecapabilities"
@@ -112,6 +114,24 @@ func (f *fakeVectorSearcher) VectorSearch(ctx context.Context,
 	return args.Get(0).([]search.Result), args.Error(1)
 }
 
+func (f *fakeVectorSearcher) VectorSearchBatch(ctx context.Context,
+	params dto.GetParams, targetVector string, searchVectors [][]float32,
+) ([][]search.Result, error) {
+	args := f.Called(params, targetVector, searchVectors)
+	return args.Get(0).([][]search.Result), args.Error(1)
+}
+
+func (f *fakeVectorSearcher) Facets(ctx context.Context,
+	params facets.Params,
+) ([]facets.Result, error) {
+	args := f.Called(params)
+	return args.Get(0).([]facets.Result), args.Error(1)
+}
+
+func (f *fakeVectorSearcher) TextAnalyzers(className string) (*dbinverted.TextAnalyzers, error) {
+	return nil, nil
+}
+
 func (f *fakeVectorSearcher) Search(ctx context.Context,
 	params dto.GetParams,
 ) ([]search.Result, error) {
@@ -148,6 +168,13 @@ func (f *fakeVectorSearcher) ResolveReferences(ctx context.Context, objs search.
 	return nil, nil
 }
 
+func (f *fakeVectorSearcher) Traverse(ctx context.Context,
+	params dto.TraverseParams,
+) (*dto.TraverseResult, error) {
+	args := f.Called(params)
+	return args.Get(0).(*dto.TraverseResult), args.Error(1)
+}
+
 type fakeVectorRepo struct {
 	mock.Mock
 }
@@ -173,6 +200,13 @@ func (f *fakeVectorRepo) Aggregate(ctx context.Context,
 	return args.Get(0).(*aggregation.Result), args.Error(1)
 }
 
+func (f *fakeVectorRepo) Traverse(ctx context.Context,
+	params dto.TraverseParams,
+) (*dto.TraverseResult, error) {
+	args := f.Called(params)
+	return args.Get(0).(*dto.TraverseResult), args.Error(1)
+}
+
 func (f *fakeVectorRepo) GetObject(ctx context.Context, uuid strfmt.UUID,
 	res *models.Object,
 ) error {
@@ -187,6 +221,18 @@ func (f *fakeExplorer) GetClass(ctx context.Context, p dto.GetParams) ([]interfa
 	return nil, nil
 }
 
+func (f *fakeExplorer) GetClassWithFacets(ctx context.Context, p dto.GetParams) ([]interface{}, []facets.Result, error) {
+	return nil, nil, nil
+}
+
+func (f *fakeExplorer) GetFacets(ctx context.Context, p dto.GetParams) ([]facets.Result, error) {
+	return nil, nil
+}
+
+func (f *fakeExplorer) GetClassBatch(ctx context.Context, p dto.GetParams) ([][]interface{}, error) {
+	return nil, nil
+}
+
 func (f *fakeExplorer) CrossClassVectorSearch(ctx context.Context, p ExploreParams) ([]search.Result, error) {
 	return nil, nil
 }
@@ -0,0 +1,218 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package grouper
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	"github.com/weaviate/weaviate/usecases/vectorizer"
+)
+
+// ArrangeGroups selects the hits of the groups of a groupBy search, orders
+// the groups and limits them to the requested number of groups. The hits of
+// a group need to be ordered by relevance and the groups by the relevance of
+// their best hit, which is kept if no sort is requested.
+func ArrangeGroups(groups []*additional.Group, groupBy *searchparams.GroupBy) ([]*additional.Group, error) {
+	for _, group := range groups {
+		hits, err := SelectHits(group.Hits, groupBy)
+		if err != nil {
+			return nil, fmt.Errorf("group %s: %w", group.GroupedBy.Value, err)
+		}
+		group.Hits = hits
+		if !groupBy.Exhaustive() {
+			group.Count = len(hits)
+		}
+	}
+
+	SortGroups(groups, groupBy.Sort)
+	if len(groups) > groupBy.Groups {
+		groups = groups[:groupBy.Groups]
+	}
+	for i := range groups {
+		groups[i].ID = i
+	}
+	return groups, nil
+}
+
+// SelectHits picks the hits of a group according to the strategy of the
+// groupBy. The hits need to be ordered by relevance and keep their order.
+func SelectHits(hits []map[string]interface{}, groupBy *searchparams.GroupBy) ([]map[string]interface{}, error) {
+	if len(hits) <= groupBy.ObjectsPerGroup {
+		return hits, nil
+	}
+	if groupBy.Strategy != searchparams.GroupByStrategyDiverse {
+		return hits[:groupBy.ObjectsPerGroup], nil
+	}
+
+	vectors := make([][]float32, len(hits))
+	for i, hit := range hits {
+		addl, err := hitAdditional(hit)
+		if err != nil {
+			return nil, err
+		}
+		if len(addl.Vector) == 0 {
+			return nil, fmt.Errorf("strategy %s requires the vectors of the hits",
+				searchparams.GroupByStrategyDiverse)
+		}
+		vectors[i] = addl.Vector
+	}
+
+	picked, err := pickDiverse(vectors, groupBy.ObjectsPerGroup)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]map[string]interface{}, len(picked))
+	for i, pos := range picked {
+		out[i] = hits[pos]
+	}
+	return out, nil
+}
+
+// pickDiverse picks n of the vectors, starting with the first one and then
+// always the one furthest away from all picked ones. The positions of the
+// picked vectors are returned in ascending order.
+func pickDiverse(vectors [][]float32, n int) ([]int, error) {
+	picked := make([]bool, len(vectors))
+	// distance of every vector to its closest picked vector
+	distances := make([]float32, len(vectors))
+	for i := range distances {
+		distances[i] = math.MaxFloat32
+	}
+
+	next := 0
+	for count := 0; count < n && next >= 0; count++ {
+		picked[next] = true
+		last := next
+		next = -1
+		for i, vector := range vectors {
+			if picked[i] {
+				continue
+			}
+			dist, err := vectorizer.NormalizedDistance(vector, vectors[last])
+			if err != nil {
+				return nil, err
+			}
+			if dist < distances[i] {
+				distances[i] = dist
+			}
+			if next < 0 || distances[i] > distances[next] {
+				next = i
+			}
+		}
+	}
+
+	out := make([]int, 0, n)
+	for i := range picked {
+		if picked[i] {
+			out = append(out, i)
+		}
+	}
+	return out, nil
+}
+
+// SortGroups orders the groups, ties keep their order. Without a sort the
+// order is kept.
+func SortGroups(groups []*additional.Group, by *searchparams.GroupBySort) {
+	if by == nil {
+		return
+	}
+
+	var less func(a, b *additional.Group) bool
+	switch by.By {
+	case searchparams.GroupBySortDistance:
+		less = func(a, b *additional.Group) bool {
+			return closestDistance(a) < closestDistance(b)
+		}
+	case searchparams.GroupBySortScore:
+		less = func(a, b *additional.Group) bool {
+			return aggregatedScore(a, by.Aggregation) > aggregatedScore(b, by.Aggregation)
+		}
+	case searchparams.GroupBySortProperty:
+		less = func(a, b *additional.Group) bool {
+			if by.Descending {
+				return lessValue(b.GroupedBy.Value, a.GroupedBy.Value)
+			}
+			return lessValue(a.GroupedBy.Value, b.GroupedBy.Value)
+		}
+	case searchparams.GroupBySortSize:
+		less = func(a, b *additional.Group) bool {
+			return a.Count > b.Count
+		}
+	default:
+		return
+	}
+
+	sort.SliceStable(groups, func(i, j int) bool {
+		return less(groups[i], groups[j])
+	})
+}
+
+func closestDistance(group *additional.Group) float32 {
+	closest := float32(math.MaxFloat32)
+	for _, hit := range group.Hits {
+		if addl, err := hitAdditional(hit); err == nil && addl.Distance < closest {
+			closest = addl.Distance
+		}
+	}
+	return closest
+}
+
+func aggregatedScore(group *additional.Group, aggregation string) float32 {
+	if len(group.Hits) == 0 {
+		return float32(math.Inf(-1))
+	}
+
+	var max, sum float32
+	for i, hit := range group.Hits {
+		addl, err := hitAdditional(hit)
+		if err != nil {
+			continue
+		}
+		if i == 0 || addl.Score > max {
+			max = addl.Score
+		}
+		sum += addl.Score
+	}
+
+	switch aggregation {
+	case searchparams.GroupByScoreSum:
+		return sum
+	case searchparams.GroupByScoreAvg:
+		return sum / float32(len(group.Hits))
+	default:
+		return max
+	}
+}
+
+// lessValue compares the values groups are grouped by, which are numerical if
+// the property is
+func lessValue(a, b string) bool {
+	af, errA := strconv.ParseFloat(a, 64)
+	bf, errB := strconv.ParseFloat(b, 64)
+	if errA == nil && errB == nil {
+		return af < bf
+	}
+	return a < b
+}
+
+func hitAdditional(hit map[string]interface{}) (*additional.GroupHitAdditional, error) {
+	addl, ok := hit["_additional"].(*additional.GroupHitAdditional)
+	if !ok {
+		return nil, fmt.Errorf("unexpected additional properties of hit: %T", hit["_additional"])
+	}
+	return addl, nil
+}
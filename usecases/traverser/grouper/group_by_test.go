@@ -0,0 +1,194 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package grouper
+
+import (
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/searchparams"
+)
+
+func TestSelectHits(t *testing.T) {
+	// the first three hits are almost the same, the last two point elsewhere
+	hits := []map[string]interface{}{
+		hit("a", 0.1, []float32{1, 0, 0}),
+		hit("b", 0.2, []float32{0.99, 0.01, 0}),
+		hit("c", 0.3, []float32{0.98, 0.02, 0}),
+		hit("d", 0.4, []float32{0, 1, 0}),
+		hit("e", 0.5, []float32{0, 0, 1}),
+	}
+
+	t.Run("closest", func(t *testing.T) {
+		out, err := SelectHits(hits, &searchparams.GroupBy{ObjectsPerGroup: 3})
+		require.Nil(t, err)
+		assert.Equal(t, []strfmt.UUID{"a", "b", "c"}, hitIDs(out))
+	})
+
+	t.Run("diverse", func(t *testing.T) {
+		out, err := SelectHits(hits, &searchparams.GroupBy{
+			ObjectsPerGroup: 3, Strategy: searchparams.GroupByStrategyDiverse,
+		})
+		require.Nil(t, err)
+		assert.Equal(t, []strfmt.UUID{"a", "d", "e"}, hitIDs(out))
+	})
+
+	t.Run("diverse with fewer hits than objects per group", func(t *testing.T) {
+		out, err := SelectHits(hits[:2], &searchparams.GroupBy{
+			ObjectsPerGroup: 3, Strategy: searchparams.GroupByStrategyDiverse,
+		})
+		require.Nil(t, err)
+		assert.Equal(t, []strfmt.UUID{"a", "b"}, hitIDs(out))
+	})
+
+	t.Run("diverse without vectors", func(t *testing.T) {
+		_, err := SelectHits([]map[string]interface{}{hit("a", 0.1, nil), hit("b", 0.2, nil)},
+			&searchparams.GroupBy{ObjectsPerGroup: 1, Strategy: searchparams.GroupByStrategyDiverse})
+		assert.ErrorContains(t, err, "requires the vectors of the hits")
+	})
+}
+
+func TestSortGroups(t *testing.T) {
+	newGroups := func() []*additional.Group {
+		return []*additional.Group{
+			{GroupedBy: &additional.GroupedBy{Value: "10"}, Count: 2, Hits: []map[string]interface{}{
+				scoredHit(0.1, 0.9), scoredHit(0.8, 0.2),
+			}},
+			{GroupedBy: &additional.GroupedBy{Value: "9"}, Count: 3, Hits: []map[string]interface{}{
+				scoredHit(0.2, 0.8), scoredHit(0.3, 0.7), scoredHit(0.4, 0.6),
+			}},
+			{GroupedBy: &additional.GroupedBy{Value: "100"}, Count: 1, Hits: []map[string]interface{}{
+				scoredHit(0.05, 0.85),
+			}},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		sort     *searchparams.GroupBySort
+		expected []string
+	}{
+		{name: "without sort", expected: []string{"10", "9", "100"}},
+		{
+			name:     "distance",
+			sort:     &searchparams.GroupBySort{By: searchparams.GroupBySortDistance},
+			expected: []string{"100", "10", "9"},
+		},
+		{
+			name:     "max score",
+			sort:     &searchparams.GroupBySort{By: searchparams.GroupBySortScore},
+			expected: []string{"10", "100", "9"},
+		},
+		{
+			name:     "avg score",
+			sort:     &searchparams.GroupBySort{By: searchparams.GroupBySortScore, Aggregation: searchparams.GroupByScoreAvg},
+			expected: []string{"100", "9", "10"},
+		},
+		{
+			name:     "sum score",
+			sort:     &searchparams.GroupBySort{By: searchparams.GroupBySortScore, Aggregation: searchparams.GroupByScoreSum},
+			expected: []string{"9", "10", "100"},
+		},
+		{
+			name:     "numerical property",
+			sort:     &searchparams.GroupBySort{By: searchparams.GroupBySortProperty},
+			expected: []string{"9", "10", "100"},
+		},
+		{
+			name:     "numerical property descending",
+			sort:     &searchparams.GroupBySort{By: searchparams.GroupBySortProperty, Descending: true},
+			expected: []string{"100", "10", "9"},
+		},
+		{
+			name:     "size",
+			sort:     &searchparams.GroupBySort{By: searchparams.GroupBySortSize},
+			expected: []string{"9", "10", "100"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			groups := newGroups()
+			SortGroups(groups, tt.sort)
+			values := make([]string, len(groups))
+			for i, group := range groups {
+				values[i] = group.GroupedBy.Value
+			}
+			assert.Equal(t, tt.expected, values)
+		})
+	}
+}
+
+func TestArrangeGroups(t *testing.T) {
+	newGroups := func() []*additional.Group {
+		return []*additional.Group{
+			{ID: 7, GroupedBy: &additional.GroupedBy{Value: "a"}, Count: 3, Hits: []map[string]interface{}{
+				hit("a1", 0.1, nil), hit("a2", 0.2, nil), hit("a3", 0.3, nil),
+			}},
+			{ID: 8, GroupedBy: &additional.GroupedBy{Value: "b"}, Count: 4, Hits: []map[string]interface{}{
+				hit("b1", 0.15, nil), hit("b2", 0.25, nil), hit("b3", 0.35, nil), hit("b4", 0.45, nil),
+			}},
+			{ID: 9, GroupedBy: &additional.GroupedBy{Value: "c"}, Count: 1, Hits: []map[string]interface{}{
+				hit("c1", 0.5, nil),
+			}},
+		}
+	}
+
+	t.Run("closest hits in the order of the search", func(t *testing.T) {
+		groups, err := ArrangeGroups(newGroups(), &searchparams.GroupBy{Groups: 2, ObjectsPerGroup: 2})
+		require.Nil(t, err)
+		require.Len(t, groups, 2)
+		assert.Equal(t, "a", groups[0].GroupedBy.Value)
+		assert.Equal(t, 0, groups[0].ID)
+		assert.Equal(t, 2, groups[0].Count)
+		assert.Equal(t, []strfmt.UUID{"a1", "a2"}, hitIDs(groups[0].Hits))
+		assert.Equal(t, "b", groups[1].GroupedBy.Value)
+		assert.Equal(t, 1, groups[1].ID)
+		assert.Equal(t, 2, groups[1].Count)
+	})
+
+	t.Run("sorted by size counts all objects of a group", func(t *testing.T) {
+		groups, err := ArrangeGroups(newGroups(), &searchparams.GroupBy{
+			Groups: 2, ObjectsPerGroup: 2,
+			Sort: &searchparams.GroupBySort{By: searchparams.GroupBySortSize},
+		})
+		require.Nil(t, err)
+		require.Len(t, groups, 2)
+		assert.Equal(t, "b", groups[0].GroupedBy.Value)
+		assert.Equal(t, 4, groups[0].Count)
+		assert.Equal(t, []strfmt.UUID{"b1", "b2"}, hitIDs(groups[0].Hits))
+		assert.Equal(t, "a", groups[1].GroupedBy.Value)
+		assert.Equal(t, 3, groups[1].Count)
+	})
+}
+
+func hit(id strfmt.UUID, distance float32, vector []float32) map[string]interface{} {
+	return map[string]interface{}{
+		"_additional": &additional.GroupHitAdditional{ID: id, Distance: distance, Vector: vector, Score: 1 - distance},
+	}
+}
+
+func scoredHit(distance, score float32) map[string]interface{} {
+	return map[string]interface{}{
+		"_additional": &additional.GroupHitAdditional{Distance: distance, Score: score},
+	}
+}
+
+func hitIDs(hits []map[string]interface{}) []strfmt.UUID {
+	ids := make([]strfmt.UUID, len(hits))
+	for i, hit := range hits {
+		ids[i] = hit["_additional"].(*additional.GroupHitAdditional).ID
+	}
+	return ids
+}
@@ -0,0 +1,298 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/stopwords"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+)
+
+const (
+	highlightPreTag  = "<em>"
+	highlightPostTag = "</em>"
+	// number of words before and after a match which are part of its fragment
+	highlightContextWords = 5
+	// maximum number of fragments returned per property
+	highlightMaxFragments = 3
+)
+
+// textAnalyzersGetter returns the custom analyzers of the index of a class,
+// so that queries are highlighted the way the properties have been indexed
+type textAnalyzersGetter interface {
+	TextAnalyzers(className string) (*inverted.TextAnalyzers, error)
+}
+
+// highlighterFromParams builds the highlighter for the keyword query of
+// params. It returns nil if no highlights were requested or the search has no
+// keyword query. As the highlighted properties need to be read from the stored
+// objects even if they are not selected, they are added to the properties of
+// params.
+func (e *Explorer) highlighterFromParams(params *dto.GetParams) (*highlighter, error) {
+	if !params.AdditionalProperties.Highlights {
+		return nil, nil
+	}
+
+	var query string
+	var properties []string
+	switch {
+	case params.KeywordRanking != nil:
+		query, properties = params.KeywordRanking.Query, params.KeywordRanking.Properties
+	case params.HybridSearch != nil:
+		query, properties = params.HybridSearch.Query, params.HybridSearch.Properties
+	default:
+		return nil, nil
+	}
+
+	class := e.schemaGetter.ReadOnlyClass(params.ClassName)
+	if class == nil {
+		return nil, fmt.Errorf("class %q not found", params.ClassName)
+	}
+	textAnalyzers, err := e.searcher.TextAnalyzers(params.ClassName)
+	if err != nil {
+		return nil, err
+	}
+	h, err := newHighlighter(class, textAnalyzers, query, properties)
+	if err != nil {
+		return nil, err
+	}
+
+	selectProps := append(search.SelectProperties{}, params.Properties...)
+	for _, prop := range h.props {
+		if selectProps.FindProperty(prop.name) == nil {
+			selectProps = append(selectProps, search.SelectProperty{Name: prop.name, IsPrimitive: true})
+		}
+	}
+	params.Properties = selectProps
+	params.AdditionalProperties.NoProps = false
+	return h, nil
+}
+
+// highlighter finds the words of stored text properties whose tokens match
+// the tokens of a keyword query. Both are analyzed the way the property was
+// indexed, so a word matches if the search would have matched it.
+type highlighter struct {
+	props []highlightProperty
+}
+
+type highlightProperty struct {
+	name         string
+	tokenization string
+	analyze      func(string) []string
+	terms        map[string]struct{}
+}
+
+// newHighlighter analyzes query the way the given properties of class are
+// indexed, textAnalyzers are the custom analyzers of the index of class
+func newHighlighter(class *models.Class, textAnalyzers *inverted.TextAnalyzers,
+	query string, properties []string,
+) (*highlighter, error) {
+	var stopwordDetector *stopwords.Detector
+	if class.InvertedIndexConfig != nil && class.InvertedIndexConfig.Stopwords != nil {
+		var err error
+		stopwordDetector, err = stopwords.NewDetectorFromConfig(*class.InvertedIndexConfig.Stopwords)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(properties) == 0 {
+		for _, prop := range class.Properties {
+			if inverted.HasSearchableIndex(prop) {
+				properties = append(properties, prop.Name)
+			}
+		}
+	}
+
+	// quotes only delimit phrases, the tokens of phrases are highlighted
+	// like any other query token
+	query = strings.ReplaceAll(query, `"`, " ")
+
+	h := &highlighter{}
+	for _, name := range properties {
+		// strip the boost of the property
+		name, _, _ = strings.Cut(name, "^")
+		prop, err := schema.GetPropertyByName(class, name)
+		if err != nil {
+			return nil, err
+		}
+		if !inverted.HasSearchableIndex(prop) {
+			continue
+		}
+
+		textAnalyzer, err := textAnalyzers.ForProperty(prop)
+		if err != nil {
+			return nil, err
+		}
+		hp := highlightProperty{
+			name:         prop.Name,
+			tokenization: prop.Tokenization,
+			terms:        map[string]struct{}{},
+		}
+		if textAnalyzer != nil {
+			hp.analyze = textAnalyzer.Analyze
+		} else {
+			tokenization := prop.Tokenization
+			hp.analyze = func(in string) []string { return helpers.Tokenize(tokenization, in) }
+		}
+
+		for _, term := range hp.analyze(query) {
+			// the searcher ignores stopwords of the query for word tokenization
+			if textAnalyzer == nil && prop.Tokenization == models.PropertyTokenizationWord &&
+				stopwordDetector != nil && stopwordDetector.IsStopword(term) {
+				continue
+			}
+			hp.terms[term] = struct{}{}
+		}
+		h.props = append(h.props, hp)
+	}
+	return h, nil
+}
+
+// addHighlights sets the highlights of each result as additional property
+func (h *highlighter) addHighlights(res []search.Result) {
+	for i := range res {
+		props, _ := res[i].Schema.(map[string]interface{})
+		if res[i].AdditionalProperties == nil {
+			res[i].AdditionalProperties = models.AdditionalProperties{}
+		}
+		res[i].AdditionalProperties["highlights"] = h.highlight(props)
+	}
+}
+
+func (h *highlighter) highlight(props map[string]interface{}) []*additional.Highlight {
+	highlights := []*additional.Highlight{}
+	for _, prop := range h.props {
+		var values []string
+		switch value := props[prop.name].(type) {
+		case string:
+			values = []string{value}
+		case []string:
+			values = value
+		case []interface{}:
+			for _, v := range value {
+				if s, ok := v.(string); ok {
+					values = append(values, s)
+				}
+			}
+		}
+
+		var fragments []string
+		for _, value := range values {
+			fragments = append(fragments, prop.fragments(value)...)
+			if len(fragments) >= highlightMaxFragments {
+				fragments = fragments[:highlightMaxFragments]
+				break
+			}
+		}
+		if len(fragments) > 0 {
+			highlights = append(highlights, &additional.Highlight{Property: prop.name, Fragments: fragments})
+		}
+	}
+	return highlights
+}
+
+type highlightWord struct {
+	start, end int
+	match      bool
+}
+
+// fragments returns the parts of text around the matching words, in which
+// the matching words are marked. Matches whose context overlaps share a
+// fragment.
+func (p highlightProperty) fragments(text string) []string {
+	if len(p.terms) == 0 {
+		return nil
+	}
+
+	words := splitHighlightWords(text, p.tokenization)
+	var matches []int
+	for i := range words {
+		for _, token := range p.analyze(text[words[i].start:words[i].end]) {
+			if _, ok := p.terms[token]; ok {
+				words[i].match = true
+				matches = append(matches, i)
+				break
+			}
+		}
+	}
+
+	var fragments []string
+	for i := 0; i < len(matches) && len(fragments) < highlightMaxFragments; {
+		from := max(0, matches[i]-highlightContextWords)
+		to := min(len(words)-1, matches[i]+highlightContextWords)
+		for i++; i < len(matches) && matches[i]-highlightContextWords <= to+1; i++ {
+			to = min(len(words)-1, matches[i]+highlightContextWords)
+		}
+
+		var fragment strings.Builder
+		pos := words[from].start
+		for _, word := range words[from : to+1] {
+			fragment.WriteString(text[pos:word.start])
+			if word.match {
+				fragment.WriteString(highlightPreTag)
+				fragment.WriteString(text[word.start:word.end])
+				fragment.WriteString(highlightPostTag)
+			} else {
+				fragment.WriteString(text[word.start:word.end])
+			}
+			pos = word.end
+		}
+		fragments = append(fragments, fragment.String())
+	}
+	return fragments
+}
+
+// splitHighlightWords splits text into the units which are highlighted as a
+// whole. Apart from field, whitespace and lowercase tokenization these are
+// runs of letters and digits, so text without spaces between words is
+// highlighted up to the next punctuation.
+func splitHighlightWords(text, tokenization string) []highlightWord {
+	var isWordRune func(r rune) bool
+	switch tokenization {
+	case models.PropertyTokenizationField:
+		if trimmed := strings.TrimSpace(text); trimmed != "" {
+			start := strings.Index(text, trimmed)
+			return []highlightWord{{start: start, end: start + len(trimmed)}}
+		}
+		return nil
+	case models.PropertyTokenizationWhitespace, models.PropertyTokenizationLowercase:
+		isWordRune = func(r rune) bool { return !unicode.IsSpace(r) }
+	default:
+		isWordRune = func(r rune) bool { return unicode.IsLetter(r) || unicode.IsNumber(r) }
+	}
+
+	var words []highlightWord
+	start := -1
+	for i, r := range text {
+		switch {
+		case isWordRune(r) && start < 0:
+			start = i
+		case !isWordRune(r) && start >= 0:
+			words = append(words, highlightWord{start: start, end: i})
+			start = -1
+		}
+	}
+	if start >= 0 {
+		words = append(words, highlightWord{start: start, end: len(text)})
+	}
+	return words
+}
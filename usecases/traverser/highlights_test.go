@@ -0,0 +1,146 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+)
+
+func TestHighlighter(t *testing.T) {
+	class := &models.Class{
+		Class: "Article",
+		InvertedIndexConfig: &models.InvertedIndexConfig{
+			Stopwords: &models.StopwordConfig{Preset: "en"},
+		},
+		Properties: []*models.Property{
+			{
+				Name:         "title",
+				DataType:     schema.DataTypeText.PropString(),
+				Tokenization: models.PropertyTokenizationWord,
+			},
+			{
+				Name:         "body",
+				DataType:     schema.DataTypeText.PropString(),
+				Tokenization: models.PropertyTokenizationWord,
+			},
+			{
+				Name:         "tags",
+				DataType:     schema.DataTypeTextArray.PropString(),
+				Tokenization: models.PropertyTokenizationField,
+			},
+			{
+				Name:     "views",
+				DataType: schema.DataTypeInt.PropString(),
+			},
+		},
+	}
+
+	t.Run("matched words are marked in their context", func(t *testing.T) {
+		h, err := newHighlighter(class, nil, "the Fox", []string{"title^2", "body"})
+		require.Nil(t, err)
+
+		highlights := h.highlight(map[string]interface{}{
+			"title": "The quick brown fox",
+			"body": "one two three four five six seven eight fox, nine ten eleven twelve thirteen " +
+				"alpha beta fourteen fifteen sixteen seventeen Fox eighteen fox nineteen",
+		})
+		assert.Equal(t, []*additional.Highlight{
+			{Property: "title", Fragments: []string{"The quick brown <em>fox</em>"}},
+			{Property: "body", Fragments: []string{
+				"four five six seven eight <em>fox</em>, nine ten eleven twelve thirteen",
+				"beta fourteen fifteen sixteen seventeen <em>Fox</em> eighteen <em>fox</em> nineteen",
+			}},
+		}, highlights)
+	})
+
+	t.Run("all searchable properties if none are given", func(t *testing.T) {
+		h, err := newHighlighter(class, nil, `"summer sale"`, nil)
+		require.Nil(t, err)
+
+		highlights := h.highlight(map[string]interface{}{
+			"title": "Nothing",
+			"tags":  []interface{}{"summer", "summer sale", "winter sale"},
+			"views": float64(3),
+		})
+		assert.Equal(t, []*additional.Highlight{
+			{Property: "tags", Fragments: []string{"<em>summer sale</em>"}},
+		}, highlights)
+	})
+
+	t.Run("number of fragments is limited", func(t *testing.T) {
+		h, err := newHighlighter(class, nil, "fox", []string{"body"})
+		require.Nil(t, err)
+
+		body := ""
+		for i := 0; i < 5; i++ {
+			body += "fox a b c d e f g h i j k l "
+		}
+		highlights := h.highlight(map[string]interface{}{"body": body})
+		require.Len(t, highlights, 1)
+		assert.Len(t, highlights[0].Fragments, highlightMaxFragments)
+	})
+
+	t.Run("properties with analyzer use the analyzers of the index", func(t *testing.T) {
+		analyzed := *class
+		analyzed.Properties = []*models.Property{{
+			Name:         "body",
+			DataType:     schema.DataTypeText.PropString(),
+			Tokenization: models.PropertyTokenizationWord,
+			Analyzer:     "stemmed",
+		}}
+		textAnalyzers, err := inverted.NewTextAnalyzers([]*models.TextAnalyzerConfig{{
+			Name:      "stemmed",
+			Tokenizer: models.PropertyTokenizationWord,
+			TokenFilters: []*models.TextAnalyzerTokenFilter{
+				{Type: models.TextAnalyzerTokenFilterTypeStemmer},
+			},
+		}}, nil)
+		require.Nil(t, err)
+
+		h, err := newHighlighter(&analyzed, textAnalyzers, "running", nil)
+		require.Nil(t, err)
+		highlights := h.highlight(map[string]interface{}{"body": "she runs"})
+		assert.Equal(t, []*additional.Highlight{
+			{Property: "body", Fragments: []string{"she <em>runs</em>"}},
+		}, highlights)
+
+		_, err = newHighlighter(&analyzed, nil, "running", nil)
+		assert.NotNil(t, err, "analyzer is missing in the index")
+	})
+
+	t.Run("unknown property", func(t *testing.T) {
+		_, err := newHighlighter(class, nil, "fox", []string{"author"})
+		assert.NotNil(t, err)
+	})
+
+	t.Run("results without match have no highlights", func(t *testing.T) {
+		h, err := newHighlighter(class, nil, "fox", []string{"body"})
+		require.Nil(t, err)
+
+		res := []search.Result{
+			{Schema: map[string]interface{}{"body": "a fox"}},
+			{Schema: map[string]interface{}{"body": "a dog"}},
+		}
+		h.addHighlights(res)
+		assert.Equal(t, []*additional.Highlight{{Property: "body", Fragments: []string{"a <em>fox</em>"}}},
+			res[0].AdditionalProperties["highlights"])
+		assert.Equal(t, []*additional.Highlight{}, res[1].AdditionalProperties["highlights"])
+	})
+}
@@ -13,14 +13,23 @@ package traverser
 
 import (
 	"context"
+	"slices"
 
 	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/dto"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/search"
 	"github.com/weaviate/weaviate/entities/searchparams"
+	"github.com/weaviate/weaviate/usecases/traverser/grouper"
 )
 
-func (e *Explorer) groupSearchResults(ctx context.Context, sr search.Results, groupBy *searchparams.GroupBy) (search.Results, error) {
+func (e *Explorer) groupSearchResults(ctx context.Context, sr search.Results, params dto.GetParams) (search.Results, error) {
+	groupBy := params.GroupBy
+	targetVector, err := e.groupByTargetVector(params)
+	if err != nil {
+		return nil, err
+	}
+
 	groupsOrdered := []string{}
 	groups := map[string][]search.Result{}
 
@@ -34,11 +43,11 @@ func (e *Explorer) groupSearchResults(ctx context.Context, sr search.Results, gr
 		}
 
 		current, groupExists := groups[val]
-		if len(current) >= groupBy.ObjectsPerGroup {
+		if len(current) >= groupBy.ObjectsPerGroup && !groupBy.Exhaustive() {
 			continue
 		}
 
-		if !groupExists && len(groups) >= groupBy.Groups {
+		if !groupExists && len(groups) >= groupBy.Groups && !groupBy.Exhaustive() {
 			continue
 		}
 
@@ -50,7 +59,7 @@ func (e *Explorer) groupSearchResults(ctx context.Context, sr search.Results, gr
 		}
 	}
 
-	out := make(search.Results, 0, len(sr))
+	arranged := make([]*additional.Group, len(groupsOrdered))
 	for i, groupValue := range groupsOrdered {
 		groupMembers := groups[groupValue]
 		first := groupMembers[0]
@@ -65,22 +74,33 @@ func (e *Explorer) groupSearchResults(ctx context.Context, sr search.Results, gr
 			props["_additional"] = &additional.GroupHitAdditional{
 				ID:       groupMember.ID,
 				Distance: groupMember.Dist,
-				Vector:   groupMember.Vector,
+				Vector:   groupHitVector(groupMember, targetVector),
+				Score:    groupMember.Score,
 			}
 			hits[j] = props
 		}
 
-		group := &additional.Group{
+		arranged[i] = &additional.Group{
 			ID: i,
 			GroupedBy: &additional.GroupedBy{
 				Value: groupValue,
 				Path:  []string{groupBy.Property},
 			},
-			Count:       len(hits),
+			Count:       len(groupMembers),
 			Hits:        hits,
 			MinDistance: first.Dist,
 			MaxDistance: first.Dist,
 		}
+	}
+
+	arranged, err = grouper.ArrangeGroups(arranged, groupBy)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(search.Results, 0, len(arranged))
+	for _, group := range arranged {
+		first := groups[group.GroupedBy.Value][0]
 
 		// add group
 		if first.AdditionalProperties == nil {
@@ -93,3 +113,51 @@ func (e *Explorer) groupSearchResults(ctx context.Context, sr search.Results, gr
 
 	return out, nil
 }
+
+// groupByTargetVector is the vector the hits of a groupBy with the diverse
+// strategy are compared by
+func (e *Explorer) groupByTargetVector(params dto.GetParams) (string, error) {
+	if params.GroupBy == nil || params.GroupBy.Strategy != searchparams.GroupByStrategyDiverse {
+		return "", nil
+	}
+	if e.schemaGetter.ReadOnlyClass(params.ClassName) == nil {
+		return "", nil
+	}
+	targetVectors, err := e.targetParamHelper.GetTargetVectorOrDefault(e.schemaGetter.GetSchemaSkipAuth(),
+		params.ClassName, e.targetParamHelper.GetTargetVectorsFromParams(params))
+	if err != nil {
+		return "", err
+	}
+	if len(targetVectors) == 0 {
+		return "", nil
+	}
+	return targetVectors[0], nil
+}
+
+// withGroupHitVectors makes the search return the vectors the hits of a
+// groupBy with the diverse strategy are compared by
+func (e *Explorer) withGroupHitVectors(params dto.GetParams) (dto.GetParams, error) {
+	if params.GroupBy == nil || params.GroupBy.Strategy != searchparams.GroupByStrategyDiverse {
+		return params, nil
+	}
+	targetVector, err := e.groupByTargetVector(params)
+	if err != nil {
+		return params, err
+	}
+
+	if targetVector == "" {
+		params.AdditionalProperties.Vector = true
+	} else if !slices.Contains(params.AdditionalProperties.Vectors, targetVector) {
+		params.AdditionalProperties.Vectors = append(slices.Clone(params.AdditionalProperties.Vectors), targetVector)
+	}
+	return params, nil
+}
+
+func groupHitVector(result search.Result, targetVector string) []float32 {
+	if targetVector != "" {
+		if vector, ok := result.Vectors[targetVector]; ok {
+			return vector
+		}
+	}
+	return result.Vector
+}
@@ -0,0 +1,82 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/searchparams"
+)
+
+func TestGroupSearchResults(t *testing.T) {
+	newResults := func() search.Results {
+		results := search.Results{}
+		for i, brand := range []string{"acme", "globex", "globex", "initech", "globex", "acme"} {
+			results = append(results, search.Result{
+				ID:     strfmt.UUID(brand + string(rune('0'+i))),
+				Schema: map[string]interface{}{"brand": brand},
+				Score:  float32(6-i) / 10,
+			})
+		}
+		return results
+	}
+	groupedBy := func(results search.Results) []string {
+		values := make([]string, len(results))
+		for i, result := range results {
+			values[i] = result.AdditionalProperties["group"].(*additional.Group).GroupedBy.Value
+		}
+		return values
+	}
+
+	t.Run("groups in the order of the results", func(t *testing.T) {
+		out, err := (&Explorer{}).groupSearchResults(context.Background(), newResults(), dto.GetParams{
+			GroupBy: &searchparams.GroupBy{Property: "brand", Groups: 2, ObjectsPerGroup: 2},
+		})
+		require.Nil(t, err)
+		assert.Equal(t, []string{"acme", "globex"}, groupedBy(out))
+		assert.Equal(t, 2, out[1].AdditionalProperties["group"].(*additional.Group).Count)
+	})
+
+	t.Run("groups sorted by size", func(t *testing.T) {
+		out, err := (&Explorer{}).groupSearchResults(context.Background(), newResults(), dto.GetParams{
+			GroupBy: &searchparams.GroupBy{
+				Property: "brand", Groups: 2, ObjectsPerGroup: 2,
+				Sort: &searchparams.GroupBySort{By: searchparams.GroupBySortSize},
+			},
+		})
+		require.Nil(t, err)
+		assert.Equal(t, []string{"globex", "acme"}, groupedBy(out))
+		group := out[0].AdditionalProperties["group"].(*additional.Group)
+		assert.Equal(t, 3, group.Count)
+		assert.Len(t, group.Hits, 2)
+	})
+
+	t.Run("groups sorted by summed score", func(t *testing.T) {
+		out, err := (&Explorer{}).groupSearchResults(context.Background(), newResults(), dto.GetParams{
+			GroupBy: &searchparams.GroupBy{
+				Property: "brand", Groups: 3, ObjectsPerGroup: 3,
+				Sort: &searchparams.GroupBySort{
+					By: searchparams.GroupBySortScore, Aggregation: searchparams.GroupByScoreSum,
+				},
+			},
+		})
+		require.Nil(t, err)
+		assert.Equal(t, []string{"globex", "acme", "initech"}, groupedBy(out))
+	})
+}
@@ -51,6 +51,9 @@ func (t *TargetVectorParamHelper) GetTargetVectorsFromParams(params dto.GetParam
 	if params.NearVector != nil && len(params.NearVector.TargetVectors) >= 1 {
 		return params.NearVector.TargetVectors
 	}
+	if params.NearVectorBatch != nil && params.NearVectorBatch.TargetVector != "" {
+		return []string{params.NearVectorBatch.TargetVector}
+	}
 	if params.HybridSearch != nil && len(params.HybridSearch.TargetVectors) >= 1 {
 		return params.HybridSearch.TargetVectors
 	}
@@ -19,6 +19,7 @@ import (
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/aggregation"
 	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/facets"
 	"github.com/weaviate/weaviate/entities/search"
 	"github.com/weaviate/weaviate/usecases/auth/authorization"
 	"github.com/weaviate/weaviate/usecases/config"
@@ -54,10 +55,14 @@ type VectorSearcher interface {
 		properties *additional.ReplicationProperties, tenant string) (*search.Result, error)
 	ObjectsByID(ctx context.Context, id strfmt.UUID, props search.SelectProperties,
 		additional additional.Properties, tenant string) (search.Results, error)
+	Traverse(ctx context.Context, params dto.TraverseParams) (*dto.TraverseResult, error)
 }
 
 type explorer interface {
 	GetClass(ctx context.Context, params dto.GetParams) ([]interface{}, error)
+	GetClassWithFacets(ctx context.Context, params dto.GetParams) ([]interface{}, []facets.Result, error)
+	GetFacets(ctx context.Context, params dto.GetParams) ([]facets.Result, error)
+	GetClassBatch(ctx context.Context, params dto.GetParams) ([][]interface{}, error)
 	CrossClassVectorSearch(ctx context.Context, params ExploreParams) ([]search.Result, error)
 }
 
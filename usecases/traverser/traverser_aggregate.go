@@ -42,6 +42,12 @@ func (t *Traverser) Aggregate(ctx context.Context, principal *models.Principal,
 		return nil, errors.Wrap(err, "invalid 'where' filter")
 	}
 
+	for _, key := range params.GroupByKeys {
+		if err := key.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
 	if params.NearVector != nil || params.NearObject != nil || len(params.ModuleParams) > 0 {
 		className := params.ClassName.String()
 		err = t.nearParamsVector.validateNearParams(params.NearVector,
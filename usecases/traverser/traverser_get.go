@@ -17,6 +17,7 @@ import (
 	"time"
 
 	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/facets"
 	"github.com/weaviate/weaviate/entities/filters"
 
 	"github.com/weaviate/weaviate/entities/dto"
@@ -29,6 +30,58 @@ import (
 func (t *Traverser) GetClass(ctx context.Context, principal *models.Principal,
 	params dto.GetParams,
 ) ([]interface{}, error) {
+	var res []interface{}
+	err := t.getClass(principal, params, func() (err error) {
+		res, err = t.explorer.GetClass(ctx, params)
+		return err
+	})
+	return res, err
+}
+
+// GetClassWithFacets returns the results and the facets of the search
+// separately, see Explorer.GetClassWithFacets
+func (t *Traverser) GetClassWithFacets(ctx context.Context, principal *models.Principal,
+	params dto.GetParams,
+) ([]interface{}, []facets.Result, error) {
+	var res []interface{}
+	var facetResults []facets.Result
+	err := t.getClass(principal, params, func() (err error) {
+		res, facetResults, err = t.explorer.GetClassWithFacets(ctx, params)
+		return err
+	})
+	return res, facetResults, err
+}
+
+// GetFacets counts the facets of the search, see Explorer.GetFacets
+func (t *Traverser) GetFacets(ctx context.Context, principal *models.Principal,
+	params dto.GetParams,
+) ([]facets.Result, error) {
+	var facetResults []facets.Result
+	err := t.getClass(principal, params, func() (err error) {
+		facetResults, err = t.explorer.GetFacets(ctx, params)
+		return err
+	})
+	return facetResults, err
+}
+
+// GetClassBatch runs the near vector queries of params.NearVectorBatch and
+// returns one result list per query vector
+func (t *Traverser) GetClassBatch(ctx context.Context, principal *models.Principal,
+	params dto.GetParams,
+) ([][]interface{}, error) {
+	var res [][]interface{}
+	err := t.getClass(principal, params, func() (err error) {
+		res, err = t.explorer.GetClassBatch(ctx, params)
+		return err
+	})
+	return res, err
+}
+
+// getClass applies rate limiting, metrics and validation shared by all Get
+// queries before calling fn
+func (t *Traverser) getClass(principal *models.Principal, params dto.GetParams,
+	fn func() error,
+) error {
 	before := time.Now()
 
 	ok := t.ratelimiter.TryInc()
@@ -36,7 +89,7 @@ func (t *Traverser) GetClass(ctx context.Context, principal *models.Principal,
 		// we currently have no concept of error status code or typed errors in
 		// GraphQL, so there is no other way then to send a message containing what
 		// we want to convey
-		return nil, enterrors.NewErrRateLimit()
+		return enterrors.NewErrRateLimit()
 	}
 
 	defer t.ratelimiter.Dec()
@@ -46,18 +99,18 @@ func (t *Traverser) GetClass(ctx context.Context, principal *models.Principal,
 	defer t.metrics.QueriesObserveDuration(params.ClassName, before.UnixMilli())
 
 	if err := t.probeForRefDepthLimit(params.Properties); err != nil {
-		return nil, err
+		return err
 	}
 
 	unlock, err := t.locks.LockConnector()
 	if err != nil {
-		return nil, enterrors.NewErrLockConnector(err)
+		return enterrors.NewErrLockConnector(err)
 	}
 	defer unlock()
 
 	// validate here, because filters can contain references that need to be authorized
 	if err := t.validateFilters(principal, params.Filters); err != nil {
-		return nil, errors.Wrap(err, "invalid 'where' filter")
+		return errors.Wrap(err, "invalid 'where' filter")
 	}
 
 	certainty := ExtractCertaintyFromParams(params)
@@ -66,11 +119,11 @@ func (t *Traverser) GetClass(ctx context.Context, principal *models.Principal,
 		// that the vector index is configured to use cosine
 		// distance
 		if err := t.validateGetDistanceParams(params); err != nil {
-			return nil, err
+			return err
 		}
 	}
 
-	return t.explorer.GetClass(ctx, params)
+	return fn()
 }
 
 // probeForRefDepthLimit checks to ensure reference nesting depth doesn't exceed the limit
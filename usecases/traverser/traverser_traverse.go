@@ -0,0 +1,152 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"context"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/dto"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+// Traverse expands the cross-references of the start objects breadth-first
+// and returns the visited subgraph. The principal needs read access to every
+// class the traversal can reach according to the schema.
+func (t *Traverser) Traverse(ctx context.Context, principal *models.Principal,
+	params dto.TraverseParams,
+) (*dto.TraverseResult, error) {
+	ok := t.ratelimiter.TryInc()
+	if !ok {
+		return nil, enterrors.NewErrRateLimit()
+	}
+	defer t.ratelimiter.Dec()
+
+	if err := t.validateTraverseParams(&params); err != nil {
+		return nil, err
+	}
+
+	classes := t.reachableClasses(params)
+	for _, class := range classes {
+		tenant := ""
+		if schema.MultiTenancyEnabled(t.schemaGetter.ReadOnlyClass(class)) {
+			tenant = params.Tenant
+		}
+		if err := t.authorizer.Authorize(principal, authorization.READ, authorization.ShardsData(class, tenant)...); err != nil {
+			return nil, err
+		}
+	}
+
+	unlock, err := t.locks.LockConnector()
+	if err != nil {
+		return nil, enterrors.NewErrLockConnector(err)
+	}
+	defer unlock()
+
+	for i, hop := range params.Hops {
+		if err := t.validateFilters(principal, hop.Filters); err != nil {
+			return nil, objects.NewErrInvalidUserInput("invalid filter of hop %d: %v", i, err)
+		}
+	}
+
+	res, err := t.vectorSearcher.Traverse(ctx, params)
+	if err != nil {
+		return nil, errors.Wrap(err, "traverse")
+	}
+	return res, nil
+}
+
+func (t *Traverser) validateTraverseParams(params *dto.TraverseParams) error {
+	class := t.schemaGetter.ReadOnlyClass(schema.UppercaseClassName(params.ClassName))
+	if class == nil {
+		return objects.NewErrInvalidUserInput("class %q not found in schema", params.ClassName)
+	}
+	params.ClassName = class.Class
+	if len(params.StartIDs) == 0 {
+		return objects.NewErrInvalidUserInput("at least one start id is required")
+	}
+	if params.MaxFanOut < 0 || params.MaxNodes < 0 || params.MaxDepth < 0 {
+		return objects.NewErrInvalidUserInput("maxDepth, maxFanOut and maxNodes must not be negative")
+	}
+
+	var hops []dto.TraverseHop
+	for i, hop := range params.Hops {
+		if hop.ClassName != "" {
+			class := t.schemaGetter.ReadOnlyClass(schema.UppercaseClassName(hop.ClassName))
+			if class == nil {
+				return objects.NewErrInvalidUserInput("hop %d: class %q not found in schema", i, hop.ClassName)
+			}
+			hop.ClassName = class.Class
+		} else if hop.Filters != nil {
+			return objects.NewErrInvalidUserInput("hop %d: filters require a class", i)
+		}
+		hops = append(hops, hop)
+	}
+	params.Hops = hops
+
+	if params.MaxDepth == 0 {
+		params.MaxDepth = 1
+	}
+	if limit := t.config.Config.QueryCrossReferenceDepthLimit; params.MaxDepth > limit {
+		return objects.NewErrInvalidUserInput(
+			"maxDepth %d exceeds QUERY_CROSS_REFERENCE_DEPTH_LIMIT (%d)", params.MaxDepth, limit)
+	}
+	if limit := int(t.config.Config.QueryMaximumResults); limit > 0 &&
+		(params.MaxNodes == 0 || params.MaxNodes > limit) {
+		params.MaxNodes = limit
+	}
+	return nil
+}
+
+// reachableClasses returns the start class and all classes reachable from it
+// within MaxDepth hops over the hop properties
+func (t *Traverser) reachableClasses(params dto.TraverseParams) []string {
+	seen := map[string]struct{}{params.ClassName: {}}
+	frontier := []string{params.ClassName}
+	for depth := 0; depth < params.MaxDepth && len(frontier) > 0; depth++ {
+		hop := params.Hop(depth)
+		var next []string
+		for _, name := range frontier {
+			class := t.schemaGetter.ReadOnlyClass(name)
+			if class == nil {
+				continue
+			}
+			for _, prop := range class.Properties {
+				if !schema.IsRefDataType(prop.DataType) || !hop.Follows(prop.Name) {
+					continue
+				}
+				for _, target := range prop.DataType {
+					if !hop.Reaches(target) {
+						continue
+					}
+					if _, ok := seen[target]; !ok {
+						seen[target] = struct{}{}
+						next = append(next, target)
+					}
+				}
+			}
+		}
+		frontier = next
+	}
+
+	classes := make([]string, 0, len(seen))
+	for class := range seen {
+		classes = append(classes, class)
+	}
+	sort.Strings(classes)
+	return classes
+}
@@ -0,0 +1,180 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/mocks"
+	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+var traverseTestSchema = schema.Schema{
+	Objects: &models.Schema{
+		Classes: []*models.Class{
+			{
+				Class: "Person",
+				Properties: []*models.Property{
+					{Name: "name", DataType: schema.DataTypeText.PropString()},
+					{Name: "knows", DataType: []string{"Person"}},
+					{Name: "worksAt", DataType: []string{"Company"}},
+				},
+			},
+			{
+				Class: "Company",
+				Properties: []*models.Property{
+					{Name: "name", DataType: schema.DataTypeText.PropString()},
+					{Name: "locatedIn", DataType: []string{"City"}},
+				},
+			},
+			{
+				Class:              "City",
+				MultiTenancyConfig: &models.MultiTenancyConfig{Enabled: true},
+				Properties: []*models.Property{
+					{Name: "name", DataType: schema.DataTypeText.PropString()},
+				},
+			},
+		},
+	},
+}
+
+func TestTraverse(t *testing.T) {
+	startID := strfmt.UUID("4bd2ef51-3eb3-4b1c-9c71-5e5f2b7d4b1f")
+	nameFilter := &filters.LocalFilter{Root: &filters.Clause{
+		Operator: filters.OperatorEqual,
+		On:       &filters.Path{Class: "Company", Property: "name"},
+		Value:    &filters.Value{Value: "acme", Type: schema.DataTypeText},
+	}}
+
+	newTraverser := func() (*Traverser, *fakeVectorSearcher, *mocks.FakeAuthorizer) {
+		logger, _ := test.NewNullLogger()
+		cfg := config.WeaviateConfig{Config: config.Config{
+			QueryCrossReferenceDepthLimit: 3,
+			QueryMaximumResults:           100,
+		}}
+		repo := &fakeVectorSearcher{}
+		authorizer := mocks.NewMockAuthorizer()
+		return NewTraverser(&cfg, &fakeLocks{}, logger, authorizer, repo, &fakeExplorer{},
+			&fakeSchemaGetter{traverseTestSchema}, nil, nil, -1), repo, authorizer
+	}
+
+	t.Run("defaults and authorization", func(t *testing.T) {
+		traverser, repo, authorizer := newTraverser()
+		expected := dto.TraverseParams{
+			ClassName: "Person",
+			StartIDs:  []strfmt.UUID{startID},
+			Tenant:    "tenant1",
+			MaxDepth:  1,
+			MaxNodes:  100,
+		}
+		res := &dto.TraverseResult{Nodes: []dto.TraverseNode{{ClassName: "Person", ID: startID}}}
+		repo.On("Traverse", expected).Return(res, nil).Once()
+
+		got, err := traverser.Traverse(context.Background(), nil, dto.TraverseParams{
+			ClassName: "person",
+			StartIDs:  []strfmt.UUID{startID},
+			Tenant:    "tenant1",
+		})
+		require.Nil(t, err)
+		assert.Equal(t, res, got)
+		repo.AssertExpectations(t)
+
+		calls := authorizer.Calls()
+		require.Len(t, calls, 2)
+		for _, call := range calls {
+			assert.Equal(t, authorization.READ, call.Verb)
+		}
+		assert.Equal(t, authorization.ShardsData("Company", ""), calls[0].Resources)
+		assert.Equal(t, authorization.ShardsData("Person", ""), calls[1].Resources)
+	})
+
+	t.Run("reachable classes follow hop properties", func(t *testing.T) {
+		traverser, _, _ := newTraverser()
+		params := dto.TraverseParams{ClassName: "Person", MaxDepth: 3}
+
+		assert.Equal(t, []string{"City", "Company", "Person"}, traverser.reachableClasses(params))
+
+		params.Hops = []dto.TraverseHop{{Properties: []string{"knows"}}}
+		assert.Equal(t, []string{"Person"}, traverser.reachableClasses(params))
+
+		params.Hops = []dto.TraverseHop{{Properties: []string{"worksAt"}}, {Properties: []string{"locatedIn"}}}
+		params.MaxDepth = 1
+		assert.Equal(t, []string{"Company", "Person"}, traverser.reachableClasses(params))
+	})
+
+	t.Run("multi-tenant classes are authorized with tenant", func(t *testing.T) {
+		traverser, repo, authorizer := newTraverser()
+		repo.On("Traverse", mock.Anything).Return(&dto.TraverseResult{}, nil).Once()
+
+		_, err := traverser.Traverse(context.Background(), nil, dto.TraverseParams{
+			ClassName: "Person",
+			StartIDs:  []strfmt.UUID{startID},
+			Tenant:    "tenant1",
+			MaxDepth:  2,
+			Hops: []dto.TraverseHop{
+				{Properties: []string{"worksAt"}, ClassName: "company", Filters: nameFilter},
+				{Properties: []string{"locatedIn"}},
+			},
+		})
+		require.Nil(t, err)
+
+		var resources []string
+		for _, call := range authorizer.Calls() {
+			resources = append(resources, call.Resources...)
+		}
+		assert.Contains(t, resources, authorization.ShardsData("City", "tenant1")[0])
+		assert.Contains(t, resources, authorization.ShardsData("Company", "")[0])
+
+		params := repo.Calls[0].Arguments.Get(0).(dto.TraverseParams)
+		assert.Equal(t, "Company", params.Hops[0].ClassName)
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		traverser, _, authorizer := newTraverser()
+		authorizer.SetErr(assert.AnError)
+
+		_, err := traverser.Traverse(context.Background(), nil, dto.TraverseParams{
+			ClassName: "Person",
+			StartIDs:  []strfmt.UUID{startID},
+		})
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+
+	t.Run("invalid params", func(t *testing.T) {
+		for name, params := range map[string]dto.TraverseParams{
+			"unknown class":    {ClassName: "Unknown", StartIDs: []strfmt.UUID{startID}},
+			"no start ids":     {ClassName: "Person"},
+			"negative limit":   {ClassName: "Person", StartIDs: []strfmt.UUID{startID}, MaxFanOut: -1},
+			"depth limit":      {ClassName: "Person", StartIDs: []strfmt.UUID{startID}, MaxDepth: 4},
+			"unknown hop":      {ClassName: "Person", StartIDs: []strfmt.UUID{startID}, Hops: []dto.TraverseHop{{ClassName: "Unknown"}}},
+			"filter w/o class": {ClassName: "Person", StartIDs: []strfmt.UUID{startID}, Hops: []dto.TraverseHop{{Filters: nameFilter}}},
+		} {
+			t.Run(name, func(t *testing.T) {
+				traverser, _, _ := newTraverser()
+				_, err := traverser.Traverse(context.Background(), nil, params)
+				assert.ErrorAs(t, err, &objects.ErrInvalidUserInput{})
+			})
+		}
+	})
+}